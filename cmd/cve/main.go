@@ -25,12 +25,13 @@ import (
 
 var (
 	apiKey = flag.String("key",
-		os.Getenv("CVE_API_KEY"), "key for accessing the CVE API (can also be set via env var CVE_API_KEY)")
+		os.Getenv(cveclient.EnvKey), "key for accessing the CVE API (can also be set via env var "+cveclient.EnvKey+")")
 	apiUser = flag.String("user",
-		os.Getenv("CVE_API_USER"), "username for accessing the CVE API (can also be set via env var CVE_API_USER)")
+		os.Getenv(cveclient.EnvUser), "username for accessing the CVE API (can also be set via env var "+cveclient.EnvUser+")")
 	apiOrg = flag.String("org",
-		"Go", "organization name for accessing the CVE API")
-	test = flag.Bool("test", false, "whether to access the CVE API in the test environment")
+		cveclient.DefaultOrg, "organization name for accessing the CVE API")
+	test   = flag.Bool("test", false, "whether to access the CVE API in the test environment")
+	dryRun = flag.Bool("dry-run", false, "reserve and publish: show what would be done without changing anything")
 
 	// flags for the reserve command
 	reserveN          = flag.Int("n", 1, "reserve: the number of new CVE IDs to reserve")
@@ -51,7 +52,7 @@ func main() {
 	flag.Usage = func() {
 		fmt.Fprintln(out, "Command cve provides utilities for managing CVE IDs and CVE Records via the MITRE CVE Services API")
 		formatCmd := "    %s: %s\n"
-		fmt.Fprintf(out, "usage: cve [-key] [-user] [-org] [-test] <cmd> ...\n  commands:\n")
+		fmt.Fprintf(out, "usage: cve [-key] [-user] [-org] [-test] [-dry-run] <cmd> ...\n  commands:\n")
 		fmt.Fprintf(out, formatCmd, "[-n] [-seq] [-year] reserve", "reserves new CVE IDs")
 		fmt.Fprintf(out, formatCmd, "quota", "outputs the CVE ID quota of the authenticated organization")
 		fmt.Fprintf(out, formatCmd, "id {cve-id}", "outputs details on an assigned CVE ID (CVE-YYYY-NNNN)")
@@ -86,6 +87,7 @@ func main() {
 		Key:      *apiKey,
 		Org:      *apiOrg,
 		User:     *apiUser,
+		DryRun:   *dryRun,
 	}
 	c := cveclient.New(cfg)
 
@@ -215,6 +217,10 @@ func reserve(c *cveclient.Client, opts cveclient.ReserveOptions) error {
 	if err != nil {
 		return err
 	}
+	if c.DryRun {
+		fmt.Printf("dry run: would reserve %d CVE IDs for %d\n", opts.NumIDs, opts.Year)
+		return nil
+	}
 	cvesReserved := len(cves)
 	if cvesReserved < opts.NumIDs {
 		fmt.Printf("warning: only %d of %d requested CVE IDs were reserved\n",
@@ -312,6 +318,10 @@ func publish(c *cveclient.Client, filename string, update bool) (err error) {
 		}
 		action = "create"
 	}
+	if c.DryRun {
+		fmt.Printf("dry run: would %s record for %s\n", action, published.Metadata.ID)
+		return nil
+	}
 	fmt.Printf("successfully %sd record for %s:\n%v\nlink: %s%s\n", action, published.Metadata.ID, recordToString(published), report.NISTPrefix, published.Metadata.ID)
 	return nil
 }
@@ -9,21 +9,95 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
 
 	"golang.org/x/vulndb/internal/database"
 )
 
 var (
-	repoDir = flag.String("repo", ".", "Directory containing vulndb repo")
-	jsonDir = flag.String("out", "out", "Directory to write JSON database to")
-	indent  = flag.Bool("indent", false, "Indent JSON for debugging")
+	repoDir    = flag.String("repo", ".", "Directory containing vulndb repo")
+	jsonDir    = flag.String("out", "out", "Directory to write JSON database to")
+	indent     = flag.Bool("indent", false, "Indent JSON for debugging")
+	incr       = flag.Bool("incremental", false, "Write only changed files, and remove stale ones, in an existing database")
+	check      = flag.Bool("validate", false, "Only validate the database in the -out directory")
+	signingKey = flag.String("signing-key", "", "File with a PEM-encoded Ed25519 private key to sign the database with")
+	pubKey     = flag.String("check-signature", "", "Only check the signature of the database in the -out directory with the PEM-encoded Ed25519 public key in this file")
+	verify     = flag.Bool("verify", false, "Only rebuild the database and check that the one in the -out directory is the same, byte for byte (use the same -indent and -overlay it was generated with)")
+	overlayDir = flag.String("overlay", "", "Directory of a git repo of private reports in data/reports to add to the database")
+	vdrFile    = flag.String("vdr", "", "Only write the database as a CycloneDX Vulnerability Disclosure Report to this file")
+	modules    = flag.String("modules", "", "Comma-separated modules, named as in the database (like stdlib), to limit -vdr to")
+	lookup     = flag.String("lookup", "", "Only print the IDs of the entries in the -out database that affect this package URL (like pkg:golang/golang.org/x/net@v0.1.0)")
 )
 
 func main() {
 	flag.Parse()
 	ctx := context.Background()
-	if err := database.Generate(ctx, *repoDir, *jsonDir, *indent); err != nil {
+	if *check {
+		if err := database.Validate(*jsonDir); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *pubKey != "" {
+		data, err := os.ReadFile(*pubKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pub, err := database.ParsePublicKey(data)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := database.CheckSignature(*jsonDir, pub); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *verify {
+		opts := database.GenerateOptions{Indent: *indent, OverlayDir: *overlayDir}
+		if err := database.Verify(ctx, *repoDir, *jsonDir, opts); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *lookup != "" {
+		entries, err := database.LookupPURL(*jsonDir, *lookup)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, e := range entries {
+			fmt.Println(e.ID)
+		}
+		return
+	}
+	if *vdrFile != "" {
+		var mods []string
+		if *modules != "" {
+			mods = strings.Split(*modules, ",")
+		}
+		bom, err := database.GenerateVDR(ctx, *repoDir, mods)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := database.WriteJSON(*vdrFile, bom, *indent); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	opts := database.GenerateOptions{Indent: *indent, Incremental: *incr, OverlayDir: *overlayDir}
+	if *signingKey != "" {
+		data, err := os.ReadFile(*signingKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		opts.SigningKey, err = database.ParseSigningKey(data)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := database.Generate(ctx, *repoDir, *jsonDir, opts); err != nil {
 		log.Fatal(err)
 	}
 }
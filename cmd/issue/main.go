@@ -50,11 +50,12 @@ func main() {
 		log.Fatal(err)
 	}
 	c := issues.NewGitHubClient(owner, repoName, *githubToken)
+	gc := ghsa.NewClient(ctx, *githubToken, nil)
 	switch cmd {
 	case "triage":
-		err = createIssueToTriage(ctx, c, *githubToken, filename)
+		err = createIssueToTriage(ctx, c, gc, filename)
 	case "excluded":
-		err = createExcluded(ctx, c, *githubToken, filename)
+		err = createExcluded(ctx, c, gc, filename)
 	default:
 		err = fmt.Errorf("unsupported command: %q", cmd)
 	}
@@ -63,42 +64,42 @@ func main() {
 	}
 }
 
-func createIssueToTriage(ctx context.Context, c issues.Client, ghToken, filename string) (err error) {
+func createIssueToTriage(ctx context.Context, c issues.Client, gc *ghsa.Client, filename string) (err error) {
 	aliases, err := parseAliases(filename)
 	if err != nil {
 		return err
 	}
 	for _, alias := range aliases {
-		if err := constructIssue(ctx, c, alias, ghToken, []string{"NeedsTriage"}); err != nil {
+		if err := constructIssue(ctx, c, gc, alias, []string{"NeedsTriage"}); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func createExcluded(ctx context.Context, c issues.Client, ghToken, filename string) (err error) {
+func createExcluded(ctx context.Context, c issues.Client, gc *ghsa.Client, filename string) (err error) {
 	records, err := parseExcluded(filename)
 	if err != nil {
 		return err
 	}
 	for _, r := range records {
-		if err := constructIssue(ctx, c, r.identifier, ghToken, []string{fmt.Sprintf("excluded: %s", r.category)}); err != nil {
+		if err := constructIssue(ctx, c, gc, r.identifier, []string{fmt.Sprintf("excluded: %s", r.category)}); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func constructIssue(ctx context.Context, c issues.Client, alias, ghToken string, labels []string) (err error) {
+func constructIssue(ctx context.Context, c issues.Client, gc *ghsa.Client, alias string, labels []string) (err error) {
 	var ghsas []*ghsa.SecurityAdvisory
 	if strings.HasPrefix(alias, "GHSA") {
-		sa, err := ghsa.FetchGHSA(ctx, ghToken, alias)
+		sa, err := gc.FetchGHSA(ctx, alias)
 		if err != nil {
 			return err
 		}
 		ghsas = append(ghsas, sa)
 	} else if strings.HasPrefix(alias, "CVE") {
-		ghsas, err = ghsa.ListForCVE(ctx, ghToken, alias)
+		ghsas, err = gc.ListForCVE(ctx, alias)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,54 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command vulnapi serves an HTTP API, and optionally a gRPC service, for
+// querying the vulnerability database written by gendb.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"golang.org/x/vulndb/internal/vulnapi"
+	"golang.org/x/vulndb/internal/vulnapi/vulnapipb"
+	"google.golang.org/grpc"
+)
+
+var (
+	dbDir    = flag.String("db", "out", "Directory containing the JSON database written by gendb")
+	addr     = flag.String("addr", ":"+envOr("PORT", "8080"), "Address to listen on")
+	maxAge   = flag.Duration("max-age", time.Hour, "How long clients may cache responses")
+	grpcAddr = flag.String("grpc-addr", "", "Address to serve the gRPC service on (empty to not serve it)")
+)
+
+func main() {
+	flag.Parse()
+	if _, err := os.Stat(*dbDir); err != nil {
+		log.Fatal(err)
+	}
+	s := vulnapi.NewServer(*dbDir, *maxAge)
+	if *grpcAddr != "" {
+		lis, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		gs := grpc.NewServer()
+		vulnapipb.RegisterVulnDBServer(gs, s.GRPCServer())
+		log.Printf("serving gRPC on %s", *grpcAddr)
+		go func() { log.Fatal(gs.Serve(lis)) }()
+	}
+	log.Printf("serving %s on %s", *dbDir, *addr)
+	log.Fatal(http.ListenAndServe(*addr, s))
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command vulndbserve serves the files of the vulnerability database written
+// by gendb over HTTP, to host a mirror of it.
+//
+// If the -user flag is set, clients must authenticate with that user and the
+// password in the VULNDBSERVE_PASSWORD environment variable.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	"golang.org/x/vulndb/internal/dbserve"
+)
+
+var (
+	dbDir  = flag.String("db", "out", "Directory containing the JSON database written by gendb")
+	addr   = flag.String("addr", ":"+envOr("PORT", "8080"), "Address to listen on")
+	user   = flag.String("user", "", "User for HTTP basic authentication (empty for none)")
+	maxAge = flag.Duration("max-age", 0, "How long clients may cache files (0 to not say)")
+)
+
+func main() {
+	flag.Parse()
+	if _, err := os.Stat(*dbDir); err != nil {
+		log.Fatal(err)
+	}
+	opts := dbserve.Options{MaxAge: *maxAge}
+	if *user != "" {
+		opts.Username = *user
+		opts.Password = os.Getenv("VULNDBSERVE_PASSWORD")
+		if opts.Password == "" {
+			log.Fatal("-user needs a password in VULNDBSERVE_PASSWORD")
+		}
+	}
+	log.Printf("serving %s on %s", *dbDir, *addr)
+	log.Fatal(http.ListenAndServe(*addr, dbserve.NewHandler(*dbDir, opts)))
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
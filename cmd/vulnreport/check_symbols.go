@@ -0,0 +1,112 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/vuln/vulncheck"
+)
+
+// declaredSymbols returns the functions and methods declared in pkg, named
+// as in reports: "F" for a function and "T.M" for a method of T or *T, or of
+// the interface T.
+func declaredSymbols(pkg *vulncheck.Package) map[string]bool {
+	syms := map[string]bool{}
+	scope := pkg.Pkg.Scope()
+	for _, name := range scope.Names() {
+		switch o := scope.Lookup(name).(type) {
+		case *types.Func:
+			syms[name] = true
+		case *types.TypeName:
+			if it, ok := o.Type().Underlying().(*types.Interface); ok {
+				for i := 0; i < it.NumMethods(); i++ {
+					syms[name+"."+it.Method(i).Name()] = true
+				}
+				continue
+			}
+			ms := types.NewMethodSet(types.NewPointer(o.Type()))
+			for i := 0; i < ms.Len(); i++ {
+				syms[name+"."+ms.At(i).Obj().Name()] = true
+			}
+		}
+	}
+	return syms
+}
+
+// missingSymbols returns a description of each of syms that pkg does not
+// declare, with a suggestion if pkg declares a similar symbol, which is
+// likely what it was renamed to or from.
+func missingSymbols(pkg *vulncheck.Package, syms []string) []string {
+	declared := declaredSymbols(pkg)
+	var missing []string
+	for _, s := range syms {
+		if declared[s] {
+			continue
+		}
+		msg := fmt.Sprintf("%s: not declared in %s", s, pkg.PkgPath)
+		if sim := similarSymbol(s, declared); sim != "" {
+			msg += fmt.Sprintf(" (did you mean %s?)", sim)
+		}
+		missing = append(missing, msg)
+	}
+	return missing
+}
+
+// similarSymbol returns the symbol in declared that is most similar to sym:
+// one that differs only in case, or is a method with the same name on
+// another type, or is within a small edit distance. It returns "" if there
+// is none.
+func similarSymbol(sym string, declared map[string]bool) string {
+	var names []string
+	for d := range declared {
+		names = append(names, d)
+	}
+	sort.Strings(names)
+
+	_, method, isMethod := strings.Cut(sym, ".")
+	best, bestDist := "", 3
+	for _, d := range names {
+		if strings.EqualFold(d, sym) {
+			return d
+		}
+		if _, m, ok := strings.Cut(d, "."); ok && isMethod && m == method {
+			return d
+		}
+		if dist := editDistance(d, sym); dist < bestDist {
+			best, bestDist = d, dist
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cur[j] = prev[j-1]
+			if a[i-1] != b[j-1] {
+				cur[j]++
+			}
+			if d := prev[j] + 1; d < cur[j] {
+				cur[j] = d
+			}
+			if d := cur[j-1] + 1; d < cur[j] {
+				cur[j] = d
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"path"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/tools/go/packages/packagestest"
+	"golang.org/x/vuln/vulncheck"
+)
+
+func TestMissingSymbols(t *testing.T) {
+	e := packagestest.Export(t, packagestest.Modules, []packagestest.Module{
+		{
+			Name: "example.com/m",
+			Files: map[string]interface{}{
+				"p/p.go": `
+					package p
+					type T struct{}
+					func (T) Get() {}
+					func (*T) Set() {}
+					type U struct{}
+					func (U) Parse() {}
+					type I interface{ Do() }
+					func ReadFile() {}
+				`,
+			},
+		},
+	})
+	defer e.Cleanup()
+
+	pkgs, err := loadPackage(e.Config, path.Join(e.Temp(), "m/p"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := missingSymbols(vulncheck.Convert(pkgs)[0], []string{
+		"T.Get", "T.Set", "I.Do", "ReadFile", // declared
+		"Readfile",  // different case
+		"T.Parse",   // method moved to another type
+		"ReadFiles", // small edit
+		"Nonexistent",
+	})
+	want := []string{
+		"Readfile: not declared in example.com/m/p (did you mean ReadFile?)",
+		"T.Parse: not declared in example.com/m/p (did you mean U.Parse?)",
+		"ReadFiles: not declared in example.com/m/p (did you mean ReadFile?)",
+		"Nonexistent: not declared in example.com/m/p",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+var cveRegexp = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+
+// createFromCVE writes a report skeleton for the CVE with the given ID, with
+// as much as possible filled in from the CVE: the module, guessed from its
+// references; version ranges, from the CPE matches of its NVD configurations
+// or else guessed from its description; and its GHSAs.
+// Since there is no issue for the report yet, the report is named after the
+// CVE; it should be renamed when the issue is filed.
+//
+// If cfg.excluded is set, it writes an excluded report instead, with only the
+// CVE, its GHSAs and the guessed module.
+func createFromCVE(ctx context.Context, id string, cfg *createCfg) (err error) {
+	defer derrors.Wrap(&err, "createFromCVE(%s)", id)
+
+	c, err := fetchCVE(ctx, id, cfg)
+	if err != nil {
+		return err
+	}
+	modulePath := guessModulePath(c)
+	r := report.CVEToReport(c, modulePath)
+	if cfg.excluded != "" {
+		r = excludedReport(cfg.excluded, modulePath, r.CVEs, nil)
+	} else if m := r.Modules[0]; modulePath == "" {
+		// CVEToReport treats an unknown module as a standard library package.
+		m.Module = todo
+		m.Packages = nil
+	} else {
+		// The NVD's CPE matches are more reliable than the description.
+		m.Versions = report.CPEVersionRanges(c, m.Module)
+		if len(m.Versions) == 0 && m.Module != stdlib.ModulePath {
+			fixed, err := report.GuessFixedVersions(r.Description, m.Module)
+			if err != nil {
+				return err
+			}
+			m.Versions = fixedVersionRanges(fixed)
+		}
+	}
+	if cfg.ghsaClient != nil {
+		sas, err := cfg.ghsaClient.ListForCVE(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, sa := range sas {
+			r.GHSAs = append(r.GHSAs, sa.ID)
+		}
+		slices.Sort(r.GHSAs)
+	}
+
+	addDraft(ctx, id, r, cfg)
+	addTODOs(r)
+	filename := fmt.Sprintf("data/%s/%s.yaml", reportDir(r), id)
+	if err := r.Write(filename); err != nil {
+		return err
+	}
+	fmt.Println(filename)
+	fmt.Print(xref(filename, r, cfg.existingByFile))
+	return nil
+}
+
+// fetchCVE returns the CVE with the given ID from the local cvelist repo, if
+// there is one, or else from the NVD, which is faster than cloning the repo.
+func fetchCVE(ctx context.Context, id string, cfg *createCfg) (*cveschema.CVE, error) {
+	if *localRepoPath != "" {
+		return cvelistrepo.FetchCVE(ctx, cfg.repoPath, id)
+	}
+	c, err := cfg.nvdClient.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%s not found in the NVD", id)
+	}
+	return c.ToCVE4(), nil
+}
+
+// repoHosts are the hosts whose repository paths, host/owner/repo, are
+// likely module paths.
+var repoHosts = map[string]bool{
+	"github.com":    true,
+	"gitlab.com":    true,
+	"bitbucket.org": true,
+}
+
+// guessModulePath returns the first path, derived from the reference URLs of
+// c, that is a standard library package or a module that the module proxy
+// knows about. It returns "" if there is none.
+func guessModulePath(c *cveschema.CVE) string {
+	for _, ref := range c.References.Data {
+		u, err := url.Parse(ref.URL)
+		if err != nil {
+			continue
+		}
+		for _, p := range candidatePaths(u) {
+			if stdlib.Contains(p) || report.ModuleExists(p) {
+				return p
+			}
+		}
+	}
+	return ""
+}
+
+// candidatePaths returns the module or package paths that u may refer to,
+// longest first.
+func candidatePaths(u *url.URL) []string {
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch {
+	case repoHosts[u.Host]:
+		if len(parts) < 2 {
+			return nil
+		}
+		return []string{path.Join(u.Host, parts[0], parts[1])}
+	case u.Host == "pkg.go.dev" || u.Host == "godoc.org":
+		var ps []string
+		for i := len(parts); i > 0; i-- {
+			ps = append(ps, path.Join(parts[:i]...))
+		}
+		return ps
+	case u.Host == "golang.org" && len(parts) > 1 && parts[0] == "pkg":
+		return []string{path.Join(parts[1:]...)}
+	}
+	return nil
+}
+
+// fixedVersionRanges returns version ranges with the given fixed versions.
+// Only the first range can be open below; the triager must fill in where the
+// others were introduced.
+func fixedVersionRanges(fixed []report.Version) []report.VersionRange {
+	fixed = slices.Clone(fixed)
+	slices.SortFunc(fixed, func(a, b report.Version) bool { return a.Before(b) })
+	var vrs []report.VersionRange
+	for i, v := range fixed {
+		vr := report.VersionRange{Fixed: v}
+		if i > 0 {
+			vr.Introduced = todo
+		}
+		vrs = append(vrs, vr)
+	}
+	return vrs
+}
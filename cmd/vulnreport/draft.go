@@ -0,0 +1,138 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// A drafter drafts the descriptions of new reports with a language model
+// served at a URL. It POSTs a JSON draftRequest for each report, and the
+// response is a JSON draftResponse.
+//
+// A draft is only a starting point. The description it writes starts with
+// report.DraftMarker, which lint reports, so a report with a draft can't be
+// committed until someone has reviewed the description and deleted the
+// marker.
+type drafter struct {
+	url    string
+	client *http.Client
+}
+
+// draftRequest is the body of a request to a drafter.
+type draftRequest struct {
+	ID         string   `json:"id"`
+	Aliases    []string `json:"aliases"`
+	Modules    []string `json:"modules"`
+	References []string `json:"references"`
+	// Text is the description of the CVE or GHSA that the report was
+	// created from.
+	Text string `json:"text"`
+}
+
+// draftResponse is the body of a response from a drafter.
+type draftResponse struct {
+	// Summary is a one-line description of the vulnerability and its
+	// impact, the first paragraph of the description.
+	Summary string `json:"summary"`
+	// Details are the rest of the description, if any.
+	Details string `json:"details"`
+}
+
+// drafterTimeout is the timeout of a request to a drafter. Models are slow.
+const drafterTimeout = time.Minute
+
+func newDrafter(rawURL string) (*drafter, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("draft URL %q is not an HTTP URL", rawURL)
+	}
+	return &drafter{url: rawURL, client: &http.Client{Timeout: drafterTimeout}}, nil
+}
+
+// draft returns a machine-drafted description for r, which is named id,
+// starting with report.DraftMarker.
+func (d *drafter) draft(ctx context.Context, id string, r *report.Report) (_ string, err error) {
+	defer derrors.Wrap(&err, "draft(%s)", id)
+
+	dreq := draftRequest{
+		ID:         id,
+		Aliases:    r.GetAliases(),
+		Modules:    []string{},
+		References: []string{},
+		Text:       r.Description,
+	}
+	if dreq.Aliases == nil {
+		dreq.Aliases = []string{}
+	}
+	for _, m := range r.Modules {
+		if m.Module != "" && m.Module != todo {
+			dreq.Modules = append(dreq.Modules, m.Module)
+		}
+	}
+	for _, ref := range r.References {
+		dreq.References = append(dreq.References, ref.URL)
+	}
+	body, err := json.Marshal(dreq)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned status %s", d.url, resp.Status)
+	}
+	var dresp draftResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dresp); err != nil {
+		return "", err
+	}
+	summary := strings.TrimSpace(dresp.Summary)
+	if summary == "" {
+		return "", errors.New("draft has no summary")
+	}
+	desc := report.DraftMarker + "\n\n" + summary
+	if details := strings.TrimSpace(dresp.Details); details != "" {
+		desc += "\n\n" + details
+	}
+	return desc, nil
+}
+
+// addDraft replaces the description of r, which is named id, with one from
+// cfg.drafter, if there is a drafter. Drafting is best effort: if it fails,
+// addDraft prints why and leaves the description alone.
+func addDraft(ctx context.Context, id string, r *report.Report, cfg *createCfg) {
+	if cfg.drafter == nil || r.Excluded != "" {
+		return
+	}
+	desc, err := cfg.drafter.draft(ctx, id, r)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: not drafting description: %v\n", id, err)
+		return
+	}
+	r.Description = desc
+}
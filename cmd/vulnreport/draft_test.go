@@ -0,0 +1,85 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/exp/slices"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestAddDraft(t *testing.T) {
+	ctx := context.Background()
+	var gotReq draftRequest
+	resp := draftResponse{Summary: "Panic in Parse.", Details: "Parse panics on long input."}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotReq); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer s.Close()
+
+	d, err := newDrafter(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &createCfg{drafter: d}
+	newReport := func() *report.Report {
+		return &report.Report{
+			Modules:     []*report.Module{{Module: "example.com/m"}},
+			Description: "A vulnerability in example.com/m.",
+			CVEs:        []string{"CVE-2022-0001"},
+			References:  []*report.Reference{{Type: report.ReferenceTypeFix, URL: "https://example.com/fix"}},
+		}
+	}
+
+	r := newReport()
+	addDraft(ctx, "GO-2022-0001", r, cfg)
+	wantReq := draftRequest{
+		ID:         "GO-2022-0001",
+		Aliases:    []string{"CVE-2022-0001"},
+		Modules:    []string{"example.com/m"},
+		References: []string{"https://example.com/fix"},
+		Text:       "A vulnerability in example.com/m.",
+	}
+	if diff := cmp.Diff(wantReq, gotReq); diff != "" {
+		t.Errorf("request mismatch (-want, +got):\n%s", diff)
+	}
+	want := report.DraftMarker + "\n\nPanic in Parse.\n\nParse panics on long input."
+	if r.Description != want {
+		t.Errorf("got description %q, want %q", r.Description, want)
+	}
+	// The draft must be reviewed before the report can be committed.
+	if lints := r.Lint("data/reports/GO-2022-0001.yaml"); !slices.Contains(lints, "description is machine-drafted and has not been reviewed") {
+		t.Errorf("got lints %v, want the draft reported", lints)
+	}
+
+	// A failed draft leaves the description alone.
+	resp = draftResponse{}
+	r = newReport()
+	addDraft(ctx, "GO-2022-0001", r, cfg)
+	if want := "A vulnerability in example.com/m."; r.Description != want {
+		t.Errorf("no summary: got description %q, want %q", r.Description, want)
+	}
+
+	// Excluded reports have no description to draft.
+	r = &report.Report{Excluded: "NOT_IMPORTABLE", CVEs: []string{"CVE-2022-0001"}}
+	addDraft(ctx, "GO-2022-0001", r, cfg)
+	if r.Description != "" {
+		t.Errorf("excluded: got description %q, want none", r.Description)
+	}
+
+	if _, err := newDrafter("model"); err == nil {
+		t.Error("newDrafter(model): got nil, want error")
+	}
+}
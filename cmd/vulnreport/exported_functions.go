@@ -30,11 +30,12 @@ type reportClient struct {
 // newReportClient creates a reportClient from a given report.
 func newReportClient(r *report.Report) *reportClient {
 	entries := map[string][]*osv.Entry{}
-	entry := database.GenerateOSVEntry("?", time.Time{}, r)
-	for _, m := range database.ModulesForEntry(entry) {
-		entries[m] = append(entries[m], &entry)
+	dbEntry := database.GenerateOSVEntry("?", time.Time{}, r)
+	entry := dbEntry.OSV()
+	for _, m := range database.ModulesForEntry(dbEntry) {
+		entries[m] = append(entries[m], entry)
 	}
-	return &reportClient{entry: &entry, entriesByModule: entries}
+	return &reportClient{entry: entry, entriesByModule: entries}
 }
 
 // GetByModule implements vdbclient.Client.GetByModule.
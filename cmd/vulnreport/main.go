@@ -13,7 +13,7 @@ import (
 	"flag"
 	"fmt"
 	"go/build"
-	"go/types"
+	"io"
 	"log"
 	"os"
 	"os/exec"
@@ -25,40 +25,68 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-git/go-git/v5"
 	"golang.org/x/exp/constraints"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"golang.org/x/tools/go/packages"
+	"golang.org/x/vuln/vulncheck"
+	"golang.org/x/vulndb/internal/cveclient"
 	"golang.org/x/vulndb/internal/cvelistrepo"
 	"golang.org/x/vulndb/internal/database"
 	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gerrit"
 	"golang.org/x/vulndb/internal/ghsa"
 	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/nvd"
 	"golang.org/x/vulndb/internal/report"
 	"golang.org/x/vulndb/internal/stdlib"
 )
 
 var (
-	localRepoPath = flag.String("local-cve-repo", "", "path to local repo, instead of cloning remote")
-	issueRepo     = flag.String("issue-repo", "github.com/golang/vulndb", "repo to create issues in")
-	githubToken   = flag.String("ghtoken", os.Getenv("VULN_GITHUB_ACCESS_TOKEN"), "GitHub access token")
-	skipSymbols   = flag.Bool("skip-symbols", false, "for lint and fix, don't load package for symbols checks")
-	alwaysFixGHSA = flag.Bool("always-fix-ghsa", false, "for fix, always update GHSAs")
+	localRepoPath  = flag.String("local-cve-repo", "", "path to local repo, instead of cloning remote")
+	issueRepo      = flag.String("issue-repo", "github.com/golang/vulndb", "repo to create issues in")
+	githubToken    = flag.String("ghtoken", os.Getenv("VULN_GITHUB_ACCESS_TOKEN"), "GitHub access token")
+	skipSymbols    = flag.Bool("skip-symbols", false, "for lint and fix, don't load package for symbols checks")
+	alwaysFixGHSA  = flag.Bool("always-fix-ghsa", false, "for fix, always update GHSAs")
+	dryRun         = flag.Bool("dry-run", false, "for reserve-cve and publish, show what would be done without changing anything")
+	resolveFixed   = flag.Bool("resolve-fixed", false, "for fix, fill in and check fixed versions using fix commits (clones repos)")
+	osvStdout      = flag.Bool("stdout", false, "for osv, print entries instead of writing them to data/osv")
+	lintJSON       = flag.Bool("json", false, "for lint, print the results for all files as JSON")
+	allowUnknown   = flag.Bool("allow-unknown-fields", false, "for lint, warn about unknown fields instead of failing, for reports written by a newer vulnreport")
+	storeProject   = flag.String("project", os.Getenv("GOOGLE_CLOUD_PROJECT"), "for xref and the review commands, project ID of the vuln worker's Firestore database")
+	storeNamespace = flag.String("namespace", os.Getenv("VULN_WORKER_NAMESPACE"), "for xref and the review commands, namespace of the vuln worker's Firestore database, such as prod")
+	nvdAPIKey      = flag.String("nvd-api-key", os.Getenv("VULN_NVD_API_KEY"), "for create, key for the NVD CVE API (optional)")
+	reviewAs       = flag.String("user", "", "for claim, review and approve, who is acting on the reports (default: git's user.email)")
+	draftURL       = flag.String("draft-url", os.Getenv("VULN_DRAFT_URL"), "for create, URL of a language model service that drafts descriptions, which must be reviewed before commit (optional)")
 )
 
 func main() {
 	ctx := context.Background()
 	flag.Usage = func() {
 		fmt.Fprintf(flag.CommandLine.Output(), "usage: vulnreport [cmd] [filename.yaml]\n")
-		fmt.Fprintf(flag.CommandLine.Output(), "  create [githubIssueNumber]: creates a new vulnerability YAML report\n")
-		fmt.Fprintf(flag.CommandLine.Output(), "  lint filename.yaml ...: lints vulnerability YAML reports\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  create [githubIssueNumber|CVE-ID]: creates a new vulnerability YAML report\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  create-excluded REASON [githubIssueNumber|CVE-ID]: creates a new excluded YAML report, for one of %v\n", report.ExcludedReasons)
+		fmt.Fprintf(flag.CommandLine.Output(), "  lint filename.yaml ...: lints vulnerability YAML reports (as JSON, with -json)\n")
 		fmt.Fprintf(flag.CommandLine.Output(), "  newcve filename.yaml ...: creates CVEs report from the provided YAML reports\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  cve filename.yaml ...: prints CVE JSON 5.0 records for YAML reports with cve_metadata\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  vex filename.yaml ...: prints OpenVEX documents for YAML reports\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  reserve-cve filename.yaml ...: reserves CVE IDs for YAML reports (needs %s and %s)\n", cveclient.EnvKey, cveclient.EnvUser)
+		fmt.Fprintf(flag.CommandLine.Output(), "  publish filename.yaml ...: publishes or updates the CVE records of YAML reports\n")
 		fmt.Fprintf(flag.CommandLine.Output(), "  fix filename.yaml ...: fixes and reformats YAML reports\n")
-		fmt.Fprintf(flag.CommandLine.Output(), "  osv filename.yaml ...: converts YAMLS reports to OSV JSON and writes to data/osv\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  migrate filename.yaml ...: upgrades YAML reports to the current schema version (%d)\n", report.CurrentSchemaVersion)
+		fmt.Fprintf(flag.CommandLine.Output(), "  osv filename.yaml ...: converts YAML reports to OSV JSON and writes to data/osv (or stdout, with -stdout)\n")
 		fmt.Fprintf(flag.CommandLine.Output(), "  set-dates filename.yaml ...: sets PublishDate of YAML reports\n")
-		fmt.Fprintf(flag.CommandLine.Output(), "  commit filename.yaml ...: creates new commits for YAML reports\n")
-		fmt.Fprintf(flag.CommandLine.Output(), "  xref filename.yaml ...: prints cross references for YAML reports\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  commit filename.yaml ...: lints YAML reports, regenerates their OSV entries, and commits them\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  xref [filename.yaml|module path] ...: prints cross references for YAML reports, or prior art for a module (open issues with -ghtoken, untriaged CVEs and GHSAs with -project and -namespace)\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  symbols filename.yaml ...: suggests symbols from the fix commits of YAML reports\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  std-symbols filename.yaml ...: fills in the packages and symbols of standard library YAML reports from their fix CLs (clones the Go repo)\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  std-versions filename.yaml ...: fills in the versions and fix CLs of standard library YAML reports from the CLs for their Go issues (clones the Go repo)\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  claim [filename.yaml|report ID] ...: assigns reports, which need not exist yet, to -user as drafts (needs -project and -namespace)\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  review filename.yaml ...: sends drafts assigned to -user for review\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  approve filename.yaml ...: approves reports in review, with -user as the reviewer\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "A filename can also be given as a report ID, like GO-2022-0001, or an issue number.\n")
 		flag.PrintDefaults()
 	}
 
@@ -71,33 +99,119 @@ func main() {
 	cmd := flag.Arg(0)
 	args := flag.Args()[1:]
 
-	// Create operates on github issue IDs instead of filenames, so it is
-	// separated from the other commands.
-	if cmd == "create" {
-		githubIDs, cfg, err := setupCreate(args)
+	// Create operates on github issue IDs and CVE IDs instead of filenames,
+	// so it is separated from the other commands. Create-excluded takes the
+	// reason for excluding them first.
+	if cmd == "create" || cmd == "create-excluded" {
+		var excluded report.ExcludedReason
+		if cmd == "create-excluded" {
+			excluded = report.ExcludedReason(args[0])
+			if !excluded.IsValid() {
+				log.Fatalf("excluded reason %q is not in set %v", excluded, report.ExcludedReasons)
+			}
+			args = args[1:]
+			if len(args) == 0 {
+				flag.Usage()
+				log.Fatal("not enough arguments")
+			}
+		}
+		githubIDs, cveIDs, cfg, err := setupCreate(ctx, args)
 		if err != nil {
 			log.Fatal(err)
 		}
+		cfg.excluded = excluded
 		for _, githubID := range githubIDs {
 			if err := create(ctx, githubID, cfg); err != nil {
 				fmt.Printf("skipped: %s\n", err)
 			}
 		}
+		for _, cveID := range cveIDs {
+			if err := createFromCVE(ctx, cveID, cfg); err != nil {
+				fmt.Printf("skipped: %s\n", err)
+			}
+		}
+		return
+	}
+
+	// With -json, lint checks every file before failing, so that CI can
+	// show all the problems at once.
+	if cmd == "lint" && *lintJSON {
+		ok, err := lintAll(args, os.Stdout)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Xref takes module paths as well as reports.
+	if cmd == "xref" {
+		if err := xrefCmd(ctx, args); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// The review commands take the IDs of reports that may not exist yet.
+	if cmd == "claim" || cmd == "review" || cmd == "approve" {
+		if err := reviewCmd(ctx, cmd, args); err != nil {
+			log.Fatal(err)
+		}
 		return
 	}
 
+	// A nil client makes fix and commit leave the GHSAs of reports alone.
+	var ghsaClient *ghsa.Client
+	if *githubToken != "" {
+		ghsaClient = ghsa.NewClient(ctx, *githubToken, nil)
+	}
 	var cmdFunc func(string) error
 	switch cmd {
 	case "lint":
 		cmdFunc = lint
 	case "commit":
-		cmdFunc = func(name string) error { return commit(ctx, name, *githubToken) }
+		cmdFunc = func(name string) error { return commit(ctx, name, ghsaClient) }
 	case "newcve":
 		cmdFunc = newCVE
+	case "cve":
+		cmdFunc = cve5
+	case "vex":
+		cmdFunc = vexCmd
+	case "reserve-cve", "publish":
+		c, err := newCVEClient()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if cmd == "publish" {
+			cmdFunc = func(name string) error { return publishCVE(c, name) }
+		} else {
+			cmdFunc = func(name string) error { return reserveCVE(c, name) }
+		}
 	case "fix":
-		cmdFunc = func(name string) error { return fix(ctx, name, *githubToken) }
+		cmdFunc = func(name string) error { return fix(ctx, name, ghsaClient) }
+	case "migrate":
+		cmdFunc = migrate
 	case "osv":
 		cmdFunc = osvCmd
+	case "symbols":
+		cmdFunc = func(name string) error { return suggestSymbols(ctx, name, os.Stdout) }
+	case "std-symbols", "std-versions":
+		gc := gerrit.NewClient(gerrit.DefaultBaseURL)
+		// Clone the Go repo once, for all the reports.
+		var goRepo *git.Repository
+		openGoRepo := func() (_ *git.Repository, err error) {
+			if goRepo == nil {
+				goRepo, err = gitrepo.CloneWithHistory(ctx, goRepoURL)
+			}
+			return goRepo, err
+		}
+		if cmd == "std-symbols" {
+			cmdFunc = func(name string) error { return stdSymbols(ctx, name, gc, openGoRepo, os.Stdout) }
+		} else {
+			cmdFunc = func(name string) error { return stdVersions(ctx, name, gc, openGoRepo, os.Stdout) }
+		}
 	case "set-dates":
 		repo, err := gitrepo.Open(ctx, ".")
 		if err != nil {
@@ -108,20 +222,6 @@ func main() {
 			log.Fatal(err)
 		}
 		cmdFunc = func(name string) error { return setDates(name, commitDates) }
-	case "xref":
-		_, existingByFile, err := existingReports()
-		if err != nil {
-			log.Fatal(err)
-		}
-		cmdFunc = func(name string) error {
-			r, err := report.Read(name)
-			if err != nil {
-				return err
-			}
-			fmt.Println(name)
-			fmt.Print(xref(name, r, existingByFile))
-			return nil
-		}
 	default:
 		flag.Usage()
 		log.Fatalf("unsupported command: %q", cmd)
@@ -139,10 +239,20 @@ func main() {
 	}
 }
 
+// reportIDRegexp matches the ID of a report, like GO-2022-0001.
+var reportIDRegexp = regexp.MustCompile(`^GO-\d\d\d\d-\d+$`)
+
 func argToFilename(arg string) (string, error) {
 	if _, err := os.Stat(arg); err != nil {
-		// If arg isn't a file, see if it might be an issue ID
-		// with an existing report.
+		// If arg isn't a file, see if it might be a report ID
+		// or an issue ID with an existing report.
+		if reportIDRegexp.MatchString(arg) {
+			m, _ := filepath.Glob("data/*/" + arg + ".yaml")
+			if len(m) == 1 {
+				return m[0], nil
+			}
+			return "", fmt.Errorf("no report with ID %s", arg)
+		}
 		for _, padding := range []string{"", "0", "00", "000"} {
 			m, _ := filepath.Glob("data/*/GO-*-" + padding + arg + ".yaml")
 			if len(m) == 1 {
@@ -231,29 +341,47 @@ func parseArgsToGithubIDs(args []string, existingByIssue map[int]*report.Report)
 }
 
 type createCfg struct {
-	ghToken        string
+	ghsaClient     *ghsa.Client
 	repoPath       string
 	issuesClient   issues.Client
+	nvdClient      *nvd.Client
 	existingByFile map[string]*report.Report
+	// excluded, if set, makes create write excluded reports with this
+	// reason, whatever the labels of their issues.
+	excluded report.ExcludedReason
+	// drafter, if set, drafts the descriptions of the reports.
+	drafter *drafter
 }
 
-func setupCreate(args []string) ([]int, *createCfg, error) {
-	if *githubToken == "" {
-		return nil, nil, fmt.Errorf("githubToken must be provided")
+// setupCreate returns the GitHub issue numbers and the CVE IDs in args, and
+// the configuration for creating reports from them. Creating reports from
+// issues needs a GitHub token; creating them from CVEs only uses it, if set,
+// to find GHSAs.
+func setupCreate(ctx context.Context, args []string) ([]int, []string, *createCfg, error) {
+	var cveIDs, issueArgs []string
+	for _, arg := range args {
+		if cveRegexp.MatchString(arg) {
+			cveIDs = append(cveIDs, arg)
+		} else {
+			issueArgs = append(issueArgs, arg)
+		}
+	}
+	if len(issueArgs) > 0 && *githubToken == "" {
+		return nil, nil, nil, fmt.Errorf("githubToken must be provided")
 	}
 	existingByIssue, existingByFile, err := existingReports()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	githubIDs, err := parseArgsToGithubIDs(args, existingByIssue)
+	githubIDs, err := parseArgsToGithubIDs(issueArgs, existingByIssue)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if len(githubIDs) > 1 && *localRepoPath == "" {
 		// Maybe we should automatically maintain a local clone of the
 		// cvelist repo, but for now we can avoid repeatedly fetching it
 		// when iterating over a list of reports.
-		return nil, nil, fmt.Errorf("git clone %v to a local directory, and set -local-cve-repo to that path", cvelistrepo.URL)
+		return nil, nil, nil, fmt.Errorf("git clone %v to a local directory, and set -local-cve-repo to that path", cvelistrepo.URL)
 	}
 	repoPath := cvelistrepo.URL
 	if *localRepoPath != "" {
@@ -261,14 +389,24 @@ func setupCreate(args []string) ([]int, *createCfg, error) {
 	}
 	owner, repoName, err := gitrepo.ParseGitHubRepo(*issueRepo)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	return githubIDs, &createCfg{
-		ghToken:        *githubToken,
+	cfg := &createCfg{
 		repoPath:       repoPath,
 		issuesClient:   issues.NewGitHubClient(owner, repoName, *githubToken),
+		nvdClient:      nvd.NewClient(nvd.DefaultBaseURL, *nvdAPIKey),
 		existingByFile: existingByFile,
-	}, nil
+	}
+	if *githubToken != "" {
+		cfg.ghsaClient = ghsa.NewClient(ctx, *githubToken, nil)
+	}
+	if *draftURL != "" {
+		cfg.drafter, err = newDrafter(*draftURL)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return githubIDs, cveIDs, cfg, nil
 }
 
 func create(ctx context.Context, issueNumber int, cfg *createCfg) (err error) {
@@ -282,9 +420,15 @@ func create(ctx context.Context, issueNumber int, cfg *createCfg) (err error) {
 	if err != nil {
 		return err
 	}
+	if cfg.excluded != "" {
+		if parsed.excluded != "" && parsed.excluded != cfg.excluded {
+			return fmt.Errorf("issue is labeled excluded: %s, not %s", parsed.excluded, cfg.excluded)
+		}
+		parsed.excluded = cfg.excluded
+	}
 	if len(parsed.ghsas) == 0 && len(parsed.cves) > 0 {
 		for _, cve := range parsed.cves {
-			sas, err := ghsa.ListForCVE(ctx, cfg.ghToken, cve)
+			sas, err := cfg.ghsaClient.ListForCVE(ctx, cve)
 			if err != nil {
 				return err
 			}
@@ -302,28 +446,17 @@ func create(ctx context.Context, issueNumber int, cfg *createCfg) (err error) {
 	}
 
 	if parsed.excluded != "" {
-		r = &report.Report{
-			Modules: []*report.Module{
-				{
-					Module: parsed.modulePath,
-				},
-			},
-			Excluded: parsed.excluded,
-			CVEs:     r.CVEs,
-			GHSAs:    r.GHSAs,
-		}
+		r = excludedReport(parsed.excluded, parsed.modulePath, r.CVEs, r.GHSAs)
 	}
 
-	addTODOs(r)
 	var year int
 	if !iss.CreatedAt.IsZero() {
 		year = iss.CreatedAt.Year()
 	}
-	dir := "reports"
-	if parsed.excluded != "" {
-		dir = "excluded"
-	}
-	filename := fmt.Sprintf("data/%s/GO-%04d-%04d.yaml", dir, year, issueNumber)
+	id := fmt.Sprintf("GO-%04d-%04d", year, issueNumber)
+	addDraft(ctx, id, r, cfg)
+	addTODOs(r)
+	filename := fmt.Sprintf("data/%s/%s.yaml", reportDir(r), id)
 	if err := r.Write(filename); err != nil {
 		return err
 	}
@@ -332,11 +465,34 @@ func create(ctx context.Context, issueNumber int, cfg *createCfg) (err error) {
 	return nil
 }
 
+// excludedReport returns a report that records the CVEs and GHSAs as excluded
+// for the given reason. Excluded reports have no other fields, except the
+// module path if it is known.
+func excludedReport(reason report.ExcludedReason, modulePath string, cves, ghsas []string) *report.Report {
+	r := &report.Report{
+		Excluded: reason,
+		CVEs:     cves,
+		GHSAs:    ghsas,
+	}
+	if modulePath != "" {
+		r.Modules = []*report.Module{{Module: modulePath}}
+	}
+	return r
+}
+
+// reportDir returns the directory of data/ that r belongs in.
+func reportDir(r *report.Report) string {
+	if r.Excluded != "" {
+		return "excluded"
+	}
+	return "reports"
+}
+
 func newReport(ctx context.Context, cfg *createCfg, parsed *parsedIssue) (*report.Report, error) {
 	var r *report.Report
 	switch {
 	case len(parsed.ghsas) > 0:
-		ghsa, err := ghsa.FetchGHSA(ctx, cfg.ghToken, parsed.ghsas[0])
+		ghsa, err := cfg.ghsaClient.FetchGHSA(ctx, parsed.ghsas[0])
 		if err != nil {
 			return nil, err
 		}
@@ -506,8 +662,8 @@ func addTODOs(r *report.Report) {
 	if r.Description == "" {
 		r.Description = todo
 	}
-	if r.Credit == "" {
-		r.Credit = todo
+	if len(r.Credits) == 0 {
+		r.Credits = []*report.Credit{{Name: todo}}
 	}
 	if len(r.CVEs) == 0 {
 		r.CVEs = []string{todo}
@@ -523,10 +679,13 @@ func addTODOs(r *report.Report) {
 
 func lint(filename string) (err error) {
 	defer derrors.Wrap(&err, "lint(%q)", filename)
-	r, err := report.Read(filename)
+	r, warnings, err := readForLint(filename)
 	if err != nil {
 		return err
 	}
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "%s: warning: %s\n", filename, w)
+	}
 
 	if lints := r.Lint(filename); len(lints) > 0 {
 		return fmt.Errorf("lint returned errors:\n\t %s", strings.Join(lints, "\n\t"))
@@ -534,7 +693,64 @@ func lint(filename string) (err error) {
 	return nil
 }
 
-func fix(ctx context.Context, filename string, accessToken string) (err error) {
+// A lintResult is the result of linting one file, for the -json flag.
+type lintResult struct {
+	Filename string
+	// Error is set if the file could not be linted.
+	Error  string   `json:",omitempty"`
+	Issues []string `json:",omitempty"`
+	// Warnings are the unknown fields of the file, with
+	// -allow-unknown-fields.
+	Warnings []string `json:",omitempty"`
+}
+
+// readForLint reads a report to lint. With -allow-unknown-fields, it returns
+// the unknown fields of the report as warnings instead of failing.
+func readForLint(filename string) (_ *report.Report, warnings []string, err error) {
+	if *allowUnknown {
+		return report.ReadLenient(filename)
+	}
+	r, err := report.Read(filename)
+	return r, nil, err
+}
+
+// lintAll lints the files named by args and writes the results to w as a JSON
+// array of lintResults. It reports whether all the files are free of issues.
+func lintAll(args []string, w io.Writer) (ok bool, err error) {
+	ok = true
+	results := []*lintResult{}
+	for _, arg := range args {
+		res := &lintResult{Filename: arg}
+		results = append(results, res)
+		filename, err := argToFilename(arg)
+		if err != nil {
+			res.Error = err.Error()
+			ok = false
+			continue
+		}
+		res.Filename = filename
+		r, warnings, err := readForLint(filename)
+		if err != nil {
+			res.Error = err.Error()
+			ok = false
+			continue
+		}
+		res.Warnings = warnings
+		res.Issues = r.Lint(filename)
+		if len(res.Issues) > 0 {
+			ok = false
+		}
+	}
+	e := json.NewEncoder(w)
+	e.SetEscapeHTML(false)
+	e.SetIndent("", "\t")
+	if err := e.Encode(results); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+func fix(ctx context.Context, filename string, gc *ghsa.Client) (err error) {
 	defer derrors.Wrap(&err, "fix(%q)", filename)
 	r, err := report.Read(filename)
 	if err != nil {
@@ -548,9 +764,18 @@ func fix(ctx context.Context, filename string, accessToken string) (err error) {
 			return err
 		}
 	}
-	if err := fixGHSAs(ctx, r, accessToken); err != nil {
+	if err := fixGHSAs(ctx, r, gc); err != nil {
 		return err
 	}
+	if *resolveFixed {
+		mismatches, err := r.ResolveFixedVersions(ctx, gitrepo.CloneWithHistory)
+		if err != nil {
+			return err
+		}
+		for _, m := range mismatches {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", filename, m)
+		}
+	}
 	// Write unconditionally in order to format.
 	if err := r.Write(filename); err != nil {
 		return err
@@ -639,26 +864,11 @@ func findExportedSymbols(m *report.Module, p *report.Package, c *reportClient) (
 		}
 	}
 
-	// Check to see that all symbols actually exist in the package.
-	// This should perhaps be a lint check, but lint doesn't
-	// load/typecheck packages at the moment, so do it here for now.
-	for _, sym := range p.Symbols {
-		if typ, method, ok := strings.Cut(sym, "."); ok {
-			n, ok := pkgs[0].Types.Scope().Lookup(typ).(*types.TypeName)
-			if !ok {
-				fmt.Fprintf(os.Stderr, "%v: type not found\n", typ)
-				continue
-			}
-			m, _, _ := types.LookupFieldOrMethod(n.Type(), true, pkgs[0].Types, method)
-			if m == nil {
-				fmt.Fprintf(os.Stderr, "%v: method not found\n", sym)
-			}
-		} else {
-			_, ok := pkgs[0].Types.Scope().Lookup(typ).(*types.Func)
-			if !ok {
-				fmt.Fprintf(os.Stderr, "%v: func not found\n", typ)
-			}
-		}
+	// Check that all symbols actually exist in the package at the vulnerable
+	// version, so that a typo or a rename doesn't silently make the report
+	// miss vulnerable code.
+	if missing := missingSymbols(vulncheck.Convert(pkgs)[0], p.Symbols); len(missing) > 0 {
+		return nil, fmt.Errorf("symbols not found at %s:\n\t%s", m.VulnerableAt, strings.Join(missing, "\n\t"))
 	}
 
 	newsyms, err := exportedFunctions(pkgs, c)
@@ -693,6 +903,16 @@ func osvCmd(filename string) (err error) {
 	if !checkLint(r, filename) {
 		return nil
 	}
+	if r.Excluded != "" {
+		return errors.New("excluded reports have no OSV entry")
+	}
+	if *osvStdout {
+		entry := database.GenerateOSVEntry(filename, time.Time{}, r)
+		e := json.NewEncoder(os.Stdout)
+		e.SetEscapeHTML(false)
+		e.SetIndent("", "  ")
+		return e.Encode(entry)
+	}
 	osvFilename, err := writeOSV(r, filename)
 	if err != nil {
 		return err
@@ -713,26 +933,64 @@ func writeOSV(r *report.Report, filename string) (string, error) {
 	return "", nil
 }
 
+// writeCVE5 writes the CVE JSON 5.0 record of the report r in filename to
+// data/cve/v5, if the Go CNA issues its CVE, and returns the name of the file.
+func writeCVE5(r *report.Report, filename string) (string, error) {
+	if r.CVEMetadata == nil {
+		return "", nil
+	}
+	record, err := r.ToCVE5()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll("data/cve/v5", 0755); err != nil {
+		return "", err
+	}
+	id := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	cveFilename := fmt.Sprintf("data/cve/v5/%v.json", id)
+	if err := database.WriteJSON(cveFilename, record, true); err != nil {
+		return "", err
+	}
+	return cveFilename, nil
+}
+
 var reportRegexp = regexp.MustCompile(`^(data/\w+)/GO-\d\d\d\d-(\d+)\.yaml$`)
 
-func commit(ctx context.Context, filename, accessToken string) (err error) {
+// commit prepares a commit of the report in filename: it fixes and lints
+// the report, regenerates its OSV entry, and its CVE record if the Go CNA
+// issues its CVE, stages the files, and commits them with a standard
+// message, which the user can edit.
+func commit(ctx context.Context, filename string, gc *ghsa.Client) (err error) {
 	defer derrors.Wrap(&err, "commit(%q)", filename)
-	m := reportRegexp.FindStringSubmatch(filename)
-	if len(m) != 3 {
+	if !reportRegexp.MatchString(filename) {
 		return fmt.Errorf("%v: not a report filename", filename)
 	}
-	issueID := m[2]
 
 	// Ignore errors. If anything is really wrong with the report, we'll
 	// detect it on re-linting below.
-	_ = fix(ctx, filename, accessToken)
+	_ = fix(ctx, filename, gc)
 
 	r, err := report.Read(filename)
 	if err != nil {
 		return err
 	}
 	if !checkLint(r, filename) {
-		return nil
+		return errors.New("fix the lint warnings and try again")
+	}
+	files := []string{filename}
+	osvFilename, err := writeOSV(r, filename)
+	if err != nil {
+		return err
+	}
+	if osvFilename != "" {
+		files = append(files, osvFilename)
+	}
+	cveFilename, err := writeCVE5(r, filename)
+	if err != nil {
+		return err
+	}
+	if cveFilename != "" {
+		files = append(files, cveFilename)
 	}
 
 	// Exec the git command rather than using go-git so as to run commit hooks
@@ -744,47 +1002,53 @@ func commit(ctx context.Context, filename, accessToken string) (err error) {
 		cmd.Stderr = os.Stderr
 		return cmd.Run()
 	}
-	if err := irun("git", "add", filename); err != nil {
-		fmt.Fprintf(os.Stderr, "git add: %v\n", err)
-		return nil
+	if err := irun("git", append([]string{"add"}, files...)...); err != nil {
+		return fmt.Errorf("git add: %v", err)
 	}
-	var osvfilename string
-	if r.Excluded == "" {
-		osvfilename = "data/osv/" + strings.TrimSuffix(filepath.Base(filename), ".yaml") + ".json"
-		if err := irun("git", "add", osvfilename); err != nil {
-			fmt.Fprintf(os.Stderr, "git add %v: %v\n", osvfilename, err)
-			return nil
-		}
+	// The report is new unless HEAD has it.
+	isNew := exec.Command("git", "cat-file", "-e", "HEAD:"+filename).Run() != nil
+	msg, err := commitMessage(filename, r, isNew)
+	if err != nil {
+		return err
 	}
-
-	var externalAdvisories string
-	action := "Fixes"
-	switch {
-	case r.CVEMetadata != nil:
-		action = "Updates"
-		externalAdvisories = r.CVEMetadata.ID
-	case len(r.CVEs) > 0:
-		externalAdvisories = strings.Join(r.CVEs, ", ")
-	case len(r.GHSAs) > 0:
-		externalAdvisories = strings.Join(r.GHSAs, ", ")
-	default:
-		externalAdvisories = "[no CVE or GHSA]"
+	if err := irun("git", append([]string{"commit", "-m", msg, "-e"}, files...)...); err != nil {
+		return fmt.Errorf("git commit: %v", err)
 	}
+	return nil
+}
 
-	folder := m[1]
-	msg := fmt.Sprintf("%s: add %s for %s\n\n%s golang/vulndb#%s\n",
-		folder,
-		strings.TrimPrefix(filename, fmt.Sprintf("%s/", folder)), externalAdvisories, action, strings.TrimPrefix(issueID, "0"))
-	args := []string{"commit", "-m", msg, "-e", filename}
-	if osvfilename != "" {
-		args = append(args, osvfilename)
+// commitMessage returns the message for a commit that adds the report r in
+// filename, or updates it if it is not new. For example:
+//
+//	data/reports: add GO-2022-0001
+//
+//	Aliases: CVE-2022-1234, GHSA-aaaa-bbbb-cccc
+//
+//	Fixes golang/vulndb#1
+//
+// A commit of a report whose CVE the Go CNA issues, or an update of a
+// report, only updates the issue, which stays open until the CVE is
+// published or the update is reviewed.
+func commitMessage(filename string, r *report.Report, isNew bool) (string, error) {
+	m := reportRegexp.FindStringSubmatch(filename)
+	if len(m) != 3 {
+		return "", fmt.Errorf("%v: not a report filename", filename)
 	}
-	if err := irun("git", args...); err != nil {
-		fmt.Fprintf(os.Stderr, "git commit: %v\n", err)
-		return nil
+	folder, issueID := m[1], strings.TrimLeft(m[2], "0")
+	verb, action := "add", "Fixes"
+	if !isNew {
+		verb, action = "update", "Updates"
 	}
-
-	return nil
+	if r.CVEMetadata != nil {
+		action = "Updates"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s %s\n\n", folder, verb, strings.TrimSuffix(filepath.Base(filename), ".yaml"))
+	if aliases := r.GetAliases(); len(aliases) > 0 {
+		fmt.Fprintf(&b, "Aliases: %s\n\n", strings.Join(aliases, ", "))
+	}
+	fmt.Fprintf(&b, "%s golang/vulndb#%s\n", action, issueID)
+	return b.String(), nil
 }
 
 func checkLint(r *report.Report, filename string) bool {
@@ -919,22 +1183,56 @@ func newCVE(filename string) (err error) {
 	if err != nil {
 		return err
 	}
+	return printJSON(cve)
+}
+
+// cve5 prints the CVE JSON 5.0 record, for publication by the Go CNA, of the
+// report in filename.
+func cve5(filename string) (err error) {
+	defer derrors.Wrap(&err, "cve5(%q)", filename)
+	cve, err := report.ToCVE5(filename)
+	if err != nil {
+		return err
+	}
+	return printJSON(cve)
+}
+
+func vexCmd(filename string) (err error) {
+	defer derrors.Wrap(&err, "vex(%q)", filename)
+	doc, err := report.ToVEX(filename)
+	if err != nil {
+		return err
+	}
+	return printJSON(doc)
+}
+
+func migrate(filename string) error {
+	changed, err := report.Migrate(filename)
+	if err != nil {
+		return err
+	}
+	if changed {
+		fmt.Printf("migrated %s\n", filename)
+	}
+	return nil
+}
 
+func printJSON(v any) error {
 	// We need to use an encoder so that it doesn't escape angle
 	// brackets.
 	e := json.NewEncoder(os.Stdout)
 	e.SetEscapeHTML(false)
 	e.SetIndent("", "\t")
-	return e.Encode(cve)
+	return e.Encode(v)
 }
 
 // loadGHSAsByCVE returns a map from CVE ID to GHSA IDs.
 // It does this by using the GitHub API to list all Go security
 // advisories.
-func loadGHSAsByCVE(ctx context.Context, accessToken string) (_ map[string][]string, err error) {
+func loadGHSAsByCVE(ctx context.Context, gc *ghsa.Client) (_ map[string][]string, err error) {
 	defer derrors.Wrap(&err, "loadGHSAsByCVE")
 
-	sas, err := ghsa.List(ctx, accessToken, time.Time{})
+	sas, err := gc.List(ctx, time.Time{})
 	if err != nil {
 		return nil, err
 	}
@@ -951,8 +1249,8 @@ func loadGHSAsByCVE(ctx context.Context, accessToken string) (_ map[string][]str
 
 // fixGHSAs replaces r.GHSAs with a sorted list of GitHub Security
 // Advisory IDs that correspond to the CVEs.
-func fixGHSAs(ctx context.Context, r *report.Report, accessToken string) error {
-	if accessToken == "" {
+func fixGHSAs(ctx context.Context, r *report.Report, gc *ghsa.Client) error {
+	if gc == nil {
 		return nil
 	}
 	if len(r.GHSAs) > 0 && !*alwaysFixGHSA {
@@ -960,7 +1258,7 @@ func fixGHSAs(ctx context.Context, r *report.Report, accessToken string) error {
 	}
 	m := map[string]struct{}{}
 	for _, cid := range r.CVEs {
-		sas, err := ghsa.ListForCVE(ctx, accessToken, cid)
+		sas, err := gc.ListForCVE(ctx, cid)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,230 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveclient"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/symbols"
+)
+
+func TestLintAll(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "reports")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "GO-2022-0001.yaml")
+	// A standard library report, so that linting does not use the proxy.
+	if err := os.WriteFile(filename, []byte(`modules:
+  - module: std
+    packages:
+      - package: time
+cves:
+  - CVE-bad
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "missing.yaml")
+
+	var buf bytes.Buffer
+	ok, err := lintAll([]string{filename, missing}, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Error("got ok, want issues")
+	}
+	var got []*lintResult
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d results, want 2:\n%s", len(got), buf.String())
+	}
+	if got[0].Filename != filename || len(got[0].Issues) == 0 || got[0].Error != "" {
+		t.Errorf("got %+v, want issues for %s", got[0], filename)
+	}
+	want := &lintResult{
+		Filename: missing,
+		Error:    missing + " is not a valid filename or issue ID with existing report",
+	}
+	if diff := cmp.Diff(want, got[1]); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestCandidatePaths(t *testing.T) {
+	for _, test := range []struct {
+		url  string
+		want []string
+	}{
+		{"https://github.com/owner/repo/commit/abc", []string{"github.com/owner/repo"}},
+		{"https://github.com/owner", nil},
+		{"https://pkg.go.dev/example.com/m/pkg", []string{"example.com/m/pkg", "example.com/m", "example.com"}},
+		{"https://golang.org/pkg/net/http/", []string{"net/http"}},
+		{"https://example.com/advisory", nil},
+	} {
+		u, err := url.Parse(test.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.want, candidatePaths(u)); diff != "" {
+			t.Errorf("%s: mismatch (-want, +got):\n%s", test.url, diff)
+		}
+	}
+}
+
+func TestFixedVersionRanges(t *testing.T) {
+	got := fixedVersionRanges([]report.Version{"1.3.1", "1.2.5"})
+	want := []report.VersionRange{
+		{Fixed: "1.2.5"},
+		{Introduced: todo, Fixed: "1.3.1"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestPackageSymbols(t *testing.T) {
+	changed := map[string][]string{
+		".":       {"F"},
+		"sub/p":   {"G"},
+		"sub":     {"H"},
+		"other/q": {"I"},
+	}
+	got := packageSymbols("example.com/m/sub", "sub", changed)
+	want := []pkgSymbols{
+		{"example.com/m/sub", []string{"H"}},
+		{"example.com/m/sub/p", []string{"G"}},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(pkgSymbols{})); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestAddStdlibPackages(t *testing.T) {
+	r := &report.Report{
+		Modules: []*report.Module{{
+			Module:       "std",
+			VulnerableAt: todo,
+			Packages: []*report.Package{
+				{Package: todo, Symbols: []string{todo}},
+				{Package: "net/url", Symbols: []string{"Parse"}},
+			},
+		}},
+	}
+	addStdlibPackages(r, &symbols.StdlibFix{
+		Before: "go1.19.1",
+		After:  "go1.19.2",
+		Packages: []*symbols.StdlibPackage{
+			{Module: "cmd", Package: "cmd/go", Symbols: []string{"main"}},
+			{Module: "std", Package: "net/http", Symbols: []string{"Get"}, Added: []string{"sanitize"}},
+			{Module: "std", Package: "net/url", Symbols: []string{"URL.String"}},
+			{Module: "std", Package: "internal/x", Added: []string{"X"}},
+		},
+	})
+	want := &report.Report{
+		Modules: []*report.Module{
+			{
+				Module:       "std",
+				VulnerableAt: "1.19.1",
+				Packages: []*report.Package{
+					{Package: "net/url", Symbols: []string{"Parse"}},
+					{Package: "net/http", Symbols: []string{"Get"}},
+				},
+			},
+			{
+				Module:       "cmd",
+				VulnerableAt: "1.19.1",
+				Packages:     []*report.Package{{Package: "cmd/go", Symbols: []string{"main"}}},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestReserveCVE(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/cve-id" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		fmt.Fprint(w, `{"cve_ids": [{"cve_id": "CVE-2022-1234", "state": "RESERVED"}]}`)
+	}))
+	defer srv.Close()
+	c := cveclient.New(cveclient.Config{Endpoint: srv.URL, Org: "Go", Key: "k", User: "u"})
+
+	filename := filepath.Join(t.TempDir(), "GO-2022-0001.yaml")
+	r := &report.Report{Modules: []*report.Module{{Module: "std"}}}
+	if err := r.Write(filename); err != nil {
+		t.Fatal(err)
+	}
+	if err := reserveCVE(c, filename); err != nil {
+		t.Fatal(err)
+	}
+	got, err := report.Read(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &report.CVEMeta{ID: "CVE-2022-1234", CWE: todo, Description: todo}
+	if diff := cmp.Diff(want, got.CVEMetadata); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	// A second reservation would be a mistake.
+	if err := reserveCVE(c, filename); err == nil {
+		t.Error("got nil error reserving again, want error")
+	}
+}
+
+func TestCommitMessage(t *testing.T) {
+	for _, test := range []struct {
+		filename string
+		r        *report.Report
+		isNew    bool
+		want     string
+	}{
+		{
+			"data/reports/GO-2022-0012.yaml",
+			&report.Report{CVEs: []string{"CVE-2022-1234"}, GHSAs: []string{"GHSA-aaaa-bbbb-cccc"}},
+			true,
+			"data/reports: add GO-2022-0012\n\nAliases: CVE-2022-1234, GHSA-aaaa-bbbb-cccc\n\nFixes golang/vulndb#12\n",
+		},
+		{
+			"data/reports/GO-2022-0100.yaml",
+			&report.Report{CVEMetadata: &report.CVEMeta{ID: "CVE-2022-5678"}},
+			true,
+			"data/reports: add GO-2022-0100\n\nAliases: CVE-2022-5678\n\nUpdates golang/vulndb#100\n",
+		},
+		{
+			"data/excluded/GO-2022-1000.yaml",
+			&report.Report{Excluded: "NOT_GO_CODE"},
+			false,
+			"data/excluded: update GO-2022-1000\n\nUpdates golang/vulndb#1000\n",
+		},
+	} {
+		got, err := commitMessage(test.filename, test.r, test.isNew)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", test.filename, got, test.want)
+		}
+	}
+	if _, err := commitMessage("GO-2022-0001.yaml", &report.Report{}, true); err == nil {
+		t.Error("got nil error for a file outside data, want error")
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/vulndb/internal/cveclient"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// newCVEClient returns a client for the CVE Services API with the
+// credentials in the environment.
+func newCVEClient() (*cveclient.Client, error) {
+	cfg, err := cveclient.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	cfg.DryRun = *dryRun
+	return cveclient.New(cfg), nil
+}
+
+// reserveCVE reserves a CVE ID for the report in filename, which the Go CNA
+// will publish, and writes it to the report's cve_metadata.
+func reserveCVE(c *cveclient.Client, filename string) (err error) {
+	defer derrors.Wrap(&err, "reserveCVE(%q)", filename)
+
+	r, err := report.Read(filename)
+	if err != nil {
+		return err
+	}
+	if len(r.CVEs) > 0 {
+		return errors.New("report already has a CVE assigned by another CNA")
+	}
+	if r.CVEMetadata != nil && r.CVEMetadata.ID != "" {
+		return fmt.Errorf("report already has CVE ID %s", r.CVEMetadata.ID)
+	}
+	cves, err := c.ReserveIDs(cveclient.ReserveOptions{
+		NumIDs: 1,
+		Year:   time.Now().Year(),
+	})
+	if err != nil {
+		return err
+	}
+	if c.DryRun {
+		fmt.Printf("%s: dry run: would reserve a CVE ID\n", filename)
+		return nil
+	}
+	if len(cves) == 0 {
+		return errors.New("no CVE ID was reserved; is the quota used up?")
+	}
+	if r.CVEMetadata == nil {
+		r.CVEMetadata = &report.CVEMeta{CWE: todo, Description: todo}
+	}
+	r.CVEMetadata.ID = cves[0].ID
+	if err := r.Write(filename); err != nil {
+		return err
+	}
+	fmt.Printf("%s: reserved %s\n", filename, cves[0].ID)
+	return nil
+}
+
+// publishCVE publishes the CVE record of the report in filename, or updates
+// it if it is already published.
+func publishCVE(c *cveclient.Client, filename string) (err error) {
+	defer derrors.Wrap(&err, "publishCVE(%q)", filename)
+
+	record, err := report.ToCVE5(filename)
+	if err != nil {
+		return err
+	}
+	id := record.Metadata.ID
+	assigned, err := c.RetrieveID(id)
+	if err != nil {
+		return err
+	}
+	publish, action := c.CreateRecord, "create"
+	if assigned.State != cveschema.StateReserved {
+		publish, action = c.UpdateRecord, "update"
+	}
+	if c.DryRun {
+		fmt.Printf("%s: dry run: would %s record for %s:\n", filename, action, id)
+		return printJSON(record)
+	}
+	if _, err := publish(id, &record.Containers); err != nil {
+		return err
+	}
+	fmt.Printf("%s: %sd record for %s: %s%s\n", filename, action, id, report.NISTPrefix, id)
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// reviewCmd runs the review command cmd, one of claim, review and approve,
+// on the reports given by args, as reportIDs or anything argToFilename
+// accepts. A report being claimed doesn't have to exist yet.
+func reviewCmd(ctx context.Context, cmd string, args []string) (err error) {
+	defer derrors.Wrap(&err, "%s", cmd)
+
+	var change func(context.Context, store.Store, string, string) (*store.ReportReview, error)
+	switch cmd {
+	case "claim":
+		change = worker.ClaimReport
+	case "review":
+		change = worker.RequestReview
+	case "approve":
+		change = worker.ApproveReport
+	default:
+		return fmt.Errorf("unknown review command %q", cmd)
+	}
+	if *storeProject == "" || *storeNamespace == "" {
+		return errors.New("need -project and -namespace")
+	}
+	user, err := reviewUser()
+	if err != nil {
+		return err
+	}
+	st, err := store.NewFireStore(ctx, *storeProject, *storeNamespace, "")
+	if err != nil {
+		return err
+	}
+	for _, arg := range args {
+		id, err := argToReportID(arg)
+		if err != nil {
+			return err
+		}
+		r, err := change(ctx, st, id, user)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: %s, assigned to %s\n", r.ReportID, r.State, r.Assignee)
+	}
+	return nil
+}
+
+// argToReportID returns the ID of the report given by arg.
+func argToReportID(arg string) (string, error) {
+	if reportIDRegexp.MatchString(arg) {
+		return arg, nil
+	}
+	filename, err := argToFilename(arg)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename)), nil
+}
+
+// reviewUser returns the user from -user, or else the email address that
+// git commits with.
+func reviewUser() (string, error) {
+	if *reviewAs != "" {
+		return *reviewAs, nil
+	}
+	out, err := exec.Command("git", "config", "user.email").Output()
+	if err != nil {
+		return "", fmt.Errorf("no -user, and can't get git's user.email: %v", err)
+	}
+	user := strings.TrimSpace(string(out))
+	if user == "" {
+		return "", errors.New("no -user, and git's user.email is empty")
+	}
+	return user, nil
+}
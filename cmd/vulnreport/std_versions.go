@@ -0,0 +1,183 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"golang.org/x/exp/slices"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gerrit"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// goIssueRegexp matches the URL of an issue of the Go project. The submatch
+// is the issue number.
+var goIssueRegexp = regexp.MustCompile(`^https://(?:go\.dev/issue|golang\.org/issue|github\.com/golang/go/issues)/(\d+)$`)
+
+// A stdlibFixCL is a CL that fixes a standard library vulnerability.
+type stdlibFixCL struct {
+	change *gerrit.Change
+	// release is the first Go release that contains the CL, or nil if none
+	// does yet.
+	release *stdlib.Release
+}
+
+// stdVersions finds the CLs that fix the Go issues that the standard library
+// or toolchain report in filename refers to, on master and on release
+// branches, and the first Go release that contains each. From those it
+// computes the version ranges of the report. It fills in the ranges of the
+// report's standard library and toolchain modules if they have none, and
+// otherwise writes to w any differences. It also adds a fix reference for
+// each CL on master that the report lacks. The Go repo, with its history,
+// comes from goRepo.
+func stdVersions(ctx context.Context, filename string, gc *gerrit.Client, goRepo func() (*git.Repository, error), w io.Writer) (err error) {
+	defer derrors.Wrap(&err, "stdVersions(%q)", filename)
+
+	r, err := report.Read(filename)
+	if err != nil {
+		return err
+	}
+	if slices.IndexFunc(r.Modules, isStdlibModule) < 0 {
+		return fmt.Errorf("%s is not a standard library or toolchain report", filename)
+	}
+	var issues []int
+	for _, ref := range r.References {
+		if m := goIssueRegexp.FindStringSubmatch(ref.URL); m != nil {
+			n, err := strconv.Atoi(m[1])
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(issues, n) {
+				issues = append(issues, n)
+			}
+		}
+	}
+	if len(issues) == 0 {
+		fmt.Fprintf(w, "%s: no Go issues to find fixes for\n", filename)
+		return nil
+	}
+	var cls []*stdlibFixCL
+	for _, n := range issues {
+		chs, err := gc.ChangesForIssue(ctx, "go", n)
+		if err != nil {
+			return err
+		}
+		for _, ch := range chs {
+			cls = append(cls, &stdlibFixCL{change: ch})
+		}
+	}
+	if len(cls) == 0 {
+		fmt.Fprintf(w, "%s: no merged CLs for issues %v\n", filename, issues)
+		return nil
+	}
+	repo, err := goRepo()
+	if err != nil {
+		return err
+	}
+	releases, err := stdlib.Releases(repo)
+	if err != nil {
+		return err
+	}
+	var fixed []report.Version
+	for _, cl := range cls {
+		c, err := repo.CommitObject(plumbing.NewHash(cl.change.CurrentRevision))
+		if err != nil {
+			return fmt.Errorf("CL %d: %v", cl.change.Number, err)
+		}
+		i, err := stdlib.FirstReleaseContaining(releases, c)
+		if err != nil {
+			return err
+		}
+		first := "no release yet"
+		if i >= 0 {
+			cl.release = releases[i]
+			first = cl.release.Tag
+			fixed = append(fixed, report.Version(cl.release.Version))
+		}
+		fmt.Fprintf(w, "%s: https://go.dev/cl/%d (%s, %s): first in %s\n",
+			filename, cl.change.Number, cl.change.Branch, cl.change.Subject, first)
+	}
+	setStdlibVersions(r, report.StdlibVersionRanges(fixed), filename, w)
+	addStdlibFixReferences(r, cls)
+	return r.Write(filename)
+}
+
+// setStdlibVersions sets the version ranges of the standard library and
+// toolchain modules of r to vrs, unless they already have ranges, in which
+// case it writes to w how they differ.
+func setStdlibVersions(r *report.Report, vrs []report.VersionRange, filename string, w io.Writer) {
+	if len(vrs) == 0 {
+		return
+	}
+	for _, m := range r.Modules {
+		if !isStdlibModule(m) {
+			continue
+		}
+		if hasVersions(m) {
+			if !slices.Equal(m.Versions, vrs) {
+				fmt.Fprintf(w, "%s: %s has versions %s, but its fixes are in %s\n",
+					filename, m.Module, formatRanges(m.Versions), formatRanges(vrs))
+			}
+			continue
+		}
+		m.Versions = vrs
+	}
+}
+
+// hasVersions reports whether m has version ranges other than the
+// placeholder that create writes.
+func hasVersions(m *report.Module) bool {
+	for _, vr := range m.Versions {
+		if vr.Introduced != todo || vr.Fixed != todo {
+			return true
+		}
+	}
+	return false
+}
+
+func formatRanges(vrs []report.VersionRange) string {
+	var ss []string
+	for _, vr := range vrs {
+		s := "[" + string(vr.Introduced) + ", " + string(vr.Fixed) + ")"
+		ss = append(ss, s)
+	}
+	return strings.Join(ss, " ")
+}
+
+// addStdlibFixReferences adds to r a fix reference for each CL on master
+// that it doesn't refer to, replacing the placeholder that create writes.
+func addStdlibFixReferences(r *report.Report, cls []*stdlibFixCL) {
+	var refs []*report.Reference
+	have := map[int]bool{}
+	for _, ref := range r.References {
+		if ref.Type == report.ReferenceTypeFix && strings.HasPrefix(ref.URL, "TODO") {
+			continue
+		}
+		if n, ok := gerrit.ParseCLURL(ref.URL); ok {
+			have[n] = true
+		}
+		refs = append(refs, ref)
+	}
+	for _, cl := range cls {
+		if cl.change.Branch != "master" || have[cl.change.Number] {
+			continue
+		}
+		have[cl.change.Number] = true
+		refs = append(refs, &report.Reference{
+			Type: report.ReferenceTypeFix,
+			URL:  fmt.Sprintf("https://go.dev/cl/%d", cl.change.Number),
+		})
+	}
+	r.References = refs
+}
@@ -0,0 +1,144 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/gerrit"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestStdVersions(t *testing.T) {
+	// A Go repo with a fix on master, in go1.20, backported to
+	// release-branch.go1.19, in go1.19.1.
+	fs := memfs.New()
+	repo, err := git.Init(memory.NewStorage(), fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit := func(content string) plumbing.Hash {
+		t.Helper()
+		if err := util.WriteFile(fs, "README", []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := wt.Add("README"); err != nil {
+			t.Fatal(err)
+		}
+		h, err := wt.Commit(content, &git.CommitOptions{Author: &object.Signature{
+			Name: "Author", When: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	tag := func(name string, h plumbing.Hash) {
+		t.Helper()
+		if _, err := repo.CreateTag(name, h, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	base := commit("go1.19")
+	tag("go1.19", base)
+	masterFix := commit("fix")
+	tag("go1.20", commit("go1.20"))
+	if err := wt.Checkout(&git.CheckoutOptions{
+		Branch: plumbing.NewBranchReferenceName("release-branch.go1.19"),
+		Hash:   base,
+		Create: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	backport := commit("backport")
+	tag("go1.19.1", commit("go1.19.1"))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, ")]}'\n"+`[
+			{"_number": 1, "project": "go", "branch": "master", "status": "MERGED", "subject": "net/http: fix",
+			 "current_revision": %[1]q, "revisions": {%[1]q: {"commit": {"message": "net/http: fix\n\nFixes #56350\n"}}}},
+			{"_number": 2, "project": "go", "branch": "release-branch.go1.19", "status": "MERGED", "subject": "[release-branch.go1.19] net/http: fix",
+			 "current_revision": %[2]q, "revisions": {%[2]q: {"commit": {"message": "net/http: fix\n\nUpdates #56350\n"}}}}
+		]`, masterFix, backport)
+	}))
+	defer srv.Close()
+
+	dir := filepath.Join(t.TempDir(), "data", "reports")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "GO-2022-0001.yaml")
+	r := &report.Report{
+		Modules: []*report.Module{{
+			Module:   "std",
+			Versions: []report.VersionRange{{Introduced: todo, Fixed: todo}},
+		}},
+		Description: "A vulnerability.",
+		References: []*report.Reference{
+			{Type: report.ReferenceTypeReport, URL: "https://go.dev/issue/56350"},
+			{Type: report.ReferenceTypeFix, URL: "TODO: PR or commit"},
+		},
+	}
+	if err := r.Write(filename); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	openRepo := func() (*git.Repository, error) { return repo, nil }
+	if err := stdVersions(context.Background(), filename, gerrit.NewClient(srv.URL), openRepo, &out); err != nil {
+		t.Fatal(err)
+	}
+	got, err := report.Read(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []report.VersionRange{{Fixed: "1.19.1"}}; !cmp.Equal(got.Modules[0].Versions, want) {
+		t.Errorf("got versions %+v, want %+v", got.Modules[0].Versions, want)
+	}
+	wantRefs := []*report.Reference{
+		{Type: report.ReferenceTypeReport, URL: "https://go.dev/issue/56350"},
+		{Type: report.ReferenceTypeFix, URL: "https://go.dev/cl/1"},
+	}
+	if diff := cmp.Diff(wantRefs, got.References); diff != "" {
+		t.Errorf("references mismatch (-want, +got):\n%s", diff)
+	}
+	for _, want := range []string{"go.dev/cl/1 (master, net/http: fix): first in go1.20", "go.dev/cl/2 (release-branch.go1.19"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output does not contain %q:\n%s", want, out.String())
+		}
+	}
+
+	// Versions that are already set are checked, not changed.
+	got.Modules[0].Versions = []report.VersionRange{{Fixed: "1.19.2"}}
+	if err := got.Write(filename); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err := stdVersions(context.Background(), filename, gerrit.NewClient(srv.URL), openRepo, &out); err != nil {
+		t.Fatal(err)
+	}
+	if want := "std has versions [, 1.19.2), but its fixes are in [, 1.19.1)"; !strings.Contains(out.String(), want) {
+		t.Errorf("output does not contain %q:\n%s", want, out.String())
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/exp/slices"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gerrit"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/symbols"
+)
+
+// suggestSymbols writes to w the functions and methods changed by each fix
+// commit of the report in filename, by package, in the YAML form of the
+// report's packages. The triager decides which of them are vulnerable.
+func suggestSymbols(ctx context.Context, filename string, w io.Writer) (err error) {
+	defer derrors.Wrap(&err, "suggestSymbols(%q)", filename)
+
+	r, err := report.Read(filename)
+	if err != nil {
+		return err
+	}
+	fcs := r.FixCommits()
+	if len(fcs) == 0 {
+		fmt.Fprintf(w, "%s: no fix commits to suggest symbols from\n", filename)
+		return nil
+	}
+	repos := map[string]*git.Repository{}
+	for _, fc := range fcs {
+		repo, ok := repos[fc.RepoURL]
+		if !ok {
+			repo, err = gitrepo.CloneWithHistory(ctx, fc.RepoURL)
+			if err != nil {
+				return err
+			}
+			repos[fc.RepoURL] = repo
+		}
+		changed, err := symbols.Changed(repo, fc.Hash)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s: symbols changed by %s:\n", filename, fc.URL)
+		for _, pkg := range packageSymbols(fc.Module.Module, fc.Dir, changed) {
+			fmt.Fprintf(w, "  - package: %s\n    symbols:\n", pkg.path)
+			for _, s := range pkg.symbols {
+				fmt.Fprintf(w, "      - %s\n", s)
+			}
+		}
+	}
+	return nil
+}
+
+type pkgSymbols struct {
+	path    string
+	symbols []string
+}
+
+// packageSymbols converts the result of symbols.Changed, keyed by directories
+// in the repo, to package paths in the module at modulePath, whose directory
+// in the repo is moduleDir. Directories outside the module are dropped.
+func packageSymbols(modulePath, moduleDir string, changed map[string][]string) []pkgSymbols {
+	var pss []pkgSymbols
+	for dir, syms := range changed {
+		rel := dir
+		if moduleDir != "" {
+			if dir != moduleDir && !strings.HasPrefix(dir, moduleDir+"/") {
+				continue
+			}
+			rel = strings.TrimPrefix(strings.TrimPrefix(dir, moduleDir), "/")
+		}
+		pss = append(pss, pkgSymbols{path.Join(modulePath, rel), syms})
+	}
+	sort.Slice(pss, func(i, j int) bool { return pss[i].path < pss[j].path })
+	return pss
+}
+
+// goRepoURL is the URL of the Go repo, which has the fix commits of
+// standard library and toolchain reports.
+const goRepoURL = "https://go.googlesource.com/go"
+
+// goCommitRegexp matches the URL of a commit in the Go repo. The submatch is
+// the commit hash.
+var goCommitRegexp = regexp.MustCompile(`^https://go\.googlesource\.com/go/\+/([0-9a-f]{40})$`)
+
+// stdSymbols fills in the packages and symbols of the standard library or
+// toolchain report in filename from its fix references, which are CLs or
+// commits in the Go repo. For each fix, it compares the functions and
+// methods that the fix changed with the Go release before the first one
+// with the fix, and adds those in the earlier release to the report. It
+// leaves the symbols of packages that already have them alone, and writes
+// to w what it found. The Go repo, with its history, comes from goRepo.
+func stdSymbols(ctx context.Context, filename string, gc *gerrit.Client, goRepo func() (*git.Repository, error), w io.Writer) (err error) {
+	defer derrors.Wrap(&err, "stdSymbols(%q)", filename)
+
+	r, err := report.Read(filename)
+	if err != nil {
+		return err
+	}
+	if slices.IndexFunc(r.Modules, isStdlibModule) < 0 {
+		return fmt.Errorf("%s is not a standard library or toolchain report", filename)
+	}
+	var hashes []string
+	for _, ref := range r.References {
+		if ref.Type != report.ReferenceTypeFix {
+			continue
+		}
+		if m := goCommitRegexp.FindStringSubmatch(ref.URL); m != nil {
+			hashes = append(hashes, m[1])
+			continue
+		}
+		n, ok := gerrit.ParseCLURL(ref.URL)
+		if !ok {
+			continue
+		}
+		ch, err := gc.Change(ctx, n)
+		if err != nil {
+			return err
+		}
+		if ch == nil || ch.Project != "go" || ch.Status != gerrit.StatusMerged {
+			fmt.Fprintf(w, "%s: %s is not a merged CL in the Go repo; skipping\n", filename, ref.URL)
+			continue
+		}
+		hashes = append(hashes, ch.CurrentRevision)
+	}
+	if len(hashes) == 0 {
+		fmt.Fprintf(w, "%s: no fix CLs or commits in the Go repo\n", filename)
+		return nil
+	}
+	repo, err := goRepo()
+	if err != nil {
+		return err
+	}
+	for _, h := range hashes {
+		sf, err := symbols.StdlibFixSymbols(repo, h)
+		if err != nil {
+			return err
+		}
+		after := sf.After
+		if after == "" {
+			after = "no release yet"
+		}
+		fmt.Fprintf(w, "%s: fix %s: vulnerable in %s, fixed in %s\n", filename, h, sf.Before, after)
+		for _, p := range sf.Packages {
+			if len(p.Added) > 0 {
+				fmt.Fprintf(w, "  %s: added by the fix, not vulnerable: %s\n", p.Package, strings.Join(p.Added, ", "))
+			}
+		}
+		addStdlibPackages(r, sf)
+	}
+	return r.Write(filename)
+}
+
+func isStdlibModule(m *report.Module) bool {
+	return m.Module == stdlib.ModulePath || m.Module == "cmd"
+}
+
+// addStdlibPackages adds the packages of sf that have symbols to r, with
+// their symbols, replacing the placeholders that create writes. Packages of
+// r that already have symbols keep them. A module without a vulnerable_at
+// version is vulnerable at the release before the fix.
+func addStdlibPackages(r *report.Report, sf *symbols.StdlibFix) {
+	for _, sp := range sf.Packages {
+		if len(sp.Symbols) == 0 {
+			continue
+		}
+		var m *report.Module
+		for _, rm := range r.Modules {
+			if rm.Module == sp.Module {
+				m = rm
+			}
+		}
+		if m == nil {
+			m = &report.Module{Module: sp.Module}
+			r.Modules = append(r.Modules, m)
+		}
+		var pkgs []*report.Package
+		for _, p := range m.Packages {
+			if p.Package != "" && p.Package != todo {
+				pkgs = append(pkgs, p)
+			}
+		}
+		m.Packages = pkgs
+		if (m.VulnerableAt == "" || m.VulnerableAt == todo) && sf.Before != "" {
+			m.VulnerableAt = semverForGoVersion(sf.Before)
+		}
+		i := slices.IndexFunc(m.Packages, func(p *report.Package) bool { return p.Package == sp.Package })
+		if i < 0 {
+			m.Packages = append(m.Packages, &report.Package{Package: sp.Package})
+			i = len(m.Packages) - 1
+		}
+		if p := m.Packages[i]; len(p.Symbols) == 0 || slices.Equal(p.Symbols, []string{todo}) {
+			p.Symbols = sp.Symbols
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/mod/module"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// xrefCmd prints cross references for each of args: for a report, given as
+// for the other commands, those of xref; for a module path, those of
+// xrefModule.
+func xrefCmd(ctx context.Context, args []string) (err error) {
+	_, existingByFile, err := existingReports()
+	if err != nil {
+		return err
+	}
+	var (
+		ic      issueSearcher
+		st      store.Store
+		clients bool
+	)
+	for _, arg := range args {
+		name, err := argToFilename(arg)
+		if err == nil {
+			r, err := report.Read(name)
+			if err != nil {
+				return err
+			}
+			fmt.Println(name)
+			fmt.Print(xref(name, r, existingByFile))
+			continue
+		}
+		if module.CheckPath(arg) != nil {
+			return fmt.Errorf("%v, or a module path", err)
+		}
+		if !clients {
+			ic, st, err = newXrefClients(ctx)
+			if err != nil {
+				return err
+			}
+			clients = true
+		}
+		fmt.Println(arg)
+		if err := xrefModule(ctx, os.Stdout, arg, existingByFile, ic, st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// An issueSearcher finds the open issues that mention some text.
+type issueSearcher interface {
+	SearchOpenIssues(ctx context.Context, text string) ([]*issues.Issue, error)
+	Reference(number int) string
+}
+
+// xrefModule writes to w the prior art for a new report about the module
+// with path modulePath: the existing reports of the module, the open issues
+// that mention it, and the CVEs and GHSAs of it that are not yet triaged.
+// A nil ic or st skips the issues or the CVEs and GHSAs.
+func xrefModule(ctx context.Context, w io.Writer, modulePath string, existingByFile map[string]*report.Report, ic issueSearcher, st store.Store) (err error) {
+	defer derrors.Wrap(&err, "xrefModule(%q)", modulePath)
+
+	for _, fname := range sorted(maps.Keys(existingByFile)) {
+		for _, path := range reportModulesMatching(existingByFile[fname], modulePath) {
+			fmt.Fprintf(w, "Module %s appears in %s", path, fname)
+			if e := existingByFile[fname].Excluded; e != "" {
+				fmt.Fprintf(w, "  %v", e)
+			}
+			fmt.Fprintf(w, "\n")
+		}
+	}
+	if ic != nil {
+		iss, err := ic.SearchOpenIssues(ctx, modulePath)
+		if err != nil {
+			return err
+		}
+		for _, is := range iss {
+			fmt.Fprintf(w, "Module %s appears in open issue %s: %s\n", modulePath, ic.Reference(is.Number), is.Title)
+		}
+	}
+	if st != nil {
+		ids, err := untriagedRecords(ctx, st, modulePath)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			fmt.Fprintf(w, "Module %s appears in untriaged %s\n", modulePath, id)
+		}
+	}
+	return nil
+}
+
+// reportModulesMatching returns the paths of the modules of r, including
+// their alternate paths, that are modulePath, are nested in it, or contain
+// it as a package.
+func reportModulesMatching(r *report.Report, modulePath string) []string {
+	var paths []string
+	for _, m := range r.Modules {
+		for _, p := range append([]string{m.Module}, m.AlternatePaths...) {
+			if p != "" && (p == modulePath || strings.HasPrefix(p, modulePath+"/") || strings.HasPrefix(modulePath, p+"/")) {
+				paths = append(paths, p)
+			}
+		}
+	}
+	return paths
+}
+
+// untriagedStates are the triage states of the CVEs and GHSAs in the store
+// that need an issue, and so may need a report.
+var untriagedStates = []store.TriageState{
+	store.TriageStateNeedsIssue,
+	store.TriageStateUpdatedSinceIssueCreation,
+}
+
+// untriagedRecords returns the IDs of the CVEs and GHSAs of st for the module
+// with path modulePath that are in untriagedStates, with their states.
+func untriagedRecords(ctx context.Context, st store.Store, modulePath string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "untriagedRecords(%q)", modulePath)
+
+	var ids []string
+	for _, ts := range untriagedStates {
+		crs, _, err := st.ListCVERecords(ctx, store.CVERecordQuery{Module: modulePath, TriageState: ts})
+		if err != nil {
+			return nil, err
+		}
+		for _, cr := range crs {
+			ids = append(ids, fmt.Sprintf("%s (%s)", cr.ID, cr.TriageState))
+		}
+	}
+	var grs []*store.GHSARecord
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		var err error
+		grs, err = tx.GetGHSARecords()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, gr := range grs {
+		if !isUntriaged(gr.TriageState) || !ghsaMentions(gr, modulePath) {
+			continue
+		}
+		ids = append(ids, fmt.Sprintf("%s (%s)", gr.GHSA.ID, gr.TriageState))
+	}
+	return sorted(ids), nil
+}
+
+func isUntriaged(ts store.TriageState) bool {
+	for _, s := range untriagedStates {
+		if ts == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ghsaMentions reports whether a package of the GHSA of gr is in the module
+// with path modulePath. GHSAs name Go packages, not modules.
+func ghsaMentions(gr *store.GHSARecord, modulePath string) bool {
+	for _, v := range gr.GHSA.Vulns {
+		if v.Package == modulePath || strings.HasPrefix(v.Package, modulePath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// newXrefClients returns the clients that xref uses to look up a module:
+// an issues client if there is a GitHub token, and the worker's store if
+// there is a project and namespace. Either may be nil.
+func newXrefClients(ctx context.Context) (issueSearcher, store.Store, error) {
+	var (
+		ic issueSearcher
+		st store.Store
+	)
+	if *githubToken != "" {
+		owner, repoName, err := gitrepo.ParseGitHubRepo(*issueRepo)
+		if err != nil {
+			return nil, nil, err
+		}
+		ic = issues.NewGitHubClient(owner, repoName, *githubToken)
+	} else {
+		log.Print("xref: no -ghtoken, so not searching issues")
+	}
+	if *storeProject != "" && *storeNamespace != "" {
+		fs, err := store.NewFireStore(ctx, *storeProject, *storeNamespace, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		st = fs
+	} else {
+		log.Print("xref: no -project and -namespace, so not listing untriaged CVEs and GHSAs")
+	}
+	return ic, st, nil
+}
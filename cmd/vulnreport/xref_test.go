@@ -0,0 +1,95 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+type fakeIssueSearcher []*issues.Issue
+
+func (f fakeIssueSearcher) SearchOpenIssues(context.Context, string) ([]*issues.Issue, error) {
+	return f, nil
+}
+
+func (fakeIssueSearcher) Reference(n int) string {
+	return fmt.Sprintf("golang/vulndb#%d", n)
+}
+
+func cveRecord(id, modulePath string, ts store.TriageState) *store.CVERecord {
+	return &store.CVERecord{
+		ID:          id,
+		Path:        id + ".json",
+		BlobHash:    "h",
+		CommitHash:  "c",
+		CommitTime:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		CVEState:    "PUBLIC",
+		Module:      modulePath,
+		TriageState: ts,
+	}
+}
+
+func TestXrefModule(t *testing.T) {
+	ctx := context.Background()
+	existingByFile := map[string]*report.Report{
+		"data/reports/GO-2022-0001.yaml": {Modules: []*report.Module{{Module: "example.com/m"}}},
+		"data/reports/GO-2022-0002.yaml": {Modules: []*report.Module{{Module: "example.com/m/v2"}}},
+		"data/excluded/GO-2022-0003.yaml": {
+			Excluded: "NOT_IMPORTABLE",
+			Modules:  []*report.Module{{Module: "example.com/old", AlternatePaths: []string{"example.com/m"}}},
+		},
+		"data/reports/GO-2022-0004.yaml": {Modules: []*report.Module{{Module: "example.com/mm"}}},
+	}
+	st := store.NewMemStore()
+	err := st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		for _, cr := range []*store.CVERecord{
+			cveRecord("CVE-2022-0001", "example.com/m", store.TriageStateNeedsIssue),
+			cveRecord("CVE-2022-0002", "example.com/m", store.TriageStateIssueCreated),
+			cveRecord("CVE-2022-0003", "example.com/other", store.TriageStateNeedsIssue),
+		} {
+			if err := tx.CreateCVERecord(cr); err != nil {
+				return err
+			}
+		}
+		for _, gr := range []*store.GHSARecord{
+			{GHSA: &ghsa.SecurityAdvisory{ID: "GHSA-aaaa-bbbb-cccc", Vulns: []*ghsa.Vuln{{Package: "example.com/m/pkg"}}}, TriageState: store.TriageStateUpdatedSinceIssueCreation},
+			{GHSA: &ghsa.SecurityAdvisory{ID: "GHSA-dddd-eeee-ffff", Vulns: []*ghsa.Vuln{{Package: "example.com/mm"}}}, TriageState: store.TriageStateNeedsIssue},
+		} {
+			if err := tx.CreateGHSARecord(gr); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ic := fakeIssueSearcher{{Number: 12, Title: "x/vulndb: potential Go vuln in example.com/m"}}
+
+	var buf bytes.Buffer
+	if err := xrefModule(ctx, &buf, "example.com/m", existingByFile, ic, st); err != nil {
+		t.Fatal(err)
+	}
+	want := `Module example.com/m appears in data/excluded/GO-2022-0003.yaml  NOT_IMPORTABLE
+Module example.com/m appears in data/reports/GO-2022-0001.yaml
+Module example.com/m/v2 appears in data/reports/GO-2022-0002.yaml
+Module example.com/m appears in open issue golang/vulndb#12: x/vulndb: potential Go vuln in example.com/m
+Module example.com/m appears in untriaged CVE-2022-0001 (NeedsIssue)
+Module example.com/m appears in untriaged GHSA-aaaa-bbbb-cccc (UpdatedSinceIssueCreation)
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -9,22 +9,36 @@ package main
 
 import (
 	"bufio"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"os/signal"
+	"os/user"
+	"sort"
+	"strconv"
 	"strings"
+	"syscall"
 	"text/tabwriter"
 	"time"
 
 	"golang.org/x/exp/event"
+	"golang.org/x/tools/txtar"
 	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/depsdev"
+	"golang.org/x/vulndb/internal/epss"
 	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/gitlab"
 	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/nvd"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/osvdev"
 	"golang.org/x/vulndb/internal/worker"
 	"golang.org/x/vulndb/internal/worker/log"
 	"golang.org/x/vulndb/internal/worker/store"
@@ -39,17 +53,119 @@ var (
 	githubTokenFile = flag.String("ghtokenfile", "",
 		"path to file containing GitHub access token (for creating issues)")
 	knownModuleFile = flag.String("known-module-file", "", "file with list of all known modules")
+	pgDataSource    = flag.String("pg", os.Getenv("VULN_WORKER_PG"),
+		"Postgres data source name; if set, use Postgres instead of Firestore")
+	sqliteFile = flag.String("sqlite", "", "path to SQLite database file; if set, use SQLite instead of Firestore")
+	logLevel   = flag.String("log-level", os.Getenv("VULN_WORKER_LOG_LEVEL"),
+		"lowest level of messages to log: debug, info, warning or error (default debug)")
+	dryRun = flag.Bool("dry-run", false,
+		"run the subcommand without writing to the DB or creating issues, and print what would have changed")
+	cpuProfile = flag.String("cpuprofile", "", "write a CPU profile of the subcommand to this file")
+	memProfile = flag.String("memprofile", "", "write a heap profile to this file after the subcommand")
 )
 
 // Config for both the server and the command-line tool.
 var cfg worker.Config
 
 func init() {
-	flag.StringVar(&cfg.Project, "project", os.Getenv("GOOGLE_CLOUD_PROJECT"), "project ID (required)")
-	flag.StringVar(&cfg.Namespace, "namespace", os.Getenv("VULN_WORKER_NAMESPACE"), "Firestore namespace (required)")
+	flag.StringVar(&cfg.Project, "project", os.Getenv("GOOGLE_CLOUD_PROJECT"), "project ID (required for Firestore and the server)")
+	flag.StringVar(&cfg.Namespace, "namespace", os.Getenv("VULN_WORKER_NAMESPACE"), "namespace of the environment, such as prod or staging: a Firestore namespace or Postgres schema (required)")
 	flag.BoolVar(&cfg.UseErrorReporting, "report-errors", os.Getenv("VULN_WORKER_REPORT_ERRORS") == "true",
 		"use the error reporting API")
 	flag.StringVar(&cfg.IssueRepo, "issue-repo", os.Getenv("VULN_WORKER_ISSUE_REPO"), "repo to create issues in")
+	flag.BoolVar(&cfg.AutoCreateIssues, "auto-issues", os.Getenv("VULN_WORKER_AUTO_ISSUES") == "true",
+		"have the server create issues after each update")
+	flag.StringVar(&cfg.NVDAPIKey, "nvd-api-key", os.Getenv("VULN_NVD_API_KEY"), "key for the NVD CVE API (optional)")
+	flag.IntVar(&cfg.UpdateParallelism, "update-parallelism", 0,
+		"number of CVEs to parse and triage at once during an update (0 means the default)")
+	flag.StringVar(&cfg.CVEListRepoURL, "cvelist-repo", os.Getenv("VULN_WORKER_CVELIST_REPO"),
+		"URL of the cvelist repo or a mirror of it (optional; credentials for a private mirror come from the environment)")
+	flag.StringVar(&cfg.VulnDBRepo, "vulndb-repo", envOr("VULN_WORKER_VULNDB_REPO", worker.VulnDBRepoURL),
+		"URL or local path of the vulndb repo, whose reports new issues are checked against and records are reconciled with (empty to disable)")
+	flag.StringVar(&cfg.RepoCacheDir, "repo-cache-dir", os.Getenv("VULN_WORKER_REPO_CACHE_DIR"),
+		"directory to keep a clone of the cvelist repo in between updates (optional)")
+	flag.StringVar(&cfg.UpdateSchedule, "update-schedule", os.Getenv("VULN_WORKER_UPDATE_SCHEDULE"),
+		"cron expression for server updates from the cvelist repo (optional)")
+	flag.StringVar(&cfg.GHSASchedule, "ghsa-schedule", os.Getenv("VULN_WORKER_GHSA_SCHEDULE"),
+		"cron expression for server updates from GitHub security advisories (optional)")
+	flag.StringVar(&cfg.IssueSchedule, "issue-schedule", os.Getenv("VULN_WORKER_ISSUE_SCHEDULE"),
+		"cron expression for server issue creation (optional)")
+	flag.StringVar(&cfg.ReconcileSchedule, "reconcile-schedule", os.Getenv("VULN_WORKER_RECONCILE_SCHEDULE"),
+		"cron expression for server reconciliation of records with issues and reports (optional)")
+	flag.StringVar(&cfg.ReportGapsSchedule, "report-gaps-schedule", os.Getenv("VULN_WORKER_REPORT_GAPS_SCHEDULE"),
+		"cron expression for server searches for aliases and references missing from reports (optional)")
+	flag.StringVar(&cfg.DepsDevSchedule, "depsdev-schedule", os.Getenv("VULN_WORKER_DEPSDEV_SCHEDULE"),
+		"cron expression for server checks of reports against deps.dev advisories (optional)")
+	flag.StringVar(&cfg.SecurityReleasesSchedule, "security-releases-schedule", os.Getenv("VULN_WORKER_SECURITY_RELEASES_SCHEDULE"),
+		"cron expression for server checks of reports against the security fixes in the Go release notes (optional)")
+	flag.StringVar(&cfg.UnfixedSchedule, "unfixed-schedule", os.Getenv("VULN_WORKER_UNFIXED_SCHEDULE"),
+		"cron expression for server checks of the modules of unfixed reports for new versions (optional)")
+	flag.StringVar(&cfg.WithdrawalSchedule, "withdrawal-schedule", os.Getenv("VULN_WORKER_WITHDRAWAL_SCHEDULE"),
+		"cron expression for server checks for reports and issues whose CVEs were rejected or disputed upstream (optional)")
+	flag.StringVar(&cfg.EmbargoSchedule, "embargo-schedule", os.Getenv("VULN_WORKER_EMBARGO_SCHEDULE"),
+		"cron expression for server checks for overlay reports whose embargoes have ended (optional)")
+	flag.StringVar(&cfg.AgingSchedule, "aging-schedule", os.Getenv("VULN_WORKER_AGING_SCHEDULE"),
+		"cron expression for server recording of the ages of the triage queues as metrics (optional)")
+	flag.StringVar(&cfg.DigestSchedule, "digest-schedule", os.Getenv("VULN_WORKER_DIGEST_SCHEDULE"),
+		"cron expression for server notifications of a digest of the ages of the triage queues, such as weekly (optional)")
+	flag.StringVar(&cfg.SummarySchedule, "summary-schedule", os.Getenv("VULN_WORKER_SUMMARY_SCHEDULE"),
+		"cron expression for server posts of a summary of the week's triage on -summary-issue, such as weekly (optional)")
+	flag.IntVar(&cfg.SummaryIssue, "summary-issue", envInt("VULN_WORKER_SUMMARY_ISSUE", 0),
+		"number of the issue in -issue-repo to post triage summaries on, as comments (optional)")
+	flag.StringVar(&cfg.OverlayDir, "overlay-dir", os.Getenv("VULN_WORKER_OVERLAY_DIR"),
+		"directory of a git repo of private reports, some of them embargoed, in the database in -db-dir (optional)")
+	flag.StringVar(&cfg.DBDir, "db-dir", os.Getenv("VULN_WORKER_DB_DIR"),
+		"directory of the database built from -vulndb-repo and -overlay-dir, to regenerate when embargoes end (optional)")
+	flag.StringVar(&cfg.SigningKeyFile, "signing-key", os.Getenv("VULN_WORKER_SIGNING_KEY"),
+		"file with a PEM-encoded Ed25519 private key to sign the database in -db-dir with (optional)")
+	flag.StringVar(&cfg.SlackWebhookURL, "slack-webhook", os.Getenv("VULN_WORKER_SLACK_WEBHOOK"),
+		"URL of a Slack incoming webhook for the server to notify (optional)")
+	flag.StringVar(&cfg.WebhookURL, "webhook", os.Getenv("VULN_WORKER_WEBHOOK"),
+		"URL of a webhook for the server to post JSON notifications to (optional)")
+	flag.StringVar(&cfg.OTLPEndpoint, "otlp-endpoint", os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+		"URL of an OpenTelemetry collector to send traces to (optional)")
+	flag.DurationVar(&cfg.DrainWindow, "drain-window", envDuration("VULN_WORKER_DRAIN_WINDOW", 8*time.Second),
+		"how long the server waits on shutdown for requests and canceled jobs to finish")
+	flag.StringVar(&cfg.TriageRulesFile, "triage-rules", os.Getenv("VULN_WORKER_TRIAGE_RULES"), "file of triage rules (optional)")
+	flag.StringVar(&cfg.TriageClassifier, "triage-classifier", os.Getenv("VULN_WORKER_TRIAGE_CLASSIFIER"),
+		`classifier that scores CVEs that need issues: "builtin", or the URL of a model endpoint (optional)`)
+}
+
+// envOr returns the value of the environment variable key, or def if it is
+// not set.
+func envOr(key, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
+
+// envDuration returns the duration in the environment variable key, or def
+// if it is not set.
+func envDuration(key string, def time.Duration) time.Duration {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		die("%s: %v", key, err)
+	}
+	return d
+}
+
+// envInt returns the integer in the environment variable key, or def if it
+// is not set.
+func envInt(key string, def int) int {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		die("%s: %v", key, err)
+	}
+	return n
 }
 
 const pkgsiteURL = "https://pkg.go.dev"
@@ -64,11 +180,37 @@ func main() {
 		fmt.Fprintln(out, "  run as a command-line tool, executing SUBCOMMAND")
 		fmt.Fprintln(out, "  subcommands:")
 		fmt.Fprintln(out, "    update COMMIT: perform an update operation")
+		fmt.Fprintln(out, "    update-ghsas: update from GitHub security advisories changed since the last update")
+		fmt.Fprintln(out, "    update-nvd [START]: update from CVEs modified in the NVD since the last update, or START")
+		fmt.Fprintln(out, "    update-gitlab [-repo PATH]: update from the Go advisories of the GitLab Advisory Database")
+		fmt.Fprintln(out, "    update-epss: set the EPSS scores of CVEs that need issues")
+		fmt.Fprintln(out, "    update-importers: set the number of importers of the modules of CVEs that need issues, from deps.dev")
+		fmt.Fprintln(out, "    update-osv: record OSV.dev advisories for CVEs that need issues, and mark those with Go reports")
 		fmt.Fprintln(out, "    list-updates: display info about update operations")
-		fmt.Fprintln(out, "    list-cves TRIAGE_STATE: display info about CVE records")
+		fmt.Fprintln(out, "    status: display the status of the updates from each source")
+		fmt.Fprintln(out, "    list-cves [-year YEAR] [-module MODULE] [-since TIME] [TRIAGE_STATE]: display info about CVE records")
+		fmt.Fprintln(out, "    list-ghsas [TRIAGE_STATE]: display info about GHSA records")
 		fmt.Fprintln(out, "    create-issues: create issues for CVEs that need them")
-		fmt.Fprintln(out, "    show ID1 ID2 ...: display CVE records")
+		fmt.Fprintln(out, "    reconcile [-repo PATH]: cross-check records, their issues and vulndb reports, and fix what is safe")
+		fmt.Fprintln(out, "    report-gaps [-repo PATH]: list aliases and references that OSV.dev advisories have but vulndb reports don't")
+		fmt.Fprintln(out, "    check-depsdev [-repo PATH]: record where deps.dev advisories and vulndb reports disagree about the versions of modules")
+		fmt.Fprintln(out, "    security-releases [-repo PATH]: create issues for security fixes in the Go release notes that vulndb reports don't cover")
+		fmt.Fprintln(out, "    watch-unfixed [-repo PATH]: flag vulndb reports without fixed versions whose modules have new versions")
+		fmt.Fprintln(out, "    withdrawals [-repo PATH]: flag vulndb reports and issues whose CVEs were rejected or disputed upstream for withdrawal review")
+		fmt.Fprintln(out, "    embargoes [-repo DIR] [-overlay DIR] [-db DIR]: regenerate the database if the embargo of an overlay report has ended")
+		fmt.Fprintln(out, "    triage-aging: display how long CVEs and GHSAs have been waiting for issues, and for reports")
+		fmt.Fprintln(out, "    triage-summary [-issue N] [-repo PATH]: post a summary of the last week's triage as a comment on an issue")
+		fmt.Fprintln(out, "    show ID1 ID2 ...: display CVE or GHSA records")
 		fmt.Fprintln(out, "    scan-modules: scan modules for vulnerabilities")
+		fmt.Fprintln(out, "    false-positive -reason REASON ID1 ID2 ...: mark CVEs as false positives")
+		fmt.Fprintln(out, "    changed-cves: display CVEs with reports or aliases that changed upstream since they were triaged")
+		fmt.Fprintln(out, "    ack-change -reason REASON ID1 ID2 ...: acknowledge that CVEs changed since they were triaged")
+		fmt.Fprintln(out, "    triage-history ID: display the changes to the triage state of a CVE or GHSA")
+		fmt.Fprintln(out, "    aliases ID: display the known aliases of a CVE, GHSA or GO ID")
+		fmt.Fprintln(out, "    search WORD ...: display the IDs of the CVEs, GHSAs and Go reports whose text has all the words")
+		fmt.Fprintln(out, "    export [-out FILE]: write a snapshot of the DB's records and triage history to FILE or stdout")
+		fmt.Fprintln(out, "    import FILE: add the records and triage history in a snapshot to the DB")
+		fmt.Fprintln(out, "    sample-cves [-n N] [-seed SEED] [-out FILE]: write a sample of the cvelist repo's CVE files, by year and triage outcome, as a txtar test fixture")
 		fmt.Fprintln(out, "flags:")
 		flag.PrintDefaults()
 	}
@@ -86,21 +228,105 @@ func main() {
 	if err := cfg.Validate(); err != nil {
 		dieWithUsage("%v", err)
 	}
+	if *logLevel != "" {
+		l, err := log.ParseLevel(*logLevel)
+		if err != nil {
+			dieWithUsage("%v", err)
+		}
+		log.SetLevel(l)
+	}
 
-	ctx := event.WithExporter(context.Background(),
-		event.NewExporter(log.NewLineHandler(os.Stderr), nil))
+	var h event.Handler = log.NewLineHandler(os.Stderr)
+	flushTraces := func(context.Context) error { return nil }
+	if cfg.OTLPEndpoint != "" && flag.NArg() > 0 {
+		// The server traces its requests itself.
+		var err error
+		h, flushTraces, err = observe.NewOTLPTraceHandler(h, cfg.OTLPEndpoint, "vuln-worker-cli")
+		if err != nil {
+			die("%v", err)
+		}
+	}
+	ctx := event.WithExporter(context.Background(), event.NewExporter(h, nil))
 	if img := os.Getenv("DOCKER_IMAGE"); img != "" {
 		log.Infof(ctx, "running in docker image %s", img)
 	}
 	log.Infof(ctx, "config: project=%s, namespace=%s, issueRepo=%s", cfg.Project, cfg.Namespace, cfg.IssueRepo)
 
-	var err error
-	cfg.Store, err = store.NewFireStore(ctx, cfg.Project, cfg.Namespace, "")
+	worker.SetUpdateParallelism(cfg.UpdateParallelism)
+	if cfg.CVEListRepoURL == "" {
+		cfg.CVEListRepoURL = cvelistrepo.URL
+	}
+	cloneOpts := gitrepo.CloneOptions{Depth: 1}
+	if cfg.RepoCacheDir != "" {
+		cloneOpts = gitrepo.CloneOptions{CacheDir: cfg.RepoCacheDir}
+	}
+	auth, err := gitrepo.AuthFromEnv(cfg.CVEListRepoURL)
 	if err != nil {
-		die("firestore: %v", err)
+		die("%v", err)
+	}
+	cloneOpts.Auth = auth
+	worker.SetCloneOptions(cloneOpts)
+	if cfg.TriageRulesFile != "" {
+		rules, err := worker.ReadTriageRuleFile(cfg.TriageRulesFile)
+		if err != nil {
+			die("%v", err)
+		}
+		worker.SetTriageRules(rules)
+	}
+	if cfg.TriageClassifier != "" {
+		c, err := worker.NewTriageClassifier(ctx, cfg.TriageClassifier, cfg.VulnDBRepo)
+		if err != nil {
+			die("%v", err)
+		}
+		worker.SetTriageClassifier(c)
+	}
+
+	switch {
+	case *sqliteFile != "":
+		cfg.Store, err = store.NewSQLiteStore(ctx, *sqliteFile)
+		if err != nil {
+			die("sqlite: %v", err)
+		}
+	case *pgDataSource != "":
+		cfg.Store, err = store.NewPGStore(ctx, *pgDataSource, cfg.Namespace)
+		if err != nil {
+			die("postgres: %v", err)
+		}
+	default:
+		if cfg.Project == "" {
+			dieWithUsage("missing project")
+		}
+		cfg.Store, err = store.NewFireStore(ctx, cfg.Project, cfg.Namespace, "")
+		if err != nil {
+			die("firestore: %v", err)
+		}
+	}
+	var dryRunStore *store.DryRunStore
+	if *dryRun {
+		if flag.NArg() == 0 {
+			dieWithUsage("-dry-run works only with a subcommand")
+		}
+		dryRunStore = store.NewDryRunStore(cfg.Store)
+		cfg.Store = dryRunStore
+	}
+	if (*cpuProfile != "" || *memProfile != "") && flag.NArg() == 0 {
+		dieWithUsage("-cpuprofile and -memprofile work only with a subcommand; the server serves profiles at /debug/pprof/")
 	}
 	if flag.NArg() > 0 {
+		stopProfiling, perr := startProfiling(*cpuProfile, *memProfile)
+		if perr != nil {
+			die("%v", perr)
+		}
 		err = runCommandLine(ctx)
+		if perr := stopProfiling(); perr != nil {
+			fmt.Fprintf(os.Stderr, "profiling: %v\n", perr)
+		}
+		if dryRunStore != nil {
+			printDryRunSummary(dryRunStore, err)
+		}
+		if ferr := flushTraces(context.Background()); ferr != nil {
+			fmt.Fprintf(os.Stderr, "sending traces: %v\n", ferr)
+		}
 	} else {
 		err = runServer(ctx)
 	}
@@ -113,33 +339,131 @@ func runServer(ctx context.Context) error {
 	if os.Getenv("PORT") == "" {
 		return errors.New("need PORT")
 	}
-	if _, err := worker.NewServer(ctx, cfg); err != nil {
+	if cfg.Project == "" {
+		return errors.New("missing project")
+	}
+	// Cloud Run sends SIGTERM before it stops an instance.
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGTERM, os.Interrupt)
+	defer stop()
+	s, err := worker.NewServer(ctx, cfg)
+	if err != nil {
 		return err
 	}
 	addr := ":" + os.Getenv("PORT")
+	srv := &http.Server{Addr: addr}
+	errc := make(chan error, 1)
+	go func() { errc <- srv.ListenAndServe() }()
 	log.Infof(ctx, "Listening on addr %s", addr)
-	return fmt.Errorf("listening: %v", http.ListenAndServe(addr, nil))
+	select {
+	case err := <-errc:
+		return fmt.Errorf("listening: %v", err)
+	case <-ctx.Done():
+	}
+	stop()
+
+	// Cancel the jobs in progress, so that they record where they stopped,
+	// and let the requests in progress finish, within the drain window.
+	log.Infof(ctx, "shutting down; draining for up to %s", cfg.DrainWindow)
+	sctx, cancel := context.WithTimeout(context.Background(), cfg.DrainWindow)
+	defer cancel()
+	jerr := s.Shutdown(sctx)
+	if err := srv.Shutdown(sctx); err != nil {
+		return fmt.Errorf("shutting down: %v", err)
+	}
+	if jerr != nil {
+		return jerr
+	}
+	log.Infof(ctx, "shut down")
+	return nil
 }
 
 const timeFormat = "2006/01/02 15:04:05"
 
 func runCommandLine(ctx context.Context) error {
+	ctx = store.WithActor(ctx, commandLineActor())
 	switch flag.Arg(0) {
 	case "list-updates":
 		return listUpdatesCommand(ctx)
+	case "status":
+		return statusCommand(ctx)
 	case "list-cves":
-		return listCVEsCommand(ctx, flag.Arg(1))
+		return listCVEsCommand(ctx, flag.Args()[1:])
+	case "list-ghsas":
+		return listGHSAsCommand(ctx, flag.Arg(1))
 	case "update":
 		if flag.NArg() != 2 {
 			return errors.New("usage: update COMMIT")
 		}
 		return updateCommand(ctx, flag.Arg(1))
+	case "update-ghsas":
+		return updateGHSAsCommand(ctx)
+	case "update-nvd":
+		if flag.NArg() > 2 {
+			return errors.New("usage: update-nvd [START]")
+		}
+		return updateNVDCommand(ctx, flag.Arg(1))
+	case "update-gitlab":
+		return updateGitLabCommand(ctx, flag.Args()[1:])
+	case "update-epss":
+		return updateEPSSCommand(ctx)
+	case "update-importers":
+		return updateImportersCommand(ctx)
+	case "update-osv":
+		return updateOSVCommand(ctx)
 	case "create-issues":
 		return createIssuesCommand(ctx)
+	case "reconcile":
+		return reconcileCommand(ctx, flag.Args()[1:])
+	case "report-gaps":
+		return reportGapsCommand(ctx, flag.Args()[1:])
+	case "check-depsdev":
+		return checkDepsDevCommand(ctx, flag.Args()[1:])
+	case "security-releases":
+		return securityReleasesCommand(ctx, flag.Args()[1:])
+	case "watch-unfixed":
+		return watchUnfixedCommand(ctx, flag.Args()[1:])
+	case "withdrawals":
+		return withdrawalsCommand(ctx, flag.Args()[1:])
+	case "embargoes":
+		return embargoesCommand(ctx, flag.Args()[1:])
+	case "triage-aging":
+		return triageAgingCommand(ctx)
+	case "triage-summary":
+		return triageSummaryCommand(ctx, flag.Args()[1:])
 	case "show":
 		return showCommand(ctx, flag.Args()[1:])
 	case "scan-modules":
 		return scanModulesCommand(ctx)
+	case "false-positive":
+		return falsePositiveCommand(ctx, flag.Args()[1:])
+	case "changed-cves":
+		return changedCVEsCommand(ctx)
+	case "ack-change":
+		return ackChangeCommand(ctx, flag.Args()[1:])
+	case "triage-history":
+		if flag.NArg() != 2 {
+			return errors.New("usage: triage-history ID")
+		}
+		return triageHistoryCommand(ctx, flag.Arg(1))
+	case "aliases":
+		if flag.NArg() != 2 {
+			return errors.New("usage: aliases ID")
+		}
+		return aliasesCommand(ctx, flag.Arg(1))
+	case "search":
+		if flag.NArg() < 2 {
+			return errors.New("usage: search WORD ...")
+		}
+		return searchCommand(ctx, strings.Join(flag.Args()[1:], " "))
+	case "export":
+		return exportCommand(ctx, flag.Args()[1:])
+	case "import":
+		if flag.NArg() != 2 {
+			return errors.New("usage: import FILE")
+		}
+		return importCommand(ctx, flag.Arg(1))
+	case "sample-cves":
+		return sampleCVEsCommand(ctx, flag.Args()[1:])
 	default:
 		return fmt.Errorf("unknown command: %q", flag.Arg(1))
 	}
@@ -160,6 +484,9 @@ func listUpdatesCommand(ctx context.Context) error {
 		if !r.EndedAt.IsZero() {
 			endTime = r.EndedAt.In(time.Local).Format(timeFormat)
 		}
+		if r.Interrupted {
+			endTime += " (interrupted)"
+		}
 		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d/%d (added %d, modified %d)\n",
 			r.StartedAt.In(time.Local).Format(timeFormat),
 			endTime,
@@ -170,29 +497,146 @@ func listUpdatesCommand(ctx context.Context) error {
 	return tw.Flush()
 }
 
-func listCVEsCommand(ctx context.Context, triageState string) error {
-	ts := store.TriageState(triageState)
-	if err := ts.Validate(); err != nil {
+func statusCommand(ctx context.Context) error {
+	ss, err := cfg.Store.ListSourceStatuses(ctx)
+	if err != nil {
 		return err
 	}
-	crs, err := cfg.Store.ListCVERecordsWithTriageState(ctx, ts)
-	if err != nil {
+	tw := tabwriter.NewWriter(os.Stdout, 1, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Source\tStart\tEnd\tProcessed\tAdded\tModified\tLast Success\tRuns\tFailures\tError\n")
+	for _, s := range ss {
+		endTime := "unfinished"
+		switch {
+		case s.Interrupted:
+			endTime = "interrupted"
+		case !s.EndedAt.IsZero():
+			endTime = s.EndedAt.In(time.Local).Format(timeFormat)
+		}
+		lastSuccess := "never"
+		if !s.LastSuccessAt.IsZero() {
+			lastSuccess = fmt.Sprintf("%s (took %s)", s.LastSuccessAt.In(time.Local).Format(timeFormat),
+				s.LastSuccessDuration.Round(time.Second))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%d\t%s\t%d\t%d\t%s\n",
+			s.Source,
+			s.StartedAt.In(time.Local).Format(timeFormat),
+			endTime,
+			s.NumProcessed, s.NumAdded, s.NumModified,
+			lastSuccess,
+			s.NumRuns, s.NumFailures,
+			s.Error)
+	}
+	return tw.Flush()
+}
+
+// commandLineActor returns the actor to which triage state changes made by
+// commands are attributed.
+func commandLineActor() string {
+	name := os.Getenv("USER")
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+	return "cli:" + name
+}
+
+// listCVEsPageSize is the number of CVE records that list-cves reads at a
+// time.
+const listCVEsPageSize = 1000
+
+func listCVEsCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("list-cves", flag.ContinueOnError)
+	year := fs.Int("year", 0, "list only CVEs from this year")
+	module := fs.String("module", "", "list only CVEs affecting this module")
+	since := fs.String("since", "", "list only CVEs changed at or after this time, in RFC 3339 format")
+	if err := fs.Parse(args); err != nil {
 		return err
 	}
+	if fs.NArg() > 1 {
+		return errors.New("usage: list-cves [-year YEAR] [-module MODULE] [-since TIME] [TRIAGE_STATE]")
+	}
+	q := store.CVERecordQuery{
+		TriageState: store.TriageState(fs.Arg(0)),
+		Year:        *year,
+		Module:      *module,
+		Limit:       listCVEsPageSize,
+	}
+	if q.TriageState != "" {
+		if err := q.TriageState.Validate(); err != nil {
+			return err
+		}
+	}
+	if *since != "" {
+		var err error
+		q.UpdatedSince, err = time.Parse(time.RFC3339, *since)
+		if err != nil {
+			return err
+		}
+	}
 	tw := tabwriter.NewWriter(os.Stdout, 1, 8, 2, ' ', 0)
 	fmt.Fprintf(tw, "ID\tCVEState\tCommit\tReason\tModule\tIssue\tIssue Created\n")
-	for i, r := range crs {
-		if *limit > 0 && i >= *limit {
+	n := 0
+	for {
+		crs, next, err := cfg.Store.ListCVERecords(ctx, q)
+		if err != nil {
+			return err
+		}
+		for _, r := range crs {
+			if *limit > 0 && n >= *limit {
+				return tw.Flush()
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				r.ID, r.CVEState, r.CommitHash, r.TriageStateReason, r.Module, r.IssueReference, worker.FormatTime(r.IssueCreatedAt))
+			n++
+		}
+		if next == "" {
+			return tw.Flush()
+		}
+		q.Cursor = next
+	}
+}
+
+func listGHSAsCommand(ctx context.Context, triageState string) error {
+	ts := store.TriageState(triageState)
+	if ts != "" {
+		if err := ts.Validate(); err != nil {
+			return err
+		}
+	}
+	var grs []*store.GHSARecord
+	err := cfg.Store.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		var err error
+		grs, err = tx.GetGHSARecords()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(grs, func(i, j int) bool { return grs[i].GHSA.ID < grs[j].GHSA.ID })
+	tw := tabwriter.NewWriter(os.Stdout, 1, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID\tTriageState\tReason\tAliases\tUpdated\tIssue\n")
+	n := 0
+	for _, r := range grs {
+		if ts != "" && r.TriageState != ts {
+			continue
+		}
+		if *limit > 0 && n >= *limit {
 			break
 		}
-		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
-			r.ID, r.CVEState, r.CommitHash, r.TriageStateReason, r.Module, r.IssueReference, worker.FormatTime(r.IssueCreatedAt))
+		n++
+		var aliases []string
+		for _, id := range r.GHSA.Identifiers {
+			if id.Type != "GHSA" {
+				aliases = append(aliases, id.Value)
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.GHSA.ID, r.TriageState, r.TriageStateReason, strings.Join(aliases, ","), worker.FormatTime(r.GHSA.UpdatedAt), r.IssueReference)
 	}
 	return tw.Flush()
 }
 
 func updateCommand(ctx context.Context, commitHash string) error {
-	repoPath := cvelistrepo.URL
+	repoPath := cfg.CVEListRepoURL
 	if *localRepoPath != "" {
 		repoPath = *localRepoPath
 	}
@@ -212,11 +656,96 @@ func updateCommand(ctx context.Context, commitHash string) error {
 		fmt.Printf("Missing GitHub access token; not updating GH security advisories.\n")
 		return nil
 	}
-	listSAs := func(ctx context.Context, since time.Time) ([]*ghsa.SecurityAdvisory, error) {
-		return ghsa.List(ctx, cfg.GitHubAccessToken, since)
+	return updateGHSAsCommand(ctx)
+}
+
+func updateGHSAsCommand(ctx context.Context) error {
+	if cfg.GitHubAccessToken == "" {
+		return errors.New("need -ghtokenfile")
+	}
+	gc := ghsa.NewClient(ctx, cfg.GitHubAccessToken, nil)
+	stats, err := worker.UpdateGHSAs(ctx, gc.List, cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("processed %d advisories: added %d, modified %d\n", stats.NumProcessed, stats.NumAdded, stats.NumModified)
+	return nil
+}
+
+func updateNVDCommand(ctx context.Context, startString string) error {
+	var start time.Time
+	if startString != "" {
+		var err error
+		start, err = time.Parse(time.RFC3339, startString)
+		if err != nil {
+			return err
+		}
+	}
+	if *knownModuleFile != "" {
+		if err := populateKnownModules(*knownModuleFile); err != nil {
+			return err
+		}
+	}
+	client := nvd.NewClient(nvd.DefaultBaseURL, cfg.NVDAPIKey)
+	stats, err := worker.UpdateCVEsFromNVD(ctx, client.ListModified, cfg.Store, pkgsiteURL, start)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("processed %d CVEs: added %d, modified %d\n", stats.NumProcessed, stats.NumAdded, stats.NumModified)
+	return nil
+}
+
+func updateGitLabCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("update-gitlab", flag.ContinueOnError)
+	repo := fs.String("repo", gitlab.URL, "URL or local path of the GitLab Advisory Database repo")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: update-gitlab [-repo PATH]")
+	}
+	if *knownModuleFile != "" {
+		if err := populateKnownModules(*knownModuleFile); err != nil {
+			return err
+		}
+	}
+	stats, err := worker.UpdateFromGitLab(ctx, *repo, cfg.Store, pkgsiteURL)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("processed %d advisories: added %d, modified %d, %d without CVEs\n",
+		stats.NumProcessed, stats.NumAdded, stats.NumModified, stats.NumWithoutCVE)
+	return nil
+}
+
+func updateEPSSCommand(ctx context.Context) error {
+	client := epss.NewClient(epss.DefaultBaseURL)
+	stats, err := worker.UpdateEPSS(ctx, client.Scores, cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("processed %d CVEs needing issues: %d scored, %d modified\n", stats.NumProcessed, stats.NumScored, stats.NumModified)
+	return nil
+}
+
+func updateImportersCommand(ctx context.Context) error {
+	client := depsdev.NewClient(depsdev.DefaultBaseURL)
+	stats, err := worker.UpdateImporters(ctx, client.Dependents, cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("processed %d CVEs needing issues: %d modules looked up, %d modified\n", stats.NumProcessed, stats.NumModules, stats.NumModified)
+	return nil
+}
+
+func updateOSVCommand(ctx context.Context) error {
+	client := osvdev.NewClient(osvdev.DefaultBaseURL)
+	stats, err := worker.UpdateOSV(ctx, client.Advisories, cfg.Store)
+	if err != nil {
+		return err
 	}
-	_, err = worker.UpdateGHSAs(ctx, listSAs, cfg.Store)
-	return err
+	fmt.Printf("processed %d CVEs needing issues: %d covered by Go reports, %d modified\n", stats.NumProcessed, stats.NumCovered, stats.NumModified)
+	return nil
 }
 
 func populateKnownModules(filename string) error {
@@ -243,26 +772,346 @@ func populateKnownModules(filename string) error {
 	return nil
 }
 
-func createIssuesCommand(ctx context.Context) error {
+// newIssueClient returns a client for the issues of the -issue-repo.
+func newIssueClient() (issues.Client, error) {
 	if cfg.IssueRepo == "" {
-		return errors.New("need -issue-repo")
+		return nil, errors.New("need -issue-repo")
 	}
 	if cfg.GitHubAccessToken == "" {
-		return errors.New("need -ghtokenfile")
+		return nil, errors.New("need -ghtokenfile")
 	}
 	owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
+	if err != nil {
+		return nil, err
+	}
+	return issues.NewGitHubClient(owner, repoName, cfg.GitHubAccessToken), nil
+}
+
+func createIssuesCommand(ctx context.Context) error {
+	client, err := newIssueClient()
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		dryRunIssues = issues.NewDryRunClient(client)
+		client = dryRunIssues
+	}
+	var reports []*worker.GoReport
+	if cfg.VulnDBRepo != "" {
+		reports, err = worker.LoadGoReports(ctx, cfg.VulnDBRepo)
+		if err != nil {
+			return err
+		}
+	}
+	return worker.CreateIssues(ctx, cfg.Store, client, reports, *limit)
+}
+
+func reconcileCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	repo := fs.String("repo", cfg.VulnDBRepo, "URL or local path of the vulndb repo (default -vulndb-repo)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: reconcile [-repo PATH]")
+	}
+	if *repo == "" {
+		return errors.New("need -repo or -vulndb-repo")
+	}
+	client, err := newIssueClient()
+	if err != nil {
+		return err
+	}
+	stats, err := worker.Reconcile(ctx, cfg.Store, client, *repo)
 	if err != nil {
 		return err
 	}
-	client := issues.NewGitHubClient(owner, repoName, cfg.GitHubAccessToken)
-	return worker.CreateIssues(ctx, cfg.Store, client, *limit)
+	fmt.Printf("checked %d records: %d moved to HasVuln, %d orphans\n", stats.NumChecked, stats.NumFixed, len(stats.Orphans))
+	for _, o := range stats.Orphans {
+		fmt.Printf("  %s\n", o)
+	}
+	return nil
+}
+
+func reportGapsCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("report-gaps", flag.ContinueOnError)
+	repo := fs.String("repo", cfg.VulnDBRepo, "URL or local path of the vulndb repo (default -vulndb-repo)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: report-gaps [-repo PATH]")
+	}
+	if *repo == "" {
+		return errors.New("need -repo or -vulndb-repo")
+	}
+	client := osvdev.NewClient(osvdev.DefaultBaseURL)
+	stats, err := worker.FindReportGaps(ctx, client.Vuln, *repo)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("checked %d reports: %d with gaps\n", stats.NumChecked, len(stats.Gaps))
+	for _, g := range stats.Gaps {
+		fmt.Printf("  %s\n", g)
+	}
+	return nil
+}
+
+func checkDepsDevCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("check-depsdev", flag.ContinueOnError)
+	repo := fs.String("repo", cfg.VulnDBRepo, "URL or local path of the vulndb repo (default -vulndb-repo)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: check-depsdev [-repo PATH]")
+	}
+	if *repo == "" {
+		return errors.New("need -repo or -vulndb-repo")
+	}
+	stats, err := worker.CheckDepsDev(ctx, depsdev.NewClient(depsdev.DefaultBaseURL), *repo, cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("checked %d modules, %d versions: %d discrepancies\n", stats.NumModules, stats.NumVersions, len(stats.Discrepancies))
+	for _, d := range stats.Discrepancies {
+		fmt.Printf("  %s\n", d)
+	}
+	return nil
+}
+
+func securityReleasesCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("security-releases", flag.ContinueOnError)
+	repo := fs.String("repo", cfg.VulnDBRepo, "URL or local path of the vulndb repo (default -vulndb-repo)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: security-releases [-repo PATH]")
+	}
+	if *repo == "" {
+		return errors.New("need -repo or -vulndb-repo")
+	}
+	client, err := newIssueClient()
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		dryRunIssues = issues.NewDryRunClient(client)
+		client = dryRunIssues
+	}
+	stats, err := worker.ScanSecurityReleases(ctx, worker.FetchReleaseHistory, cfg.Store, client, *repo)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("checked %d security releases: %d with missing reports, %d issues created\n",
+		stats.NumChecked, len(stats.Missing), stats.NumIssues)
+	for _, r := range stats.Missing {
+		fmt.Printf("  %s: %s\n", r.Release, strings.Join(r.Missing, ", "))
+	}
+	return nil
+}
+
+func watchUnfixedCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("watch-unfixed", flag.ContinueOnError)
+	repo := fs.String("repo", cfg.VulnDBRepo, "URL or local path of the vulndb repo (default -vulndb-repo)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: watch-unfixed [-repo PATH]")
+	}
+	if *repo == "" {
+		return errors.New("need -repo or -vulndb-repo")
+	}
+	client, err := newIssueClient()
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		dryRunIssues = issues.NewDryRunClient(client)
+		client = dryRunIssues
+	}
+	stats, err := worker.WatchUnfixedModules(ctx, worker.ProxyLatestVersion, cfg.Store, client, *repo)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("checked %d modules: %d flagged, %d issues created, %d commented on\n",
+		stats.NumChecked, len(stats.Flagged), stats.NumIssues, stats.NumComments)
+	for _, u := range stats.Flagged {
+		fmt.Printf("  %s: %s@%s\n", u.ReportID, u.Module, u.FlaggedVersion)
+	}
+	return nil
+}
+
+func withdrawalsCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("withdrawals", flag.ContinueOnError)
+	repo := fs.String("repo", cfg.VulnDBRepo, "URL or local path of the vulndb repo (default -vulndb-repo)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: withdrawals [-repo PATH]")
+	}
+	if *repo == "" {
+		return errors.New("need -repo or -vulndb-repo")
+	}
+	client, err := newIssueClient()
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		dryRunIssues = issues.NewDryRunClient(client)
+		client = dryRunIssues
+	}
+	stats, err := worker.CheckWithdrawals(ctx, cfg.Store, client, *repo)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("checked %d CVEs: %d reports and %d issues flagged, %d issues created, %d commented on\n",
+		stats.NumChecked, len(stats.Reports), len(stats.Issues), stats.NumIssues, stats.NumComments)
+	for _, id := range append(append([]string{}, stats.Reports...), stats.Issues...) {
+		fmt.Printf("  %s\n", id)
+	}
+	return nil
+}
+
+func embargoesCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("embargoes", flag.ContinueOnError)
+	repo := fs.String("repo", cfg.VulnDBRepo, "local path of the vulndb repo (default -vulndb-repo)")
+	overlay := fs.String("overlay", cfg.OverlayDir, "local path of the overlay repo (default -overlay-dir)")
+	db := fs.String("db", cfg.DBDir, "directory of the database (default -db-dir)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: embargoes [-repo DIR] [-overlay DIR] [-db DIR]")
+	}
+	if *repo == "" || *overlay == "" || *db == "" {
+		return errors.New("need the vulndb repo, overlay and database directories")
+	}
+	if *dryRun {
+		// The database is written outside the store.
+		return errors.New("embargoes does not support -dry-run")
+	}
+	key, err := cfg.SigningKey()
+	if err != nil {
+		return err
+	}
+	stats, err := worker.PublishEmbargoed(ctx, cfg.Store, *repo, *overlay, *db, key)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d reports embargoed; regenerated the database: %t\n", stats.NumEmbargoed, stats.Regenerated)
+	for _, id := range stats.Published {
+		fmt.Printf("  published %s\n", id)
+	}
+	for _, id := range stats.Unpublished {
+		fmt.Printf("  unpublished %s\n", id)
+	}
+	return nil
+}
+
+func triageAgingCommand(ctx context.Context) error {
+	stats, err := worker.CheckTriageAging(ctx, cfg.Store, false)
+	if err != nil {
+		return err
+	}
+	for _, q := range []struct {
+		name string
+		age  worker.QueueAge
+	}{
+		{"need issues", stats.NeedsIssue},
+		{"have issues without reports", stats.IssueOpen},
+	} {
+		fmt.Printf("%d %s, oldest for %s; %d overdue\n", q.age.Size, q.name, q.age.Oldest.Round(time.Hour), len(q.age.Overdue))
+		for _, id := range q.age.Overdue {
+			fmt.Printf("  %s\n", id)
+		}
+	}
+	return nil
+}
+
+func triageSummaryCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("triage-summary", flag.ContinueOnError)
+	number := fs.Int("issue", cfg.SummaryIssue, "number of the issue to comment on (default -summary-issue)")
+	repo := fs.String("repo", cfg.VulnDBRepo, "URL or local path of the vulndb repo, for the published reports (default -vulndb-repo)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: triage-summary [-issue N] [-repo PATH]")
+	}
+	if *number == 0 {
+		return errors.New("need -issue or -summary-issue")
+	}
+	client, err := newIssueClient()
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		dryRunIssues = issues.NewDryRunClient(client)
+		client = dryRunIssues
+	}
+	sum, err := worker.PostTriageSummary(ctx, cfg.Store, client, *number, *repo)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("posted on %s:\n\n%s", client.Reference(*number), sum.Markdown())
+	return nil
+}
+
+// dryRunIssues holds the issues and comments that create-issues and the
+// other subcommands that write issues would have created, with -dry-run.
+var dryRunIssues *issues.DryRunClient
+
+// printDryRunSummary prints the writes and issues that a subcommand run with
+// -dry-run would have made, up to the error that stopped it, if any.
+func printDryRunSummary(st *store.DryRunStore, err error) {
+	fmt.Println("Dry run: nothing was written or created.")
+	if err != nil {
+		fmt.Println("The subcommand failed; these are the changes before the failure.")
+	}
+	if err := st.WriteSummary(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "writing summary: %v\n", err)
+	}
+	if dryRunIssues == nil {
+		return
+	}
+	for _, iss := range dryRunIssues.Created() {
+		fmt.Printf("+ issue %q", iss.Title)
+		if len(iss.Labels) > 0 {
+			fmt.Printf(" [%s]", strings.Join(iss.Labels, ", "))
+		}
+		fmt.Println()
+	}
+	for _, c := range dryRunIssues.Commented() {
+		fmt.Printf("+ comment on %s\n", dryRunIssues.Reference(c.Number))
+	}
 }
 
 func showCommand(ctx context.Context, ids []string) error {
 	for _, id := range ids {
-		r, err := cfg.Store.GetCVERecord(ctx, id)
-		if err != nil {
-			return err
+		var r any
+		if strings.HasPrefix(id, "GHSA-") {
+			err := cfg.Store.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+				gr, err := tx.GetGHSARecord(id)
+				if gr != nil {
+					r = gr
+				}
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			cr, err := cfg.Store.GetCVERecord(ctx, id)
+			if err != nil {
+				return err
+			}
+			if cr != nil {
+				r = cr
+			}
 		}
 		if r == nil {
 			fmt.Printf("%s not found\n", id)
@@ -278,6 +1127,146 @@ func showCommand(ctx context.Context, ids []string) error {
 	return nil
 }
 
+func triageHistoryCommand(ctx context.Context, id string) error {
+	es, err := cfg.Store.ListTriageHistory(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(es) == 0 {
+		fmt.Printf("no triage history for %s\n", id)
+		return nil
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 1, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Time\tActor\tOld State\tNew State\tReason\n")
+	for _, e := range es {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			worker.FormatTime(e.Time), e.Actor, e.OldState, e.NewState, e.Reason)
+	}
+	return tw.Flush()
+}
+
+func aliasesCommand(ctx context.Context, id string) error {
+	aliases, err := cfg.Store.GetAliases(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(aliases) == 0 {
+		fmt.Printf("no aliases for %s\n", id)
+		return nil
+	}
+	for _, a := range aliases {
+		fmt.Println(a)
+	}
+	return nil
+}
+
+func searchCommand(ctx context.Context, query string) error {
+	ids, err := worker.Search(ctx, cfg.Store, query, *limit)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		fmt.Printf("nothing matches %q\n", query)
+		return nil
+	}
+	for _, id := range ids {
+		fmt.Println(id)
+	}
+	return nil
+}
+
+func exportCommand(ctx context.Context, args []string) (err error) {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	out := fs.String("out", "", "file to write the snapshot to, compressed with gzip if it ends in .gz (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("usage: export [-out FILE]")
+	}
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		w = f
+		if strings.HasSuffix(*out, ".gz") {
+			zw := gzip.NewWriter(f)
+			defer func() {
+				if cerr := zw.Close(); err == nil {
+					err = cerr
+				}
+			}()
+			w = zw
+		}
+	}
+	stats, err := store.Export(ctx, cfg.Store, w)
+	if err != nil {
+		return err
+	}
+	log.Infof(ctx, "exported %s", stats)
+	return nil
+}
+
+func importCommand(ctx context.Context, filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if strings.HasSuffix(filename, ".gz") {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	}
+	stats, err := store.Import(ctx, cfg.Store, r)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("imported %s\n", stats)
+	return nil
+}
+
+func sampleCVEsCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("sample-cves", flag.ContinueOnError)
+	n := fs.Int("n", 200, "number of CVE files to sample")
+	seed := fs.Int64("seed", 1, "seed of the random choice of files")
+	out := fs.String("out", "", "file to write the txtar archive to (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || *n <= 0 {
+		return errors.New("usage: sample-cves [-n N] [-seed SEED] [-out FILE]")
+	}
+	repoPath := cfg.CVEListRepoURL
+	if *localRepoPath != "" {
+		repoPath = *localRepoPath
+	}
+	ar, err := worker.SampleCVEs(ctx, repoPath, cfg.Store, *n, *seed)
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(txtar.Format(ar))
+		return err
+	}
+	if err := os.WriteFile(*out, txtar.Format(ar), 0644); err != nil {
+		return err
+	}
+	log.Infof(ctx, "wrote %d CVE files to %s", len(ar.Files), *out)
+	return nil
+}
+
 func scanModulesCommand(ctx context.Context) error {
 	return worker.ScanModules(ctx, cfg.Store, *force)
 }
@@ -294,3 +1283,94 @@ func dieWithUsage(format string, args ...interface{}) {
 	flag.Usage()
 	os.Exit(1)
 }
+
+func changedCVEsCommand(ctx context.Context) error {
+	crs, err := worker.ChangedCVEs(ctx, cfg.Store)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 1, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID\tTriage State\tChanged\tChange\n")
+	for _, cr := range crs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", cr.ID, cr.TriageState, cr.Change.CommitTime.Format(time.RFC3339), cr.Change.Summary())
+	}
+	return tw.Flush()
+}
+
+func ackChangeCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("ack-change", flag.ContinueOnError)
+	reason := fs.String("reason", "", "why the changes don't change the triage (required)")
+	// Allow flags to appear before or after the IDs.
+	var ids []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		ids = append(ids, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(ids) == 0 || *reason == "" {
+		return errors.New("usage: ack-change -reason REASON ID1 ID2 ...")
+	}
+	for _, id := range ids {
+		if _, err := worker.AcknowledgeCVEChange(ctx, cfg.Store, id, *reason); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("acknowledged the changes to %d CVEs\n", len(ids))
+	return nil
+}
+
+func falsePositiveCommand(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("false-positive", flag.ContinueOnError)
+	reason := fs.String("reason", "", "why the CVEs are false positives (required)")
+	file := fs.String("file", worker.FalsePositivesFile, "false-positives file to append to")
+	// Allow flags to appear before or after the IDs.
+	var ids []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		ids = append(ids, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(ids) == 0 || *reason == "" {
+		return errors.New("usage: false-positive -reason REASON ID1 ID2 ...")
+	}
+
+	// Check the file first, so we don't change the store if we can't
+	// record the decision.
+	existing, err := worker.ReadFalsePositives(*file)
+	if err != nil {
+		return err
+	}
+	inFile := map[string]bool{}
+	for _, cr := range existing {
+		inFile[cr.ID] = true
+	}
+	for _, id := range ids {
+		if inFile[id] {
+			return fmt.Errorf("%s is already in %s", id, *file)
+		}
+	}
+
+	var crs []*store.CVERecord
+	for _, id := range ids {
+		cr, err := worker.MarkFalsePositive(ctx, cfg.Store, id, *reason)
+		if err != nil {
+			return err
+		}
+		crs = append(crs, cr)
+	}
+	if err := worker.AppendFalsePositives(*file, crs...); err != nil {
+		return err
+	}
+	fmt.Printf("marked %d CVEs as false positives and added them to %s\n", len(crs), *file)
+	return nil
+}
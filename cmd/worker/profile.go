@@ -0,0 +1,56 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+
+	// Serve profiles of the server at /debug/pprof/, next to /debug/log-level.
+	_ "net/http/pprof"
+)
+
+// startProfiling starts writing a CPU profile to the file cpuFile, if it is
+// not empty. It returns a function that stops the CPU profile, and writes a
+// heap profile to the file memFile, if it is not empty.
+func startProfiling(cpuFile, memFile string) (stop func() error, err error) {
+	stopCPU := func() error { return nil }
+	if cpuFile != "" {
+		f, err := os.Create(cpuFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("starting CPU profile: %v", err)
+		}
+		stopCPU = func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		}
+	}
+	return func() error {
+		if err := stopCPU(); err != nil {
+			return err
+		}
+		if memFile == "" {
+			return nil
+		}
+		f, err := os.Create(memFile)
+		if err != nil {
+			return err
+		}
+		// Report the heap as of the last GC, without the garbage of the
+		// command.
+		runtime.GC()
+		if err := pprof.WriteHeapProfile(f); err != nil {
+			f.Close()
+			return fmt.Errorf("writing heap profile: %v", err)
+		}
+		return f.Close()
+	}, nil
+}
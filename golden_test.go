@@ -0,0 +1,124 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17 && !windows
+// +build go1.17,!windows
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/database"
+	"golang.org/x/vulndb/internal/report"
+)
+
+var update = flag.Bool("update", false, "update the golden files of TestGolden from the reports")
+
+const (
+	osvDir = "data/osv"
+	cveDir = "data/cve/v5"
+)
+
+// TestGolden checks that the OSV entry and CVE JSON 5.0 record that each
+// report in data/reports renders to are the same, byte for byte, as the ones
+// in data/osv and data/cve/v5, so that a change to either format shows up as
+// a diff of those files. Only the reports whose CVEs the Go CNA issues, and
+// that have what a CVE JSON 5.0 record needs, have CVE records.
+//
+// Run with -update to rewrite the golden files to match, and remove the CVE
+// records that no report renders to.
+func TestGolden(t *testing.T) {
+	reports, err := filepath.Glob(filepath.Join(reportsDir, "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(reports)
+	want := map[string]bool{}
+	for _, filename := range reports {
+		r, err := report.Read(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+		if r.Excluded == "" {
+			entry := database.GenerateOSVEntry(filename, time.Time{}, r)
+			checkGolden(t, filepath.Join(osvDir, entry.ID+".json"), entry)
+			want[filepath.Join(osvDir, entry.ID+".json")] = true
+		}
+		if r.CVEMetadata != nil {
+			record, err := r.ToCVE5()
+			if err != nil {
+				// The report can't have a record, like those whose CVEs
+				// were published before cve_metadata had descriptions. If
+				// it used to have one, its golden file is left over.
+				continue
+			}
+			checkGolden(t, filepath.Join(cveDir, id+".json"), record)
+			want[filepath.Join(cveDir, id+".json")] = true
+		}
+	}
+
+	for _, dir := range []string{osvDir, cveDir} {
+		files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range files {
+			switch {
+			case want[f]:
+			case dir == osvDir:
+				// OSV entries are published, so they are never removed.
+				t.Errorf("%s: no report renders to it (withdraw reports instead of deleting them)", f)
+			case *update:
+				if err := os.Remove(f); err != nil {
+					t.Fatal(err)
+				}
+			default:
+				t.Errorf("%s: no report renders to it (run with -update to remove it)", f)
+			}
+		}
+	}
+}
+
+// checkGolden checks that v, encoded as vulnreport writes it, is the same as
+// the contents of the file filename, or if -update is set, writes it there.
+func checkGolden(t *testing.T, filename string, v any) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := database.WriteJSON(filename, v, true); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	got, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Errorf("%s does not exist (run with -update to create it)", filename)
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		diff := cmp.Diff(strings.Split(string(want), "\n"), strings.Split(string(got), "\n"))
+		t.Errorf("%s does not match its report (-golden, +rendered; run with -update to update it):\n%s", filename, diff)
+	}
+}
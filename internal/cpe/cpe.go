@@ -0,0 +1,200 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cpe parses Common Platform Enumeration (CPE) names, which the NVD
+// uses to identify the products affected by a CVE.
+// See https://nvlpubs.nist.gov/nistpubs/Legacy/IR/nistir7695.pdf.
+package cpe
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Special values of the attributes of a Name.
+const (
+	// Any matches any value.
+	Any = "*"
+	// NA means that the attribute does not apply.
+	NA = "-"
+)
+
+// A Name is a parsed CPE name. Its attributes are unescaped: "\." in a
+// formatted string becomes ".", for example. Attributes that the name
+// leaves out are Any.
+type Name struct {
+	// Part is "a" for applications, "o" for operating systems and "h" for
+	// hardware.
+	Part      string
+	Vendor    string
+	Product   string
+	Version   string
+	Update    string
+	Edition   string
+	Language  string
+	SWEdition string
+	// TargetSW is the software environment of the product, like "go" or
+	// "node.js".
+	TargetSW string
+	TargetHW string
+	Other    string
+}
+
+// Parse parses a CPE 2.3 formatted string, like
+// "cpe:2.3:a:golang:go:1.17:*:*:*:*:*:*:*", or a CPE 2.2 URI, like
+// "cpe:/a:golang:go:1.17".
+func Parse(s string) (*Name, error) {
+	switch {
+	case strings.HasPrefix(s, "cpe:2.3:"):
+		return parseFormatted(s)
+	case strings.HasPrefix(s, "cpe:/"):
+		return parseURI(s)
+	}
+	return nil, fmt.Errorf("%q is not a CPE name", s)
+}
+
+// parseFormatted parses a CPE 2.3 formatted string.
+func parseFormatted(s string) (*Name, error) {
+	attrs, err := splitFormatted(strings.TrimPrefix(s, "cpe:2.3:"))
+	if err != nil {
+		return nil, fmt.Errorf("CPE %q: %v", s, err)
+	}
+	if len(attrs) != 11 {
+		return nil, fmt.Errorf("CPE %q: got %d attributes, want 11", s, len(attrs))
+	}
+	n := newName(attrs)
+	if err := n.checkPart(); err != nil {
+		return nil, fmt.Errorf("CPE %q: %v", s, err)
+	}
+	return n, nil
+}
+
+// splitFormatted splits the attributes of a formatted string at unescaped
+// colons, and unescapes them.
+func splitFormatted(s string) ([]string, error) {
+	var (
+		attrs []string
+		b     strings.Builder
+	)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 == len(s) {
+				return nil, errors.New("ends in an escape")
+			}
+			i++
+			b.WriteByte(s[i])
+		case ':':
+			attrs = append(attrs, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return append(attrs, b.String()), nil
+}
+
+// parseURI parses a CPE 2.2 URI, whose attributes after the language may be
+// packed into the edition, as "~edition~sw_edition~target_sw~target_hw~other".
+func parseURI(s string) (*Name, error) {
+	parts := strings.Split(strings.TrimPrefix(s, "cpe:/"), ":")
+	if len(parts) > 7 {
+		return nil, fmt.Errorf("CPE %q: got %d components, want at most 7", s, len(parts))
+	}
+	attrs := make([]string, 11)
+	for i, p := range parts {
+		v, err := url.PathUnescape(p)
+		if err != nil {
+			return nil, fmt.Errorf("CPE %q: %v", s, err)
+		}
+		switch {
+		case i == 5 && strings.HasPrefix(v, "~"):
+			packed := strings.Split(v[1:], "~")
+			if len(packed) != 5 {
+				return nil, fmt.Errorf("CPE %q: packed edition %q does not have 5 attributes", s, v)
+			}
+			attrs[5] = packed[0]
+			attrs[7], attrs[8], attrs[9], attrs[10] = packed[1], packed[2], packed[3], packed[4]
+		default:
+			attrs[i] = v
+		}
+	}
+	for i, a := range attrs {
+		if a == "" {
+			// Empty URI components match anything.
+			attrs[i] = Any
+		}
+	}
+	n := newName(attrs)
+	if err := n.checkPart(); err != nil {
+		return nil, fmt.Errorf("CPE %q: %v", s, err)
+	}
+	return n, nil
+}
+
+func newName(attrs []string) *Name {
+	return &Name{
+		Part:      attrs[0],
+		Vendor:    attrs[1],
+		Product:   attrs[2],
+		Version:   attrs[3],
+		Update:    attrs[4],
+		Edition:   attrs[5],
+		Language:  attrs[6],
+		SWEdition: attrs[7],
+		TargetSW:  attrs[8],
+		TargetHW:  attrs[9],
+		Other:     attrs[10],
+	}
+}
+
+func (n *Name) checkPart() error {
+	switch n.Part {
+	case "a", "o", "h", Any:
+		return nil
+	}
+	return fmt.Errorf("bad part %q", n.Part)
+}
+
+// String returns n as a CPE 2.3 formatted string.
+func (n *Name) String() string {
+	attrs := []string{
+		n.Part, n.Vendor, n.Product, n.Version, n.Update, n.Edition,
+		n.Language, n.SWEdition, n.TargetSW, n.TargetHW, n.Other,
+	}
+	for i, a := range attrs {
+		attrs[i] = escape(a)
+	}
+	return "cpe:2.3:" + strings.Join(attrs, ":")
+}
+
+// escape escapes the punctuation in an attribute of a formatted string,
+// other than hyphens, periods and underscores, which formatted strings leave
+// unescaped.
+func escape(a string) string {
+	switch a {
+	case "":
+		return Any
+	case Any, NA:
+		return a
+	}
+	var b strings.Builder
+	for i := 0; i < len(a); i++ {
+		c := a[i]
+		isAlnum := c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+		if !isAlnum && c != '_' && c != '.' && c != '-' && c < 0x80 {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// IsApplication reports whether n names an application, as opposed to an
+// operating system or hardware.
+func (n *Name) IsApplication() bool {
+	return n.Part == "a"
+}
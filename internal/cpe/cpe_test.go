@@ -0,0 +1,85 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpe
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want *Name
+	}{
+		{
+			"cpe:2.3:a:golang:go:1.17.1:*:*:*:*:*:*:*",
+			&Name{Part: "a", Vendor: "golang", Product: "go", Version: "1.17.1",
+				Update: Any, Edition: Any, Language: Any, SWEdition: Any, TargetSW: Any, TargetHW: Any, Other: Any},
+		},
+		{
+			"cpe:2.3:a:gin-gonic:gin:*:*:*:*:*:go:*:*",
+			&Name{Part: "a", Vendor: "gin-gonic", Product: "gin", Version: Any,
+				Update: Any, Edition: Any, Language: Any, SWEdition: Any, TargetSW: "go", TargetHW: Any, Other: Any},
+		},
+		{
+			`cpe:2.3:a:example\:corp:widget\!:1\.0:-:*:*:*:*:*:*`,
+			&Name{Part: "a", Vendor: "example:corp", Product: "widget!", Version: "1.0",
+				Update: NA, Edition: Any, Language: Any, SWEdition: Any, TargetSW: Any, TargetHW: Any, Other: Any},
+		},
+		{
+			"cpe:/a:golang:go:1.17",
+			&Name{Part: "a", Vendor: "golang", Product: "go", Version: "1.17",
+				Update: Any, Edition: Any, Language: Any, SWEdition: Any, TargetSW: Any, TargetHW: Any, Other: Any},
+		},
+		{
+			"cpe:/a:hashicorp:vault%21:1.2:beta:~~~go~~:en",
+			&Name{Part: "a", Vendor: "hashicorp", Product: "vault!", Version: "1.2",
+				Update: "beta", Edition: Any, Language: "en", SWEdition: Any, TargetSW: "go", TargetHW: Any, Other: Any},
+		},
+	} {
+		got, err := Parse(test.in)
+		if err != nil {
+			t.Errorf("%s: %v", test.in, err)
+			continue
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%s: mismatch (-want, +got):\n%s", test.in, diff)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"golang:go",
+		"cpe:2.3:a:golang:go",
+		"cpe:2.3:x:golang:go:*:*:*:*:*:*:*:*",
+		`cpe:2.3:a:golang:go:*:*:*:*:*:*:*:\`,
+		"cpe:/a:golang:go:1:2:3:4:5",
+		"cpe:/a:golang:go:1:2:~a~b",
+	} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("%q: got no error, want one", in)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	for _, in := range []string{
+		"cpe:2.3:a:golang:go:1.17.1:*:*:*:*:*:*:*",
+		"cpe:2.3:a:gin-gonic:gin:*:-:*:*:*:go:*:*",
+		`cpe:2.3:a:example\:corp:widget\!:1.0:*:*:*:*:*:*:*`,
+	} {
+		n, err := Parse(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := n.String(); got != in {
+			t.Errorf("got %q, want %q", got, in)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cron parses cron expressions and computes the times they match.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Schedule is a parsed cron expression.
+type Schedule struct {
+	spec string
+	// Bit i of each set is on if the field matches i.
+	minute, hour, dom, month, dow uint64
+	// If both days of the month and of the week are restricted, a day
+	// matches if either does, as in crontab(5).
+	domStar, dowStar bool
+}
+
+// descriptors are the supported shorthands for expressions.
+var descriptors = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+// Parse parses a cron expression with five fields: minute, hour, day of the
+// month, month and day of the week. Each field is "*", a number, a range
+// like "1-5", or a comma-separated list of them, and a number or range can
+// be followed by a step like "/15". Sunday is day 0 or 7 of the week. Parse
+// also accepts @hourly, @daily, @weekly and @monthly.
+func Parse(spec string) (*Schedule, error) {
+	expr := spec
+	if d, ok := descriptors[spec]; ok {
+		expr = d
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q: want 5 fields, got %d", spec, len(fields))
+	}
+	s := &Schedule{
+		spec:    spec,
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}
+	for i, f := range []struct {
+		name     string
+		min, max int
+		bits     *uint64
+	}{
+		{"minute", 0, 59, &s.minute},
+		{"hour", 0, 23, &s.hour},
+		{"day of month", 1, 31, &s.dom},
+		{"month", 1, 12, &s.month},
+		{"day of week", 0, 7, &s.dow},
+	} {
+		bits, err := parseField(fields[i], f.min, f.max)
+		if err != nil {
+			return nil, fmt.Errorf("cron expression %q: %s: %v", spec, f.name, err)
+		}
+		*f.bits = bits
+	}
+	// Sunday is both 0 and 7.
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+// parseField parses a field whose values are between min and max.
+func parseField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("bad step %q", stepStr)
+			}
+		}
+		lo, hi := min, max
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = parseValue(loStr, min, max); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = parseValue(hiStr, min, max); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				// "N/S" means from N to the maximum.
+				hi = max
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("bad range %q", rng)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(s string, min, max int) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("bad value %q", s)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, min, max)
+	}
+	return v, nil
+}
+
+// String returns the expression that s was parsed from.
+func (s *Schedule) String() string {
+	return s.spec
+}
+
+// maxSearch bounds the search for the next matching time, for expressions
+// like "0 0 31 2 *" that never match.
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+// Next returns the first time after t, to the minute, that s matches, in
+// t's location. It returns the zero time if there is none within five
+// years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxSearch)
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) matchDay(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	// A Wednesday.
+	start := time.Date(2022, time.October, 12, 10, 17, 30, 0, time.UTC)
+	for _, test := range []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2022, time.October, 12, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2022, time.October, 12, 10, 30, 0, 0, time.UTC)},
+		{"5,20 * * * *", time.Date(2022, time.October, 12, 10, 20, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2022, time.October, 12, 13, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2022, time.October, 12, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2022, time.October, 13, 0, 0, 0, 0, time.UTC)},
+		{"30 2 * * 1-5", time.Date(2022, time.October, 13, 2, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2022, time.October, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// Either the day of the month or of the week matches.
+		{"0 0 1 * 5", time.Date(2022, time.October, 14, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+	} {
+		s, err := Parse(test.spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := s.Next(start); !got.Equal(test.want) {
+			t.Errorf("%q: got %s, want %s", test.spec, got, test.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@yearly",
+	} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("%q: got nil error, want error", spec)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -46,6 +47,11 @@ type Config struct {
 	Key string
 	// User is the username for the account that is making API calls. Required.
 	User string
+	// DryRun, if true, makes the client skip the API calls that change
+	// anything: ReserveIDs reserves no IDs, and CreateRecord and UpdateRecord
+	// return the record that they would have published. Other calls are made
+	// as usual.
+	DryRun bool
 }
 
 // New returns an initialized client configured via cfg.
@@ -53,6 +59,43 @@ func New(cfg Config) *Client {
 	return &Client{cfg, http.DefaultClient}
 }
 
+// The environment variables from which ConfigFromEnv reads a Config.
+const (
+	EnvKey      = "CVE_API_KEY"
+	EnvUser     = "CVE_API_USER"
+	EnvOrg      = "CVE_API_ORG"
+	EnvEndpoint = "CVE_API_ENDPOINT"
+)
+
+// DefaultOrg is the organization of the Go CNA.
+const DefaultOrg = "Go"
+
+// ConfigFromEnv returns a Config with the credentials in the environment
+// variables EnvKey and EnvUser, which are required, and the organization and
+// endpoint in EnvOrg and EnvEndpoint, which default to DefaultOrg and
+// ProdEndpoint.
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Endpoint: os.Getenv(EnvEndpoint),
+		Org:      os.Getenv(EnvOrg),
+		Key:      os.Getenv(EnvKey),
+		User:     os.Getenv(EnvUser),
+	}
+	if cfg.Key == "" {
+		return Config{}, fmt.Errorf("the CVE API key must be set in the environment variable %s", EnvKey)
+	}
+	if cfg.User == "" {
+		return Config{}, fmt.Errorf("the CVE API user must be set in the environment variable %s", EnvUser)
+	}
+	if cfg.Org == "" {
+		cfg.Org = DefaultOrg
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = ProdEndpoint
+	}
+	return cfg, nil
+}
+
 // AssignedCVE contains information about an assigned CVE.
 type AssignedCVE struct {
 	ID          string      `json:"cve_id"`
@@ -149,6 +192,9 @@ type reserveIDsResponse struct {
 // There may be fewer IDs than requested if, for example, the organization's
 // quota is reached.
 func (c *Client) ReserveIDs(opts ReserveOptions) (AssignedCVEList, error) {
+	if c.DryRun {
+		return nil, nil
+	}
 	req, err := c.createReserveIDsRequest(opts)
 	if err != nil {
 		return nil, err
@@ -200,7 +246,11 @@ type createResponse struct {
 	Created cveschema5.CVERecord `json:"created"`
 }
 
+// CreateRecord publishes a new CVE record for the reserved CVE ID id.
 func (c *Client) CreateRecord(id string, record *cveschema5.Containers) (*cveschema5.CVERecord, error) {
+	if c.DryRun {
+		return dryRunRecord(id, record), nil
+	}
 	requestBody := recordRequestBody{
 		CNAContainer: record.CNAContainer,
 	}
@@ -216,7 +266,11 @@ type updateResponse struct {
 	Updated cveschema5.CVERecord `json:"updated"`
 }
 
+// UpdateRecord replaces the published CVE record for id.
 func (c *Client) UpdateRecord(id string, record *cveschema5.Containers) (*cveschema5.CVERecord, error) {
+	if c.DryRun {
+		return dryRunRecord(id, record), nil
+	}
 	requestBody := recordRequestBody{
 		CNAContainer: record.CNAContainer,
 	}
@@ -228,6 +282,20 @@ func (c *Client) UpdateRecord(id string, record *cveschema5.Containers) (*cvesch
 	return &response.Updated, nil
 }
 
+// dryRunRecord returns the record that creating or updating the record for
+// id would publish, as far as the client knows.
+func dryRunRecord(id string, record *cveschema5.Containers) *cveschema5.CVERecord {
+	return &cveschema5.CVERecord{
+		DataType:    "CVE_RECORD",
+		DataVersion: "5.0",
+		Metadata: cveschema5.Metadata{
+			ID:    id,
+			State: cveschema5.StatePublished,
+		},
+		Containers: *record,
+	}
+}
+
 type Org struct {
 	Name      string `json:"name"`
 	ShortName string `json:"short_name"`
@@ -16,6 +16,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"golang.org/x/vulndb/internal/cveschema"
 	"golang.org/x/vulndb/internal/cveschema5"
 )
@@ -494,3 +495,59 @@ func TestListOrgCVEsMultiPage(t *testing.T) {
 		t.Errorf("got %v, want %v", got, want)
 	}
 }
+
+func TestDryRun(t *testing.T) {
+	c, s := newTestClientAndServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("dry run sent request %s %s", r.Method, r.URL)
+	})
+	defer s.Close()
+	c.DryRun = true
+
+	cves, err := c.ReserveIDs(ReserveOptions{NumIDs: 1, Year: 2022})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cves) != 0 {
+		t.Errorf("ReserveIDs: got %v, want none", cves)
+	}
+	record := getDefaultTestCVERecord(t)
+	for _, publish := range []func(string, *cveschema5.Containers) (*cveschema5.CVERecord, error){
+		c.CreateRecord, c.UpdateRecord,
+	} {
+		got, err := publish(defaultTestCVEID, &record.Containers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Metadata.ID != defaultTestCVEID {
+			t.Errorf("got ID %s, want %s", got.Metadata.ID, defaultTestCVEID)
+		}
+		if diff := cmp.Diff(record.Containers, got.Containers); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv(EnvKey, testApiKey)
+	t.Setenv(EnvUser, testApiUser)
+	t.Setenv(EnvOrg, "")
+	t.Setenv(EnvEndpoint, "")
+	got, err := ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Config{
+		Endpoint: ProdEndpoint,
+		Org:      DefaultOrg,
+		Key:      testApiKey,
+		User:     testApiUser,
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	t.Setenv(EnvKey, "")
+	if _, err := ConfigFromEnv(); err == nil {
+		t.Error("got nil error with no key, want error")
+	}
+}
@@ -157,3 +157,22 @@ func ParseCVE(repo *git.Repository, f File) (*cveschema.CVE, error) {
 	}
 	return cve, nil
 }
+
+// ParseCVEForTriage parses the CVE file f into a CVE like ParseCVE, but
+// decodes only the fields that triage uses. See
+// cveschema.UnmarshalForTriage.
+func ParseCVEForTriage(repo *git.Repository, f File) (*cveschema.CVE, error) {
+	r, err := blobReader(repo, f.BlobHash)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	cve := &cveschema.CVE{}
+	if err := cveschema.UnmarshalForTriage(data, cve); err != nil {
+		return nil, err
+	}
+	return cve, nil
+}
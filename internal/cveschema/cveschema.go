@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	"golang.org/x/vulndb/internal/derrors"
 )
@@ -76,12 +77,20 @@ type CVE struct {
 	// ProblemType is problem type information (e.g. CWE identifier).
 	ProblemType ProblemType `json:"problemtype"`
 
+	// Impact is the impact of the vulnerability, as CVSS scores.
+	Impact *Impact `json:"impact,omitempty"`
+
 	// References is reference data in the form of URLs or file objects
 	// (uuencoded and embedded within the JSON file, exact format to be
 	// decided, e.g. we may require a compressed format so the objects require
 	// unpacking before they are "dangerous").
 	References References `json:"references"`
 
+	// Configurations are the affected products as CPE names with version
+	// ranges, in the form of the NVD's feeds. CVEs from the cvelist repo
+	// don't have them.
+	Configurations *Configurations `json:"configurations,omitempty"`
+
 	// Credit is the credit information (different than CVE_timeline in that
 	// these are specific things being credited to specific
 	// people/organizations/etc.).
@@ -242,6 +251,117 @@ type VersionDataItem struct {
 	VersionAffected string `json:"version_affected"`
 }
 
+// Configurations describe the affected products as a tree of nodes, each of
+// which combines the matches of CPE names with its children.
+type Configurations struct {
+	Nodes []ConfigNode `json:"nodes"`
+}
+
+// A ConfigNode is a set of CPE matches and child nodes, combined with
+// Operator, "AND" or "OR".
+type ConfigNode struct {
+	Operator string       `json:"operator"`
+	Negate   bool         `json:"negate,omitempty"`
+	Children []ConfigNode `json:"children,omitempty"`
+	CPEMatch []CPEMatch   `json:"cpe_match,omitempty"`
+}
+
+// A CPEMatch matches a range of versions of the product named by a CPE 2.3
+// formatted string. When there are no bounds, the CPE name's own version
+// is the only one matched.
+type CPEMatch struct {
+	Vulnerable            bool   `json:"vulnerable"`
+	CPE23URI              string `json:"cpe23Uri"`
+	VersionStartIncluding string `json:"versionStartIncluding,omitempty"`
+	VersionStartExcluding string `json:"versionStartExcluding,omitempty"`
+	VersionEndIncluding   string `json:"versionEndIncluding,omitempty"`
+	VersionEndExcluding   string `json:"versionEndExcluding,omitempty"`
+}
+
+// VulnerableCPEs returns the matches of the vulnerable products in c's
+// configurations, in order, from all nodes and their children. Matches in
+// negated nodes are skipped.
+func (c *CVE) VulnerableCPEs() []CPEMatch {
+	if c.Configurations == nil {
+		return nil
+	}
+	var ms []CPEMatch
+	var walk func([]ConfigNode)
+	walk = func(ns []ConfigNode) {
+		for _, n := range ns {
+			if n.Negate {
+				continue
+			}
+			for _, m := range n.CPEMatch {
+				if m.Vulnerable {
+					ms = append(ms, m)
+				}
+			}
+			walk(n.Children)
+		}
+	}
+	walk(c.Configurations.Nodes)
+	return ms
+}
+
+// Impact is the impact of the vulnerability.
+type Impact struct {
+	CVSS CVSSList `json:"cvss"`
+}
+
+// A CVSS is a CVSS score and the vector it was computed from.
+type CVSS struct {
+	Version      string  `json:"version"`
+	VectorString string  `json:"vectorString"`
+	BaseScore    float64 `json:"baseScore"`
+	BaseSeverity string  `json:"baseSeverity"`
+}
+
+// A CVSSList is a list of CVSS scores. In JSON, it can also be a single
+// score.
+type CVSSList []CVSS
+
+// UnmarshalJSON implements json.Unmarshaler. Since CNAs record impacts in
+// many formats, it ignores those it doesn't understand rather than fail.
+func (l *CVSSList) UnmarshalJSON(data []byte) error {
+	var c CVSS
+	if err := json.Unmarshal(data, &c); err == nil {
+		*l = CVSSList{c}
+		return nil
+	}
+	var cs []CVSS
+	if err := json.Unmarshal(data, &cs); err == nil {
+		*l = cs
+	}
+	return nil
+}
+
+// IsDisputed reports whether the description of c says that its
+// vulnerability is disputed, as CVE descriptions do by starting with
+// "** DISPUTED **".
+func (c *CVE) IsDisputed() bool {
+	for _, d := range c.Description.Data {
+		if strings.HasPrefix(strings.TrimSpace(d.Value), "** DISPUTED **") {
+			return true
+		}
+	}
+	return false
+}
+
+// CVSSV3 returns the first CVSS v3 vector of c's impact, or "" if there is
+// none.
+func (c *CVE) CVSSV3() string {
+	if c.Impact == nil {
+		return ""
+	}
+	for _, s := range c.Impact.CVSS {
+		if strings.HasPrefix(s.VectorString, "CVSS:3") {
+			return s.VectorString
+		}
+	}
+	return ""
+}
+
 var nullBytes = []byte("null")
 
 // UnmarshalJSON implements json.Unmarshaler.
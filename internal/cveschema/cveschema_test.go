@@ -20,6 +20,9 @@ func TestDecodeCVE(t *testing.T) {
 		{"full 2017", json1, want1},
 		{"LangString credit", json2, want2},
 		{"CreditData credit", json3, want3},
+		{"CVSS list", jsonCVSSList, wantCVSSList},
+		{"unknown impact", jsonUnknownImpact, wantUnknownImpact},
+		{"configurations", jsonConfigurations, wantConfigurations},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			var got *CVE
@@ -210,6 +213,14 @@ var want1 = &CVE{
 			}},
 		},
 	},
+	Impact: &Impact{
+		CVSS: CVSSList{{
+			Version:      "3.0",
+			VectorString: "CVSS:3.0/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H",
+			BaseScore:    8.8,
+			BaseSeverity: "HIGH",
+		}},
+	},
 	References: References{
 		Data: []Reference{
 			{URL: "https://access.redhat.com/errata/RHSA-2018:0475"},
@@ -288,3 +299,186 @@ var want3 = &CVE{
 		},
 	},
 }
+
+// A record whose impact is a list of CVSS scores.
+const jsonCVSSList = `{
+	"CVE_data_meta": {"ID": "CVE-2022-0001", "STATE": "PUBLIC"},
+	"impact": {"cvss": [
+		{"version": "3.1", "vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "baseScore": 9.8, "baseSeverity": "CRITICAL"}
+	]}
+}`
+
+var wantCVSSList = &CVE{
+	Metadata: Metadata{ID: "CVE-2022-0001", State: "PUBLIC"},
+	Impact: &Impact{CVSS: CVSSList{{
+		Version:      "3.1",
+		VectorString: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		BaseScore:    9.8,
+		BaseSeverity: "CRITICAL",
+	}}},
+}
+
+// A record whose impact is in a format that is ignored.
+const jsonUnknownImpact = `{
+	"CVE_data_meta": {"ID": "CVE-2022-0002", "STATE": "PUBLIC"},
+	"impact": {"cvss": [[{"version": "3.1"}]]}
+}`
+
+var wantUnknownImpact = &CVE{
+	Metadata: Metadata{ID: "CVE-2022-0002", State: "PUBLIC"},
+	Impact:   &Impact{},
+}
+
+// A record with configurations from the NVD.
+const jsonConfigurations = `{
+	"CVE_data_meta": {"ID": "CVE-2022-0003", "STATE": "PUBLIC"},
+	"configurations": {"CVE_data_version": "4.0", "nodes": [
+		{"operator": "AND", "children": [
+			{"operator": "OR", "children": [], "cpe_match": [
+				{"vulnerable": true, "cpe23Uri": "cpe:2.3:a:gin-gonic:gin:*:*:*:*:*:go:*:*", "versionEndExcluding": "1.7.7"}
+			]},
+			{"operator": "OR", "children": [], "cpe_match": [
+				{"vulnerable": false, "cpe23Uri": "cpe:2.3:o:linux:linux_kernel:-:*:*:*:*:*:*:*"}
+			]}
+		]},
+		{"operator": "OR", "negate": true, "cpe_match": [
+			{"vulnerable": true, "cpe23Uri": "cpe:2.3:a:gin-gonic:gin:1.7.0:*:*:*:*:go:*:*"}
+		]}
+	]}
+}`
+
+var wantConfigurations = &CVE{
+	Metadata: Metadata{ID: "CVE-2022-0003", State: "PUBLIC"},
+	Configurations: &Configurations{Nodes: []ConfigNode{
+		{
+			Operator: "AND",
+			Children: []ConfigNode{
+				{
+					Operator: "OR",
+					Children: []ConfigNode{},
+					CPEMatch: []CPEMatch{{
+						Vulnerable:          true,
+						CPE23URI:            "cpe:2.3:a:gin-gonic:gin:*:*:*:*:*:go:*:*",
+						VersionEndExcluding: "1.7.7",
+					}},
+				},
+				{
+					Operator: "OR",
+					Children: []ConfigNode{},
+					CPEMatch: []CPEMatch{{CPE23URI: "cpe:2.3:o:linux:linux_kernel:-:*:*:*:*:*:*:*"}},
+				},
+			},
+		},
+		{
+			Operator: "OR",
+			Negate:   true,
+			CPEMatch: []CPEMatch{{Vulnerable: true, CPE23URI: "cpe:2.3:a:gin-gonic:gin:1.7.0:*:*:*:*:go:*:*"}},
+		},
+	}},
+}
+
+func TestVulnerableCPEs(t *testing.T) {
+	want := []CPEMatch{{
+		Vulnerable:          true,
+		CPE23URI:            "cpe:2.3:a:gin-gonic:gin:*:*:*:*:*:go:*:*",
+		VersionEndExcluding: "1.7.7",
+	}}
+	if diff := cmp.Diff(want, wantConfigurations.VulnerableCPEs()); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if got := want1.VulnerableCPEs(); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestIsDisputed(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		want bool
+	}{
+		{"** DISPUTED ** A bug in M allows XSS. NOTE: the vendor disputes this.", true},
+		{" ** DISPUTED ** A bug.", true},
+		{"A bug in M allows XSS. It is not ** DISPUTED **.", false},
+		{"", false},
+	} {
+		c := &CVE{Description: Description{Data: []LangString{{Lang: "eng", Value: test.desc}}}}
+		if got := c.IsDisputed(); got != test.want {
+			t.Errorf("%q: got %t, want %t", test.desc, got, test.want)
+		}
+	}
+}
+
+func TestCVSSV3(t *testing.T) {
+	if got, want := want1.CVSSV3(), "CVSS:3.0/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := wantUnknownImpact.CVSSV3(); got != "" {
+		t.Errorf("got %q, want empty", got)
+	}
+}
+
+func TestUnmarshalForTriage(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		json string
+		want *CVE
+	}{
+		{"full 2017", json1, want1},
+		{"LangString credit", json2, want2},
+		{"CreditData credit", json3, want3},
+		{"CVSS list", jsonCVSSList, wantCVSSList},
+		{"unknown impact", jsonUnknownImpact, wantUnknownImpact},
+		{"configurations", jsonConfigurations, wantConfigurations},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var got CVE
+			if err := UnmarshalForTriage([]byte(test.json), &got); err != nil {
+				t.Fatal(err)
+			}
+			// The fields that triage does not use are not decoded.
+			want := *test.want
+			want.ProblemType = ProblemType{}
+			want.Credit = Credit{}
+			if diff := cmp.Diff(&want, &got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnmarshalForTriageErrors(t *testing.T) {
+	for _, data := range []string{
+		``,
+		`[]`,
+		`{"CVE_data_meta": {}`,
+		`{"credit" ["a"]}`,
+		`{"credit": "a}`,
+		`{"description": 1}`,
+		`{"credit": [], }`,
+	} {
+		var c CVE
+		if err := UnmarshalForTriage([]byte(data), &c); err == nil {
+			t.Errorf("%q: got nil error, want error", data)
+		}
+	}
+}
+
+func BenchmarkUnmarshalForTriage(b *testing.B) {
+	data := []byte(json1)
+	b.Run("full", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var c CVE
+			if err := json.Unmarshal(data, &c); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("triage", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var c CVE
+			if err := UnmarshalForTriage(data, &c); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cveschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// triageField returns where to decode the value of the JSON key of a field
+// of c that triage uses, or nil if triage does not use it.
+func (c *CVE) triageField(key []byte) interface{} {
+	switch string(key) {
+	case "CVE_data_meta":
+		return &c.Metadata
+	case "data_type":
+		return &c.DataType
+	case "data_format":
+		return &c.DataFormat
+	case "data_version":
+		return &c.DataVersion
+	case "affects":
+		return &c.Affects
+	case "description":
+		return &c.Description
+	case "impact":
+		return &c.Impact
+	case "references":
+		return &c.References
+	case "configurations":
+		return &c.Configurations
+	}
+	return nil
+}
+
+// UnmarshalForTriage decodes into c only the fields of the JSON CVE in data
+// that triage uses: the metadata, affected products, description, impact,
+// references and configurations. The values of other fields, like the
+// problem type and credit, are skipped without being decoded.
+//
+// It is faster than json.Unmarshal for scanning many CVEs, most of which
+// need nothing else.
+func UnmarshalForTriage(data []byte, c *CVE) error {
+	i := skipSpace(data, 0)
+	if i >= len(data) || data[i] != '{' {
+		return errors.New("CVE JSON is not an object")
+	}
+	i = skipSpace(data, i+1)
+	if i < len(data) && data[i] == '}' {
+		return nil
+	}
+	for {
+		keyStart := i
+		keyEnd, err := skipString(data, i)
+		if err != nil {
+			return err
+		}
+		i = skipSpace(data, keyEnd)
+		if i >= len(data) || data[i] != ':' {
+			return fmt.Errorf("offset %d: expected ':'", i)
+		}
+		valStart := skipSpace(data, i+1)
+		valEnd, err := skipValue(data, valStart)
+		if err != nil {
+			return err
+		}
+		// Keys with escapes are not among the fields we want.
+		key := data[keyStart+1 : keyEnd-1]
+		if ptr := c.triageField(key); ptr != nil {
+			if err := json.Unmarshal(data[valStart:valEnd], ptr); err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+		}
+		i = skipSpace(data, valEnd)
+		if i >= len(data) {
+			return errors.New("unexpected end of CVE JSON")
+		}
+		switch data[i] {
+		case ',':
+			i = skipSpace(data, i+1)
+		case '}':
+			return nil
+		default:
+			return fmt.Errorf("offset %d: expected ',' or '}'", i)
+		}
+	}
+}
+
+func skipSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// skipString returns the offset just past the JSON string starting at i.
+func skipString(data []byte, i int) (int, error) {
+	if i >= len(data) || data[i] != '"' {
+		return 0, fmt.Errorf("offset %d: expected string", i)
+	}
+	for i++; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return 0, errors.New("unterminated string in CVE JSON")
+}
+
+// skipValue returns the offset just past the JSON value starting at i. It
+// checks only as much syntax as it needs to find the end of the value; the
+// values that are decoded are checked fully by json.Unmarshal.
+func skipValue(data []byte, i int) (int, error) {
+	if i >= len(data) {
+		return 0, errors.New("unexpected end of CVE JSON")
+	}
+	switch data[i] {
+	case '"':
+		return skipString(data, i)
+	case '{', '[':
+		depth := 0
+		for i < len(data) {
+			switch data[i] {
+			case '"':
+				end, err := skipString(data, i)
+				if err != nil {
+					return 0, err
+				}
+				i = end
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return i + 1, nil
+				}
+			}
+			i++
+		}
+		return 0, errors.New("unterminated object or array in CVE JSON")
+	default:
+		// A number, true, false or null.
+		start := i
+		for i < len(data) {
+			switch data[i] {
+			case ',', '}', ']', ' ', '\t', '\n', '\r':
+				if i == start {
+					return 0, fmt.Errorf("offset %d: expected value", i)
+				}
+				return i, nil
+			}
+			i++
+		}
+		return i, nil
+	}
+}
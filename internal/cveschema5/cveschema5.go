@@ -35,19 +35,25 @@ type Metadata struct {
 	OrgID  string `json:"assignerOrgId,omitempty"`
 	Serial int    `json:"serial,omitempty"`
 	State  State  `json:"state,omitempty"`
+	// DateRejected is set only for rejected records.
+	DateRejected string `json:"dateRejected,omitempty"`
 }
 
 type Containers struct {
 	CNAContainer CNAPublishedContainer `json:"cna"`
 }
 
+// CNAPublishedContainer is the CNA container of a published record. The
+// container of a rejected record has only the provider metadata and the
+// rejected reasons, so it uses the same type.
 type CNAPublishedContainer struct {
 	ProviderMetadata ProviderMetadata `json:"providerMetadata"`
-	Descriptions     []Description    `json:"descriptions"`
-	Affected         []Affected       `json:"affected"`
+	Descriptions     []Description    `json:"descriptions,omitempty"`
+	Affected         []Affected       `json:"affected,omitempty"`
 	ProblemTypes     []ProblemType    `json:"problemTypes,omitempty"`
-	References       []Reference      `json:"references"`
+	References       []Reference      `json:"references,omitempty"`
 	Credits          []Credit         `json:"credits,omitempty"`
+	RejectedReasons  []Description    `json:"rejectedReasons,omitempty"`
 }
 
 type ProviderMetadata struct {
@@ -86,6 +92,7 @@ type Reference struct {
 type Credit struct {
 	Lang  string `json:"lang"`
 	Value string `json:"value"`
+	Type  string `json:"type,omitempty"`
 }
 
 type VersionRange struct {
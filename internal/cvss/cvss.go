@@ -0,0 +1,184 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cvss parses CVSS v3 vectors and computes their base scores, as
+// specified at https://www.first.org/cvss/v3.1/specification-document.
+package cvss
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// A Vector is a parsed CVSS v3.0 or v3.1 vector.
+type Vector struct {
+	// Version is "3.0" or "3.1".
+	Version string
+	// Metrics maps each metric in the vector, like "AV", to its value,
+	// like "N".
+	Metrics map[string]string
+}
+
+// metricValues are the allowed values of each metric. The base metrics are
+// required; the temporal and environmental ones are optional and do not
+// affect the base score.
+var metricValues = map[string]string{
+	// Base metrics.
+	"AV": "NALP",
+	"AC": "LH",
+	"PR": "NLH",
+	"UI": "NR",
+	"S":  "UC",
+	"C":  "HLN",
+	"I":  "HLN",
+	"A":  "HLN",
+	// Temporal metrics.
+	"E":  "XUPFH",
+	"RL": "XOTWU",
+	"RC": "XURC",
+	// Environmental metrics.
+	"CR":  "XLMH",
+	"IR":  "XLMH",
+	"AR":  "XLMH",
+	"MAV": "XNALP",
+	"MAC": "XLH",
+	"MPR": "XNLH",
+	"MUI": "XNR",
+	"MS":  "XUC",
+	"MC":  "XNLH",
+	"MI":  "XNLH",
+	"MA":  "XNLH",
+}
+
+var baseMetrics = []string{"AV", "AC", "PR", "UI", "S", "C", "I", "A"}
+
+// Parse parses a CVSS v3 vector string, like
+// "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H". The vector must have every
+// base metric, and no metric more than once.
+func Parse(s string) (*Vector, error) {
+	parts := strings.Split(s, "/")
+	var version string
+	switch parts[0] {
+	case "CVSS:3.0":
+		version = "3.0"
+	case "CVSS:3.1":
+		version = "3.1"
+	default:
+		return nil, fmt.Errorf("%q: not a CVSS v3 vector", s)
+	}
+	v := &Vector{Version: version, Metrics: map[string]string{}}
+	for _, p := range parts[1:] {
+		metric, value, ok := strings.Cut(p, ":")
+		if !ok {
+			return nil, fmt.Errorf("%q: bad metric %q", s, p)
+		}
+		values, ok := metricValues[metric]
+		if !ok {
+			return nil, fmt.Errorf("%q: unknown metric %q", s, metric)
+		}
+		if len(value) != 1 || !strings.Contains(values, value) {
+			return nil, fmt.Errorf("%q: bad value %q for metric %s", s, value, metric)
+		}
+		if _, ok := v.Metrics[metric]; ok {
+			return nil, fmt.Errorf("%q: metric %s is repeated", s, metric)
+		}
+		v.Metrics[metric] = value
+	}
+	for _, m := range baseMetrics {
+		if _, ok := v.Metrics[m]; !ok {
+			return nil, fmt.Errorf("%q: missing base metric %s", s, m)
+		}
+	}
+	return v, nil
+}
+
+// Weights of the values of the base metrics.
+var (
+	attackVector     = map[string]float64{"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2}
+	attackComplexity = map[string]float64{"L": 0.77, "H": 0.44}
+	userInteraction  = map[string]float64{"N": 0.85, "R": 0.62}
+	impact           = map[string]float64{"H": 0.56, "L": 0.22, "N": 0}
+	// privilegesRequired depends on whether the scope is changed.
+	privilegesRequired = map[bool]map[string]float64{
+		false: {"N": 0.85, "L": 0.62, "H": 0.27},
+		true:  {"N": 0.85, "L": 0.68, "H": 0.5},
+	}
+)
+
+// BaseScore returns the base score of v, from 0.0 to 10.0.
+func (v *Vector) BaseScore() float64 {
+	m := v.Metrics
+	changed := m["S"] == "C"
+	iss := 1 - (1-impact[m["C"]])*(1-impact[m["I"]])*(1-impact[m["A"]])
+	var imp float64
+	if changed {
+		imp = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	} else {
+		imp = 6.42 * iss
+	}
+	if imp <= 0 {
+		return 0
+	}
+	exploitability := 8.22 * attackVector[m["AV"]] * attackComplexity[m["AC"]] *
+		privilegesRequired[changed][m["PR"]] * userInteraction[m["UI"]]
+	if changed {
+		return roundUp(math.Min(1.08*(imp+exploitability), 10))
+	}
+	return roundUp(math.Min(imp+exploitability, 10))
+}
+
+// roundUp returns the smallest number with one decimal place that is at
+// least x, as defined in Appendix A of the CVSS v3.1 specification, which
+// avoids floating-point errors.
+func roundUp(x float64) float64 {
+	i := int(math.Round(x * 100000))
+	if i%10000 == 0 {
+		return float64(i) / 100000
+	}
+	return float64(i/10000+1) / 10
+}
+
+// Severity returns the qualitative severity rating of a score: "None",
+// "Low", "Medium", "High" or "Critical".
+func Severity(score float64) string {
+	switch {
+	case score == 0:
+		return "None"
+	case score < 4:
+		return "Low"
+	case score < 7:
+		return "Medium"
+	case score < 9:
+		return "High"
+	default:
+		return "Critical"
+	}
+}
+
+// String returns the vector in its canonical form, with the base metrics
+// first, in the order of the specification.
+func (v *Vector) String() string {
+	var b strings.Builder
+	b.WriteString("CVSS:" + v.Version)
+	for _, m := range baseMetrics {
+		fmt.Fprintf(&b, "/%s:%s", m, v.Metrics[m])
+	}
+	for _, m := range []string{"E", "RL", "RC", "CR", "IR", "AR", "MAV", "MAC", "MPR", "MUI", "MS", "MC", "MI", "MA"} {
+		if val, ok := v.Metrics[m]; ok {
+			fmt.Fprintf(&b, "/%s:%s", m, val)
+		}
+	}
+	return b.String()
+}
+
+// Score parses the vector s and returns its base score and severity.
+func Score(s string) (score float64, severity string, err error) {
+	v, err := Parse(s)
+	if err != nil {
+		return 0, "", err
+	}
+	score = v.BaseScore()
+	return score, Severity(score), nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cyclonedx contains the schema for a CycloneDX BOM in JSON format.
+// The package implements the subset of the schema needed to export the
+// vulnerability database as a Vulnerability Disclosure Report (VDR): a BOM
+// whose components are the affected modules, and which lists their
+// vulnerabilities.
+//
+// https://cyclonedx.org/docs/1.4/json contains the full JSON schema and
+// documentation for each field.
+package cyclonedx
+
+import "time"
+
+const (
+	BOMFormat   = "CycloneDX"
+	SpecVersion = "1.4"
+)
+
+type BOM struct {
+	BOMFormat       string           `json:"bomFormat"`
+	SpecVersion     string           `json:"specVersion"`
+	Version         int              `json:"version"`
+	Metadata        *Metadata        `json:"metadata,omitempty"`
+	Components      []*Component     `json:"components,omitempty"`
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities,omitempty"`
+}
+
+type Metadata struct {
+	Timestamp time.Time `json:"timestamp"`
+	Tools     []*Tool   `json:"tools,omitempty"`
+}
+
+type Tool struct {
+	Vendor string `json:"vendor,omitempty"`
+	Name   string `json:"name"`
+}
+
+// ComponentTypeLibrary is the type of the components for Go modules.
+const ComponentTypeLibrary = "library"
+
+type Component struct {
+	Type   string `json:"type"`
+	BOMRef string `json:"bom-ref"`
+	Name   string `json:"name"`
+	// PURL is the package URL of the component, such as
+	// "pkg:golang/golang.org/x/net".
+	PURL string `json:"purl"`
+}
+
+type Vulnerability struct {
+	BOMRef      string       `json:"bom-ref,omitempty"`
+	ID          string       `json:"id"`
+	Source      *Source      `json:"source,omitempty"`
+	References  []*Reference `json:"references,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Advisories  []*Advisory  `json:"advisories,omitempty"`
+	Published   time.Time    `json:"published,omitempty"`
+	Updated     time.Time    `json:"updated,omitempty"`
+	Affects     []*Affects   `json:"affects,omitempty"`
+}
+
+// A Source is a database of vulnerabilities.
+type Source struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// A Reference is another ID of a vulnerability, in another source.
+type Reference struct {
+	ID     string  `json:"id"`
+	Source *Source `json:"source"`
+}
+
+type Advisory struct {
+	URL string `json:"url"`
+}
+
+// Affects is a component that a vulnerability affects.
+type Affects struct {
+	// Ref is the BOMRef of the component.
+	Ref      string              `json:"ref"`
+	Versions []*AffectedVersions `json:"versions,omitempty"`
+}
+
+type Status string
+
+const (
+	StatusAffected   Status = "affected"
+	StatusUnaffected Status = "unaffected"
+	StatusUnknown    Status = "unknown"
+)
+
+// AffectedVersions is either a single version or a range of versions, with
+// their status.
+type AffectedVersions struct {
+	Version string `json:"version,omitempty"`
+	// Range is in the vers format, like "vers:golang/>=v1.0.0|<v1.2.0".
+	Range  string `json:"range,omitempty"`
+	Status Status `json:"status"`
+}
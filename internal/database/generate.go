@@ -6,9 +6,13 @@
 package database
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sort"
@@ -54,24 +58,116 @@ const (
 	// toolchainFileName is the name of the .json file in the vulndb repo
 	// that will contain info on toolchain (cmd/...) vulnerabilities.
 	toolchainFileName = "toolchain"
+
+	// indexFile is the name of the index files of the database: the
+	// top-level one, which maps modules to modification times, and the one
+	// in idDirectory, which lists IDs.
+	indexFile = "index.json"
+
+	// aliasesFile is the name of the file of the database that maps
+	// aliases to IDs.
+	aliasesFile = "aliases.json"
+
+	// modifiedFile is the name of the file of the database that lists the
+	// IDs of the entries with their modification times, most recently
+	// modified first, so that clients can sync only the entries that
+	// changed since they last did.
+	modifiedFile = "modified.json"
+
+	// zipFile is the name of the file of the database that contains all
+	// its other files.
+	zipFile = "vulns.zip"
 )
 
-func Generate(ctx context.Context, repoDir, jsonDir string, indent bool) (err error) {
+// GenerateOptions configure Generate.
+type GenerateOptions struct {
+	// Indent makes Generate indent JSON, for debugging.
+	Indent bool
+	// Incremental makes Generate write only the files whose contents have
+	// changed, and remove the files of entries and modules that are no
+	// longer in the database, instead of writing every file.
+	Incremental bool
+	// SigningKey, if not nil, makes Generate sign the checksums of the
+	// files of the database with it.
+	SigningKey ed25519.PrivateKey
+	// OverlayDir, if not empty, is a git repo of private YAML reports in
+	// data/reports, as in the vulndb repo, that Generate adds to the
+	// database. Their IDs must differ from those of the public reports.
+	OverlayDir string
+	// Now is the time at which the embargoes of overlay reports are
+	// checked: reports embargoed until a later time are left out of the
+	// database. If it is zero, the current time is used.
+	Now time.Time
+}
+
+// now returns opts.Now, or the current time if it is zero.
+func (opts GenerateOptions) now() time.Time {
+	if opts.Now.IsZero() {
+		return time.Now()
+	}
+	return opts.Now
+}
+
+// Generate writes the database to jsonDir, from the OSV entries in repoDir.
+// The database consists of an index.json of modules, a file of entries for
+// each module, an aliases.json, a modified.json of entries by modification
+// time, a directory of entries by ID, a zip file of all of those, and a
+// checksums.txt of all the files, with a checksums.txt.sig signature if
+// opts has a signing key. Generate validates the database after writing it.
+//
+// The database is reproducible: generating it from the same commit of the
+// repo always yields the same bytes, so that Verify can check a published
+// database against the repo. Modification times come from the git history,
+// and all times are in UTC.
+func Generate(ctx context.Context, repoDir, jsonDir string, opts GenerateOptions) (err error) {
 	defer derrors.Wrap(&err, "Generate(%q)", repoDir)
 
-	jsonVulns, entries, err := generateEntries(ctx, repoDir)
+	jsonVulns, entries, err := generateEntries(ctx, repoDir, opts.OverlayDir, opts.now())
 	if err != nil {
 		return err
 	}
+	files, err := dbFiles(jsonVulns, entries, opts.Indent)
+	if err != nil {
+		return err
+	}
+	n, err := writeFiles(jsonDir, files, opts.Incremental)
+	if err != nil {
+		return err
+	}
+	zipPath := filepath.Join(jsonDir, zipFile)
+	if _, err := os.Stat(zipPath); n > 0 || err != nil {
+		if err := writeZip(zipPath, files); err != nil {
+			return err
+		}
+	}
+	if err := writeChecksums(jsonDir, files, opts.SigningKey); err != nil {
+		return err
+	}
+	return Validate(jsonDir)
+}
+
+// dbFiles returns the contents of the files of the database, other than the
+// zip file, keyed by their slash-separated paths relative to the root of the
+// database.
+func dbFiles(jsonVulns map[string][]Entry, entries []Entry, indent bool) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	add := func(path string, value any) error {
+		j, err := jsonMarshal(value, indent)
+		if err != nil {
+			return err
+		}
+		files[path] = j
+		return nil
+	}
 
 	index := make(client.DBIndex, len(jsonVulns))
 	for modulePath, vulns := range jsonVulns {
 		epath, err := client.EscapeModulePath(modulePath)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		if err := writeVulns(filepath.Join(jsonDir, epath), vulns, indent); err != nil {
-			return err
+		if err := add(epath+".json", vulns); err != nil {
+			return nil, err
 		}
 		for _, v := range vulns {
 			if v.Modified.After(index[modulePath]) {
@@ -79,16 +175,186 @@ func Generate(ctx context.Context, repoDir, jsonDir string, indent bool) (err er
 			}
 		}
 	}
-	if err := WriteJSON(filepath.Join(jsonDir, "index.json"), index, indent); err != nil {
-		return err
+	if err := add(indexFile, index); err != nil {
+		return nil, err
 	}
-	if err := writeAliasIndex(jsonDir, entries, indent); err != nil {
+
+	aliasToGoIDs := map[string][]string{}
+	for _, e := range entries {
+		for _, a := range e.Aliases {
+			aliasToGoIDs[a] = append(aliasToGoIDs[a], e.ID)
+		}
+	}
+	if err := add(aliasesFile, aliasToGoIDs); err != nil {
+		return nil, err
+	}
+
+	if err := add(modifiedFile, modifiedIndex(entries)); err != nil {
+		return nil, err
+	}
+
+	idIndex := []string{}
+	for _, e := range entries {
+		if err := add(idDirectory+"/"+e.ID+".json", e); err != nil {
+			return nil, err
+		}
+		idIndex = append(idIndex, e.ID)
+	}
+	// The ID directory has its own index.json with a list of all the IDs.
+	if err := add(idDirectory+"/"+indexFile, idIndex); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// A ModifiedEntry is an element of the modified.json file of the database:
+// the ID of an entry and when it was last modified.
+type ModifiedEntry struct {
+	ID       string    `json:"id"`
+	Modified time.Time `json:"modified"`
+}
+
+// modifiedIndex returns the IDs and modification times of entries, most
+// recently modified first, and in ID order for equal times.
+func modifiedIndex(entries []Entry) []ModifiedEntry {
+	mes := []ModifiedEntry{}
+	for _, e := range entries {
+		mes = append(mes, ModifiedEntry{ID: e.ID, Modified: e.Modified})
+	}
+	sort.Slice(mes, func(i, j int) bool {
+		if !mes[i].Modified.Equal(mes[j].Modified) {
+			return mes[i].Modified.After(mes[j].Modified)
+		}
+		return mes[i].ID < mes[j].ID
+	})
+	return mes
+}
+
+// ModifiedSince returns the IDs and modification times of the entries of the
+// database in dbPath that were modified at or after since, most recently
+// modified first.
+func ModifiedSince(dbPath string, since time.Time) (_ []ModifiedEntry, err error) {
+	defer derrors.Wrap(&err, "ModifiedSince(%q, %s)", dbPath, since)
+
+	data, err := os.ReadFile(filepath.Join(dbPath, modifiedFile))
+	if err != nil {
+		return nil, err
+	}
+	var mes []ModifiedEntry
+	if err := json.Unmarshal(data, &mes); err != nil {
+		return nil, err
+	}
+	for i, me := range mes {
+		// The file is sorted, so the rest are older.
+		if me.Modified.Before(since) {
+			return mes[:i], nil
+		}
+	}
+	return mes, nil
+}
+
+// writeFiles writes files, as returned by dbFiles, to dir. If incremental is
+// true, it skips files whose contents have not changed, and removes the JSON
+// files in dir that are not in files. It returns the number of files written
+// or removed.
+func writeFiles(dir string, files map[string][]byte, incremental bool) (n int, err error) {
+	defer derrors.Wrap(&err, "writeFiles(%q)", dir)
+
+	for path, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(path))
+		if incremental {
+			if old, err := os.ReadFile(filename); err == nil && bytes.Equal(old, content) {
+				continue
+			}
+		}
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			return 0, err
+		}
+		if err := os.WriteFile(filename, content, 0644); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	if !incremental {
+		return n, nil
+	}
+	existing, err := dbFilePaths(dir)
+	if err != nil {
+		return 0, err
+	}
+	for _, path := range existing {
+		if _, ok := files[path]; !ok {
+			if err := os.Remove(filepath.Join(dir, filepath.FromSlash(path))); err != nil {
+				return 0, err
+			}
+			n++
+		}
+	}
+	return n, nil
+}
+
+// dbFilePaths returns the slash-separated paths, relative to dir, of the JSON
+// files in dir and its subdirectories.
+func dbFilePaths(dir string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".json") {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// writeZip writes files, as returned by dbFiles, to a zip file.
+func writeZip(filename string, files map[string][]byte) (err error) {
+	defer derrors.Wrap(&err, "writeZip(%q)", filename)
+
+	content, err := zipContents(files)
+	if err != nil {
 		return err
 	}
-	return writeEntriesByID(filepath.Join(jsonDir, idDirectory), entries, indent)
+	return os.WriteFile(filename, content, 0644)
 }
 
-func generateEntries(ctx context.Context, repoDir string) (map[string][]osv.Entry, []osv.Entry, error) {
+// zipContents returns the contents of a zip file of files. The files are
+// sorted by path and have no modification times, so that the zip file only
+// changes when the database does.
+func zipContents(files map[string][]byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	paths := maps.Keys(files)
+	sort.Strings(paths)
+	for _, path := range paths {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: path, Method: zip.Deflate})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(files[path]); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// generateEntries returns the entries of the database, from the OSV entries
+// in repoDir and, if overlayDir is not empty, the reports in overlayDir that
+// are not embargoed at now, by module and as a list.
+func generateEntries(ctx context.Context, repoDir, overlayDir string, now time.Time) (map[string][]Entry, []Entry, error) {
 	repo, err := gitrepo.Open(ctx, repoDir)
 	if err != nil {
 		return nil, nil, err
@@ -104,8 +370,14 @@ func generateEntries(ctx context.Context, repoDir string) (map[string][]osv.Entr
 		return nil, nil, err
 	}
 
-	jsonVulns := map[string][]osv.Entry{}
-	var entries []osv.Entry
+	jsonVulns := map[string][]Entry{}
+	var entries []Entry
+	add := func(entry Entry) {
+		for _, modulePath := range ModulesForEntry(entry) {
+			jsonVulns[modulePath] = append(jsonVulns[modulePath], entry)
+		}
+		entries = append(entries, entry)
+	}
 	for _, f := range osvFiles {
 		if !strings.HasSuffix(f.Name(), ".json") {
 			continue
@@ -115,67 +387,69 @@ func generateEntries(ctx context.Context, repoDir string) (map[string][]osv.Entr
 		if err != nil {
 			return nil, nil, err
 		}
-		dates, ok := commitDates[filename]
+		// The commit dates are keyed by paths relative to the repo.
+		repoPath := osvDir + "/" + f.Name()
+		dates, ok := commitDates[repoPath]
 		if !ok {
-			return nil, nil, fmt.Errorf("can't find git repo commit dates for %q", filename)
-		}
-		// If a report contains a published field, consider it
-		// the authoritative source of truth. Otherwise, set
-		// the published field from the git history.
-		if entry.Published.IsZero() {
-			entry.Published = dates.Oldest
-		}
-		entry.Modified = dates.Newest
-		for _, modulePath := range ModulesForEntry(entry) {
-			jsonVulns[modulePath] = append(jsonVulns[modulePath], entry)
+			return nil, nil, fmt.Errorf("can't find git repo commit dates for %q", repoPath)
 		}
-		entries = append(entries, entry)
+		setDates(&entry, dates)
+		add(entry)
 	}
-	return jsonVulns, entries, nil
-}
-
-// ModulesForEntry returns the list of modules affected by an OSV entry.
-func ModulesForEntry(entry osv.Entry) []string {
-	mods := map[string]bool{}
-	for _, a := range entry.Affected {
-		mods[a.Package.Name] = true
+	if overlayDir == "" {
+		return jsonVulns, entries, nil
 	}
-	return maps.Keys(mods)
-}
 
-func writeVulns(outPath string, vulns []osv.Entry, indent bool) error {
-	if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
-		return fmt.Errorf("failed to create directory %q: %s", filepath.Dir(outPath), err)
+	overlay, err := overlayEntries(ctx, overlayDir, now)
+	if err != nil {
+		return nil, nil, err
 	}
-	return WriteJSON(outPath+".json", vulns, indent)
+	public := map[string]bool{}
+	for _, e := range entries {
+		public[e.ID] = true
+	}
+	for _, entry := range overlay {
+		if public[entry.ID] {
+			return nil, nil, fmt.Errorf("overlay report %s has the ID of a report in %s", entry.ID, repoDir)
+		}
+		add(entry)
+	}
+	return jsonVulns, entries, nil
 }
 
-func writeEntriesByID(idDir string, entries []osv.Entry, indent bool) error {
-	// Write a directory containing entries by ID.
-	if err := os.MkdirAll(idDir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory %q: %v", idDir, err)
+// setDates sets the publication and modification times of entry from the
+// commit dates of its file.
+func setDates(entry *Entry, dates gitrepo.Dates) {
+	// If a report contains a published field, consider it
+	// the authoritative source of truth. Otherwise, set
+	// the published field from the git history.
+	if entry.Published.IsZero() {
+		entry.Published = dates.Oldest
 	}
-	var idIndex []string
-	for _, e := range entries {
-		outPath := filepath.Join(idDir, e.ID+".json")
-		if err := WriteJSON(outPath, e, indent); err != nil {
-			return err
+	// Commit times are in the committer's time zone; use UTC so that
+	// the output doesn't depend on who committed.
+	entry.Published = entry.Published.UTC()
+	entry.Modified = dates.Newest.UTC()
+	// A withdrawn entry stays in the database, under the modules it
+	// affected, so that clients that have it learn of the withdrawal.
+	// Its modification time must not precede the withdrawal, or
+	// clients relying on the index would not fetch it again.
+	if entry.Withdrawn != nil {
+		withdrawn := entry.Withdrawn.UTC()
+		entry.Withdrawn = &withdrawn
+		if entry.Modified.Before(withdrawn) {
+			entry.Modified = withdrawn
 		}
-		idIndex = append(idIndex, e.ID)
 	}
-	// Write an index.json in the ID directory with a list of all the IDs.
-	return WriteJSON(filepath.Join(idDir, "index.json"), idIndex, indent)
 }
 
-// Write a JSON file containing a map from alias to GO IDs.
-func writeAliasIndex(dir string, entries []osv.Entry, indent bool) error {
-	aliasToGoIDs := map[string][]string{}
-	for _, e := range entries {
-		for _, a := range e.Aliases {
-			aliasToGoIDs[a] = append(aliasToGoIDs[a], e.ID)
-		}
+// ModulesForEntry returns the list of modules affected by an OSV entry.
+func ModulesForEntry(entry Entry) []string {
+	mods := map[string]bool{}
+	for _, a := range entry.Affected {
+		mods[a.Package.Name] = true
 	}
-	return WriteJSON(filepath.Join(dir, "aliases.json"), aliasToGoIDs, indent)
+	return maps.Keys(mods)
 }
 
 func WriteJSON(filename string, value any, indent bool) (err error) {
@@ -195,33 +469,39 @@ func jsonMarshal(v any, indent bool) ([]byte, error) {
 	return json.Marshal(v)
 }
 
-// ReadOSV reads an osv.Entry from a file.
-func ReadOSV(filename string) (osv.Entry, error) {
+// ReadOSV reads an OSV entry from a file.
+func ReadOSV(filename string) (Entry, error) {
 	b, err := os.ReadFile(filename)
 	if err != nil {
-		return osv.Entry{}, err
+		return Entry{}, err
 	}
-	var entry osv.Entry
+	var entry Entry
 	if err := json.Unmarshal(b, &entry); err != nil {
-		return osv.Entry{}, fmt.Errorf("%v: %w", filename, err)
+		return Entry{}, fmt.Errorf("%v: %w", filename, err)
 	}
 	return entry, nil
 }
 
-// GenerateOSVEntry create an osv.Entry for a report. In addition to the report, it
+// GenerateOSVEntry create an OSV entry for a report. In addition to the report, it
 // takes the ID for the vuln and a URL that will point to the entry in the vuln DB.
-func GenerateOSVEntry(filename string, lastModified time.Time, r *report.Report) osv.Entry {
+func GenerateOSVEntry(filename string, lastModified time.Time, r *report.Report) Entry {
 	id := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
-	entry := osv.Entry{
+	entry := Entry{
 		ID:        id,
 		Published: r.Published,
 		Modified:  lastModified,
-		Withdrawn: r.Withdrawn,
 		Details:   r.Description,
 	}
+	if r.Withdrawn != nil {
+		// OSV has no field for the reason, so it goes first in the details.
+		withdrawn := r.Withdrawn.Date
+		entry.Withdrawn = &withdrawn
+		entry.Details = fmt.Sprintf("This report was withdrawn: %s\n\n%s", r.Withdrawn.Reason, r.Description)
+	}
 
 	linkName := fmt.Sprintf("%s%s", dbURL, id)
-	for _, m := range r.Modules {
+	// A module with alternate paths affects the module at each of them.
+	for _, m := range r.AllModules() {
 		entry.Affected = append(entry.Affected, generateAffected(m, linkName))
 	}
 	for _, ref := range r.References {
@@ -231,9 +511,55 @@ func GenerateOSVEntry(filename string, lastModified time.Time, r *report.Report)
 		})
 	}
 	entry.Aliases = r.GetAliases()
+	entry.Related = generateRelated(r)
+	entry.Severity = generateSeverity(r.CVSS)
+	for _, c := range r.Credits {
+		entry.Credits = append(entry.Credits, Credit{
+			Name:    c.Name,
+			Contact: c.Contact,
+			Type:    string(c.Type),
+		})
+	}
 	return entry
 }
 
+// generateRelated returns the IDs of the reports that r supersedes, was
+// split from, or is related to, in order and without duplicates. OSV has
+// only one kind of relation.
+func generateRelated(r *report.Report) []string {
+	var ids []string
+	seen := map[string]bool{}
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range r.Supersedes {
+		add(id)
+	}
+	add(r.SplitFrom)
+	for _, id := range r.Related {
+		add(id)
+	}
+	return ids
+}
+
+// generateSeverity returns the CVSS vectors of a report as OSV severities,
+// without duplicates. OSV has no field for their sources.
+func generateSeverity(cvss []*report.CVSS) []Severity {
+	var sevs []Severity
+	seen := map[string]bool{}
+	for _, c := range cvss {
+		if c.Vector == "" || seen[c.Vector] {
+			continue
+		}
+		seen[c.Vector] = true
+		sevs = append(sevs, Severity{Type: SeverityCVSSV3, Score: c.Vector})
+	}
+	return sevs
+}
+
 func generateAffectedRanges(versions []report.VersionRange) osv.Affects {
 	a := osv.AffectsRange{Type: osv.TypeSemver}
 	if len(versions) == 0 || versions[0].Introduced == "" {
@@ -265,7 +591,7 @@ func generateImports(m *report.Module) (imps []osv.EcosystemSpecificImport) {
 	return imps
 }
 
-func generateAffected(m *report.Module, url string) osv.Affected {
+func generateAffected(m *report.Module, url string) Affected {
 	name := m.Module
 	switch name {
 	case stdlib.ModulePath:
@@ -273,13 +599,13 @@ func generateAffected(m *report.Module, url string) osv.Affected {
 	case cmdModule:
 		name = toolchainFileName
 	}
-	return osv.Affected{
+	return Affected{
 		Package: osv.Package{
 			Name:      name,
 			Ecosystem: osv.GoEcosystem,
 		},
 		Ranges:           generateAffectedRanges(m.Versions),
-		DatabaseSpecific: osv.DatabaseSpecific{URL: url},
+		DatabaseSpecific: DatabaseSpecific{URL: url, PURLs: m.PURLs()},
 		EcosystemSpecific: osv.EcosystemSpecific{
 			Imports: generateImports(m),
 		},
@@ -5,7 +5,11 @@
 package database
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -66,7 +70,16 @@ func TestGenerate(t *testing.T) {
 		Description: "It's a real bad one, I'll tell you that",
 		CVEs:        []string{"CVE-0000-0000"},
 		GHSAs:       []string{"GHSA-abcd-efgh"},
-		Credit:      "ignored",
+		Credits: []*report.Credit{
+			{Name: "Jane Doe", Contact: []string{"https://example.com/jane"}, Type: report.CreditTypeFinder},
+		},
+		Supersedes: []string{"GO-1990-0001"},
+		SplitFrom:  "GO-1990-0002",
+		Related:    []string{"GO-1990-0003", "GO-1990-0001"},
+		CVSS: []*report.CVSS{
+			{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", Source: "CVE-0000-0000"},
+			{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", Source: "GHSA-abcd-efgh"},
+		},
 		References: []*report.Reference{
 			{Type: report.ReferenceTypeAdvisory, URL: "advisory"},
 			{Type: report.ReferenceTypeReport, URL: "issue"},
@@ -75,7 +88,7 @@ func TestGenerate(t *testing.T) {
 		},
 	}
 
-	wantEntry := osv.Entry{
+	wantEntry := Entry{
 		ID:      "GO-1991-0001",
 		Details: "It's a real bad one, I'll tell you that",
 		References: []osv.Reference{
@@ -85,7 +98,14 @@ func TestGenerate(t *testing.T) {
 			{Type: "WEB", URL: "web"},
 		},
 		Aliases: []string{"CVE-0000-0000", "GHSA-abcd-efgh"},
-		Affected: []osv.Affected{
+		Related: []string{"GO-1990-0001", "GO-1990-0002", "GO-1990-0003"},
+		Severity: []Severity{
+			{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"},
+		},
+		Credits: []Credit{
+			{Name: "Jane Doe", Contact: []string{"https://example.com/jane"}, Type: "FINDER"},
+		},
+		Affected: []Affected{
 			{
 				Package: osv.Package{
 					Name:      "example.com/vulnerable/v2",
@@ -113,7 +133,10 @@ func TestGenerate(t *testing.T) {
 						},
 					},
 				},
-				DatabaseSpecific: osv.DatabaseSpecific{URL: "https://pkg.go.dev/vuln/GO-1991-0001"},
+				DatabaseSpecific: DatabaseSpecific{
+					URL:   "https://pkg.go.dev/vuln/GO-1991-0001",
+					PURLs: []string{"pkg:golang/example.com/vulnerable/v2"},
+				},
 				EcosystemSpecific: osv.EcosystemSpecific{
 					Imports: []osv.EcosystemSpecificImport{
 						{
@@ -152,7 +175,10 @@ func TestGenerate(t *testing.T) {
 						},
 					},
 				},
-				DatabaseSpecific: osv.DatabaseSpecific{URL: "https://pkg.go.dev/vuln/GO-1991-0001"},
+				DatabaseSpecific: DatabaseSpecific{
+					URL:   "https://pkg.go.dev/vuln/GO-1991-0001",
+					PURLs: []string{"pkg:golang/vanity.host/vulnerable", "pkg:golang/vanity.host/vulnerable#package"},
+				},
 				EcosystemSpecific: osv.EcosystemSpecific{
 					Imports: []osv.EcosystemSpecificImport{
 						{
@@ -182,7 +208,10 @@ func TestGenerate(t *testing.T) {
 						},
 					},
 				},
-				DatabaseSpecific: osv.DatabaseSpecific{URL: "https://pkg.go.dev/vuln/GO-1991-0001"},
+				DatabaseSpecific: DatabaseSpecific{
+					URL:   "https://pkg.go.dev/vuln/GO-1991-0001",
+					PURLs: []string{"pkg:golang/example.com/also-vulnerable", "pkg:golang/example.com/also-vulnerable#package"},
+				},
 				EcosystemSpecific: osv.EcosystemSpecific{
 					Imports: []osv.EcosystemSpecificImport{
 						{
@@ -203,6 +232,61 @@ func TestGenerate(t *testing.T) {
 	}
 }
 
+func TestGenerateWithdrawn(t *testing.T) {
+	withdrawn := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	r := &report.Report{
+		Modules: []*report.Module{{
+			Module:   "example.com/m",
+			Packages: []*report.Package{{Package: "example.com/m"}},
+		}},
+		Description: "A description.",
+		Withdrawn:   &report.Withdrawn{Date: withdrawn, Reason: "Not a vulnerability."},
+	}
+	got := GenerateOSVEntry("GO-2022-0001", time.Time{}, r)
+	if got.Withdrawn == nil || !got.Withdrawn.Equal(withdrawn) {
+		t.Errorf("withdrawn: got %v, want %s", got.Withdrawn, withdrawn)
+	}
+	if want := "This report was withdrawn: Not a vulnerability.\n\nA description."; got.Details != want {
+		t.Errorf("details: got %q, want %q", got.Details, want)
+	}
+	// The entry still affects the module, so it stays in the index.
+	if mods := ModulesForEntry(got); !reflect.DeepEqual(mods, []string{"example.com/m"}) {
+		t.Errorf("modules: got %v", mods)
+	}
+}
+
+func TestGenerateAlternatePaths(t *testing.T) {
+	r := &report.Report{
+		Modules: []*report.Module{{
+			Module:         "example.com/new",
+			Versions:       []report.VersionRange{{Fixed: "1.2.0"}},
+			Packages:       []*report.Package{{Package: "example.com/new/p", Symbols: []string{"F"}}},
+			AlternatePaths: []string{"example.com/old"},
+		}},
+		Description: "A description.",
+	}
+	got := GenerateOSVEntry("GO-2022-0001", time.Time{}, r)
+	if len(got.Affected) != 2 {
+		t.Fatalf("got %d affected, want 2", len(got.Affected))
+	}
+	old := got.Affected[1]
+	if old.Package.Name != "example.com/old" {
+		t.Errorf("got module %q, want example.com/old", old.Package.Name)
+	}
+	if !reflect.DeepEqual(old.Ranges, got.Affected[0].Ranges) {
+		t.Errorf("got ranges %v, want those of example.com/new, %v", old.Ranges, got.Affected[0].Ranges)
+	}
+	want := []osv.EcosystemSpecificImport{{Path: "example.com/old/p", Symbols: []string{"F"}}}
+	if !reflect.DeepEqual(old.EcosystemSpecific.Imports, want) {
+		t.Errorf("got imports %+v, want %+v", old.EcosystemSpecific.Imports, want)
+	}
+	mods := ModulesForEntry(got)
+	sort.Strings(mods)
+	if !reflect.DeepEqual(mods, []string{"example.com/new", "example.com/old"}) {
+		t.Errorf("modules: got %v", mods)
+	}
+}
+
 func TestSemverCanonicalize(t *testing.T) {
 	in := []report.VersionRange{
 		{
@@ -229,3 +313,105 @@ func TestSemverCanonicalize(t *testing.T) {
 		t.Fatalf("unexpected output: got %#v, want %#v", out, expected)
 	}
 }
+
+func TestWriteAndValidate(t *testing.T) {
+	newEntry := func(id string, modified time.Time, modules ...string) Entry {
+		e := Entry{ID: id, Modified: modified, Aliases: []string{"CVE-" + id}}
+		for _, m := range modules {
+			e.Affected = append(e.Affected, Affected{
+				Package: osv.Package{Name: m, Ecosystem: osv.GoEcosystem},
+				Ranges:  generateAffectedRanges(nil),
+			})
+		}
+		return e
+	}
+	t1 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(24 * time.Hour)
+	e1 := newEntry("GO-2022-0001", t1, "example.com/a")
+	e2 := newEntry("GO-2022-0002", t2, "example.com/a", "example.com/B")
+
+	dir := t.TempDir()
+	write := func(incremental bool, entries ...Entry) int {
+		t.Helper()
+		jsonVulns := map[string][]Entry{}
+		for _, e := range entries {
+			for _, m := range ModulesForEntry(e) {
+				jsonVulns[m] = append(jsonVulns[m], e)
+			}
+		}
+		files, err := dbFiles(jsonVulns, entries, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, err := writeFiles(dir, files, incremental)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := writeZip(filepath.Join(dir, zipFile), files); err != nil {
+			t.Fatal(err)
+		}
+		if err := Validate(dir); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	// index.json, aliases.json, modified.json, ID/index.json, two module
+	// files (example.com/!b.json for example.com/B) and two entries.
+	if got, want := write(false, e1, e2), 8; got != want {
+		t.Errorf("first write: wrote %d files, want %d", got, want)
+	}
+	for _, test := range []struct {
+		since time.Time
+		want  []ModifiedEntry
+	}{
+		{t1, []ModifiedEntry{{e2.ID, t2}, {e1.ID, t1}}},
+		{t2, []ModifiedEntry{{e2.ID, t2}}},
+		{t2.Add(time.Second), []ModifiedEntry{}},
+	} {
+		got, err := ModifiedSince(dir, test.since)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("ModifiedSince(%s): mismatch (-want, +got):\n%s", test.since, diff)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "example.com", "!b.json")); err != nil {
+		t.Error(err)
+	}
+	if got, want := write(true, e1, e2), 0; got != want {
+		t.Errorf("unchanged write: wrote %d files, want %d", got, want)
+	}
+	// Removing e2 rewrites the indexes and example.com/a, and removes
+	// example.com/B and e2.
+	if got, want := write(true, e1), 7; got != want {
+		t.Errorf("write without e2: wrote %d files, want %d", got, want)
+	}
+
+	// Validate catches inconsistencies.
+	if err := os.WriteFile(filepath.Join(dir, idDirectory, "index.json"), []byte(`["GO-2022-0001", "GO-2022-0002"]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Validate(dir); err == nil || !strings.Contains(err.Error(), "ID index") {
+		t.Errorf("got %v, want ID index error", err)
+	}
+
+	// A withdrawn entry must have been modified when it was withdrawn.
+	withdrawn := t2
+	e1.Withdrawn = &withdrawn
+	jsonVulns := map[string][]Entry{"example.com/a": {e1}}
+	files, err := dbFiles(jsonVulns, []Entry{e1}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := writeFiles(dir, files, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeZip(filepath.Join(dir, zipFile), files); err != nil {
+		t.Fatal(err)
+	}
+	if err := Validate(dir); err == nil || !strings.Contains(err.Error(), "withdrawn") {
+		t.Errorf("got %v, want withdrawn error", err)
+	}
+}
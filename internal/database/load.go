@@ -13,7 +13,6 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/vuln/client"
-	"golang.org/x/vuln/osv"
 	"golang.org/x/vulndb/internal/derrors"
 )
 
@@ -39,10 +38,10 @@ func Diff(dbname1, dbname2 string) (err error) {
 	return nil
 }
 
-func loadDB(dbPath string) (_ client.DBIndex, _ map[string][]osv.Entry, err error) {
+func loadDB(dbPath string) (_ client.DBIndex, _ map[string][]Entry, err error) {
 	defer derrors.Wrap(&err, "loadDB(%q)", dbPath)
 	index := client.DBIndex{}
-	dbMap := map[string][]osv.Entry{}
+	dbMap := map[string][]Entry{}
 
 	var loadDir func(string) error
 	loadDir = func(path string) error {
@@ -58,6 +57,10 @@ func loadDB(dbPath string) (_ client.DBIndex, _ map[string][]osv.Entry, err erro
 				}
 				continue
 			}
+			if !strings.HasSuffix(f.Name(), ".json") {
+				// The zip file has the same contents as the others.
+				continue
+			}
 			content, err := os.ReadFile(fpath)
 			if err != nil {
 				return err
@@ -66,20 +69,23 @@ func loadDB(dbPath string) (_ client.DBIndex, _ map[string][]osv.Entry, err erro
 				if err := json.Unmarshal(content, &index); err != nil {
 					return fmt.Errorf("unable to parse %q: %s", fpath, err)
 				}
+			} else if path == dbPath && (f.Name() == aliasesFile || f.Name() == modifiedFile) {
+				// These are derived from the entries, like the ID index.
+				continue
 			} else if path == filepath.Join(dbPath, idDirectory) {
 				if f.Name() == "index.json" {
 					// The ID index is just a list of the entries' IDs; we'll
 					// catch any diffs in the entries themselves.
 					continue
 				}
-				var entry osv.Entry
+				var entry Entry
 				if err := json.Unmarshal(content, &entry); err != nil {
 					return fmt.Errorf("unable to parse %q: %s", fpath, err)
 				}
 				fname := strings.TrimPrefix(fpath, dbPath)
-				dbMap[fname] = []osv.Entry{entry}
+				dbMap[fname] = []Entry{entry}
 			} else {
-				var entries []osv.Entry
+				var entries []Entry
 				if err := json.Unmarshal(content, &entries); err != nil {
 					return fmt.Errorf("unable to parse %q: %s", fpath, err)
 				}
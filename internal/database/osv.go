@@ -0,0 +1,89 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"time"
+
+	"golang.org/x/vuln/osv"
+)
+
+// An Entry is an OSV entry, as the database has it. It is osv.Entry with the
+// fields of the OSV schema that golang.org/x/vuln/osv doesn't have yet:
+// related, severity and credits, and package URLs in the database_specific
+// field of each affected module. Clients that read entries as osv.Entry
+// ignore those fields.
+type Entry struct {
+	ID         string          `json:"id"`
+	Published  time.Time       `json:"published,omitempty"`
+	Modified   time.Time       `json:"modified,omitempty"`
+	Withdrawn  *time.Time      `json:"withdrawn,omitempty"`
+	Aliases    []string        `json:"aliases,omitempty"`
+	Related    []string        `json:"related,omitempty"`
+	Details    string          `json:"details"`
+	Severity   []Severity      `json:"severity,omitempty"`
+	Affected   []Affected      `json:"affected"`
+	References []osv.Reference `json:"references,omitempty"`
+	Credits    []Credit        `json:"credits,omitempty"`
+}
+
+// Affected is osv.Affected, with package URLs in its database_specific field.
+type Affected struct {
+	Package           osv.Package           `json:"package"`
+	Ranges            osv.Affects           `json:"ranges,omitempty"`
+	DatabaseSpecific  DatabaseSpecific      `json:"database_specific"`
+	EcosystemSpecific osv.EcosystemSpecific `json:"ecosystem_specific"`
+}
+
+// DatabaseSpecific is osv.DatabaseSpecific, with package URLs.
+type DatabaseSpecific struct {
+	// URL is the page of the entry on pkg.go.dev.
+	URL string `json:"url"`
+	// PURLs are the package URLs of the module and of each of its affected
+	// packages, as computed by report.Module.PURLs.
+	PURLs []string `json:"purls,omitempty"`
+}
+
+// A Severity is a score of the severity of a vulnerability.
+type Severity struct {
+	// Type is the scoring system, like "CVSS_V3".
+	Type string `json:"type"`
+	// Score is the score in that system, like a CVSS vector string.
+	Score string `json:"score"`
+}
+
+// The severity types of OSV.
+const SeverityCVSSV3 = "CVSS_V3"
+
+// A Credit acknowledges a person or organization for their part in the
+// discovery or fix of a vulnerability.
+type Credit struct {
+	Name    string   `json:"name"`
+	Contact []string `json:"contact,omitempty"`
+	Type    string   `json:"type,omitempty"`
+}
+
+// OSV returns e as an osv.Entry, without the fields that osv.Entry lacks, for
+// code that uses golang.org/x/vuln, like its vulncheck package.
+func (e *Entry) OSV() *osv.Entry {
+	o := &osv.Entry{
+		ID:         e.ID,
+		Published:  e.Published,
+		Modified:   e.Modified,
+		Withdrawn:  e.Withdrawn,
+		Aliases:    e.Aliases,
+		Details:    e.Details,
+		References: e.References,
+	}
+	for _, a := range e.Affected {
+		o.Affected = append(o.Affected, osv.Affected{
+			Package:           a.Package,
+			Ranges:            a.Ranges,
+			DatabaseSpecific:  osv.DatabaseSpecific{URL: a.DatabaseSpecific.URL},
+			EcosystemSpecific: a.EcosystemSpecific,
+		})
+	}
+	return o
+}
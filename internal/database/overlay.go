@@ -0,0 +1,133 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// overlayEntries returns the OSV entries of the YAML reports in the yamlDir
+// of overlayDir, a git repo that an organization keeps for vulnerabilities
+// that are not public. Excluded reports, and reports embargoed until after
+// now, have no entries.
+//
+// Unlike the public reports, whose OSV entries are generated and committed
+// by vulnreport, the overlay reports are converted here, so that the overlay
+// needs only YAML files. Their times come from the git history of the
+// overlay, as for the public entries, but a report is not published before
+// its embargo ends.
+func overlayEntries(ctx context.Context, overlayDir string, now time.Time) (_ []Entry, err error) {
+	defer derrors.Wrap(&err, "overlayEntries(%q)", overlayDir)
+
+	repo, err := gitrepo.Open(ctx, overlayDir)
+	if err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(filepath.Join(overlayDir, yamlDir))
+	if err != nil {
+		return nil, fmt.Errorf("can't read %q: %s", yamlDir, err)
+	}
+	commitDates, err := gitrepo.AllCommitDates(repo, gitrepo.HeadReference, yamlDir)
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), ".yaml") {
+			continue
+		}
+		filename := filepath.Join(overlayDir, yamlDir, f.Name())
+		r, err := report.Read(filename)
+		if err != nil {
+			return nil, err
+		}
+		if r.Excluded != "" || r.Embargo.After(now) {
+			continue
+		}
+		repoPath := yamlDir + "/" + f.Name()
+		if lints := r.Lint(filename); len(lints) > 0 {
+			return nil, fmt.Errorf("%s has lint errors:\n  %s", repoPath, strings.Join(lints, "\n  "))
+		}
+		dates, ok := commitDates[repoPath]
+		if !ok {
+			return nil, fmt.Errorf("can't find git repo commit dates for %q", repoPath)
+		}
+		entry := GenerateOSVEntry(f.Name(), time.Time{}, r)
+		// Private entries have no page on pkg.go.dev.
+		for i := range entry.Affected {
+			entry.Affected[i].DatabaseSpecific.URL = ""
+		}
+		setDates(&entry, dates)
+		setEmbargoDates(&entry, r)
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// setEmbargoDates moves the publication time of the entry of r, if it is
+// before the embargo of r, to the end of the embargo, since that is when
+// the entry appears in the database. Like a withdrawal, the end of the
+// embargo is also the earliest modification time, so that clients relying
+// on the index fetch the entry.
+func setEmbargoDates(entry *Entry, r *report.Report) {
+	if r.Embargo.IsZero() {
+		return
+	}
+	embargo := r.Embargo.UTC()
+	if entry.Published.Before(embargo) {
+		entry.Published = embargo
+	}
+	if entry.Modified.Before(embargo) {
+		entry.Modified = embargo
+	}
+}
+
+// OverlayEmbargoes returns the embargoes of the reports in the yamlDir of
+// overlayDir, keyed by the IDs of the reports. Reports without embargoes,
+// and excluded reports, are left out.
+func OverlayEmbargoes(overlayDir string) (_ map[string]time.Time, err error) {
+	defer derrors.Wrap(&err, "OverlayEmbargoes(%q)", overlayDir)
+
+	files, err := os.ReadDir(filepath.Join(overlayDir, yamlDir))
+	if err != nil {
+		return nil, err
+	}
+	embargoes := map[string]time.Time{}
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), ".yaml") {
+			continue
+		}
+		r, err := report.Read(filepath.Join(overlayDir, yamlDir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if r.Excluded != "" || r.Embargo.IsZero() {
+			continue
+		}
+		embargoes[strings.TrimSuffix(f.Name(), ".yaml")] = r.Embargo
+	}
+	return embargoes, nil
+}
+
+// HasEntry reports whether the database in dbPath has an entry with the
+// given ID.
+func HasEntry(dbPath, id string) (bool, error) {
+	_, err := os.Stat(filepath.Join(dbPath, idDirectory, id+".json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
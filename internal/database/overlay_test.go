@@ -0,0 +1,178 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// writeOverlayRepo writes a git repo with the given reports in yamlDir,
+// committed at 2022-02-01T00:00:00Z, and returns its directory.
+func writeOverlayRepo(t *testing.T, reports map[string]*report.Report) string {
+	t.Helper()
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, yamlDir), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for id, r := range reports {
+		if err := r.Write(filepath.Join(dir, yamlDir, id+".yaml")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wt.Add(yamlDir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wt.Commit("add reports", &git.CommitOptions{Author: &object.Signature{
+		Name:  "Author",
+		Email: "author@example.com",
+		When:  time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// useFakeProxy points the linting of reports at a module proxy that knows
+// every module, with no tagged versions.
+func useFakeProxy(t *testing.T) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/@v/list") {
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("GOPROXY", srv.URL)
+}
+
+func TestOverlay(t *testing.T) {
+	ctx := context.Background()
+	useFakeProxy(t)
+	repoDir := writeTestRepo(t)
+	private := &report.Report{
+		Modules:     []*report.Module{{Module: "corp.example.com/b", Packages: []*report.Package{{Package: "corp.example.com/b"}}}},
+		Description: "B is vulnerable.",
+	}
+	overlayDir := writeOverlayRepo(t, map[string]*report.Report{
+		"GO-2022-9001": private,
+		"GO-2022-9002": {Excluded: report.ExcludedNotGoCode, Modules: private.Modules},
+	})
+	dbPath := t.TempDir()
+	opts := GenerateOptions{OverlayDir: overlayDir}
+	if err := Generate(ctx, repoDir, dbPath, opts); err != nil {
+		t.Fatal(err)
+	}
+	e, err := ReadOSV(filepath.Join(dbPath, idDirectory, "GO-2022-9001.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC); !e.Modified.Equal(want) || !e.Published.Equal(want) {
+		t.Errorf("got published %s, modified %s, want %s", e.Published, e.Modified, want)
+	}
+	if got := e.Affected[0].DatabaseSpecific.URL; got != "" {
+		t.Errorf("got URL %q, want none", got)
+	}
+	for _, name := range []string{"corp.example.com/b.json", "example.com/a.json", idDirectory + "/GO-2022-0001.json"} {
+		if _, err := os.Stat(filepath.Join(dbPath, filepath.FromSlash(name))); err != nil {
+			t.Error(err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dbPath, idDirectory, "GO-2022-9002.json")); err == nil {
+		t.Error("excluded overlay report is in the database")
+	}
+	if err := Verify(ctx, repoDir, dbPath, opts); err != nil {
+		t.Error(err)
+	}
+	if err := Verify(ctx, repoDir, dbPath, GenerateOptions{}); err == nil {
+		t.Error("without overlay: got nil, want error")
+	}
+
+	// An overlay report can't have the ID of a public one.
+	overlayDir = writeOverlayRepo(t, map[string]*report.Report{"GO-2022-0001": private})
+	err = Generate(ctx, repoDir, t.TempDir(), GenerateOptions{OverlayDir: overlayDir})
+	if err == nil || !strings.Contains(err.Error(), "GO-2022-0001") {
+		t.Errorf("colliding ID: got %v, want error mentioning GO-2022-0001", err)
+	}
+
+	// An overlay report must pass lint.
+	overlayDir = writeOverlayRepo(t, map[string]*report.Report{"GO-2022-9003": {Modules: private.Modules}})
+	err = Generate(ctx, repoDir, t.TempDir(), GenerateOptions{OverlayDir: overlayDir})
+	if err == nil || !strings.Contains(err.Error(), "missing description") {
+		t.Errorf("report without description: got %v, want lint error", err)
+	}
+}
+
+func TestOverlayEmbargo(t *testing.T) {
+	ctx := context.Background()
+	useFakeProxy(t)
+	repoDir := writeTestRepo(t)
+	embargo := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	overlayDir := writeOverlayRepo(t, map[string]*report.Report{
+		"GO-2022-9001": {
+			Modules:     []*report.Module{{Module: "corp.example.com/b", Packages: []*report.Package{{Package: "corp.example.com/b"}}}},
+			Description: "B is vulnerable.",
+			Embargo:     embargo,
+		},
+	})
+	got, err := OverlayEmbargoes(overlayDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]time.Time{"GO-2022-9001": embargo}; !cmp.Equal(got, want) {
+		t.Errorf("OverlayEmbargoes = %v, want %v", got, want)
+	}
+
+	// Before the embargo ends, the report is left out.
+	dbPath := t.TempDir()
+	opts := GenerateOptions{Incremental: true, OverlayDir: overlayDir, Now: embargo.Add(-time.Hour)}
+	if err := Generate(ctx, repoDir, dbPath, opts); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := HasEntry(dbPath, "GO-2022-9001"); err != nil || ok {
+		t.Fatalf("before embargo: HasEntry = %t, %v; want false, nil", ok, err)
+	}
+	if _, err := os.Stat(filepath.Join(dbPath, "corp.example.com", "b.json")); err == nil {
+		t.Error("before embargo: module of embargoed report is in the database")
+	}
+
+	// After it ends, the report is published, at the end of the embargo.
+	opts.Now = embargo.Add(time.Hour)
+	if err := Generate(ctx, repoDir, dbPath, opts); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := HasEntry(dbPath, "GO-2022-9001"); err != nil || !ok {
+		t.Fatalf("after embargo: HasEntry = %t, %v; want true, nil", ok, err)
+	}
+	e, err := ReadOSV(filepath.Join(dbPath, idDirectory, "GO-2022-9001.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.Published.Equal(embargo) || !e.Modified.Equal(embargo) {
+		t.Errorf("got published %s, modified %s, want %s", e.Published, e.Modified, embargo)
+	}
+	if err := Verify(ctx, repoDir, dbPath, opts); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/vuln/client"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// LookupPURL returns the entries of the database at dbPath that affect the Go
+// module or package with the given package URL, like
+// "pkg:golang/golang.org/x/net@v0.1.0#http2". If the package URL has no
+// version, entries that affect any version are returned; if it has no
+// subpath, entries that affect any package of the module are returned.
+//
+// The entries list the package URLs of each affected module and its packages
+// in its database_specific field, but older entries in a database may not, so
+// the lookup goes by the module and package paths instead, which are what
+// the package URLs are computed from.
+func LookupPURL(dbPath, purl string) (_ []Entry, err error) {
+	defer derrors.Wrap(&err, "LookupPURL(%q, %q)", dbPath, purl)
+
+	p, err := report.ParsePURL(purl)
+	if err != nil {
+		return nil, err
+	}
+	name := p.Module
+	switch name {
+	case stdlib.ModulePath:
+		name = stdFileName
+	case cmdModule:
+		name = toolchainFileName
+	}
+	escaped, err := client.EscapeModulePath(name)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(filepath.Join(dbPath, escaped+".json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		// The database has no entries for the module.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, err
+	}
+	var matches []Entry
+	for _, e := range entries {
+		if affectsPURL(e, name, p) {
+			matches = append(matches, e)
+		}
+	}
+	return matches, nil
+}
+
+// affectsPURL reports whether e affects the module with the given name in the
+// database at the version and package of p.
+func affectsPURL(e Entry, name string, p *report.PURL) bool {
+	for _, a := range e.Affected {
+		if a.Package.Name != name {
+			continue
+		}
+		if p.Version != "" && !a.Ranges.AffectsSemver(p.Version) {
+			continue
+		}
+		if p.Package == "" || affectsPackage(a, p.Package) {
+			return true
+		}
+	}
+	return false
+}
+
+// affectsPackage reports whether a affects the package with the given import
+// path. Entries that list no packages affect every package of the module.
+func affectsPackage(a Affected, pkgPath string) bool {
+	imports := a.EcosystemSpecific.Imports
+	if len(imports) == 0 {
+		return true
+	}
+	for _, imp := range imports {
+		if imp.Path == pkgPath {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestLookupPURL(t *testing.T) {
+	modified := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	net := GenerateOSVEntry("GO-2022-0001.yaml", modified, &report.Report{
+		Modules: []*report.Module{{
+			Module:   "golang.org/x/net",
+			Versions: []report.VersionRange{{Fixed: "0.1.0"}},
+			Packages: []*report.Package{{Package: "golang.org/x/net/http2"}},
+		}},
+	})
+	std := GenerateOSVEntry("GO-2022-0002.yaml", modified, &report.Report{
+		Modules: []*report.Module{{
+			Module:   "std",
+			Versions: []report.VersionRange{{Introduced: "1.18.0", Fixed: "1.18.3"}},
+			Packages: []*report.Package{{Package: "net/http"}},
+		}},
+	})
+	entries := []Entry{net, std}
+	jsonVulns := map[string][]Entry{}
+	for _, e := range entries {
+		for _, m := range ModulesForEntry(e) {
+			jsonVulns[m] = append(jsonVulns[m], e)
+		}
+	}
+	files, err := dbFiles(jsonVulns, entries, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if _, err := writeFiles(dir, files, false); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		purl string
+		want []string
+	}{
+		{"pkg:golang/golang.org/x/net", []string{"GO-2022-0001"}},
+		{"pkg:golang/golang.org/x/net@v0.0.9#http2", []string{"GO-2022-0001"}},
+		{"pkg:golang/golang.org/x/net@v0.1.0", nil},
+		{"pkg:golang/golang.org/x/net#html", nil},
+		{"pkg:golang/stdlib@v1.18.2#net/http", []string{"GO-2022-0002"}},
+		{"pkg:golang/stdlib@v1.17.0", nil},
+		{"pkg:golang/example.com/m", nil},
+	} {
+		got, err := LookupPURL(dir, test.purl)
+		if err != nil {
+			t.Fatalf("%s: %v", test.purl, err)
+		}
+		var ids []string
+		for _, e := range got {
+			ids = append(ids, e.ID)
+		}
+		if diff := cmp.Diff(test.want, ids); diff != "" {
+			t.Errorf("%s: mismatch (-want, +got):\n%s", test.purl, diff)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+const (
+	// checksumsFile is the name of the file of the database that lists the
+	// SHA-256 checksum of every other file, in the format of sha256sum.
+	checksumsFile = "checksums.txt"
+
+	// signatureFile is the name of the file of the database that holds the
+	// Ed25519 signature of checksumsFile, in base64. Since the checksums
+	// cover every file, the signature covers the whole database.
+	signatureFile = checksumsFile + ".sig"
+)
+
+// checksums returns the contents of the checksums file for files, keyed by
+// their slash-separated paths: a line with the hex SHA-256 checksum and the
+// path of each file, sorted by path.
+func checksums(files map[string][]byte) []byte {
+	paths := maps.Keys(files)
+	sort.Strings(paths)
+	var buf bytes.Buffer
+	for _, path := range paths {
+		fmt.Fprintf(&buf, "%x  %s\n", sha256.Sum256(files[path]), path)
+	}
+	return buf.Bytes()
+}
+
+// writeChecksums writes the checksums file of the database in dbPath, whose
+// files are files and the zip file. If key is not nil, it also writes the
+// signature file; otherwise it removes any signature of a previous version
+// of the database.
+func writeChecksums(dbPath string, files map[string][]byte, key ed25519.PrivateKey) error {
+	zip, err := os.ReadFile(filepath.Join(dbPath, zipFile))
+	if err != nil {
+		return err
+	}
+	all := map[string][]byte{zipFile: zip}
+	for path, content := range files {
+		all[path] = content
+	}
+	sums := checksums(all)
+	if err := os.WriteFile(filepath.Join(dbPath, checksumsFile), sums, 0644); err != nil {
+		return err
+	}
+	sigPath := filepath.Join(dbPath, signatureFile)
+	if key == nil {
+		if err := os.Remove(sigPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(key, sums)) + "\n"
+	return os.WriteFile(sigPath, []byte(sig), 0644)
+}
+
+// validateChecksums checks that the checksums file of the database in dbPath
+// lists exactly paths, the slash-separated paths of its other files, with
+// their checksums.
+func validateChecksums(dbPath string, paths []string) error {
+	data, err := os.ReadFile(filepath.Join(dbPath, checksumsFile))
+	if err != nil {
+		return err
+	}
+	listed := map[string]bool{}
+	scan := bufio.NewScanner(bytes.NewReader(data))
+	for scan.Scan() {
+		sum, path, ok := strings.Cut(scan.Text(), "  ")
+		if !ok {
+			return fmt.Errorf("%s: bad line %q", checksumsFile, scan.Text())
+		}
+		content, err := os.ReadFile(filepath.Join(dbPath, filepath.FromSlash(path)))
+		if err != nil {
+			return fmt.Errorf("%s: %v", checksumsFile, err)
+		}
+		if got := fmt.Sprintf("%x", sha256.Sum256(content)); got != sum {
+			return fmt.Errorf("%s: %s has checksum %s, want %s", checksumsFile, path, got, sum)
+		}
+		listed[path] = true
+	}
+	if err := scan.Err(); err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if !listed[path] {
+			return fmt.Errorf("%s does not list %s", checksumsFile, path)
+		}
+	}
+	if len(listed) != len(paths) {
+		return fmt.Errorf("%s lists %d files, database has %d", checksumsFile, len(listed), len(paths))
+	}
+	return nil
+}
+
+// CheckSignature checks that the database in dbPath was signed with the
+// private key of pub, and that its files have the checksums that were signed.
+// Mirrors of the database can use it to check that what they serve is
+// authentic.
+func CheckSignature(dbPath string, pub ed25519.PublicKey) (err error) {
+	defer derrors.Wrap(&err, "CheckSignature(%q)", dbPath)
+
+	sums, err := os.ReadFile(filepath.Join(dbPath, checksumsFile))
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(filepath.Join(dbPath, signatureFile))
+	if err != nil {
+		return err
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return fmt.Errorf("%s: %v", signatureFile, err)
+	}
+	if !ed25519.Verify(pub, sums, sig) {
+		return fmt.Errorf("%s is not a valid signature of %s", signatureFile, checksumsFile)
+	}
+	paths, err := dbFilePaths(dbPath)
+	if err != nil {
+		return err
+	}
+	return validateChecksums(dbPath, append(paths, zipFile))
+}
+
+// ParseSigningKey parses a PEM-encoded Ed25519 private key in PKCS #8 form,
+// like one created by
+//
+//	openssl genpkey -algorithm ed25519
+func ParseSigningKey(data []byte) (_ ed25519.PrivateKey, err error) {
+	defer derrors.Wrap(&err, "ParseSigningKey")
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, errors.New("no PEM PRIVATE KEY block")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("key is a %T, not an Ed25519 key", key)
+	}
+	return edKey, nil
+}
+
+// ParsePublicKey parses a PEM-encoded Ed25519 public key in PKIX form, like
+// one created by
+//
+//	openssl pkey -pubout
+func ParsePublicKey(data []byte) (_ ed25519.PublicKey, err error) {
+	defer derrors.Wrap(&err, "ParsePublicKey")
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errors.New("no PEM PUBLIC KEY block")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("key is a %T, not an Ed25519 key", key)
+	}
+	return edKey, nil
+}
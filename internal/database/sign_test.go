@@ -0,0 +1,89 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	ctx := context.Background()
+	pub, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The keys survive a round trip through PEM.
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotKey, err := ParseSigningKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gotKey.Equal(key) {
+		t.Error("ParseSigningKey: got a different key")
+	}
+	der, err = x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotPub, err := ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gotPub.Equal(pub) {
+		t.Error("ParsePublicKey: got a different key")
+	}
+
+	repoDir := writeTestRepo(t)
+	dbPath := t.TempDir()
+	if err := Generate(ctx, repoDir, dbPath, GenerateOptions{SigningKey: key}); err != nil {
+		t.Fatal(err)
+	}
+	sums, err := os.ReadFile(filepath.Join(dbPath, checksumsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"ID/GO-2022-0001.json", "example.com/a.json", "vulns.zip"} {
+		if !strings.Contains(string(sums), "  "+path+"\n") {
+			t.Errorf("%s does not list %s", checksumsFile, path)
+		}
+	}
+	if err := CheckSignature(dbPath, pub); err != nil {
+		t.Fatal(err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckSignature(dbPath, otherPub); err == nil || !strings.Contains(err.Error(), "not a valid signature") {
+		t.Errorf("other key: got %v, want invalid signature error", err)
+	}
+
+	// A changed file no longer matches its signed checksum.
+	if err := os.WriteFile(filepath.Join(dbPath, aliasesFile), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckSignature(dbPath, pub); err == nil || !strings.Contains(err.Error(), "aliases.json has checksum") {
+		t.Errorf("changed file: got %v, want checksum error", err)
+	}
+
+	// Generating without a key removes the stale signature.
+	if err := Generate(ctx, repoDir, dbPath, GenerateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dbPath, signatureFile)); !os.IsNotExist(err) {
+		t.Errorf("signature file: got %v, want not exist", err)
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"golang.org/x/vuln/client"
+	"golang.org/x/vuln/osv"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// idRegexp matches the IDs of entries in the database.
+var idRegexp = regexp.MustCompile(`^GO-\d{4}-\d{4,}$`)
+
+// Validate checks that the database in dbPath is consistent: that each module
+// in the index has a file of entries that affect it, modified at the time the
+// index says; that each entry has a file in the ID directory, listed in its
+// index, and aliases that map to it, and was not modified before it was
+// withdrawn; that the modified index lists each entry at its modification
+// time, most recent first; that the zip file holds exactly the other
+// files; and that the checksums file, if any, lists all of them with their
+// checksums.
+func Validate(dbPath string) (err error) {
+	defer derrors.Wrap(&err, "Validate(%q)", dbPath)
+
+	read := func(path string, v any) error {
+		data, err := os.ReadFile(filepath.Join(dbPath, filepath.FromSlash(path)))
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		return nil
+	}
+
+	var index client.DBIndex
+	if err := read(indexFile, &index); err != nil {
+		return err
+	}
+	byID := map[string]Entry{}
+	wantPaths := map[string]bool{
+		indexFile:                     true,
+		aliasesFile:                   true,
+		modifiedFile:                  true,
+		idDirectory + "/" + indexFile: true,
+	}
+	for modulePath, modified := range index {
+		epath, err := client.EscapeModulePath(modulePath)
+		if err != nil {
+			return err
+		}
+		path := epath + ".json"
+		wantPaths[path] = true
+		var entries []Entry
+		if err := read(path, &entries); err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return fmt.Errorf("%s: no entries", path)
+		}
+		latest := entries[0].Modified
+		for _, e := range entries {
+			if !affects(e, modulePath) {
+				return fmt.Errorf("%s: entry %s does not affect %s", path, e.ID, modulePath)
+			}
+			if e.Modified.After(latest) {
+				latest = e.Modified
+			}
+			byID[e.ID] = e
+		}
+		if !latest.Equal(modified) {
+			return fmt.Errorf("%s: index has modification time %s, entries have %s", modulePath, modified, latest)
+		}
+	}
+
+	var ids []string
+	if err := read(idDirectory+"/"+indexFile, &ids); err != nil {
+		return err
+	}
+	if got, want := sortedCopy(ids), sortedCopy(maps.Keys(byID)); !slices.Equal(got, want) {
+		return fmt.Errorf("ID index has %v, module files have %v", got, want)
+	}
+	var aliases map[string][]string
+	if err := read(aliasesFile, &aliases); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if !idRegexp.MatchString(id) {
+			return fmt.Errorf("bad ID %q", id)
+		}
+		path := idDirectory + "/" + id + ".json"
+		wantPaths[path] = true
+		var e Entry
+		if err := read(path, &e); err != nil {
+			return err
+		}
+		if e.ID != id {
+			return fmt.Errorf("%s: has ID %s", path, e.ID)
+		}
+		if err := validateEntry(e); err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		if e.Withdrawn != nil && e.Modified.Before(*e.Withdrawn) {
+			return fmt.Errorf("%s: modified at %s, before it was withdrawn at %s", path, e.Modified, *e.Withdrawn)
+		}
+		for _, a := range e.Aliases {
+			if !slices.Contains(aliases[a], id) {
+				return fmt.Errorf("%s: alias %s is not mapped to it", path, a)
+			}
+		}
+	}
+
+	var modified []ModifiedEntry
+	if err := read(modifiedFile, &modified); err != nil {
+		return err
+	}
+	if len(modified) != len(byID) {
+		return fmt.Errorf("%s has %d entries, module files have %d", modifiedFile, len(modified), len(byID))
+	}
+	for i, me := range modified {
+		e, ok := byID[me.ID]
+		if !ok {
+			return fmt.Errorf("%s: unknown entry %s", modifiedFile, me.ID)
+		}
+		if !me.Modified.Equal(e.Modified) {
+			return fmt.Errorf("%s: %s has modification time %s, entry has %s", modifiedFile, me.ID, me.Modified, e.Modified)
+		}
+		if i > 0 && me.Modified.After(modified[i-1].Modified) {
+			return fmt.Errorf("%s: %s is not sorted by modification time", modifiedFile, me.ID)
+		}
+	}
+
+	paths, err := dbFilePaths(dbPath)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if !wantPaths[path] {
+			return fmt.Errorf("unexpected file %s", path)
+		}
+	}
+	if err := validateZip(dbPath, paths); err != nil {
+		return err
+	}
+	// A database written by Generate has a checksums file, but one written
+	// by other means may not.
+	if _, err := os.Stat(filepath.Join(dbPath, checksumsFile)); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return validateChecksums(dbPath, append(paths, zipFile))
+}
+
+// validateEntry checks the affected packages and ranges of an entry.
+func validateEntry(e Entry) error {
+	if len(e.Affected) == 0 {
+		return errors.New("no affected packages")
+	}
+	for _, a := range e.Affected {
+		if a.Package.Ecosystem != osv.GoEcosystem {
+			return fmt.Errorf("%s: ecosystem is %q, not %q", a.Package.Name, a.Package.Ecosystem, osv.GoEcosystem)
+		}
+		for _, r := range a.Ranges {
+			if r.Type != osv.TypeSemver {
+				return fmt.Errorf("%s: range type is %q, not %q", a.Package.Name, r.Type, osv.TypeSemver)
+			}
+			if len(r.Events) == 0 {
+				return fmt.Errorf("%s: range has no events", a.Package.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateZip checks that the zip file of the database in dbPath contains
+// exactly the files at paths, with the same contents.
+func validateZip(dbPath string, paths []string) error {
+	zr, err := zip.OpenReader(filepath.Join(dbPath, zipFile))
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	var zipPaths []string
+	for _, f := range zr.File {
+		zipPaths = append(zipPaths, f.Name)
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		got, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+		want, err := os.ReadFile(filepath.Join(dbPath, filepath.FromSlash(f.Name)))
+		if err != nil {
+			return fmt.Errorf("%s: %v", zipFile, err)
+		}
+		if !bytes.Equal(got, want) {
+			return fmt.Errorf("%s: %s differs from the file", zipFile, f.Name)
+		}
+	}
+	if got, want := sortedCopy(zipPaths), sortedCopy(paths); !slices.Equal(got, want) {
+		return fmt.Errorf("%s has %v, database has %v", zipFile, got, want)
+	}
+	return nil
+}
+
+// affects reports whether e affects the module at modulePath.
+func affects(e Entry, modulePath string) bool {
+	for _, a := range e.Affected {
+		if a.Package.Name == modulePath {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedCopy(ss []string) []string {
+	c := slices.Clone(ss)
+	sort.Strings(c)
+	return c
+}
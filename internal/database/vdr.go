@@ -0,0 +1,185 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+	"golang.org/x/vuln/osv"
+	"golang.org/x/vulndb/internal/cyclonedx"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// GenerateVDR returns the database, from the OSV entries in repoDir, as a
+// CycloneDX Vulnerability Disclosure Report. If modules is not empty, the
+// report has only the entries that affect one of them, named as in the
+// database ("stdlib" for the standard library, "toolchain" for the Go
+// command). Withdrawn entries are left out, because CycloneDX has no way to
+// say that a vulnerability was withdrawn.
+func GenerateVDR(ctx context.Context, repoDir string, modules []string) (_ *cyclonedx.BOM, err error) {
+	defer derrors.Wrap(&err, "GenerateVDR(%q)", repoDir)
+
+	_, entries, err := generateEntries(ctx, repoDir, "", time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	return vdr(entries, modules), nil
+}
+
+func vdr(entries []Entry, modules []string) *cyclonedx.BOM {
+	bom := &cyclonedx.BOM{
+		BOMFormat:   cyclonedx.BOMFormat,
+		SpecVersion: cyclonedx.SpecVersion,
+		Version:     1,
+		Metadata: &cyclonedx.Metadata{
+			Tools: []*cyclonedx.Tool{{Vendor: "Go", Name: "gendb"}},
+		},
+	}
+	components := map[string]*cyclonedx.Component{}
+	for _, e := range entries {
+		if e.Withdrawn != nil {
+			continue
+		}
+		if len(modules) > 0 && !affectsAny(e, modules) {
+			continue
+		}
+		v := vdrVulnerability(e)
+		for _, a := range e.Affected {
+			ref := goPURL(a.Package.Name)
+			components[ref] = &cyclonedx.Component{
+				Type:   cyclonedx.ComponentTypeLibrary,
+				BOMRef: ref,
+				Name:   a.Package.Name,
+				PURL:   ref,
+			}
+			v.Affects = append(v.Affects, &cyclonedx.Affects{Ref: ref, Versions: vdrVersions(a.Ranges)})
+		}
+		bom.Vulnerabilities = append(bom.Vulnerabilities, v)
+		// The report is as recent as its most recently modified entry.
+		if e.Modified.After(bom.Metadata.Timestamp) {
+			bom.Metadata.Timestamp = e.Modified
+		}
+	}
+	sort.Slice(bom.Vulnerabilities, func(i, j int) bool { return bom.Vulnerabilities[i].ID < bom.Vulnerabilities[j].ID })
+	for _, c := range components {
+		bom.Components = append(bom.Components, c)
+	}
+	sort.Slice(bom.Components, func(i, j int) bool { return bom.Components[i].BOMRef < bom.Components[j].BOMRef })
+	return bom
+}
+
+// affectsAny reports whether e affects any of modules.
+func affectsAny(e Entry, modules []string) bool {
+	for _, m := range ModulesForEntry(e) {
+		if slices.Contains(modules, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// vdrVulnerability returns the vulnerability of e, without what it affects.
+func vdrVulnerability(e Entry) *cyclonedx.Vulnerability {
+	v := &cyclonedx.Vulnerability{
+		BOMRef:      e.ID,
+		ID:          e.ID,
+		Source:      &cyclonedx.Source{Name: "Go Vulnerability Database", URL: dbURL + e.ID},
+		Description: e.Details,
+		Published:   e.Published,
+		Updated:     e.Modified,
+	}
+	for _, a := range e.Aliases {
+		var src *cyclonedx.Source
+		switch {
+		case strings.HasPrefix(a, "CVE-"):
+			src = &cyclonedx.Source{Name: "NVD", URL: "https://nvd.nist.gov/vuln/detail/" + a}
+		case strings.HasPrefix(a, "GHSA-"):
+			src = &cyclonedx.Source{Name: "GitHub", URL: "https://github.com/advisories/" + a}
+		default:
+			continue
+		}
+		v.References = append(v.References, &cyclonedx.Reference{ID: a, Source: src})
+	}
+	for _, r := range e.References {
+		v.Advisories = append(v.Advisories, &cyclonedx.Advisory{URL: r.URL})
+	}
+	return v
+}
+
+// goPURL returns the package URL of the module with the given name in the
+// database.
+func goPURL(name string) string {
+	modulePath := name
+	switch name {
+	case stdFileName:
+		modulePath = stdlib.ModulePath
+	case toolchainFileName:
+		modulePath = cmdModule
+	}
+	return report.ModulePURL(modulePath)
+}
+
+// vdrVersions returns the affected version ranges of ranges, in the vers
+// format, and their fixed versions as unaffected versions. Every version of
+// a module without ranges is affected.
+func vdrVersions(ranges osv.Affects) []*cyclonedx.AffectedVersions {
+	var vs []*cyclonedx.AffectedVersions
+	for _, r := range ranges {
+		if r.Type != osv.TypeSemver {
+			continue
+		}
+		// The events alternate between introduced and fixed versions.
+		var introduced string
+		open := false
+		for _, ev := range r.Events {
+			switch {
+			case ev.Introduced != "":
+				introduced, open = ev.Introduced, true
+			case ev.Fixed != "" && open:
+				vs = append(vs, &cyclonedx.AffectedVersions{
+					Range:  versRange(introduced, ev.Fixed),
+					Status: cyclonedx.StatusAffected,
+				}, &cyclonedx.AffectedVersions{
+					Version: "v" + ev.Fixed,
+					Status:  cyclonedx.StatusUnaffected,
+				})
+				open = false
+			}
+		}
+		if open {
+			vs = append(vs, &cyclonedx.AffectedVersions{
+				Range:  versRange(introduced, ""),
+				Status: cyclonedx.StatusAffected,
+			})
+		}
+	}
+	if len(vs) == 0 {
+		vs = append(vs, &cyclonedx.AffectedVersions{Range: versRange("0", ""), Status: cyclonedx.StatusAffected})
+	}
+	return vs
+}
+
+// versRange returns the vers range of the versions from introduced up to but
+// not including fixed. An introduced version of "0" is the lowest version,
+// and an empty fixed version is past the highest.
+func versRange(introduced, fixed string) string {
+	var cs []string
+	if introduced != "0" {
+		cs = append(cs, ">=v"+introduced)
+	}
+	if fixed != "" {
+		cs = append(cs, "<v"+fixed)
+	}
+	if len(cs) == 0 {
+		return "vers:golang/*"
+	}
+	return "vers:golang/" + strings.Join(cs, "|")
+}
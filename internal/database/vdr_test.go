@@ -0,0 +1,140 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cyclonedx"
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestVDR(t *testing.T) {
+	published := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	modified := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	entry := func(id string, r *report.Report) Entry {
+		e := GenerateOSVEntry(id+".yaml", modified, r)
+		e.Published = published
+		return e
+	}
+	third := entry("GO-2022-0001", &report.Report{
+		Modules: []*report.Module{{
+			Module: "example.com/a",
+			Versions: []report.VersionRange{
+				{Fixed: "1.1.0"},
+				{Introduced: "1.2.0"},
+			},
+		}},
+		Description: "A is vulnerable.",
+		CVEs:        []string{"CVE-2022-0001"},
+		GHSAs:       []string{"GHSA-aaaa-bbbb-cccc"},
+		References:  []*report.Reference{{Type: report.ReferenceTypeFix, URL: "https://example.com/a/fix"}},
+	})
+	std := entry("GO-2022-0002", &report.Report{
+		Modules: []*report.Module{{
+			Module:   "std",
+			Versions: []report.VersionRange{{Introduced: "1.18.0", Fixed: "1.18.3"}},
+		}},
+		Description: "Std is vulnerable.",
+	})
+	withdrawn := entry("GO-2022-0003", &report.Report{
+		Modules:   []*report.Module{{Module: "example.com/a"}},
+		Withdrawn: &report.Withdrawn{Date: modified, Reason: "not a vulnerability"},
+	})
+	entries := []Entry{std, withdrawn, third}
+
+	thirdVuln := &cyclonedx.Vulnerability{
+		BOMRef: "GO-2022-0001",
+		ID:     "GO-2022-0001",
+		Source: &cyclonedx.Source{Name: "Go Vulnerability Database", URL: "https://pkg.go.dev/vuln/GO-2022-0001"},
+		References: []*cyclonedx.Reference{
+			{ID: "CVE-2022-0001", Source: &cyclonedx.Source{Name: "NVD", URL: "https://nvd.nist.gov/vuln/detail/CVE-2022-0001"}},
+			{ID: "GHSA-aaaa-bbbb-cccc", Source: &cyclonedx.Source{Name: "GitHub", URL: "https://github.com/advisories/GHSA-aaaa-bbbb-cccc"}},
+		},
+		Description: "A is vulnerable.",
+		Advisories:  []*cyclonedx.Advisory{{URL: "https://example.com/a/fix"}},
+		Published:   published,
+		Updated:     modified,
+		Affects: []*cyclonedx.Affects{{
+			Ref: "pkg:golang/example.com/a",
+			Versions: []*cyclonedx.AffectedVersions{
+				{Range: "vers:golang/<v1.1.0", Status: cyclonedx.StatusAffected},
+				{Version: "v1.1.0", Status: cyclonedx.StatusUnaffected},
+				{Range: "vers:golang/>=v1.2.0", Status: cyclonedx.StatusAffected},
+			},
+		}},
+	}
+	stdVuln := &cyclonedx.Vulnerability{
+		BOMRef:      "GO-2022-0002",
+		ID:          "GO-2022-0002",
+		Source:      &cyclonedx.Source{Name: "Go Vulnerability Database", URL: "https://pkg.go.dev/vuln/GO-2022-0002"},
+		Description: "Std is vulnerable.",
+		Published:   published,
+		Updated:     modified,
+		Affects: []*cyclonedx.Affects{{
+			Ref: "pkg:golang/stdlib",
+			Versions: []*cyclonedx.AffectedVersions{
+				{Range: "vers:golang/>=v1.18.0|<v1.18.3", Status: cyclonedx.StatusAffected},
+				{Version: "v1.18.3", Status: cyclonedx.StatusUnaffected},
+			},
+		}},
+	}
+	component := func(name string) *cyclonedx.Component {
+		return &cyclonedx.Component{
+			Type:   cyclonedx.ComponentTypeLibrary,
+			BOMRef: "pkg:golang/" + name,
+			Name:   name,
+			PURL:   "pkg:golang/" + name,
+		}
+	}
+	bom := func(cs []*cyclonedx.Component, vs []*cyclonedx.Vulnerability) *cyclonedx.BOM {
+		return &cyclonedx.BOM{
+			BOMFormat:   "CycloneDX",
+			SpecVersion: "1.4",
+			Version:     1,
+			Metadata: &cyclonedx.Metadata{
+				Timestamp: modified,
+				Tools:     []*cyclonedx.Tool{{Vendor: "Go", Name: "gendb"}},
+			},
+			Components:      cs,
+			Vulnerabilities: vs,
+		}
+	}
+
+	for _, test := range []struct {
+		name    string
+		modules []string
+		want    *cyclonedx.BOM
+	}{
+		{
+			name: "all",
+			want: bom(
+				[]*cyclonedx.Component{component("example.com/a"), component("stdlib")},
+				[]*cyclonedx.Vulnerability{thirdVuln, stdVuln}),
+		},
+		{
+			name:    "stdlib",
+			modules: []string{"stdlib"},
+			want:    bom([]*cyclonedx.Component{component("stdlib")}, []*cyclonedx.Vulnerability{stdVuln}),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got := vdr(entries, test.modules)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGoPURL(t *testing.T) {
+	for _, name := range []string{"stdlib", "toolchain", "golang.org/x/net"} {
+		if got, want := goPURL(name), "pkg:golang/"+name; got != want {
+			t.Errorf("goPURL(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// Verify rebuilds the database from the OSV entries in repoDir, and checks
+// that the database in dbPath, including its zip file and checksums, is the
+// same byte for byte. The Indent and OverlayDir options must be the ones the
+// database was generated with, and Now a time at which the same overlay
+// embargoes had ended; the others are ignored.
+// The error lists every file that differs, is missing or is unexpected.
+func Verify(ctx context.Context, repoDir, dbPath string, opts GenerateOptions) (err error) {
+	defer derrors.Wrap(&err, "Verify(%q, %q)", repoDir, dbPath)
+
+	jsonVulns, entries, err := generateEntries(ctx, repoDir, opts.OverlayDir, opts.now())
+	if err != nil {
+		return err
+	}
+	files, err := dbFiles(jsonVulns, entries, opts.Indent)
+	if err != nil {
+		return err
+	}
+	return verifyFiles(dbPath, files)
+}
+
+// verifyFiles checks that the database in dbPath consists of exactly files,
+// as returned by dbFiles, and their zip file.
+func verifyFiles(dbPath string, files map[string][]byte) error {
+	zip, err := zipContents(files)
+	if err != nil {
+		return err
+	}
+	want := map[string][]byte{zipFile: zip}
+	for path, content := range files {
+		want[path] = content
+	}
+	want[checksumsFile] = checksums(want)
+
+	var problems []string
+	for path, content := range want {
+		got, err := os.ReadFile(filepath.Join(dbPath, filepath.FromSlash(path)))
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			problems = append(problems, "missing "+path)
+		case err != nil:
+			return err
+		case !bytes.Equal(got, content):
+			problems = append(problems, "changed "+path)
+		}
+	}
+	paths, err := dbFilePaths(dbPath)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if _, ok := want[path]; !ok {
+			problems = append(problems, "unexpected "+path)
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("database differs from a rebuild:\n\t%s", strings.Join(problems, "\n\t"))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package database
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/vulndb/internal/report"
+)
+
+// writeTestRepo writes a git repo with one OSV entry, committed in a time
+// zone other than UTC at 2022-01-01T14:00:00Z, and returns its directory.
+func writeTestRepo(t *testing.T) string {
+	t.Helper()
+	repoDir := t.TempDir()
+	repo, err := git.PlainInit(repoDir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(repoDir, osvDir), 0755); err != nil {
+		t.Fatal(err)
+	}
+	entry := GenerateOSVEntry("GO-2022-0001.yaml", time.Time{}, &report.Report{
+		Modules:     []*report.Module{{Module: "example.com/a", Packages: []*report.Package{{Package: "example.com/a"}}}},
+		Description: "A is vulnerable.",
+		CVEs:        []string{"CVE-2022-0001"},
+	})
+	if err := WriteJSON(filepath.Join(repoDir, osvDir, "GO-2022-0001.json"), entry, true); err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wt.Add(osvDir); err != nil {
+		t.Fatal(err)
+	}
+	when := time.Date(2022, 1, 1, 9, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+	if _, err := wt.Commit("add GO-2022-0001", &git.CommitOptions{Author: &object.Signature{
+		Name:  "Author",
+		Email: "author@example.com",
+		When:  when,
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	return repoDir
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	repoDir := writeTestRepo(t)
+	dbPath := t.TempDir()
+	if err := Generate(ctx, repoDir, dbPath, GenerateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dbPath, idDirectory, "GO-2022-0001.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"modified":"2022-01-01T14:00:00Z"`; !strings.Contains(string(data), want) {
+		t.Errorf("entry %s does not contain %s", data, want)
+	}
+	if err := Verify(ctx, repoDir, dbPath, GenerateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// An indented database is not the same.
+	if err := Verify(ctx, repoDir, dbPath, GenerateOptions{Indent: true}); err == nil {
+		t.Error("indented: got nil, want error")
+	}
+
+	for _, change := range []func() error{
+		func() error { return os.WriteFile(filepath.Join(dbPath, indexFile), []byte("{}"), 0644) },
+		func() error { return os.Remove(filepath.Join(dbPath, aliasesFile)) },
+		func() error { return os.WriteFile(filepath.Join(dbPath, "extra.json"), []byte("[]"), 0644) },
+	} {
+		if err := change(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = Verify(ctx, repoDir, dbPath, GenerateOptions{})
+	if err == nil {
+		t.Fatal("got nil, want error")
+	}
+	for _, want := range []string{"changed index.json", "missing aliases.json", "unexpected extra.json"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dbserve serves the files of a vulnerability database written by
+// gendb, so that anyone can host a mirror of it, including in environments
+// without access to the public database.
+//
+// Files are served with their content types, with an ETag and Last-Modified
+// time for conditional requests, and gzipped for clients that accept it.
+// Directories are not listed.
+package dbserve
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options configure a handler.
+type Options struct {
+	// Username and Password, if Username is not empty, are the credentials
+	// that clients must present with HTTP basic authentication.
+	Username, Password string
+	// MaxAge, if positive, is how long clients may cache files. Only
+	// the clients themselves may cache them when Username is set.
+	MaxAge time.Duration
+}
+
+// contentTypes maps the extensions of the files of the database to their
+// content types.
+var contentTypes = map[string]string{
+	".json": "application/json",
+	".zip":  "application/zip",
+	".txt":  "text/plain; charset=utf-8",
+	// A base64 signature.
+	".sig": "text/plain; charset=utf-8",
+}
+
+// NewHandler returns a handler that serves the files of the database in dir.
+func NewHandler(dir string, opts Options) http.Handler {
+	return &handler{dir: dir, opts: opts, cache: map[string]*cachedFile{}}
+}
+
+type handler struct {
+	dir  string
+	opts Options
+
+	mu    sync.Mutex
+	cache map[string]*cachedFile // by slash-separated path
+}
+
+// A cachedFile holds what is computed from the contents of a file, and what
+// identifies the version of the file it was computed from.
+type cachedFile struct {
+	modTime time.Time
+	size    int64
+	etag    string
+	gzipped []byte // nil if the file is not compressed
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "only GET and HEAD are allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if h.opts.Username != "" && !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="vulndb"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	// Cleaning a rooted path removes any ".." that could leave the directory.
+	p := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	contentType, ok := contentTypes[path.Ext(p)]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	filename := filepath.Join(h.dir, filepath.FromSlash(p))
+	content, info, err := readFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.Printf("%s: %v", r.URL.Path, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	cf, err := h.cachedFile(p, info, content)
+	if err != nil {
+		log.Printf("%s: %v", r.URL.Path, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	hdr := w.Header()
+	hdr.Set("Content-Type", contentType)
+	if h.opts.MaxAge > 0 {
+		// Shared caches must not serve the files of an authenticated mirror
+		// to clients that have not authenticated.
+		scope := "public"
+		if h.opts.Username != "" {
+			scope = "private"
+		}
+		hdr.Set("Cache-Control", fmt.Sprintf("%s, max-age=%d", scope, int(h.opts.MaxAge.Seconds())))
+	}
+	etag := cf.etag
+	if cf.gzipped != nil {
+		hdr.Add("Vary", "Accept-Encoding")
+		if acceptsGzip(r) {
+			hdr.Set("Content-Encoding", "gzip")
+			content = cf.gzipped
+			// The gzipped file is a different representation, so it needs
+			// a different ETag.
+			etag += "-gzip"
+		}
+	}
+	hdr.Set("ETag", `"`+etag+`"`)
+	// ServeContent answers conditional requests from the ETag and
+	// modification time.
+	http.ServeContent(w, r, "", info.ModTime(), bytes.NewReader(content))
+}
+
+// authorized reports whether r has the credentials of the handler.
+func (h *handler) authorized(r *http.Request) bool {
+	user, password, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+	userOK := subtle.ConstantTimeCompare([]byte(user), []byte(h.opts.Username)) == 1
+	passwordOK := subtle.ConstantTimeCompare([]byte(password), []byte(h.opts.Password)) == 1
+	return userOK && passwordOK
+}
+
+// readFile returns the contents of a regular file and its info.
+func readFile(filename string) ([]byte, fs.FileInfo, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, nil, fs.ErrNotExist
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, info, nil
+}
+
+// cachedFile returns what is computed from content, the contents of the file
+// at path, computing it only if the file has changed since it last was.
+func (h *handler) cachedFile(path string, info fs.FileInfo, content []byte) (*cachedFile, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if cf := h.cache[path]; cf != nil && cf.modTime.Equal(info.ModTime()) && cf.size == info.Size() {
+		return cf, nil
+	}
+	cf := &cachedFile{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		etag:    fmt.Sprintf("%x", sha256.Sum256(content)),
+	}
+	// A zip file is already compressed.
+	if !strings.HasSuffix(path, ".zip") {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(content); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		cf.gzipped = buf.Bytes()
+	}
+	h.cache[path] = cf
+	return cf, nil
+}
+
+// acceptsGzip reports whether the client that sent r accepts gzipped
+// responses.
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(enc), ";")
+		if strings.TrimSpace(name) == "gzip" && strings.ReplaceAll(params, " ", "") != "q=0" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dbserve
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"index.json":           `{"example.com/a":"2022-01-01T00:00:00Z"}`,
+		"ID/GO-2022-0001.json": `{"id":"GO-2022-0001"}`,
+		"vulns.zip":            "PK",
+		"checksums.txt":        "0123  index.json\n",
+		"notes.md":             "not part of the database",
+	}
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv := httptest.NewServer(NewHandler(dir, Options{MaxAge: time.Hour}))
+	defer srv.Close()
+
+	get := func(path string, header http.Header) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.URL.Opaque = path
+		if header != nil {
+			req.Header = header
+		}
+		// Don't let the transport ask for and decompress gzip itself.
+		res, err := (&http.Transport{DisableCompression: true}).RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { res.Body.Close() })
+		return res
+	}
+
+	for _, test := range []struct {
+		path            string
+		wantStatus      int
+		wantContentType string
+	}{
+		{"/index.json", http.StatusOK, "application/json"},
+		{"/ID/GO-2022-0001.json", http.StatusOK, "application/json"},
+		{"/vulns.zip", http.StatusOK, "application/zip"},
+		{"/checksums.txt", http.StatusOK, "text/plain; charset=utf-8"},
+		{"/notes.md", http.StatusNotFound, ""},
+		{"/ID", http.StatusNotFound, ""},
+		{"/missing.json", http.StatusNotFound, ""},
+		{"/../index.json", http.StatusOK, "application/json"},
+	} {
+		res := get(test.path, nil)
+		if res.StatusCode != test.wantStatus {
+			t.Errorf("%s: got status %d, want %d", test.path, res.StatusCode, test.wantStatus)
+			continue
+		}
+		if test.wantStatus != http.StatusOK {
+			continue
+		}
+		if got := res.Header.Get("Content-Type"); got != test.wantContentType {
+			t.Errorf("%s: got content type %q, want %q", test.path, got, test.wantContentType)
+		}
+		if got, want := res.Header.Get("Cache-Control"), "public, max-age=3600"; got != want {
+			t.Errorf("%s: got Cache-Control %q, want %q", test.path, got, want)
+		}
+	}
+
+	// Gzip, for clients that accept it, and not for zip files.
+	res := get("/index.json", http.Header{"Accept-Encoding": {"gzip"}})
+	if got := res.Header.Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("got Content-Encoding %q, want gzip", got)
+	}
+	zr, err := gzip.NewReader(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(body), files["index.json"]; got != want {
+		t.Errorf("gzipped body: got %q, want %q", got, want)
+	}
+	gzipETag := res.Header.Get("ETag")
+	res = get("/vulns.zip", http.Header{"Accept-Encoding": {"gzip"}})
+	if got := res.Header.Get("Content-Encoding"); got != "" {
+		t.Errorf("zip: got Content-Encoding %q, want none", got)
+	}
+
+	// Conditional requests.
+	res = get("/index.json", nil)
+	etag, lastModified := res.Header.Get("ETag"), res.Header.Get("Last-Modified")
+	if etag == "" || etag == gzipETag {
+		t.Errorf("got ETag %q, want one different from the gzipped ETag %q", etag, gzipETag)
+	}
+	res = get("/index.json", http.Header{"If-None-Match": {etag}})
+	if res.StatusCode != http.StatusNotModified {
+		t.Errorf("If-None-Match: got status %d, want %d", res.StatusCode, http.StatusNotModified)
+	}
+	res = get("/index.json", http.Header{"If-Modified-Since": {lastModified}})
+	if res.StatusCode != http.StatusNotModified {
+		t.Errorf("If-Modified-Since: got status %d, want %d", res.StatusCode, http.StatusNotModified)
+	}
+	// A changed file has a new ETag.
+	later := time.Now().Add(time.Hour)
+	if err := os.WriteFile(filepath.Join(dir, "index.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(filepath.Join(dir, "index.json"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	res = get("/index.json", http.Header{"If-None-Match": {etag}})
+	if res.StatusCode != http.StatusOK || res.Header.Get("ETag") == etag {
+		t.Errorf("changed file: got status %d and ETag %q", res.StatusCode, res.Header.Get("ETag"))
+	}
+}
+
+func TestHandlerAuth(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "index.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(NewHandler(dir, Options{Username: "mirror", Password: "secret", MaxAge: time.Hour}))
+	defer srv.Close()
+
+	for _, test := range []struct {
+		user, password string
+		want           int
+	}{
+		{"", "", http.StatusUnauthorized},
+		{"mirror", "wrong", http.StatusUnauthorized},
+		{"mirror", "secret", http.StatusOK},
+	} {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/index.json", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if test.user != "" {
+			req.SetBasicAuth(test.user, test.password)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != test.want {
+			t.Errorf("%s:%s: got status %d, want %d", test.user, test.password, res.StatusCode, test.want)
+		}
+		if res.StatusCode == http.StatusOK {
+			if got, want := res.Header.Get("Cache-Control"), "private, max-age=3600"; got != want {
+				t.Errorf("got Cache-Control %q, want %q", got, want)
+			}
+		}
+	}
+}
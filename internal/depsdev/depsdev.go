@@ -0,0 +1,216 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package depsdev supports fetching the number of dependents, and the
+// advisories, of Go modules from the deps.dev API.
+// See https://docs.deps.dev/api/v3alpha/.
+package depsdev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// DefaultBaseURL is the URL of the deps.dev API.
+const DefaultBaseURL = "https://api.deps.dev/v3alpha"
+
+// Dependents are the counts of the packages that depend on a version of a
+// module.
+type Dependents struct {
+	// Version is the version of the module that was counted, its default
+	// version in deps.dev: usually the latest release.
+	Version string
+	// Direct is the number of packages that import the module directly.
+	Direct int `json:"directDependentCount"`
+	// Indirect is the number that depend on it only through others.
+	Indirect int `json:"indirectDependentCount"`
+}
+
+// packageResponse is the response for a package, which deps.dev calls a Go
+// module.
+type packageResponse struct {
+	Versions []struct {
+		VersionKey struct {
+			Version string `json:"version"`
+		} `json:"versionKey"`
+		IsDefault bool `json:"isDefault"`
+	} `json:"versions"`
+}
+
+// versionResponse is the response for a version of a package.
+type versionResponse struct {
+	AdvisoryKeys []struct {
+		ID string `json:"id"`
+	} `json:"advisoryKeys"`
+}
+
+// An Advisory is a security advisory in deps.dev.
+type Advisory struct {
+	// ID is the ID of the advisory, such as a GHSA ID.
+	ID string
+	// URL is the URL of the advisory at its source.
+	URL string `json:"url"`
+	// Title is a summary of the advisory.
+	Title string `json:"title"`
+	// Aliases are the other IDs of the vulnerability, such as CVE IDs.
+	Aliases []string `json:"aliases"`
+}
+
+// advisoryResponse is the response for an advisory.
+type advisoryResponse struct {
+	Advisory
+	AdvisoryKey struct {
+		ID string `json:"id"`
+	} `json:"advisoryKey"`
+}
+
+// A Client is a client for the deps.dev API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the API at baseURL.
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// errNotFound is returned by get for a response with status Not Found.
+var errNotFound = errors.New("not found")
+
+// Dependents returns the dependents of the default version of the module
+// with the given path, or nil if deps.dev does not know the module.
+func (c *Client) Dependents(ctx context.Context, modulePath string) (_ *Dependents, err error) {
+	defer derrors.Wrap(&err, "depsdev.Dependents(%q)", modulePath)
+
+	p, err := c.getPackage(ctx, modulePath)
+	if err != nil || p == nil {
+		return nil, err
+	}
+	version := ""
+	for _, v := range p.Versions {
+		if v.IsDefault {
+			version = v.VersionKey.Version
+		}
+	}
+	if version == "" {
+		return nil, nil
+	}
+	d := &Dependents{Version: version}
+	if err := c.get(ctx, c.versionURL(modulePath, version)+":dependents", d); err != nil {
+		if err == errNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return d, nil
+}
+
+// Versions returns the versions of the module with the given path that
+// deps.dev knows, or nil if it does not know the module.
+func (c *Client) Versions(ctx context.Context, modulePath string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "depsdev.Versions(%q)", modulePath)
+
+	p, err := c.getPackage(ctx, modulePath)
+	if err != nil || p == nil {
+		return nil, err
+	}
+	var vs []string
+	for _, v := range p.Versions {
+		vs = append(vs, v.VersionKey.Version)
+	}
+	return vs, nil
+}
+
+// AdvisoryIDs returns the IDs of the advisories that affect the given version
+// of the module with the given path.
+func (c *Client) AdvisoryIDs(ctx context.Context, modulePath, version string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "depsdev.AdvisoryIDs(%q, %q)", modulePath, version)
+
+	var v versionResponse
+	if err := c.get(ctx, c.versionURL(modulePath, version), &v); err != nil {
+		if err == errNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var ids []string
+	for _, k := range v.AdvisoryKeys {
+		ids = append(ids, k.ID)
+	}
+	return ids, nil
+}
+
+// Advisory returns the advisory with the given ID, or nil if there is none.
+func (c *Client) Advisory(ctx context.Context, id string) (_ *Advisory, err error) {
+	defer derrors.Wrap(&err, "depsdev.Advisory(%q)", id)
+
+	var a advisoryResponse
+	if err := c.get(ctx, fmt.Sprintf("%s/advisories/%s", c.baseURL, url.PathEscape(id)), &a); err != nil {
+		if err == errNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	a.Advisory.ID = a.AdvisoryKey.ID
+	return &a.Advisory, nil
+}
+
+// getPackage returns the package response for the module with the given
+// path, or nil if deps.dev does not know the module.
+func (c *Client) getPackage(ctx context.Context, modulePath string) (*packageResponse, error) {
+	var p packageResponse
+	if err := c.get(ctx, c.packageURL(modulePath), &p); err != nil {
+		if err == errNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+// packageURL returns the URL of the module with the given path. deps.dev
+// calls a Go module a package, and its path must be escaped as one element.
+func (c *Client) packageURL(modulePath string) string {
+	return fmt.Sprintf("%s/systems/go/packages/%s", c.baseURL, url.PathEscape(modulePath))
+}
+
+// versionURL returns the URL of the given version of the module with the
+// given path.
+func (c *Client) versionURL(modulePath, version string) string {
+	return fmt.Sprintf("%s/versions/%s", c.packageURL(modulePath), url.PathEscape(version))
+}
+
+// get decodes the JSON response to a GET of u into v.
+func (c *Client) get(ctx context.Context, u string, v interface{}) error {
+	ctx = event.Start(ctx, "depsdev.get")
+	defer event.End(ctx)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", u, res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package depsdev
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDependents(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Module paths are escaped as one path element.
+		switch r.URL.EscapedPath() {
+		case "/systems/go/packages/golang.org%2Fx%2Ftext":
+			fmt.Fprint(w, `{"versions": [
+				{"versionKey": {"system": "GO", "name": "golang.org/x/text", "version": "v0.3.7"}},
+				{"versionKey": {"system": "GO", "name": "golang.org/x/text", "version": "v0.3.8"}, "isDefault": true}
+			]}`)
+		case "/systems/go/packages/golang.org%2Fx%2Ftext/versions/v0.3.8:dependents":
+			fmt.Fprint(w, `{"dependentCount": 1500, "directDependentCount": 1000, "indirectDependentCount": 500}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	got, err := c.Dependents(context.Background(), "golang.org/x/text")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Dependents{Version: "v0.3.8", Direct: 1000, Indirect: 500}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	got, err = c.Dependents(context.Background(), "example.com/unknown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("unknown module: got %+v, want nil", got)
+	}
+}
+
+func TestAdvisories(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/systems/go/packages/golang.org%2Fx%2Fnet":
+			fmt.Fprint(w, `{"versions": [
+				{"versionKey": {"system": "GO", "name": "golang.org/x/net", "version": "v0.1.0"}},
+				{"versionKey": {"system": "GO", "name": "golang.org/x/net", "version": "v0.2.0"}, "isDefault": true}
+			]}`)
+		case "/systems/go/packages/golang.org%2Fx%2Fnet/versions/v0.1.0":
+			fmt.Fprint(w, `{"versionKey": {"version": "v0.1.0"}, "advisoryKeys": [{"id": "GHSA-aaaa-bbbb-cccc"}]}`)
+		case "/systems/go/packages/golang.org%2Fx%2Fnet/versions/v0.2.0":
+			fmt.Fprint(w, `{"versionKey": {"version": "v0.2.0"}}`)
+		case "/advisories/GHSA-aaaa-bbbb-cccc":
+			fmt.Fprint(w, `{"advisoryKey": {"id": "GHSA-aaaa-bbbb-cccc"}, "url": "https://osv.dev/vulnerability/GHSA-aaaa-bbbb-cccc",
+				"title": "Panic in golang.org/x/net", "aliases": ["CVE-2022-1234"]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewClient(srv.URL)
+	vs, err := c.Versions(ctx, "golang.org/x/net")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"v0.1.0", "v0.2.0"}, vs); diff != "" {
+		t.Errorf("Versions mismatch (-want, +got):\n%s", diff)
+	}
+	for _, test := range []struct {
+		version string
+		want    []string
+	}{
+		{"v0.1.0", []string{"GHSA-aaaa-bbbb-cccc"}},
+		{"v0.2.0", nil},
+		{"v0.3.0", nil},
+	} {
+		got, err := c.AdvisoryIDs(ctx, "golang.org/x/net", test.version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("AdvisoryIDs(%s) mismatch (-want, +got):\n%s", test.version, diff)
+		}
+	}
+	a, err := c.Advisory(ctx, "GHSA-aaaa-bbbb-cccc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Advisory{
+		ID:      "GHSA-aaaa-bbbb-cccc",
+		URL:     "https://osv.dev/vulnerability/GHSA-aaaa-bbbb-cccc",
+		Title:   "Panic in golang.org/x/net",
+		Aliases: []string{"CVE-2022-1234"},
+	}
+	if diff := cmp.Diff(want, a); diff != "" {
+		t.Errorf("Advisory mismatch (-want, +got):\n%s", diff)
+	}
+	if a, err := c.Advisory(ctx, "GHSA-xxxx-xxxx-xxxx"); err != nil || a != nil {
+		t.Errorf("unknown advisory: got %+v, %v; want nil, nil", a, err)
+	}
+}
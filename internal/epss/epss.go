@@ -0,0 +1,117 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package epss supports fetching Exploit Prediction Scoring System (EPSS)
+// scores of CVEs from the FIRST API.
+// See https://www.first.org/epss/api.
+package epss
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// DefaultBaseURL is the URL of the FIRST EPSS API.
+const DefaultBaseURL = "https://api.first.org/data/v1/epss"
+
+// maxIDsPerRequest is the number of CVE IDs requested at once. The API
+// limits the length of the query, not the number of IDs, so this leaves
+// plenty of room.
+const maxIDsPerRequest = 100
+
+// A Score is the EPSS score of a CVE.
+type Score struct {
+	CVE string `json:"cve"`
+	// EPSS is the probability of exploitation in the next 30 days, from 0
+	// to 1.
+	EPSS float64 `json:"epss,string"`
+	// Percentile is the fraction of CVEs whose EPSS is at most this one's.
+	Percentile float64 `json:"percentile,string"`
+	// Date is the day the score was computed, as YYYY-MM-DD.
+	Date string `json:"date"`
+}
+
+// response is a page of the API's response.
+type response struct {
+	Status string   `json:"status"`
+	Total  int      `json:"total"`
+	Offset int      `json:"offset"`
+	Limit  int      `json:"limit"`
+	Data   []*Score `json:"data"`
+}
+
+// A Client is a client for the EPSS API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the API at baseURL.
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// Scores returns the EPSS scores of the CVEs with the given IDs, keyed by
+// ID. CVEs that the API has no score for are omitted.
+func (c *Client) Scores(ctx context.Context, ids []string) (_ map[string]*Score, err error) {
+	defer derrors.Wrap(&err, "epss.Scores(%d IDs)", len(ids))
+
+	scores := map[string]*Score{}
+	for i := 0; i < len(ids); i += maxIDsPerRequest {
+		j := i + maxIDsPerRequest
+		if j > len(ids) {
+			j = len(ids)
+		}
+		params := url.Values{}
+		params.Set("cve", strings.Join(ids[i:j], ","))
+		// Ask for every score in one page.
+		params.Set("limit", strconv.Itoa(j-i))
+		resp, err := c.get(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range resp.Data {
+			scores[s.CVE] = s
+		}
+	}
+	return scores, nil
+}
+
+func (c *Client) get(ctx context.Context, params url.Values) (*response, error) {
+	ctx = event.Start(ctx, "epss.get")
+	defer event.End(ctx)
+
+	u := c.baseURL + "?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", u, res.Status)
+	}
+	var r response
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return nil, err
+	}
+	if r.Status != "OK" {
+		return nil, fmt.Errorf("%s returned status %q", u, r.Status)
+	}
+	return &r, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epss
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScores(t *testing.T) {
+	known := map[string]string{
+		"CVE-2022-0001": "0.00123",
+		"CVE-2022-0002": "0.97500",
+	}
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// The API returns numbers as strings.
+		var data []map[string]string
+		for _, id := range strings.Split(r.URL.Query().Get("cve"), ",") {
+			if e, ok := known[id]; ok {
+				data = append(data, map[string]string{
+					"cve":        id,
+					"epss":       e,
+					"percentile": "0.5",
+					"date":       "2022-10-01",
+				})
+			}
+		}
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "OK",
+			"total":  len(data),
+			"data":   data,
+		}); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	// Request enough IDs to need two requests.
+	ids := []string{"CVE-2022-0001"}
+	for i := 0; i < maxIDsPerRequest; i++ {
+		ids = append(ids, fmt.Sprintf("CVE-2021-%04d", i))
+	}
+	ids = append(ids, "CVE-2022-0002")
+
+	got, err := NewClient(srv.URL).Scores(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*Score{
+		"CVE-2022-0001": {CVE: "CVE-2022-0001", EPSS: 0.00123, Percentile: 0.5, Date: "2022-10-01"},
+		"CVE-2022-0002": {CVE: "CVE-2022-0002", EPSS: 0.975, Percentile: 0.5, Date: "2022-10-01"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if requests != 2 {
+		t.Errorf("got %d requests, want 2", requests)
+	}
+}
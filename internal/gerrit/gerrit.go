@@ -0,0 +1,174 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gerrit supports fetching changes from the Go project's Gerrit
+// server, to find the commits of fix references that name CLs by number.
+// See https://gerrit-review.googlesource.com/Documentation/rest-api.html.
+package gerrit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// DefaultBaseURL is the URL of the Go project's Gerrit server.
+const DefaultBaseURL = "https://go-review.googlesource.com"
+
+// A Change is a Gerrit change.
+type Change struct {
+	// Number is the number of the change, as in go.dev/cl/NUMBER.
+	Number int `json:"_number"`
+	// Project is the repo of the change, such as "go".
+	Project string `json:"project"`
+	// Branch is the branch the change is for, such as "master" or
+	// "release-branch.go1.19".
+	Branch string `json:"branch"`
+	// Status is NEW, MERGED or ABANDONED.
+	Status string `json:"status"`
+	// Subject is the first line of the commit message.
+	Subject string `json:"subject"`
+	// CurrentRevision is the hash of the latest patch set, which is the
+	// commit of a merged change.
+	CurrentRevision string `json:"current_revision"`
+	// Message is the commit message of the current revision. It is only
+	// set by ChangesForIssue.
+	Message string `json:"-"`
+}
+
+// changeInfo is the response for a change, with the commit of its current
+// revision if it was requested.
+type changeInfo struct {
+	Change
+	Revisions map[string]struct {
+		Commit struct {
+			Message string `json:"message"`
+		} `json:"commit"`
+	} `json:"revisions"`
+}
+
+// StatusMerged is the status of a merged change.
+const StatusMerged = "MERGED"
+
+// A Client is a client for the Gerrit REST API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the server at baseURL.
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// errNotFound is returned by get for a response with status Not Found.
+var errNotFound = errors.New("not found")
+
+// Change returns the change with the given number, or nil if there is none.
+func (c *Client) Change(ctx context.Context, number int) (_ *Change, err error) {
+	defer derrors.Wrap(&err, "gerrit.Change(%d)", number)
+
+	var ci changeInfo
+	if err := c.get(ctx, fmt.Sprintf("%s/changes/%d?o=CURRENT_REVISION", c.baseURL, number), &ci); err != nil {
+		if err == errNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ci.Change, nil
+}
+
+// maxChanges is the most changes that ChangesForIssue asks for.
+const maxChanges = 100
+
+// ChangesForIssue returns the merged changes to the repo with the given
+// project name whose commit messages refer to the GitHub issue of the Go
+// project with the given number, with a line like "Fixes #NUMBER",
+// "Updates golang/go#NUMBER" or "For #NUMBER". These include the backports
+// of a fix to release branches, which refer to the original issue. The
+// changes are ordered by number.
+func (c *Client) ChangesForIssue(ctx context.Context, project string, issue int) (_ []*Change, err error) {
+	defer derrors.Wrap(&err, "gerrit.ChangesForIssue(%q, %d)", project, issue)
+
+	q := fmt.Sprintf("project:%s status:merged message:%d", project, issue)
+	u := fmt.Sprintf("%s/changes/?q=%s&o=CURRENT_REVISION&o=CURRENT_COMMIT&n=%d",
+		c.baseURL, url.QueryEscape(q), maxChanges)
+	var cis []*changeInfo
+	if err := c.get(ctx, u, &cis); err != nil {
+		return nil, err
+	}
+	// The search matches the number anywhere in the message, so check that
+	// it is an issue reference.
+	re := regexp.MustCompile(fmt.Sprintf(`(?m)^(?:Fixes|Updates|For)\b.*[\s,](?:golang/go)?#%d\b`, issue))
+	var result []*Change
+	for _, ci := range cis {
+		ch := &ci.Change
+		ch.Message = ci.Revisions[ch.CurrentRevision].Commit.Message
+		if re.MatchString(ch.Message) {
+			result = append(result, ch)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Number < result[j].Number })
+	return result, nil
+}
+
+// get decodes the JSON response to a GET of u into v.
+func (c *Client) get(ctx context.Context, u string, v interface{}) error {
+	ctx = event.Start(ctx, "gerrit.get")
+	defer event.End(ctx)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", u, res.Status)
+	}
+	// Gerrit prefixes JSON responses with a line that keeps browsers from
+	// running them as scripts.
+	br := bufio.NewReader(res.Body)
+	if _, err := br.ReadString('\n'); err != nil && err != io.EOF {
+		return err
+	}
+	return json.NewDecoder(br).Decode(v)
+}
+
+// clRegexp matches the URLs of Go CLs, such as fix references of reports.
+var clRegexp = regexp.MustCompile(`^https://(?:go\.dev/cl|go-review\.googlesource\.com/c/[^/]+/\+)/(\d+)/?$`)
+
+// ParseCLURL returns the number of the change at u, a URL like
+// https://go.dev/cl/NUMBER, and whether u is such a URL.
+func ParseCLURL(u string) (int, bool) {
+	m := clRegexp.FindStringSubmatch(u)
+	if m == nil {
+		return 0, false
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestChange(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/changes/12345":
+			if r.URL.Query().Get("o") != "CURRENT_REVISION" {
+				http.Error(w, "missing option", http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, ")]}'\n"+`{"_number": 12345, "project": "go", "status": "MERGED",
+				"current_revision": "0123456789abcdef0123456789abcdef01234567"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	got, err := c.Change(context.Background(), 12345)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Change{
+		Number:          12345,
+		Project:         "go",
+		Status:          StatusMerged,
+		CurrentRevision: "0123456789abcdef0123456789abcdef01234567",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	got, err = c.Change(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("unknown change: got %+v, want nil", got)
+	}
+}
+
+func TestChangesForIssue(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/changes/" || r.URL.Query().Get("q") != "project:go status:merged message:56350" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, ")]}'\n"+`[
+			{"_number": 3, "project": "go", "branch": "release-branch.go1.19", "status": "MERGED",
+			 "current_revision": "c3", "revisions": {"c3": {"commit": {"message": "[release-branch.go1.19] net/http: fix\n\nUpdates #56350\nFixes #56351\n"}}}},
+			{"_number": 1, "project": "go", "branch": "master", "status": "MERGED",
+			 "current_revision": "c1", "revisions": {"c1": {"commit": {"message": "net/http: fix\n\nFixes #56350\n"}}}},
+			{"_number": 2, "project": "go", "branch": "master", "status": "MERGED",
+			 "current_revision": "c2", "revisions": {"c2": {"commit": {"message": "net/http: unrelated\n\nSee CL 56350.\nFixes #563501\n"}}}},
+			{"_number": 4, "project": "go", "branch": "master", "status": "MERGED",
+			 "current_revision": "c4", "revisions": {"c4": {"commit": {"message": "x/net: vendor\n\nFor #1, golang/go#56350\n"}}}}
+		]`)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	got, err := c.ChangesForIssue(context.Background(), "go", 56350)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotNums []int
+	for _, ch := range got {
+		gotNums = append(gotNums, ch.Number)
+	}
+	if want := []int{1, 3, 4}; !cmp.Equal(gotNums, want) {
+		t.Errorf("got changes %v, want %v", gotNums, want)
+	}
+	want := &Change{
+		Number:          3,
+		Project:         "go",
+		Branch:          "release-branch.go1.19",
+		Status:          StatusMerged,
+		CurrentRevision: "c3",
+		Message:         "[release-branch.go1.19] net/http: fix\n\nUpdates #56350\nFixes #56351\n",
+	}
+	if diff := cmp.Diff(want, got[1]); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestParseCLURL(t *testing.T) {
+	for _, test := range []struct {
+		url  string
+		want int
+		ok   bool
+	}{
+		{"https://go.dev/cl/12345", 12345, true},
+		{"https://go-review.googlesource.com/c/go/+/12345", 12345, true},
+		{"https://go-review.googlesource.com/c/go/+/12345/", 12345, true},
+		{"https://go.dev/issue/12345", 0, false},
+		{"https://go.googlesource.com/go/+/0123456789abcdef0123456789abcdef01234567", 0, false},
+	} {
+		got, ok := ParseCLURL(test.url)
+		if got != test.want || ok != test.ok {
+			t.Errorf("%s: got %d, %t; want %d, %t", test.url, got, ok, test.want, test.ok)
+		}
+	}
+}
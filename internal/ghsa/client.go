@@ -0,0 +1,202 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/githubapi"
+)
+
+// A Client fetches security advisories from the GitHub GraphQL API.
+// A Client is safe for concurrent use; its rate limit applies to all
+// of its queries together.
+type Client struct {
+	gql querier
+}
+
+// querier is the part of githubv4.Client that a Client uses.
+type querier interface {
+	Query(ctx context.Context, q any, vars map[string]any) error
+}
+
+// Options configure a Client. The zero value of each field selects its
+// default.
+type Options struct {
+	// QPS is the maximum number of queries per second. The GitHub GraphQL
+	// API allows 5,000 points an hour, and each query costs at least one.
+	// The default is githubapi.DefaultQPS.
+	QPS float64
+	// MaxRetries is the number of times a query that failed transiently,
+	// because of a rate limit, a server error or a network error, is
+	// retried. Other failures are not retried. The default is
+	// githubapi.DefaultMaxRetries; a negative value disables retries.
+	MaxRetries int
+	// RetryDelay is how long to wait before the first retry. It doubles
+	// after each retry. The default is githubapi.DefaultRetryDelay.
+	RetryDelay time.Duration
+	// URL is the URL of the GraphQL endpoint. The default is GitHub's.
+	URL string
+	// HTTPClient is the client that makes the requests. It must add the
+	// access token itself. The default is an OAuth2 client that does.
+	// Either way, its requests are limited and retried as described by
+	// githubapi.Transport.
+	HTTPClient *http.Client
+}
+
+// NewClient returns a Client that authorizes with accessToken.
+// If opts is nil, it uses the defaults for all options.
+func NewClient(ctx context.Context, accessToken string, opts *Options) *Client {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	gopts := &githubapi.Options{
+		QPS:        o.QPS,
+		MaxRetries: o.MaxRetries,
+		RetryDelay: o.RetryDelay,
+		GraphQL:    true,
+	}
+	var hc *http.Client
+	if o.HTTPClient == nil {
+		hc = githubapi.NewClient(ctx, accessToken, gopts)
+	} else {
+		base := o.HTTPClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		c := *o.HTTPClient
+		c.Transport = githubapi.NewTransport(base, gopts)
+		hc = &c
+	}
+	var gql *githubv4.Client
+	if o.URL == "" {
+		gql = githubv4.NewClient(hc)
+	} else {
+		gql = githubv4.NewEnterpriseClient(o.URL, hc)
+	}
+	return &Client{gql: gql}
+}
+
+// query runs a GraphQL query. Its HTTP client limits, retries and records
+// the metrics of its requests.
+func (c *Client) query(ctx context.Context, q any, vars map[string]any) error {
+	ctx = event.Start(ctx, "ghsa.query")
+	defer event.End(ctx)
+
+	return c.gql.Query(ctx, q, vars)
+}
+
+// List returns all SecurityAdvisories that affect Go,
+// published or updated since the given time.
+func (c *Client) List(ctx context.Context, since time.Time) (_ []*SecurityAdvisory, err error) {
+	defer derrors.Wrap(&err, "ghsa.List(%s)", since.Format(time.RFC3339))
+
+	var query struct { // the GraphQL query
+		SAs struct {
+			Nodes    []gqlSecurityAdvisory
+			PageInfo struct {
+				EndCursor   githubv4.String
+				HasNextPage bool
+			}
+		} `graphql:"securityAdvisories(updatedSince: $since, first: 100, after: $cursor)"`
+	}
+	vars := map[string]any{
+		"cursor": (*githubv4.String)(nil),
+		"go":     githubv4.SecurityAdvisoryEcosystemGo,
+		"since":  githubv4.DateTime{Time: since},
+	}
+
+	var sas []*SecurityAdvisory
+	// We need a loop to page through the list. The GitHub API limits us to 100
+	// values per call.
+	for {
+		if err := c.query(ctx, &query, vars); err != nil {
+			return nil, err
+		}
+		for _, sa := range query.SAs.Nodes {
+			if len(sa.Vulnerabilities.Nodes) == 0 {
+				continue
+			}
+			s, err := sa.securityAdvisory()
+			if err != nil {
+				return nil, err
+			}
+			sas = append(sas, s)
+		}
+		if !query.SAs.PageInfo.HasNextPage {
+			break
+		}
+		vars["cursor"] = githubv4.NewString(query.SAs.PageInfo.EndCursor)
+	}
+	return sas, nil
+}
+
+// ListForCVE returns the SecurityAdvisories that affect Go and have the given
+// CVE as an identifier.
+func (c *Client) ListForCVE(ctx context.Context, cve string) (_ []*SecurityAdvisory, err error) {
+	defer derrors.Wrap(&err, "ghsa.ListForCVE(%s)", cve)
+
+	var query struct { // The GraphQL query
+		SAs struct {
+			Nodes    []gqlSecurityAdvisory
+			PageInfo struct {
+				EndCursor   githubv4.String
+				HasNextPage bool
+			}
+		} `graphql:"securityAdvisories(identifier: $id, first: 100)"`
+	}
+	vars := map[string]any{
+		"id": githubv4.SecurityAdvisoryIdentifierFilter{
+			Type:  githubv4.SecurityAdvisoryIdentifierTypeCve,
+			Value: githubv4.String(cve),
+		},
+		"go": githubv4.SecurityAdvisoryEcosystemGo,
+	}
+
+	if err := c.query(ctx, &query, vars); err != nil {
+		return nil, err
+	}
+	if query.SAs.PageInfo.HasNextPage {
+		return nil, fmt.Errorf("CVE %s has more than 100 GHSAs", cve)
+	}
+	var sas []*SecurityAdvisory
+	for _, sa := range query.SAs.Nodes {
+		if len(sa.Vulnerabilities.Nodes) == 0 {
+			continue
+		}
+		s, err := sa.securityAdvisory()
+		if err != nil {
+			return nil, err
+		}
+		sas = append(sas, s)
+	}
+	return sas, nil
+}
+
+// FetchGHSA returns the SecurityAdvisory for the given Github Security
+// Advisory ID.
+func (c *Client) FetchGHSA(ctx context.Context, ghsaID string) (_ *SecurityAdvisory, err error) {
+	defer derrors.Wrap(&err, "ghsa.FetchGHSA(%s)", ghsaID)
+
+	var query struct {
+		SA gqlSecurityAdvisory `graphql:"securityAdvisory(ghsaId: $id)"`
+	}
+	vars := map[string]any{
+		"id": githubv4.String(ghsaID),
+		"go": githubv4.SecurityAdvisoryEcosystemGo,
+	}
+
+	if err := c.query(ctx, &query, vars); err != nil {
+		return nil, err
+	}
+	return query.SA.securityAdvisory()
+}
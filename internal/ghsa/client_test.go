@@ -0,0 +1,134 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghsa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a Client for a GraphQL server that calls handle
+// with the variables of each query.
+func newTestClient(t *testing.T, maxRetries int, handle func(w http.ResponseWriter, vars map[string]any)) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		handle(w, req.Variables)
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient(context.Background(), "", &Options{
+		QPS:        1000,
+		MaxRetries: maxRetries,
+		RetryDelay: time.Millisecond,
+		URL:        srv.URL,
+		HTTPClient: srv.Client(),
+	})
+}
+
+// advisoryPage returns a response to a list query with one advisory.
+func advisoryPage(id, endCursor string, hasNext bool) string {
+	return fmt.Sprintf(`{"data": {"securityAdvisories": {
+		"nodes": [{
+			"ghsaId": %[1]q,
+			"permalink": "https://github.com/advisories/%[1]s",
+			"publishedAt": "2022-01-01T00:00:00Z",
+			"updatedAt": "2022-01-02T00:00:00Z",
+			"cvss": {"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H"},
+			"vulnerabilities": {"nodes": [{"package": {"name": "example.com/m", "ecosystem": "GO"}}]}
+		}],
+		"pageInfo": {"endCursor": %[2]q, "hasNextPage": %[3]t}
+	}}}`, id, endCursor, hasNext)
+}
+
+func TestClientList(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, 2, func(w http.ResponseWriter, vars map[string]any) {
+		calls++
+		switch {
+		case calls == 1:
+			// Transient failures are retried.
+			http.Error(w, "try again", http.StatusBadGateway)
+		case vars["cursor"] == nil:
+			fmt.Fprint(w, advisoryPage("GHSA-1", "c1", true))
+		case vars["cursor"] == "c1":
+			fmt.Fprint(w, advisoryPage("GHSA-2", "c2", false))
+		default:
+			t.Errorf("unexpected cursor %v", vars["cursor"])
+			http.Error(w, "bad cursor", http.StatusBadRequest)
+		}
+	})
+	sas, err := c.List(context.Background(), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, sa := range sas {
+		ids = append(ids, sa.ID)
+	}
+	if got, want := fmt.Sprint(ids), "[GHSA-1 GHSA-2]"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got, want := calls, 3; got != want {
+		t.Errorf("got %d calls, want %d", got, want)
+	}
+	if got, want := sas[0].CVSS, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H"; got != want {
+		t.Errorf("got CVSS %q, want %q", got, want)
+	}
+}
+
+func TestClientRetriesExhausted(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, 2, func(w http.ResponseWriter, _ map[string]any) {
+		calls++
+		http.Error(w, "down", http.StatusServiceUnavailable)
+	})
+	if _, err := c.FetchGHSA(context.Background(), "GHSA-1"); err == nil {
+		t.Fatal("got nil, want error")
+	}
+	if got, want := calls, 3; got != want {
+		t.Errorf("got %d calls, want %d", got, want)
+	}
+}
+
+func TestClientNoRetry(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		handle func(w http.ResponseWriter)
+	}{
+		{"unauthorized", func(w http.ResponseWriter) {
+			http.Error(w, "bad credentials", http.StatusUnauthorized)
+		}},
+		{"bad request", func(w http.ResponseWriter) {
+			http.Error(w, "bad query", http.StatusBadRequest)
+		}},
+		{"graphql error", func(w http.ResponseWriter) {
+			fmt.Fprint(w, `{"errors": [{"message": "Could not resolve to a SecurityAdvisory"}]}`)
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			calls := 0
+			c := newTestClient(t, 2, func(w http.ResponseWriter, _ map[string]any) {
+				calls++
+				test.handle(w)
+			})
+			if _, err := c.FetchGHSA(context.Background(), "GHSA-1"); err == nil {
+				t.Fatal("got nil, want error")
+			}
+			if calls != 1 {
+				t.Errorf("got %d calls, want 1", calls)
+			}
+		})
+	}
+}
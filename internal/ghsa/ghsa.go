@@ -6,12 +6,10 @@
 package ghsa
 
 import (
-	"context"
 	"fmt"
 	"time"
 
 	"github.com/shurcooL/githubv4"
-	"golang.org/x/oauth2"
 )
 
 // A SecurityAdvisory represents a GitHub security advisory.
@@ -32,6 +30,8 @@ type SecurityAdvisory struct {
 	PublishedAt time.Time
 	// When the advisory was last updated; should always be >= PublishedAt.
 	UpdatedAt time.Time
+	// The CVSS v3 vector of the vulnerability, if GitHub scored it.
+	CVSS string
 	// The vulnerabilities associated with this advisory.
 	Vulns []*Vuln
 }
@@ -70,6 +70,7 @@ type gqlSecurityAdvisory struct {
 	Permalink       githubv4.URI
 	PublishedAt     time.Time
 	UpdatedAt       time.Time
+	CVSS            struct{ VectorString string }
 	Vulnerabilities struct {
 		Nodes []struct {
 			Package struct {
@@ -106,6 +107,7 @@ func (sa *gqlSecurityAdvisory) securityAdvisory() (*SecurityAdvisory, error) {
 		Permalink:   sa.Permalink.URL.String(),
 		PublishedAt: sa.PublishedAt,
 		UpdatedAt:   sa.UpdatedAt,
+		CVSS:        sa.CVSS.VectorString,
 	}
 	for _, v := range sa.Vulnerabilities.Nodes {
 		s.Vulns = append(s.Vulns, &Vuln{
@@ -119,117 +121,6 @@ func (sa *gqlSecurityAdvisory) securityAdvisory() (*SecurityAdvisory, error) {
 	return s, nil
 }
 
-func newGitHubClient(ctx context.Context, accessToken string) *githubv4.Client {
-	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
-	tc := oauth2.NewClient(ctx, ts)
-	return githubv4.NewClient(tc)
-}
-
-// List returns all SecurityAdvisories that affect Go,
-// published or updated since the given time.
-func List(ctx context.Context, accessToken string, since time.Time) ([]*SecurityAdvisory, error) {
-	client := newGitHubClient(ctx, accessToken)
-
-	var query struct { // the GraphQL query
-		SAs struct {
-			Nodes    []gqlSecurityAdvisory
-			PageInfo struct {
-				EndCursor   githubv4.String
-				HasNextPage bool
-			}
-		} `graphql:"securityAdvisories(updatedSince: $since, first: 100, after: $cursor)"`
-	}
-	vars := map[string]any{
-		"cursor": (*githubv4.String)(nil),
-		"go":     githubv4.SecurityAdvisoryEcosystemGo,
-		"since":  githubv4.DateTime{Time: since},
-	}
-
-	var sas []*SecurityAdvisory
-	// We need a loop to page through the list. The GitHub API limits us to 100
-	// values per call.
-	for {
-		if err := client.Query(ctx, &query, vars); err != nil {
-			return nil, err
-		}
-		for _, sa := range query.SAs.Nodes {
-			if len(sa.Vulnerabilities.Nodes) == 0 {
-				continue
-			}
-			s, err := sa.securityAdvisory()
-			if err != nil {
-				return nil, err
-			}
-			sas = append(sas, s)
-		}
-		if !query.SAs.PageInfo.HasNextPage {
-			break
-		}
-		vars["cursor"] = githubv4.NewString(query.SAs.PageInfo.EndCursor)
-	}
-	return sas, nil
-}
-
-func ListForCVE(ctx context.Context, accessToken string, cve string) ([]*SecurityAdvisory, error) {
-	client := newGitHubClient(ctx, accessToken)
-
-	var query struct { // The GraphQL query
-		SAs struct {
-			Nodes    []gqlSecurityAdvisory
-			PageInfo struct {
-				EndCursor   githubv4.String
-				HasNextPage bool
-			}
-		} `graphql:"securityAdvisories(identifier: $id, first: 100)"`
-	}
-	vars := map[string]any{
-		"id": githubv4.SecurityAdvisoryIdentifierFilter{
-			Type:  githubv4.SecurityAdvisoryIdentifierTypeCve,
-			Value: githubv4.String(cve),
-		},
-		"go": githubv4.SecurityAdvisoryEcosystemGo,
-	}
-
-	if err := client.Query(ctx, &query, vars); err != nil {
-		return nil, err
-	}
-	if query.SAs.PageInfo.HasNextPage {
-		return nil, fmt.Errorf("CVE %s has more than 100 GHSAs", cve)
-	}
-	var sas []*SecurityAdvisory
-	for _, sa := range query.SAs.Nodes {
-		if len(sa.Vulnerabilities.Nodes) == 0 {
-			continue
-		}
-		s, err := sa.securityAdvisory()
-		if err != nil {
-			return nil, err
-		}
-		sas = append(sas, s)
-	}
-	return sas, nil
-}
-
-// FetchGHSA returns the SecurityAdvisory for the given Github Security
-// Advisory ID.
-func FetchGHSA(ctx context.Context, accessToken, ghsaID string) (_ *SecurityAdvisory, err error) {
-	client := newGitHubClient(ctx, accessToken)
-
-	var query struct {
-		SA gqlSecurityAdvisory `graphql:"securityAdvisory(ghsaId: $id)"`
-	}
-	vars := map[string]any{
-		"id": githubv4.String(ghsaID),
-		"go": githubv4.SecurityAdvisoryEcosystemGo,
-	}
-
-	if err := client.Query(ctx, &query, vars); err != nil {
-		return nil, err
-	}
-
-	return query.SA.securityAdvisory()
-}
-
 func isCVE(ids []Identifier) bool {
 	for _, id := range ids {
 		if id.Type == "CVE" {
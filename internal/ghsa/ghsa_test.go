@@ -38,7 +38,7 @@ func TestList(t *testing.T) {
 	accessToken := mustGetAccessToken(t)
 	// There were at least three relevant SAs since this date.
 	since := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
-	got, err := List(context.Background(), accessToken, since)
+	got, err := NewClient(context.Background(), accessToken, nil).List(context.Background(), since)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -52,7 +52,7 @@ func TestFetchGHSA(t *testing.T) {
 	accessToken := mustGetAccessToken(t)
 	// Real GHSA that should be found.
 	const ghsaID string = "GHSA-g9mp-8g3h-3c5c"
-	got, err := FetchGHSA(context.Background(), accessToken, ghsaID)
+	got, err := NewClient(context.Background(), accessToken, nil).FetchGHSA(context.Background(), ghsaID)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -68,7 +68,7 @@ func TestListForCVE(t *testing.T) {
 		cveID  string = "CVE-2022-27191"
 		ghsaID string = "GHSA-8c26-wmh5-6g9v"
 	)
-	got, err := ListForCVE(context.Background(), accessToken, cveID)
+	got, err := NewClient(context.Background(), accessToken, nil).ListForCVE(context.Background(), cveID)
 	if err != nil {
 		t.Fatal(err)
 	}
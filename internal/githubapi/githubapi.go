@@ -0,0 +1,444 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package githubapi provides an HTTP client for the GitHub REST and GraphQL
+// APIs, used by every part of vulndb that talks to GitHub.
+//
+// The client limits the rate of its requests, waits out GitHub's rate limits
+// and retries transient failures, makes GET requests conditional on the ETags
+// of earlier responses, and records the remaining quota as metrics.
+package githubapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
+)
+
+// Options configure a client. The zero value of each field selects its
+// default.
+type Options struct {
+	// QPS is the maximum number of requests per second. GitHub allows
+	// 5,000 REST requests and 5,000 GraphQL points an hour.
+	// The default is DefaultQPS.
+	QPS float64
+	// MaxRetries is the number of times a failed request is retried. The
+	// default is DefaultMaxRetries; a negative value disables retries.
+	MaxRetries int
+	// RetryDelay is how long to wait before the first retry of a transient
+	// failure. It doubles after each retry, and is jittered. The default is
+	// DefaultRetryDelay.
+	RetryDelay time.Duration
+	// MaxWait is the longest that a request waits for a rate limit to reset.
+	// A request that would have to wait longer fails. The default is
+	// DefaultMaxWait.
+	MaxWait time.Duration
+	// GraphQL says that the client is for the GraphQL API. Its requests are
+	// POSTs, which are retried after transient failures because we use the
+	// GraphQL API only for queries.
+	GraphQL bool
+}
+
+// Defaults for Options.
+const (
+	DefaultQPS        = 1.0
+	DefaultMaxRetries = 3
+	DefaultRetryDelay = time.Second
+	DefaultMaxWait    = 5 * time.Minute
+)
+
+// NewClient returns an HTTP client for the GitHub APIs that authorizes with
+// accessToken. If opts is nil, it uses the defaults for all options.
+func NewClient(ctx context.Context, accessToken string, opts *Options) *http.Client {
+	var base http.RoundTripper = http.DefaultTransport
+	if accessToken != "" {
+		base = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})).Transport
+	}
+	return &http.Client{Transport: NewTransport(base, opts)}
+}
+
+// NewTransport returns a Transport that sends requests with base, which must
+// authorize them itself. If opts is nil, it uses the defaults for all
+// options.
+func NewTransport(base http.RoundTripper, opts *Options) *Transport {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.QPS <= 0 {
+		o.QPS = DefaultQPS
+	}
+	switch {
+	case o.MaxRetries == 0:
+		o.MaxRetries = DefaultMaxRetries
+	case o.MaxRetries < 0:
+		o.MaxRetries = 0
+	}
+	if o.RetryDelay <= 0 {
+		o.RetryDelay = DefaultRetryDelay
+	}
+	if o.MaxWait <= 0 {
+		o.MaxWait = DefaultMaxWait
+	}
+	return &Transport{
+		base:       base,
+		limiter:    rate.NewLimiter(rate.Limit(o.QPS), 1),
+		maxRetries: o.MaxRetries,
+		retryDelay: o.RetryDelay,
+		maxWait:    o.MaxWait,
+		graphql:    o.GraphQL,
+		cache:      map[string]*cachedResponse{},
+		resets:     map[string]time.Time{},
+	}
+}
+
+// A Transport is an http.RoundTripper for the GitHub APIs. It is safe for
+// concurrent use; its rate limit applies to all of its requests together.
+//
+// A request that fails because of a rate limit is retried when the limit
+// resets, unless that is more than MaxWait away. A request that fails with a
+// server error or a network error is retried after a delay, but only if it
+// is safe to repeat: a GET or HEAD, or a GraphQL query. So creating an issue
+// is never retried after a failure that may have created it.
+type Transport struct {
+	base       http.RoundTripper
+	limiter    *rate.Limiter
+	maxRetries int
+	retryDelay time.Duration
+	maxWait    time.Duration
+	graphql    bool
+
+	mu     sync.Mutex
+	cache  map[string]*cachedResponse // by URL, for GETs with an ETag
+	resets map[string]time.Time       // by resource, for exhausted limits
+}
+
+// A cachedResponse is a successful response to a GET with an ETag.
+type cachedResponse struct {
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+// Limits on what the Transport caches.
+const (
+	maxCachedResponses = 1000
+	maxCachedBodySize  = 1 << 20
+)
+
+// Metrics of the requests to GitHub, which are served at /metrics by the
+// worker.
+var (
+	rateRemainingGauge = event.NewFloatGauge("rate_remaining", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "Requests or points left in a GitHub API rate limit, by resource.",
+	})
+	requestCounter = event.NewCounter("requests", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "Requests to the GitHub APIs, by status code.",
+	})
+	retryCounter = event.NewCounter("retries", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "Retries of requests to the GitHub APIs, by reason.",
+	})
+	notModifiedCounter = event.NewCounter("not_modified", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "Conditional requests answered from the cache, which don't count against the rate limit.",
+	})
+)
+
+const metricNamespace = "vulndb/github"
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	resource := t.resourceOf(req)
+	cached := t.cached(req)
+	delay := t.retryDelay
+	for retries := 0; ; retries++ {
+		if err := t.waitForReset(ctx, resource); err != nil {
+			return nil, err
+		}
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		r, err := t.attempt(req, retries, cached)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := t.base.RoundTrip(r)
+		if err == nil {
+			resp, err = t.record(ctx, resource, resp)
+		}
+		if retries >= t.maxRetries {
+			return t.finish(req, resp, err, cached)
+		}
+		reason, wait := t.retryAfter(req, resp, err, delay)
+		if reason == "" || wait > t.maxWait {
+			return t.finish(req, resp, err, cached)
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		retryCounter.Record(ctx, 1, event.String("reason", reason))
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		if reason != "rate_limit" {
+			delay *= 2
+		}
+	}
+}
+
+// resourceOf returns the name of the rate limit that req counts against.
+// GitHub names the resource in a response header as well, but the name is
+// needed before there is a response.
+func (t *Transport) resourceOf(req *http.Request) string {
+	switch {
+	case t.graphql:
+		return "graphql"
+	case strings.HasPrefix(req.URL.Path, "/search/"):
+		return "search"
+	default:
+		return "core"
+	}
+}
+
+// cached returns the cached response to req, or nil if there is none.
+func (t *Transport) cached(req *http.Request) *cachedResponse {
+	if req.Method != http.MethodGet {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.cache[req.URL.String()]
+}
+
+// attempt returns the request to send for try number retries of req.
+func (t *Transport) attempt(req *http.Request, retries int, cached *cachedResponse) (*http.Request, error) {
+	// A RoundTripper must not modify its request.
+	r := req.Clone(req.Context())
+	if retries > 0 && req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	if cached != nil {
+		r.Header.Set("If-None-Match", cached.etag)
+	}
+	return r, nil
+}
+
+// record records the metrics of resp, and notes when its rate limit resets
+// if it is exhausted. It reads the body of a response that may be a rate
+// limit error, so it returns a response to use instead of resp.
+func (t *Transport) record(ctx context.Context, resource string, resp *http.Response) (*http.Response, error) {
+	requestCounter.Record(ctx, 1, event.String("status", strconv.Itoa(resp.StatusCode)))
+	if r := resp.Header.Get("X-RateLimit-Resource"); r != "" {
+		resource = r
+	}
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err == nil {
+		rateRemainingGauge.Record(ctx, float64(remaining), event.String("resource", resource))
+		if remaining == 0 {
+			if reset, ok := resetTime(resp); ok {
+				t.mu.Lock()
+				t.resets[resource] = reset
+				t.mu.Unlock()
+			}
+		}
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		return resp, nil
+	}
+	// A secondary rate limit is known only by the message at the start of
+	// the body.
+	head, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	resp.Body = prependBody(head, resp.Body)
+	if bytes.Contains(bytes.ToLower(head), []byte("secondary rate limit")) {
+		resp.Header.Set(secondaryLimitHeader, "true")
+	}
+	return resp, nil
+}
+
+// prependBody returns a body that reads head and then the rest of body.
+func prependBody(head []byte, body io.ReadCloser) io.ReadCloser {
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), body), body}
+}
+
+// secondaryLimitHeader marks a response to a request that exceeded a
+// secondary rate limit. The Transport sets it, for its own use.
+const secondaryLimitHeader = "X-Vulndb-Secondary-Rate-Limit"
+
+// resetTime returns the time at which the rate limit of resp resets.
+func resetTime(resp *http.Response) (time.Time, bool) {
+	secs, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(secs, 0), true
+}
+
+// waitForReset waits until the rate limit of resource resets, if a response
+// said that it was exhausted.
+func (t *Transport) waitForReset(ctx context.Context, resource string) error {
+	t.mu.Lock()
+	reset, ok := t.resets[resource]
+	delete(t.resets, resource)
+	t.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	wait := time.Until(reset)
+	if wait > t.maxWait {
+		return fmt.Errorf("GitHub %s rate limit exhausted until %s", resource, reset.Format(time.RFC3339))
+	}
+	return sleep(ctx, wait)
+}
+
+// minSecondaryLimitDelay is how long to wait after exceeding a secondary rate
+// limit, if GitHub doesn't say. GitHub asks for at least a minute.
+var minSecondaryLimitDelay = time.Minute
+
+// retryAfter reports why and after how long the request req should be
+// retried, given the response or error of the last try and the current
+// delay for transient failures. It returns an empty reason if req should not
+// be retried.
+func (t *Transport) retryAfter(req *http.Request, resp *http.Response, err error, delay time.Duration) (reason string, wait time.Duration) {
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	if !replayable {
+		return "", 0
+	}
+	safe := req.Method == http.MethodGet || req.Method == http.MethodHead || t.graphql
+	switch {
+	case err != nil:
+		if !safe || req.Context().Err() != nil {
+			return "", 0
+		}
+		return "network", jitter(delay)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusForbidden:
+		// A request that exceeded a rate limit was not processed, so it is
+		// always safe to retry.
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			return "rate_limit", time.Duration(secs) * time.Second
+		}
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			if reset, ok := resetTime(resp); ok {
+				return "rate_limit", time.Until(reset)
+			}
+		}
+		if resp.StatusCode == http.StatusTooManyRequests || resp.Header.Get(secondaryLimitHeader) != "" {
+			d := jitter(delay)
+			if d < minSecondaryLimitDelay {
+				d = minSecondaryLimitDelay
+			}
+			return "rate_limit", d
+		}
+		// Some other reason for refusing, like a bad token.
+		return "", 0
+	case resp.StatusCode >= 500 && safe:
+		return "server_error", jitter(delay)
+	default:
+		return "", 0
+	}
+}
+
+// jitter returns a random duration between d/2 and 3d/2, so that clients
+// that failed together don't retry together.
+func jitter(d time.Duration) time.Duration {
+	return d/2 + time.Duration(rand.Int63n(int64(d)+1))
+}
+
+// finish returns the result of the last try of req: the cached response if
+// the server says it is still current, or resp, which is cached if it can be.
+func (t *Transport) finish(req *http.Request, resp *http.Response, err error, cached *cachedResponse) (*http.Response, error) {
+	if err != nil {
+		return nil, err
+	}
+	resp.Header.Del(secondaryLimitHeader)
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		notModifiedCounter.Record(req.Context(), 1)
+		resp.Body.Close()
+		header := cached.header.Clone()
+		// The rate limit is current, not cached.
+		for k, v := range resp.Header {
+			if strings.HasPrefix(k, "X-Ratelimit-") {
+				header[k] = v
+			}
+		}
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(cached.body)),
+			ContentLength: int64(len(cached.body)),
+			Request:       req,
+		}, nil
+	}
+	etag := resp.Header.Get("ETag")
+	if req.Method != http.MethodGet || resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBodySize+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if len(body) > maxCachedBodySize {
+		resp.Body = prependBody(body, resp.Body)
+		return resp, nil
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.cache) >= maxCachedResponses {
+		// Evict an arbitrary response.
+		for k := range t.cache {
+			delete(t.cache, k)
+			break
+		}
+	}
+	t.cache[req.URL.String()] = &cachedResponse{etag: etag, header: resp.Header.Clone(), body: body}
+	return resp, nil
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
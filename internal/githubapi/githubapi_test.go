@@ -0,0 +1,190 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubapi
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client for a server that responds to its nth
+// request (counting from 0) with handle(n), and a function that returns the
+// number of requests the server received.
+func newTestClient(t *testing.T, graphql bool, handle func(w http.ResponseWriter, r *http.Request, n int)) (*http.Client, string, func() int) {
+	t.Helper()
+	n := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := n
+		n++
+		handle(w, r, i)
+	}))
+	t.Cleanup(srv.Close)
+	c := &http.Client{Transport: NewTransport(http.DefaultTransport, &Options{
+		QPS:        1000,
+		RetryDelay: time.Millisecond,
+		MaxWait:    time.Second,
+		GraphQL:    graphql,
+	})}
+	return c, srv.URL, func() int { return n }
+}
+
+func get(t *testing.T, c *http.Client, url string) (int, string, error) {
+	t.Helper()
+	return do(t, c, http.MethodGet, url)
+}
+
+func do(t *testing.T, c *http.Client, method, url string) (int, string, error) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader("body"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(body), nil
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	for _, test := range []struct {
+		method      string
+		graphql     bool
+		wantStatus  int
+		wantRequest int
+	}{
+		{http.MethodGet, false, http.StatusOK, 2},
+		{http.MethodPost, false, http.StatusBadGateway, 1},
+		{http.MethodPost, true, http.StatusOK, 2},
+	} {
+		t.Run(fmt.Sprintf("%s graphql=%t", test.method, test.graphql), func(t *testing.T) {
+			c, url, requests := newTestClient(t, test.graphql, func(w http.ResponseWriter, r *http.Request, n int) {
+				if b, _ := io.ReadAll(r.Body); string(b) != "body" {
+					t.Errorf("request %d: got body %q", n, b)
+				}
+				if n == 0 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				fmt.Fprint(w, "ok")
+			})
+			status, _, err := do(t, c, test.method, url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if status != test.wantStatus || requests() != test.wantRequest {
+				t.Errorf("got status %d after %d requests, want %d after %d", status, requests(), test.wantStatus, test.wantRequest)
+			}
+		})
+	}
+}
+
+func TestRetryRateLimits(t *testing.T) {
+	defer func(d time.Duration) { minSecondaryLimitDelay = d }(minSecondaryLimitDelay)
+	minSecondaryLimitDelay = time.Millisecond
+
+	for _, test := range []struct {
+		name  string
+		limit func(w http.ResponseWriter)
+	}{
+		{"retry-after", func(w http.ResponseWriter) {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+		}},
+		{"reset", func(w http.ResponseWriter) {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Unix()))
+			w.WriteHeader(http.StatusForbidden)
+		}},
+		{"secondary", func(w http.ResponseWriter) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message": "You have exceeded a secondary rate limit."}`)
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c, url, requests := newTestClient(t, false, func(w http.ResponseWriter, r *http.Request, n int) {
+				if n == 0 {
+					test.limit(w)
+					return
+				}
+				fmt.Fprint(w, "ok")
+			})
+			// A POST is retried too, because it was not processed.
+			status, body, err := do(t, c, http.MethodPost, url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if status != http.StatusOK || body != "ok" || requests() != 2 {
+				t.Errorf("got %d %q after %d requests, want 200 \"ok\" after 2", status, body, requests())
+			}
+		})
+	}
+
+	// A 403 for another reason is not retried.
+	c, url, requests := newTestClient(t, false, func(w http.ResponseWriter, r *http.Request, n int) {
+		http.Error(w, "Bad credentials", http.StatusForbidden)
+	})
+	status, body, err := get(t, c, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != http.StatusForbidden || !strings.Contains(body, "Bad credentials") || requests() != 1 {
+		t.Errorf("got %d %q after %d requests, want 403 after 1", status, body, requests())
+	}
+}
+
+func TestExhaustedRateLimit(t *testing.T) {
+	c, url, requests := newTestClient(t, false, func(w http.ResponseWriter, r *http.Request, n int) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		fmt.Fprint(w, "ok")
+	})
+	if _, _, err := get(t, c, url); err != nil {
+		t.Fatal(err)
+	}
+	// The limit resets after MaxWait, so the next request fails without
+	// being sent.
+	_, _, err := get(t, c, url)
+	if err == nil || !strings.Contains(err.Error(), "rate limit exhausted") {
+		t.Errorf("got %v, want rate limit error", err)
+	}
+	if requests() != 1 {
+		t.Errorf("got %d requests, want 1", requests())
+	}
+}
+
+func TestETagCache(t *testing.T) {
+	c, url, requests := newTestClient(t, false, func(w http.ResponseWriter, r *http.Request, n int) {
+		w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(100-n))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, "content")
+	})
+	for i := 0; i < 2; i++ {
+		status, body, err := get(t, c, url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status != http.StatusOK || body != "content" {
+			t.Errorf("request %d: got %d %q, want 200 \"content\"", i, status, body)
+		}
+	}
+	if requests() != 2 {
+		t.Errorf("got %d requests, want 2", requests())
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gitlab supports reading the Go advisories of the GitLab Advisory
+// Database, from a clone of its community repo.
+// See https://gitlab.com/gitlab-org/advisories-community.
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"gopkg.in/yaml.v3"
+)
+
+// URL is the URL of the community repo of the GitLab Advisory Database.
+const URL = "https://gitlab.com/gitlab-org/advisories-community"
+
+// goDir is the directory of the repo that holds the advisories about Go
+// packages, in subdirectories named after the packages.
+const goDir = "go"
+
+// An Advisory is an advisory in the GitLab Advisory Database, as represented
+// in its YAML files. Only the fields needed for triage are included.
+type Advisory struct {
+	// Identifier is the main ID of the advisory, such as a CVE ID, or a
+	// GitLab ID (GMS-YYYY-NNN) if it has no other.
+	Identifier string `yaml:"identifier"`
+	// Identifiers are all the IDs of the advisory, including Identifier.
+	Identifiers []string `yaml:"identifiers"`
+	// PackageSlug is the ecosystem and the path of the package, such as
+	// "go/github.com/a/b".
+	PackageSlug string `yaml:"package_slug"`
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	// PubDate is the date the advisory was published, as YYYY-MM-DD.
+	PubDate string `yaml:"pubdate"`
+	// AffectedRange is the range of affected versions, such as "<1.2.3".
+	AffectedRange string   `yaml:"affected_range"`
+	FixedVersions []string `yaml:"fixed_versions"`
+	URLs          []string `yaml:"urls"`
+	CVSSV3        string   `yaml:"cvss_v3"`
+	CWEIDs        []string `yaml:"cwe_ids"`
+}
+
+// PackagePath returns the import path of the Go package of a, or "" if a is
+// not about a Go package.
+func (a *Advisory) PackagePath() string {
+	if !strings.HasPrefix(a.PackageSlug, goDir+"/") {
+		return ""
+	}
+	return strings.TrimPrefix(a.PackageSlug, goDir+"/")
+}
+
+// IDs returns the IDs of a, starting with Identifier, without duplicates.
+func (a *Advisory) IDs() []string {
+	ids := []string{a.Identifier}
+	for _, id := range a.Identifiers {
+		if id != "" && !contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// CVE returns the CVE ID of a, or "" if it has none.
+func (a *Advisory) CVE() string {
+	for _, id := range a.IDs() {
+		if strings.HasPrefix(id, "CVE-") {
+			return id
+		}
+	}
+	return ""
+}
+
+func contains(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ToCVE4 returns a, which must have a CVE ID, as a CVE in version 4 of the
+// CVE JSON format, with the fields that triage uses. The package of a comes
+// first among the references, as a pkg.go.dev URL.
+func (a *Advisory) ToCVE4() *cveschema.CVE {
+	cve := &cveschema.CVE{
+		Metadata: cveschema.Metadata{
+			ID:       a.CVE(),
+			Assigner: "GitLab",
+			State:    cveschema.StatePublic,
+		},
+		DataType:    "CVE",
+		DataFormat:  "MITRE",
+		DataVersion: "4.0",
+	}
+	description := a.Description
+	if description == "" {
+		description = a.Title
+	}
+	cve.Description.Data = []cveschema.LangString{{Lang: "eng", Value: description}}
+	if p := a.PackagePath(); p != "" {
+		cve.References.Data = append(cve.References.Data, cveschema.Reference{URL: "https://pkg.go.dev/" + p})
+	}
+	for _, u := range a.URLs {
+		cve.References.Data = append(cve.References.Data, cveschema.Reference{URL: u})
+	}
+	if len(a.CWEIDs) > 0 {
+		var item cveschema.ProblemTypeDataItem
+		for _, id := range a.CWEIDs {
+			item.Description = append(item.Description, cveschema.LangString{Lang: "eng", Value: id})
+		}
+		cve.ProblemType.Data = []cveschema.ProblemTypeDataItem{item}
+	}
+	if a.CVSSV3 != "" {
+		cve.Impact = &cveschema.Impact{CVSS: cveschema.CVSSList{{Version: "3.1", VectorString: a.CVSSV3}}}
+	}
+	return cve
+}
+
+// A File is an advisory file in the repo.
+type File struct {
+	// Path is the path of the file in the repo.
+	Path string
+	// BlobHash is the hash of the file's contents, which changes whenever
+	// the advisory does.
+	BlobHash string
+	Advisory *Advisory
+}
+
+// ReadGoAdvisories returns the advisory files about Go packages at HEAD of
+// repo, which must be a clone of the GitLab Advisory Database, in the order
+// of their paths.
+func ReadGoAdvisories(repo *git.Repository) (_ []*File, err error) {
+	defer derrors.Wrap(&err, "gitlab.ReadGoAdvisories")
+
+	root, err := gitrepo.Root(repo)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := root.Tree(goDir)
+	if errors.Is(err, object.ErrDirectoryNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var files []*File
+	iter := tree.Files()
+	defer iter.Close()
+	for {
+		f, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasSuffix(f.Name, ".yml") && !strings.HasSuffix(f.Name, ".yaml") {
+			continue
+		}
+		path := goDir + "/" + f.Name
+		contents, err := f.Contents()
+		if err != nil {
+			return nil, err
+		}
+		var a Advisory
+		if err := yaml.Unmarshal([]byte(contents), &a); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		if a.PackagePath() == "" {
+			return nil, fmt.Errorf("%s: package %q is not a Go package", path, a.PackageSlug)
+		}
+		files = append(files, &File{Path: path, BlobHash: f.Hash.String(), Advisory: &a})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/gitrepo"
+)
+
+func TestReadGoAdvisories(t *testing.T) {
+	repo, err := gitrepo.ReadTxtarRepo("testdata/advisories.txtar", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := ReadGoAdvisories(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, f := range files {
+		if f.BlobHash == "" {
+			t.Errorf("%s: no blob hash", f.Path)
+		}
+		paths = append(paths, f.Path)
+	}
+	wantPaths := []string{
+		"go/example.com/c/pkg/GMS-2022-7.yml",
+		"go/github.com/a/b/CVE-2022-1001.yml",
+	}
+	if diff := cmp.Diff(wantPaths, paths); diff != "" {
+		t.Fatalf("paths mismatch (-want, +got):\n%s", diff)
+	}
+
+	gms := files[0].Advisory
+	if got, want := gms.PackagePath(), "example.com/c/pkg"; got != want {
+		t.Errorf("PackagePath: got %q, want %q", got, want)
+	}
+	if got := gms.CVE(); got != "" {
+		t.Errorf("CVE: got %q, want none", got)
+	}
+
+	a := files[1].Advisory
+	if diff := cmp.Diff([]string{"CVE-2022-1001", "GHSA-aaaa-bbbb-cccc"}, a.IDs()); diff != "" {
+		t.Errorf("IDs mismatch (-want, +got):\n%s", diff)
+	}
+	want := &cveschema.CVE{
+		Metadata: cveschema.Metadata{
+			ID:       "CVE-2022-1001",
+			Assigner: "GitLab",
+			State:    cveschema.StatePublic,
+		},
+		DataType:    "CVE",
+		DataFormat:  "MITRE",
+		DataVersion: "4.0",
+	}
+	want.Description.Data = []cveschema.LangString{{Lang: "eng", Value: "b before 1.2.3 allows XSS."}}
+	want.References.Data = []cveschema.Reference{
+		{URL: "https://pkg.go.dev/github.com/a/b"},
+		{URL: "https://nvd.nist.gov/vuln/detail/CVE-2022-1001"},
+		{URL: "https://github.com/a/b/commit/123"},
+	}
+	want.ProblemType.Data = []cveschema.ProblemTypeDataItem{{
+		Description: []cveschema.LangString{{Lang: "eng", Value: "CWE-1035"}, {Lang: "eng", Value: "CWE-20"}},
+	}}
+	want.Impact = &cveschema.Impact{CVSS: cveschema.CVSSList{{
+		Version:      "3.1",
+		VectorString: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H",
+	}}}
+	if diff := cmp.Diff(want, a.ToCVE4()); diff != "" {
+		t.Errorf("ToCVE4 mismatch (-want, +got):\n%s", diff)
+	}
+}
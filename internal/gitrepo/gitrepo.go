@@ -7,6 +7,7 @@ package gitrepo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -14,8 +15,10 @@ import (
 
 	"github.com/go-git/go-billy/v5/memfs"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/storage/memory"
 	"golang.org/x/exp/event"
 	"golang.org/x/tools/txtar"
@@ -23,6 +26,26 @@ import (
 	"golang.org/x/vulndb/internal/worker/log"
 )
 
+// CloneOptions describe how to clone a repo.
+type CloneOptions struct {
+	// Depth is the number of commits of the history of HEAD to fetch. Zero
+	// means all of it.
+	Depth int
+
+	// AllTags fetches all tags, instead of none.
+	AllTags bool
+
+	// CacheDir is a directory on local disk to keep the clone in. If it
+	// already holds a clone of the same repo, that clone is brought up to
+	// date by fetching from the remote, instead of cloned again. If CacheDir
+	// is empty, the clone is kept only in memory, which suits tests and
+	// one-off runs.
+	CacheDir string
+
+	// Auth holds the credentials for a private repo. See AuthFromEnv.
+	Auth transport.AuthMethod
+}
+
 // Clone returns a repo by cloning the repo at repoURL.
 func Clone(ctx context.Context, repoURL string) (repo *git.Repository, err error) {
 	defer derrors.Wrap(&err, "gitrepo.Clone(%q)", repoURL)
@@ -30,13 +53,93 @@ func Clone(ctx context.Context, repoURL string) (repo *git.Repository, err error
 	defer event.End(ctx)
 
 	log.Infof(ctx, "Cloning repo %q at HEAD", repoURL)
-	return git.Clone(memory.NewStorage(), nil, &git.CloneOptions{
-		URL:           repoURL,
-		ReferenceName: plumbing.HEAD,
-		SingleBranch:  true,
-		Depth:         1,
-		Tags:          git.NoTags,
+	return CloneWithOptions(ctx, repoURL, CloneOptions{Depth: 1})
+}
+
+// CloneWithHistory returns a repo by cloning the repo at repoURL with the
+// full history of HEAD and all tags. It is slower than Clone, but the result
+// can answer which tags contain a commit.
+func CloneWithHistory(ctx context.Context, repoURL string) (repo *git.Repository, err error) {
+	defer derrors.Wrap(&err, "gitrepo.CloneWithHistory(%q)", repoURL)
+	ctx = event.Start(ctx, "gitrepo.CloneWithHistory")
+	defer event.End(ctx)
+
+	log.Infof(ctx, "Cloning repo %q with history", repoURL)
+	return CloneWithOptions(ctx, repoURL, CloneOptions{AllTags: true})
+}
+
+// CloneWithOptions returns a repo by cloning the HEAD branch of the repo at
+// repoURL as described by opts.
+func CloneWithOptions(ctx context.Context, repoURL string, opts CloneOptions) (repo *git.Repository, err error) {
+	defer derrors.Wrap(&err, "gitrepo.CloneWithOptions(%q, depth=%d, tags=%t, cache=%q)",
+		repoURL, opts.Depth, opts.AllTags, opts.CacheDir)
+	ctx = event.Start(ctx, "gitrepo.CloneWithOptions")
+	defer event.End(ctx)
+	defer func() { err = authError(err, opts.Auth) }()
+
+	tags := git.NoTags
+	if opts.AllTags {
+		tags = git.AllTags
+	}
+	if opts.CacheDir == "" {
+		return git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+			URL:           repoURL,
+			ReferenceName: plumbing.HEAD,
+			SingleBranch:  true,
+			Depth:         opts.Depth,
+			Tags:          tags,
+			Auth:          opts.Auth,
+		})
+	}
+	repo, err = git.PlainOpen(opts.CacheDir)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		log.Infof(ctx, "Cloning repo %q into %s", repoURL, opts.CacheDir)
+		return git.PlainCloneContext(ctx, opts.CacheDir, true, &git.CloneOptions{
+			URL:           repoURL,
+			ReferenceName: plumbing.HEAD,
+			SingleBranch:  true,
+			Depth:         opts.Depth,
+			Tags:          tags,
+			Auth:          opts.Auth,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := fetchCached(ctx, repo, repoURL, opts.Depth, tags, opts.Auth); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// fetchCached brings the branch of repo, a clone of repoURL made by
+// CloneWithOptions, up to date with the remote.
+func fetchCached(ctx context.Context, repo *git.Repository, repoURL string, depth int, tags git.TagMode, auth transport.AuthMethod) error {
+	remote, err := repo.Remote(git.DefaultRemoteName)
+	if err != nil {
+		return err
+	}
+	if urls := remote.Config().URLs; len(urls) == 0 || urls[0] != repoURL {
+		return fmt.Errorf("cached repo is a clone of %q", urls)
+	}
+	// The cached clone is bare and has only the branch that HEAD pointed to
+	// on the remote when it was cloned.
+	head, err := repo.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return err
+	}
+	branch := head.Target()
+	log.Infof(ctx, "Fetching %s of cached repo %q", branch, repoURL)
+	err = remote.FetchContext(ctx, &git.FetchOptions{
+		RefSpecs: []config.RefSpec{config.RefSpec(fmt.Sprintf("+%s:%[1]s", branch))},
+		Depth:    depth,
+		Tags:     tags,
+		Auth:     auth,
 	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return err
+	}
+	return nil
 }
 
 // Open returns a repo by opening the repo at the local path dirpath.
@@ -53,11 +156,17 @@ func Open(ctx context.Context, dirpath string) (repo *git.Repository, err error)
 	return repo, nil
 }
 
-// CloneOrOpen clones repoPath if it is an HTTP(S) URL, or opens it from the
-// local disk otherwise.
+// CloneOrOpen clones repoPath if it is an HTTP(S) or SSH URL, or opens it
+// from the local disk otherwise.
 func CloneOrOpen(ctx context.Context, repoPath string) (*git.Repository, error) {
-	if strings.HasPrefix(repoPath, "http://") || strings.HasPrefix(repoPath, "https://") {
-		return Clone(ctx, repoPath)
+	return CloneOrOpenWithOptions(ctx, repoPath, CloneOptions{Depth: 1})
+}
+
+// CloneOrOpenWithOptions clones repoPath as described by opts if it is an
+// HTTP(S) or SSH URL, or opens it from the local disk otherwise.
+func CloneOrOpenWithOptions(ctx context.Context, repoPath string, opts CloneOptions) (*git.Repository, error) {
+	if isRemote(repoPath) {
+		return CloneWithOptions(ctx, repoPath, opts)
 	}
 	return Open(ctx, repoPath)
 }
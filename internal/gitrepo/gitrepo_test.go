@@ -5,13 +5,18 @@
 package gitrepo_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os/exec"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/go-git/go-billy/v5"
 	"github.com/go-git/go-billy/v5/memfs"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/storage/memory"
 	"github.com/google/go-cmp/cmp"
@@ -54,6 +59,84 @@ func TestAllCommitDates(t *testing.T) {
 	}
 }
 
+func TestCloneWithOptions(t *testing.T) {
+	// Cloning from a local path runs git-upload-pack.
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	ctx := context.Background()
+	src := t.TempDir()
+	test := newDiskTest(t, src)
+	when := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	test.Commit("first", when, map[string]string{"a": "1"})
+	test.Commit("second", when.Add(time.Hour), map[string]string{"a": "2"})
+
+	checkHead := func(repo *git.Repository, wantCommits int) {
+		t.Helper()
+		want, err := gitrepo.HeadHash(test.Repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := gitrepo.HeadHash(repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("got HEAD %s, want %s", got, want)
+		}
+		iter, err := repo.Log(&git.LogOptions{From: got})
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		err = iter.ForEach(func(*object.Commit) error { n++; return nil })
+		// The history of a shallow clone ends at a missing parent.
+		if err != nil && !errors.Is(err, plumbing.ErrObjectNotFound) {
+			t.Fatal(err)
+		}
+		if n != wantCommits {
+			t.Errorf("got %d commits, want %d", n, wantCommits)
+		}
+	}
+
+	// In memory.
+	repo, err := gitrepo.CloneWithOptions(ctx, src, gitrepo.CloneOptions{Depth: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkHead(repo, 1)
+	repo, err = gitrepo.CloneWithOptions(ctx, src, gitrepo.CloneOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkHead(repo, 2)
+
+	// Cached on disk: a second clone fetches the new commit.
+	opts := gitrepo.CloneOptions{CacheDir: filepath.Join(t.TempDir(), "cache")}
+	repo, err = gitrepo.CloneWithOptions(ctx, src, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkHead(repo, 2)
+	test.Commit("third", when.Add(2*time.Hour), map[string]string{"a": "3"})
+	repo, err = gitrepo.CloneWithOptions(ctx, src, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkHead(repo, 3)
+	// Again, with nothing new to fetch.
+	repo, err = gitrepo.CloneWithOptions(ctx, src, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkHead(repo, 3)
+
+	// The cache does not hold a clone of another repo.
+	if _, err := gitrepo.CloneWithOptions(ctx, t.TempDir(), opts); err == nil {
+		t.Error("clone of another repo into the cache: got nil error")
+	}
+}
+
 type gitTest struct {
 	t    *testing.T
 	FS   billy.Filesystem
@@ -74,6 +157,24 @@ func newTest(t *testing.T) *gitTest {
 	}
 }
 
+// newDiskTest is like newTest, but creates the repo on disk in dir.
+func newDiskTest(t *testing.T, dir string) *gitTest {
+	t.Helper()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &gitTest{
+		t:    t,
+		FS:   wt.Filesystem,
+		Repo: repo,
+	}
+}
+
 func (test *gitTest) Commit(message string, when time.Time, files map[string]string) {
 	test.t.Helper()
 	wt, err := test.Repo.Worktree()
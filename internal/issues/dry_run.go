@@ -0,0 +1,102 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// A DryRunClient is a Client that reads issues with another Client, but
+// only remembers the issues it is asked to create.
+type DryRunClient struct {
+	c Client
+
+	mu        sync.Mutex
+	created   []*Issue
+	commented []*Comment
+}
+
+// A Comment is a comment that a DryRunClient would have added to an issue.
+type Comment struct {
+	Number int
+	Body   string
+}
+
+// NewDryRunClient returns a DryRunClient that reads issues with c.
+func NewDryRunClient(c Client) *DryRunClient {
+	return &DryRunClient{c: c}
+}
+
+// Destination implements Client.Destination.
+func (d *DryRunClient) Destination() string {
+	return d.c.Destination()
+}
+
+// Reference implements Client.Reference. The issues that d did not create
+// have negative numbers, so their references say that they are not real.
+func (d *DryRunClient) Reference(number int) string {
+	if number < 0 {
+		return fmt.Sprintf("%s (dry run issue %d)", d.c.Destination(), -number)
+	}
+	return d.c.Reference(number)
+}
+
+// IssueExists implements Client.IssueExists.
+func (d *DryRunClient) IssueExists(ctx context.Context, number int) (bool, error) {
+	if number < 0 {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		return -number <= len(d.created), nil
+	}
+	return d.c.IssueExists(ctx, number)
+}
+
+// GetIssue implements Client.GetIssue.
+func (d *DryRunClient) GetIssue(ctx context.Context, number int, opts GetIssueOptions) (*Issue, error) {
+	if number < 0 {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if -number > len(d.created) {
+			return nil, fmt.Errorf("no dry run issue %d", -number)
+		}
+		return d.created[-number-1], nil
+	}
+	return d.c.GetIssue(ctx, number, opts)
+}
+
+// CreateIssue implements Client.CreateIssue. It returns the negative of the
+// number of issues that d would have created.
+func (d *DryRunClient) CreateIssue(_ context.Context, iss *Issue) (number int, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c := *iss
+	d.created = append(d.created, &c)
+	return -len(d.created), nil
+}
+
+// Created returns the issues that d would have created, in order.
+func (d *DryRunClient) Created() []*Issue {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]*Issue(nil), d.created...)
+}
+
+// CreateComment implements Client.CreateComment. It only remembers the
+// comment.
+func (d *DryRunClient) CreateComment(_ context.Context, number int, body string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.commented = append(d.commented, &Comment{Number: number, Body: body})
+	return nil
+}
+
+// Commented returns the comments that d would have added, in order.
+func (d *DryRunClient) Commented() []*Comment {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]*Comment(nil), d.commented...)
+}
@@ -12,14 +12,16 @@ import (
 // NewFakeClient returns a fake Client suitable for testing.
 func NewFakeClient() Client {
 	return &fakeClient{
-		nextID: 1,
-		issues: map[int]*Issue{},
+		nextID:   1,
+		issues:   map[int]*Issue{},
+		comments: map[int][]string{},
 	}
 }
 
 type fakeClient struct {
-	nextID int
-	issues map[int]*Issue
+	nextID   int
+	issues   map[int]*Issue
+	comments map[int][]string
 }
 
 func (c *fakeClient) Destination() string {
@@ -46,3 +48,11 @@ func (c *fakeClient) CreateIssue(_ context.Context, iss *Issue) (number int, err
 	c.issues[number] = &copy
 	return number, nil
 }
+
+func (c *fakeClient) CreateComment(_ context.Context, number int, body string) error {
+	if _, ok := c.issues[number]; !ok {
+		return fmt.Errorf("no issue %d", number)
+	}
+	c.comments[number] = append(c.comments[number], body)
+	return nil
+}
@@ -8,16 +8,19 @@ package issues
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/go-github/v41/github"
-	"golang.org/x/oauth2"
+	"golang.org/x/exp/event"
 	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/githubapi"
 )
 
 // An Issue represents a GitHub issue or similar.
 type Issue struct {
+	Number    int
 	Title     string
 	Body      string
 	State     string
@@ -46,6 +49,10 @@ type Client interface {
 
 	// GetIssue returns an issue with the given issue number.
 	GetIssue(ctx context.Context, number int, opts GetIssueOptions) (iss *Issue, err error)
+
+	// CreateComment adds a comment with the given body to the issue with
+	// number.
+	CreateComment(ctx context.Context, number int, body string) error
 }
 
 type githubClient struct {
@@ -57,16 +64,43 @@ type githubClient struct {
 // NewGitHubClient creates a Client that will create issues in
 // the a GitHub repo.
 // A GitHub access token is required to create issues.
+//
+// The client waits out GitHub's rate limits and retries failed reads, as
+// described by githubapi.Transport.
 func NewGitHubClient(owner, repo, accessToken string) *githubClient {
-	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
-	tc := oauth2.NewClient(context.Background(), ts)
 	return &githubClient{
-		client: github.NewClient(tc),
+		client: github.NewClient(githubapi.NewClient(context.Background(), accessToken, nil)),
 		owner:  owner,
 		repo:   repo,
 	}
 }
 
+// call calls f, which makes a request with c.client.
+//
+// The transport of c.client waits out rate limits, but once a response says
+// that the rate limit is exhausted, the github package fails requests without
+// sending them until the limit resets. So if f fails that way, call waits for
+// the reset, if it is soon enough, and calls f again.
+func (c *githubClient) call(ctx context.Context, f func() error) error {
+	err := f()
+	var rerr *github.RateLimitError
+	if !errors.As(err, &rerr) {
+		return err
+	}
+	wait := time.Until(rerr.Rate.Reset.Time)
+	if wait > githubapi.DefaultMaxWait {
+		return err
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+	return f()
+}
+
 // Destination implements Client.Destination.
 func (c *githubClient) Destination() string {
 	return fmt.Sprintf("https://github.com/%s/%s", c.owner, c.repo)
@@ -80,8 +114,14 @@ func (c *githubClient) Reference(num int) string {
 // IssueExists implements Client.IssueExists.
 func (c *githubClient) IssueExists(ctx context.Context, number int) (_ bool, err error) {
 	defer derrors.Wrap(&err, "IssueExists(%d)", number)
-
-	iss, _, err := c.client.Issues.Get(ctx, c.owner, c.repo, number)
+	ctx = event.Start(ctx, "issues.IssueExists")
+	defer event.End(ctx)
+
+	var iss *github.Issue
+	err = c.call(ctx, func() (err error) {
+		iss, _, err = c.client.Issues.Get(ctx, c.owner, c.repo, number)
+		return err
+	})
 	if err != nil {
 		return false, err
 	}
@@ -95,11 +135,17 @@ func (c *githubClient) IssueExists(ctx context.Context, number int) (_ bool, err
 // GetIssue implements Client.GetIssue.
 func (c *githubClient) GetIssue(ctx context.Context, number int, opts GetIssueOptions) (_ *Issue, err error) {
 	defer derrors.Wrap(&err, "GetIssue(%d)", number)
-	iss, _, err := c.client.Issues.Get(ctx, c.owner, c.repo, number)
+	ctx = event.Start(ctx, "issues.GetIssue")
+	defer event.End(ctx)
+	var iss *github.Issue
+	err = c.call(ctx, func() (err error) {
+		iss, _, err = c.client.Issues.Get(ctx, c.owner, c.repo, number)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	r := &Issue{}
+	r := &Issue{Number: iss.GetNumber()}
 	if iss.Title != nil {
 		r.Title = *iss.Title
 	}
@@ -113,7 +159,11 @@ func (c *githubClient) GetIssue(ctx context.Context, number int, opts GetIssueOp
 		r.State = *iss.State
 	}
 	if opts.GetLabels {
-		labels, _, err := c.client.Issues.ListLabelsByIssue(ctx, c.owner, c.repo, number, nil)
+		var labels []*github.Label
+		err := c.call(ctx, func() (err error) {
+			labels, _, err = c.client.Issues.ListLabelsByIssue(ctx, c.owner, c.repo, number, nil)
+			return err
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -127,6 +177,8 @@ func (c *githubClient) GetIssue(ctx context.Context, number int, opts GetIssueOp
 // CreateIssue implements Client.CreateIssue.
 func (c *githubClient) CreateIssue(ctx context.Context, iss *Issue) (number int, err error) {
 	defer derrors.Wrap(&err, "CreateIssue(%s)", iss.Title)
+	ctx = event.Start(ctx, "issues.CreateIssue")
+	defer event.End(ctx)
 
 	req := &github.IssueRequest{
 		Title: &iss.Title,
@@ -135,9 +187,66 @@ func (c *githubClient) CreateIssue(ctx context.Context, iss *Issue) (number int,
 	if len(iss.Labels) > 0 {
 		req.Labels = &iss.Labels
 	}
-	giss, _, err := c.client.Issues.Create(ctx, c.owner, c.repo, req)
+	var giss *github.Issue
+	err = c.call(ctx, func() (err error) {
+		giss, _, err = c.client.Issues.Create(ctx, c.owner, c.repo, req)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
 	return giss.GetNumber(), nil
 }
+
+// CreateComment implements Client.CreateComment.
+func (c *githubClient) CreateComment(ctx context.Context, number int, body string) (err error) {
+	defer derrors.Wrap(&err, "CreateComment(%d)", number)
+	ctx = event.Start(ctx, "issues.CreateComment")
+	defer event.End(ctx)
+
+	return c.call(ctx, func() error {
+		_, _, err := c.client.Issues.CreateComment(ctx, c.owner, c.repo, number, &github.IssueComment{Body: &body})
+		return err
+	})
+}
+
+// SearchOpenIssues returns the open issues of the repo that mention text in
+// their title or body, most recently updated first.
+func (c *githubClient) SearchOpenIssues(ctx context.Context, text string) (_ []*Issue, err error) {
+	defer derrors.Wrap(&err, "SearchOpenIssues(%q)", text)
+	ctx = event.Start(ctx, "issues.SearchOpenIssues")
+	defer event.End(ctx)
+
+	query := fmt.Sprintf("repo:%s/%s is:issue is:open in:title,body %q", c.owner, c.repo, text)
+	opts := &github.SearchOptions{
+		Sort:        "updated",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	var r []*Issue
+	for {
+		var (
+			res  *github.IssuesSearchResult
+			resp *github.Response
+		)
+		err := c.call(ctx, func() (err error) {
+			res, resp, err = c.client.Search.Issues(ctx, query, opts)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, iss := range res.Issues {
+			r = append(r, &Issue{
+				Number:    iss.GetNumber(),
+				Title:     iss.GetTitle(),
+				Body:      iss.GetBody(),
+				State:     iss.GetState(),
+				CreatedAt: iss.GetCreatedAt(),
+			})
+		}
+		if resp.NextPage == 0 {
+			return r, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
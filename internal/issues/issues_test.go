@@ -26,6 +26,17 @@ func TestClient(t *testing.T) {
 	t.Run("fake", func(t *testing.T) {
 		testClient(t, NewFakeClient())
 	})
+	t.Run("dry run", func(t *testing.T) {
+		fake := NewFakeClient()
+		d := NewDryRunClient(fake)
+		testClient(t, d)
+		if got := len(d.Created()); got != 1 {
+			t.Errorf("got %d dry run issues, want 1", got)
+		}
+		if exists, _ := fake.IssueExists(context.Background(), 1); exists {
+			t.Error("dry run created issue")
+		}
+	})
 	t.Run("github", func(t *testing.T) {
 		if *githubRepo == "" {
 			t.Skip("skipping: no -repo flag")
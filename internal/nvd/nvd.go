@@ -0,0 +1,392 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package nvd supports fetching CVEs from version 2.0 of the National
+// Vulnerability Database's CVE API.
+// See https://nvd.nist.gov/developers/vulnerabilities.
+package nvd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/time/rate"
+	"golang.org/x/vulndb/internal/cpe"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// DefaultBaseURL is the URL of the NVD CVE API.
+const DefaultBaseURL = "https://services.nvd.nist.gov/rest/json/cves/2.0"
+
+// MaxDateRange is the longest range of last-modified dates that the API
+// accepts in a single request.
+const MaxDateRange = 120 * 24 * time.Hour
+
+// resultsPerPage is the number of CVEs requested per page. It is the
+// API's maximum.
+const resultsPerPage = 2000
+
+// VulnStatusRejected is the VulnStatus of a rejected CVE.
+const VulnStatusRejected = "Rejected"
+
+// A CVE is a CVE as represented by the NVD API.
+// Only the fields needed for triage are included.
+type CVE struct {
+	ID               string          `json:"id"`
+	SourceIdentifier string          `json:"sourceIdentifier"`
+	Published        Time            `json:"published"`
+	LastModified     Time            `json:"lastModified"`
+	VulnStatus       string          `json:"vulnStatus"`
+	Descriptions     []LangString    `json:"descriptions"`
+	References       []Reference     `json:"references"`
+	Metrics          Metrics         `json:"metrics"`
+	Weaknesses       []Weakness      `json:"weaknesses"`
+	Configurations   []Configuration `json:"configurations"`
+}
+
+// Metrics are the CVSS scores of a CVE, from the NVD and other sources.
+// Only CVSS v3 scores are recorded.
+type Metrics struct {
+	CVSSMetricV31 []CVSSMetric `json:"cvssMetricV31,omitempty"`
+	CVSSMetricV30 []CVSSMetric `json:"cvssMetricV30,omitempty"`
+}
+
+// A CVSSMetric is a CVSS score from a source, whose type is "Primary" if the
+// source is the NVD.
+type CVSSMetric struct {
+	Source   string   `json:"source"`
+	Type     string   `json:"type"`
+	CVSSData CVSSData `json:"cvssData"`
+}
+
+// CVSSData is a CVSS score and the vector it was computed from.
+type CVSSData struct {
+	Version      string  `json:"version"`
+	VectorString string  `json:"vectorString"`
+	BaseScore    float64 `json:"baseScore"`
+	BaseSeverity string  `json:"baseSeverity"`
+}
+
+// A LangString is a string in a given language.
+type LangString struct {
+	Lang  string `json:"lang"`
+	Value string `json:"value"`
+}
+
+// A Reference is a URL with information about a CVE.
+type Reference struct {
+	URL    string   `json:"url"`
+	Source string   `json:"source"`
+	Tags   []string `json:"tags"`
+}
+
+// A Weakness describes the kind of problem, usually as a CWE ID.
+type Weakness struct {
+	Source      string       `json:"source"`
+	Type        string       `json:"type"`
+	Description []LangString `json:"description"`
+}
+
+// A Configuration describes the affected products as a tree of CPE
+// matches.
+type Configuration struct {
+	Nodes []Node `json:"nodes"`
+}
+
+// A Node is a set of CPE matches, combined with Operator.
+type Node struct {
+	Operator string     `json:"operator"`
+	Negate   bool       `json:"negate"`
+	CPEMatch []CPEMatch `json:"cpeMatch"`
+}
+
+// A CPEMatch matches a range of versions of a product.
+type CPEMatch struct {
+	Vulnerable bool `json:"vulnerable"`
+	// Criteria is a CPE 2.3 formatted string, e.g.
+	// "cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*".
+	Criteria              string `json:"criteria"`
+	VersionStartIncluding string `json:"versionStartIncluding,omitempty"`
+	VersionStartExcluding string `json:"versionStartExcluding,omitempty"`
+	VersionEndIncluding   string `json:"versionEndIncluding,omitempty"`
+	VersionEndExcluding   string `json:"versionEndExcluding,omitempty"`
+}
+
+// Time is a time in the API's format, which has no time zone.
+// All API times are in UTC.
+type Time struct {
+	time.Time
+}
+
+const timeLayout = "2006-01-02T15:04:05.999"
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	tm, err := time.Parse(timeLayout, s)
+	if err != nil {
+		return err
+	}
+	t.Time = tm
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.UTC().Format(timeLayout))
+}
+
+// response is a page of results from the API.
+type response struct {
+	ResultsPerPage  int `json:"resultsPerPage"`
+	StartIndex      int `json:"startIndex"`
+	TotalResults    int `json:"totalResults"`
+	Vulnerabilities []struct {
+		CVE *CVE `json:"cve"`
+	} `json:"vulnerabilities"`
+}
+
+// A Client fetches CVEs from the NVD API.
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+}
+
+// NewClient returns a Client for the API at baseURL.
+// If apiKey is empty, requests are made without a key, and are rate-limited
+// more strictly, as the API requires.
+func NewClient(baseURL, apiKey string) *Client {
+	// The API allows 5 requests in a rolling 30-second window without a key,
+	// and 50 with one.
+	every := 6 * time.Second
+	if apiKey != "" {
+		every = 600 * time.Millisecond
+	}
+	return &Client{
+		baseURL:    baseURL,
+		apiKey:     apiKey,
+		httpClient: http.DefaultClient,
+		limiter:    rate.NewLimiter(rate.Every(every), 1),
+	}
+}
+
+// ListModified returns all the CVEs that were last modified between start and
+// end, inclusive. The range must not be longer than MaxDateRange.
+func (c *Client) ListModified(ctx context.Context, start, end time.Time) (_ []*CVE, err error) {
+	defer derrors.Wrap(&err, "nvd.ListModified(%s, %s)", start.Format(time.RFC3339), end.Format(time.RFC3339))
+
+	if end.Sub(start) > MaxDateRange {
+		return nil, fmt.Errorf("date range is longer than %s", MaxDateRange)
+	}
+	var cves []*CVE
+	for index := 0; ; {
+		params := url.Values{}
+		params.Set("lastModStartDate", start.UTC().Format(queryTimeLayout))
+		params.Set("lastModEndDate", end.UTC().Format(queryTimeLayout))
+		params.Set("startIndex", strconv.Itoa(index))
+		params.Set("resultsPerPage", strconv.Itoa(resultsPerPage))
+		resp, err := c.get(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range resp.Vulnerabilities {
+			cves = append(cves, v.CVE)
+		}
+		index += len(resp.Vulnerabilities)
+		if len(resp.Vulnerabilities) == 0 || index >= resp.TotalResults {
+			break
+		}
+	}
+	return cves, nil
+}
+
+// queryTimeLayout is the format of times in query parameters.
+const queryTimeLayout = "2006-01-02T15:04:05.000-07:00"
+
+// Get returns the CVE with the given ID, or nil if the NVD does not have it.
+func (c *Client) Get(ctx context.Context, id string) (_ *CVE, err error) {
+	defer derrors.Wrap(&err, "nvd.Get(%s)", id)
+
+	params := url.Values{}
+	params.Set("cveId", id)
+	resp, err := c.get(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range resp.Vulnerabilities {
+		if v.CVE.ID == id {
+			return v.CVE, nil
+		}
+	}
+	return nil, nil
+}
+
+// get fetches a page of CVEs from the API.
+func (c *Client) get(ctx context.Context, params url.Values) (*response, error) {
+	ctx = event.Start(ctx, "nvd.get")
+	defer event.End(ctx)
+
+	u := c.baseURL + "?" + params.Encode()
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.apiKey != "" {
+		req.Header.Set("apiKey", c.apiKey)
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		// The API explains errors in a header.
+		return nil, fmt.Errorf("%s returned %s: %s", u, res.Status, res.Header.Get("message"))
+	}
+	var r response
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ToCVE4 converts c to the JSON 4.0 CVE format used by the cvelist repo, so
+// that it can be triaged like a CVE from that repo.
+func (c *CVE) ToCVE4() *cveschema.CVE {
+	state := cveschema.StatePublic
+	if c.VulnStatus == VulnStatusRejected {
+		state = cveschema.StateRejected
+	}
+	cve := &cveschema.CVE{
+		Metadata: cveschema.Metadata{
+			ID:       c.ID,
+			Assigner: c.SourceIdentifier,
+			State:    state,
+		},
+		DataType:    "CVE",
+		DataFormat:  "MITRE",
+		DataVersion: "4.0",
+	}
+	for _, d := range c.Descriptions {
+		cve.Description.Data = append(cve.Description.Data, cveschema.LangString(d))
+	}
+	for _, w := range c.Weaknesses {
+		var item cveschema.ProblemTypeDataItem
+		for _, d := range w.Description {
+			item.Description = append(item.Description, cveschema.LangString(d))
+		}
+		cve.ProblemType.Data = append(cve.ProblemType.Data, item)
+	}
+	for _, r := range c.References {
+		cve.References.Data = append(cve.References.Data, cveschema.Reference{URL: r.URL})
+	}
+	cve.Affects.Vendor.Data = vendorData(c.Configurations)
+	cve.Configurations = configurations(c.Configurations)
+	// Prefer v3.1 scores to v3.0 ones, and the NVD's to others.
+	var cvss cveschema.CVSSList
+	for _, ms := range [][]CVSSMetric{c.Metrics.CVSSMetricV31, c.Metrics.CVSSMetricV30} {
+		for _, primary := range []bool{true, false} {
+			for _, m := range ms {
+				if (m.Type == "Primary") == primary {
+					cvss = append(cvss, cveschema.CVSS(m.CVSSData))
+				}
+			}
+		}
+	}
+	if len(cvss) > 0 {
+		cve.Impact = &cveschema.Impact{CVSS: cvss}
+	}
+	return cve
+}
+
+// vendorData returns the vulnerable vendors and products named in the CPE
+// matches of configs, without duplicates.
+func vendorData(configs []Configuration) []cveschema.VendorDataItem {
+	var items []cveschema.VendorDataItem
+	seen := map[[2]string]bool{}
+	for _, c := range configs {
+		for _, n := range c.Nodes {
+			for _, m := range n.CPEMatch {
+				if !m.Vulnerable {
+					continue
+				}
+				vendor, product, ok := parseCPE(m.Criteria)
+				if !ok || seen[[2]string{vendor, product}] {
+					continue
+				}
+				seen[[2]string{vendor, product}] = true
+				items = append(items, cveschema.VendorDataItem{
+					VendorName: vendor,
+					Product: cveschema.Product{
+						Data: []cveschema.ProductDataItem{{ProductName: product}},
+					},
+				})
+			}
+		}
+	}
+	return items
+}
+
+// parseCPE returns the vendor and product of a CPE 2.3 formatted string.
+func parseCPE(s string) (vendor, product string, ok bool) {
+	n, err := cpe.Parse(s)
+	if err != nil {
+		return "", "", false
+	}
+	return n.Vendor, n.Product, true
+}
+
+// configurations converts configs to the form of the configurations of the
+// NVD's 1.1 feeds, in which the nodes of a configuration are the children of
+// an "AND" node.
+func configurations(configs []Configuration) *cveschema.Configurations {
+	if len(configs) == 0 {
+		return nil
+	}
+	convert := func(n Node) cveschema.ConfigNode {
+		cn := cveschema.ConfigNode{Operator: n.Operator, Negate: n.Negate}
+		for _, m := range n.CPEMatch {
+			cn.CPEMatch = append(cn.CPEMatch, cveschema.CPEMatch{
+				Vulnerable:            m.Vulnerable,
+				CPE23URI:              m.Criteria,
+				VersionStartIncluding: m.VersionStartIncluding,
+				VersionStartExcluding: m.VersionStartExcluding,
+				VersionEndIncluding:   m.VersionEndIncluding,
+				VersionEndExcluding:   m.VersionEndExcluding,
+			})
+		}
+		return cn
+	}
+	cs := &cveschema.Configurations{}
+	for _, c := range configs {
+		switch len(c.Nodes) {
+		case 0:
+		case 1:
+			cs.Nodes = append(cs.Nodes, convert(c.Nodes[0]))
+		default:
+			and := cveschema.ConfigNode{Operator: "AND"}
+			for _, n := range c.Nodes {
+				and.Children = append(and.Children, convert(n))
+			}
+			cs.Nodes = append(cs.Nodes, and)
+		}
+	}
+	return cs
+}
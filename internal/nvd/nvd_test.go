@@ -0,0 +1,210 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nvd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+	"golang.org/x/vulndb/internal/cveschema"
+)
+
+func TestListModified(t *testing.T) {
+	data, err := os.ReadFile("testdata/cves.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var page response
+	if err := json.Unmarshal(data, &page); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2022, time.August, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, time.September, 1, 0, 0, 0, 0, time.UTC)
+	// Serve one CVE per page, to test paging.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got, want := q.Get("lastModStartDate"), "2022-08-01T00:00:00.000+00:00"; got != want {
+			http.Error(w, fmt.Sprintf("lastModStartDate: got %q, want %q", got, want), http.StatusBadRequest)
+			return
+		}
+		if got, want := q.Get("lastModEndDate"), "2022-09-01T00:00:00.000+00:00"; got != want {
+			http.Error(w, fmt.Sprintf("lastModEndDate: got %q, want %q", got, want), http.StatusBadRequest)
+			return
+		}
+		if got, want := r.Header.Get("apiKey"), "key"; got != want {
+			http.Error(w, fmt.Sprintf("apiKey: got %q, want %q", got, want), http.StatusForbidden)
+			return
+		}
+		i, err := strconv.Atoi(q.Get("startIndex"))
+		if err != nil || i >= len(page.Vulnerabilities) {
+			http.Error(w, "bad startIndex", http.StatusBadRequest)
+			return
+		}
+		p := page
+		p.StartIndex = i
+		p.ResultsPerPage = 1
+		p.Vulnerabilities = page.Vulnerabilities[i : i+1]
+		if err := json.NewEncoder(w).Encode(p); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key")
+	c.limiter = rate.NewLimiter(rate.Inf, 1)
+	got, err := c.ListModified(context.Background(), start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, cve := range got {
+		ids = append(ids, cve.ID)
+	}
+	if diff := cmp.Diff([]string{"CVE-2022-29804", "CVE-2022-0001"}, ids); diff != "" {
+		t.Fatalf("mismatch (-want, +got):\n%s", diff)
+	}
+	if got, want := got[0].LastModified.Time, time.Date(2022, time.August, 15, 15, 49, 21, 317000000, time.UTC); !got.Equal(want) {
+		t.Errorf("LastModified: got %s, want %s", got, want)
+	}
+
+	if _, err := c.ListModified(context.Background(), start, start.Add(MaxDateRange+time.Hour)); err == nil {
+		t.Error("range too long: got nil, want error")
+	}
+}
+
+func TestGet(t *testing.T) {
+	data, err := os.ReadFile("testdata/cves.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var page response
+	if err := json.Unmarshal(data, &page); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Query().Get("cveId")
+		p := page
+		p.Vulnerabilities = nil
+		for _, v := range page.Vulnerabilities {
+			if v.CVE.ID == id {
+				p.Vulnerabilities = append(p.Vulnerabilities, v)
+			}
+		}
+		p.TotalResults = len(p.Vulnerabilities)
+		if err := json.NewEncoder(w).Encode(p); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "")
+	c.limiter = rate.NewLimiter(rate.Inf, 1)
+	got, err := c.Get(context.Background(), "CVE-2022-0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.ID != "CVE-2022-0001" {
+		t.Errorf("got %+v, want CVE-2022-0001", got)
+	}
+	got, err = c.Get(context.Background(), "CVE-2022-9999")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("got %+v, want nil", got)
+	}
+}
+
+func TestToCVE4(t *testing.T) {
+	data, err := os.ReadFile("testdata/cves.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var page response
+	if err := json.Unmarshal(data, &page); err != nil {
+		t.Fatal(err)
+	}
+
+	got := page.Vulnerabilities[0].CVE.ToCVE4()
+	want := &cveschema.CVE{
+		Metadata: cveschema.Metadata{
+			ID:       "CVE-2022-29804",
+			Assigner: "security@golang.org",
+			State:    cveschema.StatePublic,
+		},
+		DataType:    "CVE",
+		DataFormat:  "MITRE",
+		DataVersion: "4.0",
+		Affects: cveschema.Affects{
+			Vendor: cveschema.Vendor{
+				Data: []cveschema.VendorDataItem{{
+					VendorName: "golang",
+					Product: cveschema.Product{
+						Data: []cveschema.ProductDataItem{{ProductName: "go"}},
+					},
+				}},
+			},
+		},
+		Description: cveschema.Description{
+			Data: []cveschema.LangString{{
+				Lang:  "en",
+				Value: "Incorrect conversion of certain invalid paths to valid, absolute paths in Clean in path/filepath before Go 1.17.11 and Go 1.18.3 on Windows allows potential directory traversal attack.",
+			}},
+		},
+		ProblemType: cveschema.ProblemType{
+			Data: []cveschema.ProblemTypeDataItem{{
+				Description: []cveschema.LangString{{Lang: "en", Value: "CWE-22"}},
+			}},
+		},
+		Impact: &cveschema.Impact{
+			CVSS: cveschema.CVSSList{{
+				Version:      "3.1",
+				VectorString: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N",
+				BaseScore:    7.5,
+				BaseSeverity: "HIGH",
+			}},
+		},
+		References: cveschema.References{
+			Data: []cveschema.Reference{
+				{URL: "https://go.dev/cl/401595"},
+				{URL: "https://pkg.go.dev/vuln/GO-2022-0533"},
+			},
+		},
+		Configurations: &cveschema.Configurations{
+			Nodes: []cveschema.ConfigNode{{
+				Operator: "OR",
+				CPEMatch: []cveschema.CPEMatch{
+					{
+						Vulnerable:          true,
+						CPE23URI:            "cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*",
+						VersionEndExcluding: "1.17.11",
+					},
+					{
+						Vulnerable: true,
+						CPE23URI:   "cpe:2.3:a:golang:go:1.18.0:*:*:*:*:*:*:*",
+					},
+					{CPE23URI: "cpe:2.3:o:microsoft:windows:-:*:*:*:*:*:*:*"},
+				},
+			}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	if got := page.Vulnerabilities[1].CVE.ToCVE4().State; got != cveschema.StateRejected {
+		t.Errorf("rejected CVE: got state %q, want %q", got, cveschema.StateRejected)
+	}
+}
@@ -27,6 +27,7 @@ type Observer struct {
 	tracerProvider *sdktrace.TracerProvider
 	traceHandler   *eotel.TraceHandler
 	metricHandler  *eotel.MetricHandler
+	promHandler    *PrometheusHandler
 	propagator     propagation.TextMapPropagator
 
 	// LogHandlerFunc is invoked in [Observer.Observe] to obtain an
@@ -40,12 +41,29 @@ type Observer struct {
 // than any request context.
 // (We don't want to use the request context because we still want traces even if
 // it is canceled or times out.)
-func NewObserver(ctx context.Context, projectID, serverName string) (_ *Observer, err error) {
-	defer derrors.Wrap(&err, "NewObserver(%q, %q)", projectID, serverName)
+//
+// Traces go to Cloud Trace, unless otlpEndpoint is non-empty, in which case
+// they go to the OpenTelemetry collector at that URL. Metrics always go to
+// Cloud Monitoring.
+func NewObserver(ctx context.Context, projectID, serverName, otlpEndpoint string) (_ *Observer, err error) {
+	defer derrors.Wrap(&err, "NewObserver(%q, %q, %q)", projectID, serverName, otlpEndpoint)
 
-	exporter, err := texporter.New(texporter.WithProjectID(projectID))
-	if err != nil {
-		return nil, err
+	var tp *sdktrace.TracerProvider
+	if otlpEndpoint != "" {
+		tp, err = newOTLPTracerProvider(otlpEndpoint, serverName)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		exporter, err := texporter.New(texporter.WithProjectID(projectID))
+		if err != nil {
+			return nil, err
+		}
+		tp = sdktrace.NewTracerProvider(
+			// Enable tracing if there is no incoming request, or if the incoming
+			// request is sampled.
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+			sdktrace.WithBatcher(exporter))
 	}
 	// Create exporter (collector embedded with the exporter).
 	controller, err := mexporter.NewExportPipeline([]mexporter.Option{
@@ -55,16 +73,12 @@ func NewObserver(ctx context.Context, projectID, serverName string) (_ *Observer
 		return nil, err
 	}
 
-	tp := sdktrace.NewTracerProvider(
-		// Enable tracing if there is no incoming request, or if the incoming
-		// request is sampled.
-		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
-		sdktrace.WithBatcher(exporter))
 	return &Observer{
 		ctx:            ctx,
 		tracerProvider: tp,
 		traceHandler:   eotel.NewTraceHandler(tp.Tracer(serverName)),
 		metricHandler:  eotel.NewMetricHandler(controller.Meter(serverName)),
+		promHandler:    NewPrometheusHandler(),
 		// The propagator extracts incoming trace IDs so that we can connect our trace spans
 		// to the incoming ones constructed by Cloud Run.
 		propagator: propagation.NewCompositeTextMapPropagator(
@@ -89,6 +103,19 @@ func (o *Observer) Observe(h http.Handler) http.Handler {
 	})
 }
 
+// WithExporter returns a context whose events are handled by h and are also
+// exported as traces and metrics. It is for work that is not done in a
+// request, like scheduled tasks.
+func (o *Observer) WithExporter(ctx context.Context, h event.Handler) context.Context {
+	return event.WithExporter(ctx, event.NewExporter(eventHandler{o, h}, nil))
+}
+
+// MetricsHandler returns an http.Handler that serves the metrics recorded
+// in observed contexts, in the Prometheus text format.
+func (o *Observer) MetricsHandler() http.Handler {
+	return o.promHandler
+}
+
 type eventHandler struct {
 	o  *Observer
 	eh event.Handler
@@ -100,5 +127,6 @@ func (h eventHandler) Event(ctx context.Context, ev *event.Event) context.Contex
 		ctx = h.eh.Event(ctx, ev)
 	}
 	ctx = h.o.traceHandler.Event(ctx, ev)
+	ctx = h.o.promHandler.Event(ctx, ev)
 	return h.o.metricHandler.Event(ctx, ev)
 }
@@ -0,0 +1,241 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package observe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// OTLPHeadersEnv is the environment variable with extra headers for requests
+// to an OTLP endpoint, as comma-separated key=value pairs. It is the variable
+// that OpenTelemetry SDKs use.
+const OTLPHeadersEnv = "OTEL_EXPORTER_OTLP_HEADERS"
+
+// An otlpExporter is a span exporter that sends spans to an OpenTelemetry
+// collector with OTLP over HTTP, encoded as JSON.
+//
+// The OpenTelemetry OTLP exporters need a newer version of the SDK than we
+// use, and they encode spans as protocol buffers; every collector accepts
+// the JSON encoding as well.
+type otlpExporter struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// newOTLPExporter returns an exporter that sends spans to the collector at
+// endpoint, like "http://localhost:4318", with the headers in the
+// environment.
+func newOTLPExporter(endpoint string) (*otlpExporter, error) {
+	headers, err := parseOTLPHeaders(os.Getenv(OTLPHeadersEnv))
+	if err != nil {
+		return nil, err
+	}
+	return &otlpExporter{
+		url:     strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		headers: headers,
+		client:  http.DefaultClient,
+	}, nil
+}
+
+// parseOTLPHeaders parses a list of headers like "k1=v1,k2=v2".
+func parseOTLPHeaders(s string) (map[string]string, error) {
+	headers := map[string]string{}
+	for _, kv := range strings.Split(s, ",") {
+		if strings.TrimSpace(kv) == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			// Don't echo the header, which may hold a secret.
+			return nil, fmt.Errorf("%s: missing '=' in header", OTLPHeadersEnv)
+		}
+		headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return headers, nil
+}
+
+// ExportSpans implements sdktrace.SpanExporter.
+func (e *otlpExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) (err error) {
+	defer derrors.Wrap(&err, "otlpExporter.ExportSpans(%d spans)", len(spans))
+
+	if len(spans) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(otlpRequest(spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s: %s: %s", e.url, resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// Shutdown implements sdktrace.SpanExporter.
+func (e *otlpExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// The JSON form of an OTLP ExportTraceServiceRequest, with only the fields we
+// use. As the OTLP spec requires, trace and span IDs are hex strings, and
+// 64-bit integers are decimal strings.
+type (
+	otlpTraces struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+	otlpResource struct {
+		Attributes []otlpKeyValue `json:"attributes,omitempty"`
+	}
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+	otlpScope struct {
+		Name    string `json:"name,omitempty"`
+		Version string `json:"version,omitempty"`
+	}
+	otlpSpan struct {
+		TraceID           string         `json:"traceId"`
+		SpanID            string         `json:"spanId"`
+		ParentSpanID      string         `json:"parentSpanId,omitempty"`
+		Name              string         `json:"name"`
+		Kind              int            `json:"kind"`
+		StartTimeUnixNano string         `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+		Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+		Status            otlpStatus     `json:"status"`
+	}
+	otlpStatus struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+	otlpKeyValue struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+	otlpValue struct {
+		StringValue *string  `json:"stringValue,omitempty"`
+		BoolValue   *bool    `json:"boolValue,omitempty"`
+		IntValue    *string  `json:"intValue,omitempty"`
+		DoubleValue *float64 `json:"doubleValue,omitempty"`
+	}
+)
+
+// OTLP status codes, which differ from those of the otel codes package.
+const (
+	otlpStatusOK    = 1
+	otlpStatusError = 2
+)
+
+// otlpRequest converts spans to a request, grouping them by resource and
+// instrumentation library.
+func otlpRequest(spans []sdktrace.ReadOnlySpan) *otlpTraces {
+	var req otlpTraces
+	resources := map[string]*otlpResourceSpans{}
+	// The index of each scope in the ScopeSpans of its resource. Pointers
+	// into ScopeSpans would not survive appending to it.
+	scopes := map[string]int{}
+	var resourceKeys []string
+	for _, s := range spans {
+		rkey := s.Resource().Encoded(attribute.DefaultEncoder())
+		if resources[rkey] == nil {
+			resources[rkey] = &otlpResourceSpans{Resource: otlpResource{Attributes: otlpAttributes(s.Resource().Attributes())}}
+			resourceKeys = append(resourceKeys, rkey)
+		}
+		rs := resources[rkey]
+		lib := s.InstrumentationLibrary()
+		skey := rkey + "\x00" + lib.Name + "\x00" + lib.Version
+		i, ok := scopes[skey]
+		if !ok {
+			rs.ScopeSpans = append(rs.ScopeSpans, otlpScopeSpans{Scope: otlpScope{Name: lib.Name, Version: lib.Version}})
+			i = len(rs.ScopeSpans) - 1
+			scopes[skey] = i
+		}
+		rs.ScopeSpans[i].Spans = append(rs.ScopeSpans[i].Spans, otlpSpanOf(s))
+	}
+	for _, k := range resourceKeys {
+		req.ResourceSpans = append(req.ResourceSpans, *resources[k])
+	}
+	return &req
+}
+
+func otlpSpanOf(s sdktrace.ReadOnlySpan) otlpSpan {
+	os := otlpSpan{
+		TraceID:           s.SpanContext().TraceID().String(),
+		SpanID:            s.SpanContext().SpanID().String(),
+		Name:              s.Name(),
+		Kind:              int(s.SpanKind()), // the values are the same
+		StartTimeUnixNano: strconv.FormatInt(s.StartTime().UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.EndTime().UnixNano(), 10),
+		Attributes:        otlpAttributes(s.Attributes()),
+	}
+	if p := s.Parent(); p.IsValid() {
+		os.ParentSpanID = p.SpanID().String()
+	}
+	switch s.Status().Code {
+	case codes.Ok:
+		os.Status.Code = otlpStatusOK
+	case codes.Error:
+		os.Status.Code = otlpStatusError
+		os.Status.Message = s.Status().Description
+	}
+	return os
+}
+
+func otlpAttributes(kvs []attribute.KeyValue) []otlpKeyValue {
+	var okvs []otlpKeyValue
+	for _, kv := range kvs {
+		var v otlpValue
+		switch kv.Value.Type() {
+		case attribute.BOOL:
+			b := kv.Value.AsBool()
+			v.BoolValue = &b
+		case attribute.INT64:
+			i := strconv.FormatInt(kv.Value.AsInt64(), 10)
+			v.IntValue = &i
+		case attribute.FLOAT64:
+			f := kv.Value.AsFloat64()
+			v.DoubleValue = &f
+		default:
+			// Strings, and slices, which we don't use, as strings.
+			s := kv.Value.Emit()
+			v.StringValue = &s
+		}
+		okvs = append(okvs, otlpKeyValue{Key: string(kv.Key), Value: v})
+	}
+	return okvs
+}
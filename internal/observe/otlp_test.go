@@ -0,0 +1,134 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package observe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"golang.org/x/exp/event"
+)
+
+func TestOTLPExport(t *testing.T) {
+	t.Setenv(OTLPHeadersEnv, "Authorization=Bearer xyz")
+	var (
+		got        otlpTraces
+		authHeader string
+		path       string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		authHeader = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	h, flush, err := NewOTLPTraceHandler(nil, srv.URL+"/", "test-service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := event.WithExporter(context.Background(), event.NewExporter(h, nil))
+	octx := event.Start(ctx, "outer")
+	ictx := event.Start(octx, "inner")
+	SetSpanAttribute(ictx, "cve", "CVE-2022-0001")
+	SetSpanError(ictx, errors.New("failed"))
+	event.End(ictx)
+	event.End(octx)
+	if err := flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/v1/traces" {
+		t.Errorf("got path %q, want /v1/traces", path)
+	}
+	if want := "Bearer xyz"; authHeader != want {
+		t.Errorf("got Authorization %q, want %q", authHeader, want)
+	}
+	if len(got.ResourceSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans) != 1 {
+		t.Fatalf("got %+v, want one resource with one scope", got)
+	}
+	rs := got.ResourceSpans[0]
+	service := "test-service"
+	if diff := cmp.Diff([]otlpKeyValue{{Key: "service.name", Value: otlpValue{StringValue: &service}}}, rs.Resource.Attributes); diff != "" {
+		t.Errorf("resource attributes mismatch (-want, +got):\n%s", diff)
+	}
+	spans := rs.ScopeSpans[0].Spans
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want 2", len(spans))
+	}
+	// Spans are exported as they end.
+	inner, outer := spans[0], spans[1]
+	if inner.Name != "inner" || outer.Name != "outer" {
+		t.Fatalf("got spans %q, %q, want inner, outer", inner.Name, outer.Name)
+	}
+	if inner.TraceID != outer.TraceID || inner.ParentSpanID != outer.SpanID || outer.ParentSpanID != "" {
+		t.Errorf("inner span %+v is not a child of outer span %+v", inner, outer)
+	}
+	cve := "CVE-2022-0001"
+	if diff := cmp.Diff([]otlpKeyValue{{Key: "cve", Value: otlpValue{StringValue: &cve}}}, inner.Attributes); diff != "" {
+		t.Errorf("attributes mismatch (-want, +got):\n%s", diff)
+	}
+	if want := (otlpStatus{Code: otlpStatusError, Message: "failed"}); inner.Status != want {
+		t.Errorf("got status %+v, want %+v", inner.Status, want)
+	}
+	if outer.Status != (otlpStatus{}) {
+		t.Errorf("got status %+v, want unset", outer.Status)
+	}
+}
+
+func TestOTLPRequestScopes(t *testing.T) {
+	// Spans of several scopes, interleaved, so that adding a scope to a
+	// resource moves the scopes that it already has.
+	var stubs tracetest.SpanStubs
+	for i := 0; i < 10; i++ {
+		for _, lib := range []string{"a", "b", "c", "d", "e"} {
+			stubs = append(stubs, tracetest.SpanStub{
+				Name:                   lib,
+				InstrumentationLibrary: instrumentation.Library{Name: lib},
+			})
+		}
+	}
+	req := otlpRequest(stubs.Snapshots())
+	if len(req.ResourceSpans) != 1 {
+		t.Fatalf("got %d resources, want 1", len(req.ResourceSpans))
+	}
+	scopes := req.ResourceSpans[0].ScopeSpans
+	if len(scopes) != 5 {
+		t.Fatalf("got %d scopes, want 5", len(scopes))
+	}
+	for _, ss := range scopes {
+		if len(ss.Spans) != 10 {
+			t.Errorf("scope %s: got %d spans, want 10", ss.Scope.Name, len(ss.Spans))
+		}
+		for _, sp := range ss.Spans {
+			if sp.Name != ss.Scope.Name {
+				t.Errorf("scope %s has span %s", ss.Scope.Name, sp.Name)
+			}
+		}
+	}
+}
+
+func TestParseOTLPHeaders(t *testing.T) {
+	got, err := parseOTLPHeaders("a=1, b = x=y ,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"a": "1", "b": "x=y"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if _, err := parseOTLPHeaders("a"); err == nil {
+		t.Error("got nil, want error")
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package observe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/event"
+)
+
+// A PrometheusHandler collects the values of metric events and serves them
+// in the Prometheus text exposition format, so that a Prometheus server can
+// scrape them.
+//
+// Counters are summed, and gauges keep their last value. Distributions are
+// exposed as summaries with only a count and a sum; durations are in seconds.
+// Each distinct set of labels on the events of a metric is its own series.
+type PrometheusHandler struct {
+	mu      sync.Mutex
+	metrics map[string]*promMetric // keyed by exposed name
+}
+
+type promMetric struct {
+	name   string
+	help   string
+	typ    string                 // "counter", "gauge" or "summary"
+	series map[string]*promSeries // keyed by formatted labels
+}
+
+type promSeries struct {
+	value float64 // for counters and gauges
+	count int64   // for summaries
+	sum   float64 // for summaries
+}
+
+// NewPrometheusHandler returns a PrometheusHandler with no metrics.
+func NewPrometheusHandler() *PrometheusHandler {
+	return &PrometheusHandler{metrics: map[string]*promMetric{}}
+}
+
+var _ event.Handler = (*PrometheusHandler)(nil)
+
+// Event implements event.Handler. It ignores events that are not metrics.
+func (h *PrometheusHandler) Event(ctx context.Context, ev *event.Event) context.Context {
+	if ev.Kind != event.MetricKind {
+		return ctx
+	}
+	mv, ok := event.MetricKey.Find(ev)
+	if !ok {
+		return ctx
+	}
+	m := mv.(event.Metric)
+	val := ev.Find(event.MetricVal)
+	if !val.HasValue() {
+		return ctx
+	}
+	name := promName(m.Options().Namespace + "/" + m.Name())
+	var typ string
+	switch m.(type) {
+	case *event.Counter:
+		name += "_total"
+		typ = "counter"
+	case *event.FloatGauge:
+		typ = "gauge"
+	case *event.DurationDistribution:
+		name += "_seconds"
+		typ = "summary"
+	case *event.IntDistribution:
+		typ = "summary"
+	default:
+		return ctx
+	}
+	labels := promLabels(ev.Labels)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	pm := h.metrics[name]
+	if pm == nil {
+		pm = &promMetric{name: name, help: m.Options().Description, typ: typ, series: map[string]*promSeries{}}
+		h.metrics[name] = pm
+	}
+	s := pm.series[labels]
+	if s == nil {
+		s = &promSeries{}
+		pm.series[labels] = s
+	}
+	switch m.(type) {
+	case *event.Counter:
+		s.value += float64(val.Int64())
+	case *event.FloatGauge:
+		s.value = val.Float64()
+	case *event.DurationDistribution:
+		s.count++
+		s.sum += val.Duration().Seconds()
+	case *event.IntDistribution:
+		s.count++
+		s.sum += float64(val.Int64())
+	}
+	return ctx
+}
+
+// ServeHTTP implements http.Handler by writing all the metrics, sorted by name
+// and labels.
+func (h *PrometheusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	h.write(w)
+}
+
+func (h *PrometheusHandler) write(w io.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var names []string
+	for name := range h.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pm := h.metrics[name]
+		if pm.help != "" {
+			fmt.Fprintf(w, "# HELP %s %s\n", name, helpEscaper.Replace(pm.help))
+		}
+		fmt.Fprintf(w, "# TYPE %s %s\n", name, pm.typ)
+		var keys []string
+		for k := range pm.series {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, labels := range keys {
+			s := pm.series[labels]
+			if pm.typ == "summary" {
+				fmt.Fprintf(w, "%s_sum%s %s\n", name, labels, formatFloat(s.sum))
+				fmt.Fprintf(w, "%s_count%s %d\n", name, labels, s.count)
+			} else {
+				fmt.Fprintf(w, "%s%s %s\n", name, labels, formatFloat(s.value))
+			}
+		}
+	}
+}
+
+// promName converts s to a valid Prometheus metric or label name, by replacing
+// each character that is not allowed with an underscore.
+func promName(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_' || c == ':' || i > 0 && '0' <= c && c <= '9') {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+// promLabels formats the labels of a metric event, other than its metric and
+// value, as a Prometheus label set sorted by name. It returns the empty
+// string if there are none.
+func promLabels(ls []event.Label) string {
+	var pairs []string
+	for _, l := range ls {
+		if l.Name == string(event.MetricKey) || l.Name == string(event.MetricVal) || !l.HasValue() {
+			continue
+		}
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, promName(l.Name), labelEscaper.Replace(labelValue(l))))
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	sort.Strings(pairs)
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// Escapers for the text exposition format.
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func labelValue(l event.Label) string {
+	switch {
+	case l.IsString():
+		return l.String()
+	case l.IsInt64():
+		return strconv.FormatInt(l.Int64(), 10)
+	case l.IsUint64():
+		return strconv.FormatUint(l.Uint64(), 10)
+	case l.IsFloat64():
+		return formatFloat(l.Float64())
+	case l.IsBool():
+		return strconv.FormatBool(l.Bool())
+	case l.IsDuration():
+		return l.Duration().String()
+	default:
+		return fmt.Sprint(l.Interface())
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
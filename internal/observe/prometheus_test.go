@@ -0,0 +1,51 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package observe
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/exp/event"
+)
+
+func TestPrometheusHandler(t *testing.T) {
+	h := NewPrometheusHandler()
+	ctx := event.WithExporter(context.Background(), event.NewExporter(h, nil))
+
+	opts := &event.MetricOptions{Namespace: "vulndb/test"}
+	counter := event.NewCounter("updates", &event.MetricOptions{Namespace: "vulndb/test", Description: "Number of updates."})
+	gauge := event.NewFloatGauge("remaining", opts)
+	dur := event.NewDuration("update-duration", opts)
+
+	counter.Record(ctx, 1, event.Bool("success", true))
+	counter.Record(ctx, 2, event.Bool("success", true))
+	counter.Record(ctx, 1, event.Bool("success", false))
+	gauge.Record(ctx, 10)
+	gauge.Record(ctx, 7.5)
+	dur.Record(ctx, time.Second, event.String("source", `a"b`), event.String("kind", "x"))
+	dur.Record(ctx, 500*time.Millisecond, event.String("source", `a"b`), event.String("kind", "x"))
+	// Other events are ignored.
+	event.Log(ctx, "hello")
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	want := `# TYPE vulndb_test_remaining gauge
+vulndb_test_remaining 7.5
+# TYPE vulndb_test_update_duration_seconds summary
+vulndb_test_update_duration_seconds_sum{kind="x",source="a\"b"} 1.5
+vulndb_test_update_duration_seconds_count{kind="x",source="a\"b"} 2
+# HELP vulndb_test_updates_total Number of updates.
+# TYPE vulndb_test_updates_total counter
+vulndb_test_updates_total{success="false"} 1
+vulndb_test_updates_total{success="true"} 3
+`
+	if diff := cmp.Diff(want, w.Body.String()); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
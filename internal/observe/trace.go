@@ -0,0 +1,79 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package observe
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/event"
+	eotel "golang.org/x/exp/event/otel"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// SetSpanAttribute sets an attribute of the trace span started by the last
+// call to event.Start on ctx. It does nothing if ctx has no span, as when its
+// events are not traced.
+func SetSpanAttribute(ctx context.Context, key, value string) {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String(key, value))
+}
+
+// SetSpanError marks the trace span started by the last call to event.Start
+// on ctx as failed with err, if err is non-nil. It does nothing if ctx has no
+// span.
+func SetSpanError(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// NewOTLPTraceHandler returns a handler that passes events to h and also
+// exports their spans to the OTLP collector at endpoint, for programs that
+// don't run as servers. The returned function sends the spans that have not
+// been sent yet; call it before exiting.
+func NewOTLPTraceHandler(h event.Handler, endpoint, serviceName string) (_ event.Handler, flush func(context.Context) error, err error) {
+	defer derrors.Wrap(&err, "NewOTLPTraceHandler(%q, %q)", endpoint, serviceName)
+
+	tp, err := newOTLPTracerProvider(endpoint, serviceName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return traceHandler{h, eotel.NewTraceHandler(tp.Tracer(serviceName))}, tp.Shutdown, nil
+}
+
+// newOTLPTracerProvider returns a TracerProvider that exports spans to the
+// OTLP collector at endpoint. Unlike Cloud Trace, collectors need the name of
+// the service that produced the spans.
+func newOTLPTracerProvider(endpoint, serviceName string) (*sdktrace.TracerProvider, error) {
+	exporter, err := newOTLPExporter(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceNameKey.String(serviceName))),
+		sdktrace.WithBatcher(exporter)), nil
+}
+
+type traceHandler struct {
+	h  event.Handler
+	th *eotel.TraceHandler
+}
+
+// Event implements event.Handler.
+func (h traceHandler) Event(ctx context.Context, ev *event.Event) context.Context {
+	if h.h != nil {
+		ctx = h.h.Event(ctx, ev)
+	}
+	return h.th.Event(ctx, ev)
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package openvex contains the schema for an OpenVEX document. The package
+// implements the subset of the schema needed to describe the reports of the
+// vulnerability database.
+//
+// https://github.com/openvex/spec/blob/main/OPENVEX-SPEC.md contains the
+// full specification and documentation for each field.
+package openvex
+
+import "time"
+
+// Context is the JSON-LD context of the documents of the supported version of
+// the specification.
+const Context = "https://openvex.dev/ns/v0.2.0"
+
+type Document struct {
+	Context    string       `json:"@context"`
+	ID         string       `json:"@id"`
+	Author     string       `json:"author"`
+	Timestamp  time.Time    `json:"timestamp"`
+	Version    int          `json:"version"`
+	Tooling    string       `json:"tooling,omitempty"`
+	Statements []*Statement `json:"statements"`
+}
+
+// A Status is the status of a vulnerability in a product.
+type Status string
+
+const (
+	StatusNotAffected        Status = "not_affected"
+	StatusAffected           Status = "affected"
+	StatusFixed              Status = "fixed"
+	StatusUnderInvestigation Status = "under_investigation"
+)
+
+// A Justification says why a product is not affected by a vulnerability.
+type Justification string
+
+const (
+	ComponentNotPresent                         Justification = "component_not_present"
+	VulnerableCodeNotPresent                    Justification = "vulnerable_code_not_present"
+	VulnerableCodeNotInExecutePath              Justification = "vulnerable_code_not_in_execute_path"
+	VulnerableCodeCannotBeControlledByAdversary Justification = "vulnerable_code_cannot_be_controlled_by_adversary"
+	InlineMitigationsAlreadyExist               Justification = "inline_mitigations_already_exist"
+)
+
+type Statement struct {
+	Vulnerability Vulnerability `json:"vulnerability"`
+	Products      []*Product    `json:"products"`
+	Status        Status        `json:"status"`
+	StatusNotes   string        `json:"status_notes,omitempty"`
+	// Justification is required with StatusNotAffected, unless
+	// ImpactStatement is set.
+	Justification   Justification `json:"justification,omitempty"`
+	ImpactStatement string        `json:"impact_statement,omitempty"`
+	// ActionStatement is required with StatusAffected.
+	ActionStatement string `json:"action_statement,omitempty"`
+}
+
+type Vulnerability struct {
+	ID      string   `json:"@id,omitempty"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// A Product is a piece of software, identified by a package URL, such as
+// "pkg:golang/golang.org/x/net@v0.1.0".
+type Product struct {
+	ID            string          `json:"@id"`
+	Subcomponents []*Subcomponent `json:"subcomponents,omitempty"`
+}
+
+// A Subcomponent is a part of a Product, such as one of its packages.
+type Subcomponent struct {
+	ID string `json:"@id"`
+}
@@ -0,0 +1,128 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package osvdev supports looking up vulnerabilities in the OSV.dev
+// database, which collects the advisories of many ecosystems, including the
+// Go vulnerability database.
+// See https://osv.dev/docs.
+package osvdev
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// DefaultBaseURL is the URL of the OSV.dev API.
+const DefaultBaseURL = "https://api.osv.dev/v1"
+
+// GoIDPrefix begins the IDs of reports in the Go vulnerability database.
+const GoIDPrefix = "GO-"
+
+// A Vuln is the part of an OSV.dev entry that says which other advisories
+// are about the same vulnerability, and what the entry refers to.
+type Vuln struct {
+	ID         string       `json:"id"`
+	Aliases    []string     `json:"aliases,omitempty"`
+	Related    []string     `json:"related,omitempty"`
+	References []*Reference `json:"references,omitempty"`
+}
+
+// A Reference is a link from an OSV.dev entry to more information.
+type Reference struct {
+	// Type is the kind of the reference, such as "FIX", "REPORT" or
+	// "ADVISORY".
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// A Client is a client for the OSV.dev API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the API at baseURL.
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// Vuln returns the entry with the given ID, or nil if there is none.
+func (c *Client) Vuln(ctx context.Context, id string) (_ *Vuln, err error) {
+	defer derrors.Wrap(&err, "osvdev.Vuln(%q)", id)
+	ctx = event.Start(ctx, "osvdev.Vuln")
+	defer event.End(ctx)
+
+	u := c.baseURL + "/vulns/" + url.PathEscape(id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", u, res.Status)
+	}
+	var v Vuln
+	if err := json.NewDecoder(res.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// Advisories returns the sorted IDs of the advisories in OSV.dev for the
+// vulnerability with the given ID, such as a CVE ID, other than that ID.
+// OSV.dev groups advisories that alias one another, so these include the
+// reports in the Go vulnerability database and the advisories of other
+// ecosystems that cover the vulnerability. The IDs that are only related to
+// the vulnerability are not its advisories: they are of other
+// vulnerabilities, like those that one fix fixes together.
+func (c *Client) Advisories(ctx context.Context, id string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "osvdev.Advisories(%q)", id)
+
+	v, err := c.Vuln(ctx, id)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	seen := map[string]bool{id: true}
+	var ids []string
+	for _, a := range append([]string{v.ID}, v.Aliases...) {
+		// Aliases that are other CVEs are not advisories.
+		if seen[a] || strings.HasPrefix(a, "CVE-") {
+			continue
+		}
+		seen[a] = true
+		ids = append(ids, a)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// GoReports returns the IDs in ids that are of reports in the Go
+// vulnerability database.
+func GoReports(ids []string) []string {
+	var r []string
+	for _, id := range ids {
+		if strings.HasPrefix(id, GoIDPrefix) {
+			r = append(r, id)
+		}
+	}
+	return r
+}
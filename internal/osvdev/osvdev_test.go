@@ -0,0 +1,82 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package osvdev
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAdvisories(t *testing.T) {
+	vulns := map[string]*Vuln{
+		"CVE-2022-0001": {
+			ID:      "CVE-2022-0001",
+			Aliases: []string{"GHSA-xxxx-yyyy-zzzz", "GO-2022-0100"},
+			Related: []string{"PYSEC-2022-1", "CVE-2022-0002"},
+			References: []*Reference{
+				{Type: "FIX", URL: "https://github.com/a/b/commit/123"},
+			},
+		},
+		"CVE-2022-0003": {ID: "CVE-2022-0003"},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, ok := vulns[strings.TrimPrefix(r.URL.Path, "/vulns/")]
+		if !ok {
+			http.Error(w, `{"code":5,"message":"Bug not found."}`, http.StatusNotFound)
+			return
+		}
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewClient(srv.URL)
+	for _, test := range []struct {
+		id   string
+		want []string
+	}{
+		// Related IDs are of other vulnerabilities.
+		{"CVE-2022-0001", []string{"GHSA-xxxx-yyyy-zzzz", "GO-2022-0100"}},
+		{"CVE-2022-0003", nil},
+		{"CVE-2022-0004", nil},
+	} {
+		got, err := c.Advisories(ctx, test.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%s: mismatch (-want, +got):\n%s", test.id, diff)
+		}
+	}
+	v, err := c.Vuln(ctx, "CVE-2022-0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(vulns["CVE-2022-0001"], v); diff != "" {
+		t.Errorf("Vuln mismatch (-want, +got):\n%s", diff)
+	}
+	if got, want := GoReports([]string{"GHSA-xxxx-yyyy-zzzz", "GO-2022-0100"}), []string{"GO-2022-0100"}; !cmp.Equal(got, want) {
+		t.Errorf("GoReports: got %v, want %v", got, want)
+	}
+}
+
+func TestVulnError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	if _, err := NewClient(srv.URL).Vuln(context.Background(), "CVE-2022-0001"); err == nil {
+		t.Error("got nil error")
+	}
+}
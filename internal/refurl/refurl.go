@@ -0,0 +1,192 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package refurl canonicalizes the URLs of the references of
+// vulnerabilities, so that URLs that differ only trivially, like
+// "http://github.com/a/b/commits/abc/" and "https://github.com/a/b/commit/abc",
+// compare equal.
+package refurl
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// trackingParams are the query parameters that only track where a visitor
+// came from. Parameters that start with "utm_" are also tracking parameters.
+var trackingParams = map[string]bool{
+	"fbclid":  true,
+	"gclid":   true,
+	"mc_cid":  true,
+	"mc_eid":  true,
+	"_ga":     true,
+	"ref_src": true,
+}
+
+// Canonical returns the canonical form of the reference URL u. It
+//   - uses https rather than http,
+//   - lowercases the host, and removes "www." and a default port from it,
+//   - removes a trailing slash and tracking query parameters, like
+//     utm_source, and sorts the rest,
+//   - removes the fragment, unless it is a "#!" route of a web app, and
+//   - unifies the forms of GitHub URLs that name the same commit or pull
+//     request.
+//
+// If u is not an absolute URL, Canonical returns it without surrounding
+// spaces.
+func Canonical(u string) string {
+	u = strings.TrimSpace(u)
+	p, err := url.Parse(u)
+	if err != nil || p.Host == "" {
+		return u
+	}
+	scheme := strings.ToLower(p.Scheme)
+	if scheme == "http" {
+		scheme = "https"
+	}
+	host := strings.ToLower(p.Host)
+	if h, port, ok := strings.Cut(host, ":"); ok && (port == "80" || port == "443") {
+		host = h
+	}
+	host = strings.TrimPrefix(host, "www.")
+
+	path := strings.TrimSuffix(p.EscapedPath(), "/")
+	if host == "github.com" {
+		path = canonicalGitHubPath(path)
+	}
+
+	q := p.Query()
+	for k := range q {
+		if trackingParams[k] || strings.HasPrefix(k, "utm_") {
+			delete(q, k)
+		}
+	}
+	s := scheme + "://" + host + path
+	if len(q) > 0 {
+		// Encode sorts by key.
+		s += "?" + q.Encode()
+	}
+	if strings.HasPrefix(p.Fragment, "!") {
+		s += "#" + p.EscapedFragment()
+	}
+	return s
+}
+
+// Patterns of the paths of GitHub URLs, after the owner and repo.
+var (
+	// A commit, or the patch or diff of one:
+	// /commit/SHA, /commits/SHA, /commit/SHA.patch, /pull/N/commits/SHA.
+	githubCommitRegex = regexp.MustCompile(`^/(?:pull/\d+/)?commits?/([0-9a-fA-F]{7,40})(?:\.patch|\.diff)?$`)
+	// A tab of a pull request: /pull/N/files, /pull/N/commits.
+	githubPullTabRegex = regexp.MustCompile(`^/pull/(\d+)/(?:files|commits|checks)$`)
+)
+
+// canonicalGitHubPath returns the canonical form of the path of a GitHub
+// URL, which starts with "/OWNER/REPO". GitHub ignores the case of the owner
+// and repo.
+func canonicalGitHubPath(path string) string {
+	parts := strings.SplitN(path, "/", 4)
+	if len(parts) < 3 || parts[1] == "advisories" {
+		// Not a repo; the case of advisory IDs matters.
+		return path
+	}
+	owner, repo := strings.ToLower(parts[1]), strings.ToLower(parts[2])
+	if len(parts) == 3 {
+		return "/" + owner + "/" + strings.TrimSuffix(repo, ".git")
+	}
+	rest := "/" + parts[3]
+	if m := githubCommitRegex.FindStringSubmatch(rest); m != nil {
+		rest = "/commit/" + strings.ToLower(m[1])
+	} else if m := githubPullTabRegex.FindStringSubmatch(rest); m != nil {
+		rest = "/pull/" + m[1]
+	}
+	return "/" + owner + "/" + repo + rest
+}
+
+// Equal reports whether the reference URLs u and v are the same, after
+// canonicalization.
+func Equal(u, v string) bool {
+	return Canonical(u) == Canonical(v)
+}
+
+// Patterns of the URLs of advisories, whose first submatch is the ID of the
+// vulnerability.
+var advisoryRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`^https://nvd\.nist\.gov/vuln/detail/(CVE-\d{4}-\d+)$`),
+	regexp.MustCompile(`^https://cve\.mitre\.org/cgi-bin/cvename\.cgi\?name=(CVE-\d{4}-\d+)$`),
+	regexp.MustCompile(`^https://cve\.org/cverecord\?id=(CVE-\d{4}-\d+)$`),
+	regexp.MustCompile(`^https://github\.com/advisories/(GHSA(?:-[0-9a-z]{4}){3})$`),
+	regexp.MustCompile(`^https://github\.com/[^/]+/[^/]+/security/advisories/(GHSA(?:-[0-9a-z]{4}){3})$`),
+	regexp.MustCompile(`^https://pkg\.go\.dev/vuln/(GO-\d{4}-\d+)$`),
+	regexp.MustCompile(`^https://osv\.dev/vulnerability/((?:CVE|GHSA|GO)-[0-9A-Za-z-]+)$`),
+}
+
+// AdvisoryID returns the ID of the vulnerability that u is the advisory of,
+// like "CVE-2022-1234" for "https://nvd.nist.gov/vuln/detail/CVE-2022-1234",
+// or "" if u is not a known kind of advisory.
+func AdvisoryID(u string) string {
+	c := Canonical(u)
+	for _, re := range advisoryRegexes {
+		if m := re.FindStringSubmatch(c); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+// A Resolver canonicalizes reference URLs after following their redirects,
+// so that a URL and the URL it redirects to compare equal. It remembers the
+// URLs it has resolved. It is safe for concurrent use.
+type Resolver struct {
+	client *http.Client
+
+	mu       sync.Mutex
+	resolved map[string]string
+}
+
+// NewResolver returns a Resolver that makes requests with client, or with
+// http.DefaultClient if client is nil.
+func NewResolver(client *http.Client) *Resolver {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Resolver{client: client, resolved: map[string]string{}}
+}
+
+// Canonical returns the canonical form of the URL that u redirects to, or
+// of u itself if it does not redirect or the request fails.
+func (r *Resolver) Canonical(ctx context.Context, u string) string {
+	c := Canonical(u)
+	r.mu.Lock()
+	res, ok := r.resolved[c]
+	r.mu.Unlock()
+	if ok {
+		return res
+	}
+	res = c
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c, nil)
+	if err == nil {
+		if resp, err := r.client.Do(req); err == nil {
+			resp.Body.Close()
+			// The client follows the redirects, so the request of the
+			// response is the last one.
+			if resp.StatusCode < 400 {
+				res = Canonical(resp.Request.URL.String())
+			}
+		}
+	}
+	if ctx.Err() != nil {
+		// Don't remember a URL that wasn't resolved because the context
+		// ended.
+		return res
+	}
+	r.mu.Lock()
+	r.resolved[c] = res
+	r.mu.Unlock()
+	return res
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package refurl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCanonical(t *testing.T) {
+	for _, test := range []struct {
+		in, want string
+	}{
+		{"https://go.dev/issue/123", "https://go.dev/issue/123"},
+		{" http://WWW.Example.com:80/a/ ", "https://example.com/a"},
+		{"https://example.com:8080/a", "https://example.com:8080/a"},
+		{"https://example.com/a?utm_source=x&b=2&a=1&fbclid=y", "https://example.com/a?a=1&b=2"},
+		{"https://example.com/a#section", "https://example.com/a"},
+		{"https://groups.google.com/forum/#!topic/golang-announce/abc", "https://groups.google.com/forum#!topic/golang-announce/abc"},
+		{"https://github.com/Owner/Repo/commits/ABC1234", "https://github.com/owner/repo/commit/abc1234"},
+		{"https://github.com/owner/repo/commit/abc1234.patch", "https://github.com/owner/repo/commit/abc1234"},
+		{"https://github.com/owner/repo/pull/12/commits/abc1234def", "https://github.com/owner/repo/commit/abc1234def"},
+		{"https://github.com/owner/repo/pull/12/files", "https://github.com/owner/repo/pull/12"},
+		{"https://github.com/owner/repo.git", "https://github.com/owner/repo"},
+		{"https://github.com/owner/repo/blob/Main/README.md", "https://github.com/owner/repo/blob/Main/README.md"},
+		{"https://github.com/owner/repo/commits/main", "https://github.com/owner/repo/commits/main"},
+		{"not a url", "not a url"},
+	} {
+		if got := Canonical(test.in); got != test.want {
+			t.Errorf("Canonical(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestAdvisoryID(t *testing.T) {
+	for _, test := range []struct {
+		in, want string
+	}{
+		{"https://nvd.nist.gov/vuln/detail/CVE-2022-1234", "CVE-2022-1234"},
+		{"http://nvd.nist.gov/vuln/detail/CVE-2022-1234/", "CVE-2022-1234"},
+		{"https://cve.mitre.org/cgi-bin/cvename.cgi?name=CVE-2022-1234", "CVE-2022-1234"},
+		{"https://github.com/advisories/GHSA-abcd-1234-wxyz", "GHSA-abcd-1234-wxyz"},
+		{"https://github.com/Owner/Repo/security/advisories/GHSA-abcd-1234-wxyz", "GHSA-abcd-1234-wxyz"},
+		{"https://pkg.go.dev/vuln/GO-2022-0001", "GO-2022-0001"},
+		{"https://osv.dev/vulnerability/GHSA-abcd-1234-wxyz", "GHSA-abcd-1234-wxyz"},
+		{"https://github.com/owner/repo/issues/1", ""},
+	} {
+		if got := AdvisoryID(test.in); got != test.want {
+			t.Errorf("AdvisoryID(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestResolver(t *testing.T) {
+	var srv *httptest.Server
+	requests := 0
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/old":
+			http.Redirect(w, r, srv.URL+"/new/?utm_source=redirect", http.StatusMovedPermanently)
+		case "/new/", "/same":
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	// The test server is http, but Canonical makes URLs https.
+	r := NewResolver(&http.Client{Transport: rewriteScheme{http.DefaultTransport}})
+	base := "https" + strings.TrimPrefix(srv.URL, "http")
+	for _, test := range []struct {
+		in, want string
+	}{
+		{srv.URL + "/old", base + "/new"},
+		{srv.URL + "/same", base + "/same"},
+		{srv.URL + "/missing", base + "/missing"},
+		// Resolved before, so no request.
+		{srv.URL + "/old/", base + "/new"},
+	} {
+		if got := r.Canonical(ctx, test.in); got != test.want {
+			t.Errorf("Canonical(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+	if requests != 4 {
+		t.Errorf("got %d requests, want 4", requests)
+	}
+}
+
+// rewriteScheme is a RoundTripper that makes https requests over http, for
+// the test server.
+type rewriteScheme struct {
+	rt http.RoundTripper
+}
+
+func (t rewriteScheme) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.URL.Scheme = "http"
+	return t.rt.RoundTrip(r)
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import "gopkg.in/yaml.v3"
+
+// copyComments copies the comments of the YAML nodes in src, a previous
+// version of a document, to the matching nodes in dst, so that rewriting a
+// file keeps its comments. Nodes of dst that already have comments keep
+// them.
+//
+// Mapping values match by key. Sequence elements match by value for
+// scalars, by their first key and value for mappings (like the module of a
+// module, or the package of a package), and otherwise by position. Comments
+// of nodes that are no longer in dst are dropped.
+func copyComments(dst, src *yaml.Node) {
+	if dst == nil || src == nil || dst.Kind != src.Kind {
+		return
+	}
+	if dst.HeadComment == "" {
+		dst.HeadComment = src.HeadComment
+	}
+	if dst.LineComment == "" {
+		dst.LineComment = src.LineComment
+	}
+	if dst.FootComment == "" {
+		dst.FootComment = src.FootComment
+	}
+	switch dst.Kind {
+	case yaml.DocumentNode:
+		if len(dst.Content) == 1 && len(src.Content) == 1 {
+			copyComments(dst.Content[0], src.Content[0])
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(dst.Content); i += 2 {
+			k, v := field(src, dst.Content[i].Value)
+			copyComments(dst.Content[i], k)
+			copyComments(dst.Content[i+1], v)
+		}
+	case yaml.SequenceNode:
+		used := make([]bool, len(src.Content))
+		for i, d := range dst.Content {
+			j := matchElement(d, i, src.Content, used)
+			if j < 0 {
+				continue
+			}
+			used[j] = true
+			copyComments(d, src.Content[j])
+			// The comment before a mapping in a sequence is parsed as
+			// the comment of its first key, which the encoder would
+			// write after the "-". Move it to the mapping, which the
+			// encoder writes before.
+			if d.Kind == yaml.MappingNode && len(d.Content) > 0 && d.HeadComment == "" {
+				d.HeadComment, d.Content[0].HeadComment = d.Content[0].HeadComment, ""
+			}
+		}
+	}
+}
+
+// matchElement returns the index of the unused element of srcs that matches
+// d, the element at index i of a sequence, or -1.
+func matchElement(d *yaml.Node, i int, srcs []*yaml.Node, used []bool) int {
+	key := func(n *yaml.Node) (string, bool) {
+		switch {
+		case n.Kind == yaml.ScalarNode:
+			return n.Value, true
+		case n.Kind == yaml.MappingNode && len(n.Content) >= 2 && n.Content[1].Kind == yaml.ScalarNode:
+			return n.Content[0].Value + ": " + n.Content[1].Value, true
+		}
+		return "", false
+	}
+	if dk, ok := key(d); ok {
+		for j, s := range srcs {
+			if sk, ok := key(s); ok && !used[j] && s.Kind == d.Kind && sk == dk {
+				return j
+			}
+		}
+		return -1
+	}
+	if i < len(srcs) && !used[i] {
+		return i
+	}
+	return -1
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteKeepsComments(t *testing.T) {
+	const in = `# Copyright 2022 The Go Authors. All rights reserved.
+
+schema_version: 3
+modules:
+  # The only module.
+  - module: example.com/a
+    versions:
+      - fixed: 1.2.0 # fixed by the first CL
+    packages:
+      - package: example.com/a/b
+        symbols:
+          # Found by hand.
+          - F
+description: |
+    A is vulnerable.
+references:
+  - fix: https://go.dev/cl/1 # the first CL
+`
+	filename := filepath.Join(t.TempDir(), "GO-2022-0001.yaml")
+	if err := os.WriteFile(filename, []byte(in), 0644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := Read(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Change the report the way vulnreport fix might.
+	r.Modules[0].Packages[0].Symbols = append(r.Modules[0].Packages[0].Symbols, "G")
+	r.References = append([]*Reference{{Type: ReferenceTypeReport, URL: "https://go.dev/issue/1"}}, r.References...)
+	if err := r.Write(filename); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `# Copyright 2022 The Go Authors. All rights reserved.
+
+schema_version: 3
+modules:
+  # The only module.
+  - module: example.com/a
+    versions:
+      - fixed: 1.2.0 # fixed by the first CL
+    packages:
+      - package: example.com/a/b
+        symbols:
+          # Found by hand.
+          - F
+          - G
+description: |
+    A is vulnerable.
+references:
+  - report: https://go.dev/issue/1
+  - fix: https://go.dev/cl/1 # the first CL
+`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -6,8 +6,14 @@ package report
 
 import (
 	"errors"
+	"path"
+	"regexp"
 	"strings"
 
+	"golang.org/x/exp/slices"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/internal/cpe"
 	"golang.org/x/vulndb/internal/cveschema"
 	"golang.org/x/vulndb/internal/derrors"
 	"golang.org/x/vulndb/internal/stdlib"
@@ -31,6 +37,28 @@ func ToCVE(reportPath string) (_ *cveschema.CVE, err error) {
 		return nil, errors.New("report missing CVE ID")
 	}
 
+	if r.Withdrawn != nil {
+		// A rejected CVE has only a description, which says why.
+		return &cveschema.CVE{
+			DataType:    "CVE",
+			DataFormat:  "MITRE",
+			DataVersion: "4.0",
+			Metadata: cveschema.Metadata{
+				ID:       r.CVEMetadata.ID,
+				Assigner: "security@golang.org",
+				State:    cveschema.StateRejected,
+			},
+			Description: cveschema.Description{
+				Data: []cveschema.LangString{
+					{
+						Lang:  "eng",
+						Value: "** REJECT ** " + strings.TrimSuffix(r.Withdrawn.Reason, "\n"),
+					},
+				},
+			},
+		}, nil
+	}
+
 	c := &cveschema.CVE{
 		DataType:    "CVE",
 		DataFormat:  "MITRE",
@@ -130,11 +158,10 @@ func CVEToReport(c *cveschema.CVE, modulePath string) *Report {
 			URL:  r.URL,
 		})
 	}
-	var credits []string
+	var credits []*Credit
 	for _, v := range c.Credit.Data.Description.Data {
-		credits = append(credits, v.Value)
+		credits = append(credits, &Credit{Name: v.Value})
 	}
-	credit := strings.Join(credits, "\t")
 
 	var pkgPath string
 	if data := c.Affects.Vendor.Data; len(data) > 0 {
@@ -157,9 +184,12 @@ func CVEToReport(c *cveschema.CVE, modulePath string) *Report {
 		}},
 		Description: description,
 		CVEs:        []string{c.Metadata.ID},
-		Credit:      credit,
+		Credits:     credits,
 		References:  refs,
 	}
+	if v := c.CVSSV3(); v != "" {
+		r.CVSS = []*CVSS{{Vector: v, Source: c.Metadata.ID}}
+	}
 	if !strings.Contains(modulePath, ".") {
 		r.Modules[0].Module = stdlib.ModulePath
 		r.Modules[0].Packages[0].Package = modulePath
@@ -170,3 +200,131 @@ func CVEToReport(c *cveschema.CVE, modulePath string) *Report {
 	r.Fix()
 	return r
 }
+
+// fixedVersionRegexp matches phrases in CVE descriptions that give a version
+// in which a vulnerability is fixed, like "before 1.2.3" or "fixed in v1.2".
+var fixedVersionRegexp = regexp.MustCompile(`(?i)\b(?:before|prior to|fixed in|patched in|upgrade to)\s+(?:version\s+)?v?(\d+\.\d+(?:\.\d+)?)\b`)
+
+// FixedVersionsInText returns the versions that text, usually the
+// description of a CVE, says fix a vulnerability, in the order they appear
+// in text, without duplicates. The versions may not exist.
+func FixedVersionsInText(text string) []Version {
+	var vs []Version
+	for _, m := range fixedVersionRegexp.FindAllStringSubmatch(text, -1) {
+		v := Version(strings.TrimPrefix(semver.Canonical("v"+m[1]), "v"))
+		if !slices.Contains(vs, v) {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
+
+// GuessFixedVersions returns the versions of the module at modulePath that
+// text, usually the description of a CVE, says fix a vulnerability, and that
+// the module proxy knows about. The versions are in the order they appear in
+// text, without duplicates.
+func GuessFixedVersions(text, modulePath string) (_ []Version, err error) {
+	defer derrors.Wrap(&err, "GuessFixedVersions(%q)", modulePath)
+
+	candidates := FixedVersionsInText(text)
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	known, err := getModVersionsFromProxy(modulePath)
+	if err != nil {
+		return nil, err
+	}
+	var vs []Version
+	for _, v := range candidates {
+		if known[v.V()] {
+			vs = append(vs, v)
+		}
+	}
+	return vs, nil
+}
+
+// CPEVersionRanges returns the version ranges of the module at modulePath
+// that the vulnerable CPE matches in the configurations of c give, in order,
+// without duplicates. It uses the matches of the product named like the last
+// element of modulePath, without its major version suffix, or of product
+// "go" for the standard library. If there are none, it uses all the matches
+// if they are of a single product.
+//
+// Matches of single versions, or whose bounds can't be expressed as a range
+// with an introduced and a fixed version, are skipped, as are ranges of
+// versions that are not semantic versions.
+func CPEVersionRanges(c *cveschema.CVE, modulePath string) []VersionRange {
+	type match struct {
+		product string
+		cveschema.CPEMatch
+	}
+	var ms []match
+	for _, m := range c.VulnerableCPEs() {
+		n, err := cpe.Parse(m.CPE23URI)
+		if err != nil || !n.IsApplication() {
+			continue
+		}
+		ms = append(ms, match{cpeProductKey(n.Product), m})
+	}
+	want := "go"
+	if modulePath != stdlib.ModulePath {
+		prefix, _, _ := module.SplitPathVersion(modulePath)
+		want = cpeProductKey(path.Base(prefix))
+	}
+	var chosen []cveschema.CPEMatch
+	for _, m := range ms {
+		if m.product == want {
+			chosen = append(chosen, m.CPEMatch)
+		}
+	}
+	if len(chosen) == 0 {
+		for _, m := range ms {
+			if m.product != ms[0].product {
+				return nil
+			}
+			chosen = append(chosen, m.CPEMatch)
+		}
+	}
+
+	var vrs []VersionRange
+	for _, m := range chosen {
+		if m.VersionStartExcluding != "" || m.VersionEndIncluding != "" {
+			continue
+		}
+		if m.VersionStartIncluding == "" && m.VersionEndExcluding == "" {
+			continue
+		}
+		vr := VersionRange{
+			Introduced: cpeVersion(m.VersionStartIncluding),
+			Fixed:      cpeVersion(m.VersionEndExcluding),
+		}
+		if (vr.Introduced == "") != (m.VersionStartIncluding == "") || (vr.Fixed == "") != (m.VersionEndExcluding == "") {
+			// A bound is not a semantic version.
+			continue
+		}
+		if !slices.Contains(vrs, vr) {
+			vrs = append(vrs, vr)
+		}
+	}
+	return vrs
+}
+
+// cpeProductKey returns the CPE product p in a form for comparing with the
+// elements of module paths, which may use hyphens where CPE names use
+// underscores.
+func cpeProductKey(p string) string {
+	return strings.ReplaceAll(strings.ToLower(p), "-", "_")
+}
+
+// cpeVersion returns v, a version from a CPE match, as a canonical semantic
+// version without the "v" prefix, or "" if it is empty or not a semantic
+// version.
+func cpeVersion(v string) Version {
+	if v == "" {
+		return ""
+	}
+	if !strings.HasPrefix(v, "v") {
+		v = "v" + v
+	}
+	return Version(strings.TrimPrefix(semver.Canonical(v), "v"))
+}
@@ -8,9 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"golang.org/x/vulndb/internal/cveschema5"
 	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/stdlib"
 )
 
 // TODO(https://go.dev/issues/53256): Add a function to convert from
@@ -32,6 +34,12 @@ func ToCVE5(reportPath string) (_ *cveschema5.CVERecord, err error) {
 	if lints := r.Lint(reportPath); len(lints) > 0 {
 		return nil, fmt.Errorf("report has outstanding lint errors:\n  %v", strings.Join(lints, "\n  "))
 	}
+	return r.ToCVE5()
+}
+
+// ToCVE5 creates a CVE in 5.0 format from the report, without linting it
+// first.
+func (r *Report) ToCVE5() (*cveschema5.CVERecord, error) {
 	if len(r.CVEs) > 0 {
 		return nil, errors.New("report has CVE ID is wrong section (should be in cve_metadata for self-issued CVEs)")
 	}
@@ -41,6 +49,9 @@ func ToCVE5(reportPath string) (_ *cveschema5.CVERecord, err error) {
 	if r.CVEMetadata.ID == "" {
 		return nil, errors.New("report missing CVE ID")
 	}
+	if r.Withdrawn != nil {
+		return rejectedCVE5(r), nil
+	}
 	if r.CVEMetadata.Description == "" {
 		return nil, errors.New("report missing cve_metadata.description")
 	}
@@ -72,15 +83,26 @@ func ToCVE5(reportPath string) (_ *cveschema5.CVERecord, err error) {
 		},
 	}
 
-	for _, m := range r.Modules {
-		for _, p := range m.Packages {
+	for _, m := range r.AllModules() {
+		// A module without packages is affected as a whole.
+		pkgs := m.Packages
+		if len(pkgs) == 0 {
+			pkgs = []*Package{{Package: m.Module}}
+		}
+		for _, p := range pkgs {
 			affected := cveschema5.Affected{
+				Vendor:        vendor(m.Module),
+				Product:       p.Package,
 				CollectionURL: "https://pkg.go.dev",
 				PackageName:   p.Package,
 				Versions:      versionRangeToVersionRange(m.Versions),
 				DefaultStatus: cveschema5.StatusUnaffected,
 				Platforms:     p.GOOS,
 			}
+			if len(affected.Versions) == 0 {
+				// Every version is affected.
+				affected.DefaultStatus = cveschema5.StatusAffected
+			}
 			for _, symbol := range p.AllSymbols() {
 				affected.ProgramRoutines = append(affected.ProgramRoutines, cveschema5.ProgramRoutine{Name: symbol})
 			}
@@ -92,13 +114,13 @@ func ToCVE5(reportPath string) (_ *cveschema5.CVERecord, err error) {
 		c.References = append(c.References, cveschema5.Reference{URL: ref.URL})
 	}
 
-	if r.Credit != "" {
-		c.Credits = []cveschema5.Credit{
-			{
-				Lang:  "en",
-				Value: r.Credit,
-			},
-		}
+	for _, credit := range r.Credits {
+		c.Credits = append(c.Credits, cveschema5.Credit{
+			Lang:  "en",
+			Value: credit.Name,
+			// CVE types are like OSV's, in lower case with spaces.
+			Type: strings.ToLower(strings.ReplaceAll(string(credit.Type), "_", " ")),
+		})
 	}
 
 	return &cveschema5.CVERecord{
@@ -113,6 +135,46 @@ func ToCVE5(reportPath string) (_ *cveschema5.CVERecord, err error) {
 	}, nil
 }
 
+// rejectedCVE5 returns the CVE record for a withdrawn report, which rejects
+// the CVE.
+func rejectedCVE5(r *Report) *cveschema5.CVERecord {
+	return &cveschema5.CVERecord{
+		DataType:    "CVE_RECORD",
+		DataVersion: "5.0",
+		Metadata: cveschema5.Metadata{
+			ID:           r.CVEMetadata.ID,
+			State:        cveschema5.StateRejected,
+			DateRejected: r.Withdrawn.Date.UTC().Format(time.RFC3339),
+		},
+		Containers: cveschema5.Containers{
+			CNAContainer: cveschema5.CNAPublishedContainer{
+				ProviderMetadata: cveschema5.ProviderMetadata{
+					OrgID: GoOrgUUID,
+				},
+				RejectedReasons: []cveschema5.Description{
+					{
+						Lang:  "en",
+						Value: strings.ReplaceAll(strings.TrimSuffix(r.Withdrawn.Reason, "\n"), "\n", " "),
+					},
+				},
+			},
+		},
+	}
+}
+
+// vendor returns the vendor of the packages of the module at modulePath, as
+// the Go CNA names it in CVE records: the module path, or "Go standard
+// library" or "Go toolchain" for the packages of the Go repo.
+func vendor(modulePath string) string {
+	switch modulePath {
+	case stdlib.ModulePath:
+		return "Go standard library"
+	case "cmd":
+		return "Go toolchain"
+	}
+	return modulePath
+}
+
 func versionRangeToVersionRange(versions []VersionRange) []cveschema5.VersionRange {
 	var cveVRs []cveschema5.VersionRange
 	for _, vr := range versions {
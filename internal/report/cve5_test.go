@@ -5,7 +5,9 @@
 package report
 
 import (
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/vulndb/internal/cveschema5"
@@ -31,6 +33,8 @@ var (
 				},
 				Affected: []cveschema5.Affected{
 					{
+						Vendor:        "Go standard library",
+						Product:       "crypto/rand",
 						CollectionURL: "https://pkg.go.dev",
 						PackageName:   "crypto/rand",
 						Versions: []cveschema5.VersionRange{
@@ -86,6 +90,7 @@ var (
 					{
 						Lang:  "en",
 						Value: "A Credit",
+						Type:  "remediation developer",
 					},
 				},
 			},
@@ -110,6 +115,8 @@ var (
 				},
 				Affected: []cveschema5.Affected{
 					{
+						Vendor:        "github.com/gin-gonic/gin",
+						Product:       "github.com/gin-gonic/gin",
 						CollectionURL: "https://pkg.go.dev",
 						PackageName:   "github.com/gin-gonic/gin",
 						Versions: []cveschema5.VersionRange{
@@ -149,7 +156,7 @@ var (
 				Credits: []cveschema5.Credit{
 					{
 						Lang:  "en",
-						Value: "@thinkerou <thinkerou@gmail.com>",
+						Value: "@thinkerou",
 					},
 				},
 			},
@@ -186,3 +193,71 @@ func TestToCVE5(t *testing.T) {
 		})
 	}
 }
+
+func TestToCVE5WholeModule(t *testing.T) {
+	r, err := Read("testdata/report.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A module with no packages or versions is affected entirely.
+	r.Modules[0].Packages = nil
+	r.Modules[0].Versions = nil
+	filename := filepath.Join(t.TempDir(), "report.yaml")
+	if err := r.Write(filename); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ToCVE5(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []cveschema5.Affected{{
+		Vendor:        "github.com/gin-gonic/gin",
+		Product:       "github.com/gin-gonic/gin",
+		CollectionURL: "https://pkg.go.dev",
+		PackageName:   "github.com/gin-gonic/gin",
+		DefaultStatus: cveschema5.StatusAffected,
+	}}
+	if diff := cmp.Diff(want, got.Containers.CNAContainer.Affected); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestToCVE5Withdrawn(t *testing.T) {
+	r, err := Read("testdata/std-report.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Withdrawn = &Withdrawn{
+		Date:   time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+		Reason: "The vulnerable function\nwas never released.",
+	}
+	filename := filepath.Join(t.TempDir(), "report.yaml")
+	if err := r.Write(filename); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ToCVE5(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &cveschema5.CVERecord{
+		DataType:    "CVE_RECORD",
+		DataVersion: "5.0",
+		Metadata: cveschema5.Metadata{
+			ID:           "CVE-9999-0001",
+			State:        cveschema5.StateRejected,
+			DateRejected: "2022-11-01T00:00:00Z",
+		},
+		Containers: cveschema5.Containers{
+			CNAContainer: cveschema5.CNAPublishedContainer{
+				ProviderMetadata: cveschema5.ProviderMetadata{OrgID: GoOrgUUID},
+				RejectedReasons: []cveschema5.Description{{
+					Lang:  "en",
+					Value: "The vulnerable function was never released.",
+				}},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveschema"
+)
+
+func TestGuessFixedVersions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/example.com/!m/@v/list" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "v1.0.0\nv1.2.0\nv1.2.3\nv2.0.0\n")
+	}))
+	defer srv.Close()
+	defer func(old string) { proxyURL = old }(proxyURL)
+	proxyURL = srv.URL
+
+	for _, test := range []struct {
+		text string
+		want []Version
+	}{
+		{"A bug in M before 1.2.3 allows XSS.", []Version{"1.2.3"}},
+		{"Fixed in v1.2. Users should upgrade to version 1.2.3; versions prior to 1.2 are affected.", []Version{"1.2.0", "1.2.3"}},
+		// 1.9.9 is not a version of the module.
+		{"M before 1.9.9 allows XSS.", nil},
+		{"M allows XSS.", nil},
+	} {
+		got, err := GuessFixedVersions(test.text, "example.com/M")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%q: mismatch (-want, +got):\n%s", test.text, diff)
+		}
+	}
+}
+
+func TestCPEVersionRanges(t *testing.T) {
+	cve := func(ms ...cveschema.CPEMatch) *cveschema.CVE {
+		for i := range ms {
+			ms[i].Vulnerable = true
+		}
+		return &cveschema.CVE{
+			Configurations: &cveschema.Configurations{
+				Nodes: []cveschema.ConfigNode{{Operator: "OR", CPEMatch: ms}},
+			},
+		}
+	}
+	const (
+		gin   = "cpe:2.3:a:gin-gonic:gin:*:*:*:*:*:go:*:*"
+		other = "cpe:2.3:a:example:other:*:*:*:*:*:*:*:*"
+		goCPE = "cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*"
+	)
+	for _, test := range []struct {
+		name       string
+		c          *cveschema.CVE
+		modulePath string
+		want       []VersionRange
+	}{
+		{
+			"product of the module",
+			cve(
+				cveschema.CPEMatch{CPE23URI: gin, VersionEndExcluding: "1.6.3"},
+				cveschema.CPEMatch{CPE23URI: gin, VersionStartIncluding: "1.7.0", VersionEndExcluding: "v1.7.7"},
+				cveschema.CPEMatch{CPE23URI: other, VersionEndExcluding: "2.0.0"},
+			),
+			"github.com/gin-gonic/gin",
+			[]VersionRange{{Fixed: "1.6.3"}, {Introduced: "1.7.0", Fixed: "1.7.7"}},
+		},
+		{
+			"major version suffix",
+			cve(cveschema.CPEMatch{CPE23URI: gin, VersionStartIncluding: "2.0", VersionEndExcluding: "2.1"}),
+			"example.com/gin/v2",
+			[]VersionRange{{Introduced: "2.0.0", Fixed: "2.1.0"}},
+		},
+		{
+			"single product",
+			cve(cveschema.CPEMatch{CPE23URI: other, VersionEndExcluding: "2.0.0"}),
+			"example.com/m",
+			[]VersionRange{{Fixed: "2.0.0"}},
+		},
+		{
+			"several other products",
+			cve(
+				cveschema.CPEMatch{CPE23URI: gin, VersionEndExcluding: "1.6.3"},
+				cveschema.CPEMatch{CPE23URI: other, VersionEndExcluding: "2.0.0"},
+			),
+			"example.com/m",
+			nil,
+		},
+		{
+			"standard library",
+			cve(
+				cveschema.CPEMatch{CPE23URI: goCPE, VersionEndExcluding: "1.17.11"},
+				cveschema.CPEMatch{CPE23URI: goCPE, VersionStartIncluding: "1.18.0", VersionEndExcluding: "1.18.3"},
+			),
+			"std",
+			[]VersionRange{{Fixed: "1.17.11"}, {Introduced: "1.18.0", Fixed: "1.18.3"}},
+		},
+		{
+			"bounds that are skipped",
+			cve(
+				cveschema.CPEMatch{CPE23URI: "cpe:2.3:a:gin-gonic:gin:1.5.0:*:*:*:*:go:*:*"},
+				cveschema.CPEMatch{CPE23URI: gin, VersionEndIncluding: "1.6.3"},
+				cveschema.CPEMatch{CPE23URI: gin, VersionStartExcluding: "1.0.0", VersionEndExcluding: "1.6.3"},
+				cveschema.CPEMatch{CPE23URI: gin, VersionEndExcluding: "2022-01-01"},
+			),
+			"github.com/gin-gonic/gin",
+			nil,
+		},
+		{"no configurations", &cveschema.CVE{}, "github.com/gin-gonic/gin", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got := CPEVersionRanges(test.c, test.modulePath)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// unknownFieldRegexp matches the error of the YAML decoder for a field that
+// is not in a struct. The groups are the field and the type of the struct.
+var unknownFieldRegexp = regexp.MustCompile(`field (\S+) not found in type (\S+)`)
+
+// annotateDecodeErrors returns the errors of a *yaml.TypeError, where those
+// for fields that are not in a struct suggest the closest field of the
+// struct, if there is a likely one. It also returns those errors alone.
+func annotateDecodeErrors(te *yaml.TypeError) (msgs, unknown []string) {
+	for _, msg := range te.Errors {
+		m := unknownFieldRegexp.FindStringSubmatch(msg)
+		if m == nil {
+			msgs = append(msgs, msg)
+			continue
+		}
+		if s := closest(m[1], yamlFields()[m[2]]); s != "" {
+			msg += fmt.Sprintf(" (did you mean %s?)", s)
+		}
+		msgs = append(msgs, msg)
+		unknown = append(unknown, msg)
+	}
+	return msgs, unknown
+}
+
+// yamlFields returns the YAML names of the fields of Report and of the
+// structs of this package it contains, by the names of their types as in
+// the errors of the YAML decoder, like "report.Module".
+func yamlFields() map[string][]string {
+	fields := map[string][]string{}
+	pkgPath := reflect.TypeOf(Report{}).PkgPath()
+	var add func(t reflect.Type)
+	add = func(t reflect.Type) {
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || t.PkgPath() != pkgPath {
+			return
+		}
+		if _, ok := fields[t.String()]; ok {
+			return
+		}
+		fields[t.String()] = nil
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			// As in the YAML package, a field is named by its tag, or by
+			// its name in lower case.
+			name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = strings.ToLower(f.Name)
+			}
+			fields[t.String()] = append(fields[t.String()], name)
+			add(f.Type)
+		}
+	}
+	add(reflect.TypeOf(Report{}))
+	return fields
+}
+
+// closest returns the name in names that is closest to name in edit
+// distance, if it is close enough to be a likely typo, or "".
+func closest(name string, names []string) string {
+	best, bestDist := "", -1
+	for _, n := range names {
+		if d := editDistance(name, n); bestDist < 0 || d < bestDist {
+			best, bestDist = n, d
+		}
+	}
+	// Allow one edit for short names, and more for longer ones.
+	if bestDist < 0 || bestDist > 1+len(name)/4 {
+		return ""
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b: the number
+// of insertions, deletions and substitutions of bytes that turn a into b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(x int, ys ...int) int {
+	for _, y := range ys {
+		if y < x {
+			x = y
+		}
+	}
+	return x
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import "testing"
+
+func TestClosest(t *testing.T) {
+	names := yamlFields()["report.Package"]
+	for _, test := range []struct {
+		name, want string
+	}{
+		{"symbol", "symbols"},
+		{"derivedsymbols", "derived_symbols"},
+		{"pakcage", "package"},
+		{"goarc", "goarch"},
+		{"os", ""},
+		{"description", ""},
+	} {
+		if got := closest(test.name, names); got != test.want {
+			t.Errorf("closest(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, test := range []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"versions", "verions", 1},
+		{"kitten", "sitting", 3},
+	} {
+		if got := editDistance(test.a, test.b); got != test.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+		if got := editDistance(test.b, test.a); got != test.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", test.b, test.a, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// fixCommitRegexps match the URLs of fix commits whose repos can be cloned.
+// The first submatch is the repo path, as a module path would begin, and the
+// second is the commit hash.
+var fixCommitRegexps = []struct {
+	re      *regexp.Regexp
+	repoURL func(repoPath string) string
+}{{
+	regexp.MustCompile(`^https://(github\.com/[^/]+/[^/]+)/commit/([0-9a-f]{40})$`),
+	func(p string) string { return "https://" + p },
+}, {
+	regexp.MustCompile(`^https://go\.googlesource\.com/([^/+]+)/\+/([0-9a-f]{40})$`),
+	func(p string) string { return "https://go.googlesource.com/" + strings.TrimPrefix(p, "golang.org/x/") },
+}}
+
+// A FixCommit is a commit, referred to by a fix reference, in the repo of
+// one of the modules of a report.
+type FixCommit struct {
+	URL     string  // the URL of the reference
+	RepoURL string  // the URL to clone the repo from
+	Hash    string  // the full commit hash
+	Module  *Module // the module of the report in the repo
+	// Dir is the directory of the module in the repo, which prefixes its
+	// version tags.
+	Dir string
+}
+
+// FixCommits returns the fix commits of r that are in the repo of one of its
+// modules. Commits in the Go repo are skipped, since the standard library is
+// not versioned by module tags.
+func (r *Report) FixCommits() []*FixCommit {
+	var fcs []*FixCommit
+	for _, ref := range r.References {
+		if ref.Type != ReferenceTypeFix {
+			continue
+		}
+		for _, fr := range fixCommitRegexps {
+			m := fr.re.FindStringSubmatch(ref.URL)
+			if m == nil {
+				continue
+			}
+			repoPath, hash := m[1], m[2]
+			if !strings.Contains(repoPath, "/") {
+				// A go.googlesource.com repo.
+				repoPath = "golang.org/x/" + repoPath
+			}
+			for _, mod := range r.Modules {
+				dir, ok := moduleDir(mod.Module, repoPath)
+				if !ok || mod.Module == stdlib.ModulePath {
+					continue
+				}
+				fcs = append(fcs, &FixCommit{
+					URL:     ref.URL,
+					RepoURL: fr.repoURL(repoPath),
+					Hash:    hash,
+					Module:  mod,
+					Dir:     dir,
+				})
+			}
+		}
+	}
+	return fcs
+}
+
+// moduleDir returns the directory of the module at modulePath in the repo
+// whose root is at repoPath, and whether the module is in the repo at all.
+func moduleDir(modulePath, repoPath string) (string, bool) {
+	prefix, _, ok := module.SplitPathVersion(modulePath)
+	if !ok {
+		return "", false
+	}
+	if prefix == repoPath {
+		return "", true
+	}
+	if dir := strings.TrimPrefix(prefix, repoPath+"/"); dir != prefix {
+		return dir, true
+	}
+	return "", false
+}
+
+// ResolveFixedVersions determines the first version of its module that
+// contains each fix commit of r, among the versions that the module proxy
+// knows about. The open function returns the repo at a URL, with its history
+// and tags; each repo is opened once.
+//
+// A fix commit that is in no version is at its pseudo-version, if the
+// proxy knows the commit, as for a module without tags.
+//
+// If a module of r has no version ranges, or has a single range with no
+// fixed version, and all of its fix commits are first in the same version,
+// ResolveFixedVersions sets the fixed version to that version, and the fixed
+// commit too if the version is a pseudo-version. It returns a message for
+// each fix commit that contradicts the version ranges: one that is in no
+// version, or whose version is not the fixed version of any range.
+// Backported fixes are different commits, so they are checked only if the
+// report refers to them too.
+func (r *Report) ResolveFixedVersions(ctx context.Context, open func(context.Context, string) (*git.Repository, error)) (mismatches []string, err error) {
+	defer derrors.Wrap(&err, "ResolveFixedVersions")
+
+	repos := map[string]*git.Repository{}
+	resolved := map[*Module][]fixResolution{}
+	var mods []*Module
+	for _, fc := range r.FixCommits() {
+		repo, ok := repos[fc.RepoURL]
+		if !ok {
+			repo, err = open(ctx, fc.RepoURL)
+			if err != nil {
+				return nil, err
+			}
+			repos[fc.RepoURL] = repo
+		}
+		versions, err := getModVersionsFromProxy(fc.Module.Module)
+		if err != nil {
+			return nil, err
+		}
+		v, err := firstVersionContaining(repo, fc.Hash, fc.Dir, versions)
+		if err != nil {
+			return nil, err
+		}
+		if v == "" {
+			pv, err := getCanonicalModVersionFromProxy(fc.Module.Module, fc.Hash)
+			if err != nil {
+				mismatches = append(mismatches, fmt.Sprintf("%s: fix commit is not in any version of %s", fc.URL, fc.Module.Module))
+				continue
+			}
+			v = Version(strings.TrimPrefix(pv, "v"))
+		}
+		if _, ok := resolved[fc.Module]; !ok {
+			mods = append(mods, fc.Module)
+		}
+		resolved[fc.Module] = append(resolved[fc.Module], fixResolution{v, fc.Hash})
+		if !hasFixedVersion(fc.Module, v, fc.Hash) {
+			mismatches = append(mismatches, fmt.Sprintf("%s: fix commit is first in %s@%s, but no range is fixed there", fc.URL, fc.Module.Module, v.V()))
+		}
+	}
+	for _, m := range mods {
+		rs := resolved[m]
+		fr := rs[0]
+		for _, fr2 := range rs[1:] {
+			if fr2.version != fr.version {
+				// The triager must decide which is right.
+				fr.version = ""
+			}
+		}
+		if fr.version == "" {
+			continue
+		}
+		vr := VersionRange{Fixed: fr.version}
+		if isPseudoVersion(fr.version.V()) {
+			vr.FixedCommit = fr.hash
+		}
+		switch {
+		case len(m.Versions) == 0:
+			m.Versions = []VersionRange{vr}
+		case len(m.Versions) == 1 && m.Versions[0].Fixed == "" && m.Versions[0].Introduced.Before(vr.Fixed):
+			m.Versions[0].Fixed = vr.Fixed
+			if m.Versions[0].FixedCommit == "" {
+				m.Versions[0].FixedCommit = vr.FixedCommit
+			}
+		}
+	}
+	return mismatches, nil
+}
+
+// A fixResolution is the version that a fix commit is first in.
+type fixResolution struct {
+	version Version
+	hash    string // the hash of the fix commit
+}
+
+// hasFixedVersion reports whether v, the version of the fix commit with the
+// given hash, is the fixed version of one of m's version ranges, or the
+// commit is the fixed commit of one, or whether m has no fixed versions to
+// contradict.
+func hasFixedVersion(m *Module, v Version, hash string) bool {
+	hasFixed := false
+	for _, vr := range m.Versions {
+		if vr.Fixed == v || (vr.FixedCommit != "" && strings.HasPrefix(hash, vr.FixedCommit)) {
+			return true
+		}
+		hasFixed = hasFixed || vr.Fixed != ""
+	}
+	return !hasFixed
+}
+
+// firstVersionContaining returns the earliest release version, among those in
+// versions, whose tag in repo contains the commit with the given hash. The
+// tags of a module in a subdirectory of the repo are prefixed by the
+// directory dir. It returns "" if no version contains the commit.
+func firstVersionContaining(repo *git.Repository, hash, dir string, versions map[string]bool) (_ Version, err error) {
+	defer derrors.Wrap(&err, "firstVersionContaining(%s)", hash)
+
+	fix, err := repo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return "", err
+	}
+	prefix := ""
+	if dir != "" {
+		prefix = dir + "/"
+	}
+	type tag struct {
+		version string // as listed by the proxy
+		ref     *plumbing.Reference
+	}
+	var tags []tag
+	iter, err := repo.Tags()
+	if err != nil {
+		return "", err
+	}
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		if !strings.HasPrefix(name, prefix) {
+			return nil
+		}
+		v := strings.TrimPrefix(name, prefix)
+		if !semver.IsValid(v) || semver.Prerelease(v) != "" {
+			return nil
+		}
+		switch {
+		case versions[v]:
+		case versions[v+"+incompatible"]:
+			v += "+incompatible"
+		default:
+			return nil
+		}
+		tags = append(tags, tag{v, ref})
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(tags, func(i, j int) bool { return semver.Compare(tags[i].version, tags[j].version) < 0 })
+	for _, t := range tags {
+		c, err := tagCommit(repo, t.ref)
+		if err != nil {
+			return "", err
+		}
+		ok, err := fix.IsAncestor(c)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return Version(strings.TrimPrefix(t.version, "v")), nil
+		}
+	}
+	return "", nil
+}
+
+// tagCommit returns the commit that the tag ref points to, which may be an
+// annotated tag.
+func tagCommit(repo *git.Repository, ref *plumbing.Reference) (*object.Commit, error) {
+	if t, err := repo.TagObject(ref.Hash()); err == nil {
+		return t.Commit()
+	}
+	return repo.CommitObject(ref.Hash())
+}
+
+// StdlibVersionRanges returns the version ranges of a standard library or
+// toolchain vulnerability whose fixes are first in the given Go versions,
+// such as the first releases from each release branch that the fix was
+// backported to. The versions before the earliest fix are affected, and so
+// are the versions of each later minor release before its fix. A fix in the
+// first version of a minor release, like 1.20.0, affects none of it.
+func StdlibVersionRanges(fixed []Version) []VersionRange {
+	// The earliest fix in each minor release.
+	byMinor := map[string]Version{}
+	for _, v := range fixed {
+		mm := semver.MajorMinor(v.V())
+		if f, ok := byMinor[mm]; !ok || v.Before(f) {
+			byMinor[mm] = v
+		}
+	}
+	var vs []Version
+	for _, v := range byMinor {
+		vs = append(vs, v)
+	}
+	sort.Slice(vs, func(i, j int) bool { return vs[i].Before(vs[j]) })
+	var vrs []VersionRange
+	for i, v := range vs {
+		if i == 0 {
+			vrs = append(vrs, VersionRange{Fixed: v})
+			continue
+		}
+		introduced := Version(strings.TrimPrefix(semver.MajorMinor(v.V()), "v") + ".0")
+		if introduced != v {
+			vrs = append(vrs, VersionRange{Introduced: introduced, Fixed: v})
+		}
+	}
+	return vrs
+}
@@ -0,0 +1,225 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolveFixedVersions(t *testing.T) {
+	var hashes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/github.com/a/b/@v/list":
+			// v1.3.0 is tagged but not listed.
+			fmt.Fprint(w, "v1.0.0\nv1.1.0\nv1.2.0\n")
+		case "/github.com/a/b/sub/@v/list":
+			fmt.Fprint(w, "v0.1.0\n")
+		case "/github.com/a/b/@v/" + hashes[3] + ".info":
+			fmt.Fprintf(w, `{"Version": "v1.2.1-0.20220101000000-%s"}`, hashes[3][:12])
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	defer func(old string) { proxyURL = old }(proxyURL)
+	proxyURL = srv.URL
+
+	// The repo has one commit for each of these, in order, with the given
+	// tags. The commits named "fix" fix the vulnerability.
+	repo, hs := newTestRepo(t, []testCommit{
+		{"initial", []string{"v1.0.0"}},
+		{"fix", nil},
+		{"release", []string{"v1.1.0-rc.1", "v1.1.0", "sub/v0.1.0"}},
+		{"unreleased fix", []string{"v1.3.0"}},
+		{"unknown fix", nil},
+	})
+	hashes = hs
+	open := func(_ context.Context, url string) (*git.Repository, error) {
+		if url != "https://github.com/a/b" {
+			return nil, fmt.Errorf("unexpected repo %s", url)
+		}
+		return repo, nil
+	}
+	fixRef := func(h string) *Reference {
+		return &Reference{Type: ReferenceTypeFix, URL: "https://github.com/a/b/commit/" + h}
+	}
+
+	for _, test := range []struct {
+		name           string
+		versions       []VersionRange
+		fixes          []string
+		wantVersions   []VersionRange
+		wantMismatches int
+	}{
+		{
+			name:         "fill in",
+			fixes:        []string{hashes[1]},
+			wantVersions: []VersionRange{{Fixed: "1.1.0"}},
+		},
+		{
+			name:         "fill in range",
+			versions:     []VersionRange{{Introduced: "1.0.0"}},
+			fixes:        []string{hashes[1]},
+			wantVersions: []VersionRange{{Introduced: "1.0.0", Fixed: "1.1.0"}},
+		},
+		{
+			name:         "match",
+			versions:     []VersionRange{{Fixed: "1.1.0"}},
+			fixes:        []string{hashes[1]},
+			wantVersions: []VersionRange{{Fixed: "1.1.0"}},
+		},
+		{
+			name:           "mismatch",
+			versions:       []VersionRange{{Fixed: "1.0.0"}},
+			fixes:          []string{hashes[1]},
+			wantVersions:   []VersionRange{{Fixed: "1.0.0"}},
+			wantMismatches: 1,
+		},
+		{
+			name:  "not released",
+			fixes: []string{hashes[3]},
+			wantVersions: []VersionRange{{
+				Fixed:       Version("1.2.1-0.20220101000000-" + hashes[3][:12]),
+				FixedCommit: hashes[3],
+			}},
+		},
+		{
+			name:     "not released, with fixed commit",
+			versions: []VersionRange{{FixedCommit: hashes[3][:12]}},
+			fixes:    []string{hashes[3]},
+			wantVersions: []VersionRange{{
+				Fixed:       Version("1.2.1-0.20220101000000-" + hashes[3][:12]),
+				FixedCommit: hashes[3][:12],
+			}},
+		},
+		{
+			name:           "unknown to proxy",
+			fixes:          []string{hashes[4]},
+			wantMismatches: 1,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			r := &Report{
+				Modules: []*Module{{Module: "github.com/a/b", Versions: test.versions}},
+			}
+			for _, h := range test.fixes {
+				r.References = append(r.References, fixRef(h))
+			}
+			mismatches, err := r.ResolveFixedVersions(context.Background(), open)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.wantVersions, r.Modules[0].Versions); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+			if len(mismatches) != test.wantMismatches {
+				t.Errorf("got mismatches %q, want %d", mismatches, test.wantMismatches)
+			}
+		})
+	}
+
+	t.Run("subdirectory", func(t *testing.T) {
+		r := &Report{
+			Modules:    []*Module{{Module: "github.com/a/b/sub"}},
+			References: []*Reference{fixRef(hashes[1])},
+		}
+		if _, err := r.ResolveFixedVersions(context.Background(), open); err != nil {
+			t.Fatal(err)
+		}
+		want := []VersionRange{{Fixed: "0.1.0"}}
+		if diff := cmp.Diff(want, r.Modules[0].Versions); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+}
+
+type testCommit struct {
+	message string
+	tags    []string
+}
+
+// newTestRepo returns a repo with the given commits, in order, and their
+// hashes. The tag v1.1.0 is annotated; the others are lightweight.
+func newTestRepo(t *testing.T, commits []testCommit) (*git.Repository, []string) {
+	t.Helper()
+	fs := memfs.New()
+	repo, err := git.Init(memory.NewStorage(), fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := &object.Signature{Name: "Author", Email: "author@example.com", When: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	var hashes []string
+	for i, c := range commits {
+		f, err := fs.Create("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fmt.Fprint(f, i); err != nil {
+			t.Fatal(err)
+		}
+		if err := f.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := wt.Add("file"); err != nil {
+			t.Fatal(err)
+		}
+		h, err := wt.Commit(c.message, &git.CommitOptions{Author: sig})
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, h.String())
+		for _, tag := range c.tags {
+			var opts *git.CreateTagOptions
+			if tag == "v1.1.0" {
+				opts = &git.CreateTagOptions{Tagger: sig, Message: tag}
+			}
+			if _, err := repo.CreateTag(tag, h, opts); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return repo, hashes
+}
+
+func TestStdlibVersionRanges(t *testing.T) {
+	for _, test := range []struct {
+		fixed []Version
+		want  []VersionRange
+	}{
+		{nil, nil},
+		{[]Version{"1.20.0"}, []VersionRange{{Fixed: "1.20.0"}}},
+		{
+			// A fix on master, backported to two release branches.
+			[]Version{"1.20.0", "1.19.4", "1.18.9"},
+			[]VersionRange{{Fixed: "1.18.9"}, {Introduced: "1.19.0", Fixed: "1.19.4"}},
+		},
+		{
+			// Two fixes to the same release branch.
+			[]Version{"1.19.5", "1.19.4", "1.20.1"},
+			[]VersionRange{{Fixed: "1.19.4"}, {Introduced: "1.20.0", Fixed: "1.20.1"}},
+		},
+	} {
+		got := StdlibVersionRanges(test.fixed)
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%v: mismatch (-want, +got):\n%s", test.fixed, diff)
+		}
+	}
+}
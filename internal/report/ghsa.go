@@ -27,6 +27,9 @@ func GHSAToReport(sa *ghsa.SecurityAdvisory, modulePath string) *Report {
 	}
 	r.CVEs = cves
 	r.GHSAs = ghsas
+	if sa.CVSS != "" {
+		r.CVSS = []*CVSS{{Vector: sa.CVSS, Source: sa.ID}}
+	}
 	if modulePath == "" {
 		modulePath = "TODO"
 	}
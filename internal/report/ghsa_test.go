@@ -20,6 +20,7 @@ func TestGHSAToReport(t *testing.T) {
 		UpdatedAt:   updatedTime,
 		Permalink:   "https://github.com/permalink/to/G1",
 		Description: "a description",
+		CVSS:        "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
 		Vulns: []*ghsa.Vuln{{
 			Package:                "aPackage",
 			EarliestFixedVersion:   "1.2.3",
@@ -40,6 +41,7 @@ func TestGHSAToReport(t *testing.T) {
 		Description: "a description",
 		GHSAs:       []string{"G1"},
 		CVEs:        []string{"C1"},
+		CVSS:        []*CVSS{{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", Source: "G1_blah"}},
 	}
 
 	if diff := cmp.Diff(*got, *want); diff != "" {
@@ -6,35 +6,59 @@ package report
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
+	"net/mail"
 	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 
 	"golang.org/x/exp/slices"
 	"golang.org/x/mod/modfile"
 	"golang.org/x/mod/module"
 	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/internal/cvss"
+	"golang.org/x/vulndb/internal/refurl"
 	"golang.org/x/vulndb/internal/stdlib"
 )
 
-// TODO: getting things from the proxy should all be cached so we
-// aren't re-requesting the same stuff over and over.
+// proxyURL, if not empty, is the URL of the module proxy that linting asks
+// about modules. Otherwise it is GOPROXY, or proxy.golang.org if that is not
+// set. GOPROXY is read on each lookup, so that tests of other packages can
+// set it.
+var proxyURL string
 
-var proxyURL = "https://proxy.golang.org"
-
-func init() {
+func getProxyURL() string {
+	if proxyURL != "" {
+		return proxyURL
+	}
 	if proxy, ok := os.LookupEnv("GOPROXY"); ok {
-		proxyURL = proxy
+		return proxy
 	}
+	return "https://proxy.golang.org"
 }
 
+// proxyCache holds the successful responses of the proxy, by URL, so that
+// linting many reports of the same module asks the proxy only once.
+var proxyCache = struct {
+	sync.Mutex
+	m map[string][]byte
+}{m: map[string][]byte{}}
+
 func proxyLookup(urlSuffix string) ([]byte, error) {
-	url := fmt.Sprintf("%s/%s", proxyURL, urlSuffix)
+	url := fmt.Sprintf("%s/%s", getProxyURL(), urlSuffix)
+	proxyCache.Lock()
+	b, ok := proxyCache.m[url]
+	proxyCache.Unlock()
+	if ok {
+		return b, nil
+	}
 	resp, err := http.Get(url)
 	if err != nil {
 		return nil, err
@@ -42,10 +66,13 @@ func proxyLookup(urlSuffix string) ([]byte, error) {
 		return nil, fmt.Errorf("http.Get(%q) returned status %v", url, resp.Status)
 	}
 	defer resp.Body.Close()
-	b, err := io.ReadAll(resp.Body)
+	b, err = io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
+	proxyCache.Lock()
+	proxyCache.m[url] = b
+	proxyCache.Unlock()
 	return b, nil
 }
 
@@ -60,11 +87,20 @@ func getModVersionsFromProxy(path string) (_ map[string]bool, err error) {
 	}
 	versions := map[string]bool{}
 	for _, v := range strings.Split(string(b), "\n") {
-		versions[v] = true
+		if v != "" {
+			versions[v] = true
+		}
 	}
 	return versions, nil
 }
 
+// ModuleExists reports whether the module proxy knows about the module at
+// path.
+func ModuleExists(path string) bool {
+	_, err := getModVersionsFromProxy(path)
+	return err == nil
+}
+
 func getCanonicalModNameFromProxy(path, version string) (_ string, err error) {
 	escapedPath, err := module.EscapePath(path)
 	if err != nil {
@@ -159,14 +195,29 @@ func checkModVersions(modPath string, vrs []VersionRange) (err error) {
 		}
 		return nil
 	}
+	// The proxy lists only tagged versions, so a module without tags, a
+	// range between pseudo-versions, or a range from the first commit
+	// (which affects the untagged commits before the first tag) may rightly
+	// contain none of them.
+	untagged := len(foundVersions) == 0
 	for _, vr := range vrs {
 		for _, v := range []Version{vr.Introduced, vr.Fixed} {
 			if err := checkVersion(v); err != nil {
 				return fmt.Errorf("bad version %q: %s", v, err)
 			}
+			untagged = untagged || isPseudoVersion(v.V())
 		}
+		untagged = untagged || vr.Introduced == ""
 	}
-	return nil
+	if len(vrs) == 0 || untagged {
+		return nil
+	}
+	for v := range foundVersions {
+		if Affects(vrs, Version(strings.TrimPrefix(v, "v"))) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no published version of %s is in the affected ranges", modPath)
 }
 
 func (m *Module) lintStdLib(addPkgIssue func(string)) {
@@ -193,24 +244,148 @@ func (m *Module) lintThirdParty(addPkgIssue func(string)) {
 			addPkgIssue("missing package")
 			continue
 		}
-		if !strings.HasPrefix(p.Package, m.Module) {
-			addPkgIssue("module must be a prefix of package")
-		}
 		if err := module.CheckImportPath(p.Package); err != nil {
 			addPkgIssue(err.Error())
 		}
 	}
 }
 
+// lintPackages checks that each package of r is in the module it is listed
+// under, and is listed only once there. A package of a third-party module
+// must be in the module's path, and not in a more specific module: another
+// module of the report, like the v2 of the module, or a major version of the
+// module that the report doesn't list.
+func (r *Report) lintPackages(addIssue func(string)) {
+	for i, m := range r.Modules {
+		addPkgIssue := func(iss string) {
+			addIssue(fmt.Sprintf("modules[%v]: %v", i, iss))
+		}
+		seen := map[string]bool{}
+		for _, p := range m.Packages {
+			if p.Package == "" {
+				continue // reported by lintStdLib or lintThirdParty
+			}
+			if seen[p.Package] {
+				addPkgIssue(fmt.Sprintf("package %q is listed more than once", p.Package))
+			}
+			seen[p.Package] = true
+			switch m.Module {
+			case stdlib.ModulePath, "cmd":
+				// The toolchain also has packages outside cmd, like
+				// misc/wasm, but all are in the Go repo.
+				if !stdlib.Contains(p.Package) {
+					addPkgIssue(fmt.Sprintf("%q is not a package of the Go distribution", p.Package))
+				}
+			case "":
+				// Reported by lintThirdParty.
+			default:
+				if !inModule(p.Package, m.Module) {
+					addPkgIssue("module must be a prefix of package")
+					continue
+				}
+				if mod := innermostModule(p.Package, r.Modules); mod != m.Module {
+					addPkgIssue(fmt.Sprintf("package %q is in module %q, not %q", p.Package, mod, m.Module))
+					continue
+				}
+				rest := strings.TrimPrefix(strings.TrimPrefix(p.Package, m.Module), "/")
+				first, _, _ := strings.Cut(rest, "/")
+				if _, major, ok := module.SplitPathVersion(m.Module + "/" + first); ok && major != "" {
+					addPkgIssue(fmt.Sprintf("package %q is in module %q, not %q", p.Package, m.Module+major, m.Module))
+				}
+			}
+		}
+	}
+}
+
+// lintAlternatePaths checks that the alternate paths of the modules of r are
+// valid module paths, and are not the path of another module of the report,
+// or alternate paths of another module. The standard library and the Go
+// toolchain have no alternate paths.
+func (r *Report) lintAlternatePaths(addIssue func(string)) {
+	modulePaths := map[string]bool{}
+	for _, m := range r.Modules {
+		modulePaths[m.Module] = true
+	}
+	owners := map[string]string{} // from alternate path to module path
+	for i, m := range r.Modules {
+		addPkgIssue := func(iss string) {
+			addIssue(fmt.Sprintf("modules[%v]: %v", i, iss))
+		}
+		if len(m.AlternatePaths) > 0 && (m.Module == stdlib.ModulePath || m.Module == "cmd") {
+			addPkgIssue(fmt.Sprintf("module %q can't have alternate paths", m.Module))
+			continue
+		}
+		for _, alt := range m.AlternatePaths {
+			if err := module.CheckPath(alt); err != nil {
+				addPkgIssue(fmt.Sprintf("alternate path: %v", err))
+				continue
+			}
+			if modulePaths[alt] {
+				addPkgIssue(fmt.Sprintf("alternate path %q is the path of a module of the report", alt))
+				continue
+			}
+			if owner, ok := owners[alt]; ok && owner != m.Module {
+				addPkgIssue(fmt.Sprintf("alternate path %q is also an alternate path of %q", alt, owner))
+			}
+			owners[alt] = m.Module
+		}
+	}
+}
+
+// inModule reports whether the import path pkg is in the path of the module
+// with path mod.
+func inModule(pkg, mod string) bool {
+	_, ok := cutModulePath(pkg, mod)
+	return ok
+}
+
+// innermostModule returns the longest path of modules that pkg is in.
+func innermostModule(pkg string, modules []*Module) string {
+	var innermost string
+	for _, m := range modules {
+		if inModule(pkg, m.Module) && len(m.Module) > len(innermost) {
+			innermost = m.Module
+		}
+	}
+	return innermost
+}
+
 func (m *Module) lintVersions(addPkgIssue func(string)) {
 	if m.VulnerableAt != "" && !m.VulnerableAt.IsValid() {
 		addPkgIssue(fmt.Sprintf("invalid vulnerable_at semantic version: %q", m.VulnerableAt))
 	}
+	lintCanonical := func(v Version) {
+		if c := canonicalVersion(v); v.IsValid() && c != v {
+			addPkgIssue(fmt.Sprintf("version %q is not canonical (use %q)", v, c))
+		}
+	}
+	lintCanonical(m.VulnerableAt)
+	lintCommit := func(field string, v Version, commit string) {
+		if commit == "" {
+			return
+		}
+		switch {
+		case m.Module == stdlib.ModulePath || m.Module == "cmd":
+			addPkgIssue(fmt.Sprintf("%s_commit: the Go distribution is versioned by its releases, not by commits", field))
+		case !commitHashRegex.MatchString(commit):
+			addPkgIssue(fmt.Sprintf("%s_commit %q is not a commit hash", field, commit))
+		case v == "":
+			addPkgIssue(fmt.Sprintf("%s_commit %s has no %s version (run vulnreport fix)", field, commit, field))
+		case isPseudoVersion(v.V()):
+			rev, err := module.PseudoVersionRev(v.V())
+			if err == nil && !strings.HasPrefix(commit, rev) && !strings.HasPrefix(rev, commit) {
+				addPkgIssue(fmt.Sprintf("%s version %q is not at %s_commit %s", field, v, field, commit))
+			}
+		}
+	}
 	for i, vr := range m.Versions {
+		lintCommit("introduced", vr.Introduced, vr.IntroducedCommit)
+		lintCommit("fixed", vr.Fixed, vr.FixedCommit)
 		for _, v := range []Version{vr.Introduced, vr.Fixed} {
 			if v != "" && !v.IsValid() {
 				addPkgIssue(fmt.Sprintf("invalid semantic version: %q", v))
 			}
+			lintCanonical(v)
 		}
 		if vr.Fixed != "" && !vr.Introduced.Before(vr.Fixed) {
 			addPkgIssue(
@@ -221,13 +396,34 @@ func (m *Module) lintVersions(addPkgIssue func(string)) {
 		// this one.
 		for _, vrPrev := range m.Versions[:i] {
 			if vrPrev.Introduced.Before(vr.Fixed) && vr.Introduced.Before(vrPrev.Fixed) {
-				addPkgIssue(fmt.Sprintf("version ranges overlap: [%v,%v), [%v,%v)", vr.Introduced, vr.Fixed, vr.Introduced, vrPrev.Fixed))
+				addPkgIssue(fmt.Sprintf("version ranges overlap: [%v,%v), [%v,%v)", vr.Introduced, vr.Fixed, vrPrev.Introduced, vrPrev.Fixed))
 			}
 		}
 	}
+	// The versions become a sequence of OSV range events, which must be in
+	// increasing order.
+	var prev Version
+	for _, vr := range m.Versions {
+		if vr.Fixed != "" && !vr.Introduced.Before(vr.Fixed) {
+			continue // already reported
+		}
+		for _, v := range []Version{vr.Introduced, vr.Fixed} {
+			if v == "" || !v.IsValid() {
+				continue
+			}
+			if prev != "" && !prev.Before(v) {
+				addPkgIssue(fmt.Sprintf("versions out of order: %q after %q", v, prev))
+			}
+			prev = v
+		}
+	}
 }
 
-var cveRegex = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+var (
+	cveRegex    = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+	ghsaIDRegex = regexp.MustCompile(`^GHSA-[0-9a-z]{4}-[0-9a-z]{4}-[0-9a-z]{4}$`)
+	goIDRegex   = regexp.MustCompile(`^GO-\d{4}-\d{4,}$`)
+)
 
 func (r *Report) lintCVEs(addIssue func(string)) {
 	if len(r.CVEs) > 0 && r.CVEMetadata != nil && r.CVEMetadata.ID != "" {
@@ -240,6 +436,11 @@ func (r *Report) lintCVEs(addIssue func(string)) {
 			addIssue("malformed cve identifier")
 		}
 	}
+	for _, ghsa := range r.GHSAs {
+		if !ghsaIDRegex.MatchString(ghsa) {
+			addIssue(fmt.Sprintf("malformed ghsa identifier %q", ghsa))
+		}
+	}
 
 	if r.CVEMetadata != nil {
 		if r.CVEMetadata.ID == "" {
@@ -253,6 +454,190 @@ func (r *Report) lintCVEs(addIssue func(string)) {
 	}
 }
 
+// lintCVSS checks that each CVSS vector is valid, and is from a different
+// CVE or GHSA of the report.
+func (r *Report) lintCVSS(addIssue func(string)) {
+	sources := map[string]bool{}
+	for _, id := range append(append([]string{}, r.CVEs...), r.GHSAs...) {
+		sources[id] = true
+	}
+	if r.CVEMetadata != nil && r.CVEMetadata.ID != "" {
+		sources[r.CVEMetadata.ID] = true
+	}
+	seen := map[string]bool{}
+	for i, c := range r.CVSS {
+		if _, err := cvss.Parse(c.Vector); err != nil {
+			addIssue(fmt.Sprintf("cvss[%d]: %v", i, err))
+		}
+		switch {
+		case c.Source == "":
+			addIssue(fmt.Sprintf("cvss[%d]: source is required", i))
+		case !sources[c.Source]:
+			addIssue(fmt.Sprintf("cvss[%d]: source %q is not a CVE or GHSA of the report", i, c.Source))
+		case seen[c.Source]:
+			addIssue(fmt.Sprintf("cvss[%d]: more than one vector from %s", i, c.Source))
+		}
+		seen[c.Source] = true
+	}
+}
+
+// lintCredits checks that each credit has a name without email addresses or
+// URLs, contacts that are URLs or email addresses, and a type defined in OSV.
+func (r *Report) lintCredits(addIssue func(string)) {
+	for i, c := range r.Credits {
+		if c.Name == "" {
+			addIssue(fmt.Sprintf("credits[%d]: name is required", i))
+		}
+		if contactRegexp.MatchString(c.Name) {
+			addIssue(fmt.Sprintf("credits[%d]: name has an email address or URL (move it to contact)", i))
+		}
+		for _, contact := range c.Contact {
+			if !isURLOrEmail(contact) {
+				addIssue(fmt.Sprintf("credits[%d]: contact %q is not a URL or email address", i, contact))
+			}
+		}
+		if c.Type != "" && !c.Type.IsValid() {
+			addIssue(fmt.Sprintf("credits[%d]: type %q is not one of %v", i, c.Type, CreditTypes))
+		}
+	}
+}
+
+// isURLOrEmail reports whether s is an absolute http, https or mailto URL,
+// or an email address.
+func isURLOrEmail(s string) bool {
+	if u, err := url.Parse(s); err == nil {
+		switch u.Scheme {
+		case "http", "https":
+			return u.Host != ""
+		case "mailto":
+			return u.Opaque != ""
+		}
+	}
+	a, err := mail.ParseAddress(s)
+	return err == nil && a.Address == s
+}
+
+// lintRelated checks the IDs of the reports that r supersedes, was split
+// from, or is related to: that each is the ID of another report, listed
+// once, and that each report that r supersedes is withdrawn. The other
+// reports are looked up next to filename, if it is in the reports or
+// excluded directory.
+func (r *Report) lintRelated(filename string, addIssue func(string)) {
+	id := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	dataDir := ""
+	switch filepath.Base(filepath.Dir(filename)) {
+	case "reports", "excluded":
+		dataDir = filepath.Dir(filepath.Dir(filename))
+	}
+	seen := map[string]bool{}
+	check := func(field, other string) *Report {
+		switch {
+		case !goIDRegex.MatchString(other):
+			addIssue(fmt.Sprintf("%s: malformed report ID %q", field, other))
+			return nil
+		case other == id:
+			addIssue(fmt.Sprintf("%s: %s is this report", field, other))
+			return nil
+		case seen[other]:
+			addIssue(fmt.Sprintf("%s: %s is listed more than once", field, other))
+			return nil
+		}
+		seen[other] = true
+		if dataDir == "" {
+			return nil
+		}
+		for _, dir := range []string{"reports", "excluded"} {
+			or, err := Read(filepath.Join(dataDir, dir, other+".yaml"))
+			if err == nil {
+				return or
+			}
+			if !errors.Is(err, fs.ErrNotExist) {
+				addIssue(fmt.Sprintf("%s: %v", field, err))
+				return nil
+			}
+		}
+		addIssue(fmt.Sprintf("%s: no report %s", field, other))
+		return nil
+	}
+	for _, other := range r.Supersedes {
+		if or := check("supersedes", other); or != nil && or.Withdrawn == nil {
+			addIssue(fmt.Sprintf("supersedes: %s is not withdrawn", other))
+		}
+	}
+	if r.SplitFrom != "" {
+		check("split_from", r.SplitFrom)
+	}
+	for _, other := range r.Related {
+		check("related", other)
+	}
+}
+
+// lintExcluded checks that an excluded report has only the fields that
+// excluded reports may have: the reason, the CVEs and GHSAs it covers, and
+// the paths of the modules they were found in.
+func (r *Report) lintExcluded(addIssue func(string)) {
+	if len(r.CVEs) == 0 && len(r.GHSAs) == 0 {
+		addIssue("excluded report must have at least one associated CVE or GHSA")
+	}
+	notAllowed := func(field string) {
+		addIssue(fmt.Sprintf("excluded report must not have %s", field))
+	}
+	if r.DoNotExport {
+		notAllowed("do_not_export")
+	}
+	for i, m := range r.Modules {
+		if len(m.Versions) > 0 || m.VulnerableAt != "" || len(m.Packages) > 0 {
+			notAllowed(fmt.Sprintf("modules[%d] fields other than module", i))
+		}
+	}
+	if r.Description != "" {
+		notAllowed("description")
+	}
+	if !r.Published.IsZero() {
+		notAllowed("published")
+	}
+	if r.Withdrawn != nil {
+		notAllowed("withdrawn")
+	}
+	if !r.Embargo.IsZero() {
+		notAllowed("embargo")
+	}
+	if len(r.Credits) > 0 {
+		notAllowed("credits")
+	}
+	if len(r.References) > 0 {
+		notAllowed("references")
+	}
+	if len(r.CVSS) > 0 {
+		notAllowed("cvss")
+	}
+	if r.CVEMetadata != nil {
+		notAllowed("cve_metadata")
+	}
+}
+
+func (r *Report) lintWithdrawn(addIssue func(string)) {
+	if r.Withdrawn == nil {
+		return
+	}
+	if r.Withdrawn.Date.IsZero() {
+		addIssue("withdrawn.date is required")
+	} else if r.Withdrawn.Date.Before(r.Published) {
+		addIssue("withdrawn.date is before published")
+	}
+	if r.Withdrawn.Reason == "" {
+		addIssue("withdrawn.reason is required")
+	}
+}
+
+// lintEmbargo checks that a report with an embargo was not published
+// before the embargo ended.
+func (r *Report) lintEmbargo(addIssue func(string)) {
+	if !r.Embargo.IsZero() && !r.Published.IsZero() && r.Published.Before(r.Embargo) {
+		addIssue("published is before embargo")
+	}
+}
+
 func (r *Report) lintLineLength(field, content string, addIssue func(string)) {
 	const maxLineLength = 100
 	for _, line := range strings.Split(content, "\n") {
@@ -273,10 +658,6 @@ var (
 	commitRegex   = regexp.MustCompile(`https://go.googlesource.com/[^/]+/\+/([^/]+)`)
 	issueRegex    = regexp.MustCompile(`https://go.dev/issue/\d+`)
 	announceRegex = regexp.MustCompile(`https://groups.google.com/g/golang-(announce|dev|nuts)/c/([^/]+)`)
-
-	nistRegex  = regexp.MustCompile(`^https://nvd.nist.gov/vuln/detail/(CVE-.*)$`)
-	ghsaRegex  = regexp.MustCompile(`^https://github.com/.*/(GHSA-[^/]+)$`)
-	mitreRegex = regexp.MustCompile(`^https://cve.mitre.org/.*(CVE-[\d\-]+)$`)
 )
 
 // Checks that the "links" section of a Report for a package in the
@@ -322,6 +703,8 @@ func (r *Report) lintStdLibLinks(addIssue func(string)) {
 
 func (r *Report) lintLinks(addIssue func(string)) {
 	advisoryCount := 0
+	// The first reference with each canonical URL.
+	seen := map[string]string{}
 	for _, ref := range r.References {
 		if !slices.Contains(ReferenceTypes, ref.Type) {
 			addIssue(fmt.Sprintf("%q is not a valid reference type", ref.Type))
@@ -333,6 +716,12 @@ func (r *Report) lintLinks(addIssue func(string)) {
 		if fixed := fixURL(l); fixed != l {
 			addIssue(fmt.Sprintf("unfixed url: %q should be %q", l, fixURL(l)))
 		}
+		c := refurl.Canonical(l)
+		if first, ok := seen[c]; ok {
+			addIssue(fmt.Sprintf("%q is the same reference as %q", l, first))
+		} else {
+			seen[c] = l
+		}
 		if ref.Type == ReferenceTypeAdvisory {
 			advisoryCount++
 		}
@@ -345,12 +734,9 @@ func (r *Report) lintLinks(addIssue func(string)) {
 			//
 			// A reference to a CVE/GHSA that appears in the CVEs/GHSAs
 			// aliases is redundant.
-			for _, re := range []*regexp.Regexp{nistRegex, mitreRegex, ghsaRegex} {
-				if m := re.FindStringSubmatch(ref.URL); len(m) > 0 {
-					id := m[1]
-					if slices.Contains(r.CVEs, id) || slices.Contains(r.GHSAs, id) {
-						addIssue(fmt.Sprintf("redundant non-advisory reference to %v", id))
-					}
+			if id := refurl.AdvisoryID(ref.URL); id != "" {
+				if slices.Contains(r.CVEs, id) || slices.Contains(r.GHSAs, id) {
+					addIssue(fmt.Sprintf("redundant non-advisory reference to %v", id))
 				}
 			}
 		}
@@ -360,6 +746,11 @@ func (r *Report) lintLinks(addIssue func(string)) {
 	}
 }
 
+// DraftMarker starts a description that a language model drafted. Lint
+// reports a description that contains it, so that no draft is committed
+// before a person has reviewed it and deleted the marker.
+const DraftMarker = "MACHINE-DRAFTED: review this description, then delete this line."
+
 // Lint checks the content of a Report and outputs a list of strings
 // representing lint errors.
 // TODO: It might make sense to include warnings or informational things
@@ -385,12 +776,10 @@ func (r *Report) Lint(filename string) []string {
 	case "excluded":
 		if r.Excluded == "" {
 			addIssue("report in excluded/ must have excluded set")
-		} else if !slices.Contains(ExcludedReasons, r.Excluded) {
+		} else if !r.Excluded.IsValid() {
 			addIssue(fmt.Sprintf("excluded (%q) is not in set %v", r.Excluded, ExcludedReasons))
 		}
-		if len(r.CVEs) == 0 && len(r.GHSAs) == 0 {
-			addIssue("excluded report must have at least one associated CVE or GHSA")
-		}
+		r.lintExcluded(addIssue)
 	}
 
 	isStdLibReport := false
@@ -413,12 +802,22 @@ func (r *Report) Lint(filename string) []string {
 
 		m.lintVersions(addPkgIssue)
 	}
+	r.lintPackages(addIssue)
+	r.lintAlternatePaths(addIssue)
 
 	r.lintLineLength("description", r.Description, addIssue)
+	if strings.Contains(r.Description, DraftMarker) {
+		addIssue("description is machine-drafted and has not been reviewed")
+	}
 	if r.CVEMetadata != nil {
 		r.lintLineLength("cve_metadata.description", r.CVEMetadata.Description, addIssue)
 	}
 	r.lintCVEs(addIssue)
+	r.lintWithdrawn(addIssue)
+	r.lintEmbargo(addIssue)
+	r.lintCVSS(addIssue)
+	r.lintCredits(addIssue)
+	r.lintRelated(filename, addIssue)
 
 	r.lintLinks(addIssue)
 	if isStdLibReport {
@@ -428,39 +827,63 @@ func (r *Report) Lint(filename string) []string {
 	return issues
 }
 
-var commitHashRegex = regexp.MustCompile(`^[a-f0-9]+$`)
+// commitHashRegex matches full and abbreviated git commit hashes.
+var commitHashRegex = regexp.MustCompile(`^[a-f0-9]{7,40}$`)
+
+// canonicalVersion returns the canonical form of v, like "1.2.0" for "1.2",
+// keeping any build metadata. It returns v unchanged if it is not valid.
+func canonicalVersion(v Version) Version {
+	if !v.IsValid() {
+		return v
+	}
+	return Version(v.Canonical() + semver.Build(v.V()))
+}
 
 func (r *Report) Fix() {
+	// Fix the references, and remove those that are the same as earlier
+	// ones.
+	seen := map[string]bool{}
+	refs := r.References[:0]
 	for _, ref := range r.References {
 		ref.URL = fixURL(ref.URL)
+		if c := refurl.Canonical(ref.URL); !seen[c] {
+			seen[c] = true
+			refs = append(refs, ref)
+		}
 	}
-	fixVersion := func(mod string, vp *Version) {
+	r.References = refs
+	fixVersion := func(vp *Version) {
 		v := *vp
 		if v == "" {
 			return
 		}
-		if commitHashRegex.MatchString(string(v)) {
-			if c, err := getCanonicalModVersionFromProxy(mod, string(v)); err == nil {
-				v = Version(c)
-			}
-		}
 		v = Version(strings.TrimPrefix(string(v), "v"))
 		v = Version(strings.TrimPrefix(string(v), "go"))
-		if v.IsValid() {
-			build := semver.Build(v.V())
-			v = Version(v.Canonical())
-			if build != "" {
-				v += Version(build)
-			}
+		*vp = canonicalVersion(v)
+	}
+	// fixCommit moves a commit hash written as a version to the commit
+	// field, and sets the version of a commit to its pseudo-version, or
+	// to its tagged version if it has one.
+	fixCommit := func(mod string, vp *Version, cp *string) {
+		if commitHashRegex.MatchString(string(*vp)) && *cp == "" {
+			*vp, *cp = "", string(*vp)
+		}
+		if *cp == "" || *vp != "" || mod == stdlib.ModulePath || mod == "cmd" {
+			return
+		}
+		if v, err := getCanonicalModVersionFromProxy(mod, *cp); err == nil {
+			*vp = Version(v)
 		}
-		*vp = v
 	}
 	for _, m := range r.Modules {
 		for i := range m.Versions {
-			fixVersion(m.Module, &m.Versions[i].Introduced)
-			fixVersion(m.Module, &m.Versions[i].Fixed)
+			vr := &m.Versions[i]
+			fixCommit(m.Module, &vr.Introduced, &vr.IntroducedCommit)
+			fixCommit(m.Module, &vr.Fixed, &vr.FixedCommit)
+			fixVersion(&vr.Introduced)
+			fixVersion(&vr.Fixed)
 		}
-		fixVersion(m.Module, &m.VulnerableAt)
+		fixVersion(&m.VulnerableAt)
 	}
 }
 
@@ -6,12 +6,17 @@ package report
 
 import (
 	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
-// TODO: Add tests for helper functions that call the proxy.
-
 var (
 	validStdLibReferences = []*Reference{
 		{Type: ReferenceTypeFix, URL: "https://go.dev/cl/12345"},
@@ -75,6 +80,20 @@ func TestLint(t *testing.T) {
 			},
 			want: []string{"missing description"},
 		},
+		{
+			desc: "unreviewed draft description",
+			report: Report{
+				Modules: []*Module{{
+					Module: "std",
+					Packages: []*Package{{
+						Package: "time",
+					}},
+				}},
+				Description: DraftMarker + "\n\nA drafted description.",
+				References:  validStdLibReferences,
+			},
+			want: []string{"description is machine-drafted and has not been reviewed"},
+		},
 		{
 			desc: "missing package path",
 			report: Report{
@@ -172,7 +191,7 @@ func TestLint(t *testing.T) {
 				Modules: []*Module{{
 					Module: "std",
 					Versions: []VersionRange{{
-						Introduced: "1.3",
+						Introduced: "1.3.0",
 						Fixed:      "1.2.1",
 					}},
 					Packages: []*Package{{
@@ -182,7 +201,7 @@ func TestLint(t *testing.T) {
 				Description: "description",
 				References:  validStdLibReferences,
 			},
-			want: []string{`version "1.3" >= "1.2.1"`},
+			want: []string{`version "1.3.0" >= "1.2.1"`},
 		},
 		{
 			desc: "invalid semantic version",
@@ -216,6 +235,40 @@ func TestLint(t *testing.T) {
 			},
 			want: []string{"malformed cve identifier"},
 		},
+		{
+			desc: "bad ghsa identifier",
+			report: Report{
+				Modules: []*Module{{
+					Module: "std",
+					Packages: []*Package{{
+						Package: "time",
+					}},
+				}},
+				Description: "description",
+				GHSAs:       []string{"GHSA-abcd-1234"},
+				References:  validStdLibReferences,
+			},
+			want: []string{`malformed ghsa identifier "GHSA-abcd-1234"`},
+		},
+		{
+			desc: "versions out of order",
+			report: Report{
+				Modules: []*Module{{
+					Module: "std",
+					Versions: []VersionRange{{
+						Introduced: "1.3.0",
+					}, {
+						Fixed: "1.2.1",
+					}},
+					Packages: []*Package{{
+						Package: "time",
+					}},
+				}},
+				Description: "description",
+				References:  validStdLibReferences,
+			},
+			want: []string{`versions out of order: "1.2.1" after "1.3.0"`},
+		},
 		{
 			desc: "cve and cve metadata both present",
 			report: Report{
@@ -271,6 +324,72 @@ func TestLint(t *testing.T) {
 			},
 			want: []string{"malformed cve_metadata.id identifier"},
 		},
+		{
+			desc: "bad cvss vector",
+			report: Report{
+				Modules: []*Module{{
+					Module: "std",
+					Packages: []*Package{{
+						Package: "time",
+					}},
+				}},
+				Description: "description",
+				CVEs:        []string{"CVE-2022-1234"},
+				CVSS:        []*CVSS{{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H", Source: "CVE-2022-1234"}},
+				References:  validStdLibReferences,
+			},
+			want: []string{"cvss[0]: "},
+		},
+		{
+			desc: "bad cvss sources",
+			report: Report{
+				Modules: []*Module{{
+					Module: "std",
+					Packages: []*Package{{
+						Package: "time",
+					}},
+				}},
+				Description: "description",
+				CVEs:        []string{"CVE-2022-1234"},
+				CVSS: []*CVSS{
+					{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", Source: "CVE-2022-1234"},
+					{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:N", Source: "CVE-2022-1234"},
+					{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", Source: "GHSA-xxxx-yyyy-zzzz"},
+					{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"},
+				},
+				References: validStdLibReferences,
+			},
+			want: []string{
+				"cvss[1]: more than one vector from CVE-2022-1234",
+				`cvss[2]: source "GHSA-xxxx-yyyy-zzzz" is not a CVE or GHSA of the report`,
+				"cvss[3]: source is required",
+			},
+		},
+		{
+			desc: "bad credits",
+			report: Report{
+				Modules: []*Module{{
+					Module: "std",
+					Packages: []*Package{{
+						Package: "time",
+					}},
+				}},
+				Description: "description",
+				Credits: []*Credit{
+					{Name: "Jane Doe", Contact: []string{"jane@example.com", "https://example.com/jane"}, Type: CreditTypeFinder},
+					{Contact: []string{"mailto:joe@example.com"}},
+					{Name: "Joe", Contact: []string{"@joe"}, Type: "FOUND"},
+					{Name: "Ann (ann@example.com)"},
+				},
+				References: validStdLibReferences,
+			},
+			want: []string{
+				"credits[1]: name is required",
+				`credits[2]: contact "@joe" is not a URL or email address`,
+				`credits[2]: type "FOUND" is not one of`,
+				"credits[3]: name has an email address or URL",
+			},
+		},
 		{
 			desc: "invalid reference type",
 			report: Report{
@@ -329,6 +448,28 @@ func TestLint(t *testing.T) {
 				"redundant non-advisory reference to GHSA-0000-0000-0000",
 			},
 		},
+		{
+			desc: "duplicate links",
+			report: validXReport(func(r *Report) {
+				r.References = append(r.References, &Reference{
+					Type: "WEB",
+					URL:  "https://github.com/a/b/commit/abcdef0",
+				}, &Reference{
+					Type: "WEB",
+					URL:  "http://github.com/A/b/commits/abcdef0/",
+				}, &Reference{
+					Type: "WEB",
+					URL:  "https://example.com/page?utm_source=feed",
+				}, &Reference{
+					Type: "WEB",
+					URL:  "https://example.com/page",
+				})
+			}),
+			want: []string{
+				`"http://github.com/A/b/commits/abcdef0/" is the same reference as "https://github.com/a/b/commit/abcdef0"`,
+				`"https://example.com/page" is the same reference as "https://example.com/page?utm_source=feed"`,
+			},
+		},
 		{
 			desc: "unfixed links",
 			report: Report{
@@ -431,6 +572,36 @@ func TestLint(t *testing.T) {
 				CVEs:     []string{"CVE-2022-1234545"},
 			},
 		},
+		{
+			desc: "excluded with invalid reason",
+			dir:  "excluded",
+			report: Report{
+				Excluded: "NOT_INTERESTING",
+				GHSAs:    []string{"GHSA-xxxx-yyyy-zzzz"},
+			},
+			want: []string{
+				`excluded ("NOT_INTERESTING") is not in set`,
+			},
+		},
+		{
+			desc: "excluded with fields of advisories",
+			dir:  "excluded",
+			report: Report{
+				Excluded: ExcludedNotImportable,
+				CVEs:     []string{"CVE-2022-1234545"},
+				Modules: []*Module{{
+					Module:   "golang.org/x/vulndb",
+					Packages: []*Package{{Package: "golang.org/x/vulndb/cmd/vulnreport"}},
+				}},
+				Description: "description",
+				References:  []*Reference{{Type: ReferenceTypeWeb, URL: "https://example.com"}},
+			},
+			want: []string{
+				`excluded report must not have modules[0] fields other than module`,
+				`excluded report must not have description`,
+				`excluded report must not have references`,
+			},
+		},
 	} {
 		dir := test.dir
 		if dir == "" {
@@ -453,7 +624,7 @@ func TestLint(t *testing.T) {
 		}
 		if len(missing) > 0 {
 			var buf bytes.Buffer
-			if err := test.report.encode(&buf); err != nil {
+			if err := test.report.encode(&buf, nil); err != nil {
 				t.Error(err)
 			}
 			t.Errorf("TestLint(%q): missing expected lint errors in report:\n"+
@@ -479,7 +650,7 @@ func TestLint(t *testing.T) {
 			}
 			if len(unexpected) > 0 {
 				var buf bytes.Buffer
-				if err := test.report.encode(&buf); err != nil {
+				if err := test.report.encode(&buf, nil); err != nil {
 					t.Error(err)
 				}
 				t.Errorf("TestLint(%q): unexpected lint errors in report:\n"+
@@ -489,3 +660,361 @@ func TestLint(t *testing.T) {
 		}
 	}
 }
+
+func TestLintWithdrawn(t *testing.T) {
+	published := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		desc      string
+		withdrawn *Withdrawn
+		want      []string
+	}{
+		{"not withdrawn", nil, nil},
+		{"ok", &Withdrawn{Date: published.AddDate(0, 1, 0), Reason: "not a vulnerability"}, nil},
+		{"missing fields", &Withdrawn{}, []string{"withdrawn.date is required", "withdrawn.reason is required"}},
+		{"before published", &Withdrawn{Date: published.AddDate(0, -1, 0), Reason: "r"}, []string{"withdrawn.date is before published"}},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := validXReport(func(r *Report) {
+				r.Published = published
+				r.Withdrawn = test.withdrawn
+			})
+			var got []string
+			r.lintWithdrawn(func(iss string) { got = append(got, iss) })
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLintEmbargo(t *testing.T) {
+	embargo := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		desc      string
+		published time.Time
+		want      []string
+	}{
+		{"not published", time.Time{}, nil},
+		{"published after", embargo.AddDate(0, 0, 1), nil},
+		{"published before", embargo.AddDate(0, 0, -1), []string{"published is before embargo"}},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := validXReport(func(r *Report) {
+				r.Embargo = embargo
+				r.Published = test.published
+			})
+			var got []string
+			r.lintEmbargo(func(iss string) { got = append(got, iss) })
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLintPackages(t *testing.T) {
+	mod := func(path string, pkgs ...string) *Module {
+		m := &Module{Module: path}
+		for _, p := range pkgs {
+			m.Packages = append(m.Packages, &Package{Package: p})
+		}
+		return m
+	}
+	for _, test := range []struct {
+		desc    string
+		modules []*Module
+		want    []string
+	}{
+		{
+			desc: "ok",
+			modules: []*Module{
+				mod("example.com/a", "example.com/a", "example.com/a/b"),
+				mod("example.com/a/v2", "example.com/a/v2/b"),
+				mod("std", "net/http"),
+				mod("cmd", "cmd/go", "misc/wasm"),
+			},
+		},
+		{
+			desc:    "not a path prefix",
+			modules: []*Module{mod("example.com/a", "example.com/ab")},
+			want:    []string{"modules[0]: module must be a prefix of package"},
+		},
+		{
+			desc: "in another module of the report",
+			modules: []*Module{
+				mod("example.com/a", "example.com/a/v2/b"),
+				mod("example.com/a/v2"),
+			},
+			want: []string{`modules[0]: package "example.com/a/v2/b" is in module "example.com/a/v2", not "example.com/a"`},
+		},
+		{
+			desc:    "in a major version of the module",
+			modules: []*Module{mod("example.com/a", "example.com/a/v3/b")},
+			want:    []string{`modules[0]: package "example.com/a/v3/b" is in module "example.com/a/v3", not "example.com/a"`},
+		},
+		{
+			desc:    "listed twice",
+			modules: []*Module{mod("example.com/a", "example.com/a/b", "example.com/a/b")},
+			want:    []string{`modules[0]: package "example.com/a/b" is listed more than once`},
+		},
+		{
+			desc:    "not in the Go distribution",
+			modules: []*Module{mod("std", "golang.org/x/net/http2")},
+			want:    []string{`modules[0]: "golang.org/x/net/http2" is not a package of the Go distribution`},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := &Report{Modules: test.modules}
+			var got []string
+			r.lintPackages(func(iss string) { got = append(got, iss) })
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLintAlternatePaths(t *testing.T) {
+	for _, test := range []struct {
+		desc    string
+		modules []*Module
+		want    []string
+	}{
+		{
+			desc:    "ok",
+			modules: []*Module{{Module: "example.com/new", AlternatePaths: []string{"example.com/old", "vanity.example/m"}}},
+		},
+		{
+			desc:    "std",
+			modules: []*Module{{Module: "std", AlternatePaths: []string{"example.com/std"}}},
+			want:    []string{`modules[0]: module "std" can't have alternate paths`},
+		},
+		{
+			desc:    "invalid",
+			modules: []*Module{{Module: "example.com/new", AlternatePaths: []string{"example.com/old."}}},
+			want:    []string{`modules[0]: alternate path: malformed module path "example.com/old.": trailing dot in path element`},
+		},
+		{
+			desc: "path of a module of the report",
+			modules: []*Module{
+				{Module: "example.com/a", AlternatePaths: []string{"example.com/b"}},
+				{Module: "example.com/b"},
+			},
+			want: []string{`modules[0]: alternate path "example.com/b" is the path of a module of the report`},
+		},
+		{
+			desc: "alternate path of two modules",
+			modules: []*Module{
+				{Module: "example.com/a", AlternatePaths: []string{"example.com/old"}},
+				{Module: "example.com/b", AlternatePaths: []string{"example.com/old"}},
+			},
+			want: []string{`modules[1]: alternate path "example.com/old" is also an alternate path of "example.com/a"`},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := &Report{Modules: test.modules}
+			var got []string
+			r.lintAlternatePaths(func(iss string) { got = append(got, iss) })
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCheckModVersions(t *testing.T) {
+	// A proxy with three tagged versions of example.com/m.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/example.com/m/@v/list":
+			fmt.Fprint(w, "v1.0.0\nv1.1.0\nv1.2.0\n")
+		case strings.HasPrefix(r.URL.Path, "/example.com/m/@v/") && strings.HasSuffix(r.URL.Path, ".mod"):
+			fmt.Fprint(w, "module example.com/m\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	defer func(u string) { proxyURL = u }(proxyURL)
+	proxyURL = srv.URL
+
+	for _, test := range []struct {
+		desc string
+		vrs  []VersionRange
+		want string // substring of the error, or "" for none
+	}{
+		{"ok", []VersionRange{{Introduced: "1.1.0", Fixed: "1.2.0"}}, ""},
+		{"all versions", nil, ""},
+		{"unknown version", []VersionRange{{Fixed: "1.3.0"}}, "proxy unaware of version"},
+		{"before first tag", []VersionRange{{Fixed: "1.0.0"}}, ""},
+		{"no published version in range", []VersionRange{{Introduced: "1.2.0", Fixed: "1.1.0"}}, "no published version of example.com/m is in the affected ranges"},
+		{"pseudo-versions", []VersionRange{{
+			Introduced: "1.2.1-0.20220101000000-abcdefabcdef",
+			Fixed:      "1.2.1-0.20220201000000-abcdefabcdef",
+		}}, ""},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			err := checkModVersions("example.com/m", test.vrs)
+			if test.want == "" {
+				if err != nil {
+					t.Errorf("got %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got %v, want error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestLintCanonicalVersions(t *testing.T) {
+	m := &Module{
+		Module:       "std",
+		Versions:     []VersionRange{{Introduced: "1.18", Fixed: "1.18.1+incompatible"}},
+		VulnerableAt: "1.18.0",
+	}
+	var got []string
+	m.lintVersions(func(iss string) { got = append(got, iss) })
+	want := []string{`version "1.18" is not canonical (use "1.18.0")`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestLintCommits(t *testing.T) {
+	const hash = "0123456789abcdef0123456789abcdef01234567"
+	for _, test := range []struct {
+		desc string
+		m    *Module
+		want []string
+	}{
+		{
+			desc: "ok",
+			m: &Module{
+				Module:   "example.com/m",
+				Versions: []VersionRange{{Fixed: "1.2.1-0.20220101000000-0123456789ab", FixedCommit: hash}},
+			},
+		},
+		{
+			desc: "tagged",
+			m: &Module{
+				Module:   "example.com/m",
+				Versions: []VersionRange{{IntroducedCommit: hash[:7], Introduced: "1.1.0"}},
+			},
+		},
+		{
+			desc: "not resolved",
+			m: &Module{
+				Module:   "example.com/m",
+				Versions: []VersionRange{{FixedCommit: hash}},
+			},
+			want: []string{"fixed_commit " + hash + " has no fixed version (run vulnreport fix)"},
+		},
+		{
+			desc: "other commit",
+			m: &Module{
+				Module:   "example.com/m",
+				Versions: []VersionRange{{Fixed: "1.2.1-0.20220101000000-abcdefabcdef", FixedCommit: hash}},
+			},
+			want: []string{`fixed version "1.2.1-0.20220101000000-abcdefabcdef" is not at fixed_commit ` + hash},
+		},
+		{
+			desc: "not a hash",
+			m: &Module{
+				Module:   "example.com/m",
+				Versions: []VersionRange{{Fixed: "1.0.0", FixedCommit: "main"}},
+			},
+			want: []string{`fixed_commit "main" is not a commit hash`},
+		},
+		{
+			desc: "std",
+			m: &Module{
+				Module:   "std",
+				Versions: []VersionRange{{Fixed: "1.19.0", FixedCommit: hash}},
+			},
+			want: []string{"fixed_commit: the Go distribution is versioned by its releases, not by commits"},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			var got []string
+			test.m.lintVersions(func(iss string) { got = append(got, iss) })
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFixCommits(t *testing.T) {
+	const hash = "0123456789abcdef0123456789abcdef01234567"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/example.com/m/@v/"+hash+".info" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"Version": "v1.2.1-0.20220101000000-0123456789ab"}`)
+	}))
+	defer srv.Close()
+	defer func(u string) { proxyURL = u }(proxyURL)
+	proxyURL = srv.URL
+
+	r := &Report{
+		Modules: []*Module{{
+			Module: "example.com/m",
+			Versions: []VersionRange{
+				{Introduced: "v1.0"},
+				// A commit written as a version.
+				{Fixed: hash},
+			},
+		}, {
+			Module:   "example.com/unknown",
+			Versions: []VersionRange{{FixedCommit: hash}},
+		}},
+	}
+	r.Fix()
+	want := [][]VersionRange{
+		{
+			{Introduced: "1.0.0"},
+			{Fixed: "1.2.1-0.20220101000000-0123456789ab", FixedCommit: hash},
+		},
+		{{FixedCommit: hash}},
+	}
+	for i, m := range r.Modules {
+		if !reflect.DeepEqual(m.Versions, want[i]) {
+			t.Errorf("%s: got %+v, want %+v", m.Module, m.Versions, want[i])
+		}
+	}
+}
+
+func TestLintRelated(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "reports")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for id, r := range map[string]*Report{
+		"GO-2022-0001": {Description: "d", Withdrawn: &Withdrawn{Date: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		"GO-2022-0002": {Description: "d"},
+	} {
+		if err := r.Write(filepath.Join(dir, id+".yaml")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := &Report{
+		Supersedes: []string{"GO-2022-0001", "GO-2022-0002"},
+		SplitFrom:  "GO-2022-0009",
+		Related:    []string{"GO-2022-0003", "GO-22-1", "GO-2022-0001"},
+	}
+	var got []string
+	r.lintRelated(filepath.Join(dir, "GO-2022-0003.yaml"), func(iss string) { got = append(got, iss) })
+	want := []string{
+		"supersedes: GO-2022-0002 is not withdrawn",
+		"split_from: no report GO-2022-0009",
+		"related: GO-2022-0003 is this report",
+		`related: malformed report ID "GO-22-1"`,
+		"related: GO-2022-0001 is listed more than once",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+}
@@ -0,0 +1,363 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"gopkg.in/yaml.v3"
+)
+
+// CurrentSchemaVersion is the version of the schema of reports: the fields
+// of Report and their meaning. When a change to Report would break existing
+// reports, like renaming or restructuring a field, increment it and add a
+// migration to migrations; then run "vulnreport migrate" on all reports.
+const CurrentSchemaVersion = 3
+
+// schemaVersionField is the YAML key of Report.SchemaVersion.
+const schemaVersionField = "schema_version"
+
+// A migration upgrades the YAML mapping of a report from one schema version
+// to the next. It works on YAML nodes rather than on a Report, since the
+// report may have fields that Report no longer has.
+type migration func(m *yaml.Node) error
+
+// migrations[i] upgrades a report from schema version i to i+1. Version 0 is
+// the schema of reports from before they had versions.
+var migrations = []migration{
+	// Version 1 only added the schema_version field.
+	func(*yaml.Node) error { return nil },
+	// Version 2 replaced severity.cvss_v3 with cvss, a list of vectors
+	// with their sources.
+	migrateSeverity,
+	// Version 3 replaced the free text of credit with credits, a list of
+	// names with their contacts and parts.
+	migrateCredit,
+}
+
+// migrateSeverity moves the vector of the severity field to the cvss field.
+// The source of the vector is not recorded, so it is taken to be the CVE or
+// GHSA of the report if the report has only one; otherwise the triager must
+// fill it in, as lint requires.
+func migrateSeverity(m *yaml.Node) error {
+	_, sev := field(m, "severity")
+	if sev == nil {
+		return nil
+	}
+	removeField(m, "severity")
+	_, vec := field(sev, "cvss_v3")
+	if vec == nil || vec.Value == "" {
+		return nil
+	}
+	if k, _ := field(m, "cvss"); k != nil {
+		return fmt.Errorf("line %d: both severity and cvss", k.Line)
+	}
+	entry := &yaml.Node{
+		Kind: yaml.MappingNode,
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Value: "vector"},
+			{Kind: yaml.ScalarNode, Value: vec.Value},
+		},
+	}
+	if sources := advisoryIDs(m); len(sources) == 1 {
+		entry.Content = append(entry.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "source"},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: sources[0]})
+	}
+	m.Content = append(m.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Value: "cvss"},
+		&yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{entry}})
+	return nil
+}
+
+// advisoryIDs returns the distinct IDs in the cves, ghsas and cve_metadata
+// fields of the YAML mapping m of a report: the possible sources of its CVSS
+// vectors.
+func advisoryIDs(m *yaml.Node) []string {
+	var ids []string
+	add := func(id string) {
+		for _, x := range ids {
+			if x == id {
+				return
+			}
+		}
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	for _, key := range []string{"cves", "ghsas"} {
+		if _, seq := field(m, key); seq != nil && seq.Kind == yaml.SequenceNode {
+			for _, n := range seq.Content {
+				add(n.Value)
+			}
+		}
+	}
+	if _, meta := field(m, "cve_metadata"); meta != nil && meta.Kind == yaml.MappingNode {
+		if _, id := field(meta, "id"); id != nil {
+			add(id.Value)
+		}
+	}
+	return ids
+}
+
+// decode decodes a report in YAML format, upgrading it to the current schema
+// version if it is older. Fields that are not in Report are an error if
+// strict is true; otherwise decode returns a message for each.
+func decode(data []byte, strict bool) (_ *Report, unknown []string, err error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, fmt.Errorf("yaml.Unmarshal: %v", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, nil, errors.New("report is not a YAML mapping")
+	}
+	m := doc.Content[0]
+	version, err := schemaVersion(m)
+	if err != nil {
+		return nil, nil, err
+	}
+	if version > CurrentSchemaVersion {
+		return nil, nil, fmt.Errorf("schema version %d is newer than %d, the latest this program supports", version, CurrentSchemaVersion)
+	}
+	if version < CurrentSchemaVersion {
+		for v := version; v < CurrentSchemaVersion; v++ {
+			if err := migrations[v](m); err != nil {
+				return nil, nil, fmt.Errorf("migrating from schema version %d: %v", v, err)
+			}
+		}
+		setField(m, schemaVersionField, strconv.Itoa(CurrentSchemaVersion))
+		// Decode the upgraded report below, so that its fields are
+		// checked too.
+		data, err = yaml.Marshal(&doc)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d := yaml.NewDecoder(bytes.NewReader(data))
+	// Require that all fields in the file are in the struct.
+	// This corresponds to v2's UnmarshalStrict.
+	d.KnownFields(true)
+	var r Report
+	if err := d.Decode(&r); err != nil {
+		te, ok := err.(*yaml.TypeError)
+		if !ok {
+			return nil, nil, fmt.Errorf("yaml.Decode: %v", err)
+		}
+		msgs, unknown := annotateDecodeErrors(te)
+		if strict || len(unknown) < len(msgs) {
+			return nil, nil, fmt.Errorf("yaml.Decode: %v", &yaml.TypeError{Errors: msgs})
+		}
+		// The decoder keeps going after type errors, so r has every
+		// other field.
+		return &r, unknown, nil
+	}
+	return &r, nil, nil
+}
+
+// schemaVersion returns the schema version of the report with YAML mapping m.
+func schemaVersion(m *yaml.Node) (int, error) {
+	_, v := field(m, schemaVersionField)
+	if v == nil {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(v.Value)
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("line %d: bad %s %q", v.Line, schemaVersionField, v.Value)
+	}
+	return version, nil
+}
+
+// field returns the key and value nodes of the given key of YAML mapping m,
+// or nils if m does not have the key.
+func field(m *yaml.Node, key string) (k, v *yaml.Node) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i], m.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// setField sets the given key of YAML mapping m to a scalar value, adding
+// the key first if m does not have it.
+func setField(m *yaml.Node, key, value string) {
+	if _, v := field(m, key); v != nil {
+		*v = yaml.Node{Kind: yaml.ScalarNode, Value: value}
+		return
+	}
+	m.Content = append([]*yaml.Node{
+		{Kind: yaml.ScalarNode, Value: key},
+		{Kind: yaml.ScalarNode, Value: value},
+	}, m.Content...)
+}
+
+// migrateCredit splits the text of the credit field into the elements of
+// the credits field. The text is split into names at commas and at "and",
+// what each name was credited for (like "for reporting it") is dropped, and
+// email addresses and URLs become contacts. The splitting is a guess, so the triager
+// should check the result.
+func migrateCredit(m *yaml.Node) error {
+	_, credit := field(m, "credit")
+	if credit == nil {
+		return nil
+	}
+	removeField(m, "credit")
+	// The text may be wrapped over lines.
+	text := strings.Join(strings.Fields(credit.Value), " ")
+	if text == "" {
+		return nil
+	}
+	if k, _ := field(m, "credits"); k != nil {
+		return fmt.Errorf("line %d: both credit and credits", k.Line)
+	}
+	seq := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, c := range splitCredit(text) {
+		n := &yaml.Node{Kind: yaml.MappingNode}
+		if c.Name != "" {
+			n.Content = append(n.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "name"},
+				&yaml.Node{Kind: yaml.ScalarNode, Value: c.Name})
+		}
+		if len(c.Contact) > 0 {
+			contacts := &yaml.Node{Kind: yaml.SequenceNode}
+			for _, contact := range c.Contact {
+				contacts.Content = append(contacts.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: contact})
+			}
+			n.Content = append(n.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "contact"}, contacts)
+		}
+		seq.Content = append(seq.Content, n)
+	}
+	m.Content = append(m.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "credits"}, seq)
+	return nil
+}
+
+var (
+	contactRegexp       = regexp.MustCompile(`https?://[^\s()<>]+|[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+	emptyBracketsRegexp = regexp.MustCompile(`\(\s*\)|<\s*>`)
+	// creditedByRegexp matches the words that may come before a name, as
+	// in "discovered by OSS-Fuzz and reported by Jane Doe", or "the
+	// OSS-Fuzz project for discovering this issue and to Jane Doe".
+	creditedByRegexp = regexp.MustCompile(`^(?:(?:discovered|found|reported|fixed) by|to) `)
+)
+
+// splitCredit splits the free text of a credit, like "the OSS-Fuzz project
+// for discovering this issue and Jane Doe (jane@example.com) for reporting
+// it", into credits, like "the OSS-Fuzz project" and "Jane Doe" with contact
+// "jane@example.com". Commas and "and" in parentheses don't split the text.
+func splitCredit(text string) []*Credit {
+	var credits []*Credit
+	for _, part := range splitOutsideParens(text, ",") {
+		// Text after "for" in a part is what its name was credited for,
+		// and may itself have "and", as in "for finding and reporting
+		// it". A name after it starts with a capital letter or "the".
+		inFor := false
+		for _, name := range splitOutsideParens(part, " and ") {
+			name = strings.TrimSpace(name)
+			name = strings.TrimSpace(strings.TrimPrefix(name, "and "))
+			name = creditedByRegexp.ReplaceAllString(name, "")
+			if inFor && !startsName(name) {
+				continue
+			}
+			inFor = false
+			if before, _, ok := strings.Cut(name, " for "); ok {
+				name, inFor = before, true
+			}
+			c := &Credit{Contact: contactRegexp.FindAllString(name, -1)}
+			name = emptyBracketsRegexp.ReplaceAllString(contactRegexp.ReplaceAllString(name, ""), "")
+			c.Name = strings.Join(strings.Fields(name), " ")
+			if c.Name != "" || len(c.Contact) > 0 {
+				credits = append(credits, c)
+			}
+		}
+	}
+	return credits
+}
+
+// splitOutsideParens splits s at each sep that is not in parentheses.
+func splitOutsideParens(s, sep string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '(':
+			depth++
+		case s[i] == ')' && depth > 0:
+			depth--
+		case depth == 0 && strings.HasPrefix(s[i:], sep):
+			parts = append(parts, s[start:i])
+			start = i + len(sep)
+			i = start - 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// startsName reports whether s looks like the start of the name of a person
+// or organization, rather than of a phrase.
+func startsName(s string) bool {
+	if strings.HasPrefix(s, "the ") || strings.HasPrefix(s, "@") {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsUpper(r)
+}
+
+// removeField removes a key and its value from YAML mapping m, if m has the
+// key.
+func removeField(m *yaml.Node, key string) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			m.Content = append(m.Content[:i], m.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// renameField renames a key of YAML mapping m, for migrations. It does
+// nothing if m does not have the key.
+func renameField(m *yaml.Node, from, to string) error {
+	k, _ := field(m, from)
+	if k == nil {
+		return nil
+	}
+	if existing, _ := field(m, to); existing != nil {
+		return fmt.Errorf("line %d: both %s and %s", existing.Line, from, to)
+	}
+	k.Value = to
+	return nil
+}
+
+// Migrate upgrades the report in filename to the current schema version, and
+// rewrites it in canonical form, keeping its comments. It reports whether the
+// file changed.
+func Migrate(filename string) (changed bool, err error) {
+	defer derrors.Wrap(&err, "report.Migrate(%q)", filename)
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return false, err
+	}
+	r, _, err := decode(data, true)
+	if err != nil {
+		return false, err
+	}
+	var buf bytes.Buffer
+	if err := r.encode(&buf, data); err != nil {
+		return false, err
+	}
+	if bytes.Equal(buf.Bytes(), data) {
+		return false, nil
+	}
+	return true, os.WriteFile(filename, buf.Bytes(), 0644)
+}
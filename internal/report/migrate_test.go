@@ -0,0 +1,188 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestMigrations(t *testing.T) {
+	if len(migrations) != CurrentSchemaVersion {
+		t.Errorf("got %d migrations, want %d, one for each schema version", len(migrations), CurrentSchemaVersion)
+	}
+}
+
+func TestDecodeSchemaVersion(t *testing.T) {
+	for _, test := range []struct {
+		name, in string
+		wantErr  string
+	}{
+		{"unversioned", "description: d\n", ""},
+		{"version 1", "schema_version: 1\ndescription: d\n", ""},
+		{"version 2", "schema_version: 2\ndescription: d\n", ""},
+		{"current", "schema_version: 3\ndescription: d\n", ""},
+		{"newer", "schema_version: 4\ndescription: d\n", "newer than 3"},
+		{"bad", "schema_version: x\ndescription: d\n", `bad schema_version "x"`},
+		{"unknown field", "description: d\nfoo: bar\n", "not found"},
+		{"not a mapping", "- description: d\n", "not a YAML mapping"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			r, _, err := decode([]byte(test.in), true)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got %v, want error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.SchemaVersion != CurrentSchemaVersion || r.Description != "d" {
+				t.Errorf("got version %d, description %q", r.SchemaVersion, r.Description)
+			}
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "GO-2022-0001.yaml")
+	if err := os.WriteFile(filename, []byte("description: |\n    d\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []bool{true, false} {
+		changed, err := Migrate(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changed != want {
+			t.Errorf("migration %d: got changed %t, want %t", i, changed, want)
+		}
+	}
+	got, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "schema_version: 3\ndescription: |\n    d\n"; string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestMigrateSeverity(t *testing.T) {
+	const vector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+	for _, test := range []struct {
+		name, fields string
+		want         []*CVSS
+	}{
+		{
+			name: "no advisories",
+			want: []*CVSS{{Vector: vector}},
+		},
+		{
+			name:   "one CVE",
+			fields: "cves:\n    - CVE-2022-0001\n",
+			want:   []*CVSS{{Vector: vector, Source: "CVE-2022-0001"}},
+		},
+		{
+			name:   "CVE issued by the Go CNA",
+			fields: "cve_metadata:\n    id: CVE-2022-0001\n",
+			want:   []*CVSS{{Vector: vector, Source: "CVE-2022-0001"}},
+		},
+		{
+			name:   "CVE and GHSA",
+			fields: "cves:\n    - CVE-2022-0001\nghsas:\n    - GHSA-aaaa-bbbb-cccc\n",
+			want:   []*CVSS{{Vector: vector}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			in := "schema_version: 1\ndescription: d\n" + test.fields + "severity:\n    cvss_v3: " + vector + "\n"
+			r, _, err := decode([]byte(in), true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(r.CVSS, test.want) {
+				t.Errorf("got %+v, want %+v", r.CVSS, test.want)
+			}
+		})
+	}
+}
+
+func TestMigrateCredit(t *testing.T) {
+	const in = `schema_version: 2
+description: d
+credit: |
+    the OSS-Fuzz project for discovering this issue and
+    Jane Doe (jane@example.com) for reporting it
+`
+	r, _, err := decode([]byte(in), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Credit{
+		{Name: "the OSS-Fuzz project"},
+		{Name: "Jane Doe", Contact: []string{"jane@example.com"}},
+	}
+	if !reflect.DeepEqual(r.Credits, want) {
+		t.Errorf("got %+v, want %+v", r.Credits, want)
+	}
+}
+
+func TestSplitCredit(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want []*Credit
+	}{
+		{"Jane Doe", []*Credit{{Name: "Jane Doe"}}},
+		{
+			"Jane Doe, John Smith, and the Go team",
+			[]*Credit{{Name: "Jane Doe"}, {Name: "John Smith"}, {Name: "the Go team"}},
+		},
+		{
+			"Jane Doe for finding and reporting it and John Smith for the fix",
+			[]*Credit{{Name: "Jane Doe"}, {Name: "John Smith"}},
+		},
+		{
+			"Jane Doe <jane@example.com>, john@example.com",
+			[]*Credit{
+				{Name: "Jane Doe", Contact: []string{"jane@example.com"}},
+				{Contact: []string{"john@example.com"}},
+			},
+		},
+	} {
+		got := splitCredit(test.in)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("splitCredit(%q) = %+v, want %+v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestRenameField(t *testing.T) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte("a: 1\nb: 2\n"), &doc); err != nil {
+		t.Fatal(err)
+	}
+	m := doc.Content[0]
+	if err := renameField(m, "a", "c"); err != nil {
+		t.Fatal(err)
+	}
+	if err := renameField(m, "missing", "d"); err != nil {
+		t.Fatal(err)
+	}
+	if err := renameField(m, "b", "c"); err == nil {
+		t.Error("renaming to an existing field: got nil, want error")
+	}
+	out, err := yaml.Marshal(&doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(out), "c: 1\nb: 2\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// purlPrefix begins the package URLs of Go modules and packages.
+const purlPrefix = "pkg:golang/"
+
+// cmdModulePath is the module path that reports use for the Go toolchain.
+const cmdModulePath = "cmd"
+
+// The names of the standard library and the Go toolchain in package URLs,
+// which are the same as their names in the database.
+const (
+	stdPURLName       = "stdlib"
+	toolchainPURLName = "toolchain"
+)
+
+// ModulePURL returns the package URL (purl) of the module with the given
+// path, like "pkg:golang/golang.org/x/net". The standard library is
+// "pkg:golang/stdlib" and the Go toolchain is "pkg:golang/toolchain", as in
+// the database.
+func ModulePURL(modulePath string) string {
+	switch modulePath {
+	case stdlib.ModulePath:
+		return purlPrefix + stdPURLName
+	case cmdModulePath:
+		return purlPrefix + toolchainPURLName
+	}
+	return purlPrefix + modulePath
+}
+
+// PackagePURL returns the package URL of the Go package pkgPath in the module
+// modulePath: that of the module, with the package's directory in the module
+// as the subpath, like "pkg:golang/golang.org/x/net#http2".
+func PackagePURL(modulePath, pkgPath string) string {
+	purl := ModulePURL(modulePath)
+	sub := pkgPath
+	if modulePath != stdlib.ModulePath {
+		sub = strings.TrimPrefix(strings.TrimPrefix(pkgPath, modulePath), "/")
+	}
+	if sub == "" {
+		return purl
+	}
+	return purl + "#" + sub
+}
+
+// PURLs returns the package URLs of m and of each of its packages, without
+// duplicates.
+func (m *Module) PURLs() []string {
+	purls := []string{ModulePURL(m.Module)}
+	for _, p := range m.Packages {
+		if purl := PackagePURL(m.Module, p.Package); purl != purls[0] {
+			purls = append(purls, purl)
+		}
+	}
+	return purls
+}
+
+// PURLs returns the package URLs of the modules and packages that r affects,
+// including at the alternate paths of its modules.
+func (r *Report) PURLs() []string {
+	var purls []string
+	for _, m := range r.AllModules() {
+		purls = append(purls, m.PURLs()...)
+	}
+	return purls
+}
+
+// A PURL is a parsed package URL of a Go module or package.
+type PURL struct {
+	// Module is the module path, as in reports: "std" for the standard
+	// library and "cmd" for the Go toolchain.
+	Module string
+	// Version is the version of the module, like "v1.2.3", or empty if the
+	// package URL has none.
+	Version string
+	// Package is the import path of the package, or empty if the package URL
+	// names the whole module.
+	Package string
+}
+
+// ParsePURL parses a package URL of a Go module or package, like
+// "pkg:golang/golang.org/x/net@v0.1.0#http2". Qualifiers are ignored.
+func ParsePURL(s string) (_ *PURL, err error) {
+	defer derrors.Wrap(&err, "report.ParsePURL(%q)", s)
+
+	if !strings.HasPrefix(s, purlPrefix) {
+		return nil, fmt.Errorf("not a Go package URL (want prefix %q)", purlPrefix)
+	}
+	rest, sub, _ := strings.Cut(strings.TrimPrefix(s, purlPrefix), "#")
+	rest, _, _ = strings.Cut(rest, "?")
+	name, version, _ := strings.Cut(rest, "@")
+	if name, err = url.PathUnescape(name); err != nil {
+		return nil, err
+	}
+	if version, err = url.PathUnescape(version); err != nil {
+		return nil, err
+	}
+	if sub, err = url.PathUnescape(sub); err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, errors.New("missing module path")
+	}
+	p := &PURL{Module: name, Version: version}
+	switch name {
+	case stdPURLName:
+		p.Module = stdlib.ModulePath
+	case toolchainPURLName:
+		p.Module = cmdModulePath
+	}
+	sub = strings.Trim(sub, "/")
+	switch {
+	case sub == "":
+	case p.Module == stdlib.ModulePath:
+		p.Package = sub
+	default:
+		p.Package = p.Module + "/" + sub
+	}
+	return p, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPURLs(t *testing.T) {
+	r := &Report{
+		Modules: []*Module{
+			{
+				Module: "std",
+				Packages: []*Package{
+					{Package: "crypto/rand"},
+					{Package: "net/http"},
+				},
+			},
+			{
+				Module:   "cmd",
+				Packages: []*Package{{Package: "cmd/go"}},
+			},
+			{
+				Module: "golang.org/x/net",
+				Packages: []*Package{
+					{Package: "golang.org/x/net"},
+					{Package: "golang.org/x/net/http2"},
+				},
+			},
+		},
+	}
+	want := []string{
+		"pkg:golang/stdlib",
+		"pkg:golang/stdlib#crypto/rand",
+		"pkg:golang/stdlib#net/http",
+		"pkg:golang/toolchain",
+		"pkg:golang/toolchain#go",
+		"pkg:golang/golang.org/x/net",
+		"pkg:golang/golang.org/x/net#http2",
+	}
+	if diff := cmp.Diff(want, r.PURLs()); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestParsePURL(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want *PURL
+	}{
+		{"pkg:golang/golang.org/x/net", &PURL{Module: "golang.org/x/net"}},
+		{
+			"pkg:golang/golang.org/x/net@v0.1.0#http2",
+			&PURL{Module: "golang.org/x/net", Version: "v0.1.0", Package: "golang.org/x/net/http2"},
+		},
+		{
+			"pkg:golang/example.com/m@v1.0.0%2Bincompatible?type=module",
+			&PURL{Module: "example.com/m", Version: "v1.0.0+incompatible"},
+		},
+		{"pkg:golang/stdlib@v1.18.3#net/http", &PURL{Module: "std", Version: "v1.18.3", Package: "net/http"}},
+		{"pkg:golang/toolchain#go", &PURL{Module: "cmd", Package: "cmd/go"}},
+	} {
+		got, err := ParsePURL(test.in)
+		if err != nil {
+			t.Fatalf("%s: %v", test.in, err)
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%s: mismatch (-want, +got):\n%s", test.in, diff)
+		}
+	}
+	for _, in := range []string{"golang.org/x/net", "pkg:npm/left-pad", "pkg:golang/"} {
+		if _, err := ParsePURL(in); err == nil {
+			t.Errorf("%s: got nil, want error", in)
+		}
+	}
+}
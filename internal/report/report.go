@@ -7,8 +7,10 @@
 package report
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"strings"
 	"time"
@@ -42,9 +44,29 @@ func (v Version) Canonical() string {
 	return strings.TrimPrefix(semver.Canonical(v.V()), "v")
 }
 
+// Affects reports whether v is in one of the version ranges vrs. No ranges
+// means that every version is affected.
+func Affects(vrs []VersionRange, v Version) bool {
+	if len(vrs) == 0 {
+		return true
+	}
+	for _, vr := range vrs {
+		if (vr.Introduced == "" || !v.Before(vr.Introduced)) && (vr.Fixed == "" || v.Before(vr.Fixed)) {
+			return true
+		}
+	}
+	return false
+}
+
 type VersionRange struct {
 	Introduced Version `yaml:"introduced,omitempty"`
 	Fixed      Version `yaml:"fixed,omitempty"`
+	// IntroducedCommit and FixedCommit are the hashes of the commits that
+	// introduced and fixed the vulnerability, for a module that has no
+	// tagged version at them. "vulnreport fix" sets Introduced and Fixed
+	// to the pseudo-versions of the commits, which the OSV entry uses.
+	IntroducedCommit string `yaml:"introduced_commit,omitempty"`
+	FixedCommit      string `yaml:"fixed_commit,omitempty"`
 }
 
 type Module struct {
@@ -58,6 +80,43 @@ type Module struct {
 	// for packages without tagged versions, so we specify it manually here.
 	VulnerableAt Version    `yaml:"vulnerable_at,omitempty"`
 	Packages     []*Package `yaml:",omitempty"`
+	// AlternatePaths are other paths of the module, like its path before
+	// its repo was renamed, or a vanity import path, under which the same
+	// versions are vulnerable. The OSV entry of the report affects the
+	// module at each of them, so that scanners of programs that use an
+	// alternate path find the vulnerability.
+	AlternatePaths []string `yaml:"alternate_paths,omitempty"`
+}
+
+// AtPath returns a copy of m at another path of the module, like one of its
+// alternate paths: with path as its module path, and with the packages of m
+// under path instead of m.Module. The copy has no alternate paths.
+func (m *Module) AtPath(path string) *Module {
+	mc := *m
+	mc.Module = path
+	mc.AlternatePaths = nil
+	mc.Packages = nil
+	for _, p := range m.Packages {
+		pc := *p
+		if rest, ok := cutModulePath(p.Package, m.Module); ok {
+			pc.Package = path + rest
+		}
+		mc.Packages = append(mc.Packages, &pc)
+	}
+	return &mc
+}
+
+// cutModulePath returns the rest of the import path pkg after the module
+// path mod, which is empty or begins with a slash, and whether pkg is in
+// mod.
+func cutModulePath(pkg, mod string) (rest string, ok bool) {
+	if pkg == mod {
+		return "", true
+	}
+	if strings.HasPrefix(pkg, mod+"/") {
+		return pkg[len(mod):], true
+	}
+	return "", false
 }
 
 type Package struct {
@@ -89,6 +148,16 @@ type LegacyPackage struct {
 	VulnerableAt Version `yaml:"vulnerable_at,omitempty"`
 }
 
+// A CVSS is a score of the severity of a vulnerability by an upstream
+// source, like its CVE or GHSA.
+type CVSS struct {
+	// Vector is a CVSS v3.0 or v3.1 vector string, like
+	// "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H".
+	Vector string `yaml:",omitempty"`
+	// Source is the ID of the CVE or GHSA that the vector is from.
+	Source string `yaml:",omitempty"`
+}
+
 type CVEMeta struct {
 	ID          string `yaml:",omitempty"`
 	CWE         string `yaml:",omitempty"`
@@ -100,15 +169,97 @@ type CVEMeta struct {
 // It must be one of the values in ExcludedReasons.
 type ExcludedReason string
 
+// The reasons a report may be excluded from the database.
+const (
+	ExcludedNotImportable          ExcludedReason = "NOT_IMPORTABLE"
+	ExcludedNotGoCode              ExcludedReason = "NOT_GO_CODE"
+	ExcludedNotAVulnerability      ExcludedReason = "NOT_A_VULNERABILITY"
+	ExcludedEffectivelyPrivate     ExcludedReason = "EFFECTIVELY_PRIVATE"
+	ExcludedDependentVulnerability ExcludedReason = "DEPENDENT_VULNERABILITY"
+)
+
 // ExcludedReasons are the set of reasons a report may be excluded from the database.
 // These are described in detail at
 // https://go.googlesource.com/vulndb/+/refs/heads/master/doc/format.md.
 var ExcludedReasons = []ExcludedReason{
-	"NOT_IMPORTABLE",
-	"NOT_GO_CODE",
-	"NOT_A_VULNERABILITY",
-	"EFFECTIVELY_PRIVATE",
-	"DEPENDENT_VULNERABILITY",
+	ExcludedNotImportable,
+	ExcludedNotGoCode,
+	ExcludedNotAVulnerability,
+	ExcludedEffectivelyPrivate,
+	ExcludedDependentVulnerability,
+}
+
+// IsValid reports whether er is one of ExcludedReasons.
+func (er ExcludedReason) IsValid() bool {
+	for _, r := range ExcludedReasons {
+		if er == r {
+			return true
+		}
+	}
+	return false
+}
+
+// Withdrawn records that a report was withdrawn, for instance because the
+// vulnerability turned out not to be one. A withdrawn report stays in the
+// database, so that clients learn that they should stop reporting it.
+type Withdrawn struct {
+	// Date is when the report was withdrawn.
+	Date time.Time `yaml:",omitempty"`
+	// Reason explains why.
+	Reason string `yaml:",omitempty"`
+}
+
+// A Credit acknowledges a person or organization for their part in the
+// discovery or fix of a vulnerability.
+type Credit struct {
+	// Name is the name of the person or organization, like "Jane Doe",
+	// "@janedoe", or "the OSS-Fuzz project".
+	Name string `yaml:",omitempty"`
+	// Contact are URLs or email addresses to reach them.
+	Contact []string `yaml:",omitempty"`
+	// Type is their part.
+	Type CreditType `yaml:",omitempty"`
+}
+
+// CreditType is the part that a credited person or organization had.
+type CreditType string
+
+// The credit types defined in OSV.
+const (
+	CreditTypeFinder               = CreditType("FINDER")
+	CreditTypeReporter             = CreditType("REPORTER")
+	CreditTypeAnalyst              = CreditType("ANALYST")
+	CreditTypeCoordinator          = CreditType("COORDINATOR")
+	CreditTypeRemediationDeveloper = CreditType("REMEDIATION_DEVELOPER")
+	CreditTypeRemediationReviewer  = CreditType("REMEDIATION_REVIEWER")
+	CreditTypeRemediationVerifier  = CreditType("REMEDIATION_VERIFIER")
+	CreditTypeTool                 = CreditType("TOOL")
+	CreditTypeSponsor              = CreditType("SPONSOR")
+	CreditTypeOther                = CreditType("OTHER")
+)
+
+// CreditTypes is the set of credit types defined in OSV.
+var CreditTypes = []CreditType{
+	CreditTypeFinder,
+	CreditTypeReporter,
+	CreditTypeAnalyst,
+	CreditTypeCoordinator,
+	CreditTypeRemediationDeveloper,
+	CreditTypeRemediationReviewer,
+	CreditTypeRemediationVerifier,
+	CreditTypeTool,
+	CreditTypeSponsor,
+	CreditTypeOther,
+}
+
+// IsValid reports whether ct is one of CreditTypes.
+func (ct CreditType) IsValid() bool {
+	for _, t := range CreditTypes {
+		if ct == t {
+			return true
+		}
+	}
+	return false
 }
 
 // Reference type is a reference (link) type.
@@ -164,6 +315,11 @@ func (r *Reference) UnmarshalYAML(n *yaml.Node) (err error) {
 // Report represents a vulnerability report in the vulndb.
 // Remember to update doc/format.md when this structure changes.
 type Report struct {
+	// SchemaVersion is the version of the schema the report was written
+	// in. Reports are upgraded to CurrentSchemaVersion when read, and
+	// always written in it.
+	SchemaVersion int `yaml:"schema_version,omitempty"`
+
 	// TODO: could also be GoToolchain, but we might want
 	// this for other things?
 	//
@@ -180,7 +336,12 @@ type Report struct {
 	// assigning a CVE ID ourselves, use CVEMetadata.Description instead.
 	Description string     `yaml:",omitempty"`
 	Published   time.Time  `yaml:",omitempty"`
-	Withdrawn   *time.Time `yaml:",omitempty"`
+	Withdrawn   *Withdrawn `yaml:",omitempty"`
+
+	// Embargo is when the vulnerability may be disclosed. A report with an
+	// embargo is kept in a private overlay repo, and the database that
+	// gendb builds with the overlay leaves it out until then.
+	Embargo time.Time `yaml:",omitempty"`
 
 	// CVE are CVE IDs for existing CVEs.
 	// If we are assigning a CVE ID ourselves, use CVEMetdata.ID instead.
@@ -189,9 +350,23 @@ type Report struct {
 	// the above CVEs.
 	GHSAs []string `yaml:",omitempty"`
 
-	Credit     string       `yaml:",omitempty"`
+	Credits    []*Credit    `yaml:",omitempty"`
 	References []*Reference `yaml:",omitempty"`
 
+	// Supersedes are the IDs of the reports that this report replaces,
+	// which are withdrawn.
+	Supersedes []string `yaml:",omitempty"`
+	// SplitFrom is the ID of the report that this report was split from,
+	// like one of the reports for each module of an earlier report.
+	SplitFrom string `yaml:"split_from,omitempty"`
+	// Related are the IDs of other reports about related vulnerabilities,
+	// like the same bug in a fork.
+	Related []string `yaml:",omitempty"`
+
+	// CVSS are the severity scores of the upstream sources of the report.
+	// They are used to prioritize triage, and exported to the OSV severity.
+	CVSS []*CVSS `yaml:"cvss,omitempty"`
+
 	// CVEMetdata is used to capture CVE information when we want to assign a
 	// CVE ourselves. If a CVE already exists for an issue, use the CVE field
 	// to fill in the ID string.
@@ -206,6 +381,19 @@ func (r *Report) GetCVEs() []string {
 	return r.CVEs
 }
 
+// AllModules returns the modules of r, each followed by a copy of it at
+// each of its alternate paths.
+func (r *Report) AllModules() []*Module {
+	var mods []*Module
+	for _, m := range r.Modules {
+		mods = append(mods, m)
+		for _, path := range m.AlternatePaths {
+			mods = append(mods, m.AtPath(path))
+		}
+	}
+	return mods
+}
+
 // GetAliases returns all aliases (e.g., CVEs, GHSAs) for a report.
 func (r *Report) GetAliases() []string {
 	return append(r.GetCVEs(), r.GHSAs...)
@@ -222,33 +410,47 @@ func (a *Package) AllSymbols() []string {
 	return append(append([]string(nil), a.Symbols...), a.DerivedSymbols...)
 }
 
-// Read reads a Report in YAML format from filename.
+// Read reads a Report in YAML format from filename, upgrading it to the
+// current schema version if it is older.
 func Read(filename string) (_ *Report, err error) {
 	defer derrors.Wrap(&err, "report.Read(%q)", filename)
 
-	f, err := os.Open(filename)
+	data, err := os.ReadFile(filename)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
-	d := yaml.NewDecoder(f)
-	// Require that all fields in the file are in the struct.
-	// This corresponds to v2's UnmarshalStrict.
-	d.KnownFields(true)
-	var r Report
-	if err := d.Decode(&r); err != nil {
-		return nil, fmt.Errorf("yaml.Decode: %v", err)
+	r, _, err := decode(data, true)
+	return r, err
+}
+
+// ReadLenient reads a Report like Read, except that fields that are not in
+// Report are not an error. Instead, ReadLenient returns a message for each,
+// which suggests the field that was likely meant. It lets tools check
+// reports written for a newer version of Report, which they would otherwise
+// fail to read.
+func ReadLenient(filename string) (_ *Report, unknown []string, err error) {
+	defer derrors.Wrap(&err, "report.ReadLenient(%q)", filename)
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
 	}
-	return &r, nil
+	return decode(data, false)
 }
 
-// Write writes r to filename in YAML format.
+// Write writes r to filename in YAML format. If filename exists, the
+// comments in it are kept, so that rewriting a report only changes what
+// changed in r.
 func (r *Report) Write(filename string) (err error) {
+	old, err := os.ReadFile(filename)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
 	f, err := os.Create(filename)
 	if err != nil {
 		return err
 	}
-	err = r.encode(f)
+	err = r.encode(f, old)
 	err2 := f.Close()
 	if err == nil {
 		err = err2
@@ -259,15 +461,36 @@ func (r *Report) Write(filename string) (err error) {
 // ToString encodes r to a YAML string.
 func (r *Report) ToString() (string, error) {
 	var b strings.Builder
-	if err := r.encode(&b); err != nil {
+	if err := r.encode(&b, nil); err != nil {
 		return "", err
 	}
 	return b.String(), nil
 }
 
-func (r *Report) encode(w io.Writer) error {
+// encode writes r to w in YAML format, in the current schema version and
+// with the fields in the order of Report. If old is not nil, it is a
+// previous version of the report, whose comments are copied.
+func (r *Report) encode(w io.Writer, old []byte) error {
+	rc := *r
+	rc.SchemaVersion = CurrentSchemaVersion
+	// Encode through a yaml.Node, which can hold comments.
+	data, err := yaml.Marshal(&rc)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if old != nil {
+		var oldDoc yaml.Node
+		// A previous version that doesn't parse has no comments to keep.
+		if err := yaml.Unmarshal(old, &oldDoc); err == nil {
+			copyComments(&doc, &oldDoc)
+		}
+	}
 	e := yaml.NewEncoder(w)
 	defer e.Close()
 	e.SetIndent(4)
-	return e.Encode(r)
+	return e.Encode(&doc)
 }
@@ -45,3 +45,97 @@ func TestUnknownField(t *testing.T) {
 		t.Errorf("got %v, want error containing %q", err, want)
 	}
 }
+
+func TestUnknownFieldSuggestion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "GO-2022-0001.yaml")
+	const in = `modules:
+  - module: example.com/a
+    verions:
+      - fixed: 1.2.0
+credtis: c
+description: d
+`
+	if err := os.WriteFile(filename, []byte(in), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := Read(filename)
+	if err == nil {
+		t.Fatal("got nil, want error")
+	}
+	for _, want := range []string{
+		"field verions not found in type report.Module (did you mean versions?)",
+		"field credtis not found in type report.Report (did you mean credits?)",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+
+	r, unknown, err := ReadLenient(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unknown) != 2 {
+		t.Errorf("got unknown fields %q, want 2", unknown)
+	}
+	if r.Modules[0].Module != "example.com/a" || r.Description != "d" {
+		t.Errorf("got %+v, want the known fields decoded", r)
+	}
+
+	// Other errors are errors even for ReadLenient.
+	if err := os.WriteFile(filename, []byte("published: yesterday\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := ReadLenient(filename); err == nil {
+		t.Error("bad time: got nil, want error")
+	}
+}
+
+func TestAllModules(t *testing.T) {
+	m := &Module{
+		Module:         "example.com/new",
+		Versions:       []VersionRange{{Fixed: "1.2.0"}},
+		Packages:       []*Package{{Package: "example.com/new"}, {Package: "example.com/new/sub", Symbols: []string{"F"}}},
+		AlternatePaths: []string{"example.com/old"},
+	}
+	r := &Report{Modules: []*Module{m, {Module: "std", Packages: []*Package{{Package: "net/http"}}}}}
+	want := []*Module{
+		m,
+		{
+			Module:   "example.com/old",
+			Versions: []VersionRange{{Fixed: "1.2.0"}},
+			Packages: []*Package{{Package: "example.com/old"}, {Package: "example.com/old/sub", Symbols: []string{"F"}}},
+		},
+		r.Modules[1],
+	}
+	if diff := cmp.Diff(want, r.AllModules()); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	// The copy doesn't share packages with the original.
+	if m.Packages[1].Package != "example.com/new/sub" {
+		t.Errorf("AtPath changed the original package to %q", m.Packages[1].Package)
+	}
+}
+
+func TestAffects(t *testing.T) {
+	vrs := []VersionRange{{Fixed: "1.1.0"}, {Introduced: "1.2.0", Fixed: "1.2.3"}, {Introduced: "1.4.0"}}
+	for _, test := range []struct {
+		v    Version
+		want bool
+	}{
+		{"0.1.0", true},
+		{"1.1.0", false},
+		{"1.2.0", true},
+		{"1.2.3", false},
+		{"1.3.0", false},
+		{"1.4.0", true},
+		{"2.0.0", true},
+	} {
+		if got := Affects(vrs, test.v); got != test.want {
+			t.Errorf("Affects(%s) = %t, want %t", test.v, got, test.want)
+		}
+	}
+	if !Affects(nil, "1.0.0") {
+		t.Error("no ranges: got false, want true")
+	}
+}
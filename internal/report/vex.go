@@ -0,0 +1,199 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/openvex"
+)
+
+// vulnURLPrefix begins the URLs of the reports on pkg.go.dev.
+const vulnURLPrefix = "https://pkg.go.dev/vuln/"
+
+// ToVEX creates an OpenVEX document from a YAML report file.
+//
+// For each module of the report, the document says that the module is
+// affected at its vulnerable versions, and fixed at its fixed versions. The
+// affected product is the module's package URL with the vulnerable versions
+// as a "vers" qualifier. When the report limits the vulnerability to some
+// symbols or platforms, the notes of the affected statement say so; they
+// are not separate not_affected statements, since those would be about the
+// same product and override the affected one. The document of a withdrawn
+// report says that no module is affected.
+func ToVEX(reportPath string) (_ *openvex.Document, err error) {
+	defer derrors.Wrap(&err, "report.ToVEX(%q)", reportPath)
+
+	r, err := Read(reportPath)
+	if err != nil {
+		return nil, err
+	}
+	if lints := r.Lint(reportPath); len(lints) > 0 {
+		return nil, fmt.Errorf("report has outstanding lint errors:\n  %v", strings.Join(lints, "\n  "))
+	}
+	if r.Excluded != "" {
+		return nil, errors.New("excluded reports have no VEX statements")
+	}
+	id := strings.TrimSuffix(filepath.Base(reportPath), filepath.Ext(reportPath))
+	return r.toVEX(id)
+}
+
+func (r *Report) toVEX(id string) (*openvex.Document, error) {
+	doc := &openvex.Document{
+		Context:   openvex.Context,
+		ID:        vulnURLPrefix + id + "#vex",
+		Author:    "security@golang.org",
+		Timestamp: r.Published,
+		Version:   1,
+		Tooling:   "vulnreport",
+	}
+	if r.Withdrawn != nil {
+		doc.Timestamp = r.Withdrawn.Date
+	}
+	if doc.Timestamp.IsZero() {
+		return nil, errors.New("report has no published date (run vulnreport set-dates)")
+	}
+	vuln := openvex.Vulnerability{
+		ID:      vulnURLPrefix + id,
+		Name:    id,
+		Aliases: r.GetAliases(),
+	}
+
+	if r.Withdrawn != nil {
+		s := &openvex.Statement{
+			Vulnerability:   vuln,
+			Status:          openvex.StatusNotAffected,
+			Justification:   openvex.VulnerableCodeNotPresent,
+			ImpactStatement: "The report was withdrawn: " + r.Withdrawn.Reason,
+		}
+		for _, m := range r.AllModules() {
+			s.Products = append(s.Products, &openvex.Product{ID: ModulePURL(m.Module)})
+		}
+		doc.Statements = []*openvex.Statement{s}
+		return doc, nil
+	}
+
+	for _, m := range r.AllModules() {
+		purl := ModulePURL(m.Module)
+		product := &openvex.Product{ID: purl + versQualifier(m.Versions)}
+		notes := []string{"Affected versions: " + versionsText(m.Versions) + "."}
+		for _, p := range m.Packages {
+			product.Subcomponents = append(product.Subcomponents, &openvex.Subcomponent{ID: PackagePURL(m.Module, p.Package)})
+			if symbols := p.AllSymbols(); len(symbols) > 0 {
+				notes = append(notes, fmt.Sprintf("Only code that calls %s in package %s is affected.",
+					strings.Join(symbols, ", "), p.Package))
+			}
+			if len(p.GOOS) > 0 || len(p.GOARCH) > 0 {
+				notes = append(notes, fmt.Sprintf("Package %s is only affected when built for %s.",
+					p.Package, platformsText(p)))
+			}
+		}
+		doc.Statements = append(doc.Statements, &openvex.Statement{
+			Vulnerability:   vuln,
+			Products:        []*openvex.Product{product},
+			Status:          openvex.StatusAffected,
+			StatusNotes:     strings.Join(notes, " "),
+			ActionStatement: fixAction(m),
+		})
+
+		fixed := &openvex.Statement{Vulnerability: vuln, Status: openvex.StatusFixed}
+		for _, vr := range m.Versions {
+			if vr.Fixed != "" {
+				fixed.Products = append(fixed.Products, &openvex.Product{ID: purl + "@" + vr.Fixed.V()})
+			}
+		}
+		if len(fixed.Products) > 0 {
+			doc.Statements = append(doc.Statements, fixed)
+		}
+	}
+	return doc, nil
+}
+
+// versQualifier returns the qualifier of a package URL that limits it to the
+// version ranges vs, in the "vers" range syntax, like
+// "?vers=vers:golang/%3Cv1.2.0%7C%3E%3Dv1.3.0%7C%3Cv1.3.1". It returns ""
+// when vs covers all versions.
+func versQualifier(vs []VersionRange) string {
+	var constraints []string
+	for _, vr := range vs {
+		if vr.Introduced == "" && vr.Fixed == "" {
+			return ""
+		}
+		if vr.Introduced != "" {
+			constraints = append(constraints, ">="+vr.Introduced.V())
+		}
+		if vr.Fixed != "" {
+			constraints = append(constraints, "<"+vr.Fixed.V())
+		}
+	}
+	if len(constraints) == 0 {
+		return ""
+	}
+	// Package URLs percent-encode the characters of qualifier values that
+	// are not allowed unencoded.
+	r := strings.NewReplacer("<", "%3C", ">", "%3E", "=", "%3D", "|", "%7C")
+	return "?vers=vers:golang/" + r.Replace(strings.Join(constraints, "|"))
+}
+
+// versionsText describes the version ranges vs, like "before v1.2.0, from
+// v1.3.0 before v1.3.1".
+func versionsText(vs []VersionRange) string {
+	if len(vs) == 0 {
+		return "all versions"
+	}
+	var parts []string
+	for _, vr := range vs {
+		var s string
+		switch {
+		case vr.Introduced == "" && vr.Fixed == "":
+			s = "all versions"
+		case vr.Introduced == "":
+			s = "before " + vr.Fixed.V()
+		case vr.Fixed == "":
+			s = "from " + vr.Introduced.V()
+		default:
+			s = fmt.Sprintf("from %s before %s", vr.Introduced.V(), vr.Fixed.V())
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// fixAction returns what to do about the vulnerability in m: upgrade to its
+// latest fixed version, if it has one.
+func fixAction(m *Module) string {
+	var latest Version
+	for _, vr := range m.Versions {
+		if vr.Fixed == "" {
+			// The latest versions are affected.
+			latest = ""
+			break
+		}
+		if latest == "" || latest.Before(vr.Fixed) {
+			latest = vr.Fixed
+		}
+	}
+	if latest == "" {
+		return fmt.Sprintf("No version of %s fixes the vulnerability; avoid the affected packages or replace the module.", m.Module)
+	}
+	return fmt.Sprintf("Upgrade %s to %s or later.", m.Module, latest.V())
+}
+
+// platformsText describes the platforms that p is limited to, like
+// "GOOS windows" or "GOOS linux, darwin and GOARCH amd64".
+func platformsText(p *Package) string {
+	var parts []string
+	if len(p.GOOS) > 0 {
+		parts = append(parts, "GOOS "+strings.Join(p.GOOS, ", "))
+	}
+	if len(p.GOARCH) > 0 {
+		parts = append(parts, "GOARCH "+strings.Join(p.GOARCH, ", "))
+	}
+	return strings.Join(parts, " and ")
+}
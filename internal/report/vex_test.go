@@ -0,0 +1,132 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/openvex"
+)
+
+func TestToVEX(t *testing.T) {
+	published := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	vuln := openvex.Vulnerability{
+		ID:      "https://pkg.go.dev/vuln/GO-2022-0001",
+		Name:    "GO-2022-0001",
+		Aliases: []string{"CVE-9999-0001"},
+	}
+	for _, test := range []struct {
+		name      string
+		filename  string
+		withdrawn *Withdrawn
+		want      []*openvex.Statement
+	}{
+		{
+			name:     "std",
+			filename: "testdata/std-report.yaml",
+			want: []*openvex.Statement{
+				{
+					Vulnerability: vuln,
+					Products: []*openvex.Product{{
+						ID:            "pkg:golang/stdlib?vers=vers:golang/%3Cv1.17.11%7C%3E%3Dv1.18.0%7C%3Cv1.18.3",
+						Subcomponents: []*openvex.Subcomponent{{ID: "pkg:golang/stdlib#crypto/rand"}},
+					}},
+					Status: openvex.StatusAffected,
+					StatusNotes: "Affected versions: before v1.17.11, from v1.18.0 before v1.18.3. " +
+						"Only code that calls TestSymbol in package crypto/rand is affected. " +
+						"Package crypto/rand is only affected when built for GOOS windows.",
+					ActionStatement: "Upgrade std to v1.18.3 or later.",
+				},
+				{
+					Vulnerability: vuln,
+					Products: []*openvex.Product{
+						{ID: "pkg:golang/stdlib@v1.17.11"},
+						{ID: "pkg:golang/stdlib@v1.18.3"},
+					},
+					Status: openvex.StatusFixed,
+				},
+			},
+		},
+		{
+			name:     "third party",
+			filename: "testdata/report.yaml",
+			want: []*openvex.Statement{
+				{
+					Vulnerability: vuln,
+					Products: []*openvex.Product{{
+						ID:            "pkg:golang/github.com/gin-gonic/gin?vers=vers:golang/%3Cv1.6.0",
+						Subcomponents: []*openvex.Subcomponent{{ID: "pkg:golang/github.com/gin-gonic/gin"}},
+					}},
+					Status: openvex.StatusAffected,
+					StatusNotes: "Affected versions: before v1.6.0. " +
+						"Only code that calls defaultLogFormatter in package github.com/gin-gonic/gin is affected.",
+					ActionStatement: "Upgrade github.com/gin-gonic/gin to v1.6.0 or later.",
+				},
+				{
+					Vulnerability: vuln,
+					Products:      []*openvex.Product{{ID: "pkg:golang/github.com/gin-gonic/gin@v1.6.0"}},
+					Status:        openvex.StatusFixed,
+				},
+			},
+		},
+		{
+			name:     "withdrawn",
+			filename: "testdata/std-report.yaml",
+			withdrawn: &Withdrawn{
+				Date:   time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+				Reason: "not a vulnerability",
+			},
+			want: []*openvex.Statement{{
+				Vulnerability:   vuln,
+				Products:        []*openvex.Product{{ID: "pkg:golang/stdlib"}},
+				Status:          openvex.StatusNotAffected,
+				Justification:   openvex.VulnerableCodeNotPresent,
+				ImpactStatement: "The report was withdrawn: not a vulnerability",
+			}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Read(test.filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.Published = published
+			r.Withdrawn = test.withdrawn
+			filename := filepath.Join(t.TempDir(), "GO-2022-0001.yaml")
+			if err := r.Write(filename); err != nil {
+				t.Fatal(err)
+			}
+			got, err := ToVEX(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantTime := published
+			if test.withdrawn != nil {
+				wantTime = test.withdrawn.Date
+			}
+			want := &openvex.Document{
+				Context:    openvex.Context,
+				ID:         "https://pkg.go.dev/vuln/GO-2022-0001#vex",
+				Author:     "security@golang.org",
+				Timestamp:  wantTime,
+				Version:    1,
+				Tooling:    "vulnreport",
+				Statements: test.want,
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToVEXUnpublished(t *testing.T) {
+	if _, err := ToVEX("testdata/std-report.yaml"); err == nil {
+		t.Error("got nil, want error for a report without a published date")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stdlib
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// A Release is a Go release, tagged in the Go repo.
+type Release struct {
+	// Tag is the tag of the release, like "go1.19" or "go1.19.1".
+	Tag string
+	// Version is the semantic version of the release, without a "v", like
+	// "1.19.0" or "1.19.1".
+	Version string
+	// Commit is the tagged commit.
+	Commit *object.Commit
+}
+
+// releaseRegexp matches the tags of Go releases, but not of betas or
+// release candidates.
+var releaseRegexp = regexp.MustCompile(`^go1\.(\d+)(\.\d+)?$`)
+
+// Releases returns the Go releases tagged in repo, a clone of the Go repo,
+// ordered by version.
+func Releases(repo *git.Repository) (_ []*Release, err error) {
+	defer derrors.Wrap(&err, "stdlib.Releases")
+
+	iter, err := repo.Tags()
+	if err != nil {
+		return nil, err
+	}
+	var rs []*Release
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		tag := ref.Name().Short()
+		m := releaseRegexp.FindStringSubmatch(tag)
+		if m == nil {
+			return nil
+		}
+		patch := m[2]
+		if patch == "" {
+			patch = ".0"
+		}
+		c, err := tagCommit(repo, ref)
+		if err != nil {
+			return err
+		}
+		rs = append(rs, &Release{Tag: tag, Version: "1." + m[1] + patch, Commit: c})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return semver.Compare("v"+rs[i].Version, "v"+rs[j].Version) < 0
+	})
+	return rs, nil
+}
+
+// tagCommit returns the commit that the tag ref points to, which may be an
+// annotated tag.
+func tagCommit(repo *git.Repository, ref *plumbing.Reference) (*object.Commit, error) {
+	if t, err := repo.TagObject(ref.Hash()); err == nil {
+		return t.Commit()
+	}
+	return repo.CommitObject(ref.Hash())
+}
+
+// FirstReleaseContaining returns the index of the first of releases, which
+// are ordered by version, that contains commit c, or -1 if none does. For a
+// commit on a release branch, that is the first release from the branch
+// after the commit; for a commit on master, it is the first major release
+// branched after the commit.
+func FirstReleaseContaining(releases []*Release, c *object.Commit) (_ int, err error) {
+	defer derrors.Wrap(&err, "stdlib.FirstReleaseContaining(%s)", c.Hash)
+
+	for i, r := range releases {
+		// A release made before the commit can't contain it; skip the walk
+		// of its history.
+		if r.Commit.Committer.When.Before(c.Committer.When) {
+			continue
+		}
+		ok, err := c.IsAncestor(r.Commit)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package symbols
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// A StdlibFix describes the packages and symbols of the Go repo that a fix
+// commit changed, compared with the Go releases around the fix.
+type StdlibFix struct {
+	// Before is the tag of the last Go release before the first one that
+	// contains the fix, like "go1.19.1", or "" if there is none.
+	Before string
+	// After is the tag of the first Go release that contains the fix, or ""
+	// if no release does yet.
+	After string
+	// Packages are the packages that the fix changed, sorted by path.
+	Packages []*StdlibPackage
+}
+
+// A StdlibPackage is a package of the standard library or the toolchain
+// that a fix changed.
+type StdlibPackage struct {
+	// Module is "std" or "cmd".
+	Module string
+	// Package is the import path of the package.
+	Package string
+	// Symbols are the functions and methods that the fix changed and that
+	// are in the release before it: the candidates for the symbols of a
+	// report.
+	Symbols []string
+	// Added are the functions and methods that the fix changed and that are
+	// not in the release before it, such as helpers or new API.
+	Added []string
+}
+
+// StdlibFixSymbols analyzes the commit in repo, a clone of the Go repo with
+// its history and tags, with the given hash. It finds the first Go release
+// that contains the commit and the release before it, and sorts the
+// functions and methods that the commit changed into those that are in the
+// earlier release, and so may be vulnerable in it, and those that the fix
+// added. If there is no earlier release, the commit is compared with its
+// parent. Vendored packages and packages outside of src are ignored.
+func StdlibFixSymbols(repo *git.Repository, hash string) (_ *StdlibFix, err error) {
+	defer derrors.Wrap(&err, "StdlibFixSymbols(%s)", hash)
+
+	fix, err := repo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return nil, err
+	}
+	changed, err := Changed(repo, hash)
+	if err != nil {
+		return nil, err
+	}
+	releases, err := stdlib.Releases(repo)
+	if err != nil {
+		return nil, err
+	}
+	// b is the index of the release before the first that contains the
+	// fix, or of the last release if none does.
+	sf := &StdlibFix{}
+	b := len(releases) - 1
+	i, err := stdlib.FirstReleaseContaining(releases, fix)
+	if err != nil {
+		return nil, err
+	}
+	if i >= 0 {
+		sf.After = releases[i].Tag
+		b = i - 1
+	}
+	var before *object.Commit
+	if b >= 0 {
+		sf.Before = releases[b].Tag
+		before = releases[b].Commit
+	} else {
+		if fix.NumParents() == 0 {
+			return nil, fmt.Errorf("commit %s has no parent", hash)
+		}
+		if before, err = fix.Parent(0); err != nil {
+			return nil, err
+		}
+	}
+
+	for dir, syms := range changed {
+		mod, pkg, ok := stdlibPackage(dir)
+		if !ok {
+			continue
+		}
+		funcs, err := packageFuncs(before, dir)
+		if err != nil {
+			return nil, err
+		}
+		sp := &StdlibPackage{Module: mod, Package: pkg}
+		for _, s := range syms {
+			if funcs[s] {
+				sp.Symbols = append(sp.Symbols, s)
+			} else {
+				sp.Added = append(sp.Added, s)
+			}
+		}
+		sf.Packages = append(sf.Packages, sp)
+	}
+	sort.Slice(sf.Packages, func(i, j int) bool { return sf.Packages[i].Package < sf.Packages[j].Package })
+	return sf, nil
+}
+
+// stdlibPackage returns the module and import path of the package in the
+// directory dir of the Go repo, and whether there is such a package.
+func stdlibPackage(dir string) (module, pkg string, ok bool) {
+	pkg = strings.TrimPrefix(dir, "src/")
+	if pkg == dir {
+		return "", "", false
+	}
+	for _, elem := range strings.Split(pkg, "/") {
+		if elem == "vendor" {
+			return "", "", false
+		}
+	}
+	if pkg == "cmd" || strings.HasPrefix(pkg, "cmd/") {
+		return "cmd", pkg, true
+	}
+	return stdlib.ModulePath, pkg, true
+}
+
+// packageFuncs returns the names of the functions and methods of the package
+// in the directory dir at commit c, named as by Changed. The package has no
+// functions if the directory does not exist.
+func packageFuncs(c *object.Commit, dir string) (map[string]bool, error) {
+	funcs := map[string]bool{}
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, err
+	}
+	dt, err := tree.Tree(dir)
+	if err == object.ErrDirectoryNotFound {
+		return funcs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range dt.Entries {
+		if !e.Mode.IsFile() || !isGoSource(path.Join(dir, e.Name)) {
+			continue
+		}
+		f, err := dt.TreeEntryFile(&e)
+		if err != nil {
+			return nil, err
+		}
+		src, err := f.Contents()
+		if err != nil {
+			return nil, err
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), e.Name, src, parser.SkipObjectResolution)
+		if err != nil {
+			// As in changedFuncs, a file that doesn't parse has nothing to
+			// offer.
+			continue
+		}
+		for _, decl := range file.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok {
+				funcs[funcName(fd)] = true
+			}
+		}
+	}
+	return funcs, nil
+}
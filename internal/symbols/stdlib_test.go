@@ -0,0 +1,76 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package symbols
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStdlibFixSymbols(t *testing.T) {
+	repo, commit := newTestRepo(t)
+	tag := func(name, hash string, annotated bool) {
+		t.Helper()
+		var opts *git.CreateTagOptions
+		if annotated {
+			opts = &git.CreateTagOptions{
+				Tagger:  &object.Signature{Name: "Releaser", Email: "releaser@example.com"},
+				Message: name,
+			}
+		}
+		if _, err := repo.CreateTag(name, plumbing.NewHash(hash), opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tag("go1.19", commit(map[string]string{
+		"src/net/http/p.go":   before,
+		"src/cmd/go/main.go":  "package main\n\nfunc main() {}\n",
+		"src/vendor/x/y/y.go": "package y\n\nfunc Y() {}\n",
+		"misc/m.go":           "package m\n\nfunc M() {}\n",
+	}), false)
+	tag("go1.19.1", commit(map[string]string{"README": "Go 1.19.1\n"}), true)
+	fix := commit(map[string]string{
+		"src/net/http/p.go":   after,
+		"src/cmd/go/main.go":  "package main\n\nfunc main() { println() }\n",
+		"src/vendor/x/y/y.go": "package y\n\nfunc Y() { println() }\n",
+		"misc/m.go":           "package m\n\nfunc M() { println() }\n",
+	})
+	tag("go1.20rc1", fix, false)
+	tag("go1.20", commit(map[string]string{"README": "Go 1.20\n"}), true)
+	unreleased := commit(map[string]string{"src/cmd/go/main.go": "package main\n\nfunc main() {}\n"})
+
+	got, err := StdlibFixSymbols(repo, fix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &StdlibFix{
+		Before: "go1.19.1",
+		After:  "go1.20",
+		Packages: []*StdlibPackage{
+			{Module: "cmd", Package: "cmd/go", Symbols: []string{"main"}},
+			{Module: "std", Package: "net/http", Symbols: []string{"Fixed", "Removed", "T.Get"}, Added: []string{"sanitize"}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	got, err = StdlibFixSymbols(repo, unreleased)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = &StdlibFix{
+		Before:   "go1.20",
+		Packages: []*StdlibPackage{{Module: "cmd", Package: "cmd/go", Symbols: []string{"main"}}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unreleased: mismatch (-want, +got):\n%s", diff)
+	}
+}
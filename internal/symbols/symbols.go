@@ -0,0 +1,200 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package symbols finds the Go symbols that a commit changes, as suggestions
+// for the symbols of a vulnerability report.
+package symbols
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	fdiff "github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// Changed returns the functions and methods changed by the commit in repo
+// with the given hash, compared to its first parent. The result maps the
+// directory of each package, relative to the root of the repo, to the sorted
+// names of its changed symbols. A method is named like "T.Method". Test files
+// and testdata directories are ignored.
+//
+// A symbol is changed if the commit deletes one of its lines or adds a line
+// to it. Changes to declarations other than functions and methods, like
+// types and variables, are not reported.
+func Changed(repo *git.Repository, hash string) (_ map[string][]string, err error) {
+	defer derrors.Wrap(&err, "symbols.Changed(%s)", hash)
+
+	commit, err := repo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return nil, err
+	}
+	if commit.NumParents() == 0 {
+		return nil, fmt.Errorf("commit %s has no parent", hash)
+	}
+	parent, err := commit.Parent(0)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := parent.Patch(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	syms := map[string]map[string]bool{}
+	for _, fp := range patch.FilePatches() {
+		if fp.IsBinary() {
+			continue
+		}
+		from, to := fp.Files()
+		deleted, added := changedLines(fp.Chunks())
+		for _, f := range []struct {
+			file  fdiff.File
+			c     *object.Commit
+			lines map[int]bool
+		}{
+			{from, parent, deleted},
+			{to, commit, added},
+		} {
+			if f.file == nil || len(f.lines) == 0 || !isGoSource(f.file.Path()) {
+				continue
+			}
+			names, err := changedFuncs(f.c, f.file.Path(), f.lines)
+			if err != nil {
+				return nil, err
+			}
+			dir := path.Dir(f.file.Path())
+			for _, n := range names {
+				if syms[dir] == nil {
+					syms[dir] = map[string]bool{}
+				}
+				syms[dir][n] = true
+			}
+		}
+	}
+
+	result := map[string][]string{}
+	for dir, names := range syms {
+		for n := range names {
+			result[dir] = append(result[dir], n)
+		}
+		sort.Strings(result[dir])
+	}
+	return result, nil
+}
+
+// isGoSource reports whether the file at the slash-separated path p is a
+// non-test Go file outside of any testdata directory.
+func isGoSource(p string) bool {
+	if !strings.HasSuffix(p, ".go") || strings.HasSuffix(p, "_test.go") {
+		return false
+	}
+	for _, elem := range strings.Split(path.Dir(p), "/") {
+		if elem == "testdata" {
+			return false
+		}
+	}
+	return true
+}
+
+// changedLines returns the 1-based numbers of the lines of the old file
+// that chunks delete, and of the lines of the new file that they add.
+func changedLines(chunks []fdiff.Chunk) (deleted, added map[int]bool) {
+	deleted, added = map[int]bool{}, map[int]bool{}
+	oldLine, newLine := 1, 1
+	for _, c := range chunks {
+		n := countLines(c.Content())
+		switch c.Type() {
+		case fdiff.Equal:
+			oldLine += n
+			newLine += n
+		case fdiff.Delete:
+			for i := 0; i < n; i++ {
+				deleted[oldLine+i] = true
+			}
+			oldLine += n
+		case fdiff.Add:
+			for i := 0; i < n; i++ {
+				added[newLine+i] = true
+			}
+			newLine += n
+		}
+	}
+	return deleted, added
+}
+
+// countLines returns the number of lines in s, including a final line with
+// no newline.
+func countLines(s string) int {
+	n := strings.Count(s, "\n")
+	if !strings.HasSuffix(s, "\n") && s != "" {
+		n++
+	}
+	return n
+}
+
+// changedFuncs returns the names of the functions and methods in the file at
+// path in commit c that contain any of the given lines.
+func changedFuncs(c *object.Commit, path string, lines map[int]bool) ([]string, error) {
+	f, err := c.File(path)
+	if err != nil {
+		return nil, err
+	}
+	src, err := f.Contents()
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+	if err != nil {
+		// The file may not compile at every commit; there is nothing to
+		// suggest from it.
+		return nil, nil
+	}
+	var names []string
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		start, end := fset.Position(fd.Pos()).Line, fset.Position(fd.End()).Line
+		for l := start; l <= end; l++ {
+			if lines[l] {
+				names = append(names, funcName(fd))
+				break
+			}
+		}
+	}
+	return names, nil
+}
+
+// funcName returns the name of a function, or the name of a method's
+// receiver type and the method, separated by a dot.
+func funcName(fd *ast.FuncDecl) string {
+	if fd.Recv == nil || len(fd.Recv.List) == 0 {
+		return fd.Name.Name
+	}
+	t := fd.Recv.List[0].Type
+	if s, ok := t.(*ast.StarExpr); ok {
+		t = s.X
+	}
+	switch x := t.(type) {
+	case *ast.IndexExpr:
+		t = x.X
+	case *ast.IndexListExpr:
+		t = x.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name + "." + fd.Name.Name
+	}
+	return fd.Name.Name
+}
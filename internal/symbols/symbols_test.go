@@ -0,0 +1,124 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package symbols
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+const before = `package p
+
+type T struct{}
+
+func (t *T) Get(i int) int {
+	return i
+}
+
+func Unchanged() {}
+
+func Removed() {
+	println("removed")
+}
+
+func Fixed(s string) string {
+	return s
+}
+`
+
+const after = `package p
+
+type T struct{}
+
+func (t *T) Get(i int) int {
+	if i < 0 {
+		return 0
+	}
+	return i
+}
+
+func Unchanged() {}
+
+func Fixed(s string) string {
+	return sanitize(s)
+}
+
+func sanitize(s string) string { return s }
+`
+
+// newTestRepo returns an empty repo, and a function that commits files to it
+// and returns the hash of the commit.
+func newTestRepo(t *testing.T) (*git.Repository, func(files map[string]string) string) {
+	fs := memfs.New()
+	repo, err := git.Init(memory.NewStorage(), fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit := func(files map[string]string) string {
+		t.Helper()
+		for name, content := range files {
+			f, err := fs.Create(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := f.Write([]byte(content)); err != nil {
+				t.Fatal(err)
+			}
+			if err := f.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := wt.Add(name); err != nil {
+				t.Fatal(err)
+			}
+		}
+		h, err := wt.Commit("commit", &git.CommitOptions{Author: &object.Signature{
+			Name:  "Author",
+			Email: "author@example.com",
+			When:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h.String()
+	}
+	return repo, commit
+}
+
+func TestChanged(t *testing.T) {
+	repo, commit := newTestRepo(t)
+	commit(map[string]string{
+		"a/p.go":          before,
+		"a/p_test.go":     before,
+		"a/testdata/x.go": before,
+	})
+	fix := commit(map[string]string{
+		"a/p.go":          after,
+		"a/p_test.go":     after,
+		"a/testdata/x.go": after,
+		"b/new.go":        "package b\n\nfunc New() {}\n",
+	})
+
+	got, err := Changed(repo, fix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{
+		"a": {"Fixed", "Removed", "T.Get", "sanitize"},
+		"b": {"New"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
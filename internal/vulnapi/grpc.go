@@ -0,0 +1,145 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vulnapi
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"golang.org/x/vulndb/internal/database"
+	"golang.org/x/vulndb/internal/vulnapi/vulnapipb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCServer returns an implementation of the VulnDB gRPC service that
+// serves the same database as s.
+func (s *Server) GRPCServer() vulnapipb.VulnDBServer {
+	return &grpcServer{s: s}
+}
+
+type grpcServer struct {
+	vulnapipb.UnimplementedVulnDBServer
+	s *Server
+}
+
+func (g *grpcServer) GetModule(_ context.Context, req *vulnapipb.GetModuleRequest) (*vulnapipb.Entries, error) {
+	resp, err := g.s.lookupModule(req.ModulePath)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return protoEntries(resp.content)
+}
+
+func (g *grpcServer) GetEntry(_ context.Context, req *vulnapipb.GetEntryRequest) (*vulnapipb.Entry, error) {
+	resp, err := g.s.lookupID(req.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return protoEntry(resp.content)
+}
+
+func (g *grpcServer) GetAlias(_ context.Context, req *vulnapipb.GetAliasRequest) (*vulnapipb.Entries, error) {
+	resp, err := g.s.lookupAlias(req.Alias)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return protoEntries(resp.content)
+}
+
+func (g *grpcServer) ExportEntries(req *vulnapipb.ExportEntriesRequest, stream vulnapipb.VulnDB_ExportEntriesServer) error {
+	var since time.Time
+	if req.ModifiedSince != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339, req.ModifiedSince)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "modified_since: %v", err)
+		}
+	}
+	var ids []string
+	if since.IsZero() {
+		if err := g.s.readJSON(idDirectory+"/"+indexFile, &ids); err != nil {
+			return grpcError(err)
+		}
+	} else {
+		mes, err := database.ModifiedSince(g.s.dbPath, since)
+		if err != nil {
+			return grpcError(err)
+		}
+		for _, me := range mes {
+			ids = append(ids, me.ID)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		resp, err := g.s.lookupID(id)
+		if err != nil {
+			return grpcError(err)
+		}
+		e, err := protoEntry(resp.content)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// grpcError returns the gRPC status error for an error of a lookup.
+func grpcError(err error) error {
+	serr, ok := err.(*serverError)
+	if !ok {
+		log.Print(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	code := codes.Internal
+	switch serr.status {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusNotFound:
+		code = codes.NotFound
+	}
+	return status.Error(code, serr.err.Error())
+}
+
+// protoEntry returns the Entry message for an OSV entry in JSON.
+func protoEntry(content []byte) (*vulnapipb.Entry, error) {
+	var e struct {
+		ID       string    `json:"id"`
+		Modified time.Time `json:"modified"`
+	}
+	if err := json.Unmarshal(content, &e); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &vulnapipb.Entry{
+		Id:       e.ID,
+		Modified: e.Modified.Format(time.RFC3339),
+		Json:     content,
+	}, nil
+}
+
+// protoEntries returns the Entries message for a list of OSV entries in
+// JSON.
+func protoEntries(content []byte) (*vulnapipb.Entries, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(content, &raws); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	es := &vulnapipb.Entries{}
+	for _, raw := range raws {
+		e, err := protoEntry(raw)
+		if err != nil {
+			return nil, err
+		}
+		es.Entries = append(es.Entries, e)
+	}
+	return es, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vulnapi
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/vulnapi/vulnapipb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestGRPCServer(t *testing.T) {
+	dir, t1, _ := writeTestDB(t)
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	vulnapipb.RegisterVulnDBServer(gs, NewServer(dir, time.Hour).GRPCServer())
+	go gs.Serve(lis)
+	defer gs.Stop()
+
+	ctx := context.Background()
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := vulnapipb.NewVulnDBClient(conn)
+
+	ids := func(es []*vulnapipb.Entry) []string {
+		var ids []string
+		for _, e := range es {
+			ids = append(ids, e.Id)
+		}
+		return ids
+	}
+	check := func(name string, got, want []string) {
+		t.Helper()
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("%s: mismatch (-want, +got):\n%s", name, diff)
+		}
+	}
+
+	es, err := c.GetModule(ctx, &vulnapipb.GetModuleRequest{ModulePath: "std"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("GetModule", ids(es.Entries), []string{"GO-2022-0002"})
+
+	e, err := c.GetEntry(ctx, &vulnapipb.GetEntryRequest{Id: "GO-2022-0001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Id != "GO-2022-0001" || e.Modified != t1.Format(time.RFC3339) || len(e.Json) == 0 {
+		t.Errorf("GetEntry: got %v", e)
+	}
+
+	es, err = c.GetAlias(ctx, &vulnapipb.GetAliasRequest{Alias: "CVE-2022-0001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("GetAlias", ids(es.Entries), []string{"GO-2022-0001", "GO-2022-0002"})
+
+	for _, test := range []struct {
+		call func() error
+		want codes.Code
+	}{
+		{func() error { _, err := c.GetEntry(ctx, &vulnapipb.GetEntryRequest{Id: "GO-2022-0003"}); return err }, codes.NotFound},
+		{func() error { _, err := c.GetEntry(ctx, &vulnapipb.GetEntryRequest{Id: "index"}); return err }, codes.InvalidArgument},
+		{func() error {
+			_, err := c.GetAlias(ctx, &vulnapipb.GetAliasRequest{Alias: "CVE-2022-0002"})
+			return err
+		}, codes.NotFound},
+	} {
+		if got := status.Code(test.call()); got != test.want {
+			t.Errorf("got code %v, want %v", got, test.want)
+		}
+	}
+
+	export := func(since string) ([]string, error) {
+		stream, err := c.ExportEntries(ctx, &vulnapipb.ExportEntriesRequest{ModifiedSince: since})
+		if err != nil {
+			return nil, err
+		}
+		var es []*vulnapipb.Entry
+		for {
+			e, err := stream.Recv()
+			if err == io.EOF {
+				return ids(es), nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			es = append(es, e)
+		}
+	}
+	got, err := export("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("ExportEntries", got, []string{"GO-2022-0001", "GO-2022-0002"})
+	got, err = export(t1.Add(time.Hour).Format(time.RFC3339))
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("ExportEntries since", got, []string{"GO-2022-0002"})
+	if _, err := export("yesterday"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ExportEntries with bad time: got %v, want InvalidArgument", err)
+	}
+}
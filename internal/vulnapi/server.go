@@ -0,0 +1,253 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package vulnapi implements an HTTP API and a gRPC service for querying a
+// vulnerability database written by gendb, so that clients need not mirror
+// its files.
+//
+// The HTTP API has these endpoints, which return JSON:
+//
+//	/v1/module/{path}    the OSV entries of a module
+//	/v1/id/{GO-ID}       the OSV entry with the ID
+//	/v1/aliases/{alias}  the OSV entries with the alias, like a CVE ID
+//	/v1/modified/{time}  the IDs and modification times of the entries
+//	                     modified at or after the time, in RFC 3339 format
+//
+// Responses have a Last-Modified time and an ETag, and may be cached for the
+// server's max age.
+//
+// The gRPC service, VulnDB in vulnapipb/vulnapi.proto, has the same lookups,
+// and also streams every entry for bulk export.
+package vulnapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/vuln/client"
+	"golang.org/x/vuln/osv"
+	"golang.org/x/vulndb/internal/database"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// The names of files and directories in the database, as written by
+// database.Generate.
+const (
+	indexFile   = "index.json"
+	aliasesFile = "aliases.json"
+	idDirectory = "ID"
+)
+
+// A Server serves the database in a directory.
+type Server struct {
+	dbPath string
+	maxAge time.Duration
+	mux    *http.ServeMux
+}
+
+// NewServer returns a server for the database in dbPath, whose responses may
+// be cached for maxAge.
+func NewServer(dbPath string, maxAge time.Duration) *Server {
+	s := &Server{dbPath: dbPath, maxAge: maxAge, mux: http.NewServeMux()}
+	s.handle("/v1/module/", s.lookupModule)
+	s.handle("/v1/id/", s.lookupID)
+	s.handle("/v1/aliases/", s.lookupAlias)
+	s.handle("/v1/modified/", s.lookupModified)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// A response is the JSON content of a successful request, and when what it
+// describes was last modified.
+type response struct {
+	content  []byte
+	modified time.Time
+}
+
+type serverError struct {
+	status int   // HTTP status code
+	err    error // wrapped error
+}
+
+func (s *serverError) Error() string {
+	return fmt.Sprintf("%d (%s): %v", s.status, http.StatusText(s.status), s.err)
+}
+
+func notFound(format string, args ...any) error {
+	return &serverError{status: http.StatusNotFound, err: fmt.Errorf(format, args...)}
+}
+
+// handle registers hfunc for the paths that begin with prefix. It passes
+// hfunc the rest of the path.
+func (s *Server) handle(prefix string, hfunc func(arg string) (*response, error)) {
+	s.mux.HandleFunc(prefix, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "only GET and HEAD are allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		arg := strings.TrimPrefix(r.URL.Path, prefix)
+		if arg == "" {
+			http.Error(w, "missing "+strings.TrimSuffix(strings.TrimPrefix(prefix, "/v1/"), "/"), http.StatusBadRequest)
+			return
+		}
+		resp, err := hfunc(arg)
+		if err != nil {
+			serr, ok := err.(*serverError)
+			if !ok {
+				serr = &serverError{status: http.StatusInternalServerError, err: err}
+				log.Printf("%s: %v", r.URL.Path, err)
+			}
+			http.Error(w, serr.err.Error(), serr.status)
+			return
+		}
+		h := w.Header()
+		h.Set("Content-Type", "application/json")
+		h.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(s.maxAge.Seconds())))
+		h.Set("ETag", fmt.Sprintf(`"%x"`, sha256.Sum256(resp.content)))
+		// ServeContent answers conditional requests from the ETag and
+		// modification time.
+		http.ServeContent(w, r, "", resp.modified, bytes.NewReader(resp.content))
+	})
+}
+
+// lookupModule returns the entries of a module. The standard library and the
+// Go toolchain can be named either as in reports ("std", "cmd") or as in the
+// database ("stdlib", "toolchain").
+func (s *Server) lookupModule(modulePath string) (*response, error) {
+	switch modulePath {
+	case stdlib.ModulePath:
+		modulePath = "stdlib"
+	case "cmd":
+		modulePath = "toolchain"
+	}
+	// Escaping also rejects paths that could leave the database directory.
+	epath, err := client.EscapeModulePath(modulePath)
+	if err != nil {
+		return nil, &serverError{status: http.StatusBadRequest, err: err}
+	}
+	content, err := s.readFile(epath + ".json")
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, notFound("no entries for module %s", modulePath)
+	}
+	var index client.DBIndex
+	if err := s.readJSON(indexFile, &index); err != nil {
+		return nil, err
+	}
+	return &response{content: content, modified: index[modulePath]}, nil
+}
+
+var idRegexp = regexp.MustCompile(`^GO-\d{4}-\d{4,}$`)
+
+// lookupID returns the entry with the given ID.
+func (s *Server) lookupID(id string) (*response, error) {
+	if !idRegexp.MatchString(id) {
+		return nil, &serverError{status: http.StatusBadRequest, err: fmt.Errorf("%q is not a Go vulnerability ID", id)}
+	}
+	content, err := s.readFile(idDirectory + "/" + id + ".json")
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, notFound("no entry with ID %s", id)
+	}
+	var e osv.Entry
+	if err := json.Unmarshal(content, &e); err != nil {
+		return nil, err
+	}
+	return &response{content: content, modified: e.Modified}, nil
+}
+
+// lookupAlias returns the entries that have the given alias, as a list.
+func (s *Server) lookupAlias(alias string) (*response, error) {
+	var aliases map[string][]string
+	if err := s.readJSON(aliasesFile, &aliases); err != nil {
+		return nil, err
+	}
+	ids := aliases[alias]
+	if len(ids) == 0 {
+		return nil, notFound("no entries with alias %s", alias)
+	}
+	var (
+		entries  []json.RawMessage
+		modified time.Time
+	)
+	for _, id := range ids {
+		resp, err := s.lookupID(id)
+		if err != nil {
+			return nil, fmt.Errorf("alias %s: %v", alias, err)
+		}
+		entries = append(entries, resp.content)
+		if resp.modified.After(modified) {
+			modified = resp.modified
+		}
+	}
+	content, err := json.Marshal(entries)
+	if err != nil {
+		return nil, err
+	}
+	return &response{content: content, modified: modified}, nil
+}
+
+// lookupModified returns the IDs and modification times of the entries
+// modified at or after a time in RFC 3339 format, most recent first.
+func (s *Server) lookupModified(since string) (*response, error) {
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return nil, &serverError{status: http.StatusBadRequest, err: err}
+	}
+	mes, err := database.ModifiedSince(s.dbPath, t)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(mes)
+	if err != nil {
+		return nil, err
+	}
+	var modified time.Time
+	if len(mes) > 0 {
+		modified = mes[0].Modified
+	}
+	return &response{content: content, modified: modified}, nil
+}
+
+// readFile returns the contents of the file of the database with the given
+// slash-separated name, or nil if there is no such file.
+func (s *Server) readFile(name string) ([]byte, error) {
+	content, err := os.ReadFile(filepath.Join(s.dbPath, filepath.FromSlash(name)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return content, err
+}
+
+// readJSON unmarshals the file of the database with the given name into v.
+// The file must exist.
+func (s *Server) readJSON(name string, v any) error {
+	content, err := s.readFile(name)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		return fmt.Errorf("database has no %s", name)
+	}
+	return json.Unmarshal(content, v)
+}
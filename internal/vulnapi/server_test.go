@@ -0,0 +1,160 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vulnapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vuln/client"
+	"golang.org/x/vuln/osv"
+	"golang.org/x/vulndb/internal/database"
+)
+
+// writeTestDB writes a database with two entries, modified at t1 and t2,
+// and returns its directory.
+func writeTestDB(t *testing.T) (dir string, t1, t2 time.Time) {
+	t1 = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 = t1.Add(24 * time.Hour)
+	entry := func(id, module string, modified time.Time, aliases ...string) osv.Entry {
+		return osv.Entry{
+			ID:       id,
+			Modified: modified,
+			Aliases:  aliases,
+			Affected: []osv.Affected{{Package: osv.Package{Name: module, Ecosystem: osv.GoEcosystem}}},
+		}
+	}
+	e1 := entry("GO-2022-0001", "example.com/A", t1, "CVE-2022-0001")
+	e2 := entry("GO-2022-0002", "stdlib", t2, "CVE-2022-0001", "GHSA-aaaa-bbbb-cccc")
+
+	dir = t.TempDir()
+	write := func(name string, v any) {
+		t.Helper()
+		j, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, j, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("index.json", client.DBIndex{"example.com/A": t1, "stdlib": t2})
+	write("aliases.json", map[string][]string{
+		"CVE-2022-0001":       {e1.ID, e2.ID},
+		"GHSA-aaaa-bbbb-cccc": {e2.ID},
+	})
+	write("example.com/!a.json", []osv.Entry{e1})
+	write("stdlib.json", []osv.Entry{e2})
+	write("ID/GO-2022-0001.json", e1)
+	write("ID/GO-2022-0002.json", e2)
+	write("ID/index.json", []string{e2.ID, e1.ID})
+	write("modified.json", []database.ModifiedEntry{{ID: e2.ID, Modified: t2}, {ID: e1.ID, Modified: t1}})
+
+	return dir, t1, t2
+}
+
+func TestServer(t *testing.T) {
+	dir, t1, t2 := writeTestDB(t)
+	srv := httptest.NewServer(NewServer(dir, time.Hour))
+	defer srv.Close()
+
+	for _, test := range []struct {
+		path         string
+		wantStatus   int
+		wantIDs      []string
+		wantModified time.Time
+	}{
+		{"/v1/module/example.com/A", http.StatusOK, []string{"GO-2022-0001"}, t1},
+		{"/v1/module/std", http.StatusOK, []string{"GO-2022-0002"}, t2},
+		{"/v1/module/stdlib", http.StatusOK, []string{"GO-2022-0002"}, t2},
+		{"/v1/module/example.com/b", http.StatusNotFound, nil, time.Time{}},
+		{"/v1/module/example.com/a%3Ab", http.StatusBadRequest, nil, time.Time{}},
+		{"/v1/id/GO-2022-0001", http.StatusOK, []string{"GO-2022-0001"}, t1},
+		{"/v1/id/GO-2022-0003", http.StatusNotFound, nil, time.Time{}},
+		{"/v1/id/index", http.StatusBadRequest, nil, time.Time{}},
+		{"/v1/aliases/CVE-2022-0001", http.StatusOK, []string{"GO-2022-0001", "GO-2022-0002"}, t2},
+		{"/v1/aliases/GHSA-aaaa-bbbb-cccc", http.StatusOK, []string{"GO-2022-0002"}, t2},
+		{"/v1/aliases/CVE-2022-0002", http.StatusNotFound, nil, time.Time{}},
+		{"/v1/aliases/", http.StatusBadRequest, nil, time.Time{}},
+		{"/v1/modified/2022-01-01T12:00:00Z", http.StatusOK, []string{"GO-2022-0002"}, t2},
+		{"/v1/modified/2022-01-01T00:00:00Z", http.StatusOK, []string{"GO-2022-0002", "GO-2022-0001"}, t2},
+		{"/v1/modified/yesterday", http.StatusBadRequest, nil, time.Time{}},
+	} {
+		t.Run(test.path, func(t *testing.T) {
+			// Send the path as is, without escaping it again.
+			req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.URL.Opaque = test.path
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			if res.StatusCode != test.wantStatus {
+				t.Fatalf("got status %d, want %d", res.StatusCode, test.wantStatus)
+			}
+			if test.wantStatus != http.StatusOK {
+				return
+			}
+			if got, want := res.Header.Get("Cache-Control"), "public, max-age=3600"; got != want {
+				t.Errorf("Cache-Control: got %q, want %q", got, want)
+			}
+			if got, want := res.Header.Get("Last-Modified"), test.wantModified.Format(http.TimeFormat); got != want {
+				t.Errorf("Last-Modified: got %q, want %q", got, want)
+			}
+			var entries []osv.Entry
+			if strings.HasPrefix(test.path, "/v1/id/") {
+				var e osv.Entry
+				err = json.NewDecoder(res.Body).Decode(&e)
+				entries = []osv.Entry{e}
+			} else {
+				err = json.NewDecoder(res.Body).Decode(&entries)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, e := range entries {
+				ids = append(ids, e.ID)
+			}
+			if diff := cmp.Diff(test.wantIDs, ids); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+
+			// A request with the ETag is answered with Not Modified.
+			req.Header.Set("If-None-Match", res.Header.Get("ETag"))
+			res2, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res2.Body.Close()
+			if res2.StatusCode != http.StatusNotModified {
+				t.Errorf("with ETag: got status %d, want %d", res2.StatusCode, http.StatusNotModified)
+			}
+		})
+	}
+
+	res, err := http.Post(srv.URL+"/v1/id/GO-2022-0001", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST: got status %d, want %d", res.StatusCode, http.StatusMethodNotAllowed)
+	}
+}
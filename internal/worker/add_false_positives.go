@@ -0,0 +1,102 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Program to add CVE records to the false-positives file.
+
+// This requires a local copy of the cvelist repo:
+//     git clone https://github.com/CVEProject/cvelist
+//
+// Then run this program from this directory:
+//     go run add_false_positives.go -repo PATH_TO_LOCAL_REPO CVE-ID...
+//
+// By default, the CVEs are read from the HEAD commit of the repo and
+// recorded as false positives. Use -report to record CVEs that are
+// covered by a report in the Go vulndb instead, or -osv to look up the
+// covering report of each CVE in OSV.dev.
+
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/osvdev"
+	"golang.org/x/vulndb/internal/worker"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+var (
+	repoPath = flag.String("repo", "", "path to local copy of the cvelist repo")
+	commitID = flag.String("commit", "", "cvelist commit to read CVEs from (default HEAD)")
+	reportID = flag.String("report", "", "ID of the Go vulndb report that covers the CVEs, if any")
+	useOSV   = flag.Bool("osv", false, "look up the Go vulndb report that covers each CVE in OSV.dev")
+	filename = flag.String("file", "false_positives.yaml", "false-positives file to add to")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: go run add_false_positives.go -repo PATH [flags] CVE-ID...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *repoPath == "" || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(context.Background(), flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, ids []string) error {
+	repo, err := gitrepo.Open(ctx, *repoPath)
+	if err != nil {
+		return err
+	}
+	hash := plumbing.NewHash(*commitID)
+	if *commitID == "" {
+		ref, err := repo.Reference(plumbing.HEAD, true)
+		if err != nil {
+			return err
+		}
+		hash = ref.Hash()
+	}
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		return fmt.Errorf("commit %s: %w", hash, err)
+	}
+	osv := osvdev.NewClient(osvdev.DefaultBaseURL)
+	var crs []*store.CVERecord
+	for _, id := range ids {
+		report := *reportID
+		if *useOSV && report == "" {
+			as, err := osv.Advisories(ctx, id)
+			if err != nil {
+				return err
+			}
+			if rs := osvdev.GoReports(as); len(rs) > 0 {
+				report = rs[0]
+				fmt.Printf("%s is covered by %s\n", id, report)
+			}
+		}
+		cr, err := worker.NewFalsePositiveRecord(commit, id, report)
+		if err != nil {
+			return err
+		}
+		crs = append(crs, cr)
+	}
+	if err := worker.AppendFalsePositives(*filename, crs...); err != nil {
+		return err
+	}
+	fmt.Printf("added %d records to %s\n", len(crs), *filename)
+	return nil
+}
@@ -0,0 +1,222 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// The triage queues whose ages are tracked, and how long a record may stay
+// in each before it is overdue.
+const (
+	// Records that need an issue, from when they entered
+	// TriageStateNeedsIssue.
+	queueNeedsIssue = "needs_issue"
+	needsIssueSLA   = 7 * 24 * time.Hour
+
+	// Records whose issues are open, because no report covers them yet,
+	// from when the issue was created.
+	queueIssueOpen = "issue_open"
+	issueOpenSLA   = 30 * 24 * time.Hour
+)
+
+// Metrics of the triage queues, labeled by queue. They are recorded each
+// time the ages are checked.
+var (
+	queueSizeGauge = event.NewFloatGauge("triage_queue_size", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "CVEs and GHSAs in each triage queue.",
+	})
+	queueOverdueGauge = event.NewFloatGauge("triage_queue_overdue", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "CVEs and GHSAs that have been in each triage queue for longer than its SLA.",
+	})
+	queueOldestGauge = event.NewFloatGauge("triage_queue_oldest_age_seconds", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "How long the oldest CVE or GHSA in each triage queue has been in it.",
+	})
+)
+
+// A QueueAge describes the records in one triage queue.
+type QueueAge struct {
+	// Size is the number of records in the queue.
+	Size int
+	// Oldest is how long the oldest record has been in the queue.
+	Oldest time.Duration
+	// Overdue are the IDs of the records that have been in the queue for
+	// longer than its SLA, from oldest to newest.
+	Overdue []string
+}
+
+// AgingStats describes the triage queues.
+type AgingStats struct {
+	// NeedsIssue is the queue of CVEs and GHSAs in TriageStateNeedsIssue.
+	NeedsIssue QueueAge
+	// IssueOpen is the queue of CVEs and GHSAs with issues, but no reports.
+	IssueOpen QueueAge
+}
+
+// CheckTriageAging measures how long the CVEs and GHSAs in st have been
+// waiting for an issue, and for a report once they have an issue, and
+// records the results as metrics. If digest is true, it also sends them as a
+// notification, whether or not any records are overdue.
+func CheckTriageAging(ctx context.Context, st store.Store, digest bool) (_ AgingStats, err error) {
+	defer derrors.Wrap(&err, "CheckTriageAging")
+	return checkTriageAging(ctx, st, digest, time.Now())
+}
+
+func checkTriageAging(ctx context.Context, st store.Store, digest bool, now time.Time) (stats AgingStats, err error) {
+	ctx = event.Start(ctx, "checkTriageAging")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, nil, "triage-aging")
+	defer func() { end(err) }()
+
+	stats, err = triageQueues(ctx, st, now)
+	if err != nil {
+		return stats, err
+	}
+	for _, q := range []struct {
+		name string
+		age  QueueAge
+	}{
+		{queueNeedsIssue, stats.NeedsIssue},
+		{queueIssueOpen, stats.IssueOpen},
+	} {
+		label := event.String("queue", q.name)
+		queueSizeGauge.Record(ctx, float64(q.age.Size), label)
+		queueOverdueGauge.Record(ctx, float64(len(q.age.Overdue)), label)
+		queueOldestGauge.Record(ctx, q.age.Oldest.Seconds(), label)
+	}
+	if digest {
+		notify(ctx, &Notification{
+			Kind: NotifyTriageDigest,
+			Text: digestText(stats),
+			IDs:  append(append([]string{}, stats.NeedsIssue.Overdue...), stats.IssueOpen.Overdue...),
+		})
+	}
+	log.Infof(ctx, "triage aging check succeeded: %d need issues (%d overdue), %d issues open (%d overdue)",
+		stats.NeedsIssue.Size, len(stats.NeedsIssue.Overdue), stats.IssueOpen.Size, len(stats.IssueOpen.Overdue))
+	return stats, nil
+}
+
+// triageQueues returns the ages of the triage queues of the records in st,
+// as of now.
+func triageQueues(ctx context.Context, st store.Store, now time.Time) (stats AgingStats, err error) {
+	// The time each record entered its queue, by ID.
+	needsIssue := map[string]time.Time{}
+	issueOpen := map[string]time.Time{}
+	add := func(id string, ts store.TriageState, fallback, issueCreatedAt time.Time) error {
+		switch ts {
+		case store.TriageStateNeedsIssue:
+			entered, err := enteredNeedsIssue(ctx, st, id)
+			if err != nil {
+				return err
+			}
+			if entered.IsZero() {
+				// The record predates its triage history.
+				entered = fallback
+			}
+			needsIssue[id] = entered
+		case store.TriageStateIssueCreated, store.TriageStateUpdatedSinceIssueCreation:
+			if !issueCreatedAt.IsZero() {
+				issueOpen[id] = issueCreatedAt
+			}
+		}
+		return nil
+	}
+	for _, ts := range []store.TriageState{
+		store.TriageStateNeedsIssue,
+		store.TriageStateIssueCreated,
+		store.TriageStateUpdatedSinceIssueCreation,
+	} {
+		crs, _, err := st.ListCVERecords(ctx, store.CVERecordQuery{TriageState: ts})
+		if err != nil {
+			return stats, err
+		}
+		for _, cr := range crs {
+			if err := add(cr.ID, cr.TriageState, cr.CommitTime, cr.IssueCreatedAt); err != nil {
+				return stats, err
+			}
+		}
+	}
+	grs, err := getGHSARecords(ctx, st)
+	if err != nil {
+		return stats, err
+	}
+	for _, gr := range grs {
+		if err := add(gr.GHSA.ID, gr.TriageState, gr.GHSA.UpdatedAt, gr.IssueCreatedAt); err != nil {
+			return stats, err
+		}
+	}
+
+	stats.NeedsIssue = queueAge(needsIssue, needsIssueSLA, now)
+	stats.IssueOpen = queueAge(issueOpen, issueOpenSLA, now)
+	return stats, nil
+}
+
+// enteredNeedsIssue returns the time at which the record with the given ID
+// last entered TriageStateNeedsIssue, according to its triage history, or
+// the zero time if the history doesn't say.
+func enteredNeedsIssue(ctx context.Context, st store.Store, id string) (time.Time, error) {
+	es, err := st.ListTriageHistory(ctx, id)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for i := len(es) - 1; i >= 0; i-- {
+		if es[i].NewState == store.TriageStateNeedsIssue {
+			return es[i].Time, nil
+		}
+	}
+	return time.Time{}, nil
+}
+
+// queueAge returns the age of the queue of records that entered it at the
+// given times, by ID.
+func queueAge(entered map[string]time.Time, sla time.Duration, now time.Time) QueueAge {
+	var ids []string
+	for id := range entered {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		ti, tj := entered[ids[i]], entered[ids[j]]
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return ids[i] < ids[j]
+	})
+	q := QueueAge{Size: len(ids)}
+	for _, id := range ids {
+		age := now.Sub(entered[id])
+		if age > q.Oldest {
+			q.Oldest = age
+		}
+		if age > sla {
+			q.Overdue = append(q.Overdue, id)
+		}
+	}
+	return q
+}
+
+// digestText returns the text of the notification of a digest of stats.
+func digestText(stats AgingStats) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Triage digest: %d CVEs or GHSAs need issues, %d of them for more than %d days.",
+		stats.NeedsIssue.Size, len(stats.NeedsIssue.Overdue), int(needsIssueSLA.Hours()/24))
+	fmt.Fprintf(&b, " %d have issues without reports, %d of them for more than %d days.",
+		stats.IssueOpen.Size, len(stats.IssueOpen.Overdue), int(issueOpenSLA.Hours()/24))
+	if len(stats.NeedsIssue.Overdue)+len(stats.IssueOpen.Overdue) > 0 {
+		b.WriteString(" The overdue ones, oldest first:")
+	}
+	return b.String()
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestCheckTriageAging(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	// Records created now enter their triage state now, according to their
+	// triage history, so check the queues as of later.
+	created := time.Now()
+	now := created.Add(10 * 24 * time.Hour)
+	cve := func(id string, ts store.TriageState, issueCreatedAt time.Time) *store.CVERecord {
+		return &store.CVERecord{
+			ID:             id,
+			Path:           id + ".json",
+			BlobHash:       "abc",
+			CommitHash:     "123",
+			CommitTime:     created,
+			TriageState:    ts,
+			IssueCreatedAt: issueCreatedAt,
+		}
+	}
+	err := mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		// Entered NeedsIssue 10 days ago: overdue.
+		if err := tx.CreateCVERecord(cve("CVE-2022-0001", store.TriageStateNeedsIssue, time.Time{})); err != nil {
+			return err
+		}
+		// Entered NeedsIssue 5 days ago.
+		if err := tx.AddTriageHistory(&store.TriageHistoryEntry{
+			ID:       "CVE-2022-0002",
+			Time:     now.Add(-5 * 24 * time.Hour),
+			NewState: store.TriageStateNeedsIssue,
+		}); err != nil {
+			return err
+		}
+		if err := tx.CreateCVERecord(cve("CVE-2022-0002", store.TriageStateNeedsIssue, time.Time{})); err != nil {
+			return err
+		}
+		// Issue open for 40 days: overdue.
+		if err := tx.CreateCVERecord(cve("CVE-2022-0003", store.TriageStateIssueCreated, now.Add(-40*24*time.Hour))); err != nil {
+			return err
+		}
+		// Not in a queue.
+		if err := tx.CreateCVERecord(cve("CVE-2022-0004", store.TriageStateNoActionNeeded, time.Time{})); err != nil {
+			return err
+		}
+		// Issue open for 2 days.
+		return tx.CreateGHSARecord(&store.GHSARecord{
+			GHSA: &ghsa.SecurityAdvisory{
+				ID:        "GHSA-aaaa-bbbb-cccc",
+				UpdatedAt: created,
+				Vulns:     []*ghsa.Vuln{{Package: "example.com/a"}},
+			},
+			TriageState:    store.TriageStateIssueCreated,
+			IssueCreatedAt: now.Add(-2 * 24 * time.Hour),
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rn := &recordingNotifier{}
+	stats, err := checkTriageAging(WithNotifier(ctx, rn), mstore, true, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The time at which CVE-2022-0001 was created is only known roughly.
+	oldest := stats.NeedsIssue.Oldest
+	if oldest < 10*24*time.Hour-time.Minute || oldest > 10*24*time.Hour {
+		t.Errorf("oldest needing an issue: got %s, want about 10 days", oldest)
+	}
+	want := AgingStats{
+		NeedsIssue: QueueAge{Size: 2, Oldest: oldest, Overdue: []string{"CVE-2022-0001"}},
+		IssueOpen:  QueueAge{Size: 2, Oldest: 40 * 24 * time.Hour, Overdue: []string{"CVE-2022-0003"}},
+	}
+	if diff := cmp.Diff(want, stats); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	if len(rn.ns) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(rn.ns))
+	}
+	n := rn.ns[0]
+	if n.Kind != NotifyTriageDigest || !strings.Contains(n.Text, "2 CVEs or GHSAs need issues, 1 of them") {
+		t.Errorf("got notification %+v", n)
+	}
+	if diff := cmp.Diff([]string{"CVE-2022-0001", "CVE-2022-0003"}, n.IDs); diff != "" {
+		t.Errorf("notification IDs mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// ChangedCVEs returns the records of the CVEs that changed upstream after
+// they were found to have reports or to be aliases, in order of ID. Updates
+// leave those records in their triage state, so a person has to check
+// whether the reports need to change too. (CVEs that changed after their
+// issues were created are UpdatedSinceIssueCreation.)
+func ChangedCVEs(ctx context.Context, st store.Store) (_ []*store.CVERecord, err error) {
+	defer derrors.Wrap(&err, "ChangedCVEs")
+
+	var changed []*store.CVERecord
+	for _, ts := range []store.TriageState{store.TriageStateAlias, store.TriageStateHasVuln} {
+		crs, _, err := st.ListCVERecords(ctx, store.CVERecordQuery{TriageState: ts})
+		if err != nil {
+			return nil, err
+		}
+		for _, cr := range crs {
+			if cr.Change != nil {
+				changed = append(changed, cr)
+			}
+		}
+	}
+	sort.Slice(changed, func(i, j int) bool { return changed[i].ID < changed[j].ID })
+	return changed, nil
+}
+
+// AcknowledgeCVEChange records that the change to the CVE with the given ID
+// since it was triaged does not change its triage, so that the CVE's current
+// content becomes the basis for later changes. A record that was updated
+// since its issue was created goes back to IssueCreated.
+func AcknowledgeCVEChange(ctx context.Context, st store.Store, id, reason string) (_ *store.CVERecord, err error) {
+	defer derrors.Wrap(&err, "AcknowledgeCVEChange(%s)", id)
+
+	var mod *store.CVERecord
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		crs, err := tx.GetCVERecords(id, id)
+		if err != nil {
+			return err
+		}
+		if len(crs) == 0 {
+			return fmt.Errorf("no record for %s; it may need an update", id)
+		}
+		old := crs[0]
+		if old.Change == nil {
+			return fmt.Errorf("%s has not changed since it was triaged", id)
+		}
+		c := *old // copy the old one
+		mod = &c
+		mod.TriagedContent = old.Change.New
+		mod.Change = nil
+		if old.TriageState == store.TriageStateUpdatedSinceIssueCreation {
+			mod.TriageState = store.TriageStateIssueCreated
+			mod.TriageStateReason = reason
+			mod.History = append([]*store.CVERecordSnapshot{old.Snapshot()}, old.History...)
+		}
+		setSeenVersion(ctx, mod)
+		return tx.SetCVERecord(mod)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mod, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/safehtml/template"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestAcknowledgeCVEChange(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	old := &store.CVEContent{State: "PUBLIC", Description: "old", References: []string{"https://example.com/a"}}
+	cur := &store.CVEContent{State: "PUBLIC", Description: "new", References: []string{"https://example.com/a", "https://example.com/b"}}
+	change := &store.CVEChange{CommitHash: "456", CommitTime: time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC), Old: old, New: cur}
+	cve := func(id string, ts store.TriageState, change *store.CVEChange) *store.CVERecord {
+		return &store.CVERecord{
+			ID:             id,
+			Path:           id + ".json",
+			BlobHash:       "abc",
+			CommitHash:     "456",
+			CommitTime:     time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC),
+			TriageState:    ts,
+			TriagedContent: old,
+			Change:         change,
+		}
+	}
+	createCVERecords(t, mstore, []*store.CVERecord{
+		cve("CVE-2022-0001", store.TriageStateHasVuln, change),
+		cve("CVE-2022-0002", store.TriageStateHasVuln, nil),
+		cve("CVE-2022-0003", store.TriageStateUpdatedSinceIssueCreation, change),
+		cve("CVE-2022-0004", store.TriageStateAlias, change),
+	})
+
+	crs, err := ChangedCVEs(ctx, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, cr := range crs {
+		got = append(got, cr.ID)
+	}
+	if want := []string{"CVE-2022-0001", "CVE-2022-0004"}; strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("got changed CVEs %v, want %v", got, want)
+	}
+
+	staticPath := template.TrustedSourceFromConstant("static")
+	tmpl, err := parseTemplate(staticPath, template.TrustedSourceFromConstant("cve.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	if err := renderPage(ctx, w, cvePage{Record: crs[0]}, tmpl); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"1 references added, description changed", "https://example.com/b", "/cve/CVE-2022-0001/ack-change"} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Errorf("page does not contain %q", want)
+		}
+	}
+
+	for _, id := range []string{"CVE-2022-0001", "CVE-2022-0003"} {
+		if _, err := AcknowledgeCVEChange(ctx, mstore, id, "no change to the report"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for id, want := range map[string]store.TriageState{
+		"CVE-2022-0001": store.TriageStateHasVuln,
+		"CVE-2022-0003": store.TriageStateIssueCreated,
+	} {
+		cr := mstore.CVERecords()[id]
+		if cr.TriageState != want || cr.Change != nil || !cr.TriagedContent.Equal(cur) {
+			t.Errorf("%s: got state %s, change %v and content %+v; want %s, no change and the new content",
+				id, cr.TriageState, cr.Change, cr.TriagedContent, want)
+		}
+	}
+	if _, err := AcknowledgeCVEChange(ctx, mstore, "CVE-2022-0002", "r"); err == nil {
+		t.Error("acknowledging an unchanged CVE: got no error, want one")
+	}
+}
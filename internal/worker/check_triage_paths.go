@@ -0,0 +1,50 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Program to validate the triage paths file.
+
+// Run this program from this directory after editing the file:
+//     go run check_triage_paths.go [FILE]
+//
+// FILE defaults to triage_paths.yaml. The program reports the first problem
+// with the file, or prints a summary of its contents. Also run the tests in
+// this directory, which fail if the triage of known CVEs changes.
+
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"golang.org/x/vulndb/internal/worker"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: go run check_triage_paths.go [FILE]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	filename := "triage_paths.yaml"
+	switch flag.NArg() {
+	case 0:
+	case 1:
+		filename = flag.Arg(0)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	tp, err := worker.ReadTriagePaths(filename)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s is valid: %d package hosts, %d Go project keywords, %d Go vulnerability keywords, %d paths that are not Go modules, %d CPE vendors, %d CPE target software\n",
+		filename, len(tp.PackageHosts), len(tp.GoProjectKeywords), len(tp.GoVulnKeywords), len(tp.NotGoModules),
+		len(tp.GoProjectCPEVendors), len(tp.GoCPETargetSW))
+}
@@ -0,0 +1,308 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A classifier scores the CVEs that triage decides need issues by how likely
+// they are to be Go vulnerabilities. The score is kept in the CVE's record,
+// and the triage page lists the records that need issues with the likeliest
+// first, so that people triage those before the rest. The classifier is
+// optional, and doesn't change triage: a CVE that it scores low still needs
+// an issue.
+
+// A TriageClassifier scores CVEs by how likely they are to be Go
+// vulnerabilities.
+type TriageClassifier interface {
+	// Score returns the probability, from 0 to 1, that cve is a Go
+	// vulnerability.
+	Score(ctx context.Context, cve *cveschema.CVE) (float64, error)
+}
+
+// The classifier that scores CVEs that need issues, if any.
+var triageClassifier TriageClassifier
+
+// SetTriageClassifier makes triage score the CVEs that need issues with c.
+func SetTriageClassifier(c TriageClassifier) {
+	triageClassifier = c
+}
+
+// scoreCVE returns the score of cve from the triage classifier, or 0 if there
+// is no classifier. Scoring is best effort: an error is logged, and the score
+// is 0.
+func scoreCVE(ctx context.Context, cve *cveschema.CVE) float64 {
+	if triageClassifier == nil {
+		return 0
+	}
+	score, err := triageClassifier.Score(ctx, cve)
+	if err != nil {
+		log.Warningf(ctx, "scoring %s: %v", cve.ID, err)
+		return 0
+	}
+	return score
+}
+
+// BuiltinClassifier is the value of Config.TriageClassifier that selects a
+// classifier trained on the vulndb repo and the false-positives file.
+const BuiltinClassifier = "builtin"
+
+// NewTriageClassifier returns the classifier that spec, a value of
+// Config.TriageClassifier, describes: the built-in classifier, trained on the
+// reports of the vulndb repo at vulnDBRepo and on the false-positives file,
+// or a model served at a URL.
+func NewTriageClassifier(ctx context.Context, spec, vulnDBRepo string) (_ TriageClassifier, err error) {
+	defer derrors.Wrap(&err, "NewTriageClassifier(%q)", spec)
+
+	if spec != BuiltinClassifier {
+		return newEndpointClassifier(spec)
+	}
+	if vulnDBRepo == "" {
+		return nil, fmt.Errorf("the %s classifier needs the vulndb repo", BuiltinClassifier)
+	}
+	reports, err := LoadGoReports(ctx, vulnDBRepo)
+	if err != nil {
+		return nil, err
+	}
+	fps, err := loadFalsePositives()
+	if err != nil {
+		return nil, err
+	}
+	examples := classifierExamples(reports, fps)
+	c := TrainLogisticClassifier(examples)
+	log.Infof(ctx, "trained triage classifier on %d examples, with %d features", len(examples), len(c.Weights))
+	return c, nil
+}
+
+// A ClassifierExample is a triaged CVE to train a classifier on.
+type ClassifierExample struct {
+	// ReferenceURLs are the URLs of the CVE's references.
+	ReferenceURLs []string
+	// Go reports whether the CVE is a Go vulnerability.
+	Go bool
+}
+
+// classifierExamples returns the examples to train the built-in classifier
+// on: the reports of vulndb, which are Go vulnerabilities, and the records of
+// the false-positives file, which are Go vulnerabilities if they are covered
+// by reports and are not otherwise.
+func classifierExamples(reports []*GoReport, fps []*store.CVERecord) []*ClassifierExample {
+	var es []*ClassifierExample
+	for _, r := range reports {
+		// Excluded reports are for CVEs that are about Go, but some are not
+		// about Go code, so they are left out.
+		if r.Excluded || len(r.References) == 0 {
+			continue
+		}
+		es = append(es, &ClassifierExample{ReferenceURLs: r.References, Go: true})
+	}
+	for _, cr := range fps {
+		if len(cr.ReferenceURLs) == 0 {
+			continue
+		}
+		es = append(es, &ClassifierExample{
+			ReferenceURLs: cr.ReferenceURLs,
+			Go:            cr.TriageState == store.TriageStateHasVuln,
+		})
+	}
+	return es
+}
+
+// classifierFeatures returns the features of a CVE with the given reference
+// URLs: the host of each URL, its first one and two path elements, and the
+// words in it.
+func classifierFeatures(refURLs []string) []string {
+	set := map[string]bool{}
+	for _, r := range refURLs {
+		u, err := url.Parse(r)
+		if err != nil || u.Host == "" {
+			continue
+		}
+		host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+		set["host:"+host] = true
+		elems := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+		for i := 1; i <= 2 && i <= len(elems); i++ {
+			set["path:"+host+"/"+strings.Join(elems[:i], "/")] = true
+		}
+		words := strings.FieldsFunc(strings.ToLower(host+u.Path), func(r rune) bool {
+			return !('a' <= r && r <= 'z' || '0' <= r && r <= '9')
+		})
+		for _, w := range words {
+			if len(w) >= 2 {
+				set["word:"+w] = true
+			}
+		}
+	}
+	fs := make([]string, 0, len(set))
+	for f := range set {
+		fs = append(fs, f)
+	}
+	sort.Strings(fs)
+	return fs
+}
+
+// A LogisticClassifier is a logistic regression model over the features of
+// a CVE's reference URLs.
+type LogisticClassifier struct {
+	Bias    float64
+	Weights map[string]float64
+}
+
+// The parameters of the training of a LogisticClassifier.
+const (
+	classifierEpochs       = 30
+	classifierLearningRate = 0.1
+	classifierL2           = 1e-4
+)
+
+// TrainLogisticClassifier trains a LogisticClassifier on examples with
+// stochastic gradient descent. The examples of each class weigh the same in
+// total, because Go vulnerabilities are a small part of the CVEs that look
+// like them. Training is deterministic.
+func TrainLogisticClassifier(examples []*ClassifierExample) *LogisticClassifier {
+	c := &LogisticClassifier{Weights: map[string]float64{}}
+	var numGo int
+	features := make([][]string, len(examples))
+	for i, e := range examples {
+		features[i] = classifierFeatures(e.ReferenceURLs)
+		if e.Go {
+			numGo++
+		}
+	}
+	if numGo == 0 || numGo == len(examples) {
+		return c
+	}
+	goWeight := float64(len(examples)) / (2 * float64(numGo))
+	otherWeight := float64(len(examples)) / (2 * float64(len(examples)-numGo))
+	for epoch := 0; epoch < classifierEpochs; epoch++ {
+		for i, e := range examples {
+			p := c.score(features[i])
+			y, w := 0.0, otherWeight
+			if e.Go {
+				y, w = 1, goWeight
+			}
+			g := w * (p - y)
+			c.Bias -= classifierLearningRate * g
+			for _, f := range features[i] {
+				c.Weights[f] -= classifierLearningRate * (g + classifierL2*c.Weights[f])
+			}
+		}
+	}
+	return c
+}
+
+// Score implements TriageClassifier.Score.
+func (c *LogisticClassifier) Score(_ context.Context, cve *cveschema.CVE) (float64, error) {
+	var refs []string
+	for _, r := range cve.References.Data {
+		refs = append(refs, r.URL)
+	}
+	return c.score(classifierFeatures(refs)), nil
+}
+
+func (c *LogisticClassifier) score(features []string) float64 {
+	z := c.Bias
+	for _, f := range features {
+		z += c.Weights[f]
+	}
+	return 1 / (1 + math.Exp(-z))
+}
+
+// An endpointClassifier scores CVEs with a model served at a URL. It POSTs a
+// JSON classifierRequest for each CVE, and the response is a JSON
+// classifierResponse.
+type endpointClassifier struct {
+	url    string
+	client *http.Client
+}
+
+// classifierRequest is the body of a request to an endpointClassifier.
+type classifierRequest struct {
+	ID          string   `json:"id"`
+	References  []string `json:"references"`
+	Description string   `json:"description"`
+}
+
+// classifierResponse is the body of a response from an endpointClassifier.
+type classifierResponse struct {
+	Score float64 `json:"score"`
+}
+
+// endpointClassifierTimeout is the timeout of a request to an
+// endpointClassifier.
+const endpointClassifierTimeout = 10 * time.Second
+
+func newEndpointClassifier(rawURL string) (*endpointClassifier, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("want %q or an HTTP URL", BuiltinClassifier)
+	}
+	return &endpointClassifier{url: rawURL, client: &http.Client{Timeout: endpointClassifierTimeout}}, nil
+}
+
+// Score implements TriageClassifier.Score.
+func (c *endpointClassifier) Score(ctx context.Context, cve *cveschema.CVE) (_ float64, err error) {
+	defer derrors.Wrap(&err, "endpointClassifier.Score(%s)", cve.ID)
+
+	creq := classifierRequest{ID: cve.ID, References: []string{}}
+	for _, r := range cve.References.Data {
+		creq.References = append(creq.References, r.URL)
+	}
+	for _, d := range cve.Description.Data {
+		if d.Lang == "eng" {
+			creq.Description = d.Value
+			break
+		}
+	}
+	body, err := json.Marshal(creq)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%s returned status %s", c.url, resp.Status)
+	}
+	var cresp classifierResponse
+	if err := json.NewDecoder(resp.Body).Decode(&cresp); err != nil {
+		return 0, err
+	}
+	if cresp.Score < 0 || cresp.Score > 1 || math.IsNaN(cresp.Score) {
+		return 0, fmt.Errorf("score %v is not between 0 and 1", cresp.Score)
+	}
+	return cresp.Score, nil
+}
+
+// sortByGoScore sorts crs by the scores from the triage classifier, highest
+// first.
+func sortByGoScore(crs []*store.CVERecord) {
+	sort.SliceStable(crs, func(i, j int) bool { return crs[i].GoScore > crs[j].GoScore })
+}
@@ -0,0 +1,174 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func cveWithRefs(id string, refs ...string) *cveschema.CVE {
+	c := &cveschema.CVE{Metadata: cveschema.Metadata{ID: id, State: cveschema.StatePublic}}
+	for _, r := range refs {
+		c.References.Data = append(c.References.Data, cveschema.Reference{URL: r})
+	}
+	return c
+}
+
+func TestLogisticClassifier(t *testing.T) {
+	ctx := context.Background()
+	examples := []*ClassifierExample{
+		{ReferenceURLs: []string{"https://pkg.go.dev/github.com/a/b", "https://github.com/a/b/pull/1"}, Go: true},
+		{ReferenceURLs: []string{"https://github.com/golang/go/issues/1"}, Go: true},
+		{ReferenceURLs: []string{"https://go.dev/cl/2", "https://groups.google.com/g/golang-announce/c/x"}, Go: true},
+		{ReferenceURLs: []string{"https://github.com/tensorflow/tensorflow/issues/3"}},
+		{ReferenceURLs: []string{"https://wordpress.org/plugins/x", "https://wpscan.com/vulnerability/4"}},
+		{ReferenceURLs: []string{"https://github.com/c/d/commit/5", "https://www.npmjs.com/package/d"}},
+		{ReferenceURLs: []string{"https://github.com/e/f/issues/6", "https://pypi.org/project/f"}},
+		{ReferenceURLs: []string{"https://example.com/advisory/7"}},
+	}
+	c := TrainLogisticClassifier(examples)
+	if diff := cmp.Diff(c, TrainLogisticClassifier(examples)); diff != "" {
+		t.Errorf("training is not deterministic (-first, +second):\n%s", diff)
+	}
+
+	for _, test := range []struct {
+		refs   []string
+		goVuln bool
+	}{
+		{[]string{"https://pkg.go.dev/github.com/x/y"}, true},
+		{[]string{"https://go.dev/issue/8"}, true},
+		{[]string{"https://wordpress.org/plugins/y"}, false},
+		{[]string{"https://www.npmjs.com/package/z"}, false},
+	} {
+		score, err := c.Score(ctx, cveWithRefs("CVE-2022-0001", test.refs...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (score > 0.5) != test.goVuln {
+			t.Errorf("%v: got score %.2f, want Go vuln %t", test.refs, score, test.goVuln)
+		}
+	}
+
+	// With one class, there is nothing to learn.
+	if c := TrainLogisticClassifier(examples[:3]); len(c.Weights) != 0 || c.Bias != 0 {
+		t.Errorf("one class: got %+v, want empty classifier", c)
+	}
+}
+
+func TestClassifierExamples(t *testing.T) {
+	reports := []*GoReport{
+		{ID: "GO-2022-0001", References: []string{"https://go.dev/issue/1"}},
+		{ID: "GO-2022-0002", Excluded: true, References: []string{"https://example.com/2"}},
+		{ID: "GO-2022-0003"},
+	}
+	fps := []*store.CVERecord{
+		{ID: "CVE-2022-0004", TriageState: store.TriageStateFalsePositive, ReferenceURLs: []string{"https://example.com/4"}},
+		{ID: "CVE-2022-0005", TriageState: store.TriageStateHasVuln, ReferenceURLs: []string{"https://example.com/5"}},
+		{ID: "CVE-2022-0006", TriageState: store.TriageStateFalsePositive},
+	}
+	got := classifierExamples(reports, fps)
+	want := []*ClassifierExample{
+		{ReferenceURLs: []string{"https://go.dev/issue/1"}, Go: true},
+		{ReferenceURLs: []string{"https://example.com/4"}},
+		{ReferenceURLs: []string{"https://example.com/5"}, Go: true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestEndpointClassifier(t *testing.T) {
+	ctx := context.Background()
+	score := 0.75
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req classifierRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.ID != "CVE-2022-0001" || len(req.References) != 1 {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(classifierResponse{Score: score})
+	}))
+	defer s.Close()
+
+	c, err := NewTriageClassifier(ctx, s.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cve := cveWithRefs("CVE-2022-0001", "https://go.dev/issue/1")
+	got, err := c.Score(ctx, cve)
+	if err != nil || got != score {
+		t.Errorf("got %v, %v; want %v, nil", got, err, score)
+	}
+	if _, err := c.Score(ctx, cveWithRefs("CVE-2022-0002")); err == nil {
+		t.Error("bad request: got nil, want error")
+	}
+	score = 2
+	if _, err := c.Score(ctx, cve); err == nil {
+		t.Error("score out of range: got nil, want error")
+	}
+
+	for _, spec := range []string{"ftp://example.com", "model", BuiltinClassifier} {
+		if _, err := NewTriageClassifier(ctx, spec, ""); err == nil {
+			t.Errorf("%q: got nil, want error", spec)
+		}
+	}
+}
+
+// fakeClassifier scores every CVE the same.
+type fakeClassifier float64
+
+func (c fakeClassifier) Score(context.Context, *cveschema.CVE) (float64, error) {
+	return float64(c), nil
+}
+
+func TestTriageAndStoreScore(t *testing.T) {
+	defer SetTriageClassifier(nil)
+	SetTriageClassifier(fakeClassifier(0.9))
+
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	src := cveSource{path: "p", blobHash: "b", commitHash: "c", commitTime: time.Now().UTC()}
+	for _, test := range []struct {
+		result *triageResult
+		want   float64
+	}{
+		{&triageResult{modulePath: "example.com/m"}, 0.9},
+		// Only CVEs that need issues are scored.
+		{&triageResult{falsePositive: true}, 0},
+		{nil, 0},
+	} {
+		cve := cveWithRefs("CVE-2022-0001", "https://example.com/m")
+		triage := func(context.Context, *cveschema.CVE) (*triageResult, error) { return test.result, nil }
+		if err := mstore.Clear(ctx); err != nil {
+			t.Fatal(err)
+		}
+		err := mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+			_, err := triageAndStoreCVE(ctx, tx, cve, nil, src, nil, triage)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := mstore.CVERecords()[cve.ID].GoScore; got != test.want {
+			t.Errorf("%+v: got score %v, want %v", test.result, got, test.want)
+		}
+	}
+}
@@ -5,8 +5,14 @@
 package worker
 
 import (
+	"crypto/ed25519"
 	"errors"
+	"os"
+	"time"
 
+	"golang.org/x/vulndb/internal/cron"
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/database"
 	"golang.org/x/vulndb/internal/worker/store"
 )
 
@@ -18,7 +24,9 @@ type Config struct {
 	// Project is the Google Cloud Project where the resources live.
 	Project string
 
-	// Namespace is the Firstore namespace to use.
+	// Namespace is the Firestore namespace or Postgres schema to use. It
+	// separates the data of environments, such as prod and staging, that
+	// share a project or database. It also labels notifications.
 	Namespace string
 
 	// UseErrorReporting determines whether errors go to the Error Reporting API.
@@ -31,19 +39,209 @@ type Config struct {
 	// GitHubAccessToken is the token needed to authorize to the GitHub API.
 	GitHubAccessToken string
 
+	// AutoCreateIssues makes the server create issues for the CVEs and GHSAs
+	// that need them at the end of each update, rather than waiting for a
+	// request to /issues. It requires IssueRepo.
+	AutoCreateIssues bool
+
+	// NVDAPIKey is the key for the NVD CVE API. It is optional, but requests
+	// without it are rate-limited more strictly.
+	NVDAPIKey string
+
+	// TriageRulesFile is the path to a file of triage rules. If it is
+	// non-empty, the rules in it are applied before the built-in triage
+	// heuristics. The file is read again when it changes.
+	TriageRulesFile string
+
+	// TriageClassifier selects the classifier that scores the CVEs that need
+	// issues by how likely they are to be Go vulnerabilities: "builtin" for
+	// one trained on the reports of VulnDBRepo and the false-positives file,
+	// or the URL of a model endpoint. If it is empty, CVEs are not scored.
+	TriageClassifier string
+
+	// UpdateParallelism is the number of CVEs that an update from the
+	// cvelist repo parses and triages at once. If it is zero, a default is
+	// used.
+	UpdateParallelism int
+
+	// CVEListRepoURL is the URL of the cvelist repo to update from. It can
+	// be a private mirror, with credentials from the environment as
+	// described by gitrepo.AuthFromEnv. If it is empty, the public repo is
+	// used.
+	CVEListRepoURL string
+
+	// RepoCacheDir is a directory on local disk in which to keep a clone of
+	// the cvelist repo with its full history between updates. If it is
+	// empty, each update clones HEAD into memory.
+	RepoCacheDir string
+
+	// VulnDBRepo is the URL or local directory of the vulndb repo. Issue
+	// creation checks its reports for ones that a CVE may duplicate, and
+	// reconciliation checks records against them. If it is empty, issue
+	// creation checks only open issues, and the server doesn't reconcile.
+	VulnDBRepo string
+
+	// OverlayDir is the local directory of a git repo of private reports,
+	// some of them embargoed, and DBDir the directory of the database that
+	// gendb built from VulnDBRepo, which must then be a local directory,
+	// and the overlay. When the embargo of a report in the overlay ends,
+	// the server regenerates the database in DBDir to publish it, signed
+	// with the PEM-encoded Ed25519 private key in SigningKeyFile, if it is
+	// set. If either OverlayDir or DBDir is empty, the server doesn't check
+	// embargoes.
+	OverlayDir     string
+	DBDir          string
+	SigningKeyFile string
+
+	// UpdateSchedule, GHSASchedule, IssueSchedule, ReconcileSchedule,
+	// ReportGapsSchedule, DepsDevSchedule, SecurityReleasesSchedule,
+	// UnfixedSchedule, EmbargoSchedule, AgingSchedule, DigestSchedule,
+	// SummarySchedule and WithdrawalSchedule are cron expressions for when
+	// the server updates from the cvelist repo, updates from the GitHub
+	// security advisories, creates issues, reconciles records with issues
+	// and reports, searches for report gaps, checks the reports against
+	// deps.dev, checks the reports against the security fixes in the Go
+	// release notes, checks the modules of unfixed reports for new versions,
+	// publishes the overlay reports whose embargoes have ended, records the
+	// ages of the triage queues as metrics, sends a digest of them, posts a
+	// summary of the week's triage, and flags the reports and issues of CVEs
+	// rejected or disputed upstream, without waiting for a request. An
+	// empty expression disables the task. IssueSchedule, ReconcileSchedule,
+	// SecurityReleasesSchedule, UnfixedSchedule and WithdrawalSchedule
+	// require IssueRepo; ReconcileSchedule, ReportGapsSchedule,
+	// DepsDevSchedule, SecurityReleasesSchedule, UnfixedSchedule,
+	// EmbargoSchedule and WithdrawalSchedule require VulnDBRepo;
+	// EmbargoSchedule requires OverlayDir and DBDir; and SummarySchedule
+	// requires IssueRepo and SummaryIssue.
+	UpdateSchedule           string
+	GHSASchedule             string
+	IssueSchedule            string
+	ReconcileSchedule        string
+	ReportGapsSchedule       string
+	DepsDevSchedule          string
+	SecurityReleasesSchedule string
+	UnfixedSchedule          string
+	EmbargoSchedule          string
+	AgingSchedule            string
+	DigestSchedule           string
+	SummarySchedule          string
+	WithdrawalSchedule       string
+
+	// SummaryIssue is the number of the issue in IssueRepo that the weekly
+	// triage summary is posted on, as a comment. The summary counts the
+	// reports published in VulnDBRepo, if it is set.
+	SummaryIssue int
+
+	// SlackWebhookURL is the URL of a Slack incoming webhook, and WebhookURL
+	// the URL of any other webhook, that the server notifies when updates move
+	// CVEs or GHSAs to NeedsIssue, when updates fail, and when creating issues
+	// fails. Slack is sent text; other webhooks are sent a JSON Notification.
+	// Both are optional.
+	SlackWebhookURL string
+	WebhookURL      string
+
+	// OTLPEndpoint is the URL of an OpenTelemetry collector, like
+	// "http://localhost:4318", to send traces to with OTLP over HTTP. If it
+	// is empty, the server sends traces to Cloud Trace and the command-line
+	// tool doesn't trace.
+	OTLPEndpoint string
+
+	// DrainWindow is how long the server waits, after it is told to shut
+	// down, for requests and canceled jobs to finish. It should be shorter
+	// than the time the platform gives the server to stop; Cloud Run gives
+	// ten seconds after SIGTERM.
+	DrainWindow time.Duration
+
 	// Store is the implementation of store.Store used by the server.
 	Store store.Store
 }
 
-func (c *Config) Validate() error {
-	if c.Project == "" {
-		return errors.New("missing project")
+// cveListRepoURL returns the URL of the cvelist repo to update from.
+func (c *Config) cveListRepoURL() string {
+	if c.CVEListRepoURL != "" {
+		return c.CVEListRepoURL
 	}
+	return cvelistrepo.URL
+}
+
+// Validate checks the fields of c that every worker configuration needs.
+// Project is not checked, because it is needed only with Firestore and by the
+// server.
+func (c *Config) Validate() error {
 	if c.Namespace == "" {
 		return errors.New("missing namespace")
 	}
+	if err := store.ValidateNamespace(c.Namespace); err != nil {
+		return err
+	}
 	if c.IssueRepo != "" && c.GitHubAccessToken == "" {
 		return errors.New("issue repo requires access token")
 	}
+	if c.AutoCreateIssues && c.IssueRepo == "" {
+		return errors.New("automatic issue creation requires issue repo")
+	}
+	if c.UpdateParallelism < 0 {
+		return errors.New("update parallelism must not be negative")
+	}
+	if c.DrainWindow < 0 {
+		return errors.New("drain window must not be negative")
+	}
+	if c.IssueSchedule != "" && c.IssueRepo == "" {
+		return errors.New("scheduled issue creation requires issue repo")
+	}
+	if c.ReconcileSchedule != "" && c.IssueRepo == "" {
+		return errors.New("scheduled reconciliation requires issue repo")
+	}
+	if c.ReconcileSchedule != "" && c.VulnDBRepo == "" {
+		return errors.New("scheduled reconciliation requires vulndb repo")
+	}
+	if c.ReportGapsSchedule != "" && c.VulnDBRepo == "" {
+		return errors.New("scheduled report gaps search requires vulndb repo")
+	}
+	if c.DepsDevSchedule != "" && c.VulnDBRepo == "" {
+		return errors.New("scheduled deps.dev check requires vulndb repo")
+	}
+	if c.SecurityReleasesSchedule != "" && (c.IssueRepo == "" || c.VulnDBRepo == "") {
+		return errors.New("scheduled security release scan requires issue repo and vulndb repo")
+	}
+	if c.UnfixedSchedule != "" && (c.IssueRepo == "" || c.VulnDBRepo == "") {
+		return errors.New("scheduled unfixed module check requires issue repo and vulndb repo")
+	}
+	if c.EmbargoSchedule != "" && (c.VulnDBRepo == "" || c.OverlayDir == "" || c.DBDir == "") {
+		return errors.New("scheduled embargo check requires vulndb repo, overlay dir and DB dir")
+	}
+	if c.WithdrawalSchedule != "" && (c.IssueRepo == "" || c.VulnDBRepo == "") {
+		return errors.New("scheduled withdrawal check requires issue repo and vulndb repo")
+	}
+	if c.SummarySchedule != "" && (c.IssueRepo == "" || c.SummaryIssue == 0) {
+		return errors.New("scheduled triage summary requires issue repo and summary issue")
+	}
+	if c.SigningKeyFile != "" && c.DBDir == "" {
+		return errors.New("signing key file requires DB dir")
+	}
+	if c.TriageClassifier == BuiltinClassifier && c.VulnDBRepo == "" {
+		return errors.New("builtin triage classifier requires vulndb repo")
+	}
+	for _, spec := range []string{c.UpdateSchedule, c.GHSASchedule, c.IssueSchedule, c.ReconcileSchedule, c.ReportGapsSchedule, c.DepsDevSchedule, c.SecurityReleasesSchedule, c.UnfixedSchedule, c.EmbargoSchedule, c.AgingSchedule, c.DigestSchedule, c.SummarySchedule, c.WithdrawalSchedule} {
+		if spec == "" {
+			continue
+		}
+		if _, err := cron.Parse(spec); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// SigningKey returns the private key in c.SigningKeyFile, or nil if it is
+// empty.
+func (c *Config) SigningKey() (ed25519.PrivateKey, error) {
+	if c.SigningKeyFile == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(c.SigningKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return database.ParseSigningKey(data)
+}
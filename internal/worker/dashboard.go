@@ -0,0 +1,377 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+// This file has the pages of the server for triaging CVEs and for
+// navigating reports.
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// triageStates are the triage states shown on the triage page, in order.
+var triageStates = []store.TriageState{
+	store.TriageStateNeedsIssue,
+	store.TriageStateUpdatedSinceIssueCreation,
+	store.TriageStateIssueCreated,
+	store.TriageStateAlias,
+	store.TriageStateFalsePositive,
+	store.TriageStateHasVuln,
+	store.TriageStateNotAModule,
+	store.TriageStateNoActionNeeded,
+}
+
+// triagePageLimit is the maximum number of CVE records shown for each
+// triage state on the triage page.
+const triagePageLimit = 50
+
+type triagePage struct {
+	Namespace string
+	// Sort is how the records that need issues are sorted: "go", "epss" or
+	// "importers".
+	Sort string
+	// Scored reports whether the worker has a triage classifier.
+	Scored bool
+	Groups []*triageGroup
+}
+
+// A triageGroup is the CVE records with one triage state.
+type triageGroup struct {
+	State   store.TriageState
+	Records []*store.CVERecord
+	// If true, there are more records than shown.
+	More bool
+}
+
+// handleTriage serves the triage page. The records that need issues are
+// sorted by the sort query param: by their scores from the triage classifier
+// for "go", by their EPSS scores for "epss", or by the importers of their
+// modules for "importers". The default is "go" if the worker has a
+// classifier, and "epss" otherwise.
+func (s *Server) handleTriage(w http.ResponseWriter, r *http.Request) error {
+	page := triagePage{Namespace: s.cfg.Namespace, Sort: "epss", Scored: triageClassifier != nil}
+	if page.Scored {
+		page.Sort = "go"
+	}
+	switch srt := r.FormValue("sort"); srt {
+	case "go", "epss", "importers":
+		page.Sort = srt
+	}
+	g, ctx := errgroup.WithContext(r.Context())
+	for _, ts := range triageStates {
+		group := &triageGroup{State: ts}
+		page.Groups = append(page.Groups, group)
+		if ts == store.TriageStateNeedsIssue {
+			// Show the records most likely to be Go vulnerabilities,
+			// most likely to be exploited, or with the most widely used
+			// modules, first.
+			g.Go(func() error {
+				crs, err := s.cfg.Store.ListCVERecordsWithTriageState(ctx, group.State)
+				if err != nil {
+					return err
+				}
+				switch page.Sort {
+				case "go":
+					sortByGoScore(crs)
+				case "importers":
+					sortByImporters(crs)
+				default:
+					sortByEPSS(crs)
+				}
+				if len(crs) > triagePageLimit {
+					crs, group.More = crs[:triagePageLimit], true
+				}
+				group.Records = crs
+				return nil
+			})
+			continue
+		}
+		g.Go(func() error {
+			crs, next, err := s.cfg.Store.ListCVERecords(ctx, store.CVERecordQuery{
+				TriageState: group.State,
+				Limit:       triagePageLimit,
+			})
+			group.Records, group.More = crs, next != ""
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	return renderPage(r.Context(), w, page, s.triageTemplate)
+}
+
+type cvePage struct {
+	Namespace   string
+	Record      *store.CVERecord
+	SourceURL   string
+	Description string
+	References  []string
+	Aliases     []string
+	History     []*store.TriageHistoryEntry
+}
+
+// handleCVE serves the detail page of a CVE at /cve/ID, and handles the
+// forms on it, which POST to /cve/ID/ACTION.
+func (s *Server) handleCVE(w http.ResponseWriter, r *http.Request) error {
+	id, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/cve/"), "/")
+	if id == "" {
+		return &serverError{status: http.StatusNotFound, err: errors.New("missing CVE ID")}
+	}
+	if action != "" {
+		return s.doCVEAction(w, r, id, action)
+	}
+
+	ctx := r.Context()
+	cr, err := s.cfg.Store.GetCVERecord(ctx, id)
+	if err != nil {
+		return err
+	}
+	if cr == nil {
+		return &serverError{status: http.StatusNotFound, err: fmt.Errorf("no record for %s", id)}
+	}
+	page := cvePage{
+		Namespace:  s.cfg.Namespace,
+		Record:     cr,
+		SourceURL:  cr.Path,
+		References: cr.ReferenceURLs,
+	}
+	// Records from the NVD have URLs as paths; those from the cvelist repo
+	// have paths in the repo.
+	if !strings.HasPrefix(cr.Path, "https://") {
+		page.SourceURL = fmt.Sprintf("%s/tree/%s/%s", cvelistrepo.URL, cr.CommitHash, cr.Path)
+	}
+	if cr.CVE != nil {
+		if len(cr.CVE.Description.Data) > 0 {
+			page.Description = cr.CVE.Description.Data[0].Value
+		}
+		page.References = nil
+		for _, ref := range cr.CVE.References.Data {
+			page.References = append(page.References, ref.URL)
+		}
+	}
+	page.Aliases, err = s.cfg.Store.GetAliases(ctx, id)
+	if err != nil {
+		return err
+	}
+	page.History, err = s.cfg.Store.ListTriageHistory(ctx, id)
+	if err != nil {
+		return err
+	}
+	return renderPage(ctx, w, page, s.cveTemplate)
+}
+
+type reportPage struct {
+	Namespace string
+	Report    *GoReport
+	Family    *reportFamily
+	Review    *store.ReportReview
+}
+
+// A reportFamily is the reports that a report is linked to, in either
+// direction, by the IDs in its supersedes, split_from and related fields
+// and theirs.
+type reportFamily struct {
+	Supersedes   []string
+	SupersededBy []string
+	SplitFrom    string
+	SplitInto    []string
+	Related      []string
+}
+
+// familyOf returns the report with the given ID among reports, and its
+// family, or nil if there is no such report.
+func familyOf(reports []*GoReport, id string) (*GoReport, *reportFamily) {
+	var gr *GoReport
+	f := &reportFamily{}
+	related := map[string]bool{}
+	for _, r := range reports {
+		if r.ID == id {
+			gr = r
+			f.Supersedes = r.Supersedes
+			f.SplitFrom = r.SplitFrom
+			for _, other := range r.Related {
+				related[other] = true
+			}
+			continue
+		}
+		for _, other := range r.Supersedes {
+			if other == id {
+				f.SupersededBy = append(f.SupersededBy, r.ID)
+			}
+		}
+		if r.SplitFrom == id {
+			f.SplitInto = append(f.SplitInto, r.ID)
+		}
+		for _, other := range r.Related {
+			if other == id {
+				related[r.ID] = true
+			}
+		}
+	}
+	if gr == nil {
+		return nil, nil
+	}
+	for other := range related {
+		f.Related = append(f.Related, other)
+	}
+	sort.Strings(f.Related)
+	return gr, f
+}
+
+// handleReport serves the page of a report at /report/ID, with links to the
+// reports of its family. It reads the reports from the vulndb repo.
+func (s *Server) handleReport(w http.ResponseWriter, r *http.Request) error {
+	id := strings.TrimPrefix(r.URL.Path, "/report/")
+	if id == "" {
+		return &serverError{status: http.StatusNotFound, err: errors.New("missing report ID")}
+	}
+	if s.cfg.VulnDBRepo == "" {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("the report pages need a vulndb repo"),
+		}
+	}
+	reports, err := LoadGoReports(r.Context(), s.cfg.VulnDBRepo)
+	if err != nil {
+		return err
+	}
+	gr, family := familyOf(reports, id)
+	if gr == nil {
+		return &serverError{status: http.StatusNotFound, err: fmt.Errorf("no report %s", id)}
+	}
+	review, err := s.cfg.Store.GetReportReview(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	page := reportPage{Namespace: s.cfg.Namespace, Report: gr, Family: family, Review: review}
+	return renderPage(r.Context(), w, page, s.reportTemplate)
+}
+
+type searchPage struct {
+	Namespace string
+	Query     string
+	Results   []*searchResult
+	// More reports whether there are more results than Results.
+	More bool
+}
+
+// A searchResult is a CVE, GHSA or Go report that matches a search, with
+// the URL of its page.
+type searchResult struct {
+	ID  string
+	URL string
+}
+
+// maxSearchResults is the maximum number of results on the search page.
+const maxSearchResults = 100
+
+// handleSearch serves the page at /search, which lists the CVEs, GHSAs and
+// Go reports whose text has all the words of the q query param.
+func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	page := searchPage{Namespace: s.cfg.Namespace, Query: strings.TrimSpace(r.FormValue("q"))}
+	if page.Query != "" {
+		ids, err := Search(ctx, s.cfg.Store, page.Query, maxSearchResults+1)
+		if err != nil {
+			return err
+		}
+		if len(ids) > maxSearchResults {
+			ids = ids[:maxSearchResults]
+			page.More = true
+		}
+		for _, id := range ids {
+			page.Results = append(page.Results, &searchResult{ID: id, URL: searchResultURL(id)})
+		}
+	}
+	return renderPage(ctx, w, page, s.searchTemplate)
+}
+
+// searchResultURL returns the URL of the page of the CVE, GHSA or Go report
+// with the given ID.
+func searchResultURL(id string) string {
+	switch {
+	case strings.HasPrefix(id, "GHSA-"):
+		return "https://github.com/advisories/" + id
+	case strings.HasPrefix(id, "GO-"):
+		return "/report/" + id
+	default:
+		return "/cve/" + id
+	}
+}
+
+// doCVEAction changes the triage state of the CVE with the given ID, then
+// redirects to the CVE's page.
+func (s *Server) doCVEAction(w http.ResponseWriter, r *http.Request, id, action string) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	reason := strings.TrimSpace(r.FormValue("reason"))
+	if reason == "" {
+		return &serverError{status: http.StatusBadRequest, err: errors.New("missing reason")}
+	}
+	ctx := store.WithActor(r.Context(), webActor(r))
+	// The version of the record on the page, so that the change doesn't
+	// overwrite one made after the page was loaded.
+	if v := r.FormValue("version"); v != "" {
+		version, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return &serverError{status: http.StatusBadRequest, err: fmt.Errorf("bad version %q", v)}
+		}
+		ctx = withSeenVersion(ctx, version)
+	}
+	var err error
+	switch action {
+	case "false-positive":
+		_, err = MarkFalsePositive(ctx, s.cfg.Store, id, reason)
+	case "needs-issue":
+		_, err = MarkNeedsIssue(ctx, s.cfg.Store, id, strings.TrimSpace(r.FormValue("module")), reason)
+	case "ack-change":
+		_, err = AcknowledgeCVEChange(ctx, s.cfg.Store, id, reason)
+	default:
+		return &serverError{status: http.StatusNotFound, err: fmt.Errorf("unknown action %q", action)}
+	}
+	var cerr *store.ConflictError
+	if errors.As(err, &cerr) {
+		return &serverError{
+			status: http.StatusConflict,
+			err:    fmt.Errorf("%s changed since the page was loaded; reload it and try again", id),
+		}
+	}
+	if err != nil {
+		return err
+	}
+	http.Redirect(w, r, "/cve/"+id, http.StatusSeeOther)
+	return nil
+}
+
+// iapUserHeader is the header in which Identity-Aware Proxy passes the
+// authenticated user, as "accounts.google.com:EMAIL".
+const iapUserHeader = "X-Goog-Authenticated-User-Email"
+
+// webActor returns the actor to which triage state changes made with the
+// dashboard are attributed.
+func webActor(r *http.Request) string {
+	user := r.Header.Get(iapUserHeader)
+	if user == "" {
+		return "web"
+	}
+	if _, email, ok := strings.Cut(user, ":"); ok {
+		user = email
+	}
+	return "web:" + user
+}
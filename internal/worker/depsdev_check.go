@@ -0,0 +1,191 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/exp/maps"
+	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/internal/depsdev"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A DepsDevClient gets the versions of Go modules, and the advisories that
+// affect them, from deps.dev.
+type DepsDevClient interface {
+	Versions(ctx context.Context, modulePath string) ([]string, error)
+	AdvisoryIDs(ctx context.Context, modulePath, version string) ([]string, error)
+	Advisory(ctx context.Context, id string) (*depsdev.Advisory, error)
+}
+
+// CheckDepsDevStats describes the result of a check of the reports against
+// deps.dev.
+type CheckDepsDevStats struct {
+	// Number of modules checked: those of the reports that deps.dev knows.
+	NumModules int
+	// Number of versions of those modules checked.
+	NumVersions int
+	// Discrepancies are the disagreements found, in the order of
+	// store.ListDepsDevDiscrepancies.
+	Discrepancies []*store.DepsDevDiscrepancy
+}
+
+// CheckDepsDev checks the reports in the vulndb repo at repoPath, which may be
+// a URL to clone or a local directory, against the advisories that deps.dev
+// has for the versions of their modules. It replaces the discrepancies in st
+// with the advisories that no report is about, and those whose affected
+// versions differ from those of the report about the same vulnerability.
+//
+// Only the modules of the reports are checked, so advisories about modules
+// that have no reports are not found.
+func CheckDepsDev(ctx context.Context, client DepsDevClient, repoPath string, st store.Store) (_ CheckDepsDevStats, err error) {
+	defer derrors.Wrap(&err, "CheckDepsDev(%q)", repoPath)
+
+	reports, err := LoadGoReports(ctx, repoPath)
+	if err != nil {
+		return CheckDepsDevStats{}, err
+	}
+	return checkDepsDev(ctx, client, reports, st)
+}
+
+func checkDepsDev(ctx context.Context, client DepsDevClient, reports []*GoReport, st store.Store) (stats CheckDepsDevStats, err error) {
+	ctx = event.Start(ctx, "checkDepsDev")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "depsdev-check")
+	defer func() { end(err) }()
+
+	// An advisory is about the vulnerability of a report, including an
+	// excluded one, if its ID or one of its aliases is the ID or an alias
+	// of the report.
+	byID := map[string]*GoReport{}
+	modules := map[string]bool{}
+	for _, r := range reports {
+		byID[r.ID] = r
+		for _, a := range r.Aliases {
+			byID[a] = r
+		}
+		if r.Excluded {
+			continue
+		}
+		for _, m := range r.Modules {
+			if m.Path != "" {
+				modules[m.Path] = true
+			}
+		}
+	}
+	advisories := map[string]*depsdev.Advisory{}
+	modulePaths := maps.Keys(modules)
+	sort.Strings(modulePaths)
+	for _, modulePath := range modulePaths {
+		versions, err := client.Versions(ctx, modulePath)
+		if err != nil {
+			return stats, err
+		}
+		versions = validVersions(versions)
+		if len(versions) == 0 {
+			continue
+		}
+		stats.NumModules++
+		stats.NumVersions += len(versions)
+		// The versions that each advisory affects.
+		affected := map[string]map[string]bool{}
+		for _, v := range versions {
+			ids, err := client.AdvisoryIDs(ctx, modulePath, v)
+			if err != nil {
+				return stats, err
+			}
+			for _, id := range ids {
+				if affected[id] == nil {
+					affected[id] = map[string]bool{}
+				}
+				affected[id][v] = true
+			}
+		}
+		ids := maps.Keys(affected)
+		sort.Strings(ids)
+		for _, id := range ids {
+			a, ok := advisories[id]
+			if !ok {
+				a, err = client.Advisory(ctx, id)
+				if err != nil {
+					return stats, err
+				}
+				advisories[id] = a
+			}
+			r := byID[id]
+			if r == nil && a != nil {
+				for _, alias := range a.Aliases {
+					if r = byID[alias]; r != nil {
+						break
+					}
+				}
+			}
+			if r != nil && r.Excluded {
+				continue
+			}
+			if d := depsDevDiscrepancy(modulePath, id, versions, affected[id], r); d != nil {
+				stats.Discrepancies = append(stats.Discrepancies, d)
+			}
+		}
+	}
+	if err := st.SetDepsDevDiscrepancies(ctx, stats.Discrepancies); err != nil {
+		return stats, err
+	}
+	log.Infof(ctx, "deps.dev check succeeded: checked %d modules, %d versions; %d discrepancies",
+		stats.NumModules, stats.NumVersions, len(stats.Discrepancies))
+	return stats, nil
+}
+
+// depsDevDiscrepancy returns the discrepancy between the advisory with the
+// given ID, which affects the given versions of the module, and r, the
+// report about the same vulnerability, which may be nil. It returns nil if
+// they agree about all versions.
+func depsDevDiscrepancy(modulePath, id string, versions []string, affected map[string]bool, r *GoReport) *store.DepsDevDiscrepancy {
+	d := &store.DepsDevDiscrepancy{Module: modulePath, AdvisoryID: id}
+	var ranges []report.VersionRange
+	reportAffects := false
+	if r != nil {
+		d.ReportID = r.ID
+		for _, m := range r.Modules {
+			if m.Path == modulePath {
+				ranges = m.Versions
+				reportAffects = true
+			}
+		}
+	}
+	for _, v := range versions {
+		theirs := affected[v]
+		ours := reportAffects && report.Affects(ranges, report.Version(strings.TrimPrefix(v, "v")))
+		switch {
+		case theirs && !ours:
+			d.DepsDevOnly = append(d.DepsDevOnly, v)
+		case ours && !theirs:
+			d.ReportOnly = append(d.ReportOnly, v)
+		}
+	}
+	if len(d.DepsDevOnly) == 0 && len(d.ReportOnly) == 0 {
+		return nil
+	}
+	return d
+}
+
+// validVersions returns the valid semantic versions of vs, in order.
+func validVersions(vs []string) []string {
+	var valid []string
+	for _, v := range vs {
+		if semver.IsValid(v) {
+			valid = append(valid, v)
+		}
+	}
+	sort.Slice(valid, func(i, j int) bool { return semver.Compare(valid[i], valid[j]) < 0 })
+	return valid
+}
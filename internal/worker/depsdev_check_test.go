@@ -0,0 +1,124 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/depsdev"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// fakeDepsDev is a DepsDevClient with a fixed set of modules and advisories.
+type fakeDepsDev struct {
+	// affected maps module paths to their versions, and each version to the
+	// IDs of the advisories that affect it.
+	affected   map[string]map[string][]string
+	advisories map[string]*depsdev.Advisory
+}
+
+func (f *fakeDepsDev) Versions(_ context.Context, modulePath string) ([]string, error) {
+	var vs []string
+	for v := range f.affected[modulePath] {
+		vs = append(vs, v)
+	}
+	return vs, nil
+}
+
+func (f *fakeDepsDev) AdvisoryIDs(_ context.Context, modulePath, version string) ([]string, error) {
+	return f.affected[modulePath][version], nil
+}
+
+func (f *fakeDepsDev) Advisory(_ context.Context, id string) (*depsdev.Advisory, error) {
+	return f.advisories[id], nil
+}
+
+func TestCheckDepsDev(t *testing.T) {
+	ctx := context.Background()
+	reports := []*GoReport{
+		{
+			// deps.dev agrees.
+			ID:      "GO-2022-0001",
+			Aliases: []string{"GHSA-aaaa-aaaa-aaaa"},
+			Modules: []*GoReportModule{{Path: "example.com/a", Versions: []report.VersionRange{{Fixed: "1.1.0"}}}},
+		},
+		{
+			// deps.dev thinks v1.2.0 is affected too, and that v1.0.0
+			// isn't. The advisory is found through its CVE alias.
+			ID:      "GO-2022-0002",
+			Aliases: []string{"CVE-2022-0002"},
+			Modules: []*GoReportModule{{Path: "example.com/b", Versions: []report.VersionRange{{Fixed: "1.2.0"}}}},
+		},
+		{
+			// Excluded reports count as knowing a vulnerability.
+			ID:       "GO-2022-0003",
+			Excluded: true,
+			Aliases:  []string{"GHSA-cccc-cccc-cccc"},
+		},
+	}
+	client := &fakeDepsDev{
+		affected: map[string]map[string][]string{
+			"example.com/a": {
+				"v1.0.0": {"GHSA-aaaa-aaaa-aaaa"},
+				"v1.1.0": nil,
+				// Not a semantic version.
+				"master": {"GHSA-dddd-dddd-dddd"},
+			},
+			"example.com/b": {
+				"v1.0.0": {"GHSA-cccc-cccc-cccc"},
+				"v1.1.0": {"GHSA-bbbb-bbbb-bbbb", "GHSA-cccc-cccc-cccc"},
+				"v1.2.0": {"GHSA-bbbb-bbbb-bbbb", "GHSA-eeee-eeee-eeee"},
+			},
+		},
+		advisories: map[string]*depsdev.Advisory{
+			"GHSA-aaaa-aaaa-aaaa": {ID: "GHSA-aaaa-aaaa-aaaa"},
+			"GHSA-bbbb-bbbb-bbbb": {ID: "GHSA-bbbb-bbbb-bbbb", Aliases: []string{"CVE-2022-0002"}},
+			"GHSA-cccc-cccc-cccc": {ID: "GHSA-cccc-cccc-cccc"},
+			"GHSA-eeee-eeee-eeee": {ID: "GHSA-eeee-eeee-eeee"},
+		},
+	}
+	mstore := store.NewMemStore()
+	// The check replaces what is in the store.
+	if err := mstore.SetDepsDevDiscrepancies(ctx, []*store.DepsDevDiscrepancy{{Module: "example.com/old", AdvisoryID: "GHSA-0000-0000-0000"}}); err != nil {
+		t.Fatal(err)
+	}
+	stats, err := checkDepsDev(ctx, client, reports, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*store.DepsDevDiscrepancy{
+		{
+			Module:      "example.com/b",
+			AdvisoryID:  "GHSA-bbbb-bbbb-bbbb",
+			ReportID:    "GO-2022-0002",
+			DepsDevOnly: []string{"v1.2.0"},
+			ReportOnly:  []string{"v1.0.0"},
+		},
+		{
+			Module:      "example.com/b",
+			AdvisoryID:  "GHSA-eeee-eeee-eeee",
+			DepsDevOnly: []string{"v1.2.0"},
+		},
+	}
+	if diff := cmp.Diff(want, stats.Discrepancies); diff != "" {
+		t.Errorf("discrepancies mismatch (-want, +got):\n%s", diff)
+	}
+	if stats.NumModules != 2 || stats.NumVersions != 5 {
+		t.Errorf("got %d modules, %d versions; want 2, 5", stats.NumModules, stats.NumVersions)
+	}
+	got, err := mstore.ListDepsDevDiscrepancies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("stored discrepancies mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/refurl"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A duplicateCandidate is an existing report or open issue that a CVE about
+// to get an issue may duplicate.
+type duplicateCandidate struct {
+	// Name is the ID of the report, or the reference to the issue.
+	Name string
+	// Reasons say what the CVE shares with the report or issue.
+	Reasons []string
+}
+
+func (c *duplicateCandidate) String() string {
+	return fmt.Sprintf("%s: %s", c.Name, strings.Join(c.Reasons, "; "))
+}
+
+// A dupEntry is an existing report or open issue, with what a duplicate of
+// it would share with it.
+type dupEntry struct {
+	name       string
+	aliases    []string
+	references []string
+	modules    []*GoReportModule
+}
+
+// A duplicateChecker finds the existing reports and open issues that a CVE
+// about to get an issue may duplicate.
+type duplicateChecker struct {
+	entries []*dupEntry
+}
+
+// newDuplicateChecker returns a duplicateChecker for the reports, and for the
+// open issues of the CVE and GHSA records in st.
+func newDuplicateChecker(ctx context.Context, st store.Store, reports []*GoReport) (_ *duplicateChecker, err error) {
+	defer derrors.Wrap(&err, "newDuplicateChecker")
+
+	d := &duplicateChecker{}
+	for _, r := range reports {
+		name := r.ID
+		if r.Excluded {
+			name += " (excluded)"
+		}
+		d.entries = append(d.entries, &dupEntry{
+			name:       name,
+			aliases:    append([]string{r.ID}, r.Aliases...),
+			references: r.References,
+			modules:    r.Modules,
+		})
+	}
+	for _, ts := range []store.TriageState{store.TriageStateIssueCreated, store.TriageStateUpdatedSinceIssueCreation} {
+		crs, err := st.ListCVERecordsWithTriageState(ctx, ts)
+		if err != nil {
+			return nil, err
+		}
+		for _, cr := range crs {
+			d.addCVE(cr)
+		}
+	}
+	grs, err := getGHSARecords(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	for _, gr := range grs {
+		if gr.IssueReference == "" {
+			continue
+		}
+		e := &dupEntry{name: gr.IssueReference, aliases: []string{gr.GHSA.ID}}
+		for _, id := range gr.GHSA.Identifiers {
+			e.aliases = append(e.aliases, id.Value)
+		}
+		for _, m := range report.GHSAToReport(gr.GHSA, "").Modules {
+			e.modules = append(e.modules, &GoReportModule{Path: m.Module, Versions: m.Versions})
+		}
+		d.entries = append(d.entries, e)
+	}
+	return d, nil
+}
+
+// addCVE adds the issue of cr, which may have just been created, to d.
+func (d *duplicateChecker) addCVE(cr *store.CVERecord) {
+	if cr.IssueReference == "" {
+		return
+	}
+	e := cveDupEntry(cr, nil)
+	e.name = cr.IssueReference
+	d.entries = append(d.entries, e)
+}
+
+// cveDupEntry returns what cr, with the given aliases, shares with its
+// duplicates.
+func cveDupEntry(cr *store.CVERecord, aliases []string) *dupEntry {
+	e := &dupEntry{
+		aliases:    append(append([]string{cr.ID}, cr.OSVAdvisories...), aliases...),
+		references: cr.ReferenceURLs,
+	}
+	var description string
+	if cr.CVE != nil {
+		e.references = nil
+		for _, r := range cr.CVE.References.Data {
+			e.references = append(e.references, r.URL)
+		}
+		if len(cr.CVE.Description.Data) > 0 {
+			description = cr.CVE.Description.Data[0].Value
+		}
+	}
+	if cr.Module != "" && cr.Module != unknownPath {
+		m := &GoReportModule{Path: cr.Module}
+		// The CVE affects all versions before the last one that its
+		// description says fixes it.
+		var last report.Version
+		for _, v := range report.FixedVersionsInText(description) {
+			if last == "" || last.Before(v) {
+				last = v
+			}
+		}
+		if last != "" {
+			m.Versions = []report.VersionRange{{Fixed: last}}
+		}
+		e.modules = []*GoReportModule{m}
+	}
+	return e
+}
+
+// check returns the reports and open issues that cr, with the given aliases,
+// may duplicate: those that share an alias or a reference with it, or that
+// affect its module at overlapping versions.
+func (d *duplicateChecker) check(cr *store.CVERecord, aliases []string) []*duplicateCandidate {
+	e := cveDupEntry(cr, aliases)
+	var cs []*duplicateCandidate
+	for _, other := range d.entries {
+		var reasons []string
+		for _, a := range sharedStrings(e.aliases, other.aliases, func(s string) string { return s }) {
+			reasons = append(reasons, "shares alias "+a)
+		}
+		for _, u := range sharedStrings(e.references, other.references, refurl.Canonical) {
+			reasons = append(reasons, "shares reference "+u)
+		}
+		for _, m := range e.modules {
+			for _, om := range other.modules {
+				if m.Path == om.Path && versionsOverlap(m.Versions, om.Versions) {
+					reasons = append(reasons, fmt.Sprintf("affects %s at overlapping versions", m.Path))
+				}
+			}
+		}
+		if len(reasons) > 0 {
+			cs = append(cs, &duplicateCandidate{Name: other.name, Reasons: reasons})
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Name < cs[j].Name })
+	return cs
+}
+
+// sharedStrings returns the strings of a that are also in b, after
+// normalizing both with norm, without duplicates.
+func sharedStrings(a, b []string, norm func(string) string) []string {
+	inB := map[string]bool{}
+	for _, s := range b {
+		inB[norm(s)] = true
+	}
+	var shared []string
+	seen := map[string]bool{}
+	for _, s := range a {
+		n := norm(s)
+		if inB[n] && !seen[n] {
+			seen[n] = true
+			shared = append(shared, s)
+		}
+	}
+	return shared
+}
+
+// versionsOverlap reports whether some version may be in both a and b. If
+// either has no ranges, its versions are unknown, so they may overlap.
+func versionsOverlap(a, b []report.VersionRange) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	for _, ra := range a {
+		for _, rb := range b {
+			if rangesOverlap(ra, rb) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rangesOverlap reports whether the ranges [a.Introduced, a.Fixed) and
+// [b.Introduced, b.Fixed) overlap. An empty or invalid Introduced is the
+// lowest version, and an empty or invalid Fixed is past the highest.
+func rangesOverlap(a, b report.VersionRange) bool {
+	// The ranges overlap if each starts before the other ends.
+	return startsBefore(a.Introduced, b.Fixed) && startsBefore(b.Introduced, a.Fixed)
+}
+
+func startsBefore(introduced, fixed report.Version) bool {
+	if !introduced.IsValid() || !fixed.IsValid() {
+		return true
+	}
+	return introduced.Before(fixed)
+}
+
+// duplicatesSection returns the section of an issue body that lists the
+// possible duplicates cs.
+func duplicatesSection(cs []*duplicateCandidate) string {
+	var b strings.Builder
+	b.WriteString("**Possible duplicates.** Check these before writing a report:\n")
+	for _, c := range cs {
+		fmt.Fprintf(&b, "- %s\n", c)
+	}
+	return b.String()
+}
+
+// withDuplicates returns a function like newBody whose body also lists the
+// possible duplicates cs, if there are any.
+func withDuplicates(newBody func(storeRecord) (string, error), cs []*duplicateCandidate) func(storeRecord) (string, error) {
+	if len(cs) == 0 {
+		return newBody
+	}
+	return func(r storeRecord) (string, error) {
+		body, err := newBody(r)
+		if err != nil {
+			return "", err
+		}
+		return body + "\n\n" + duplicatesSection(cs), nil
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestDuplicates(t *testing.T) {
+	ctx := context.Background()
+	repo, err := gitrepo.ReadTxtarRepo("testdata/vulndb.txtar", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	reports, err := ReadGoReports(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cve := func(id, module, description string, refs ...string) *store.CVERecord {
+		c := &cveschema.CVE{Metadata: cveschema.Metadata{ID: id}}
+		c.Description.Data = []cveschema.LangString{{Lang: "eng", Value: description}}
+		for _, u := range refs {
+			c.References.Data = append(c.References.Data, cveschema.Reference{URL: u})
+		}
+		return &store.CVERecord{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  time.Date(2022, time.October, 1, 0, 0, 0, 0, time.UTC),
+			Module:      module,
+			CVE:         c,
+			TriageState: store.TriageStateNeedsIssue,
+		}
+	}
+
+	mstore := store.NewMemStore()
+	open := cve("CVE-2022-0010", "example.com/d", "D before 2.0.0 allows XSS.")
+	open.TriageState = store.TriageStateIssueCreated
+	open.IssueReference = "inMemory#10"
+	createCVERecords(t, mstore, []*store.CVERecord{open})
+	createGHSARecords(t, mstore, []*store.GHSARecord{{
+		GHSA: &ghsa.SecurityAdvisory{
+			ID:          "GHSA-xxxx-yyyy-zzzz",
+			Identifiers: []ghsa.Identifier{{Type: "CVE", Value: "CVE-2022-0020"}},
+		},
+		TriageState:    store.TriageStateIssueCreated,
+		IssueReference: "inMemory#20",
+	}})
+	d, err := newDuplicateChecker(ctx, mstore, reports)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name    string
+		cr      *store.CVERecord
+		aliases []string
+		want    []*duplicateCandidate
+	}{
+		{
+			name: "excluded alias",
+			cr:   cve("CVE-2022-0004", "example.com/e", ""),
+			want: []*duplicateCandidate{{
+				Name:    "GO-2022-0004 (excluded)",
+				Reasons: []string{"shares alias CVE-2022-0004"},
+			}},
+		},
+		{
+			name: "reference and versions",
+			cr:   cve("CVE-2022-0030", "example.com/b", "B before 1.1.0 allows XSS.", "http://GitHub.com/b/b/commit/123/"),
+			want: []*duplicateCandidate{{
+				Name: "GO-2022-0005",
+				Reasons: []string{
+					"shares reference http://GitHub.com/b/b/commit/123/",
+					"affects example.com/b at overlapping versions",
+				},
+			}},
+		},
+		{
+			name: "disjoint versions",
+			cr:   cve("CVE-2022-0031", "example.com/b", "B before 0.9.0 allows XSS."),
+		},
+		{
+			name: "open CVE issue",
+			cr:   cve("CVE-2022-0032", "example.com/d", "D allows XSS."),
+			want: []*duplicateCandidate{{
+				Name:    "inMemory#10",
+				Reasons: []string{"affects example.com/d at overlapping versions"},
+			}},
+		},
+		{
+			name: "open GHSA issue",
+			cr:   cve("CVE-2022-0020", unknownPath, ""),
+			want: []*duplicateCandidate{{
+				Name:    "inMemory#20",
+				Reasons: []string{"shares alias CVE-2022-0020"},
+			}},
+		},
+		{
+			name:    "known alias",
+			cr:      cve("CVE-2022-0040", "", ""),
+			aliases: []string{"GO-2022-0001"},
+			want: []*duplicateCandidate{{
+				Name:    "GO-2022-0001",
+				Reasons: []string{"shares alias GO-2022-0001"},
+			}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got := d.check(test.cr, test.aliases)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+
+	// The issue of a possible duplicate lists the candidates.
+	createCVERecords(t, mstore, []*store.CVERecord{cve("CVE-2022-0004", "example.com/e", "")})
+	ic := issues.NewDryRunClient(issues.NewFakeClient())
+	if err := CreateIssues(ctx, mstore, ic, reports, 0); err != nil {
+		t.Fatal(err)
+	}
+	created := ic.Created()
+	if len(created) != 1 {
+		t.Fatalf("got %d issues, want 1", len(created))
+	}
+	want := "**Possible duplicates.** Check these before writing a report:\n- GO-2022-0004 (excluded): shares alias CVE-2022-0004\n"
+	if !strings.HasSuffix(created[0].Body, want) {
+		t.Errorf("got body\n%s\nwant it to end with\n%s", created[0].Body, want)
+	}
+}
+
+func TestVersionsOverlap(t *testing.T) {
+	vr := func(introduced, fixed report.Version) report.VersionRange {
+		return report.VersionRange{Introduced: introduced, Fixed: fixed}
+	}
+	for _, test := range []struct {
+		a, b []report.VersionRange
+		want bool
+	}{
+		{nil, []report.VersionRange{vr("1.0.0", "1.1.0")}, true},
+		{[]report.VersionRange{vr("", "1.1.0")}, []report.VersionRange{vr("1.0.0", "")}, true},
+		{[]report.VersionRange{vr("", "1.0.0")}, []report.VersionRange{vr("1.0.0", "")}, false},
+		{[]report.VersionRange{vr("1.0.0", "1.1.0"), vr("2.0.0", "2.1.0")}, []report.VersionRange{vr("2.0.5", "")}, true},
+		{[]report.VersionRange{vr("1.0.0", "1.1.0")}, []report.VersionRange{vr("1.2.0", "1.3.0")}, false},
+		// A fixed version that is not valid is unknown, so the range is open.
+		{[]report.VersionRange{vr("1.0.0", "TODO")}, []report.VersionRange{vr("1.2.0", "1.3.0")}, true},
+	} {
+		if got := versionsOverlap(test.a, test.b); got != test.want {
+			t.Errorf("versionsOverlap(%v, %v) = %t, want %t", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"crypto/ed25519"
+	"sort"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/database"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// EmbargoStats describes the result of a check of the embargoes of the
+// private reports in an overlay repo.
+type EmbargoStats struct {
+	// Number of reports that are still embargoed.
+	NumEmbargoed int
+	// IDs of the reports whose embargoes have ended, and that the
+	// database lacked, in order.
+	Published []string
+	// IDs of the reports that are embargoed, but that the database had, in
+	// order. Their embargoes were set or extended after they were
+	// published.
+	Unpublished []string
+	// Whether the database was regenerated.
+	Regenerated bool
+}
+
+// PublishEmbargoed checks the embargoes of the reports in the overlay repo
+// in overlayDir against the database in dbDir. If a report whose embargo has
+// ended is missing from the database, or an embargoed one is in it, it
+// regenerates the database from the vulndb repo in repoDir and the overlay,
+// incrementally and signed with key, if it is not nil. The reports of the
+// overlay are left out of the database until their embargoes end, so
+// regenerating it publishes them.
+//
+// Both repoDir and overlayDir must be local directories with the git history
+// of the repos, as for gendb.
+func PublishEmbargoed(ctx context.Context, st store.Store, repoDir, overlayDir, dbDir string, key ed25519.PrivateKey) (_ EmbargoStats, err error) {
+	defer derrors.Wrap(&err, "PublishEmbargoed(%q, %q, %q)", repoDir, overlayDir, dbDir)
+	return publishEmbargoed(ctx, st, repoDir, overlayDir, dbDir, key, time.Now())
+}
+
+func publishEmbargoed(ctx context.Context, st store.Store, repoDir, overlayDir, dbDir string, key ed25519.PrivateKey, now time.Time) (stats EmbargoStats, err error) {
+	ctx = event.Start(ctx, "publishEmbargoed")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "embargoes")
+	defer func() { end(err) }()
+
+	embargoes, err := database.OverlayEmbargoes(overlayDir)
+	if err != nil {
+		return stats, err
+	}
+	for id, embargo := range embargoes {
+		embargoed := embargo.After(now)
+		if embargoed {
+			stats.NumEmbargoed++
+		}
+		published, err := database.HasEntry(dbDir, id)
+		if err != nil {
+			return stats, err
+		}
+		switch {
+		case !embargoed && !published:
+			stats.Published = append(stats.Published, id)
+		case embargoed && published:
+			log.Warningf(ctx, "%s is in the database, but embargoed until %s", id, embargo.Format(time.RFC3339))
+			stats.Unpublished = append(stats.Unpublished, id)
+		}
+	}
+	sort.Strings(stats.Published)
+	sort.Strings(stats.Unpublished)
+	if len(stats.Published) > 0 || len(stats.Unpublished) > 0 {
+		opts := database.GenerateOptions{
+			Incremental: true,
+			OverlayDir:  overlayDir,
+			SigningKey:  key,
+			Now:         now,
+		}
+		if err := database.Generate(ctx, repoDir, dbDir, opts); err != nil {
+			return stats, err
+		}
+		stats.Regenerated = true
+		for _, id := range stats.Published {
+			log.Infof(ctx, "published %s, whose embargo ended at %s", id, embargoes[id].Format(time.RFC3339))
+		}
+	}
+	log.Infof(ctx, "embargo check succeeded: %d embargoed, %d published, %d unpublished",
+		stats.NumEmbargoed, len(stats.Published), len(stats.Unpublished))
+	return stats, nil
+}
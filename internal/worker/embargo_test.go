@@ -0,0 +1,119 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/database"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// writeGitRepo writes a git repo on disk with files, keyed by their
+// slash-separated paths, in one commit at when, and returns its directory.
+func writeGitRepo(t *testing.T, files map[string]string, when time.Time) string {
+	t.Helper()
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := wt.Add(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sig := &object.Signature{Name: "Author", Email: "author@example.com", When: when}
+	if _, err := wt.Commit("add files", &git.CommitOptions{Author: sig}); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestPublishEmbargoed(t *testing.T) {
+	ctx := context.Background()
+	committed := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	repoDir := writeGitRepo(t, map[string]string{
+		"data/osv/GO-2022-0001.json": `{"id":"GO-2022-0001","modified":"0001-01-01T00:00:00Z","published":"0001-01-01T00:00:00Z",` +
+			`"details":"A is vulnerable.","affected":[{"package":{"name":"example.com/a","ecosystem":"Go"}}]}`,
+	}, committed)
+	overlayDir := writeGitRepo(t, map[string]string{
+		"data/reports/GO-2022-9001.yaml": `modules:
+  - module: corp.example.com/b
+description: B is vulnerable.
+embargo: 2022-12-01T00:00:00Z
+`,
+		"data/reports/GO-2022-9002.yaml": `modules:
+  - module: corp.example.com/c
+description: C is vulnerable.
+embargo: 2023-01-01T00:00:00Z
+`,
+	}, committed)
+	useFakeProxy(t, map[string]string{"corp.example.com/b": "", "corp.example.com/c": ""})
+	dbDir := t.TempDir()
+	mstore := store.NewMemStore()
+	publish := func(now time.Time) EmbargoStats {
+		t.Helper()
+		stats, err := publishEmbargoed(ctx, mstore, repoDir, overlayDir, dbDir, nil, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stats
+	}
+	hasEntry := func(id string) bool {
+		t.Helper()
+		ok, err := database.HasEntry(dbDir, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ok
+	}
+
+	// Once the first embargo ends, the database is generated with its
+	// report, but not the other.
+	stats := publish(time.Date(2022, 12, 2, 0, 0, 0, 0, time.UTC))
+	want := EmbargoStats{NumEmbargoed: 1, Published: []string{"GO-2022-9001"}, Regenerated: true}
+	if diff := cmp.Diff(want, stats); diff != "" {
+		t.Errorf("first check mismatch (-want, +got):\n%s", diff)
+	}
+	if !hasEntry("GO-2022-0001") || !hasEntry("GO-2022-9001") || hasEntry("GO-2022-9002") {
+		t.Error("first check: wrong entries in the database")
+	}
+
+	// Nothing changes until the other embargo ends.
+	stats = publish(time.Date(2022, 12, 3, 0, 0, 0, 0, time.UTC))
+	if diff := cmp.Diff(EmbargoStats{NumEmbargoed: 1}, stats); diff != "" {
+		t.Errorf("second check mismatch (-want, +got):\n%s", diff)
+	}
+	stats = publish(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC))
+	want = EmbargoStats{Published: []string{"GO-2022-9002"}, Regenerated: true}
+	if diff := cmp.Diff(want, stats); diff != "" {
+		t.Errorf("third check mismatch (-want, +got):\n%s", diff)
+	}
+	if !hasEntry("GO-2022-9002") {
+		t.Error("third check: GO-2022-9002 is not in the database")
+	}
+}
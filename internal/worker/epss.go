@@ -0,0 +1,111 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"sort"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/epss"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// EPSSFunc is the type of a function that returns the EPSS scores of the CVEs
+// with the given IDs, keyed by ID.
+type EPSSFunc func(ctx context.Context, ids []string) (map[string]*epss.Score, error)
+
+// UpdateEPSSStats describes the result of an update of EPSS scores.
+type UpdateEPSSStats struct {
+	// Number of CVERecords needing an issue.
+	NumProcessed int
+	// Number of those that had a score.
+	NumScored int
+	// Number of CVERecords whose score changed.
+	NumModified int
+}
+
+// UpdateEPSS sets the EPSS scores of the CVE records that need an issue, so
+// they can be triaged in order of how likely they are to be exploited.
+func UpdateEPSS(ctx context.Context, scores EPSSFunc, st store.Store) (stats UpdateEPSSStats, err error) {
+	defer derrors.Wrap(&err, "UpdateEPSS")
+	ctx = event.Start(ctx, "UpdateEPSS")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "epss")
+	defer func() { end(err) }()
+	defer func() { countUpdate(ctx, stats.NumProcessed, 0, stats.NumModified) }()
+
+	crs, err := st.ListCVERecordsWithTriageState(ctx, store.TriageStateNeedsIssue)
+	if err != nil {
+		return stats, err
+	}
+	stats.NumProcessed = len(crs)
+	if len(crs) == 0 {
+		return stats, nil
+	}
+	var ids []string
+	for _, cr := range crs {
+		ids = append(ids, cr.ID)
+	}
+	sort.Strings(ids)
+	ss, err := scores(ctx, ids)
+	if err != nil {
+		return stats, err
+	}
+	stats.NumScored = len(ss)
+	for i := 0; i < len(ids); i += maxTransactionWrites {
+		j := i + maxTransactionWrites
+		if j > len(ids) {
+			j = len(ids)
+		}
+		n, err := updateEPSSBatch(ctx, st, ids[i:j], ss)
+		if err != nil {
+			return stats, err
+		}
+		stats.NumModified += n
+	}
+	log.Infof(ctx, "EPSS update succeeded: %+v", stats)
+	return stats, nil
+}
+
+func updateEPSSBatch(ctx context.Context, st store.Store, ids []string, scores map[string]*epss.Score) (numMods int, err error) {
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		numMods = 0
+		for _, id := range ids {
+			s := scores[id]
+			if s == nil {
+				continue
+			}
+			crs, err := tx.GetCVERecords(id, id)
+			if err != nil {
+				return err
+			}
+			// The record may have been triaged since it was listed.
+			if len(crs) == 0 || crs[0].TriageState != store.TriageStateNeedsIssue {
+				continue
+			}
+			cr := crs[0]
+			if cr.EPSS == s.EPSS && cr.EPSSPercentile == s.Percentile {
+				continue
+			}
+			cr.EPSS = s.EPSS
+			cr.EPSSPercentile = s.Percentile
+			if err := tx.SetCVERecord(cr); err != nil {
+				return err
+			}
+			numMods++
+		}
+		return nil
+	})
+	return numMods, err
+}
+
+// sortByEPSS sorts crs so the records most likely to be exploited are first.
+// Records with equal scores, including those with none, keep their order.
+func sortByEPSS(crs []*store.CVERecord) {
+	sort.SliceStable(crs, func(i, j int) bool { return crs[i].EPSS > crs[j].EPSS })
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/epss"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestUpdateEPSS(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	record := func(id string, ts store.TriageState) *store.CVERecord {
+		return &store.CVERecord{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  time.Date(2022, time.October, 1, 0, 0, 0, 0, time.UTC),
+			TriageState: ts,
+		}
+	}
+	createCVERecords(t, mstore, []*store.CVERecord{
+		record("CVE-2022-0001", store.TriageStateNeedsIssue),
+		record("CVE-2022-0002", store.TriageStateNeedsIssue),
+		record("CVE-2022-0003", store.TriageStateNeedsIssue),
+		record("CVE-2022-0004", store.TriageStateNoActionNeeded),
+	})
+
+	var requested []string
+	scores := func(_ context.Context, ids []string) (map[string]*epss.Score, error) {
+		requested = ids
+		return map[string]*epss.Score{
+			"CVE-2022-0001": {CVE: "CVE-2022-0001", EPSS: 0.01, Percentile: 0.4},
+			"CVE-2022-0002": {CVE: "CVE-2022-0002", EPSS: 0.9, Percentile: 0.99},
+		}, nil
+	}
+	stats, err := UpdateEPSS(ctx, scores, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"CVE-2022-0001", "CVE-2022-0002", "CVE-2022-0003"}, requested); diff != "" {
+		t.Errorf("requested IDs mismatch (-want, +got):\n%s", diff)
+	}
+	if want := (UpdateEPSSStats{NumProcessed: 3, NumScored: 2, NumModified: 2}); stats != want {
+		t.Errorf("got %+v, want %+v", stats, want)
+	}
+	crs := mstore.CVERecords()
+	if got := crs["CVE-2022-0002"]; got.EPSS != 0.9 || got.EPSSPercentile != 0.99 {
+		t.Errorf("CVE-2022-0002: got EPSS %g, percentile %g; want 0.9, 0.99", got.EPSS, got.EPSSPercentile)
+	}
+
+	// Unchanged scores are not written again.
+	stats, err = UpdateEPSS(ctx, scores, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.NumModified != 0 {
+		t.Errorf("second update: modified %d records, want 0", stats.NumModified)
+	}
+
+	// The records most likely to be exploited sort first.
+	var needsIssue []*store.CVERecord
+	for _, id := range []string{"CVE-2022-0001", "CVE-2022-0002", "CVE-2022-0003"} {
+		needsIssue = append(needsIssue, crs[id])
+	}
+	sortByEPSS(needsIssue)
+	var got []string
+	for _, cr := range needsIssue {
+		got = append(got, cr.ID)
+	}
+	if diff := cmp.Diff([]string{"CVE-2022-0002", "CVE-2022-0001", "CVE-2022-0003"}, got); diff != "" {
+		t.Errorf("sortByEPSS mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -5,19 +5,297 @@
 package worker
 
 import (
+	"bytes"
 	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
 
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"golang.org/x/exp/event"
 	"golang.org/x/vulndb/internal/derrors"
 	"golang.org/x/vulndb/internal/worker/store"
+	"gopkg.in/yaml.v3"
 )
 
+// FalsePositivesFile is the path of the false-positives data file, relative
+// to the root of the vulndb repo.
+const FalsePositivesFile = "internal/worker/false_positives.yaml"
+
+// falsePositivesVersion is the version of the false-positives file format.
+// It must be incremented whenever the format changes incompatibly.
+const falsePositivesVersion = 1
+
+// The contents of FalsePositivesFile, embedded so the worker always has the
+// data that was checked in alongside its code.
+//
+//go:embed false_positives.yaml
+var falsePositivesData []byte
+
+var (
+	falsePositivesOnce sync.Once
+	falsePositives     []*store.CVERecord
+	falsePositivesErr  error
+)
+
+// loadFalsePositives parses and validates the embedded false-positives file
+// the first time it is called, and returns the result on every call.
+func loadFalsePositives() ([]*store.CVERecord, error) {
+	falsePositivesOnce.Do(func() {
+		falsePositives, falsePositivesErr = ParseFalsePositives(falsePositivesData)
+	})
+	return falsePositives, falsePositivesErr
+}
+
+// falsePositivesFileContents is the top-level structure of the
+// false-positives file.
+type falsePositivesFileContents struct {
+	Version int                    `yaml:"version"`
+	Records []*falsePositiveRecord `yaml:"records"`
+}
+
+// A falsePositiveRecord is the representation of a false-positive
+// store.CVERecord in the false-positives file.
+type falsePositiveRecord struct {
+	ID                string            `yaml:"id"`
+	Path              string            `yaml:"path"`
+	BlobHash          string            `yaml:"blob_hash"`
+	CommitHash        string            `yaml:"commit_hash"`
+	CommitTime        time.Time         `yaml:"commit_time"`
+	CVEState          string            `yaml:"cve_state"`
+	TriageState       store.TriageState `yaml:"triage_state"`
+	TriageStateReason string            `yaml:"triage_state_reason,omitempty"`
+	ReferenceURLs     []string          `yaml:"reference_urls,omitempty"`
+}
+
+func (r *falsePositiveRecord) toCVERecord() *store.CVERecord {
+	return &store.CVERecord{
+		ID:                r.ID,
+		Path:              r.Path,
+		BlobHash:          r.BlobHash,
+		CommitHash:        r.CommitHash,
+		CommitTime:        r.CommitTime.UTC(),
+		CVEState:          r.CVEState,
+		TriageState:       r.TriageState,
+		TriageStateReason: r.TriageStateReason,
+		ReferenceURLs:     r.ReferenceURLs,
+	}
+}
+
+func newFalsePositiveRecord(cr *store.CVERecord) *falsePositiveRecord {
+	return &falsePositiveRecord{
+		ID:                cr.ID,
+		Path:              cr.Path,
+		BlobHash:          cr.BlobHash,
+		CommitHash:        cr.CommitHash,
+		CommitTime:        cr.CommitTime.UTC(),
+		CVEState:          cr.CVEState,
+		TriageState:       cr.TriageState,
+		TriageStateReason: cr.TriageStateReason,
+		ReferenceURLs:     cr.ReferenceURLs,
+	}
+}
+
+// ParseFalsePositives parses the contents of a false-positives file and
+// validates the records in it.
+func ParseFalsePositives(data []byte) (_ []*store.CVERecord, err error) {
+	defer derrors.Wrap(&err, "ParseFalsePositives")
+
+	d := yaml.NewDecoder(bytes.NewReader(data))
+	d.KnownFields(true)
+	var contents falsePositivesFileContents
+	if err := d.Decode(&contents); err != nil {
+		return nil, fmt.Errorf("yaml.Decode: %v", err)
+	}
+	if contents.Version != falsePositivesVersion {
+		return nil, fmt.Errorf("version is %d, want %d", contents.Version, falsePositivesVersion)
+	}
+	var crs []*store.CVERecord
+	for _, r := range contents.Records {
+		crs = append(crs, r.toCVERecord())
+	}
+	if err := validateFalsePositives(crs); err != nil {
+		return nil, err
+	}
+	return crs, nil
+}
+
+// validateFalsePositives returns an error if any of crs is invalid, is not
+// in a false-positive triage state, or has the same ID as another.
+func validateFalsePositives(crs []*store.CVERecord) error {
+	seen := map[string]bool{}
+	for i, cr := range crs {
+		if err := cr.Validate(); err != nil {
+			return fmt.Errorf("record %d (%s): %v", i, cr.ID, err)
+		}
+		switch cr.TriageState {
+		case store.TriageStateFalsePositive:
+		case store.TriageStateHasVuln:
+			if cr.TriageStateReason == "" {
+				return fmt.Errorf("record %d (%s): %s record needs the ID of the covering report as its reason", i, cr.ID, cr.TriageState)
+			}
+		default:
+			return fmt.Errorf("record %d (%s): bad triage state %q", i, cr.ID, cr.TriageState)
+		}
+		if seen[cr.ID] {
+			return fmt.Errorf("record %d: duplicate ID %s", i, cr.ID)
+		}
+		seen[cr.ID] = true
+	}
+	return nil
+}
+
+// WriteFalsePositives writes crs to w in the format of the false-positives
+// file.
+func WriteFalsePositives(w io.Writer, crs []*store.CVERecord) (err error) {
+	defer derrors.Wrap(&err, "WriteFalsePositives")
+
+	if err := validateFalsePositives(crs); err != nil {
+		return err
+	}
+	contents := falsePositivesFileContents{Version: falsePositivesVersion}
+	for _, cr := range crs {
+		contents.Records = append(contents.Records, newFalsePositiveRecord(cr))
+	}
+	e := yaml.NewEncoder(w)
+	e.SetIndent(4)
+	if err := e.Encode(contents); err != nil {
+		return err
+	}
+	return e.Close()
+}
+
+// ReadFalsePositives reads and validates the false-positives file at filename.
+func ReadFalsePositives(filename string) (_ []*store.CVERecord, err error) {
+	defer derrors.Wrap(&err, "ReadFalsePositives(%q)", filename)
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFalsePositives(data)
+}
+
+// AppendFalsePositives adds crs to the end of the false-positives file at
+// filename. It is an error if any of crs is already in the file.
+func AppendFalsePositives(filename string, crs ...*store.CVERecord) (err error) {
+	defer derrors.Wrap(&err, "AppendFalsePositives(%q)", filename)
+
+	existing, err := ReadFalsePositives(filename)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := WriteFalsePositives(&buf, append(existing, crs...)); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, buf.Bytes(), 0644)
+}
+
+// NewFalsePositiveRecord reads the CVE with the given ID from commit of the
+// cvelist repo and returns a record for it. If reportID is empty, the record's
+// triage state is TriageStateFalsePositive; otherwise it is
+// TriageStateHasVuln, with reportID as the reason.
+func NewFalsePositiveRecord(commit *object.Commit, id, reportID string) (_ *store.CVERecord, err error) {
+	defer derrors.Wrap(&err, "NewFalsePositiveRecord(%s, %s)", commit.Hash, id)
+
+	path, err := CVEPath(id)
+	if err != nil {
+		return nil, err
+	}
+	cve, blobHash, err := ReadCVEAtPath(commit, path)
+	if err != nil {
+		return nil, err
+	}
+	if cve.ID != id {
+		return nil, fmt.Errorf("ID at path %s is %s", path, cve.ID)
+	}
+	cr := store.NewCVERecord(cve, path, blobHash, commit)
+	if reportID != "" {
+		cr.TriageState = store.TriageStateHasVuln
+		cr.TriageStateReason = reportID
+	} else {
+		cr.TriageState = store.TriageStateFalsePositive
+		for _, r := range cve.References.Data {
+			if r.URL != "" {
+				cr.ReferenceURLs = append(cr.ReferenceURLs, r.URL)
+			}
+		}
+	}
+	return cr, nil
+}
+
+// MarkFalsePositive sets the triage state of the CVE record with the given ID
+// to TriageStateFalsePositive, with reason as the explanation, and returns the
+// modified record. The record must already be in the store.
+func MarkFalsePositive(ctx context.Context, st store.Store, id, reason string) (_ *store.CVERecord, err error) {
+	defer derrors.Wrap(&err, "MarkFalsePositive(%s)", id)
+
+	var mod *store.CVERecord
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		crs, err := tx.GetCVERecords(id, id)
+		if err != nil {
+			return err
+		}
+		if len(crs) == 0 {
+			return fmt.Errorf("no record for %s; it may need an update", id)
+		}
+		old := crs[0]
+		c := *old // copy the old one
+		mod = &c
+		if old.TriageState == store.TriageStateFalsePositive && old.TriageStateReason == reason {
+			return nil
+		}
+		mod.TriageState = store.TriageStateFalsePositive
+		mod.TriageStateReason = reason
+		if old.CVE != nil && len(mod.ReferenceURLs) == 0 {
+			for _, r := range old.CVE.References.Data {
+				if r.URL != "" {
+					mod.ReferenceURLs = append(mod.ReferenceURLs, r.URL)
+				}
+			}
+		}
+		mod.Module = ""
+		mod.Package = ""
+		mod.CVE = nil
+		mod.History = append([]*store.CVERecordSnapshot{old.Snapshot()}, old.History...)
+		setSeenVersion(ctx, mod)
+		return tx.SetCVERecord(mod)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mod, nil
+}
+
+// CVEPath returns the path of the file for the CVE with the given ID in the
+// cvelist repo.
+func CVEPath(id string) (string, error) {
+	var year, num int
+	if _, err := fmt.Sscanf(id, "CVE-%d-%d", &year, &num); err != nil || year < 1999 {
+		return "", fmt.Errorf("bad CVE ID %q", id)
+	}
+	// The directory is the number with its last three digits replaced by 'x'.
+	dir := fmt.Sprintf("%dxxx", num/1000)
+	if id != fmt.Sprintf("CVE-%d-%04d", year, num) {
+		return "", fmt.Errorf("bad CVE ID %q", id)
+	}
+	return fmt.Sprintf("%d/%s/%s.json", year, dir, id), nil
+}
+
 // updateFalsePositives makes sure the store reflects the list of false positives.
 func updateFalsePositives(ctx context.Context, st store.Store) (err error) {
 	defer derrors.Wrap(&err, "updateFalsePositives")
 	ctx = event.Start(ctx, "updateFalsePositives")
 	defer event.End(ctx)
 
+	falsePositives, err := loadFalsePositives()
+	if err != nil {
+		return err
+	}
 	for i := 0; i < len(falsePositives); i += maxTransactionWrites {
 		j := i + maxTransactionWrites
 		if j >= len(falsePositives) {
@@ -29,7 +307,11 @@ func updateFalsePositives(ctx context.Context, st store.Store) (err error) {
 			if err != nil {
 				return err
 			}
-			for i, cr := range batch {
+			for i, cached := range batch {
+				// The records are cached by loadFalsePositives, and the
+				// store may modify what it is given, so write a copy.
+				c := *cached
+				cr := &c
 				old := oldRecords[i]
 				var err error
 				if old == nil {
@@ -38,6 +320,8 @@ func updateFalsePositives(ctx context.Context, st store.Store) (err error) {
 					// If the false positive data is more recent than what is in
 					// the store, then update the DB. But ignore records whose
 					// commit time hasn't been populated.
+					// cr replaces old, so it has old's version.
+					cr.Version = old.Version
 					err = tx.SetCVERecord(cr)
 				}
 				if err != nil {
@@ -8,11 +8,15 @@
 package worker
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"golang.org/x/vulndb/internal/cveschema"
 	"golang.org/x/vulndb/internal/worker/store"
 )
@@ -93,8 +97,159 @@ func TestUpdateFalsePositives(t *testing.T) {
 			},
 		},
 	} {
-		if diff := cmp.Diff(want, got[want.ID]); diff != "" {
+		if diff := cmp.Diff(want, got[want.ID], ignoreVersion); diff != "" {
 			t.Errorf("mismatch (-want, +got):\n%s", diff)
 		}
 	}
+
+	// The store must not have modified the cached false positives.
+	cached, err := loadFalsePositives()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cr := range cached {
+		if cr.Version != 0 {
+			t.Errorf("%s: cached record has version %d, want 0", cr.ID, cr.Version)
+			break
+		}
+	}
+}
+
+func TestParseFalsePositives(t *testing.T) {
+	// The embedded file must be valid.
+	if _, err := loadFalsePositives(); err != nil {
+		t.Fatal(err)
+	}
+
+	cr := &store.CVERecord{
+		ID:          "CVE-2016-0216",
+		Path:        "2016/0xxx/CVE-2016-0216.json",
+		BlobHash:    "ac9f59c6700576b5936dc014ce265ee0c9a41097",
+		CommitHash:  "17294f1a2af61a2a2df52ac89cbd7c516f0c4e6a",
+		CommitTime:  time.Date(2021, time.April, 12, 23, 0, 56, 0, time.UTC),
+		CVEState:    cveschema.StatePublic,
+		TriageState: store.TriageStateFalsePositive,
+	}
+	var buf bytes.Buffer
+	if err := WriteFalsePositives(&buf, []*store.CVERecord{cr}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseFalsePositives(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*store.CVERecord{cr}, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	for _, test := range []struct {
+		name string
+		data string
+	}{
+		{"bad version", "version: 2\nrecords: []\n"},
+		{"unknown field", "version: 1\nrecords:\n  - id: CVE-2016-0216\n    color: blue\n"},
+		{"missing hash", "version: 1\nrecords:\n  - id: CVE-2016-0216\n    path: p\n    commit_time: 2021-04-12T23:00:56Z\n    triage_state: FalsePositive\n"},
+		{"bad triage state", strings.Replace(buf.String(), "FalsePositive", "NeedsIssue", 1)},
+		{"no report ID", strings.Replace(buf.String(), "FalsePositive", "HasVuln", 1)},
+		{"duplicate", buf.String() + buf.String()[strings.Index(buf.String(), "  - id"):]},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := ParseFalsePositives([]byte(test.data)); err == nil {
+				t.Error("got nil, want error")
+			}
+		})
+	}
+}
+
+func TestCVEPath(t *testing.T) {
+	for _, test := range []struct {
+		id, want string
+	}{
+		{"CVE-2016-0216", "2016/0xxx/CVE-2016-0216.json"},
+		{"CVE-2020-15112", "2020/15xxx/CVE-2020-15112.json"},
+		{"CVE-2017-1000056", "2017/1000xxx/CVE-2017-1000056.json"},
+	} {
+		got, err := CVEPath(test.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s: got %q, want %q", test.id, got, test.want)
+		}
+	}
+	for _, id := range []string{"CVE-2016-216", "GHSA-xxxx-yyyy-zzzz", "CVE-2016-0216x"} {
+		if _, err := CVEPath(id); err == nil {
+			t.Errorf("%s: got nil, want error", id)
+		}
+	}
+}
+
+func TestMarkFalsePositive(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	cve := &cveschema.CVE{
+		Metadata: cveschema.Metadata{ID: "CVE-2022-0001", State: cveschema.StatePublic},
+		References: cveschema.References{
+			Data: []cveschema.Reference{{URL: "https://example.com/a"}, {URL: ""}},
+		},
+	}
+	createCVERecords(t, mstore, []*store.CVERecord{{
+		ID:                "CVE-2022-0001",
+		Path:              "2022/0xxx/CVE-2022-0001.json",
+		BlobHash:          "abc",
+		CommitHash:        "123",
+		CommitTime:        time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC),
+		CVEState:          cveschema.StatePublic,
+		TriageState:       store.TriageStateNeedsIssue,
+		TriageStateReason: "reference is a Go module",
+		Module:            "golang.org/x/mod",
+		CVE:               cve,
+	}})
+
+	got, err := MarkFalsePositive(store.WithActor(ctx, "cli:alice"), mstore, "CVE-2022-0001", "not Go code")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &store.CVERecord{
+		ID:                "CVE-2022-0001",
+		Path:              "2022/0xxx/CVE-2022-0001.json",
+		BlobHash:          "abc",
+		CommitHash:        "123",
+		CommitTime:        time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC),
+		CVEState:          cveschema.StatePublic,
+		TriageState:       store.TriageStateFalsePositive,
+		TriageStateReason: "not Go code",
+		ReferenceURLs:     []string{"https://example.com/a"},
+		History: []*store.CVERecordSnapshot{{
+			CommitHash:        "123",
+			CVEState:          cveschema.StatePublic,
+			TriageState:       store.TriageStateNeedsIssue,
+			TriageStateReason: "reference is a Go module",
+		}},
+	}
+	if diff := cmp.Diff(want, got, ignoreVersion); diff != "" {
+		t.Errorf("returned record mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want, mstore.CVERecords()["CVE-2022-0001"], ignoreVersion); diff != "" {
+		t.Errorf("stored record mismatch (-want, +got):\n%s", diff)
+	}
+	hist, err := mstore.ListTriageHistory(ctx, "CVE-2022-0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantHist := []*store.TriageHistoryEntry{
+		{ID: "CVE-2022-0001", Actor: store.DefaultActor, NewState: store.TriageStateNeedsIssue, Reason: "reference is a Go module"},
+		{ID: "CVE-2022-0001", Actor: "cli:alice", OldState: store.TriageStateNeedsIssue, NewState: store.TriageStateFalsePositive, Reason: "not Go code"},
+	}
+	if diff := cmp.Diff(wantHist, hist, cmpopts.IgnoreFields(store.TriageHistoryEntry{}, "Time")); diff != "" {
+		t.Errorf("triage history mismatch (-want, +got):\n%s", diff)
+	}
+	// The record must be writable to the false-positives file.
+	if err := WriteFalsePositives(io.Discard, []*store.CVERecord{got}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := MarkFalsePositive(ctx, mstore, "CVE-2022-9999", "not Go code"); err == nil {
+		t.Error("missing record: got nil, want error")
+	}
 }
@@ -0,0 +1,198 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/osvdev"
+	"golang.org/x/vulndb/internal/refurl"
+	"golang.org/x/vulndb/internal/worker/log"
+)
+
+// OSVVulnFunc is the type of a function that returns the entry in OSV.dev
+// with the given ID, or nil if there is none.
+type OSVVulnFunc func(ctx context.Context, id string) (*osvdev.Vuln, error)
+
+// gapReferenceTypes are the types of the references of other advisories that
+// a report may be missing. Other types, like ADVISORY and PACKAGE, refer to
+// the advisories themselves or to things that reports don't list.
+var gapReferenceTypes = map[string]bool{
+	"FIX":    true,
+	"REPORT": true,
+}
+
+// A ReportGap is what the advisories of other databases about the same
+// vulnerability as a report know, but the report doesn't.
+type ReportGap struct {
+	// ID is the ID of the report, such as GO-2022-0123.
+	ID string
+	// Aliases are the CVE and GHSA IDs of the vulnerability that the report
+	// doesn't list.
+	Aliases []string
+	// References are the fix and issue report URLs of the other advisories
+	// that the report doesn't list.
+	References []string
+}
+
+func (g *ReportGap) String() string {
+	var parts []string
+	if len(g.Aliases) > 0 {
+		parts = append(parts, "add aliases "+strings.Join(g.Aliases, ", "))
+	}
+	if len(g.References) > 0 {
+		parts = append(parts, "add references "+strings.Join(g.References, ", "))
+	}
+	return fmt.Sprintf("%s: %s", g.ID, strings.Join(parts, "; "))
+}
+
+// ReportGapsStats describes the result of a search for report gaps.
+type ReportGapsStats struct {
+	// Number of reports checked.
+	NumChecked int
+	// Gaps are the reports that may be missing aliases or references, in
+	// order of ID.
+	Gaps []*ReportGap
+}
+
+// FindReportGaps checks the reports in the vulndb repo at repoPath, which may
+// be a URL to clone or a local directory, against the advisories of other
+// databases in OSV.dev that are about the same vulnerabilities. It returns
+// the CVE and GHSA aliases, and the fix and issue report references, of
+// those advisories that the reports don't have, and sends a digest of them
+// as a notification.
+//
+// The reports are not changed: a triager decides which suggestions to add to
+// the YAML.
+func FindReportGaps(ctx context.Context, vuln OSVVulnFunc, repoPath string) (_ ReportGapsStats, err error) {
+	defer derrors.Wrap(&err, "FindReportGaps(%q)", repoPath)
+
+	reports, err := LoadGoReports(ctx, repoPath)
+	if err != nil {
+		return ReportGapsStats{}, err
+	}
+	return findReportGaps(ctx, vuln, reports)
+}
+
+func findReportGaps(ctx context.Context, vuln OSVVulnFunc, reports []*GoReport) (stats ReportGapsStats, err error) {
+	ctx = event.Start(ctx, "findReportGaps")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, nil, "report-gaps")
+	defer func() { end(err) }()
+
+	// Several reports may have the same aliases, so look up each ID once.
+	vulns := map[string]*osvdev.Vuln{}
+	lookup := func(id string) (*osvdev.Vuln, error) {
+		if v, ok := vulns[id]; ok {
+			return v, nil
+		}
+		v, err := vuln(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		vulns[id] = v
+		return v, nil
+	}
+
+	for _, r := range reports {
+		if r.Excluded {
+			continue
+		}
+		stats.NumChecked++
+		g, err := reportGap(r, lookup)
+		if err != nil {
+			return stats, err
+		}
+		if g != nil {
+			stats.Gaps = append(stats.Gaps, g)
+		}
+	}
+	sort.Slice(stats.Gaps, func(i, j int) bool { return stats.Gaps[i].ID < stats.Gaps[j].ID })
+
+	if len(stats.Gaps) > 0 {
+		var b strings.Builder
+		fmt.Fprintf(&b, "%d Go reports may be missing aliases or references.", len(stats.Gaps))
+		for i, g := range stats.Gaps {
+			log.Infof(ctx, "report gap: %s", g)
+			if i == maxListedIDs {
+				fmt.Fprintf(&b, "\n... and %d more", len(stats.Gaps)-i)
+				break
+			}
+			fmt.Fprintf(&b, "\n%s", g)
+		}
+		notify(ctx, &Notification{Kind: NotifyReportGaps, Text: b.String()})
+	}
+	log.Infof(ctx, "report gaps search succeeded: checked %d, %d with gaps", stats.NumChecked, len(stats.Gaps))
+	return stats, nil
+}
+
+// reportGap returns what the advisories about the same vulnerability as r
+// know but r doesn't, or nil if there is nothing. The advisories are those
+// that lookup returns for r's aliases, and for the aliases of r's own entry
+// in OSV.dev.
+func reportGap(r *GoReport, lookup func(string) (*osvdev.Vuln, error)) (*ReportGap, error) {
+	ids := append([]string{}, r.Aliases...)
+	own, err := lookup(r.ID)
+	if err != nil {
+		return nil, err
+	}
+	if own != nil {
+		ids = append(ids, own.Aliases...)
+	}
+
+	known := map[string]bool{r.ID: true}
+	for _, a := range r.Aliases {
+		known[a] = true
+	}
+	knownRefs := map[string]bool{}
+	for _, u := range r.References {
+		knownRefs[refurl.Canonical(u)] = true
+	}
+	g := &ReportGap{ID: r.ID}
+	addAlias := func(id string) {
+		if known[id] || !(strings.HasPrefix(id, "CVE-") || strings.HasPrefix(id, "GHSA-")) {
+			return
+		}
+		known[id] = true
+		g.Aliases = append(g.Aliases, id)
+	}
+	looked := map[string]bool{}
+	for _, id := range ids {
+		if looked[id] || strings.HasPrefix(id, osvdev.GoIDPrefix) {
+			continue
+		}
+		looked[id] = true
+		addAlias(id)
+		v, err := lookup(id)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			continue
+		}
+		addAlias(v.ID)
+		for _, a := range v.Aliases {
+			addAlias(a)
+		}
+		for _, ref := range v.References {
+			n := refurl.Canonical(ref.URL)
+			if !gapReferenceTypes[ref.Type] || knownRefs[n] {
+				continue
+			}
+			knownRefs[n] = true
+			g.References = append(g.References, ref.URL)
+		}
+	}
+	if len(g.Aliases) == 0 && len(g.References) == 0 {
+		return nil, nil
+	}
+	sort.Strings(g.Aliases)
+	return g, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/osvdev"
+)
+
+func TestFindReportGaps(t *testing.T) {
+	ctx := context.Background()
+	repo, err := gitrepo.ReadTxtarRepo("testdata/vulndb.txtar", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	reports, err := ReadGoReports(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vulns := map[string]*osvdev.Vuln{
+		// The report's own entry knows a GHSA that the report doesn't.
+		"GO-2022-0005": {ID: "GO-2022-0005", Aliases: []string{"CVE-2022-0002", "GHSA-bbbb-cccc-dddd"}},
+		"GHSA-bbbb-cccc-dddd": {
+			ID:      "GHSA-bbbb-cccc-dddd",
+			Aliases: []string{"CVE-2022-0002"},
+			References: []*osvdev.Reference{
+				// Already in the report.
+				{Type: "FIX", URL: "https://GitHub.com/b/b/commit/123/"},
+				{Type: "REPORT", URL: "https://github.com/b/b/issues/1"},
+				// Not a kind of reference that reports list.
+				{Type: "ADVISORY", URL: "https://nvd.nist.gov/vuln/detail/CVE-2022-0002"},
+			},
+		},
+		// Another database knows a CVE that the report doesn't.
+		"GHSA-aaaa-bbbb-cccc": {ID: "GHSA-aaaa-bbbb-cccc", Aliases: []string{"CVE-2022-0007", "PYSEC-2022-1"}},
+		// Nothing to add.
+		"CVE-2022-0001": {ID: "CVE-2022-0001", Aliases: []string{"GO-2022-0001"}},
+	}
+	var looked []string
+	vuln := func(_ context.Context, id string) (*osvdev.Vuln, error) {
+		looked = append(looked, id)
+		return vulns[id], nil
+	}
+
+	rn := &recordingNotifier{}
+	ctx = WithNotifier(ctx, rn)
+	stats, err := findReportGaps(ctx, vuln, reports)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ReportGapsStats{
+		NumChecked: 3,
+		Gaps: []*ReportGap{
+			{
+				ID:         "GO-2022-0005",
+				Aliases:    []string{"GHSA-bbbb-cccc-dddd"},
+				References: []string{"https://github.com/b/b/issues/1"},
+			},
+			{ID: "GO-2022-0007", Aliases: []string{"CVE-2022-0007"}},
+		},
+	}
+	if diff := cmp.Diff(want, stats); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	// Each ID is looked up once, and excluded reports not at all.
+	seen := map[string]bool{}
+	for _, id := range looked {
+		if seen[id] {
+			t.Errorf("%s looked up more than once", id)
+		}
+		seen[id] = true
+	}
+	if seen["GO-2022-0004"] || seen["CVE-2022-0004"] {
+		t.Error("excluded report was checked")
+	}
+
+	if len(rn.ns) != 1 || rn.ns[0].Kind != NotifyReportGaps {
+		t.Fatalf("got notifications %+v, want one about report gaps", rn.ns)
+	}
+	wantText := "2 Go reports may be missing aliases or references.\n" +
+		"GO-2022-0005: add aliases GHSA-bbbb-cccc-dddd; add references https://github.com/b/b/issues/1\n" +
+		"GO-2022-0007: add aliases CVE-2022-0007"
+	if got := rn.ns[0].Text; got != wantText {
+		t.Errorf("got text\n%s\nwant\n%s", got, wantText)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gitlab"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// CVE records that come from the GitLab Advisory Database have no cvelist
+// repo commit. Their CommitHash is gitlabCommitHash, and their CommitTime is
+// the time of the commit of the GitLab repo they were read from.
+const gitlabCommitHash = "gitlab"
+
+// UpdateGitLabStats describes the result of an update from the GitLab
+// Advisory Database.
+type UpdateGitLabStats struct {
+	// Number of Go advisories seen.
+	NumProcessed int
+	// Number of CVERecords added to the store.
+	NumAdded int
+	// Number of CVERecords already in the store that were modified.
+	NumModified int
+	// Number of advisories without a CVE ID, of which only the aliases were
+	// recorded. Those with a GHSA ID are covered by GHSA updates.
+	NumWithoutCVE int
+}
+
+// UpdateFromGitLab updates the store with the Go advisories of the GitLab
+// Advisory Database at repoPath, which may be a URL to clone or a local
+// directory. It records the IDs of each advisory as aliases, and triages the
+// CVE of each advisory like the CVEs of the cvelist repo.
+//
+// An advisory whose CVE is not in the store adds a CVE record for it. A CVE
+// record that came from the cvelist repo or the NVD is left alone, unless
+// it didn't need an issue, but the advisory shows that the CVE is about a
+// Go package: then it moves to TriageStateNeedsIssue.
+func UpdateFromGitLab(ctx context.Context, repoPath string, st store.Store, pkgsiteURL string) (_ UpdateGitLabStats, err error) {
+	defer derrors.Wrap(&err, "UpdateFromGitLab(%q)", repoPath)
+
+	repo, err := gitrepo.CloneOrOpen(ctx, repoPath)
+	if err != nil {
+		return UpdateGitLabStats{}, err
+	}
+	knownVulnIDs, err := getAllCVEsAndGHSAsInVulnDB(ctx)
+	if err != nil {
+		return UpdateGitLabStats{}, err
+	}
+	knownIDs := map[string]bool{}
+	for _, id := range knownVulnIDs {
+		knownIDs[id] = true
+	}
+	triage := func(ctx context.Context, cve *cveschema.CVE) (*triageResult, error) {
+		return TriageCVE(ctx, cve, pkgsiteURL)
+	}
+	return updateFromGitLab(ctx, repo, st, knownIDs, triage)
+}
+
+func updateFromGitLab(ctx context.Context, repo *git.Repository, st store.Store, knownIDs map[string]bool, triage triageFunc) (stats UpdateGitLabStats, err error) {
+	ctx = event.Start(ctx, "updateFromGitLab")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "gitlab")
+	defer func() { end(err) }()
+	defer func() { countUpdate(ctx, stats.NumProcessed, stats.NumAdded, stats.NumModified) }()
+
+	ref, err := repo.Head()
+	if err != nil {
+		return stats, err
+	}
+	commit, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return stats, err
+	}
+	files, err := gitlab.ReadGoAdvisories(repo)
+	if err != nil {
+		return stats, err
+	}
+	log.Infof(ctx, "GitLab update starting at commit %s: %d Go advisories", commit.Hash, len(files))
+
+	// An advisory can write the alias documents of its IDs, and its CVE
+	// record with the search document and alias documents of the CVE, so
+	// the batches are smaller than those of other updates.
+	const batchSize = maxTransactionWrites / 10
+	for i := 0; i < len(files); i += batchSize {
+		j := i + batchSize
+		if j > len(files) {
+			j = len(files)
+		}
+		numAdded, numModified, err := updateGitLabBatch(ctx, files[i:j], commit.Committer.When.UTC(), st, knownIDs, triage)
+		if err != nil {
+			return stats, err
+		}
+		stats.NumProcessed += j - i
+		cvesProcessedCounter.Record(ctx, int64(j-i), event.String("source", "gitlab"))
+		stats.NumAdded += numAdded
+		stats.NumModified += numModified
+	}
+	for _, f := range files {
+		if f.Advisory.CVE() == "" {
+			stats.NumWithoutCVE++
+		}
+	}
+	log.Infof(ctx, "GitLab update succeeded: %+v", stats)
+	return stats, nil
+}
+
+func updateGitLabBatch(ctx context.Context, batch []*gitlab.File, commitTime time.Time, st store.Store, knownIDs map[string]bool, triage triageFunc) (numAdds, numMods int, err error) {
+	defer derrors.Wrap(&err, "updateGitLabBatch(%s-%s)", batch[0].Path, batch[len(batch)-1].Path)
+
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		numAdds = 0
+		numMods = 0
+		for _, f := range batch {
+			a := f.Advisory
+			if ids := a.IDs(); len(ids) > 1 {
+				if err := tx.AddAliases(ids...); err != nil {
+					return err
+				}
+			}
+			id := a.CVE()
+			if id == "" {
+				continue
+			}
+			crs, err := tx.GetCVERecords(id, id)
+			if err != nil {
+				return err
+			}
+			var old *store.CVERecord
+			if len(crs) > 0 {
+				old = crs[0]
+			}
+			src := gitlabSource(f, commitTime)
+			cve := a.ToCVE4()
+			if old != nil && old.CommitHash != gitlabCommitHash {
+				changed, err := triageFromGitLab(ctx, tx, cve, old, a.Identifier, knownIDs, triage)
+				if err != nil {
+					return err
+				}
+				if changed {
+					numMods++
+				}
+				continue
+			}
+			if old != nil && old.BlobHash == src.blobHash {
+				// No change; do nothing.
+				continue
+			}
+			added, err := triageAndStoreCVE(ctx, tx, cve, old, src, knownIDs, triage)
+			if err != nil {
+				return err
+			}
+			if added {
+				numAdds++
+			} else {
+				numMods++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	log.Debugf(ctx, "GitLab update transaction %s-%s: added %d, modified %d", batch[0].Path, batch[len(batch)-1].Path, numAdds, numMods)
+	return numAdds, numMods, nil
+}
+
+// triageFromGitLab triages cve, from the GitLab advisory with the given ID,
+// whose record old came from another source. If old didn't need an issue,
+// but the advisory is about a Go module, it moves old to
+// TriageStateNeedsIssue and reports that it changed it. The source fields
+// of old are kept, so that updates from its source don't see a change.
+func triageFromGitLab(ctx context.Context, tx store.Transaction, cve *cveschema.CVE, old *store.CVERecord, advisoryID string, knownIDs map[string]bool, triage triageFunc) (changed bool, err error) {
+	switch old.TriageState {
+	case store.TriageStateNoActionNeeded, store.TriageStateNotAModule:
+	default:
+		return false, nil
+	}
+	ctx = log.NewContext(ctx, "cve", cve.ID)
+	result, err := triageForStore(ctx, cve, old, knownIDs, triage)
+	if err != nil {
+		return false, err
+	}
+	if result == nil || result.falsePositive || result.notModule {
+		return false, nil
+	}
+	mod := *old
+	mod.History = append([]*store.CVERecordSnapshot{old.Snapshot()}, mod.History...)
+	mod.TriageState = store.TriageStateNeedsIssue
+	mod.Module = result.modulePath
+	mod.Package = result.packagePath
+	mod.TriageStateReason = fmt.Sprintf("GitLab advisory %s: %s", advisoryID, result.reason)
+	mod.CVE = cve
+	if err := tx.SetCVERecord(&mod); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// gitlabSource returns the source of a CVE record for the advisory in f,
+// read at a commit with the given time.
+func gitlabSource(f *gitlab.File, commitTime time.Time) cveSource {
+	return cveSource{
+		path:       gitlab.URL + "/-/blob/main/" + f.Path,
+		blobHash:   "gitlab:" + f.BlobHash,
+		commitHash: gitlabCommitHash,
+		commitTime: commitTime,
+	}
+}
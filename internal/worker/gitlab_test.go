@@ -0,0 +1,108 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestUpdateFromGitLab(t *testing.T) {
+	ctx := context.Background()
+	commitTime := time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC)
+	repo, err := gitrepo.ReadTxtarRepo("../gitlab/testdata/advisories.txtar", commitTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	triage := func(_ context.Context, c *cveschema.CVE) (*triageResult, error) {
+		for _, r := range c.References.Data {
+			if p := strings.TrimPrefix(r.URL, "https://pkg.go.dev/"); p != r.URL {
+				return &triageResult{modulePath: p, reason: "pkg.go.dev reference"}, nil
+			}
+		}
+		return nil, nil
+	}
+	const id = "CVE-2022-1001"
+
+	t.Run("new", func(t *testing.T) {
+		mstore := store.NewMemStore()
+		stats, err := updateFromGitLab(ctx, repo, mstore, nil, triage)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := (UpdateGitLabStats{NumProcessed: 2, NumAdded: 1, NumWithoutCVE: 1}); stats != want {
+			t.Errorf("got %+v, want %+v", stats, want)
+		}
+		got := mstore.CVERecords()[id]
+		if got == nil {
+			t.Fatalf("no record for %s", id)
+		}
+		if got.CommitHash != gitlabCommitHash || !got.CommitTime.Equal(commitTime) || !strings.HasPrefix(got.BlobHash, "gitlab:") {
+			t.Errorf("got source %s, %s, %s; want the GitLab commit", got.CommitHash, got.CommitTime, got.BlobHash)
+		}
+		if got.TriageState != store.TriageStateNeedsIssue || got.Module != "github.com/a/b" {
+			t.Errorf("got %s for %q, want NeedsIssue for github.com/a/b", got.TriageState, got.Module)
+		}
+		aliases, err := mstore.GetAliases(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]string{"GHSA-aaaa-bbbb-cccc"}, aliases); diff != "" {
+			t.Errorf("aliases mismatch (-want, +got):\n%s", diff)
+		}
+
+		// Another update of the same commit changes nothing.
+		stats, err = updateFromGitLab(ctx, repo, mstore, nil, triage)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := (UpdateGitLabStats{NumProcessed: 2, NumWithoutCVE: 1}); stats != want {
+			t.Errorf("second update: got %+v, want %+v", stats, want)
+		}
+	})
+
+	t.Run("from cvelist", func(t *testing.T) {
+		mstore := store.NewMemStore()
+		createCVERecords(t, mstore, []*store.CVERecord{{
+			ID:          id,
+			Path:        "2022/1xxx/CVE-2022-1001.json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  commitTime.Add(-time.Hour),
+			TriageState: store.TriageStateNoActionNeeded,
+		}})
+		stats, err := updateFromGitLab(ctx, repo, mstore, nil, triage)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := (UpdateGitLabStats{NumProcessed: 2, NumModified: 1, NumWithoutCVE: 1}); stats != want {
+			t.Errorf("got %+v, want %+v", stats, want)
+		}
+		got := mstore.CVERecords()[id]
+		if got.TriageState != store.TriageStateNeedsIssue || got.Module != "github.com/a/b" {
+			t.Errorf("got %s for %q, want NeedsIssue for github.com/a/b", got.TriageState, got.Module)
+		}
+		if want := "GitLab advisory CVE-2022-1001: pkg.go.dev reference"; got.TriageStateReason != want {
+			t.Errorf("got reason %q, want %q", got.TriageStateReason, want)
+		}
+		// The record keeps its source, so that cvelist updates see no change.
+		if got.CommitHash != "123" || got.BlobHash != "abc" {
+			t.Errorf("got source %s, %s; want 123, abc", got.CommitHash, got.BlobHash)
+		}
+		if len(got.History) != 1 || got.History[0].TriageState != store.TriageStateNoActionNeeded {
+			t.Errorf("got history %+v, want the NoActionNeeded snapshot", got.History)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"sort"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/depsdev"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// DependentsFunc is the type of a function that returns the dependents of the
+// module with the given path, or nil if they are not known.
+type DependentsFunc func(ctx context.Context, modulePath string) (*depsdev.Dependents, error)
+
+// UpdateImportersStats describes the result of an update of importer counts.
+type UpdateImportersStats struct {
+	// Number of CVERecords needing an issue that have a module.
+	NumProcessed int
+	// Number of distinct modules of those records that were looked up.
+	NumModules int
+	// Number of CVERecords whose count changed.
+	NumModified int
+}
+
+// UpdateImporters sets the number of importers of the module of each CVE
+// record that needs an issue, so they can be triaged in order of the reach
+// of their modules.
+func UpdateImporters(ctx context.Context, dependents DependentsFunc, st store.Store) (stats UpdateImportersStats, err error) {
+	defer derrors.Wrap(&err, "UpdateImporters")
+	ctx = event.Start(ctx, "UpdateImporters")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "importers")
+	defer func() { end(err) }()
+	defer func() { countUpdate(ctx, stats.NumProcessed, 0, stats.NumModified) }()
+
+	crs, err := st.ListCVERecordsWithTriageState(ctx, store.TriageStateNeedsIssue)
+	if err != nil {
+		return stats, err
+	}
+	importers := map[string]int{}
+	var ids []string
+	for _, cr := range crs {
+		if cr.Module == "" {
+			continue
+		}
+		ids = append(ids, cr.ID)
+		if _, ok := importers[cr.Module]; ok {
+			continue
+		}
+		d, err := dependents(ctx, cr.Module)
+		if err != nil {
+			return stats, err
+		}
+		importers[cr.Module] = 0
+		if d != nil {
+			importers[cr.Module] = d.Direct
+		}
+	}
+	stats.NumProcessed = len(ids)
+	stats.NumModules = len(importers)
+	sort.Strings(ids)
+	for i := 0; i < len(ids); i += maxTransactionWrites {
+		j := i + maxTransactionWrites
+		if j > len(ids) {
+			j = len(ids)
+		}
+		n, err := updateImportersBatch(ctx, st, ids[i:j], importers)
+		if err != nil {
+			return stats, err
+		}
+		stats.NumModified += n
+	}
+	log.Infof(ctx, "importers update succeeded: %+v", stats)
+	return stats, nil
+}
+
+func updateImportersBatch(ctx context.Context, st store.Store, ids []string, importers map[string]int) (numMods int, err error) {
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		numMods = 0
+		for _, id := range ids {
+			crs, err := tx.GetCVERecords(id, id)
+			if err != nil {
+				return err
+			}
+			// The record may have been triaged since it was listed.
+			if len(crs) == 0 || crs[0].TriageState != store.TriageStateNeedsIssue {
+				continue
+			}
+			cr := crs[0]
+			n, ok := importers[cr.Module]
+			if !ok || cr.Importers == n {
+				continue
+			}
+			cr.Importers = n
+			if err := tx.SetCVERecord(cr); err != nil {
+				return err
+			}
+			numMods++
+		}
+		return nil
+	})
+	return numMods, err
+}
+
+// sortByImporters sorts crs so the records whose modules have the most
+// importers are first. Records with equal counts, including those with none,
+// keep their order.
+func sortByImporters(crs []*store.CVERecord) {
+	sort.SliceStable(crs, func(i, j int) bool { return crs[i].Importers > crs[j].Importers })
+}
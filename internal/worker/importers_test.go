@@ -0,0 +1,99 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/depsdev"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestUpdateImporters(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	record := func(id, module string, ts store.TriageState) *store.CVERecord {
+		return &store.CVERecord{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  time.Date(2022, time.October, 1, 0, 0, 0, 0, time.UTC),
+			TriageState: ts,
+			Module:      module,
+		}
+	}
+	createCVERecords(t, mstore, []*store.CVERecord{
+		record("CVE-2022-0001", "golang.org/x/small", store.TriageStateNeedsIssue),
+		record("CVE-2022-0002", "golang.org/x/big", store.TriageStateNeedsIssue),
+		record("CVE-2022-0003", "golang.org/x/big", store.TriageStateNeedsIssue),
+		record("CVE-2022-0004", "golang.org/x/unknown", store.TriageStateNeedsIssue),
+		record("CVE-2022-0005", "", store.TriageStateNeedsIssue),
+		record("CVE-2022-0006", "golang.org/x/big", store.TriageStateNoActionNeeded),
+	})
+
+	var requested []string
+	dependents := func(_ context.Context, modulePath string) (*depsdev.Dependents, error) {
+		requested = append(requested, modulePath)
+		switch modulePath {
+		case "golang.org/x/small":
+			return &depsdev.Dependents{Version: "v1.0.0", Direct: 3, Indirect: 10}, nil
+		case "golang.org/x/big":
+			return &depsdev.Dependents{Version: "v2.0.0", Direct: 500, Indirect: 9000}, nil
+		}
+		return nil, nil
+	}
+	stats, err := UpdateImporters(ctx, dependents, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"golang.org/x/small", "golang.org/x/big", "golang.org/x/unknown"}, requested); diff != "" {
+		t.Errorf("requested modules mismatch (-want, +got):\n%s", diff)
+	}
+	if want := (UpdateImportersStats{NumProcessed: 4, NumModules: 3, NumModified: 3}); stats != want {
+		t.Errorf("got %+v, want %+v", stats, want)
+	}
+	crs := mstore.CVERecords()
+	for id, want := range map[string]int{
+		"CVE-2022-0001": 3,
+		"CVE-2022-0002": 500,
+		"CVE-2022-0003": 500,
+		"CVE-2022-0004": 0,
+		"CVE-2022-0006": 0,
+	} {
+		if got := crs[id].Importers; got != want {
+			t.Errorf("%s: got %d importers, want %d", id, got, want)
+		}
+	}
+
+	// Unchanged counts are not written again.
+	stats, err = UpdateImporters(ctx, dependents, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.NumModified != 0 {
+		t.Errorf("second update: modified %d records, want 0", stats.NumModified)
+	}
+
+	// The records of the most imported modules sort first.
+	var needsIssue []*store.CVERecord
+	for _, id := range []string{"CVE-2022-0004", "CVE-2022-0001", "CVE-2022-0002"} {
+		needsIssue = append(needsIssue, crs[id])
+	}
+	sortByImporters(needsIssue)
+	var got []string
+	for _, cr := range needsIssue {
+		got = append(got, cr.ID)
+	}
+	if diff := cmp.Diff([]string{"CVE-2022-0002", "CVE-2022-0001", "CVE-2022-0004"}, got); diff != "" {
+		t.Errorf("sortByImporters mismatch (-want, +got):\n%s", diff)
+	}
+}
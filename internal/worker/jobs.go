@@ -0,0 +1,494 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/vulndb/internal/depsdev"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/epss"
+	"golang.org/x/vulndb/internal/osvdev"
+	"golang.org/x/vulndb/internal/worker/log"
+)
+
+// The server runs jobs, like updates and issue creation, on its own
+// schedules (see startScheduler), or when an external scheduler asks it to,
+// with a POST to /jobs/NAME or a Pub/Sub push to /pubsub. An external
+// scheduler suits platforms like Cloud Run, which may stop a server that
+// isn't serving a request.
+//
+// A request may give an idempotency key, such as the ID of a Pub/Sub
+// message. Once a job succeeds for a key, a request with the same key
+// succeeds without running it again, so a message that is delivered twice
+// runs the job once. A job that fails may be retried with the same key.
+
+// A job is work that the server does at once, on a schedule or on request.
+type job struct {
+	name string
+	// timeout is how long a run of the job may take.
+	timeout time.Duration
+	// check returns an error if the server is not configured for the job.
+	check func() error
+	run   func(ctx context.Context) error
+}
+
+// jobs returns the jobs that the server can run.
+func (s *Server) jobs() []*job {
+	needIssueRepo := func() error {
+		if s.issueClient == nil {
+			return errors.New("no issue repo")
+		}
+		return nil
+	}
+	needVulnDBRepo := func() error {
+		if s.cfg.VulnDBRepo == "" {
+			return errors.New("no vulndb repo")
+		}
+		return nil
+	}
+	return []*job{
+		{
+			// Triage happens as part of updates.
+			name:    "update",
+			timeout: 30 * time.Minute,
+			run: func(ctx context.Context) error {
+				return UpdateCVEsAtCommit(ctx, s.cfg.cveListRepoURL(), "HEAD", s.cfg.Store, pkgsiteURL, false)
+			},
+		},
+		{
+			name:    "update-ghsas",
+			timeout: 15 * time.Minute,
+			run: func(ctx context.Context) error {
+				_, err := UpdateGHSAs(ctx, s.ghsaClient.List, s.cfg.Store)
+				return err
+			},
+		},
+		{
+			name:    "update-epss",
+			timeout: 15 * time.Minute,
+			run: func(ctx context.Context) error {
+				_, err := UpdateEPSS(ctx, epss.NewClient(epss.DefaultBaseURL).Scores, s.cfg.Store)
+				return err
+			},
+		},
+		{
+			name:    "update-importers",
+			timeout: 30 * time.Minute,
+			run: func(ctx context.Context) error {
+				_, err := UpdateImporters(ctx, depsdev.NewClient(depsdev.DefaultBaseURL).Dependents, s.cfg.Store)
+				return err
+			},
+		},
+		{
+			name:    "issues",
+			timeout: 15 * time.Minute,
+			check:   needIssueRepo,
+			run: func(ctx context.Context) error {
+				return s.createIssues(ctx, defaultIssueLimit)
+			},
+		},
+		{
+			name:    "reconcile",
+			timeout: 30 * time.Minute,
+			check: func() error {
+				if err := needIssueRepo(); err != nil {
+					return err
+				}
+				return needVulnDBRepo()
+			},
+			run: func(ctx context.Context) error {
+				_, err := Reconcile(ctx, s.cfg.Store, s.issueClient, s.cfg.VulnDBRepo)
+				return err
+			},
+		},
+		{
+			name:    "report-gaps",
+			timeout: 30 * time.Minute,
+			check:   needVulnDBRepo,
+			run: func(ctx context.Context) error {
+				_, err := FindReportGaps(ctx, osvdev.NewClient(osvdev.DefaultBaseURL).Vuln, s.cfg.VulnDBRepo)
+				return err
+			},
+		},
+		{
+			name:    "check-depsdev",
+			timeout: 50 * time.Minute,
+			check:   needVulnDBRepo,
+			run: func(ctx context.Context) error {
+				_, err := CheckDepsDev(ctx, depsdev.NewClient(depsdev.DefaultBaseURL), s.cfg.VulnDBRepo, s.cfg.Store)
+				return err
+			},
+		},
+		{
+			name:    "security-releases",
+			timeout: 15 * time.Minute,
+			check: func() error {
+				if err := needIssueRepo(); err != nil {
+					return err
+				}
+				return needVulnDBRepo()
+			},
+			run: func(ctx context.Context) error {
+				_, err := ScanSecurityReleases(ctx, FetchReleaseHistory, s.cfg.Store, s.issueClient, s.cfg.VulnDBRepo)
+				return err
+			},
+		},
+		{
+			name:    "watch-unfixed",
+			timeout: 30 * time.Minute,
+			check: func() error {
+				if err := needIssueRepo(); err != nil {
+					return err
+				}
+				return needVulnDBRepo()
+			},
+			run: func(ctx context.Context) error {
+				_, err := WatchUnfixedModules(ctx, ProxyLatestVersion, s.cfg.Store, s.issueClient, s.cfg.VulnDBRepo)
+				return err
+			},
+		},
+		{
+			name:    "withdrawals",
+			timeout: 15 * time.Minute,
+			check: func() error {
+				if err := needIssueRepo(); err != nil {
+					return err
+				}
+				return needVulnDBRepo()
+			},
+			run: func(ctx context.Context) error {
+				_, err := CheckWithdrawals(ctx, s.cfg.Store, s.issueClient, s.cfg.VulnDBRepo)
+				return err
+			},
+		},
+		{
+			name:    "embargoes",
+			timeout: 15 * time.Minute,
+			check:   s.needEmbargoConfig,
+			run: func(ctx context.Context) error {
+				_, err := s.publishEmbargoed(ctx)
+				return err
+			},
+		},
+		{
+			name:    "triage-aging",
+			timeout: 15 * time.Minute,
+			run: func(ctx context.Context) error {
+				_, err := CheckTriageAging(ctx, s.cfg.Store, false)
+				return err
+			},
+		},
+		{
+			name:    "triage-digest",
+			timeout: 15 * time.Minute,
+			run: func(ctx context.Context) error {
+				_, err := CheckTriageAging(ctx, s.cfg.Store, true)
+				return err
+			},
+		},
+		{
+			name:    "triage-summary",
+			timeout: 15 * time.Minute,
+			check:   s.needSummaryIssue,
+			run: func(ctx context.Context) error {
+				_, err := PostTriageSummary(ctx, s.cfg.Store, s.issueClient, s.cfg.SummaryIssue, s.cfg.VulnDBRepo)
+				return err
+			},
+		},
+	}
+}
+
+// findJob returns the job with the given name, or nil if there is none.
+func (s *Server) findJob(name string) *job {
+	for _, j := range s.jobList {
+		if j.name == name {
+			return j
+		}
+	}
+	return nil
+}
+
+// runFunc returns the run function of j, limited to j's timeout. Runs are
+// canceled when the server shuts down, and none start after that.
+//
+// A run holds the lease of the job, so that the job never runs twice at
+// once, whether it is started by a schedule or a request, and on whichever
+// replica. A run that would overlap another returns a *LeaseHeldError.
+func (s *Server) runFunc(j *job) func(context.Context) error {
+	return func(ctx context.Context) error {
+		ctx, done, err := s.inFlight.start(ctx)
+		if err != nil {
+			return err
+		}
+		defer done()
+		ctx, cancel := context.WithTimeout(ctx, j.timeout)
+		defer cancel()
+		return withLease(ctx, s.cfg.Store, "lease-job-"+j.name, j.run)
+	}
+}
+
+// errShuttingDown is returned for a job that would start after the server
+// started to shut down.
+var errShuttingDown = errors.New("server is shutting down")
+
+// inFlight tracks the runs of jobs in progress, so that the server can cancel
+// them and wait for them when it shuts down. The zero value is ready to use.
+type inFlight struct {
+	mu       sync.Mutex
+	stopping bool
+	nextID   int
+	cancels  map[int]context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// start registers a run, returning a context for it that is canceled by
+// stop, and a function to call when the run ends.
+func (f *inFlight) start(ctx context.Context) (_ context.Context, done func(), err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.stopping {
+		return nil, nil, errShuttingDown
+	}
+	ctx, cancel := context.WithCancel(context.WithValue(ctx, inFlightKey{}, f))
+	if f.cancels == nil {
+		f.cancels = map[int]context.CancelFunc{}
+	}
+	id := f.nextID
+	f.nextID++
+	f.cancels[id] = cancel
+	f.wg.Add(1)
+	return ctx, func() {
+		f.mu.Lock()
+		delete(f.cancels, id)
+		f.mu.Unlock()
+		cancel()
+		f.wg.Done()
+	}, nil
+}
+
+// stop cancels the runs in progress, and keeps new ones from starting.
+func (f *inFlight) stop() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stopping = true
+	for _, cancel := range f.cancels {
+		cancel()
+	}
+}
+
+type inFlightKey struct{}
+
+// shuttingDown reports whether ctx is that of a run that the server has
+// canceled, or will cancel, because it is shutting down. Other runs can end
+// with a canceled context too, as when they time out.
+func shuttingDown(ctx context.Context) bool {
+	f, ok := ctx.Value(inFlightKey{}).(*inFlight)
+	if !ok {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.stopping
+}
+
+// wait waits for the runs in progress to end, or for ctx to be done.
+func (f *inFlight) wait(ctx context.Context) error {
+	ended := make(chan struct{})
+	go func() {
+		f.wg.Wait()
+		close(ended)
+	}()
+	select {
+	case <-ended:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Shutdown stops the scheduler and cancels the jobs in progress, then waits
+// for them to end, or for ctx to be done. A canceled job stops at its next
+// checkpoint: an update records where it stopped, and the next update of
+// the same commit resumes there. Jobs requested after Shutdown is called
+// fail with http.StatusServiceUnavailable, so that they are retried
+// elsewhere.
+func (s *Server) Shutdown(ctx context.Context) (err error) {
+	defer derrors.Wrap(&err, "Server.Shutdown")
+
+	if s.stopScheduler != nil {
+		s.stopScheduler()
+	}
+	s.inFlight.stop()
+	return s.inFlight.wait(ctx)
+}
+
+// detach returns a context with the values of ctx, such as its event
+// exporter, that is never canceled. It is for the writes that record that
+// work was canceled.
+func detach(ctx context.Context) context.Context {
+	return detachedContext{ctx}
+}
+
+type detachedContext struct{ parent context.Context }
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// errJobRunning is returned by runJob when the job is running for the same
+// idempotency key on another replica, or in another request.
+var errJobRunning = errors.New("job is running for the same idempotency key")
+
+// runJob runs j, unless it has already succeeded for the idempotency key, and
+// reports whether it ran the job. With an empty key, it always runs the job.
+func (s *Server) runJob(ctx context.Context, j *job, key string) (ran bool, err error) {
+	defer derrors.Wrap(&err, "runJob(%s, %q)", j.name, key)
+
+	if key == "" {
+		return true, s.runFunc(j)(ctx)
+	}
+	// Firestore document IDs cannot contain slashes.
+	name := "job-" + j.name + "-" + strings.ReplaceAll(key, "/", "|")
+	err = withLease(ctx, s.cfg.Store, name, func(ctx context.Context) error {
+		// Like the time of the last run of a scheduled task, the time that
+		// a job succeeded for a key is kept as a fetch cursor.
+		done, err := s.cfg.Store.GetFetchCursor(ctx, name)
+		if err != nil {
+			return err
+		}
+		if !done.IsZero() {
+			log.Infof(ctx, "job %s: already succeeded for key %q at %s", j.name, key, done.Format(time.RFC3339))
+			return nil
+		}
+		ran = true
+		if err := s.runFunc(j)(ctx); err != nil {
+			return err
+		}
+		return s.cfg.Store.SetFetchCursor(ctx, name, time.Now())
+	})
+	if lerr := new(LeaseHeldError); errors.As(err, &lerr) && lerr.Name == name {
+		return false, errJobRunning
+	}
+	return ran, err
+}
+
+// idempotencyKeyHeader is the request header for the idempotency key of a
+// job. Cloud Scheduler can set it.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// handleJob runs the job named by the last element of the path, with the
+// idempotency key of the request header, if any.
+func (s *Server) handleJob(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/jobs/")
+	j := s.findJob(name)
+	if j == nil {
+		return &serverError{
+			status: http.StatusNotFound,
+			err:    fmt.Errorf("no job %q", name),
+		}
+	}
+	return s.serveJob(w, r, j, r.Header.Get(idempotencyKeyHeader))
+}
+
+// serveJob runs j for a request, with the given idempotency key.
+func (s *Server) serveJob(w http.ResponseWriter, r *http.Request, j *job, key string) error {
+	if j.check != nil {
+		if err := j.check(); err != nil {
+			return &serverError{
+				status: http.StatusPreconditionFailed,
+				err:    fmt.Errorf("job %s: %v", j.name, err),
+			}
+		}
+	}
+	ran, err := s.runJob(r.Context(), j, key)
+	if lerr := new(LeaseHeldError); errors.Is(err, errJobRunning) || errors.As(err, &lerr) {
+		// A push subscription retries the message later.
+		return &serverError{status: http.StatusConflict, err: err}
+	}
+	if errors.Is(err, errShuttingDown) {
+		return &serverError{status: http.StatusServiceUnavailable, err: err}
+	}
+	if err != nil {
+		return err
+	}
+	if !ran {
+		fmt.Fprintf(w, "job %s already succeeded for key %q\n", j.name, key)
+		return nil
+	}
+	fmt.Fprintf(w, "job %s succeeded\n", j.name)
+	return nil
+}
+
+// pubsubPush is the body of a request from a Pub/Sub push subscription.
+// See https://cloud.google.com/pubsub/docs/push.
+type pubsubPush struct {
+	Message struct {
+		Attributes map[string]string `json:"attributes"`
+		// Data is base64-encoded in the request, and decoded by
+		// encoding/json.
+		Data      []byte `json:"data"`
+		MessageID string `json:"messageId"`
+	} `json:"message"`
+	Subscription string `json:"subscription"`
+}
+
+// handlePubSub runs the job named by a Pub/Sub message pushed to the server,
+// using the ID of the message as the idempotency key. The job is named by
+// the message's "job" attribute, or else by its data.
+//
+// A message that names no job is acknowledged, so that Pub/Sub doesn't
+// deliver it again; a job that fails is not, so that Pub/Sub retries it.
+func (s *Server) handlePubSub(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	var p pubsubPush
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		return &serverError{
+			status: http.StatusBadRequest,
+			err:    fmt.Errorf("decoding Pub/Sub push: %v", err),
+		}
+	}
+	name := p.Message.Attributes["job"]
+	if name == "" {
+		name = strings.TrimSpace(string(p.Message.Data))
+	}
+	j := s.findJob(name)
+	if j == nil {
+		log.Errorf(r.Context(), "Pub/Sub message %s from %s: no job %q; dropping it", p.Message.MessageID, p.Subscription, name)
+		fmt.Fprintf(w, "no job %q\n", name)
+		return nil
+	}
+	return s.serveJob(w, r, j, p.Message.MessageID)
+}
+
+// replicaOwner returns the owner of the locks that this replica of the server
+// takes, which must be unique among the replicas.
+func replicaOwner() (string, error) {
+	host, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid()), nil
+}
@@ -0,0 +1,222 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestJobs(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	runs := 0
+	var runErr error
+	s := &Server{cfg: Config{Store: mstore}, owner: "a"}
+	s.jobList = []*job{
+		{
+			name:    "update",
+			timeout: time.Minute,
+			run: func(ctx context.Context) error {
+				if _, ok := ctx.Deadline(); !ok {
+					t.Error("job run without a deadline")
+				}
+				runs++
+				return runErr
+			},
+		},
+		{
+			name:    "issues",
+			timeout: time.Minute,
+			check:   func() error { return errors.New("no issue repo") },
+			run:     func(context.Context) error { return nil },
+		},
+	}
+
+	serve := func(handler func(http.ResponseWriter, *http.Request) error, req *http.Request) (int, string) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		err := handler(w, req)
+		var serr *serverError
+		if errors.As(err, &serr) {
+			return serr.status, serr.err.Error()
+		}
+		if err != nil {
+			return http.StatusInternalServerError, err.Error()
+		}
+		return http.StatusOK, strings.TrimSpace(w.Body.String())
+	}
+	postJob := func(name, key string) (int, string) {
+		req := httptest.NewRequest(http.MethodPost, "/jobs/"+name, nil)
+		if key != "" {
+			req.Header.Set(idempotencyKeyHeader, key)
+		}
+		return serve(s.handleJob, req)
+	}
+	pushMessage := func(body string) (int, string) {
+		return serve(s.handlePubSub, httptest.NewRequest(http.MethodPost, "/pubsub", strings.NewReader(body)))
+	}
+
+	for _, test := range []struct {
+		name       string
+		do         func() (int, string)
+		wantStatus int
+		wantRuns   int
+	}{
+		{
+			name:       "no key",
+			do:         func() (int, string) { return postJob("update", "") },
+			wantStatus: http.StatusOK,
+			wantRuns:   1,
+		},
+		{
+			name:       "no key again",
+			do:         func() (int, string) { return postJob("update", "") },
+			wantStatus: http.StatusOK,
+			wantRuns:   2,
+		},
+		{
+			name:       "key",
+			do:         func() (int, string) { return postJob("update", "k1") },
+			wantStatus: http.StatusOK,
+			wantRuns:   3,
+		},
+		{
+			name:       "same key",
+			do:         func() (int, string) { return postJob("update", "k1") },
+			wantStatus: http.StatusOK,
+			wantRuns:   3,
+		},
+		{
+			name:       "unknown job",
+			do:         func() (int, string) { return postJob("nope", "") },
+			wantStatus: http.StatusNotFound,
+			wantRuns:   3,
+		},
+		{
+			name:       "unconfigured job",
+			do:         func() (int, string) { return postJob("issues", "") },
+			wantStatus: http.StatusPreconditionFailed,
+			wantRuns:   3,
+		},
+		{
+			// Pub/Sub sends data in base64: this is "update".
+			name:       "message data",
+			do:         func() (int, string) { return pushMessage(`{"message": {"data": "dXBkYXRl", "messageId": "m1"}}`) },
+			wantStatus: http.StatusOK,
+			wantRuns:   4,
+		},
+		{
+			name: "message attribute, delivered again",
+			do: func() (int, string) {
+				return pushMessage(`{"message": {"attributes": {"job": "update"}, "messageId": "m1"}}`)
+			},
+			wantStatus: http.StatusOK,
+			wantRuns:   4,
+		},
+		{
+			// Acknowledged, so that it isn't delivered again.
+			name:       "message without job",
+			do:         func() (int, string) { return pushMessage(`{"message": {"messageId": "m2"}}`) },
+			wantStatus: http.StatusOK,
+			wantRuns:   4,
+		},
+		{
+			name:       "bad message",
+			do:         func() (int, string) { return pushMessage(`{`) },
+			wantStatus: http.StatusBadRequest,
+			wantRuns:   4,
+		},
+	} {
+		status, body := test.do()
+		if status != test.wantStatus || runs != test.wantRuns {
+			t.Errorf("%s: got status %d (%s), %d runs; want %d, %d runs", test.name, status, body, runs, test.wantStatus, test.wantRuns)
+		}
+	}
+
+	// A job that is running elsewhere for the same key is a conflict.
+	if _, err := mstore.AcquireLock(ctx, "job-update-k2", "b", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if status, _ := postJob("update", "k2"); status != http.StatusConflict || runs != 4 {
+		t.Errorf("running elsewhere: got status %d, %d runs; want %d, 4 runs", status, runs, http.StatusConflict)
+	}
+	if err := mstore.ReleaseLock(ctx, "job-update-k2", "b"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A job that is running elsewhere, however it was started, is a
+	// conflict, with any key or none.
+	if _, err := mstore.AcquireLock(ctx, "lease-job-update", "b", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"", "k3"} {
+		if status, _ := postJob("update", key); status != http.StatusConflict || runs != 4 {
+			t.Errorf("job running elsewhere, key %q: got status %d, %d runs; want %d, 4 runs", key, status, runs, http.StatusConflict)
+		}
+	}
+	if status, _ := pushMessage(`{"message": {"data": "dXBkYXRl", "messageId": "m3"}}`); status != http.StatusConflict || runs != 4 {
+		t.Errorf("job running elsewhere, message: got status %d, %d runs; want %d, 4 runs", status, runs, http.StatusConflict)
+	}
+	if err := mstore.ReleaseLock(ctx, "lease-job-update", "b"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A job that fails runs again for the same key.
+	runErr = errors.New("fail")
+	if status, _ := postJob("update", "k2"); status != http.StatusInternalServerError || runs != 5 {
+		t.Errorf("failure: got status %d, %d runs; want %d, 5 runs", status, runs, http.StatusInternalServerError)
+	}
+	runErr = nil
+	if status, _ := postJob("update", "k2"); status != http.StatusOK || runs != 6 {
+		t.Errorf("retry: got status %d, %d runs; want %d, 6 runs", status, runs, http.StatusOK)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	started := make(chan struct{})
+	s := &Server{cfg: Config{Store: store.NewMemStore()}, owner: "a"}
+	s.jobList = []*job{{
+		name:    "update",
+		timeout: time.Minute,
+		run: func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}}
+	errc := make(chan error, 1)
+	go func() {
+		_, err := s.runJob(context.Background(), s.jobList[0], "k")
+		errc <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+	// The canceled job released its lock, and no job starts after shutdown.
+	req := httptest.NewRequest(http.MethodPost, "/jobs/update", nil)
+	req.Header.Set(idempotencyKeyHeader, "k")
+	var serr *serverError
+	if err := s.handleJob(httptest.NewRecorder(), req); !errors.As(err, &serr) || serr.status != http.StatusServiceUnavailable {
+		t.Errorf("after shutdown: got %v, want status %d", err, http.StatusServiceUnavailable)
+	}
+}
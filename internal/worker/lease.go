@@ -0,0 +1,141 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// Updates from the cvelist repo and issue creation must not run twice at
+// once, even in different replicas of the server or in the command-line
+// tool: two updates would write the same update record, and two runs of issue
+// creation could file an issue for the same record twice. Each holds a lease
+// while it runs: a lock in the store that it renews until it is done.
+
+// The names of the leases.
+const (
+	updateLease = "lease-update"
+	issuesLease = "lease-issues"
+)
+
+// leaseTTL is how long a lease lasts without being renewed. A holder renews
+// it three times as often, so that one failed renewal doesn't lose it. If a
+// holder crashes, another can take the lease after leaseTTL.
+var leaseTTL = 2 * time.Minute
+
+// A LeaseHeldError is returned for work that could not start because its
+// lease is held elsewhere.
+type LeaseHeldError struct {
+	Name string
+}
+
+func (e *LeaseHeldError) Error() string {
+	return fmt.Sprintf("lease %s is held by another run", e.Name)
+}
+
+// errLeaseLost is returned for work that was canceled because its lease
+// could not be renewed before it expired.
+var errLeaseLost = errors.New("lease lost")
+
+// withLease runs f while holding the lease with the given name, and returns
+// its error. If the lease is held elsewhere, it returns a *LeaseHeldError
+// without running f. If the lease is lost, the context of f is canceled.
+func withLease(ctx context.Context, st store.Store, name string, f func(context.Context) error) (err error) {
+	defer derrors.Wrap(&err, "withLease(%s)", name)
+
+	owner, err := leaseOwner()
+	if err != nil {
+		return err
+	}
+	return holdLease(ctx, st, name, owner, f)
+}
+
+// holdLease is like withLease, but the lease is held by owner.
+func holdLease(ctx context.Context, st store.Store, name, owner string, f func(context.Context) error) (err error) {
+	acquired, err := st.AcquireLock(ctx, name, owner, leaseTTL)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return &LeaseHeldError{Name: name}
+	}
+	log.Debugf(ctx, "acquired lease %s as %s", name, owner)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var lost int32
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		ticker := time.NewTicker(leaseTTL / 3)
+		defer ticker.Stop()
+		// The lease lasts until leaseTTL after it was last acquired or
+		// renewed.
+		last := time.Now()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			now := time.Now()
+			ok, err := st.RenewLock(ctx, name, owner, leaseTTL)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if time.Since(last) < leaseTTL {
+					// Try again at the next tick, while the lease lasts.
+					log.Warningf(ctx, "renewing lease %s: %v", name, err)
+					continue
+				}
+				log.Errorf(ctx, "renewing lease %s: %v", name, err)
+				ok = false
+			}
+			if !ok {
+				log.Errorf(ctx, "lost lease %s; canceling", name)
+				atomic.StoreInt32(&lost, 1)
+				cancel()
+				return
+			}
+			last = now
+		}
+	}()
+
+	err = f(ctx)
+	cancel()
+	<-renewed
+	if atomic.LoadInt32(&lost) != 0 {
+		return fmt.Errorf("%w: %v", errLeaseLost, err)
+	}
+	// Release the lease even if f was canceled.
+	if rerr := st.ReleaseLock(detach(ctx), name, owner); rerr != nil && err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// leaseOwner returns a new owner for a lease, unique to one run in one
+// replica, so that two runs in the same process exclude each other too.
+func leaseOwner() (string, error) {
+	owner, err := replicaOwner()
+	if err != nil {
+		return "", err
+	}
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%x", owner, b), nil
+}
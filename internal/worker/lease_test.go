@@ -0,0 +1,103 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestWithLease(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+
+	// A second run does not start while the first holds the lease.
+	err := withLease(ctx, mstore, "l", func(ctx context.Context) error {
+		err := withLease(ctx, mstore, "l", func(context.Context) error {
+			t.Error("second run ran")
+			return nil
+		})
+		if lerr := new(LeaseHeldError); !errors.As(err, &lerr) {
+			t.Errorf("second run: got %v, want LeaseHeldError", err)
+		}
+		// Another lease is independent.
+		return withLease(ctx, mstore, "other", func(context.Context) error { return nil })
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The lease was released.
+	ran := false
+	if err := withLease(ctx, mstore, "l", func(context.Context) error { ran = true; return nil }); err != nil || !ran {
+		t.Fatalf("after release: got %v, ran %t; want nil, ran", err, ran)
+	}
+}
+
+// lostLeaseStore is a store whose locks can't be renewed.
+type lostLeaseStore struct {
+	store.Store
+}
+
+func (lostLeaseStore) RenewLock(context.Context, string, string, time.Duration) (bool, error) {
+	return false, nil
+}
+
+func TestWithLeaseLost(t *testing.T) {
+	defer func(ttl time.Duration) { leaseTTL = ttl }(leaseTTL)
+	leaseTTL = 30 * time.Millisecond
+
+	err := withLease(context.Background(), lostLeaseStore{store.NewMemStore()}, "l", func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Second):
+			t.Error("run not canceled")
+			return nil
+		}
+	})
+	if !errors.Is(err, errLeaseLost) {
+		t.Errorf("got %v, want errLeaseLost", err)
+	}
+}
+
+// unreachableLeaseStore is a store whose locks can't be renewed because
+// renewing them fails.
+type unreachableLeaseStore struct {
+	store.Store
+}
+
+func (unreachableLeaseStore) RenewLock(context.Context, string, string, time.Duration) (bool, error) {
+	return false, errors.New("store unavailable")
+}
+
+func TestWithLeaseRenewalsFail(t *testing.T) {
+	defer func(ttl time.Duration) { leaseTTL = ttl }(leaseTTL)
+	leaseTTL = 30 * time.Millisecond
+
+	start := time.Now()
+	err := withLease(context.Background(), unreachableLeaseStore{store.NewMemStore()}, "l", func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Second):
+			t.Error("run not canceled")
+			return nil
+		}
+	})
+	if !errors.Is(err, errLeaseLost) {
+		t.Errorf("got %v, want errLeaseLost", err)
+	}
+	// A failed renewal doesn't cancel the run while the lease lasts.
+	if d := time.Since(start); d < leaseTTL {
+		t.Errorf("canceled after %s, before the lease expired after %s", d, leaseTTL)
+	}
+}
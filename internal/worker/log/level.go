@@ -0,0 +1,45 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/exp/event/severity"
+)
+
+// minLevel is the lowest level that is logged, as an int32 so that it can be
+// changed while the server runs.
+var minLevel = int32(severity.Debug)
+
+// SetLevel sets the lowest level of the messages that are logged. Messages
+// at lower levels are dropped before they reach any handler.
+func SetLevel(l severity.Level) {
+	atomic.StoreInt32(&minLevel, int32(l))
+}
+
+// Level returns the lowest level of the messages that are logged.
+func Level() severity.Level {
+	return severity.Level(atomic.LoadInt32(&minLevel))
+}
+
+// ParseLevel parses the name of a level: "debug", "info", "warning" or
+// "error", in any case.
+func ParseLevel(s string) (severity.Level, error) {
+	switch strings.ToLower(s) {
+	case "debug":
+		return severity.Debug, nil
+	case "info":
+		return severity.Info, nil
+	case "warning":
+		return severity.Warning, nil
+	case "error":
+		return severity.Error, nil
+	default:
+		return 0, fmt.Errorf("unknown log level %q", s)
+	}
+}
@@ -99,8 +99,45 @@ func pairToLabel(name string, value interface{}) event.Label {
 	}
 }
 
+type labelsKey struct{}
+
+// NewContext returns a context whose log messages have the labels of ctx
+// and the given key-value pairs, like the ID of an update run or a CVE, so
+// that they can be found together. A later value for a key replaces an
+// earlier one.
+func NewContext(ctx context.Context, kvs ...interface{}) context.Context {
+	var ls Labels
+	for _, l := range fromContext(ctx) {
+		if !hasKey(kvs, l.Name) {
+			ls = append(ls, l)
+		}
+	}
+	return context.WithValue(ctx, labelsKey{}, ls.With(kvs...))
+}
+
+func fromContext(ctx context.Context) Labels {
+	ls, _ := ctx.Value(labelsKey{}).(Labels)
+	return ls
+}
+
+func hasKey(kvs []interface{}, name string) bool {
+	for i := 0; i < len(kvs); i += 2 {
+		if kvs[i] == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (l Labels) logf(ctx context.Context, s severity.Level, format string, args ...interface{}) {
-	event.Log(ctx, fmt.Sprintf(format, args...), append(l, s.Label())...)
+	if s < Level() {
+		return
+	}
+	cls := fromContext(ctx)
+	// Copy, so that appending changes neither the labels of ctx nor l.
+	ls := make([]event.Label, 0, len(cls)+len(l)+1)
+	ls = append(append(append(ls, cls...), l...), s.Label())
+	event.Log(ctx, fmt.Sprintf(format, args...), ls...)
 }
 
 func (l Labels) Debugf(ctx context.Context, format string, args ...interface{}) {
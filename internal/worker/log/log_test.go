@@ -0,0 +1,62 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/exp/event/severity"
+)
+
+func TestContextLabels(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := event.WithExporter(context.Background(), event.NewExporter(NewLineHandler(&buf), nil))
+	ctx = NewContext(ctx, "run", "r1", "cve", "CVE-2022-0001")
+	ctx2 := NewContext(ctx, "cve", "CVE-2022-0002")
+	With("n", 3).Infof(ctx2, "hello")
+	Infof(ctx, "bye")
+
+	got := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := []string{
+		"INFO hello run=r1 cve=CVE-2022-0002 n=3",
+		"INFO bye run=r1 cve=CVE-2022-0001",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	for i := range want {
+		// Skip the time.
+		if _, line, _ := strings.Cut(got[i], " INFO"); "INFO"+line != want[i] {
+			t.Errorf("got %q, want %q", got[i], want[i])
+		}
+	}
+}
+
+func TestLevel(t *testing.T) {
+	defer SetLevel(Level())
+	var buf bytes.Buffer
+	ctx := event.WithExporter(context.Background(), event.NewExporter(NewLineHandler(&buf), nil))
+
+	l, err := ParseLevel("Warning")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l != severity.Warning {
+		t.Fatalf("got %s, want warning", l)
+	}
+	SetLevel(l)
+	Infof(ctx, "dropped")
+	Errorf(ctx, "kept")
+	if got := buf.String(); strings.Contains(got, "dropped") || !strings.Contains(got, "kept") {
+		t.Errorf("got %q, want only the error", got)
+	}
+	if _, err := ParseLevel("loud"); err == nil {
+		t.Error("got nil, want error")
+	}
+}
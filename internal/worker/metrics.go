@@ -0,0 +1,40 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/exp/event"
+)
+
+// Metrics of updates, which are served at /metrics along with the store's.
+// The source label of each is the name of what the update reads from, like
+// "cvelist" or "ghsa".
+var (
+	cvesProcessedCounter = event.NewCounter("cves_processed", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "CVEs read and triaged by updates.",
+	})
+	updateDuration = event.NewDuration("update_duration", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "How long updates take.",
+	})
+	lastUpdateGauge = event.NewFloatGauge("last_successful_update_timestamp_seconds", &event.MetricOptions{
+		Namespace:   metricNamespace,
+		Description: "The Unix time at which the last successful update ended.",
+	})
+)
+
+// recordUpdate records the metrics of an update from source that started at
+// start and ended with err.
+func recordUpdate(ctx context.Context, source string, start time.Time, err error) {
+	src := event.String("source", source)
+	updateDuration.Record(ctx, time.Since(start), src, event.Bool("success", err == nil))
+	if err == nil {
+		lastUpdateGauge.Record(ctx, float64(time.Now().Unix()), src)
+	}
+}
@@ -14,11 +14,14 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/mod/module"
 	"golang.org/x/mod/semver"
 	"golang.org/x/net/context/ctxhttp"
+	"golang.org/x/time/rate"
+	"golang.org/x/vulndb/internal/derrors"
 )
 
 // Convenience functions for accessing the Go module proxy.
@@ -72,22 +75,108 @@ func moduleZip(ctx context.Context, proxyURL, modulePath, version string) (*zip.
 }
 
 func proxyRequest(ctx context.Context, proxyURL, modulePath, suffix string) ([]byte, error) {
+	body, status, url, err := proxyGet(ctx, proxyURL, modulePath, suffix)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", url, status)
+	}
+	return body, nil
+}
+
+// proxyGet requests suffix for modulePath from the proxy, and returns the
+// response body and status, and the URL requested.
+func proxyGet(ctx context.Context, proxyURL, modulePath, suffix string) (body []byte, status int, url string, err error) {
 	ep, err := module.EscapePath(modulePath)
 	if err != nil {
-		return nil, fmt.Errorf("module path %v: %w", modulePath, err)
+		return nil, 0, "", fmt.Errorf("module path %v: %w", modulePath, err)
 	}
-	url := fmt.Sprintf("%s/%s%s", proxyURL, ep, suffix)
+	url = fmt.Sprintf("%s/%s%s", proxyURL, ep, suffix)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, url, err
 	}
 	res, err := ctxhttp.Do(ctx, http.DefaultClient, req)
 	if err != nil {
-		return nil, err
+		return nil, 0, url, err
 	}
 	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s returned status %d", url, res.StatusCode)
+	body, err = io.ReadAll(res.Body)
+	if err != nil {
+		return nil, 0, url, err
+	}
+	return body, res.StatusCode, url, nil
+}
+
+// A proxyClient checks whether modules exist in a module proxy. It caches
+// its answers and limits the rate of its requests.
+type proxyClient struct {
+	url     string
+	limiter *rate.Limiter
+
+	mu    sync.Mutex
+	cache map[string]*proxyCheck
+}
+
+// Limit proxy requests to this many per second.
+const proxyQPS = 10
+
+func newProxyClient(url string) *proxyClient {
+	return &proxyClient{
+		url:     url,
+		limiter: rate.NewLimiter(rate.Every(time.Second/proxyQPS), 5),
+		cache:   map[string]*proxyCheck{},
+	}
+}
+
+// A proxyCheck is the result of looking for a module in the proxy.
+type proxyCheck struct {
+	exists bool
+	// evidence describes the responses of the proxy.
+	evidence string
+}
+
+// check looks for modulePath in the proxy. The module exists if its
+// @v/list endpoint lists a version, or its @latest endpoint returns one.
+// It does not exist if the proxy returns "not found" or "gone" for both.
+// Other responses are errors.
+func (c *proxyClient) check(ctx context.Context, modulePath string) (_ *proxyCheck, err error) {
+	defer derrors.Wrap(&err, "proxyClient.check(%q)", modulePath)
+
+	c.mu.Lock()
+	pc, ok := c.cache[modulePath]
+	c.mu.Unlock()
+	if ok {
+		return pc, nil
+	}
+	var evidence []string
+	pc = &proxyCheck{}
+	for _, suffix := range []string{"/@v/list", "/@latest"} {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		body, status, url, err := proxyGet(ctx, c.url, modulePath, suffix)
+		if err != nil {
+			return nil, err
+		}
+		switch status {
+		case http.StatusOK:
+			if suffix == "/@latest" || len(bytes.TrimSpace(body)) > 0 {
+				pc.exists = true
+			}
+		case http.StatusNotFound, http.StatusGone:
+		default:
+			return nil, fmt.Errorf("%s returned status %d", url, status)
+		}
+		evidence = append(evidence, fmt.Sprintf("%s %d", suffix, status))
+		if pc.exists {
+			break
+		}
 	}
-	return io.ReadAll(res.Body)
+	pc.evidence = fmt.Sprintf("%s: %s", modulePath, strings.Join(evidence, ", "))
+	c.mu.Lock()
+	c.cache[modulePath] = pc
+	c.mu.Unlock()
+	return pc, nil
 }
@@ -65,6 +65,34 @@ func TestModuleZip(t *testing.T) {
 	}
 }
 
+func TestProxyClientCheck(t *testing.T) {
+	pt := newProxyTest()
+	defer pt.Close()
+
+	ctx := context.Background()
+	c := newProxyClient(pt.URL)
+	for _, test := range []struct {
+		modulePath   string
+		wantExists   bool
+		wantEvidence string
+	}{
+		{"golang.org/x/tools", true, "golang.org/x/tools: /@v/list 200"},
+		{"golang.org/x/build", true, "golang.org/x/build: /@v/list 200, /@latest 200"},
+		{"example.com/nope", false, "example.com/nope: /@v/list 404, /@latest 404"},
+	} {
+		got, err := c.check(ctx, test.modulePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.exists != test.wantExists || got.evidence != test.wantEvidence {
+			t.Errorf("%s: got (%t, %q), want (%t, %q)", test.modulePath, got.exists, got.evidence, test.wantExists, test.wantEvidence)
+		}
+	}
+	if _, err := c.check(ctx, "example.com/broken"); err == nil {
+		t.Error("example.com/broken: got nil error")
+	}
+}
+
 type proxyTest struct {
 	*httptest.Server
 }
@@ -88,6 +116,8 @@ func (*proxyTest) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		fw, _ := zw.Create("golang.org/x/mod@v0.5.1/go.mod")
 		fw.Write([]byte(`module golang.org/x/mod`))
 		zw.Close()
+	case "/example.com/broken/@v/list":
+		w.WriteHeader(500)
 	default:
 		w.WriteHeader(404)
 	}
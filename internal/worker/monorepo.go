@@ -0,0 +1,140 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/refurl"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// githubFixRegex matches the path of the canonical URL of a GitHub commit
+// or pull request, after the repo.
+var githubFixRegex = regexp.MustCompile(`^/(commit/[0-9a-f]{7,40}|pull/\d+)$`)
+
+// fixURLs returns the URLs of the GitHub commits and pull requests in repo,
+// like "github.com/owner/repo", among the references of cve, in their
+// canonical form, without duplicates.
+func fixURLs(refs []cveschema.Reference, repo string) []string {
+	prefix := "https://" + strings.ToLower(repo)
+	seen := map[string]bool{}
+	var us []string
+	for _, r := range refs {
+		u := refurl.Canonical(r.URL)
+		rest := strings.TrimPrefix(u, prefix)
+		if rest == u || !githubFixRegex.MatchString(rest) || seen[u] {
+			continue
+		}
+		seen[u] = true
+		us = append(us, u)
+	}
+	return us
+}
+
+// candidateModules returns the modules of rm, the modules of a repo, that
+// the fixes among refs change, with the share of the changed files in each,
+// from most to least likely. It returns nil if refs have no fixes in the
+// repo, or they change no file in a module.
+//
+// A fix that cannot be read, as when the GitHub API rate limit is reached,
+// is skipped.
+func candidateModules(ctx context.Context, rm *store.RepoModules, refs []cveschema.Reference) []*store.CandidateModule {
+	var files []string
+	for _, u := range fixURLs(refs, rm.Repo) {
+		fs, err := githubChangedFiles(ctx, u)
+		if err != nil {
+			log.Warningf(ctx, "%v", err)
+			continue
+		}
+		files = append(files, fs...)
+	}
+	// Changes to Go files say the most about which module is affected.
+	var goFiles []string
+	for _, f := range files {
+		if strings.HasSuffix(f, ".go") {
+			goFiles = append(goFiles, f)
+		}
+	}
+	if len(goFiles) > 0 {
+		files = goFiles
+	}
+	counts := map[string]int{}
+	for _, f := range files {
+		if m := rm.ModuleAt(f); m != nil {
+			counts[m.Path]++
+		}
+	}
+	var cs []*store.CandidateModule
+	for p, n := range counts {
+		cs = append(cs, &store.CandidateModule{Path: p, Confidence: float64(n) / float64(len(files))})
+	}
+	sort.Slice(cs, func(i, j int) bool {
+		if cs[i].Confidence != cs[j].Confidence {
+			return cs[i].Confidence > cs[j].Confidence
+		}
+		return cs[i].Path < cs[j].Path
+	})
+	return cs
+}
+
+// githubChangedFiles returns the paths of the files changed by the GitHub
+// commit or pull request with the canonical URL u.
+func githubChangedFiles(ctx context.Context, u string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "githubChangedFiles(%q)", u)
+
+	p, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	// The path is /OWNER/REPO/commit/SHA or /OWNER/REPO/pull/N.
+	parts := strings.Split(strings.TrimPrefix(p.Path, "/"), "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("not a commit or pull request")
+	}
+	repo, kind, id := parts[0]+"/"+parts[1], parts[2], parts[3]
+	var api string
+	if kind == "commit" {
+		api = githubAPIURL + "/repos/" + repo + "/commits/" + id
+	} else {
+		api = githubAPIURL + "/repos/" + repo + "/pulls/" + id + "/files?per_page=100"
+	}
+	status, body, err := githubGet(ctx, api, 16<<20)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", api, status)
+	}
+	type file struct {
+		Filename string
+	}
+	var files []file
+	if kind == "commit" {
+		var c struct{ Files []file }
+		err = json.Unmarshal(body, &c)
+		files = c.Files
+	} else {
+		err = json.Unmarshal(body, &files)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var fs []string
+	for _, f := range files {
+		fs = append(fs, f.Filename)
+	}
+	return fs, nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A NotificationKind says what a Notification is about.
+type NotificationKind string
+
+const (
+	// NotifyNeedsIssue is sent after an update moves CVEs or GHSAs to
+	// TriageStateNeedsIssue.
+	NotifyNeedsIssue NotificationKind = "needs_issue"
+	// NotifyUpdateFailed is sent when an update fails.
+	NotifyUpdateFailed NotificationKind = "update_failed"
+	// NotifyIssuesFailed is sent when creating issues fails.
+	NotifyIssuesFailed NotificationKind = "issue_creation_failed"
+	// NotifyOrphans is sent when a reconciliation finds records whose
+	// issues are closed, but which no report covers.
+	NotifyOrphans NotificationKind = "orphans"
+	// NotifyReportGaps is sent when a search for report gaps finds reports
+	// that may be missing aliases or references.
+	NotifyReportGaps NotificationKind = "report_gaps"
+	// NotifyTriageDigest is sent when the worker checks how long records
+	// have been waiting in the triage queues, for a digest.
+	NotifyTriageDigest NotificationKind = "triage_digest"
+	// NotifyWithdrawalReview is sent when a check finds reports or issues
+	// whose CVEs were rejected or disputed upstream.
+	NotifyWithdrawalReview NotificationKind = "withdrawal_review"
+)
+
+// A Notification tells triagers about something that happened in the worker.
+type Notification struct {
+	Kind NotificationKind `json:"kind"`
+	// Text describes what happened, for people.
+	Text string `json:"text"`
+	// Source and Run are the source and run ID of the update that the
+	// notification is about, if any. See the log labels of an update.
+	Source string `json:"source,omitempty"`
+	Run    string `json:"run,omitempty"`
+	// IDs are the IDs of the CVEs or GHSAs that the notification is about,
+	// if any.
+	IDs []string `json:"ids,omitempty"`
+	// Error is the error that the notification is about, if any.
+	Error string `json:"error,omitempty"`
+	// Namespace is the namespace of the worker that sent the notification,
+	// so that notifications from staging can be told from those of prod.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// A Notifier sends notifications to triagers, such as to a Slack channel.
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// NewSlackNotifier returns a Notifier that posts the text of notifications
+// to the Slack incoming webhook at url.
+func NewSlackNotifier(url string) Notifier {
+	return &webhookNotifier{url: url, slack: true}
+}
+
+// NewWebhookNotifier returns a Notifier that posts notifications as JSON
+// to url.
+func NewWebhookNotifier(url string) Notifier {
+	return &webhookNotifier{url: url}
+}
+
+type webhookNotifier struct {
+	url   string
+	slack bool
+}
+
+// maxListedIDs is the largest number of IDs in the text of a notification.
+const maxListedIDs = 20
+
+// Notify implements Notifier.Notify.
+func (w *webhookNotifier) Notify(ctx context.Context, n *Notification) (err error) {
+	// Don't put the URL in the error, because it is a secret.
+	defer derrors.Wrap(&err, "Notify(%s)", n.Kind)
+
+	var payload interface{} = n
+	if w.slack {
+		payload = struct {
+			Text string `json:"text"`
+		}{slackText(n)}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		// The error of a failed request contains the URL.
+		return fmt.Errorf("request failed: %v", strings.ReplaceAll(err.Error(), w.url, "<url>"))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// slackText returns the text of a notification for Slack: its namespace and
+// text, followed by the first of its IDs and the update run.
+func slackText(n *Notification) string {
+	var b strings.Builder
+	if n.Namespace != "" {
+		fmt.Fprintf(&b, "[%s] ", n.Namespace)
+	}
+	b.WriteString(n.Text)
+	if len(n.IDs) > 0 {
+		ids := n.IDs
+		if len(ids) > maxListedIDs {
+			ids = ids[:maxListedIDs]
+		}
+		fmt.Fprintf(&b, "\n%s", strings.Join(ids, ", "))
+		if len(n.IDs) > len(ids) {
+			fmt.Fprintf(&b, " and %d more", len(n.IDs)-len(ids))
+		}
+	}
+	if n.Run != "" {
+		fmt.Fprintf(&b, "\n(%s update run %s)", n.Source, n.Run)
+	}
+	return b.String()
+}
+
+// multiNotifier sends each notification to several Notifiers.
+type multiNotifier []Notifier
+
+// Notify implements Notifier.Notify. It returns the first error.
+func (m multiNotifier) Notify(ctx context.Context, n *Notification) error {
+	var firstErr error
+	for _, nf := range m {
+		if err := nf.Notify(ctx, n); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// namespaceNotifier sets the namespace of the notifications it sends.
+type namespaceNotifier struct {
+	namespace string
+	nf        Notifier
+}
+
+// Notify implements Notifier.Notify.
+func (n namespaceNotifier) Notify(ctx context.Context, nt *Notification) error {
+	c := *nt
+	c.Namespace = n.namespace
+	return n.nf.Notify(ctx, &c)
+}
+
+// newNotifier returns the Notifier for the webhooks in cfg, or nil if there
+// are none. Its notifications have the namespace of cfg.
+func newNotifier(cfg Config) Notifier {
+	var m multiNotifier
+	if cfg.SlackWebhookURL != "" {
+		m = append(m, NewSlackNotifier(cfg.SlackWebhookURL))
+	}
+	if cfg.WebhookURL != "" {
+		m = append(m, NewWebhookNotifier(cfg.WebhookURL))
+	}
+	var nf Notifier
+	switch len(m) {
+	case 0:
+		return nil
+	case 1:
+		nf = m[0]
+	default:
+		nf = m
+	}
+	if cfg.Namespace != "" {
+		nf = namespaceNotifier{cfg.Namespace, nf}
+	}
+	return nf
+}
+
+type notifierKey struct{}
+
+// WithNotifier returns a context in which updates and issue creation send
+// notifications to n.
+func WithNotifier(ctx context.Context, n Notifier) context.Context {
+	return context.WithValue(ctx, notifierKey{}, n)
+}
+
+// notify sends n to the Notifier of ctx, if any. Failing to send it is
+// logged, rather than failing the work it is about.
+func notify(ctx context.Context, n *Notification) {
+	nf, ok := ctx.Value(notifierKey{}).(Notifier)
+	if !ok || nf == nil {
+		return
+	}
+	if err := nf.Notify(ctx, n); err != nil {
+		log.Errorf(ctx, "sending notification: %v", err)
+	}
+}
+
+// A needsIssueCollector collects the IDs of the records that enter
+// TriageStateNeedsIssue during an update.
+type needsIssueCollector struct {
+	mu  sync.Mutex
+	ids map[string]bool
+}
+
+// listen implements store.TriageListener.
+func (c *needsIssueCollector) listen(_ context.Context, changes []*store.TriageHistoryEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range changes {
+		if e.NewState == store.TriageStateNeedsIssue {
+			c.ids[e.ID] = true
+		}
+	}
+}
+
+// sortedIDs returns the collected IDs, sorted.
+func (c *needsIssueCollector) sortedIDs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var ids []string
+	for id := range c.ids {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
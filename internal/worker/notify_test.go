@@ -0,0 +1,128 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A recordingNotifier remembers the notifications it is sent.
+type recordingNotifier struct {
+	ns []*Notification
+}
+
+func (r *recordingNotifier) Notify(_ context.Context, n *Notification) error {
+	r.ns = append(r.ns, n)
+	return nil
+}
+
+func TestUpdateNotifications(t *testing.T) {
+	rn := &recordingNotifier{}
+	ctx := WithNotifier(context.Background(), rn)
+	mstore := store.NewMemStore()
+
+	ctx, end := startUpdate(ctx, nil, "test")
+	for i, ts := range []store.TriageState{store.TriageStateNeedsIssue, store.TriageStateNoActionNeeded, store.TriageStateNeedsIssue} {
+		id := fmt.Sprintf("CVE-2022-000%d", 3-i)
+		err := mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+			return tx.CreateCVERecord(&store.CVERecord{
+				ID:          id,
+				Path:        id + ".json",
+				BlobHash:    "abc",
+				CommitHash:  "123",
+				CommitTime:  time.Date(2022, time.October, 1, 0, 0, 0, 0, time.UTC),
+				TriageState: ts,
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	end(errors.New("boom"))
+
+	want := []*Notification{
+		{
+			Kind:   NotifyNeedsIssue,
+			Text:   "2 CVEs or GHSAs now need issues.",
+			Source: "test",
+			IDs:    []string{"CVE-2022-0001", "CVE-2022-0003"},
+		},
+		{
+			Kind:   NotifyUpdateFailed,
+			Text:   "The test update failed: boom",
+			Source: "test",
+			Error:  "boom",
+		},
+	}
+	if diff := cmp.Diff(want, rn.ns, cmpopts.IgnoreFields(Notification{}, "Run")); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	for _, n := range rn.ns {
+		if n.Run == "" {
+			t.Errorf("%s: no run ID", n.Kind)
+		}
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var m map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+			t.Error(err)
+		}
+		if m["kind"] != nil {
+			got = append(got, fmt.Sprintf("%s %v", m["kind"], m["ids"]))
+		} else {
+			got = append(got, m["text"].(string))
+		}
+	}))
+	defer srv.Close()
+
+	var ids []string
+	for i := 1; i <= maxListedIDs+2; i++ {
+		ids = append(ids, fmt.Sprintf("CVE-2022-%04d", i))
+	}
+	n := &Notification{Kind: NotifyNeedsIssue, Text: "Some need issues.", Source: "nvd", Run: "r1", IDs: ids}
+	ctx := context.Background()
+	staging := newNotifier(Config{Namespace: "staging", SlackWebhookURL: srv.URL})
+	for _, nf := range []Notifier{NewWebhookNotifier(srv.URL), NewSlackNotifier(srv.URL), staging} {
+		if err := nf.Notify(ctx, n); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{
+		fmt.Sprintf("needs_issue %v", ids),
+		"Some need issues.\n" + strings.Join(ids[:maxListedIDs], ", ") + " and 2 more\n(nvd update run r1)",
+		"[staging] Some need issues.\n" + strings.Join(ids[:maxListedIDs], ", ") + " and 2 more\n(nvd update run r1)",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no such hook", http.StatusNotFound)
+	}))
+	defer bad.Close()
+	if err := NewSlackNotifier(bad.URL).Notify(ctx, n); err == nil || strings.Contains(err.Error(), bad.URL) {
+		t.Errorf("got %v, want error without URL", err)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/nvd"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// nvdCursorSource is the name of the store's fetch cursor for the NVD. The
+// cursor is the end of the last range of modification times that was fully
+// processed.
+const nvdCursorSource = "nvd"
+
+// CVE records that come from the NVD have no cvelist repo commit. Their
+// CommitHash is nvdCommitHash, and their CommitTime is the CVE's
+// last-modified time.
+const nvdCommitHash = "nvd"
+
+// NVDListFunc is the type of a function that lists the CVEs in the NVD that
+// were last modified between start and end, inclusive. The range is never
+// longer than nvd.MaxDateRange.
+type NVDListFunc func(ctx context.Context, start, end time.Time) ([]*nvd.CVE, error)
+
+// UpdateNVDStats describes the result of an update from the NVD.
+type UpdateNVDStats struct {
+	// Number of CVEs seen.
+	NumProcessed int
+	// Number of CVERecords added to the store.
+	NumAdded int
+	// Number of CVERecords already in the store that were modified.
+	NumModified int
+}
+
+// UpdateCVEsFromNVD updates the store with the CVEs in the NVD that were
+// modified since the last such update, triaging new and changed CVEs. It is
+// an alternative to UpdateCVEsAtCommit that doesn't need a copy of the
+// cvelist repo.
+//
+// The time of the last update is kept in the store. If there has been no
+// update, start must be non-zero; CVEs modified before it are ignored.
+func UpdateCVEsFromNVD(ctx context.Context, list NVDListFunc, st store.Store, pkgsiteURL string, start time.Time) (_ UpdateNVDStats, err error) {
+	defer derrors.Wrap(&err, "UpdateCVEsFromNVD")
+
+	log.Infof(ctx, "updating false positives")
+	if err := updateFalsePositives(ctx, st); err != nil {
+		return UpdateNVDStats{}, err
+	}
+	knownVulnIDs, err := getAllCVEsAndGHSAsInVulnDB(ctx)
+	if err != nil {
+		return UpdateNVDStats{}, err
+	}
+	knownIDs := map[string]bool{}
+	for _, id := range knownVulnIDs {
+		knownIDs[id] = true
+	}
+	triage := func(ctx context.Context, cve *cveschema.CVE) (*triageResult, error) {
+		return TriageCVE(ctx, cve, pkgsiteURL)
+	}
+	return updateCVEsFromNVD(ctx, list, st, knownIDs, triage, start, time.Now())
+}
+
+func updateCVEsFromNVD(ctx context.Context, list NVDListFunc, st store.Store, knownIDs map[string]bool, triage triageFunc, start, now time.Time) (stats UpdateNVDStats, err error) {
+	ctx = event.Start(ctx, "updateCVEsFromNVD")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "nvd")
+	defer func() { end(err) }()
+	defer func() { countUpdate(ctx, stats.NumProcessed, stats.NumAdded, stats.NumModified) }()
+
+	cursor, err := st.GetFetchCursor(ctx, nvdCursorSource)
+	if err != nil {
+		return stats, err
+	}
+	if !cursor.IsZero() {
+		start = cursor
+	} else if start.IsZero() {
+		return stats, errors.New("no previous NVD update; need a start time")
+	}
+
+	defer func() {
+		if err != nil {
+			log.Errorf(ctx, "NVD update failed: %v", err)
+		} else {
+			log.Infof(ctx, "NVD update succeeded with start=%s: %+v", start.Format(time.RFC3339), stats)
+		}
+	}()
+	log.Infof(ctx, "NVD update starting with start=%s", start.Format(time.RFC3339))
+
+	// The NVD limits the range of a query, so proceed in steps. Advance the
+	// cursor after each one, so an interrupted update can resume.
+	for s := start; s.Before(now); {
+		e := s.Add(nvd.MaxDateRange)
+		if e.After(now) {
+			e = now
+		}
+		cves, err := list(ctx, s, e)
+		if err != nil {
+			return stats, err
+		}
+		sort.Slice(cves, func(i, j int) bool { return cves[i].ID < cves[j].ID })
+		for i := 0; i < len(cves); i += maxTransactionWrites {
+			j := i + maxTransactionWrites
+			if j > len(cves) {
+				j = len(cves)
+			}
+			numAdded, numModified, err := updateNVDBatch(ctx, cves[i:j], st, knownIDs, triage)
+			if err != nil {
+				return stats, err
+			}
+			stats.NumProcessed += j - i
+			cvesProcessedCounter.Record(ctx, int64(j-i), event.String("source", "nvd"))
+			stats.NumAdded += numAdded
+			stats.NumModified += numModified
+		}
+		if err := st.SetFetchCursor(ctx, nvdCursorSource, e); err != nil {
+			return stats, err
+		}
+		s = e
+	}
+	return stats, nil
+}
+
+func updateNVDBatch(ctx context.Context, batch []*nvd.CVE, st store.Store, knownIDs map[string]bool, triage triageFunc) (numAdds, numMods int, err error) {
+	defer derrors.Wrap(&err, "updateNVDBatch(%s-%s)", batch[0].ID, batch[len(batch)-1].ID)
+
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		numAdds = 0
+		numMods = 0
+		for _, c := range batch {
+			// Unlike files in the cvelist repo, these CVEs are not
+			// contiguous, so read them one at a time.
+			crs, err := tx.GetCVERecords(c.ID, c.ID)
+			if err != nil {
+				return err
+			}
+			var old *store.CVERecord
+			if len(crs) > 0 {
+				old = crs[0]
+			}
+			src := nvdSource(c)
+			if old != nil && old.BlobHash == src.blobHash {
+				// No change; do nothing.
+				continue
+			}
+			added, err := triageAndStoreCVE(ctx, tx, c.ToCVE4(), old, src, knownIDs, triage)
+			if err != nil {
+				return err
+			}
+			if added {
+				numAdds++
+			} else {
+				numMods++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	log.Debugf(ctx, "NVD update transaction %s-%s: added %d, modified %d", batch[0].ID, batch[len(batch)-1].ID, numAdds, numMods)
+	return numAdds, numMods, nil
+}
+
+// nvdSource returns the source of a CVE record for c.
+// Since the NVD changes the last-modified time of a CVE whenever the CVE
+// changes, that time serves as the record's BlobHash.
+func nvdSource(c *nvd.CVE) cveSource {
+	modified := c.LastModified.UTC()
+	return cveSource{
+		path:       "https://nvd.nist.gov/vuln/detail/" + c.ID,
+		blobHash:   "nvd:" + modified.Format(time.RFC3339Nano),
+		commitHash: nvdCommitHash,
+		commitTime: modified,
+	}
+}
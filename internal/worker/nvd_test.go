@@ -0,0 +1,139 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/nvd"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestUpdateCVEsFromNVD(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC)
+	start := now.Add(-200 * 24 * time.Hour)
+
+	goCVE := &nvd.CVE{
+		ID:           "CVE-2022-0001",
+		LastModified: nvd.Time{Time: now.Add(-time.Hour)},
+		VulnStatus:   "Analyzed",
+		References:   []nvd.Reference{{URL: "https://github.com/golang/go/issues/1"}},
+	}
+	otherCVE := &nvd.CVE{
+		ID:           "CVE-2022-0002",
+		LastModified: nvd.Time{Time: start.Add(time.Hour)},
+		VulnStatus:   "Analyzed",
+		References:   []nvd.Reference{{URL: "https://example.com"}},
+	}
+	knownCVE := &nvd.CVE{
+		ID:           "CVE-2022-0003",
+		LastModified: nvd.Time{Time: start.Add(time.Hour)},
+		VulnStatus:   "Analyzed",
+	}
+	cves := []*nvd.CVE{goCVE, otherCVE, knownCVE}
+
+	var ranges [][2]time.Time
+	list := func(_ context.Context, s, e time.Time) ([]*nvd.CVE, error) {
+		ranges = append(ranges, [2]time.Time{s, e})
+		var r []*nvd.CVE
+		for _, c := range cves {
+			if !c.LastModified.Before(s) && !c.LastModified.After(e) {
+				r = append(r, c)
+			}
+		}
+		return r, nil
+	}
+	triage := func(_ context.Context, c *cveschema.CVE) (*triageResult, error) {
+		for _, r := range c.References.Data {
+			if strings.Contains(r.URL, "golang") {
+				return &triageResult{modulePath: "std", reason: "golang"}, nil
+			}
+		}
+		return nil, nil
+	}
+	knownIDs := map[string]bool{"CVE-2022-0003": true}
+
+	mstore := store.NewMemStore()
+	// Without a cursor or a start time, there is nothing to do.
+	if _, err := updateCVEsFromNVD(ctx, list, mstore, knownIDs, triage, time.Time{}, now); err == nil {
+		t.Fatal("got nil, want error")
+	}
+
+	stats, err := updateCVEsFromNVD(ctx, list, mstore, knownIDs, triage, start, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (UpdateNVDStats{NumProcessed: 3, NumAdded: 3}); stats != want {
+		t.Errorf("got %+v, want %+v", stats, want)
+	}
+	wantRanges := [][2]time.Time{
+		{start, start.Add(nvd.MaxDateRange)},
+		{start.Add(nvd.MaxDateRange), now},
+	}
+	if diff := cmp.Diff(wantRanges, ranges); diff != "" {
+		t.Errorf("ranges mismatch (-want, +got):\n%s", diff)
+	}
+	got := mstore.CVERecords()
+	wantRecord := &store.CVERecord{
+		ID:                "CVE-2022-0001",
+		Path:              "https://nvd.nist.gov/vuln/detail/CVE-2022-0001",
+		BlobHash:          "nvd:2022-10-31T23:00:00Z",
+		CommitHash:        nvdCommitHash,
+		CommitTime:        goCVE.LastModified.Time,
+		CVEState:          cveschema.StatePublic,
+		TriageState:       store.TriageStateNeedsIssue,
+		TriageStateReason: "golang",
+		Module:            "std",
+		CVE:               goCVE.ToCVE4(),
+		TriagedContent:    store.NewCVEContent(goCVE.ToCVE4()),
+	}
+	if diff := cmp.Diff(wantRecord, got["CVE-2022-0001"], ignoreVersion); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	for id, want := range map[string]store.TriageState{
+		"CVE-2022-0002": store.TriageStateNoActionNeeded,
+		"CVE-2022-0003": store.TriageStateHasVuln,
+	} {
+		if got := got[id].TriageState; got != want {
+			t.Errorf("%s: got %s, want %s", id, got, want)
+		}
+	}
+	cursor, err := mstore.GetFetchCursor(ctx, nvdCursorSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cursor.Equal(now) {
+		t.Errorf("cursor: got %s, want %s", cursor, now)
+	}
+
+	// The next update starts at the cursor, and only modifies changed CVEs.
+	later := now.Add(24 * time.Hour)
+	otherCVE.LastModified = nvd.Time{Time: later.Add(-time.Hour)}
+	otherCVE.References = append(otherCVE.References, nvd.Reference{URL: "https://golang.org/issue/2"})
+	goCVE.LastModified = nvd.Time{Time: now}
+	ranges = nil
+	stats, err = updateCVEsFromNVD(ctx, list, mstore, knownIDs, triage, start, later)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (UpdateNVDStats{NumProcessed: 2, NumModified: 2}); stats != want {
+		t.Errorf("got %+v, want %+v", stats, want)
+	}
+	if diff := cmp.Diff([][2]time.Time{{now, later}}, ranges); diff != "" {
+		t.Errorf("ranges mismatch (-want, +got):\n%s", diff)
+	}
+	if got, want := mstore.CVERecords()["CVE-2022-0002"].TriageState, store.TriageStateNeedsIssue; got != want {
+		t.Errorf("CVE-2022-0002: got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"sort"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/exp/slices"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/osvdev"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// OSVFunc is the type of a function that returns the IDs of the advisories in
+// OSV.dev for the vulnerability with the given ID.
+type OSVFunc func(ctx context.Context, id string) ([]string, error)
+
+// UpdateOSVStats describes the result of an update from OSV.dev.
+type UpdateOSVStats struct {
+	// Number of CVERecords needing an issue.
+	NumProcessed int
+	// Number of those covered by a report in the Go vulnerability database.
+	NumCovered int
+	// Number of CVERecords that changed.
+	NumModified int
+}
+
+// UpdateOSV looks up the CVE records that need an issue in OSV.dev, and
+// records the advisories there for the same vulnerability, both in the CVE
+// record and as aliases of the CVE. A CVE covered by a report in the Go
+// vulnerability database is moved to TriageStateHasVuln, so that triagers
+// don't write another report for it.
+func UpdateOSV(ctx context.Context, advisories OSVFunc, st store.Store) (stats UpdateOSVStats, err error) {
+	defer derrors.Wrap(&err, "UpdateOSV")
+	ctx = event.Start(ctx, "UpdateOSV")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "osv")
+	defer func() { end(err) }()
+	defer func() { countUpdate(ctx, stats.NumProcessed, 0, stats.NumModified) }()
+
+	crs, err := st.ListCVERecordsWithTriageState(ctx, store.TriageStateNeedsIssue)
+	if err != nil {
+		return stats, err
+	}
+	stats.NumProcessed = len(crs)
+	var ids []string
+	for _, cr := range crs {
+		ids = append(ids, cr.ID)
+	}
+	sort.Strings(ids)
+	found := map[string][]string{}
+	for _, id := range ids {
+		as, err := advisories(ctx, id)
+		if err != nil {
+			return stats, err
+		}
+		found[id] = as
+	}
+	// Each CVE writes its record and the alias documents of its ID and its
+	// advisories, as in other updates.
+	for i := 0; i < len(ids); i += updateBatchSize {
+		j := i + updateBatchSize
+		if j > len(ids) {
+			j = len(ids)
+		}
+		nc, nm, err := updateOSVBatch(ctx, st, ids[i:j], found)
+		if err != nil {
+			return stats, err
+		}
+		stats.NumCovered += nc
+		stats.NumModified += nm
+	}
+	log.Infof(ctx, "OSV update succeeded: %+v", stats)
+	return stats, nil
+}
+
+func updateOSVBatch(ctx context.Context, st store.Store, ids []string, found map[string][]string) (numCovered, numMods int, err error) {
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		numCovered, numMods = 0, 0
+		for _, id := range ids {
+			crs, err := tx.GetCVERecords(id, id)
+			if err != nil {
+				return err
+			}
+			// The record may have been triaged since it was listed.
+			if len(crs) == 0 || crs[0].TriageState != store.TriageStateNeedsIssue {
+				continue
+			}
+			cr := crs[0]
+			as := found[id]
+			reports := osvdev.GoReports(as)
+			if len(reports) == 0 && slices.Equal(cr.OSVAdvisories, as) {
+				continue
+			}
+			cr.OSVAdvisories = as
+			if err := tx.AddAliases(append([]string{id}, as...)...); err != nil {
+				return err
+			}
+			if len(reports) > 0 {
+				cr.History = append([]*store.CVERecordSnapshot{cr.Snapshot()}, cr.History...)
+				cr.TriageState = store.TriageStateHasVuln
+				// As in the false-positives file, the reason is the ID of
+				// the covering report.
+				cr.TriageStateReason = reports[0]
+				cr.CVE = nil
+				numCovered++
+			}
+			if err := tx.SetCVERecord(cr); err != nil {
+				return err
+			}
+			numMods++
+		}
+		return nil
+	})
+	return numCovered, numMods, err
+}
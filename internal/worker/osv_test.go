@@ -0,0 +1,160 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/osvdev"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestUpdateOSV(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	record := func(id string, ts store.TriageState) *store.CVERecord {
+		return &store.CVERecord{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  time.Date(2022, time.October, 1, 0, 0, 0, 0, time.UTC),
+			CVEState:    cveschema.StatePublic,
+			TriageState: ts,
+		}
+	}
+	covered := record("CVE-2022-0001", store.TriageStateNeedsIssue)
+	covered.CVE = &cveschema.CVE{Metadata: cveschema.Metadata{ID: covered.ID}}
+	createCVERecords(t, mstore, []*store.CVERecord{
+		covered,
+		record("CVE-2022-0002", store.TriageStateNeedsIssue),
+		record("CVE-2022-0003", store.TriageStateNeedsIssue),
+		record("CVE-2022-0004", store.TriageStateNoActionNeeded),
+	})
+
+	var requested []string
+	advisories := func(_ context.Context, id string) ([]string, error) {
+		requested = append(requested, id)
+		switch id {
+		case "CVE-2022-0001":
+			return []string{"GHSA-aaaa-bbbb-cccc", "GO-2022-0100"}, nil
+		case "CVE-2022-0002":
+			return []string{"PYSEC-2022-1"}, nil
+		default:
+			return nil, nil
+		}
+	}
+	stats, err := UpdateOSV(ctx, advisories, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"CVE-2022-0001", "CVE-2022-0002", "CVE-2022-0003"}, requested); diff != "" {
+		t.Errorf("requested IDs mismatch (-want, +got):\n%s", diff)
+	}
+	if want := (UpdateOSVStats{NumProcessed: 3, NumCovered: 1, NumModified: 2}); stats != want {
+		t.Errorf("got %+v, want %+v", stats, want)
+	}
+
+	crs := mstore.CVERecords()
+	want := record("CVE-2022-0001", store.TriageStateHasVuln)
+	want.TriageStateReason = "GO-2022-0100"
+	want.OSVAdvisories = []string{"GHSA-aaaa-bbbb-cccc", "GO-2022-0100"}
+	want.History = []*store.CVERecordSnapshot{{
+		CommitHash:  "123",
+		CVEState:    cveschema.StatePublic,
+		TriageState: store.TriageStateNeedsIssue,
+	}}
+	if diff := cmp.Diff(want, crs["CVE-2022-0001"], ignoreVersion); diff != "" {
+		t.Errorf("covered record mismatch (-want, +got):\n%s", diff)
+	}
+	if got := crs["CVE-2022-0002"]; got.TriageState != store.TriageStateNeedsIssue || !cmp.Equal(got.OSVAdvisories, []string{"PYSEC-2022-1"}) {
+		t.Errorf("CVE-2022-0002: got %s, %v; want NeedsIssue, [PYSEC-2022-1]", got.TriageState, got.OSVAdvisories)
+	}
+	aliases, err := mstore.GetAliases(ctx, "GO-2022-0100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"CVE-2022-0001", "GHSA-aaaa-bbbb-cccc"}, aliases); diff != "" {
+		t.Errorf("aliases mismatch (-want, +got):\n%s", diff)
+	}
+
+	// Unchanged advisories are not written again.
+	stats, err = UpdateOSV(ctx, advisories, mstore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (UpdateOSVStats{NumProcessed: 2}); stats != want {
+		t.Errorf("second update: got %+v, want %+v", stats, want)
+	}
+}
+
+func TestUpdateOSVRelated(t *testing.T) {
+	// OSV.dev relates the CVE to a Go report of another vulnerability.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimPrefix(r.URL.Path, "/vulns/") != "CVE-2022-0001" {
+			http.Error(w, `{"code":5,"message":"Bug not found."}`, http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(&osvdev.Vuln{
+			ID:      "CVE-2022-0001",
+			Aliases: []string{"GHSA-aaaa-bbbb-cccc"},
+			Related: []string{"GO-2022-0200"},
+		})
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	createCVERecords(t, mstore, []*store.CVERecord{{
+		ID:          "CVE-2022-0001",
+		Path:        "CVE-2022-0001.json",
+		BlobHash:    "abc",
+		CommitHash:  "123",
+		CommitTime:  time.Date(2022, time.October, 1, 0, 0, 0, 0, time.UTC),
+		CVEState:    cveschema.StatePublic,
+		TriageState: store.TriageStateNeedsIssue,
+	}})
+	if _, err := UpdateOSV(ctx, osvdev.NewClient(srv.URL).Advisories, mstore); err != nil {
+		t.Fatal(err)
+	}
+	cr := mstore.CVERecords()["CVE-2022-0001"]
+	if cr.TriageState != store.TriageStateNeedsIssue || !cmp.Equal(cr.OSVAdvisories, []string{"GHSA-aaaa-bbbb-cccc"}) {
+		t.Errorf("got %s, %v; want NeedsIssue, [GHSA-aaaa-bbbb-cccc]", cr.TriageState, cr.OSVAdvisories)
+	}
+	// The related report is not joined to the CVE or its aliases.
+	err := mstore.RunTransaction(ctx, func(_ context.Context, tx store.Transaction) error {
+		for _, id := range []string{"CVE-2022-0001", "GHSA-aaaa-bbbb-cccc"} {
+			report, err := findGoReportAlias(tx, id)
+			if err != nil {
+				return err
+			}
+			if report != "" {
+				t.Errorf("%s: got Go report alias %s, want none", id, report)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	aliases, err := mstore.GetAliases(ctx, "GO-2022-0200")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aliases) != 0 {
+		t.Errorf("GO-2022-0200: got aliases %v, want none", aliases)
+	}
+}
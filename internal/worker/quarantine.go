@@ -0,0 +1,106 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"path"
+	"time"
+
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A CVE file that fails to parse does not fail the update of the cvelist
+// repo. The update skips the file and records the failure in the store, and
+// leaves the file's directory to be processed again by the next update. Once
+// quarantineAfter updates in a row have failed to parse the same contents,
+// the file is quarantined: updates skip it without parsing it, until its
+// contents change. A file that parses again is forgotten.
+
+// quarantineAfter is the number of updates that must fail to parse a CVE file
+// before it is quarantined.
+const quarantineAfter = 3
+
+// A parseError is an error from parsing a CVE file.
+type parseError struct {
+	err error
+}
+
+func (e *parseError) Error() string { return e.err.Error() }
+
+func (e *parseError) Unwrap() error { return e.err }
+
+// loadQuarantine reads the files that earlier updates failed to parse from
+// the store.
+func (u *cveUpdater) loadQuarantine(ctx context.Context) error {
+	qs, err := u.st.ListQuarantinedFiles(ctx)
+	if err != nil {
+		return err
+	}
+	u.quarantine = map[string]*store.QuarantinedFile{}
+	for _, q := range qs {
+		u.quarantine[q.Path] = q
+	}
+	return nil
+}
+
+// quarantined reports whether f is quarantined, so that the update should
+// skip it.
+func (u *cveUpdater) quarantined(f cvelistrepo.File) bool {
+	q := u.quarantine[path.Join(f.DirPath, f.Filename)]
+	return q != nil && q.Quarantined && q.BlobHash == f.BlobHash.String()
+}
+
+// recordParseResults records in the store that the files of batch whose IDs
+// are in failures failed to parse, and forgets the earlier failures of those
+// that were prepared. It returns the number of files that failed to parse.
+func (u *cveUpdater) recordParseResults(ctx context.Context, batch []cvelistrepo.File, prepared map[string]*preparedCVE, failures map[string]error) (numFailed int, err error) {
+	now := time.Now()
+	for _, f := range batch {
+		id := idFromFilename(f.Filename)
+		p := path.Join(f.DirPath, f.Filename)
+		q := u.quarantine[p]
+		if perr := failures[id]; perr != nil {
+			numFailed++
+			if q == nil || q.BlobHash != f.BlobHash.String() {
+				q = &store.QuarantinedFile{
+					Path:          p,
+					BlobHash:      f.BlobHash.String(),
+					FirstFailedAt: now,
+				}
+			}
+			q.Error = perr.Error()
+			q.NumFailures++
+			q.LastFailedAt = now
+			q.Quarantined = q.NumFailures >= quarantineAfter
+			if q.Quarantined {
+				log.Errorf(ctx, "quarantining %s after %d failures to parse it: %v", p, q.NumFailures, perr)
+			} else {
+				log.Warningf(ctx, "skipping %s, which failed to parse (%d of %d failures): %v",
+					p, q.NumFailures, quarantineAfter, perr)
+			}
+			if err := u.st.SetQuarantinedFile(ctx, q); err != nil {
+				return 0, err
+			}
+			u.quarantine[p] = q
+		} else if q != nil && prepared[id] != nil {
+			log.Infof(ctx, "%s parses again; releasing it from quarantine", p)
+			if err := u.st.DeleteQuarantinedFile(ctx, p); err != nil {
+				return 0, err
+			}
+			delete(u.quarantine, p)
+		}
+	}
+	return numFailed, nil
+}
+
+// isParseError reports whether err is from parsing a CVE file.
+func isParseError(err error) bool {
+	var perr *parseError
+	return errors.As(err, &perr)
+}
@@ -0,0 +1,400 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+	"gopkg.in/yaml.v3"
+)
+
+// VulnDBRepoURL is the URL of the vulndb repo, whose reports Reconcile
+// checks the store and issues against.
+const VulnDBRepoURL = "https://go.googlesource.com/vulndb"
+
+// vulnDBIssues is the destination of the issues of the vulndb repo. The
+// report GO-YYYY-NNNN is written for issue NNNN there.
+const vulnDBIssues = "https://github.com/golang/vulndb"
+
+// A GoReport is a report in the vulndb repo, with only what Reconcile needs.
+type GoReport struct {
+	// ID is the ID of the report, such as GO-2022-0123.
+	ID string
+	// Excluded reports whether the report is in data/excluded, rather than
+	// data/reports.
+	Excluded bool
+	// Aliases are the CVE and GHSA IDs of the report.
+	Aliases []string
+	// References are the URLs of the report's references.
+	References []string
+	// Description is the description of the report.
+	Description string
+	// Modules are the modules that the report affects.
+	Modules []*GoReportModule
+	// Supersedes, SplitFrom and Related are the IDs of the reports that
+	// the report supersedes, was split from, and is related to.
+	Supersedes []string
+	SplitFrom  string
+	Related    []string
+	// Published is when the report was published, if it says.
+	Published time.Time
+}
+
+// A GoReportModule is a module that a GoReport affects.
+type GoReportModule struct {
+	Path string
+	// Versions are the vulnerable version ranges of the module.
+	Versions []report.VersionRange
+	// Packages are the import paths of the module's vulnerable packages.
+	Packages []string
+}
+
+// issueNumber returns the number of the vulndb issue that r was written
+// for, or 0 if its ID has none.
+func (r *GoReport) issueNumber() int {
+	var year, num int
+	if _, err := fmt.Sscanf(r.ID, "GO-%d-%d", &year, &num); err != nil {
+		return 0
+	}
+	return num
+}
+
+// ReadGoReports returns the reports and excluded reports at HEAD of repo,
+// which must be a clone of the vulndb repo.
+func ReadGoReports(repo *git.Repository) (_ []*GoReport, err error) {
+	defer derrors.Wrap(&err, "ReadGoReports")
+
+	root, err := gitrepo.Root(repo)
+	if err != nil {
+		return nil, err
+	}
+	var rs []*GoReport
+	for _, dir := range []struct {
+		path     string
+		excluded bool
+	}{
+		{"data/reports", false},
+		{"data/excluded", true},
+	} {
+		tree, err := root.Tree(dir.path)
+		if err == object.ErrDirectoryNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range tree.Entries {
+			e := &tree.Entries[i]
+			if !strings.HasSuffix(e.Name, ".yaml") {
+				continue
+			}
+			f, err := tree.TreeEntryFile(e)
+			if err != nil {
+				return nil, err
+			}
+			contents, err := f.Contents()
+			if err != nil {
+				return nil, err
+			}
+			var r report.Report
+			if err := yaml.Unmarshal([]byte(contents), &r); err != nil {
+				return nil, fmt.Errorf("%s/%s: %v", dir.path, e.Name, err)
+			}
+			gr := &GoReport{
+				ID:          strings.TrimSuffix(e.Name, ".yaml"),
+				Excluded:    dir.excluded,
+				Aliases:     r.GetAliases(),
+				Description: r.Description,
+				Supersedes:  r.Supersedes,
+				SplitFrom:   r.SplitFrom,
+				Related:     r.Related,
+				Published:   r.Published,
+			}
+			for _, ref := range r.References {
+				gr.References = append(gr.References, ref.URL)
+			}
+			for _, m := range r.Modules {
+				gm := &GoReportModule{Path: m.Module, Versions: m.Versions}
+				for _, p := range m.Packages {
+					gm.Packages = append(gm.Packages, p.Package)
+				}
+				gr.Modules = append(gr.Modules, gm)
+			}
+			rs = append(rs, gr)
+		}
+	}
+	return rs, nil
+}
+
+// LoadGoReports returns the reports and excluded reports of the vulndb repo
+// at repoPath, which may be a URL to clone or a local directory.
+func LoadGoReports(ctx context.Context, repoPath string) (_ []*GoReport, err error) {
+	defer derrors.Wrap(&err, "LoadGoReports(%q)", repoPath)
+
+	repo, err := gitrepo.CloneOrOpen(ctx, repoPath)
+	if err != nil {
+		return nil, err
+	}
+	return ReadGoReports(repo)
+}
+
+// ReconcileStats describes the result of a reconciliation.
+type ReconcileStats struct {
+	// Number of CVE and GHSA records checked: those that need an issue or
+	// have one.
+	NumChecked int
+	// Number of those moved to TriageStateHasVuln, because a report covers
+	// them.
+	NumFixed int
+	// Records whose issue and reports disagree in a way that a person must
+	// sort out.
+	Orphans []*Orphan
+}
+
+// An Orphan is a record whose issue and reports disagree in a way that
+// Reconcile can't fix safely.
+type Orphan struct {
+	// ID is the CVE or GHSA ID of the record.
+	ID string
+	// IssueReference is the reference to the record's issue.
+	IssueReference string
+	// Problem describes the disagreement.
+	Problem string
+}
+
+func (o *Orphan) String() string {
+	return fmt.Sprintf("%s (%s): %s", o.ID, o.IssueReference, o.Problem)
+}
+
+// Reconcile cross-checks the CVE and GHSA records in the store, their issues
+// in ic, and the reports in the vulndb repo at repoPath, which may be a URL
+// to clone or a local directory.
+//
+// A record that needs an issue or has one, but is covered by a report, is
+// moved to TriageStateHasVuln with the report's ID as the reason. A record
+// whose issue is closed without a report is an orphan: Reconcile doesn't
+// change it, but returns it in the stats and sends a notification about it.
+func Reconcile(ctx context.Context, st store.Store, ic issues.Client, repoPath string) (stats ReconcileStats, err error) {
+	defer derrors.Wrap(&err, "Reconcile(%q)", repoPath)
+
+	reports, err := LoadGoReports(ctx, repoPath)
+	if err != nil {
+		return stats, err
+	}
+	if err := indexGoReports(ctx, st, reports); err != nil {
+		return stats, err
+	}
+	return reconcile(ctx, st, ic, reports)
+}
+
+// A reconcileRecord is a CVE or GHSA record being reconciled.
+type reconcileRecord struct {
+	id             string
+	ghsa           bool
+	issueReference string
+}
+
+// reconcilableStates are the triage states of the records that Reconcile
+// checks.
+var reconcilableStates = []store.TriageState{
+	store.TriageStateNeedsIssue,
+	store.TriageStateIssueCreated,
+	store.TriageStateUpdatedSinceIssueCreation,
+}
+
+func isReconcilable(ts store.TriageState) bool {
+	for _, s := range reconcilableStates {
+		if ts == s {
+			return true
+		}
+	}
+	return false
+}
+
+func reconcile(ctx context.Context, st store.Store, ic issues.Client, reports []*GoReport) (stats ReconcileStats, err error) {
+	ctx = event.Start(ctx, "reconcile")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "reconcile")
+	defer func() { end(err) }()
+	defer func() { countUpdate(ctx, stats.NumChecked, 0, stats.NumFixed) }()
+
+	// Index the reports by alias, and by issue if the issues are those of
+	// the vulndb repo. An alias of both a report and an excluded report is
+	// covered by the report.
+	byAlias := map[string]*GoReport{}
+	byIssue := map[int]*GoReport{}
+	matchIssues := ic.Destination() == vulnDBIssues
+	for _, r := range reports {
+		for _, a := range r.Aliases {
+			if old := byAlias[a]; old == nil || !r.Excluded {
+				byAlias[a] = r
+			}
+		}
+		if n := r.issueNumber(); n > 0 && matchIssues {
+			byIssue[n] = r
+		}
+	}
+
+	var recs []reconcileRecord
+	for _, ts := range reconcilableStates {
+		crs, err := st.ListCVERecordsWithTriageState(ctx, ts)
+		if err != nil {
+			return stats, err
+		}
+		for _, cr := range crs {
+			recs = append(recs, reconcileRecord{id: cr.ID, issueReference: cr.IssueReference})
+		}
+	}
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		grs, err := tx.GetGHSARecords()
+		if err != nil {
+			return err
+		}
+		for _, gr := range grs {
+			if isReconcilable(gr.TriageState) {
+				recs = append(recs, reconcileRecord{id: gr.GHSA.ID, ghsa: true, issueReference: gr.IssueReference})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+	sort.Slice(recs, func(i, j int) bool { return recs[i].id < recs[j].id })
+	stats.NumChecked = len(recs)
+
+	fixes := map[string]*GoReport{}
+	var toFix []reconcileRecord
+	for _, rec := range recs {
+		num := issueNumber(ic, rec.issueReference)
+		r := byAlias[rec.id]
+		if r == nil && num > 0 {
+			r = byIssue[num]
+		}
+		if r != nil && !r.Excluded {
+			fixes[rec.id] = r
+			toFix = append(toFix, rec)
+			continue
+		}
+		if r != nil || num == 0 {
+			// An excluded report settles the issue, and a record without
+			// an issue can't be orphaned.
+			continue
+		}
+		iss, err := ic.GetIssue(ctx, num, issues.GetIssueOptions{})
+		if err != nil {
+			return stats, err
+		}
+		if iss.State == "closed" {
+			stats.Orphans = append(stats.Orphans, &Orphan{
+				ID:             rec.id,
+				IssueReference: rec.issueReference,
+				Problem:        "the issue is closed, but no report covers the record",
+			})
+		}
+	}
+
+	for i := 0; i < len(toFix); i += maxTransactionWrites {
+		j := i + maxTransactionWrites
+		if j > len(toFix) {
+			j = len(toFix)
+		}
+		n, err := fixReconciledBatch(ctx, st, toFix[i:j], fixes)
+		if err != nil {
+			return stats, err
+		}
+		stats.NumFixed += n
+	}
+
+	if len(stats.Orphans) > 0 {
+		var ids []string
+		for _, o := range stats.Orphans {
+			log.Infof(ctx, "orphan: %s", o)
+			ids = append(ids, o.ID)
+		}
+		notify(ctx, &Notification{
+			Kind: NotifyOrphans,
+			Text: fmt.Sprintf("%d CVEs or GHSAs have closed issues but no reports.", len(ids)),
+			IDs:  ids,
+		})
+	}
+	log.Infof(ctx, "reconciliation succeeded: checked %d, fixed %d, %d orphans",
+		stats.NumChecked, stats.NumFixed, len(stats.Orphans))
+	return stats, nil
+}
+
+// fixReconciledBatch moves the records to TriageStateHasVuln, with the IDs
+// of the reports in fixes as the reasons, and returns the number it moved.
+func fixReconciledBatch(ctx context.Context, st store.Store, recs []reconcileRecord, fixes map[string]*GoReport) (numFixed int, err error) {
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		numFixed = 0
+		for _, rec := range recs {
+			reason := fixes[rec.id].ID
+			if rec.ghsa {
+				gr, err := tx.GetGHSARecord(rec.id)
+				if err != nil {
+					return err
+				}
+				// The record may have been triaged since it was listed.
+				if gr == nil || !isReconcilable(gr.TriageState) {
+					continue
+				}
+				gr.TriageState = store.TriageStateHasVuln
+				gr.TriageStateReason = reason
+				if err := tx.SetGHSARecord(gr); err != nil {
+					return err
+				}
+				numFixed++
+				continue
+			}
+			crs, err := tx.GetCVERecords(rec.id, rec.id)
+			if err != nil {
+				return err
+			}
+			if len(crs) == 0 || !isReconcilable(crs[0].TriageState) {
+				continue
+			}
+			cr := crs[0]
+			cr.History = append([]*store.CVERecordSnapshot{cr.Snapshot()}, cr.History...)
+			cr.TriageState = store.TriageStateHasVuln
+			cr.TriageStateReason = reason
+			cr.CVE = nil
+			if err := tx.SetCVERecord(cr); err != nil {
+				return err
+			}
+			numFixed++
+		}
+		return nil
+	})
+	return numFixed, err
+}
+
+// issueNumber returns the number of the issue of ic that ref refers to, or 0
+// if ref doesn't refer to one.
+func issueNumber(ic issues.Client, ref string) int {
+	i := strings.LastIndexAny(ref, "/#")
+	if i < 0 {
+		return 0
+	}
+	n, err := strconv.Atoi(ref[i+1:])
+	if err != nil || n <= 0 || ic.Reference(n) != ref {
+		return 0
+	}
+	return n
+}
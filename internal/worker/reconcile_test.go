@@ -0,0 +1,186 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// reconcileIssueClient is an issues.Client for the vulndb issues, whose
+// issues have the given states.
+type reconcileIssueClient struct {
+	states map[int]string
+}
+
+func (c *reconcileIssueClient) Destination() string { return vulnDBIssues }
+
+func (c *reconcileIssueClient) Reference(n int) string {
+	return fmt.Sprintf("%s/issues/%d", vulnDBIssues, n)
+}
+
+func (c *reconcileIssueClient) IssueExists(_ context.Context, n int) (bool, error) {
+	_, ok := c.states[n]
+	return ok, nil
+}
+
+func (c *reconcileIssueClient) GetIssue(_ context.Context, n int, _ issues.GetIssueOptions) (*issues.Issue, error) {
+	state, ok := c.states[n]
+	if !ok {
+		return nil, fmt.Errorf("no issue %d", n)
+	}
+	return &issues.Issue{State: state}, nil
+}
+
+func (c *reconcileIssueClient) CreateIssue(context.Context, *issues.Issue) (int, error) {
+	return 0, errors.New("unexpected CreateIssue")
+}
+
+func (c *reconcileIssueClient) CreateComment(context.Context, int, string) error {
+	return errors.New("unexpected CreateComment")
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	repo, err := gitrepo.ReadTxtarRepo("testdata/vulndb.txtar", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	reports, err := ReadGoReports(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantReports := []*GoReport{
+		{
+			ID:      "GO-2022-0001",
+			Aliases: []string{"CVE-2022-0001"},
+			Modules: []*GoReportModule{{Path: "example.com/a"}},
+		},
+		{
+			ID:         "GO-2022-0005",
+			Aliases:    []string{"CVE-2022-0002"},
+			References: []string{"https://github.com/b/b/commit/123"},
+			Modules: []*GoReportModule{{
+				Path:     "example.com/b",
+				Versions: []report.VersionRange{{Introduced: "1.0.0", Fixed: "1.2.0"}},
+			}},
+		},
+		{
+			ID:      "GO-2022-0007",
+			Aliases: []string{"GHSA-aaaa-bbbb-cccc"},
+			Modules: []*GoReportModule{{Path: "example.com/c"}},
+		},
+		{ID: "GO-2022-0004", Excluded: true, Aliases: []string{"CVE-2022-0004"}},
+	}
+	if diff := cmp.Diff(wantReports, reports); diff != "" {
+		t.Fatalf("reports mismatch (-want, +got):\n%s", diff)
+	}
+	// An excluded report for a CVE whose issue is another one.
+	reports = append(reports, &GoReport{ID: "GO-2022-0010", Excluded: true, Aliases: []string{"CVE-2022-0009"}})
+
+	ic := &reconcileIssueClient{states: map[int]string{
+		1: "closed", 3: "closed", 4: "closed", 6: "open", 7: "open", 8: "closed", 9: "closed",
+	}}
+	mstore := store.NewMemStore()
+	record := func(id string, ts store.TriageState, issue int) *store.CVERecord {
+		r := &store.CVERecord{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  time.Date(2022, time.October, 1, 0, 0, 0, 0, time.UTC),
+			TriageState: ts,
+		}
+		if issue > 0 {
+			r.IssueReference = ic.Reference(issue)
+		}
+		return r
+	}
+	createCVERecords(t, mstore, []*store.CVERecord{
+		// Covered by the report for its issue.
+		record("CVE-2022-0001", store.TriageStateIssueCreated, 1),
+		// Covered by a report for another issue.
+		record("CVE-2022-0002", store.TriageStateNeedsIssue, 0),
+		// Issue closed without a report.
+		record("CVE-2022-0003", store.TriageStateIssueCreated, 3),
+		// Issue closed with an excluded report.
+		record("CVE-2022-0004", store.TriageStateIssueCreated, 4),
+		// Issue still open.
+		record("CVE-2022-0006", store.TriageStateIssueCreated, 6),
+		// Not checked.
+		record("CVE-2022-0008", store.TriageStateFalsePositive, 8),
+		// Issue closed with an excluded report for another issue.
+		record("CVE-2022-0009", store.TriageStateIssueCreated, 9),
+	})
+	createGHSARecords(t, mstore, []*store.GHSARecord{{
+		GHSA:           &ghsa.SecurityAdvisory{ID: "GHSA-aaaa-bbbb-cccc"},
+		TriageState:    store.TriageStateIssueCreated,
+		IssueReference: ic.Reference(7),
+	}})
+
+	rn := &recordingNotifier{}
+	ctx = WithNotifier(ctx, rn)
+	stats, err := reconcile(ctx, mstore, ic, reports)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantOrphans := []*Orphan{{
+		ID:             "CVE-2022-0003",
+		IssueReference: ic.Reference(3),
+		Problem:        "the issue is closed, but no report covers the record",
+	}}
+	if stats.NumChecked != 7 || stats.NumFixed != 3 {
+		t.Errorf("got %d checked and %d fixed, want 7 and 3", stats.NumChecked, stats.NumFixed)
+	}
+	if diff := cmp.Diff(wantOrphans, stats.Orphans); diff != "" {
+		t.Errorf("orphans mismatch (-want, +got):\n%s", diff)
+	}
+	if len(rn.ns) != 1 || rn.ns[0].Kind != NotifyOrphans || !cmp.Equal(rn.ns[0].IDs, []string{"CVE-2022-0003"}) {
+		t.Errorf("got notifications %+v, want one about the orphan", rn.ns)
+	}
+
+	crs := mstore.CVERecords()
+	for id, want := range map[string]store.TriageState{
+		"CVE-2022-0001": store.TriageStateHasVuln,
+		"CVE-2022-0002": store.TriageStateHasVuln,
+		"CVE-2022-0003": store.TriageStateIssueCreated,
+		"CVE-2022-0004": store.TriageStateIssueCreated,
+		"CVE-2022-0006": store.TriageStateIssueCreated,
+		"CVE-2022-0008": store.TriageStateFalsePositive,
+		"CVE-2022-0009": store.TriageStateIssueCreated,
+	} {
+		if got := crs[id].TriageState; got != want {
+			t.Errorf("%s: got %s, want %s", id, got, want)
+		}
+	}
+	if got, want := crs["CVE-2022-0002"].TriageStateReason, "GO-2022-0005"; got != want {
+		t.Errorf("CVE-2022-0002: got reason %q, want %q", got, want)
+	}
+	var got *store.GHSARecord
+	err = mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		var err error
+		got, err = tx.GetGHSARecord("GHSA-aaaa-bbbb-cccc")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.TriageState != store.TriageStateHasVuln || got.TriageStateReason != "GO-2022-0007" {
+		t.Errorf("GHSA: got %s (%s), want HasVuln (GO-2022-0007)", got.TriageState, got.TriageStateReason)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+var (
+	// githubRawURL is the base URL for the contents of files in GitHub repos.
+	githubRawURL = "https://raw.githubusercontent.com"
+	// githubAPIURL is the base URL of the GitHub REST API.
+	githubAPIURL = "https://api.github.com"
+)
+
+const (
+	// repoModulesTTL is how long the modules of a repo in the store are
+	// used before they are looked up again.
+	repoModulesTTL = 30 * 24 * time.Hour
+
+	// maxRepoModules is the most go.mod files read from one repo.
+	maxRepoModules = 100
+)
+
+var (
+	// Cache of the modules of GitHub repos, by repo path. Guarded by
+	// githubRepoModulesCacheMu.
+	githubRepoModulesCacheMu sync.Mutex
+	githubRepoModulesCache   = map[string]*store.RepoModules{}
+)
+
+type repoModulesStoreKey struct{}
+
+// withRepoModulesStore returns a context in which githubRepoModules reads
+// and writes the modules of repos in st, so that they are reused across
+// runs.
+func withRepoModulesStore(ctx context.Context, st store.Store) context.Context {
+	return context.WithValue(ctx, repoModulesStoreKey{}, st)
+}
+
+func repoModulesStore(ctx context.Context) store.Store {
+	st, _ := ctx.Value(repoModulesStoreKey{}).(store.Store)
+	return st
+}
+
+// githubRepoModules returns the modules declared by the go.mod files in the
+// default branch of the GitHub repo at repoPath, like
+// "github.com/owner/repo", including those in subdirectories. A go.mod file
+// that does not declare a valid module path is ignored.
+//
+// The files are read from the repo rather than the module proxy, which would
+// synthesize a go.mod file for a repo without one. The result is cached in
+// memory, and in the store of ctx, if any, for repoModulesTTL.
+func githubRepoModules(ctx context.Context, repoPath string) (_ *store.RepoModules, err error) {
+	defer derrors.Wrap(&err, "githubRepoModules(%q)", repoPath)
+
+	githubRepoModulesCacheMu.Lock()
+	rm, ok := githubRepoModulesCache[repoPath]
+	githubRepoModulesCacheMu.Unlock()
+	if ok {
+		return rm, nil
+	}
+	st := repoModulesStore(ctx)
+	if st != nil {
+		rm, err := st.GetRepoModules(ctx, repoPath)
+		if err != nil {
+			return nil, err
+		}
+		if rm != nil && time.Since(rm.FetchedAt) < repoModulesTTL {
+			cacheRepoModules(rm)
+			return rm, nil
+		}
+	}
+
+	ctx = event.Start(ctx, "githubRepoModules")
+	defer event.End(ctx)
+	observe.SetSpanAttribute(ctx, "repo", repoPath)
+	rm = &store.RepoModules{Repo: repoPath, FetchedAt: time.Now()}
+	repo := strings.TrimPrefix(repoPath, "github.com/")
+	dirs, complete, err := githubGoModDirs(ctx, repo)
+	if err != nil {
+		// The API is rate-limited more strictly than raw file contents. Make
+		// do with the go.mod file at the root, but don't remember the
+		// partial result beyond this run.
+		log.Warningf(ctx, "listing go.mod files of %s: %v; reading only the root", repoPath, err)
+		dirs = []string{""}
+	}
+	for _, dir := range dirs {
+		mp, err := githubGoModPath(ctx, repo, dir)
+		if err != nil {
+			return nil, err
+		}
+		if mp != "" {
+			rm.Modules = append(rm.Modules, &store.RepoModule{Dir: dir, Path: mp})
+		}
+	}
+	cacheRepoModules(rm)
+	if st != nil && complete {
+		if err := st.SetRepoModules(ctx, rm); err != nil {
+			return nil, err
+		}
+	}
+	return rm, nil
+}
+
+func cacheRepoModules(rm *store.RepoModules) {
+	githubRepoModulesCacheMu.Lock()
+	githubRepoModulesCache[rm.Repo] = rm
+	githubRepoModulesCacheMu.Unlock()
+}
+
+// githubGoModDirs returns the sorted directories of the go.mod files in the
+// default branch of repo, like "owner/repo", with "" for the root. It skips
+// directories that the go command ignores, and vendored and test data. It
+// reports whether the list is complete: false if there were more than
+// maxRepoModules files, or GitHub truncated the list.
+func githubGoModDirs(ctx context.Context, repo string) (_ []string, complete bool, err error) {
+	// HEAD is the default branch.
+	url := githubAPIURL + "/repos/" + repo + "/git/trees/HEAD?recursive=1"
+	status, body, err := githubGet(ctx, url, 64<<20)
+	if err != nil {
+		return nil, false, err
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// No such repo, or it is empty.
+		return nil, true, nil
+	default:
+		return nil, false, fmt.Errorf("%s returned status %d", url, status)
+	}
+	var tree struct {
+		Tree []struct {
+			Path string
+			Type string
+		}
+		Truncated bool
+	}
+	if err := json.Unmarshal(body, &tree); err != nil {
+		return nil, false, err
+	}
+	complete = !tree.Truncated
+	var dirs []string
+	for _, e := range tree.Tree {
+		if e.Type != "blob" || path.Base(e.Path) != "go.mod" {
+			continue
+		}
+		dir := path.Dir(e.Path)
+		if dir == "." {
+			dir = ""
+		}
+		if ignoredModuleDir(dir) {
+			continue
+		}
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	if len(dirs) > maxRepoModules {
+		dirs = dirs[:maxRepoModules]
+		complete = false
+	}
+	return dirs, complete, nil
+}
+
+// ignoredModuleDir reports whether a go.mod file in dir does not define a
+// module of the repo.
+func ignoredModuleDir(dir string) bool {
+	if dir == "" {
+		return false
+	}
+	for _, elem := range strings.Split(dir, "/") {
+		if elem == "vendor" || elem == "testdata" || strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, "_") {
+			return true
+		}
+	}
+	return false
+}
+
+// githubGoModPath returns the module path declared by the go.mod file in dir
+// of the default branch of repo, like "owner/repo", or "" if there is no
+// such file or it does not declare a valid module path.
+func githubGoModPath(ctx context.Context, repo, dir string) (string, error) {
+	url := githubRawURL + "/" + repo + "/HEAD/" + path.Join(dir, "go.mod")
+	// A go.mod file is small; don't read more than a generous limit.
+	status, body, err := githubGet(ctx, url, 1<<20)
+	if err != nil {
+		return "", err
+	}
+	switch status {
+	case http.StatusOK:
+		mp := modfile.ModulePath(body)
+		if module.CheckPath(mp) != nil {
+			return "", nil
+		}
+		return mp, nil
+	case http.StatusNotFound:
+		return "", nil
+	default:
+		return "", fmt.Errorf("%s returned status %d", url, status)
+	}
+}
+
+// githubGet gets url, and returns the status code of the response and at
+// most limit bytes of its body.
+func githubGet(ctx context.Context, url string, limit int64) (int, []byte, error) {
+	start := time.Now()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	var status string
+	if err == nil {
+		status = strconv.Quote(res.Status)
+	}
+	log.With(
+		"latency", time.Since(start),
+		"status", status,
+		"error", err,
+	).Debugf(ctx, "GET "+url)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(res.Body, limit))
+	if err != nil {
+		return 0, nil, err
+	}
+	return res.StatusCode, body, nil
+}
+
+// githubFileDir returns the path in the repo of the file or directory that
+// the path of a GitHub URL, like "/owner/repo/blob/main/dir/file.go", names,
+// or "" if it does not name one. It assumes that the branch or tag has no
+// slashes.
+func githubFileDir(urlPath string) string {
+	parts := strings.SplitN(strings.Trim(urlPath, "/"), "/", 5)
+	if len(parts) < 5 || (parts[2] != "blob" && parts[2] != "tree") {
+		return ""
+	}
+	return parts[4]
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// The review of a pending report moves through these states:
+//
+//	(none) --claim--> draft --review--> in-review --approve--> approved
+//
+// Anyone may claim a report that is not approved, becoming its assignee;
+// claiming a report in review sends it back to draft. Only the assignee can
+// send a report for review, and only someone else can approve it.
+//
+// These rules keep honest users from stepping on each other; they are not
+// access control. The user is whoever the caller says it is (vulnreport
+// takes it from -user or git's user.email), so anyone who can write to the
+// store can approve their own report under another name. The code review of
+// the report's CL is what enforces a second pair of eyes.
+
+// ClaimReport makes user the assignee of the report with the given ID, as a
+// draft.
+func ClaimReport(ctx context.Context, st store.Store, reportID, user string) (_ *store.ReportReview, err error) {
+	defer derrors.Wrap(&err, "ClaimReport(%s, %s)", reportID, user)
+
+	return updateReview(ctx, st, reportID, func(r *store.ReportReview) error {
+		if r.State == store.ReviewStateApproved {
+			return fmt.Errorf("%s is approved", reportID)
+		}
+		r.Assignee = user
+		r.State = store.ReviewStateDraft
+		return nil
+	})
+}
+
+// RequestReview moves the report with the given ID, which user must be the
+// assignee of, from draft to in review.
+func RequestReview(ctx context.Context, st store.Store, reportID, user string) (_ *store.ReportReview, err error) {
+	defer derrors.Wrap(&err, "RequestReview(%s, %s)", reportID, user)
+
+	return updateReview(ctx, st, reportID, func(r *store.ReportReview) error {
+		if r.Assignee != user {
+			return fmt.Errorf("%s is not assigned to %s; claim it first", reportID, user)
+		}
+		if r.State != store.ReviewStateDraft {
+			return fmt.Errorf("%s is %s, not %s", reportID, r.State, store.ReviewStateDraft)
+		}
+		r.State = store.ReviewStateInReview
+		return nil
+	})
+}
+
+// ApproveReport approves the report with the given ID, which must be in
+// review, with user as the reviewer. The assignee of a report can't approve
+// it.
+func ApproveReport(ctx context.Context, st store.Store, reportID, user string) (_ *store.ReportReview, err error) {
+	defer derrors.Wrap(&err, "ApproveReport(%s, %s)", reportID, user)
+
+	return updateReview(ctx, st, reportID, func(r *store.ReportReview) error {
+		if r.State != store.ReviewStateInReview {
+			return fmt.Errorf("%s is %s, not %s", reportID, r.State, store.ReviewStateInReview)
+		}
+		if r.Assignee == user {
+			return fmt.Errorf("%s is assigned to %s, who can't approve it", reportID, user)
+		}
+		r.State = store.ReviewStateApproved
+		r.Reviewer = user
+		return nil
+	})
+}
+
+// updateReview applies change to the review of the report with the given ID,
+// or to a new one without a state if there is none, and stores it. It reads,
+// checks and writes the review in one transaction, so that a concurrent
+// change can't be lost or slip past the checks of change.
+func updateReview(ctx context.Context, st store.Store, reportID string, change func(*store.ReportReview) error) (*store.ReportReview, error) {
+	var r *store.ReportReview
+	err := st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		var err error
+		r, err = tx.GetReportReview(reportID)
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			r = &store.ReportReview{ReportID: reportID}
+		}
+		if err := change(r); err != nil {
+			return err
+		}
+		r.UpdatedAt = time.Now()
+		return tx.SetReportReview(r)
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof(ctx, "review of %s: %s, assigned to %s", reportID, r.State, r.Assignee)
+	return r, nil
+}
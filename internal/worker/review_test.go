@@ -0,0 +1,62 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestReviewStates(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	const id = "GO-2022-0001"
+	check := func(r *store.ReportReview, err error, wantState store.ReviewState, wantAssignee string) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.State != wantState || r.Assignee != wantAssignee {
+			t.Fatalf("got %s, assigned to %s; want %s, assigned to %s", r.State, r.Assignee, wantState, wantAssignee)
+		}
+	}
+	fail := func(_ *store.ReportReview, err error) {
+		t.Helper()
+		if err == nil {
+			t.Fatal("got nil, want error")
+		}
+	}
+
+	// Only the assignee of a draft can send it for review.
+	fail(RequestReview(ctx, mstore, id, "alice"))
+	r, err := ClaimReport(ctx, mstore, id, "alice")
+	check(r, err, store.ReviewStateDraft, "alice")
+	fail(RequestReview(ctx, mstore, id, "bob"))
+	fail(ApproveReport(ctx, mstore, id, "bob"))
+	r, err = RequestReview(ctx, mstore, id, "alice")
+	check(r, err, store.ReviewStateInReview, "alice")
+
+	// The assignee can't approve their own report.
+	fail(ApproveReport(ctx, mstore, id, "alice"))
+
+	// Claiming a report in review sends it back to draft.
+	r, err = ClaimReport(ctx, mstore, id, "bob")
+	check(r, err, store.ReviewStateDraft, "bob")
+	r, err = RequestReview(ctx, mstore, id, "bob")
+	check(r, err, store.ReviewStateInReview, "bob")
+	r, err = ApproveReport(ctx, mstore, id, "alice")
+	check(r, err, store.ReviewStateApproved, "bob")
+	if r.Reviewer != "alice" {
+		t.Errorf("got reviewer %q, want alice", r.Reviewer)
+	}
+
+	// An approved report can't be claimed.
+	fail(ClaimReport(ctx, mstore, id, "carol"))
+}
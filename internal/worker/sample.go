@@ -0,0 +1,243 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"golang.org/x/tools/txtar"
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// untriaged is the outcome of the CVEs that are not in the store.
+const untriaged = "Untriaged"
+
+// SampleCVEs returns a txtar archive of n CVE files sampled from the HEAD of
+// the cvelist repo at repoPath, which may be a URL to clone or a local
+// directory, for tests to read with gitrepo.ReadTxtarRepo.
+//
+// The sample is stratified by the year of each CVE and the outcome of its
+// triage, its triage state in st or "Untriaged" if st doesn't have it, so that
+// the rare outcomes, like CVEs that needed issues, are represented as well
+// as the common ones: the strata take turns giving a file, chosen at random,
+// until there are n. The same seed gives the same sample of the same repo
+// and store.
+//
+// The names and email addresses of people in the credit, contact and
+// discoverer fields of the files are replaced, and the comment of the archive
+// describes the sample.
+func SampleCVEs(ctx context.Context, repoPath string, st store.Store, n int, seed int64) (_ *txtar.Archive, err error) {
+	defer derrors.Wrap(&err, "SampleCVEs(%q, %d, %d)", repoPath, n, seed)
+
+	repo, err := gitrepo.CloneOrOpenWithOptions(ctx, repoPath, cloneOptions)
+	if err != nil {
+		return nil, err
+	}
+	crs, _, err := st.ListCVERecords(ctx, store.CVERecordQuery{})
+	if err != nil {
+		return nil, err
+	}
+	outcomes := map[string]string{}
+	for _, cr := range crs {
+		outcomes[cr.ID] = string(cr.TriageState)
+	}
+	return sampleCVEs(repo, outcomes, n, rand.New(rand.NewSource(seed)))
+}
+
+// sampleCVEs samples n CVE files from the HEAD of repo, where outcomes holds
+// the outcome of the triage of each CVE by ID.
+func sampleCVEs(repo *git.Repository, outcomes map[string]string, n int, rng *rand.Rand) (*txtar.Archive, error) {
+	ref, err := repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	commit, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, err
+	}
+	files, err := cvelistrepo.Files(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	// The files of each stratum, in a random order.
+	strata := map[string][]cvelistrepo.File{}
+	for _, f := range files {
+		outcome, ok := outcomes[cveIDOf(f)]
+		if !ok {
+			outcome = untriaged
+		}
+		key := fmt.Sprintf("%d %s", f.Year, outcome)
+		strata[key] = append(strata[key], f)
+	}
+	var keys []string
+	for k, fs := range strata {
+		rng.Shuffle(len(fs), func(i, j int) { fs[i], fs[j] = fs[j], fs[i] })
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sample []cvelistrepo.File
+	counts := map[string]int{}
+	for len(sample) < n && len(sample) < len(files) {
+		for _, k := range keys {
+			if len(sample) == n {
+				break
+			}
+			if fs := strata[k]; counts[k] < len(fs) {
+				sample = append(sample, fs[counts[k]])
+				counts[k]++
+			}
+		}
+	}
+	sort.Slice(sample, func(i, j int) bool { return filePath(sample[i]) < filePath(sample[j]) })
+
+	var comment strings.Builder
+	fmt.Fprintf(&comment, "Repo in the shape of %s, with a sample of %d of the %d CVE\n", cvelistrepo.URL, len(sample), len(files))
+	fmt.Fprintf(&comment, "files at commit %s, by year and triage outcome:\n\n", commit.Hash)
+	for _, k := range keys {
+		if counts[k] > 0 {
+			fmt.Fprintf(&comment, "%s: %d of %d\n", k, counts[k], len(strata[k]))
+		}
+	}
+	ar := &txtar.Archive{Comment: []byte(comment.String())}
+	for _, f := range sample {
+		data, err := readBlob(repo, f.BlobHash)
+		if err != nil {
+			return nil, err
+		}
+		data, err = anonymizeCVE(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", filePath(f), err)
+		}
+		ar.Files = append(ar.Files, txtar.File{Name: filePath(f), Data: data})
+	}
+	return ar, nil
+}
+
+// cveIDOf returns the ID of the CVE in f.
+func cveIDOf(f cvelistrepo.File) string {
+	return strings.TrimSuffix(f.Filename, path.Ext(f.Filename))
+}
+
+// filePath returns the path of f in the repo.
+func filePath(f cvelistrepo.File) string {
+	return path.Join(f.DirPath, f.Filename)
+}
+
+// readBlob returns the contents of the blob with the given hash.
+func readBlob(repo *git.Repository, hash plumbing.Hash) ([]byte, error) {
+	blob, err := repo.BlobObject(hash)
+	if err != nil {
+		return nil, err
+	}
+	r, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+var (
+	emailRegex = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+	// urlOrEmailRegex matches a URL, or else an email address.
+	urlOrEmailRegex = regexp.MustCompile(`https?://[^\s"'<>]+|` + emailRegex.String())
+)
+
+// Replacements for the names and email addresses of people.
+const (
+	anonymousName  = "Anonymous"
+	anonymousEmail = "anonymous@example.com"
+)
+
+// personalFields are the keys of the fields of a CVE, in JSON 4.0 or 5.0,
+// that hold the names and email addresses of people.
+var personalFields = map[string]bool{
+	"credit":     true,
+	"credits":    true,
+	"contact":    true,
+	"discoverer": true,
+}
+
+// anonymizeCVE returns the contents of a CVE file with the strings in its
+// credit, contact and discoverer fields replaced: email addresses by
+// anonymousEmail, and anything else by anonymousName. The rest of the file,
+// including its references, which triage reads, is left as it is. If there is
+// nothing to replace, it returns data unchanged; otherwise the JSON is
+// indented as in the cvelist repo.
+//
+// A file that is not JSON, like the files that updates quarantine, has no
+// fields to go by, so all its email addresses outside of URLs are replaced
+// as text.
+func anonymizeCVE(data []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return urlOrEmailRegex.ReplaceAllFunc(data, func(b []byte) []byte {
+			if bytes.HasPrefix(b, []byte("http")) {
+				return b
+			}
+			return []byte(anonymousEmail)
+		}), nil
+	}
+	changed := false
+	var anonymize func(v interface{}, personal bool) interface{}
+	anonymize = func(v interface{}, personal bool) interface{} {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, x := range v {
+				if personal && (k == "lang" || k == "type") {
+					continue
+				}
+				v[k] = anonymize(x, personal || personalFields[strings.ToLower(k)])
+			}
+		case []interface{}:
+			for i, x := range v {
+				v[i] = anonymize(x, personal)
+			}
+		case string:
+			if !personal {
+				return v
+			}
+			s := anonymousName
+			if emailRegex.FindString(v) == v {
+				s = anonymousEmail
+			}
+			if s != v {
+				changed = true
+			}
+			return s
+		}
+		return v
+	}
+	v = anonymize(v, false)
+	if !changed {
+		return data, nil
+	}
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	e.SetIndent("", "    ")
+	if err := e.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/tools/txtar"
+	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/gitrepo"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestSampleCVEs(t *testing.T) {
+	cveFile := func(id, extra string) []byte {
+		return []byte(fmt.Sprintf(`{
+    "data_type": "CVE",
+    "data_format": "MITRE",
+    "data_version": "4.0",
+    "CVE_data_meta": {
+        "ID": %q,
+        "ASSIGNER": "security@example.org",
+        "STATE": "PUBLIC"
+    },%s
+    "description": {
+        "description_data": [
+            {
+                "lang": "eng",
+                "value": "A vulnerability in a product."
+            }
+        ]
+    }
+}
+`, id, extra))
+	}
+	ar := &txtar.Archive{}
+	for _, id := range []string{"CVE-2021-0001", "CVE-2021-0002", "CVE-2021-0003", "CVE-2022-1002"} {
+		ar.Files = append(ar.Files, txtar.File{Name: cvePath(id), Data: cveFile(id, "")})
+	}
+	// A CVE that credits a person, with their email address, and has a
+	// reference with an email address in it.
+	ar.Files = append(ar.Files, txtar.File{Name: cvePath("CVE-2022-1001"), Data: cveFile("CVE-2022-1001", `
+    "credit": [
+        {
+            "lang": "eng",
+            "value": "Jane Doe (jane@example.net)"
+        }
+    ],
+    "contact": "jane@example.net",
+    "references": {
+        "reference_data": [
+            {
+                "url": "`+mailingListURL+`"
+            }
+        ]
+    },`)})
+	repoFile := filepath.Join(t.TempDir(), "cvelist.txtar")
+	if err := os.WriteFile(repoFile, txtar.Format(ar), 0644); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := gitrepo.ReadTxtarRepo(repoFile, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	outcomes := map[string]string{
+		"CVE-2021-0001": string(store.TriageStateNeedsIssue),
+		"CVE-2021-0002": string(store.TriageStateNoActionNeeded),
+		"CVE-2021-0003": string(store.TriageStateNoActionNeeded),
+	}
+	sample := func(n int, seed int64) *txtar.Archive {
+		t.Helper()
+		got, err := sampleCVEs(repo, outcomes, n, rand.New(rand.NewSource(seed)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+	names := func(ar *txtar.Archive) []string {
+		var ns []string
+		for _, f := range ar.Files {
+			ns = append(ns, f.Name)
+		}
+		return ns
+	}
+
+	// Each of the three strata gives a file.
+	got := sample(3, 1)
+	var years, needsIssue int
+	for _, f := range got.Files {
+		if strings.HasPrefix(f.Name, "2022/") {
+			years++
+		}
+		if f.Name == cvePath("CVE-2021-0001") {
+			needsIssue++
+		}
+	}
+	if len(got.Files) != 3 || years != 1 || needsIssue != 1 {
+		t.Errorf("got sample %v, want one file from each stratum", names(got))
+	}
+	if diff := cmp.Diff(names(got), names(sample(3, 1))); diff != "" {
+		t.Errorf("same seed, different samples (-first, +second):\n%s", diff)
+	}
+	wantComment := "2021 NeedsIssue: 1 of 1\n2021 NoActionNeeded: 1 of 2\n2022 Untriaged: 1 of 2\n"
+	if !strings.HasSuffix(string(got.Comment), wantComment) {
+		t.Errorf("got comment\n%s\nwant it to end with\n%s", got.Comment, wantComment)
+	}
+
+	// A sample larger than the repo is the whole repo.
+	got = sample(10, 1)
+	if len(got.Files) != len(ar.Files) {
+		t.Fatalf("got %d files, want %d", len(got.Files), len(ar.Files))
+	}
+	for _, f := range got.Files {
+		if f.Name == cvePath("CVE-2022-1001") {
+			s := string(f.Data)
+			if strings.Contains(s, "jane@") || strings.Contains(s, "Jane") ||
+				!strings.Contains(s, `"value": "`+anonymousName+`"`) ||
+				!strings.Contains(s, `"contact": "`+anonymousEmail+`"`) ||
+				!strings.Contains(s, `"url": "`+mailingListURL+`"`) ||
+				!strings.Contains(s, "security@example.org") {
+				t.Errorf("not anonymized as expected:\n%s", s)
+			}
+		} else if want := cveFile(strings.TrimSuffix(filepath.Base(f.Name), ".json"), ""); string(f.Data) != string(want) {
+			t.Errorf("%s: changed, but has nothing to anonymize:\n%s", f.Name, f.Data)
+		}
+	}
+	// The sample is a repo whose CVEs parse.
+	if err := os.WriteFile(repoFile, txtar.Format(got), 0644); err != nil {
+		t.Fatal(err)
+	}
+	srepo, err := gitrepo.ReadTxtarRepo(repoFile, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit := headCommit(t, srepo)
+	files, err := cvelistrepo.Files(srepo, commit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if _, err := cvelistrepo.ParseCVEForTriage(srepo, f); err != nil {
+			t.Errorf("%s: %v", f.Filename, err)
+		}
+	}
+}
+
+// mailingListURL is the URL of a message in a mailing list archive, whose ID
+// looks like an email address.
+const mailingListURL = "https://lists.example.org/archives/CAKx9+1@mail.example.net"
+
+func TestAnonymizeCVENotJSON(t *testing.T) {
+	got, err := anonymizeCVE([]byte(`{"ASSIGNER": "cve@example.org", "value": "jane@example.net", "url": "` + mailingListURL + `"`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"ASSIGNER": "anonymous@example.com", "value": "anonymous@example.com", "url": "` + mailingListURL + `"`
+	if string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+// cvePath returns the path of the CVE with the given ID in the cvelist repo.
+func cvePath(id string) string {
+	year, num := id[4:8], id[9:]
+	return fmt.Sprintf("%s/%sxxx/%s.json", year, num[:len(num)-3], id)
+}
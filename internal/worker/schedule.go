@@ -0,0 +1,119 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/vulndb/internal/cron"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// A Scheduler runs tasks on cron schedules. Every replica of the server can
+// run a Scheduler with the same tasks: for each scheduled time, a task runs
+// on only one of them, the first to acquire the task's lease in the store,
+// which it renews while the task runs.
+type Scheduler struct {
+	st    store.Store
+	owner string
+	tasks []*scheduledTask
+}
+
+type scheduledTask struct {
+	name     string
+	schedule *cron.Schedule
+	run      func(context.Context) error
+}
+
+// NewScheduler returns a Scheduler whose locks in st are held by owner, which
+// must be different for each replica.
+func NewScheduler(st store.Store, owner string) *Scheduler {
+	return &Scheduler{st: st, owner: owner}
+}
+
+// Add adds a task with the given name, which runs at the times matched by the
+// cron expression spec.
+func (s *Scheduler) Add(name, spec string, run func(context.Context) error) error {
+	sched, err := cron.Parse(spec)
+	if err != nil {
+		return err
+	}
+	s.tasks = append(s.tasks, &scheduledTask{name, sched, run})
+	return nil
+}
+
+// Run runs the tasks on their schedules until ctx is done. A task never
+// overlaps with itself; if a run is still going at the next scheduled time,
+// that time is skipped.
+func (s *Scheduler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, t := range s.tasks {
+		t := t
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				next := t.schedule.Next(time.Now())
+				if next.IsZero() {
+					log.Errorf(ctx, "task %s: schedule %q never matches", t.name, t.schedule)
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Until(next)):
+				}
+				if _, err := s.runTask(ctx, t, next); err != nil {
+					log.Errorf(ctx, "task %s: %v", t.name, err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// runTask runs t for the time scheduled, unless another replica is running it
+// or has already run it for that time. It reports whether it ran the task.
+func (s *Scheduler) runTask(ctx context.Context, t *scheduledTask, scheduled time.Time) (ran bool, err error) {
+	defer derrors.Wrap(&err, "runTask(%s, %s)", t.name, scheduled.Format(time.RFC3339))
+
+	// Firestore document IDs cannot contain slashes.
+	leaseName := "task-" + t.name
+	err = holdLease(ctx, s.st, leaseName, s.owner, func(ctx context.Context) error {
+		// The time of the last run, like the fetch cursors, is kept in the
+		// store, so that it is shared by all replicas.
+		cursor := "schedule-" + t.name
+		last, err := s.st.GetFetchCursor(ctx, cursor)
+		if err != nil {
+			return err
+		}
+		if !last.Before(scheduled) {
+			log.Infof(ctx, "task %s: already ran for %s", t.name, scheduled.Format(time.RFC3339))
+			return nil
+		}
+		// Record the run first, so a failed run is not retried until the
+		// next scheduled time.
+		if err := s.st.SetFetchCursor(ctx, cursor, scheduled); err != nil {
+			return err
+		}
+		ran = true
+		log.Infof(ctx, "task %s: starting run for %s", t.name, scheduled.Format(time.RFC3339))
+		if err := t.run(ctx); err != nil {
+			return err
+		}
+		log.Infof(ctx, "task %s: done", t.name)
+		return nil
+	})
+	if lerr := new(LeaseHeldError); errors.As(err, &lerr) {
+		log.Infof(ctx, "task %s: running elsewhere", t.name)
+		return false, nil
+	}
+	return ran, err
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestRunTask(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	a := NewScheduler(mstore, "a")
+	b := NewScheduler(mstore, "b")
+
+	runs := 0
+	if err := a.Add("update", "@hourly", func(context.Context) error {
+		runs++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	task := a.tasks[0]
+	scheduled := time.Date(2022, time.October, 1, 10, 0, 0, 0, time.UTC)
+
+	check := func(s *Scheduler, scheduled time.Time, wantRan bool, wantRuns int) {
+		t.Helper()
+		ran, err := s.runTask(ctx, task, scheduled)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ran != wantRan || runs != wantRuns {
+			t.Fatalf("%s at %s: got ran=%t, runs=%d; want %t, %d", s.owner, scheduled, ran, runs, wantRan, wantRuns)
+		}
+	}
+	check(a, scheduled, true, 1)
+	// Another replica does not run the task again for the same time.
+	check(b, scheduled, false, 1)
+	// It does run it for the next time.
+	scheduled = scheduled.Add(time.Hour)
+	check(b, scheduled, true, 2)
+
+	// A task does not run while another replica holds its lock.
+	if _, err := mstore.AcquireLock(ctx, "task-update", "b", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	check(a, scheduled.Add(time.Hour), false, 2)
+	if err := mstore.ReleaseLock(ctx, "task-update", "b"); err != nil {
+		t.Fatal(err)
+	}
+	check(a, scheduled.Add(time.Hour), true, 3)
+
+	// A failed run is not retried for the same time.
+	errFail := errors.New("fail")
+	task.run = func(context.Context) error { return errFail }
+	scheduled = scheduled.Add(2 * time.Hour)
+	if ran, err := a.runTask(ctx, task, scheduled); !ran || !errors.Is(err, errFail) {
+		t.Fatalf("got (%t, %v), want (true, %v)", ran, err, errFail)
+	}
+	check(b, scheduled, false, 3)
+
+	if err := a.Add("bad", "* * *", nil); err == nil {
+		t.Error("Add with bad schedule: got nil error")
+	}
+}
+
+func TestRunTaskRenewsLease(t *testing.T) {
+	defer func(ttl time.Duration) { leaseTTL = ttl }(leaseTTL)
+	leaseTTL = 30 * time.Millisecond
+
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	a := NewScheduler(mstore, "a")
+	b := NewScheduler(mstore, "b")
+	scheduled := time.Date(2022, time.October, 1, 10, 0, 0, 0, time.UTC)
+
+	// While a's run outlasts several TTLs of its lease, b can't run the
+	// task for the next time.
+	runs := 0
+	if err := a.Add("check-depsdev", "@hourly", func(context.Context) error {
+		runs++
+		if runs == 1 {
+			time.Sleep(5 * leaseTTL)
+			ran, err := b.runTask(ctx, a.tasks[0], scheduled.Add(time.Hour))
+			if ran || err != nil {
+				t.Errorf("b: got (%t, %v), want (false, nil)", ran, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if ran, err := a.runTask(ctx, a.tasks[0], scheduled); !ran || err != nil {
+		t.Fatalf("a: got (%t, %v), want (true, nil)", ran, err)
+	}
+	if runs != 1 {
+		t.Errorf("got %d runs, want 1", runs)
+	}
+}
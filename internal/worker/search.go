@@ -0,0 +1,116 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// The worker indexes the text of CVEs, GHSAs and Go reports in the store, so
+// that triagers can search it. A CVE or GHSA is indexed when its record is
+// written, and the Go reports when they are reconciled.
+
+// stopWords are common English words that are not worth indexing.
+var stopWords = map[string]bool{
+	"an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"by": true, "can": true, "for": true, "from": true, "has": true,
+	"in": true, "is": true, "it": true, "its": true, "of": true, "on": true,
+	"or": true, "that": true, "the": true, "this": true, "to": true,
+	"was": true, "which": true, "with": true,
+}
+
+// searchTerms returns the terms of texts for the search index: their words,
+// in lower case, except for stop words and single characters.
+//
+// Words are split at spaces, punctuation and slashes, but not at dots,
+// hyphens or underscores inside them, so that a term can be the last
+// element of a module path, like "yaml.v2", or an identifier.
+func searchTerms(texts ...string) []string {
+	var terms []string
+	for _, text := range texts {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '.' && r != '-' && r != '_'
+		})
+		for _, w := range words {
+			w = strings.Trim(w, ".-_")
+			if len(w) < 2 || stopWords[w] {
+				continue
+			}
+			terms = append(terms, w)
+		}
+	}
+	return terms
+}
+
+// cveSearchTerms returns the search terms of cve.
+func cveSearchTerms(cve *cveschema.CVE) []string {
+	var texts []string
+	for _, d := range cve.Description.Data {
+		texts = append(texts, d.Value)
+	}
+	return searchTerms(texts...)
+}
+
+// ghsaSearchTerms returns the search terms of sa.
+func ghsaSearchTerms(sa *ghsa.SecurityAdvisory) []string {
+	texts := []string{sa.Summary, sa.Description}
+	for _, v := range sa.Vulns {
+		texts = append(texts, v.Package)
+	}
+	return searchTerms(texts...)
+}
+
+// goReportSearchTerms returns the search terms of r.
+func goReportSearchTerms(r *GoReport) []string {
+	texts := []string{r.Description}
+	for _, m := range r.Modules {
+		texts = append(texts, m.Path)
+	}
+	return searchTerms(texts...)
+}
+
+// indexGoReports sets the search terms of reports in st.
+func indexGoReports(ctx context.Context, st store.Store, reports []*GoReport) (err error) {
+	defer derrors.Wrap(&err, "indexGoReports")
+
+	for i := 0; i < len(reports); i += maxTransactionWrites {
+		j := i + maxTransactionWrites
+		if j > len(reports) {
+			j = len(reports)
+		}
+		err := st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+			for _, r := range reports[i:j] {
+				if err := tx.SetSearchTerms(r.ID, goReportSearchTerms(r)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Search returns the IDs of the CVEs, GHSAs and Go reports whose text has all
+// the words of query, in sorted order. If limit is positive, it returns at
+// most limit IDs.
+func Search(ctx context.Context, st store.Store, query string, limit int) (_ []string, err error) {
+	defer derrors.Wrap(&err, "Search(%q)", query)
+
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	return st.Search(ctx, terms, limit)
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/safehtml/template"
+	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestSearchTerms(t *testing.T) {
+	got := searchTerms("Deserialization of untrusted YAML in gopkg.in/yaml.v2 causes a panic.", "CVE-2022-28948")
+	want := []string{"deserialization", "untrusted", "yaml", "gopkg.in", "yaml.v2", "causes", "panic", "cve-2022-28948"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	reports := []*GoReport{
+		{
+			ID:          "GO-2022-0001",
+			Description: "Unmarshaling a document can cause a panic.",
+			Modules:     []*GoReportModule{{Path: "gopkg.in/yaml.v2"}},
+		},
+		{
+			ID:          "GO-2022-0002",
+			Description: "Deserialization of a crafted message can exhaust memory.",
+			Modules:     []*GoReportModule{{Path: "github.com/example/proto"}},
+		},
+	}
+	if err := indexGoReports(ctx, mstore, reports); err != nil {
+		t.Fatal(err)
+	}
+	sas := []*ghsa.SecurityAdvisory{{
+		ID:          "GHSA-aaaa-bbbb-cccc",
+		Summary:     "Deserialization panic in yaml.v2",
+		Description: "A crafted document panics during deserialization.",
+		Vulns:       []*ghsa.Vuln{{Package: "gopkg.in/yaml.v2"}},
+	}}
+	if _, _, err := updateGHSABatch(ctx, sas, mstore); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		query string
+		want  []string
+	}{
+		{"yaml.v2 deserialization", []string{"GHSA-aaaa-bbbb-cccc"}},
+		{"yaml.v2", []string{"GHSA-aaaa-bbbb-cccc", "GO-2022-0001"}},
+		{"Deserialization", []string{"GHSA-aaaa-bbbb-cccc", "GO-2022-0002"}},
+		{"panic", []string{"GHSA-aaaa-bbbb-cccc", "GO-2022-0001"}},
+		{"the", nil},
+	} {
+		got, err := Search(ctx, mstore, test.query, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(got, test.want) {
+			t.Errorf("Search(%q) = %v, want %v", test.query, got, test.want)
+		}
+	}
+
+	staticPath := template.TrustedSourceFromConstant("static")
+	tmpl, err := parseTemplate(staticPath, template.TrustedSourceFromConstant("search.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{cfg: Config{Store: mstore}, searchTemplate: tmpl}
+	w := httptest.NewRecorder()
+	if err := s.handleSearch(w, httptest.NewRequest("GET", "/search?q=yaml.v2", nil)); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<a href="https://github.com/advisories/GHSA-aaaa-bbbb-cccc">GHSA-aaaa-bbbb-cccc</a>`,
+		`<a href="/report/GO-2022-0001">GO-2022-0001</a>`,
+	} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Errorf("page does not contain %q", want)
+		}
+	}
+}
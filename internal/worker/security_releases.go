@@ -0,0 +1,289 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/exp/slices"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// releaseHistoryURL is the page of the Go release notes that summarizes each
+// minor release, including the packages that its security fixes are to.
+const releaseHistoryURL = "https://go.dev/doc/devel/release"
+
+// ReleaseHistoryFunc is the type of a function that returns the HTML of the
+// Go release history page.
+type ReleaseHistoryFunc func(ctx context.Context) ([]byte, error)
+
+// FetchReleaseHistory returns the HTML of the Go release history page.
+func FetchReleaseHistory(ctx context.Context) (_ []byte, err error) {
+	defer derrors.Wrap(&err, "FetchReleaseHistory")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, releaseHistoryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %s", releaseHistoryURL, res.Status)
+	}
+	return io.ReadAll(io.LimitReader(res.Body, 10<<20))
+}
+
+// securityReleasesSince is the date of the first release that the scan
+// checks. Earlier security releases were triaged before the scan existed, so
+// filing issues for them would only add noise.
+var securityReleasesSince = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// securityReleaseGracePeriod is how long after a release the scan waits
+// before filing an issue for its missing reports, to give the reports of its
+// fixes time to arrive through the usual channels.
+const securityReleaseGracePeriod = 7 * 24 * time.Hour
+
+// SecurityReleasesStats describes the result of a scan of the Go release
+// notes.
+type SecurityReleasesStats struct {
+	// Number of security releases checked.
+	NumChecked int
+	// Releases whose security fixes to some packages have no reports, in
+	// the order of the release history page.
+	Missing []*store.SecurityRelease
+	// Number of issues created for them.
+	NumIssues int
+}
+
+// ScanSecurityReleases checks the security fixes that the Go release notes
+// list against the reports in the vulndb repo at repoPath, which may be a URL
+// to clone or a local directory. A fix to a package in a release is covered
+// if a standard library or toolchain report lists the package and says that
+// it was fixed in the release; several fixes to the same package in a
+// release are covered by one report.
+//
+// Each security release is recorded in st. For a release that has fixes
+// without reports a week after it was made, an issue is created in ic, once.
+// If ic is nil, no issues are created.
+//
+// The stdlib vulnerabilities in the release notes usually also reach the
+// worker as CVEs, but not always in time; the scan catches those that don't.
+func ScanSecurityReleases(ctx context.Context, history ReleaseHistoryFunc, st store.Store, ic issues.Client, repoPath string) (_ SecurityReleasesStats, err error) {
+	defer derrors.Wrap(&err, "ScanSecurityReleases(%q)", repoPath)
+
+	reports, err := LoadGoReports(ctx, repoPath)
+	if err != nil {
+		return SecurityReleasesStats{}, err
+	}
+	page, err := history(ctx)
+	if err != nil {
+		return SecurityReleasesStats{}, err
+	}
+	return scanSecurityReleases(ctx, page, st, ic, reports, time.Now())
+}
+
+func scanSecurityReleases(ctx context.Context, page []byte, st store.Store, ic issues.Client, reports []*GoReport, now time.Time) (stats SecurityReleasesStats, err error) {
+	ctx = event.Start(ctx, "scanSecurityReleases")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "security-releases")
+	defer func() { end(err) }()
+
+	stored, err := st.ListSecurityReleases(ctx)
+	if err != nil {
+		return stats, err
+	}
+	old := map[string]*store.SecurityRelease{}
+	for _, r := range stored {
+		old[r.Release] = r
+	}
+	for _, r := range parseSecurityReleases(page) {
+		if r.ReleasedAt.Before(securityReleasesSince) {
+			continue
+		}
+		stats.NumChecked++
+		for _, p := range r.Packages {
+			if !reportsFix(reports, r.Release, p) {
+				r.Missing = append(r.Missing, p)
+			}
+		}
+		r.CheckedAt = now
+		if o := old[r.Release]; o != nil {
+			r.IssueReference = o.IssueReference
+			r.IssueCreatedAt = o.IssueCreatedAt
+		}
+		if len(r.Missing) > 0 {
+			stats.Missing = append(stats.Missing, r)
+			log.Infof(ctx, "%s has security fixes without reports: %s", r.Release, strings.Join(r.Missing, ", "))
+			if ic != nil && r.IssueReference == "" && now.Sub(r.ReleasedAt) >= securityReleaseGracePeriod {
+				ref, err := createSecurityReleaseIssue(ctx, r, ic)
+				if err != nil {
+					return stats, err
+				}
+				r.IssueReference = ref
+				r.IssueCreatedAt = now
+				stats.NumIssues++
+			}
+		}
+		if err := st.SetSecurityRelease(ctx, r); err != nil {
+			return stats, err
+		}
+	}
+	log.Infof(ctx, "security release scan succeeded: checked %d, %d with missing reports, created %d issues",
+		stats.NumChecked, len(stats.Missing), stats.NumIssues)
+	return stats, nil
+}
+
+// reportsFix reports whether one of reports says that the standard library
+// or toolchain package pkg was fixed in release, a tag like "go1.19.4".
+func reportsFix(reports []*GoReport, release, pkg string) bool {
+	version := releaseVersion(release)
+	for _, r := range reports {
+		if r.Excluded {
+			continue
+		}
+		for _, m := range r.Modules {
+			if m.Path != stdlib.ModulePath && m.Path != "cmd" {
+				continue
+			}
+			if !slices.Contains(m.Packages, pkg) {
+				continue
+			}
+			for _, vr := range m.Versions {
+				if string(vr.Fixed) == version {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// releaseVersion returns the version that reports use for release, a tag
+// like "go1.19" or "go1.19.4": "1.19.0" or "1.19.4".
+func releaseVersion(release string) string {
+	v := strings.TrimPrefix(release, "go")
+	if strings.Count(v, ".") == 1 {
+		v += ".0"
+	}
+	return v
+}
+
+func createSecurityReleaseIssue(ctx context.Context, r *store.SecurityRelease, ic issues.Client) (ref string, err error) {
+	defer derrors.Wrap(&err, "createSecurityReleaseIssue(%s)", r.Release)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "The [release notes](%s#%s) of %s, released %s, list security fixes to these packages, but no report says that they were fixed in %[3]s:\n",
+		releaseHistoryURL, releaseMinor(r.Release), r.Release, r.ReleasedAt.Format("2006-01-02"))
+	for _, p := range r.Missing {
+		fmt.Fprintf(&b, "- %s\n", p)
+	}
+	fmt.Fprintf(&b, "\nSee the [%s milestone](https://github.com/golang/go/issues?q=milestone%%3A%s+label%%3ASecurity) for the issues of the fixes. ",
+		r.Release, strings.Replace(r.Release, "go", "Go", 1))
+	b.WriteString("Write reports for them, or add the fixed versions to the reports that cover them.\n")
+	iss := &issues.Issue{
+		Title: fmt.Sprintf("x/vulndb: potential Go vuln in %s: security fixes in %s", strings.Join(r.Missing, ", "), r.Release),
+		Body:  b.String(),
+	}
+	if err := issueRateLimiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	num, err := ic.CreateIssue(ctx, iss)
+	if err != nil {
+		return "", err
+	}
+	ref = ic.Reference(num)
+	log.Infof(ctx, "created issue %s for %s", ref, r.Release)
+	return ref, nil
+}
+
+// releaseMinor returns the anchor of the release history page for the
+// minor release of release, like "go1.19.minor".
+func releaseMinor(release string) string {
+	parts := strings.SplitN(release, ".", 3)
+	if len(parts) < 2 {
+		return release
+	}
+	return parts[0] + "." + parts[1] + ".minor"
+}
+
+var (
+	htmlTagRegexp    = regexp.MustCompile(`<[^>]*>`)
+	whitespaceRegexp = regexp.MustCompile(`\s+`)
+	// releaseRegexp matches the start of the summary of a release, like
+	// "go1.19.4 (released 2022-12-06)".
+	releaseRegexp = regexp.MustCompile(`\b(go1\.\d+(?:\.\d+)?) \(released (\d{4}-\d{2}-\d{2})\)`)
+	// securityFixRegexp matches the list of packages in a summary like
+	// "includes security fixes to the net/http and os packages, as well as
+	// bug fixes to ...". The list ends at "as well as" or the end of the
+	// sentence.
+	securityFixRegexp = regexp.MustCompile(`security fix(?:es)? to (.+?)(?:,? as well as|\.(?:\s|$)|$)`)
+	// packageListRegexp separates the elements of a list like "a, b, and c".
+	packageListRegexp = regexp.MustCompile(`,\s*and\s+|,\s*|\s+and\s+`)
+)
+
+// toolNames are the names that the release notes use for some tools, and the
+// import paths of their packages.
+var toolNames = map[string]string{
+	"go command": "cmd/go",
+	"compiler":   "cmd/compile",
+	"linker":     "cmd/link",
+	"cgo":        "cmd/cgo",
+}
+
+// parseSecurityReleases returns the releases on the Go release history page
+// whose summaries list security fixes, with the packages that the fixes are
+// to, in the order of the page. Their other fields are not set.
+func parseSecurityReleases(page []byte) []*store.SecurityRelease {
+	text := html.UnescapeString(htmlTagRegexp.ReplaceAllString(string(page), " "))
+	text = whitespaceRegexp.ReplaceAllString(text, " ")
+	// Undo the space that replacing a tag left before punctuation, as in
+	// "<code>net/http</code>,".
+	text = strings.NewReplacer(" ,", ",", " .", ".").Replace(text)
+	var rs []*store.SecurityRelease
+	locs := releaseRegexp.FindAllStringSubmatchIndex(text, -1)
+	for i, loc := range locs {
+		end := len(text)
+		if i+1 < len(locs) {
+			end = locs[i+1][0]
+		}
+		m := securityFixRegexp.FindStringSubmatch(text[loc[1]:end])
+		if m == nil {
+			continue
+		}
+		released, err := time.Parse("2006-01-02", text[loc[4]:loc[5]])
+		if err != nil {
+			continue
+		}
+		r := &store.SecurityRelease{Release: text[loc[2]:loc[3]], ReleasedAt: released}
+		list := strings.TrimSpace(m[1])
+		list = strings.TrimSuffix(strings.TrimSuffix(list, " packages"), " package")
+		for _, p := range packageListRegexp.Split(list, -1) {
+			p = strings.TrimPrefix(strings.TrimSpace(p), "the ")
+			if t, ok := toolNames[p]; ok {
+				p = t
+			}
+			if p != "" && !slices.Contains(r.Packages, p) {
+				r.Packages = append(r.Packages, p)
+			}
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+const releaseHistory = `
+<h3 id="go1.19">go1.19 (released 2022-08-02)</h3>
+<p>Go 1.19 is a major release of Go. Read the <a href="/doc/go1.19">Go 1.19 Release Notes</a> for more information.</p>
+<h4 id="go1.19.minor">Minor revisions</h4>
+<p>
+go1.19.1 (released 2022-09-06) includes security fixes to the <code>net/http</code> and
+<code>net/url</code> packages, as well as bug fixes to the compiler, the go command, and the
+<code>net/http</code> package.
+</p>
+<p>
+go1.19.2 (released 2022-10-04) includes security fixes to the <code>archive/tar</code>,
+<code>net/http/httputil</code>, and <code>regexp</code> packages.
+</p>
+<p>
+go1.19.3 (released 2022-11-01) includes security fixes to the go command and the
+<code>os/exec</code> package, as well as bug fixes to the runtime.
+</p>
+<p>
+go1.19.4 (released 2022-12-06) includes a security fix to the <code>net/http</code> package,
+as well as bug fixes to the compiler.
+</p>
+<p>
+go1.19.5 (released 2023-01-10) includes fixes to the compiler and the linker.
+</p>
+<h3 id="go1.16">go1.16 (released 2021-02-16)</h3>
+<p>
+go1.16.1 (released 2021-03-10) includes security fixes to the <code>archive/zip</code> and
+<code>encoding/xml</code> packages.
+</p>
+`
+
+func TestParseSecurityReleases(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	got := parseSecurityReleases([]byte(releaseHistory))
+	want := []*store.SecurityRelease{
+		{Release: "go1.19.1", ReleasedAt: date("2022-09-06"), Packages: []string{"net/http", "net/url"}},
+		{Release: "go1.19.2", ReleasedAt: date("2022-10-04"), Packages: []string{"archive/tar", "net/http/httputil", "regexp"}},
+		{Release: "go1.19.3", ReleasedAt: date("2022-11-01"), Packages: []string{"cmd/go", "os/exec"}},
+		{Release: "go1.19.4", ReleasedAt: date("2022-12-06"), Packages: []string{"net/http"}},
+		{Release: "go1.16.1", ReleasedAt: date("2021-03-10"), Packages: []string{"archive/zip", "encoding/xml"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestScanSecurityReleases(t *testing.T) {
+	ctx := context.Background()
+	reports := []*GoReport{
+		{
+			ID: "GO-2022-0001",
+			Modules: []*GoReportModule{{
+				Path:     "std",
+				Packages: []string{"net/http"},
+				Versions: []report.VersionRange{{Fixed: "1.18.6"}, {Introduced: "1.19.0", Fixed: "1.19.1"}},
+			}},
+		},
+		{
+			ID: "GO-2022-0002",
+			Modules: []*GoReportModule{{
+				Path:     "std",
+				Packages: []string{"net/url"},
+				Versions: []report.VersionRange{{Introduced: "1.19.0", Fixed: "1.19.1"}},
+			}},
+		},
+		{
+			ID: "GO-2022-0003",
+			Modules: []*GoReportModule{
+				{Path: "std", Packages: []string{"archive/tar"}, Versions: []report.VersionRange{{Fixed: "1.19.2"}}},
+				{Path: "std", Packages: []string{"regexp"}, Versions: []report.VersionRange{{Fixed: "1.19.2"}}},
+			},
+		},
+		{
+			ID: "GO-2022-0004",
+			Modules: []*GoReportModule{{
+				Path:     "cmd",
+				Packages: []string{"cmd/go"},
+				Versions: []report.VersionRange{{Fixed: "1.19.3"}},
+			}},
+		},
+		// Excluded reports don't count.
+		{
+			ID:       "GO-2022-0005",
+			Excluded: true,
+			Modules: []*GoReportModule{{
+				Path:     "std",
+				Packages: []string{"os/exec"},
+				Versions: []report.VersionRange{{Fixed: "1.19.3"}},
+			}},
+		},
+	}
+	mstore := store.NewMemStore()
+	ic := issues.NewDryRunClient(issues.NewFakeClient())
+	// go1.19.4 was released a day ago, so its issue waits.
+	now := time.Date(2022, 12, 7, 0, 0, 0, 0, time.UTC)
+	stats, err := scanSecurityReleases(ctx, []byte(releaseHistory), mstore, ic, reports, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotMissing []string
+	for _, r := range stats.Missing {
+		gotMissing = append(gotMissing, r.Release+": "+strings.Join(r.Missing, ", "))
+	}
+	wantMissing := []string{"go1.19.2: net/http/httputil", "go1.19.3: os/exec", "go1.19.4: net/http"}
+	if diff := cmp.Diff(wantMissing, gotMissing); diff != "" {
+		t.Errorf("missing mismatch (-want, +got):\n%s", diff)
+	}
+	if stats.NumChecked != 4 || stats.NumIssues != 2 {
+		t.Errorf("got %d checked, %d issues; want 4, 2", stats.NumChecked, stats.NumIssues)
+	}
+	created := ic.Created()
+	if len(created) != 2 {
+		t.Fatalf("got %d issues, want 2", len(created))
+	}
+	if want := "x/vulndb: potential Go vuln in net/http/httputil: security fixes in go1.19.2"; created[0].Title != want {
+		t.Errorf("got title %q, want %q", created[0].Title, want)
+	}
+	if want := "- os/exec\n"; !strings.Contains(created[1].Body, want) {
+		t.Errorf("got body\n%s\nwant it to contain %q", created[1].Body, want)
+	}
+
+	srs, err := mstore.ListSecurityReleases(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hasIssue := map[string]bool{}
+	for _, sr := range srs {
+		hasIssue[sr.Release] = sr.IssueReference != ""
+	}
+	wantHasIssue := map[string]bool{
+		"go1.19.1": false,
+		"go1.19.2": true,
+		"go1.19.3": true,
+		"go1.19.4": false,
+	}
+	if diff := cmp.Diff(wantHasIssue, hasIssue); diff != "" {
+		t.Errorf("stored issues mismatch (-want, +got):\n%s", diff)
+	}
+
+	// A week later, the issue for go1.19.4 is created, but not those for
+	// the others again.
+	stats, err = scanSecurityReleases(ctx, []byte(releaseHistory), mstore, ic, reports, now.Add(7*24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.NumIssues != 1 || len(ic.Created()) != 3 {
+		t.Errorf("second scan: got %d new issues, %d in all; want 1, 3", stats.NumIssues, len(ic.Created()))
+	}
+}
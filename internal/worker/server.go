@@ -7,6 +7,7 @@ package worker
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -14,6 +15,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -24,11 +26,17 @@ import (
 	"golang.org/x/exp/event"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/vulndb/internal/cvelistrepo"
+	"golang.org/x/vulndb/internal/cvss"
+	"golang.org/x/vulndb/internal/depsdev"
 	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/epss"
 	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/gitlab"
 	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/nvd"
 	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/osvdev"
 	"golang.org/x/vulndb/internal/worker/log"
 	"golang.org/x/vulndb/internal/worker/store"
 )
@@ -41,10 +49,25 @@ const (
 var staticPath = template.TrustedSourceFromConstant("internal/worker/static")
 
 type Server struct {
-	cfg           Config
-	indexTemplate *template.Template
-	issueClient   issues.Client
-	observer      *observe.Observer
+	cfg            Config
+	indexTemplate  *template.Template
+	triageTemplate *template.Template
+	cveTemplate    *template.Template
+	reportTemplate *template.Template
+	searchTemplate *template.Template
+	issueClient    issues.Client
+	ghsaClient     *ghsa.Client
+	observer       *observe.Observer
+	notifier       Notifier // nil if there are no webhooks
+	// owner is the owner of the locks that this replica takes.
+	owner string
+	// jobList holds the jobs that the server runs on schedules and on
+	// request.
+	jobList []*job
+	// inFlight tracks the runs of jobs in progress, for Shutdown.
+	inFlight inFlight
+	// stopScheduler stops the scheduler, if it is running.
+	stopScheduler context.CancelFunc
 }
 
 const traceIDHeader = "X-Cloud-Trace-Context"
@@ -52,9 +75,19 @@ const traceIDHeader = "X-Cloud-Trace-Context"
 func NewServer(ctx context.Context, cfg Config) (_ *Server, err error) {
 	defer derrors.Wrap(&err, "NewServer(%q)", cfg.Namespace)
 
-	s := &Server{cfg: cfg}
+	s := &Server{cfg: cfg, notifier: newNotifier(cfg)}
+	s.cfg.Store = store.WithMetrics(cfg.Store)
 
-	s.observer, err = observe.NewObserver(ctx, cfg.Project, serverName)
+	// Fail at startup, rather than at the first update, if the
+	// false-positives or triage paths file is invalid.
+	if _, err := loadFalsePositives(); err != nil {
+		return nil, err
+	}
+	if _, err := loadTriagePaths(); err != nil {
+		return nil, err
+	}
+
+	s.observer, err = observe.NewObserver(ctx, cfg.Project, serverName, cfg.OTLPEndpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +111,7 @@ func NewServer(ctx context.Context, cfg Config) (_ *Server, err error) {
 		}
 		derrors.SetReportingClient(reportingClient)
 	}
+	s.ghsaClient = ghsa.NewClient(ctx, cfg.GitHubAccessToken, nil)
 	if cfg.IssueRepo != "" {
 		owner, repoName, err := gitrepo.ParseGitHubRepo(cfg.IssueRepo)
 		if err != nil {
@@ -93,7 +127,42 @@ func NewServer(ctx context.Context, cfg Config) (_ *Server, err error) {
 	if err != nil {
 		return nil, err
 	}
+	s.triageTemplate, err = parseTemplate(staticPath, template.TrustedSourceFromConstant("triage.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	s.cveTemplate, err = parseTemplate(staticPath, template.TrustedSourceFromConstant("cve.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	s.reportTemplate, err = parseTemplate(staticPath, template.TrustedSourceFromConstant("report.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	s.searchTemplate, err = parseTemplate(staticPath, template.TrustedSourceFromConstant("search.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	s.owner, err = replicaOwner()
+	if err != nil {
+		return nil, err
+	}
+	s.jobList = s.jobs()
+	if err := s.startScheduler(ctx); err != nil {
+		return nil, err
+	}
+
 	s.handle(ctx, "/", s.indexPage)
+	// triage: Show CVEs grouped by triage state.
+	s.handle(ctx, "/triage", s.handleTriage)
+	// cve/ID: Show a CVE record, with forms to change its triage state.
+	s.handle(ctx, "/cve/", s.handleCVE)
+	// report/ID: Show a report of the vulndb repo, with links to the reports
+	// it supersedes, was split from, or is related to, and back.
+	s.handle(ctx, "/report/", s.handleReport)
+	// search?q=WORDS: List the CVEs, GHSAs and Go reports whose text has
+	// all the words.
+	s.handle(ctx, "/search", s.handleSearch)
 	http.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.Dir(staticPath.String()))))
 	s.handle(ctx, "/favicon.ico", func(w http.ResponseWriter, r *http.Request) error {
 		http.ServeFile(w, r, filepath.Join(staticPath.String(), "favicon.ico"))
@@ -107,15 +176,134 @@ func NewServer(ctx context.Context, cfg Config) (_ *Server, err error) {
 	s.handle(ctx, "/issues", s.handleIssues)
 	// update-and-issues: do update followed by issues.
 	s.handle(ctx, "/update-and-issues", s.handleUpdateAndIssues)
+	// update-ghsas: Update the DB from the GitHub security advisories
+	// changed since the last such update.
+	s.handle(ctx, "/update-ghsas", s.handleUpdateGHSAs)
+	// update-nvd: Update the DB from the CVEs modified in the NVD since the
+	// last such update, instead of from the cvelist repo.
+	s.handle(ctx, "/update-nvd", s.handleUpdateNVD)
+	// update-gitlab: Update the DB from the Go advisories of the GitLab
+	// Advisory Database.
+	s.handle(ctx, "/update-gitlab", s.handleUpdateGitLab)
+	// update-epss: Set the EPSS scores of the CVEs that need issues, from the
+	// FIRST API.
+	s.handle(ctx, "/update-epss", s.handleUpdateEPSS)
+	// update-importers: Set the number of importers of the modules of the
+	// CVEs that need issues, from deps.dev.
+	s.handle(ctx, "/update-importers", s.handleUpdateImporters)
+	// update-osv: Record the advisories in OSV.dev for the CVEs that need
+	// issues, and mark those covered by Go reports.
+	s.handle(ctx, "/update-osv", s.handleUpdateOSV)
+	// reconcile: Cross-check the records that need or have issues with
+	// their issues and the reports in the vulndb repo, and move those that
+	// reports cover to HasVuln.
+	s.handle(ctx, "/reconcile", s.handleReconcile)
+	// report-gaps: List the aliases and references that the advisories of
+	// other databases in OSV.dev have, but the reports in the vulndb repo
+	// don't, and send them as a notification.
+	s.handle(ctx, "/report-gaps", s.handleReportGaps)
+	// jobs/NAME: Run the job NAME, such as update or issues, for an
+	// external scheduler, once for the Idempotency-Key header, if any.
+	s.handle(ctx, "/jobs/", s.handleJob)
+	// pubsub: Run the job named by a message from a Pub/Sub push
+	// subscription, once for the message ID.
+	s.handle(ctx, "/pubsub", s.handlePubSub)
+	// check-depsdev: Check the reports in the vulndb repo against the
+	// advisories that deps.dev has for their modules, and replace the
+	// discrepancies in the store, which the dashboard shows.
+	s.handle(ctx, "/check-depsdev", s.handleCheckDepsDev)
+	// security-releases: Check the security fixes that the Go release notes
+	// list against the reports in the vulndb repo, and create issues for
+	// those without reports.
+	s.handle(ctx, "/security-releases", s.handleSecurityReleases)
+	// watch-unfixed: Check the modules of reports without fixed versions
+	// for new versions in the module proxy, and flag those that may fix
+	// them for review.
+	s.handle(ctx, "/watch-unfixed", s.handleWatchUnfixed)
+	// withdrawals: Flag the reports and issues of CVEs that were rejected
+	// or disputed upstream for withdrawal review.
+	s.handle(ctx, "/withdrawals", s.handleWithdrawals)
+	// embargoes: Regenerate the database built with the overlay repo of
+	// private reports if the embargo of one of them has ended, to publish
+	// it.
+	s.handle(ctx, "/embargoes", s.handleEmbargoes)
+	// triage-aging: Record how long the CVEs and GHSAs have been waiting for
+	// issues, and for reports, as metrics, and send a digest of them as a
+	// notification if the digest form value is true.
+	s.handle(ctx, "/triage-aging", s.handleTriageAging)
+	// triage-summary: Post a summary of the last week's triage as a comment
+	// on the summary issue.
+	s.handle(ctx, "/triage-summary", s.handleTriageSummary)
+	// cves: List the CVE records matching the query params, as JSON.
+	s.handle(ctx, "/cves", s.handleCVEs)
+	// triage-history: Display the changes to the triage state of the CVE or
+	// GHSA given by the id query param, as JSON.
+	s.handle(ctx, "/triage-history", s.handleTriageHistory)
+	// aliases: Display the IDs of the vulnerability given by the id query
+	// param, as JSON in the form of an OSV entry's id and aliases.
+	s.handle(ctx, "/aliases", s.handleAliases)
+	// status: Display the status of the updates from each source, as JSON.
+	s.handle(ctx, "/status", s.handleStatus)
 	// scan-repos: scan various modules for vulnerabilities
 	s.handle(ctx, "/scan-modules", s.handleScanModules)
+	// metrics: Serve the metrics of updates, triage, the store and the GitHub
+	// APIs in the Prometheus text format.
+	http.Handle("/metrics", s.observer.MetricsHandler())
+	// debug/log-level: Display the lowest level of messages that are logged,
+	// or set it from the level form value with a POST.
+	s.handle(ctx, "/debug/log-level", s.handleLogLevel)
 	return s, nil
 }
 
+// startScheduler starts running the jobs that the config schedules, if any.
+func (s *Server) startScheduler(ctx context.Context) error {
+	schedules := map[string]string{
+		"update":            s.cfg.UpdateSchedule,
+		"update-ghsas":      s.cfg.GHSASchedule,
+		"issues":            s.cfg.IssueSchedule,
+		"reconcile":         s.cfg.ReconcileSchedule,
+		"report-gaps":       s.cfg.ReportGapsSchedule,
+		"check-depsdev":     s.cfg.DepsDevSchedule,
+		"security-releases": s.cfg.SecurityReleasesSchedule,
+		"watch-unfixed":     s.cfg.UnfixedSchedule,
+		"withdrawals":       s.cfg.WithdrawalSchedule,
+		"embargoes":         s.cfg.EmbargoSchedule,
+		"triage-aging":      s.cfg.AgingSchedule,
+		"triage-digest":     s.cfg.DigestSchedule,
+		"triage-summary":    s.cfg.SummarySchedule,
+	}
+	sched := NewScheduler(s.cfg.Store, s.owner)
+	for _, j := range s.jobList {
+		spec := schedules[j.name]
+		if spec == "" {
+			continue
+		}
+		if err := sched.Add(j.name, spec, s.runFunc(j)); err != nil {
+			return err
+		}
+		log.Infof(ctx, "scheduled task %s at %q", j.name, spec)
+	}
+	if len(sched.tasks) == 0 {
+		return nil
+	}
+	// Export the tasks' metrics and traces, as for requests.
+	ctx = s.observer.WithExporter(ctx, log.NewLineHandler(os.Stderr))
+	if s.notifier != nil {
+		ctx = WithNotifier(ctx, s.notifier)
+	}
+	ctx, s.stopScheduler = context.WithCancel(ctx)
+	go sched.Run(ctx)
+	return nil
+}
+
 func (s *Server) handle(_ context.Context, pattern string, hfunc func(w http.ResponseWriter, r *http.Request) error) {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 		ctx := r.Context()
+		if s.notifier != nil {
+			ctx = WithNotifier(ctx, s.notifier)
+			r = r.WithContext(ctx)
+		}
 		log.With("httpRequest", r).Infof(ctx, "starting %s", r.URL.Path)
 
 		w2 := &responseWriter{ResponseWriter: w}
@@ -142,7 +330,12 @@ func (s *serverError) Error() string {
 func (s *Server) serveError(ctx context.Context, w http.ResponseWriter, _ *http.Request, err error) {
 	serr, ok := err.(*serverError)
 	if !ok {
-		serr = &serverError{status: http.StatusInternalServerError, err: err}
+		status := http.StatusInternalServerError
+		if lerr := new(LeaseHeldError); errors.As(err, &lerr) {
+			// The work is running elsewhere; a retry may succeed.
+			status = http.StatusConflict
+		}
+		serr = &serverError{status: status, err: err}
 	}
 	if serr.status == http.StatusInternalServerError {
 		log.Errorf(ctx, serr.err.Error())
@@ -177,6 +370,8 @@ func parseTemplate(staticPath, filename template.TrustedSource) (*template.Templ
 	templatePath := template.TrustedSourceJoin(staticPath, filename)
 	return template.New(filename.String()).Funcs(template.FuncMap{
 		"timefmt":  FormatTime,
+		"severity": formatSeverity,
+		"epssfmt":  formatEPSS,
 		"commasep": func(s []string) string { return strings.Join(s, ", ") },
 	}).ParseFilesFromTrustedSources(templatePath)
 }
@@ -199,6 +394,25 @@ func FormatTime(t time.Time) string {
 	return t.In(locNewYork).Format("2006-01-02 15:04:05")
 }
 
+// formatSeverity returns the base score and severity of a CVSS v3 vector,
+// like "9.8 Critical", or "-" if there is no valid vector.
+func formatSeverity(vector string) string {
+	score, severity, err := cvss.Score(vector)
+	if err != nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f %s", score, severity)
+}
+
+// formatEPSS formats an EPSS probability or percentile as a percentage, or
+// "-" if it is not set.
+func formatEPSS(p float64) string {
+	if p == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f%%", p*100)
+}
+
 func renderPage(ctx context.Context, w http.ResponseWriter, page interface{}, tmpl *template.Template) (err error) {
 	defer derrors.Wrap(&err, "renderPage")
 
@@ -214,20 +428,35 @@ func renderPage(ctx context.Context, w http.ResponseWriter, page interface{}, tm
 }
 
 type indexPage struct {
-	BuildInfo        string
-	CVEListRepoURL   string
-	Namespace        string
-	Updates          []*store.CommitUpdateRecord
-	CVEsNeedingIssue []*store.CVERecord
-	CVEsUpdatedSince []*store.CVERecord
-	ModuleScans      []*store.ModuleScanRecord
+	BuildInfo         string
+	CVEListRepoURL    string
+	Namespace         string
+	QuarantineAfter   int
+	Updates           []*store.CommitUpdateRecord
+	CVEsNeedingIssue  []*store.CVERecord
+	CVEsUpdatedSince  []*store.CVERecord
+	CVEsChanged       []*store.CVERecord
+	GHSAsNeedingIssue []*store.GHSARecord
+	ModuleScans       []*store.ModuleScanRecord
+	Discrepancies     []*store.DepsDevDiscrepancy
+	QuarantinedFiles  []*store.QuarantinedFile
+	FlaggedUnfixed    []*store.UnfixedModule
+	PendingReviews    []*store.ReportReview
+
+	// If true, there are more CVE records than shown.
+	MoreCVEsNeedingIssue, MoreCVEsUpdatedSince bool
 }
 
+// indexPageCVELimit is the maximum number of CVE records in each list on the
+// index page.
+const indexPageCVELimit = 100
+
 func (s *Server) indexPage(w http.ResponseWriter, r *http.Request) error {
 
 	var page = indexPage{
-		CVEListRepoURL: cvelistrepo.URL,
-		Namespace:      s.cfg.Namespace,
+		CVEListRepoURL:  cvelistrepo.URL,
+		Namespace:       s.cfg.Namespace,
+		QuarantineAfter: quarantineAfter,
 	}
 
 	buildInfo, ok := debug.ReadBuildInfo()
@@ -256,20 +485,80 @@ func (s *Server) indexPage(w http.ResponseWriter, r *http.Request) error {
 		return err
 	})
 	g.Go(func() error {
-		var err error
-		page.CVEsNeedingIssue, err = s.cfg.Store.ListCVERecordsWithTriageState(ctx, store.TriageStateNeedsIssue)
+		crs, next, err := s.cfg.Store.ListCVERecords(ctx, store.CVERecordQuery{
+			TriageState: store.TriageStateNeedsIssue,
+			Limit:       indexPageCVELimit,
+		})
+		page.CVEsNeedingIssue, page.MoreCVEsNeedingIssue = crs, next != ""
+		return err
+	})
+	g.Go(func() error {
+		crs, next, err := s.cfg.Store.ListCVERecords(ctx, store.CVERecordQuery{
+			TriageState: store.TriageStateUpdatedSinceIssueCreation,
+			Limit:       indexPageCVELimit,
+		})
+		page.CVEsUpdatedSince, page.MoreCVEsUpdatedSince = crs, next != ""
 		return err
 	})
 	g.Go(func() error {
 		var err error
-		page.CVEsUpdatedSince, err = s.cfg.Store.ListCVERecordsWithTriageState(ctx, store.TriageStateUpdatedSinceIssueCreation)
+		page.CVEsChanged, err = ChangedCVEs(ctx, s.cfg.Store)
 		return err
 	})
+	g.Go(func() error {
+		grs, err := getGHSARecords(ctx, s.cfg.Store)
+		if err != nil {
+			return err
+		}
+		for _, gr := range grs {
+			if gr.TriageState == store.TriageStateNeedsIssue {
+				page.GHSAsNeedingIssue = append(page.GHSAsNeedingIssue, gr)
+			}
+		}
+		sort.Slice(page.GHSAsNeedingIssue, func(i, j int) bool {
+			return page.GHSAsNeedingIssue[i].GHSA.ID < page.GHSAsNeedingIssue[j].GHSA.ID
+		})
+		return nil
+	})
 	g.Go(func() error {
 		var err error
 		page.ModuleScans, err = s.cfg.Store.ListModuleScanRecords(ctx, 300)
 		return err
 	})
+	g.Go(func() error {
+		var err error
+		page.Discrepancies, err = s.cfg.Store.ListDepsDevDiscrepancies(ctx)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		page.QuarantinedFiles, err = s.cfg.Store.ListQuarantinedFiles(ctx)
+		return err
+	})
+	g.Go(func() error {
+		us, err := s.cfg.Store.ListUnfixedModules(ctx)
+		if err != nil {
+			return err
+		}
+		for _, u := range us {
+			if u.FlaggedVersion != "" {
+				page.FlaggedUnfixed = append(page.FlaggedUnfixed, u)
+			}
+		}
+		return nil
+	})
+	g.Go(func() error {
+		rs, err := s.cfg.Store.ListReportReviews(ctx)
+		if err != nil {
+			return err
+		}
+		for _, r := range rs {
+			if r.State != store.ReviewStateApproved {
+				page.PendingReviews = append(page.PendingReviews, r)
+			}
+		}
+		return nil
+	})
 	if err := g.Wait(); err != nil {
 		return err
 	}
@@ -281,11 +570,29 @@ const metricNamespace = "vulndb/worker"
 var updateCounter = event.NewCounter("updates", &event.MetricOptions{Namespace: metricNamespace})
 
 func (s *Server) handleUpdate(w http.ResponseWriter, r *http.Request) error {
-	err := s.doUpdate(r)
-	if err == nil {
-		fmt.Fprintf(w, "Update succeeded.\n")
+	if err := s.doUpdate(r); err != nil {
+		return err
 	}
-	return err
+	fmt.Fprintf(w, "Update succeeded.\n")
+	return s.autoCreateIssues(w, r)
+}
+
+// defaultIssueLimit is the number of issues created by a request, unless the
+// request asks for a different number.
+const defaultIssueLimit = 10
+
+// autoCreateIssues creates issues for CVEs and GHSAs that need them, if the
+// server is configured to do so after updates.
+func (s *Server) autoCreateIssues(w http.ResponseWriter, r *http.Request) error {
+	if !s.cfg.AutoCreateIssues || s.issueClient == nil {
+		return nil
+	}
+	log.With("limit", defaultIssueLimit).Infof(r.Context(), "creating issues after update")
+	if err := s.createIssues(r.Context(), defaultIssueLimit); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Issue creation succeeded.\n")
+	return nil
 }
 
 func (s *Server) doUpdate(r *http.Request) (err error) {
@@ -301,7 +608,7 @@ func (s *Server) doUpdate(r *http.Request) (err error) {
 		}
 	}
 	force := (r.FormValue("force") == "true")
-	err = UpdateCVEsAtCommit(r.Context(), cvelistrepo.URL, "HEAD", s.cfg.Store, pkgsiteURL, force)
+	err = UpdateCVEsAtCommit(r.Context(), s.cfg.cveListRepoURL(), "HEAD", s.cfg.Store, pkgsiteURL, force)
 	if cerr := new(CheckUpdateError); errors.As(err, &cerr) {
 		return &serverError{
 			status: http.StatusPreconditionFailed,
@@ -311,14 +618,359 @@ func (s *Server) doUpdate(r *http.Request) (err error) {
 	if err != nil {
 		return err
 	}
-	listSAs := func(ctx context.Context, since time.Time) ([]*ghsa.SecurityAdvisory, error) {
-		return ghsa.List(ctx, s.cfg.GitHubAccessToken, since)
-	}
-	_, err = UpdateGHSAs(r.Context(), listSAs, s.cfg.Store)
+	_, err = UpdateGHSAs(r.Context(), s.ghsaClient.List, s.cfg.Store)
 	return err
 
 }
 
+func (s *Server) handleUpdateGHSAs(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	stats, err := UpdateGHSAs(r.Context(), s.ghsaClient.List, s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "GHSA update succeeded: %+v\n", stats)
+	return s.autoCreateIssues(w, r)
+}
+
+func (s *Server) handleUpdateNVD(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	// The start time is needed only for the first update.
+	var start time.Time
+	if s := r.FormValue("start"); s != "" {
+		var err error
+		start, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return &serverError{status: http.StatusBadRequest, err: err}
+		}
+	}
+	client := nvd.NewClient(nvd.DefaultBaseURL, s.cfg.NVDAPIKey)
+	stats, err := UpdateCVEsFromNVD(r.Context(), client.ListModified, s.cfg.Store, pkgsiteURL, start)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "NVD update succeeded: %+v\n", stats)
+	return s.autoCreateIssues(w, r)
+}
+
+func (s *Server) handleUpdateGitLab(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	stats, err := UpdateFromGitLab(r.Context(), gitlab.URL, s.cfg.Store, pkgsiteURL)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "GitLab update succeeded: %+v\n", stats)
+	return s.autoCreateIssues(w, r)
+}
+
+func (s *Server) handleUpdateEPSS(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	client := epss.NewClient(epss.DefaultBaseURL)
+	stats, err := UpdateEPSS(r.Context(), client.Scores, s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "EPSS update succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleUpdateImporters(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	client := depsdev.NewClient(depsdev.DefaultBaseURL)
+	stats, err := UpdateImporters(r.Context(), client.Dependents, s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "importers update succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleUpdateOSV(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	client := osvdev.NewClient(osvdev.DefaultBaseURL)
+	stats, err := UpdateOSV(r.Context(), client.Advisories, s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "OSV update succeeded: %+v\n", stats)
+	return nil
+}
+
+func (s *Server) handleReconcile(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	if s.issueClient == nil {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("reconciliation needs an issue repo"),
+		}
+	}
+	if s.cfg.VulnDBRepo == "" {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("reconciliation needs a vulndb repo"),
+		}
+	}
+	stats, err := Reconcile(r.Context(), s.cfg.Store, s.issueClient, s.cfg.VulnDBRepo)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "reconciliation succeeded: checked %d, fixed %d\n", stats.NumChecked, stats.NumFixed)
+	for _, o := range stats.Orphans {
+		fmt.Fprintf(w, "orphan: %s\n", o)
+	}
+	return nil
+}
+
+func (s *Server) handleReportGaps(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	if s.cfg.VulnDBRepo == "" {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("the report gaps search needs a vulndb repo"),
+		}
+	}
+	client := osvdev.NewClient(osvdev.DefaultBaseURL)
+	stats, err := FindReportGaps(r.Context(), client.Vuln, s.cfg.VulnDBRepo)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "report gaps search succeeded: checked %d, %d with gaps\n", stats.NumChecked, len(stats.Gaps))
+	for _, g := range stats.Gaps {
+		fmt.Fprintf(w, "%s\n", g)
+	}
+	return nil
+}
+
+func (s *Server) handleCheckDepsDev(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	if s.cfg.VulnDBRepo == "" {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("the deps.dev check needs a vulndb repo"),
+		}
+	}
+	stats, err := CheckDepsDev(r.Context(), depsdev.NewClient(depsdev.DefaultBaseURL), s.cfg.VulnDBRepo, s.cfg.Store)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "deps.dev check succeeded: checked %d modules, %d versions; %d discrepancies\n",
+		stats.NumModules, stats.NumVersions, len(stats.Discrepancies))
+	return nil
+}
+
+func (s *Server) handleSecurityReleases(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	if s.cfg.VulnDBRepo == "" {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("the security release scan needs a vulndb repo"),
+		}
+	}
+	stats, err := ScanSecurityReleases(r.Context(), FetchReleaseHistory, s.cfg.Store, s.issueClient, s.cfg.VulnDBRepo)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "security release scan succeeded: checked %d, %d with missing reports, created %d issues\n",
+		stats.NumChecked, len(stats.Missing), stats.NumIssues)
+	for _, sr := range stats.Missing {
+		fmt.Fprintf(w, "%s: %s\n", sr.Release, strings.Join(sr.Missing, ", "))
+	}
+	return nil
+}
+
+func (s *Server) handleWatchUnfixed(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	if s.cfg.VulnDBRepo == "" {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("the unfixed module check needs a vulndb repo"),
+		}
+	}
+	stats, err := WatchUnfixedModules(r.Context(), ProxyLatestVersion, s.cfg.Store, s.issueClient, s.cfg.VulnDBRepo)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "unfixed module check succeeded: checked %d, flagged %d, created %d issues and %d comments\n",
+		stats.NumChecked, len(stats.Flagged), stats.NumIssues, stats.NumComments)
+	for _, u := range stats.Flagged {
+		fmt.Fprintf(w, "%s: %s@%s\n", u.ReportID, u.Module, u.FlaggedVersion)
+	}
+	return nil
+}
+
+func (s *Server) handleWithdrawals(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	if s.cfg.VulnDBRepo == "" {
+		return &serverError{
+			status: http.StatusPreconditionFailed,
+			err:    errors.New("the withdrawal check needs a vulndb repo"),
+		}
+	}
+	stats, err := CheckWithdrawals(r.Context(), s.cfg.Store, s.issueClient, s.cfg.VulnDBRepo)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "withdrawal check succeeded: checked %d CVEs, flagged %d reports and %d issues, created %d issues and %d comments\n",
+		stats.NumChecked, len(stats.Reports), len(stats.Issues), stats.NumIssues, stats.NumComments)
+	for _, id := range append(append([]string{}, stats.Reports...), stats.Issues...) {
+		fmt.Fprintln(w, id)
+	}
+	return nil
+}
+
+// needEmbargoConfig returns an error if the server is not configured to
+// check embargoes.
+func (s *Server) needEmbargoConfig() error {
+	if s.cfg.VulnDBRepo == "" || s.cfg.OverlayDir == "" || s.cfg.DBDir == "" {
+		return errors.New("the embargo check needs a vulndb repo, an overlay dir and a DB dir")
+	}
+	return nil
+}
+
+// publishEmbargoed publishes the overlay reports whose embargoes have ended.
+func (s *Server) publishEmbargoed(ctx context.Context) (EmbargoStats, error) {
+	key, err := s.cfg.SigningKey()
+	if err != nil {
+		return EmbargoStats{}, err
+	}
+	return PublishEmbargoed(ctx, s.cfg.Store, s.cfg.VulnDBRepo, s.cfg.OverlayDir, s.cfg.DBDir, key)
+}
+
+func (s *Server) handleEmbargoes(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	if err := s.needEmbargoConfig(); err != nil {
+		return &serverError{status: http.StatusPreconditionFailed, err: err}
+	}
+	stats, err := s.publishEmbargoed(r.Context())
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "embargo check succeeded: %d embargoed, %d published, %d unpublished\n",
+		stats.NumEmbargoed, len(stats.Published), len(stats.Unpublished))
+	for _, id := range stats.Published {
+		fmt.Fprintf(w, "published %s\n", id)
+	}
+	for _, id := range stats.Unpublished {
+		fmt.Fprintf(w, "unpublished %s\n", id)
+	}
+	return nil
+}
+
+func (s *Server) handleTriageAging(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	digest := (r.FormValue("digest") == "true")
+	stats, err := CheckTriageAging(r.Context(), s.cfg.Store, digest)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "triage aging check succeeded: %d need issues (%d overdue), %d issues open (%d overdue)\n",
+		stats.NeedsIssue.Size, len(stats.NeedsIssue.Overdue), stats.IssueOpen.Size, len(stats.IssueOpen.Overdue))
+	for _, id := range stats.NeedsIssue.Overdue {
+		fmt.Fprintf(w, "needs issue: %s\n", id)
+	}
+	for _, id := range stats.IssueOpen.Overdue {
+		fmt.Fprintf(w, "issue open: %s\n", id)
+	}
+	return nil
+}
+
+// needSummaryIssue returns an error if the server is not configured to post
+// triage summaries.
+func (s *Server) needSummaryIssue() error {
+	if s.issueClient == nil || s.cfg.SummaryIssue == 0 {
+		return errors.New("the triage summary needs an issue repo and a summary issue")
+	}
+	return nil
+}
+
+func (s *Server) handleTriageSummary(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodPost),
+		}
+	}
+	if err := s.needSummaryIssue(); err != nil {
+		return &serverError{status: http.StatusPreconditionFailed, err: err}
+	}
+	sum, err := PostTriageSummary(r.Context(), s.cfg.Store, s.issueClient, s.cfg.SummaryIssue, s.cfg.VulnDBRepo)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "posted triage summary on %s:\n\n%s", s.issueClient.Reference(s.cfg.SummaryIssue), sum.Markdown())
+	return nil
+}
+
 func (s *Server) handleIssues(w http.ResponseWriter, r *http.Request) error {
 	if r.Method != http.MethodPost {
 		return &serverError{
@@ -333,7 +985,7 @@ func (s *Server) handleIssues(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 	// Unless explicitly asked to, don't create more than a few issues.
-	limit := 10
+	limit := defaultIssueLimit
 	if sl := r.FormValue("limit"); sl != "" {
 		var err error
 		limit, err = strconv.Atoi(sl)
@@ -345,7 +997,23 @@ func (s *Server) handleIssues(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 	log.With("limit", limit).Infof(r.Context(), "creating issues")
-	return CreateIssues(r.Context(), s.cfg.Store, s.issueClient, limit)
+	return s.createIssues(r.Context(), limit)
+}
+
+// createIssues creates up to limit issues, checking them for duplicates of
+// the reports in the vulndb repo, if it is configured. The check is only
+// advice to triagers, so if the reports can't be read, createIssues logs the
+// error and checks only the open issues.
+func (s *Server) createIssues(ctx context.Context, limit int) error {
+	var reports []*GoReport
+	if s.cfg.VulnDBRepo != "" {
+		var err error
+		reports, err = LoadGoReports(ctx, s.cfg.VulnDBRepo)
+		if err != nil {
+			log.Errorf(ctx, "not checking issues for duplicate reports: %v", err)
+		}
+	}
+	return CreateIssues(ctx, s.cfg.Store, s.issueClient, reports, limit)
 }
 
 var updateAndIssuesInProgress atomic.Value
@@ -370,6 +1038,146 @@ func (s *Server) handleUpdateAndIssues(w http.ResponseWriter, r *http.Request) e
 	return s.handleIssues(w, r)
 }
 
+const (
+	// defaultCVEsLimit is the default number of records returned by /cves.
+	defaultCVEsLimit = 100
+	// maxCVEsLimit is the maximum number of records returned by /cves.
+	maxCVEsLimit = 1000
+)
+
+// cvesResponse is the response of /cves.
+type cvesResponse struct {
+	Records []*store.CVERecord
+	// Next is the cursor for the next page, if there may be more records.
+	Next string `json:",omitempty"`
+}
+
+func (s *Server) handleCVEs(w http.ResponseWriter, r *http.Request) error {
+	q, err := parseCVERecordQuery(r)
+	if err != nil {
+		return &serverError{status: http.StatusBadRequest, err: err}
+	}
+	crs, next, err := s.cfg.Store.ListCVERecords(r.Context(), q)
+	if err != nil {
+		return err
+	}
+	if crs == nil {
+		crs = []*store.CVERecord{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(cvesResponse{Records: crs, Next: next})
+}
+
+// parseCVERecordQuery returns the query described by the query params
+// triage_state, year, module, updated_since (in RFC 3339 format), cursor and
+// limit.
+func parseCVERecordQuery(r *http.Request) (_ store.CVERecordQuery, err error) {
+	q := store.CVERecordQuery{
+		TriageState: store.TriageState(r.FormValue("triage_state")),
+		Module:      r.FormValue("module"),
+		Cursor:      r.FormValue("cursor"),
+		Limit:       defaultCVEsLimit,
+	}
+	if q.TriageState != "" {
+		if err := q.TriageState.Validate(); err != nil {
+			return q, err
+		}
+	}
+	if v := r.FormValue("year"); v != "" {
+		q.Year, err = strconv.Atoi(v)
+		if err != nil {
+			return q, fmt.Errorf("year: %w", err)
+		}
+	}
+	if v := r.FormValue("updated_since"); v != "" {
+		q.UpdatedSince, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			return q, fmt.Errorf("updated_since: %w", err)
+		}
+	}
+	if v := r.FormValue("limit"); v != "" {
+		q.Limit, err = strconv.Atoi(v)
+		if err != nil {
+			return q, fmt.Errorf("limit: %w", err)
+		}
+		if q.Limit <= 0 || q.Limit > maxCVEsLimit {
+			return q, fmt.Errorf("limit must be between 1 and %d", maxCVEsLimit)
+		}
+	}
+	return q, nil
+}
+
+func (s *Server) handleTriageHistory(w http.ResponseWriter, r *http.Request) error {
+	id := r.FormValue("id")
+	if id == "" {
+		return &serverError{
+			status: http.StatusBadRequest,
+			err:    errors.New("missing id query param"),
+		}
+	}
+	es, err := s.cfg.Store.ListTriageHistory(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	if es == nil {
+		es = []*store.TriageHistoryEntry{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(es)
+}
+
+// aliasesResponse is the response of the /aliases endpoint. Its fields are
+// named like those of an OSV entry.
+type aliasesResponse struct {
+	ID      string   `json:"id"`
+	Aliases []string `json:"aliases"`
+}
+
+func (s *Server) handleAliases(w http.ResponseWriter, r *http.Request) error {
+	id := r.FormValue("id")
+	if id == "" {
+		return &serverError{
+			status: http.StatusBadRequest,
+			err:    errors.New("missing id query param"),
+		}
+	}
+	aliases, err := s.cfg.Store.GetAliases(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	if aliases == nil {
+		aliases = []string{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(aliasesResponse{ID: id, Aliases: aliases})
+}
+
+func (s *Server) handleLogLevel(w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		l, err := log.ParseLevel(r.FormValue("level"))
+		if err != nil {
+			return &serverError{status: http.StatusBadRequest, err: err}
+		}
+		log.Infof(r.Context(), "changing log level from %s to %s", log.Level(), l)
+		log.SetLevel(l)
+	default:
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s or %s required", http.MethodGet, http.MethodPost),
+		}
+	}
+	fmt.Fprintln(w, log.Level())
+	return nil
+}
+
 func (s *Server) handleScanModules(w http.ResponseWriter, r *http.Request) error {
 	return ScanModules(r.Context(), s.cfg.Store, r.FormValue("force") == "true")
 }
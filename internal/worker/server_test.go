@@ -9,10 +9,23 @@
 package worker
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/safehtml/template"
 	"github.com/jba/templatecheck"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
 )
 
 func TestTemplates(t *testing.T) {
@@ -25,4 +38,221 @@ func TestTemplates(t *testing.T) {
 	if err := templatecheck.CheckSafe(index, indexPage{}); err != nil {
 		t.Error(err)
 	}
+	triage, err := parseTemplate(staticPath, template.TrustedSourceFromConstant("triage.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := templatecheck.CheckSafe(triage, triagePage{}); err != nil {
+		t.Error(err)
+	}
+	cve, err := parseTemplate(staticPath, template.TrustedSourceFromConstant("cve.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := templatecheck.CheckSafe(cve, cvePage{}); err != nil {
+		t.Error(err)
+	}
+	report, err := parseTemplate(staticPath, template.TrustedSourceFromConstant("report.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := templatecheck.CheckSafe(report, reportPage{}); err != nil {
+		t.Error(err)
+	}
+	search, err := parseTemplate(staticPath, template.TrustedSourceFromConstant("search.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := templatecheck.CheckSafe(search, searchPage{}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReportFamily(t *testing.T) {
+	reports := []*GoReport{
+		{ID: "GO-2022-0001"},
+		{ID: "GO-2022-0002", Supersedes: []string{"GO-2022-0001"}, Related: []string{"GO-2022-0005"}},
+		{ID: "GO-2022-0003", SplitFrom: "GO-2022-0002"},
+		{ID: "GO-2022-0004", SplitFrom: "GO-2022-0002", Related: []string{"GO-2022-0002"}},
+		{ID: "GO-2022-0005"},
+	}
+	gr, got := familyOf(reports, "GO-2022-0002")
+	if gr != reports[1] {
+		t.Fatalf("got report %+v, want GO-2022-0002", gr)
+	}
+	want := &reportFamily{
+		Supersedes: []string{"GO-2022-0001"},
+		SplitInto:  []string{"GO-2022-0003", "GO-2022-0004"},
+		Related:    []string{"GO-2022-0004", "GO-2022-0005"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	_, got = familyOf(reports, "GO-2022-0001")
+	if want := []string{"GO-2022-0002"}; !cmp.Equal(got.SupersededBy, want) {
+		t.Errorf("superseded by: got %q, want %q", got.SupersededBy, want)
+	}
+	if gr, _ := familyOf(reports, "GO-2022-0009"); gr != nil {
+		t.Errorf("unknown report: got %+v, want nil", gr)
+	}
+
+	staticPath := template.TrustedSourceFromConstant("static")
+	tmpl, err := parseTemplate(staticPath, template.TrustedSourceFromConstant("report.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gr, family := familyOf(reports, "GO-2022-0003")
+	w := httptest.NewRecorder()
+	if err := renderPage(context.Background(), w, reportPage{Report: gr, Family: family}, tmpl); err != nil {
+		t.Fatal(err)
+	}
+	if want := `<a href="/report/GO-2022-0002">GO-2022-0002</a>`; !strings.Contains(w.Body.String(), want) {
+		t.Errorf("page does not contain %q", want)
+	}
+}
+
+func TestCVEPage(t *testing.T) {
+	staticPath := template.TrustedSourceFromConstant("static")
+	tmpl, err := parseTemplate(staticPath, template.TrustedSourceFromConstant("cve.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mstore := store.NewMemStore()
+	s := &Server{cfg: Config{Store: mstore}, cveTemplate: tmpl}
+	createCVERecords(t, mstore, []*store.CVERecord{{
+		ID:            "CVE-2022-0001",
+		Path:          "2022/0xxx/CVE-2022-0001.json",
+		BlobHash:      "abc",
+		CommitHash:    "123",
+		CommitTime:    time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC),
+		CVEState:      cveschema.StatePublic,
+		TriageState:   store.TriageStateFalsePositive,
+		CVSS:          "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		ReferenceURLs: []string{"https://example.com/a"},
+	}})
+
+	serve := func(method, target string, form url.Values) *httptest.ResponseRecorder {
+		t.Helper()
+		var body io.Reader
+		if form != nil {
+			body = strings.NewReader(form.Encode())
+		}
+		r := httptest.NewRequest(method, target, body)
+		if form != nil {
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		r.Header.Set(iapUserHeader, "accounts.google.com:alice@example.com")
+		w := httptest.NewRecorder()
+		if err := s.handleCVE(w, r); err != nil {
+			t.Fatalf("%s %s: %v", method, target, err)
+		}
+		return w
+	}
+
+	w := serve(http.MethodGet, "/cve/CVE-2022-0001", nil)
+	for _, want := range []string{"FalsePositive", "9.8 Critical", "https://example.com/a", "/cve/CVE-2022-0001/needs-issue", `name="version" value="1"`} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Errorf("page does not contain %q", want)
+		}
+	}
+
+	w = serve(http.MethodPost, "/cve/CVE-2022-0001/needs-issue", url.Values{
+		"module":  {"example.com/a"},
+		"reason":  {"it is Go"},
+		"version": {"1"},
+	})
+	if w.Code != http.StatusSeeOther {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusSeeOther)
+	}
+	cr := mstore.CVERecords()["CVE-2022-0001"]
+	if cr.TriageState != store.TriageStateNeedsIssue || cr.Module != "example.com/a" || cr.CVE == nil {
+		t.Errorf("got %+v, want NeedsIssue record for example.com/a with CVE", cr)
+	}
+	w = serve(http.MethodGet, "/cve/CVE-2022-0001", nil)
+	if want := "web:alice@example.com"; !strings.Contains(w.Body.String(), want) {
+		t.Errorf("page does not contain %q", want)
+	}
+
+	// Changing the record from a page loaded before the change fails.
+	form := url.Values{"reason": {"not Go"}, "version": {"1"}}
+	r := httptest.NewRequest(http.MethodPost, "/cve/CVE-2022-0001/false-positive", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var serr *serverError
+	if err := s.handleCVE(httptest.NewRecorder(), r); !errors.As(err, &serr) || serr.status != http.StatusConflict {
+		t.Errorf("stale change: got %v, want status %d", err, http.StatusConflict)
+	}
+	if got := mstore.CVERecords()["CVE-2022-0001"].TriageState; got != store.TriageStateNeedsIssue {
+		t.Errorf("stale change: got triage state %s, want %s", got, store.TriageStateNeedsIssue)
+	}
+}
+
+func TestAutoCreateIssues(t *testing.T) {
+	mstore := store.NewMemStore()
+	createCVERecords(t, mstore, []*store.CVERecord{
+		{
+			ID:          "CVE-2022-0001",
+			Path:        "2022/0xxx/CVE-2022-0001.json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Module:      "example.com/a",
+			CVE:         &cveschema.CVE{Metadata: cveschema.Metadata{ID: "CVE-2022-0001"}},
+			TriageState: store.TriageStateNeedsIssue,
+		},
+		{
+			// Already has an issue, so it is skipped and left alone.
+			ID:             "CVE-2022-0002",
+			Path:           "2022/0xxx/CVE-2022-0002.json",
+			BlobHash:       "def",
+			CommitHash:     "123",
+			CommitTime:     time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Module:         "example.com/b",
+			CVE:            &cveschema.CVE{Metadata: cveschema.Metadata{ID: "CVE-2022-0002"}},
+			TriageState:    store.TriageStateNeedsIssue,
+			IssueReference: "inMemory#99",
+		},
+	})
+	s := &Server{cfg: Config{Store: mstore}, issueClient: issues.NewFakeClient()}
+
+	run := func() {
+		t.Helper()
+		w := httptest.NewRecorder()
+		if err := s.autoCreateIssues(w, httptest.NewRequest(http.MethodPost, "/update", nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Nothing happens unless configured.
+	run()
+	if got := mstore.CVERecords()["CVE-2022-0001"].TriageState; got != store.TriageStateNeedsIssue {
+		t.Errorf("unconfigured: got %s, want %s", got, store.TriageStateNeedsIssue)
+	}
+
+	s.cfg.AutoCreateIssues = true
+	run()
+	crs := mstore.CVERecords()
+	if cr := crs["CVE-2022-0001"]; cr.TriageState != store.TriageStateIssueCreated || cr.IssueReference != "inMemory#1" {
+		t.Errorf("got %s %q, want %s %q", cr.TriageState, cr.IssueReference, store.TriageStateIssueCreated, "inMemory#1")
+	}
+	if cr := crs["CVE-2022-0002"]; cr.TriageState != store.TriageStateNeedsIssue || cr.IssueReference != "inMemory#99" {
+		t.Errorf("skipped record: got %s %q, want unchanged", cr.TriageState, cr.IssueReference)
+	}
+}
+
+func TestLogLevel(t *testing.T) {
+	defer log.SetLevel(log.Level())
+	s := &Server{}
+	serve := func(method, target string) (string, error) {
+		w := httptest.NewRecorder()
+		err := s.handleLogLevel(w, httptest.NewRequest(method, target, nil))
+		return strings.TrimSpace(w.Body.String()), err
+	}
+	if got, err := serve(http.MethodPost, "/debug/log-level?level=error"); err != nil || got != "error" {
+		t.Errorf("POST: got %q, %v, want error", got, err)
+	}
+	if got, err := serve(http.MethodGet, "/debug/log-level"); err != nil || got != "error" {
+		t.Errorf("GET: got %q, %v, want error", got, err)
+	}
+	if _, err := serve(http.MethodPost, "/debug/log-level?level=loud"); err == nil {
+		t.Error("got nil, want error for unknown level")
+	}
 }
@@ -0,0 +1,180 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// Each update records the status of its source in the store when it starts
+// and ends: how the last update went, with its counts, and when the source
+// last succeeded. The statuses are served as JSON at /status, for uptime
+// checks and dashboards. Writing a status is best effort: an error is logged,
+// and does not fail the update.
+
+// An updateRun holds the counts of a run of an update, for the status of its
+// source.
+type updateRun struct {
+	mu                                  sync.Mutex
+	numProcessed, numAdded, numModified int
+}
+
+type updateRunKey struct{}
+
+// countUpdate records the numbers of records that the update running in ctx
+// processed, added and modified, for the status of its source. It replaces
+// any counts recorded before.
+func countUpdate(ctx context.Context, numProcessed, numAdded, numModified int) {
+	run, ok := ctx.Value(updateRunKey{}).(*updateRun)
+	if !ok {
+		return
+	}
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	run.numProcessed, run.numAdded, run.numModified = numProcessed, numAdded, numModified
+}
+
+// getSourceStatus returns the status of source in st, or a new one if there
+// is none.
+func getSourceStatus(ctx context.Context, st store.Store, source string) (*store.SourceStatus, error) {
+	ss, err := st.ListSourceStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range ss {
+		if s.Source == source {
+			return s, nil
+		}
+	}
+	return &store.SourceStatus{Source: source}, nil
+}
+
+// startSourceStatus records in st that an update from source started at
+// start, and returns a context for the update that collects its counts.
+func startSourceStatus(ctx context.Context, st store.Store, source string, start time.Time) context.Context {
+	ctx = context.WithValue(ctx, updateRunKey{}, &updateRun{})
+	s, err := getSourceStatus(ctx, st, source)
+	if err == nil {
+		s.StartedAt = start
+		s.EndedAt = time.Time{}
+		s.Error = ""
+		s.Interrupted = false
+		s.NumProcessed, s.NumAdded, s.NumModified = 0, 0, 0
+		err = st.SetSourceStatus(ctx, s)
+	}
+	if err != nil {
+		log.Warningf(ctx, "recording start of %s update: %v", source, err)
+	}
+	return ctx
+}
+
+// endSourceStatus records in st that the update from source of ctx, which
+// started at start, ended with err. If interrupted is true, the update was
+// stopped rather than failing.
+func endSourceStatus(ctx context.Context, st store.Store, source string, start time.Time, err error, interrupted bool) {
+	// The status must be written even if the update was canceled.
+	ctx = detach(ctx)
+	s, serr := getSourceStatus(ctx, st, source)
+	if serr == nil {
+		now := time.Now()
+		s.StartedAt = start
+		s.EndedAt = now
+		s.Interrupted = interrupted
+		if run, ok := ctx.Value(updateRunKey{}).(*updateRun); ok {
+			run.mu.Lock()
+			s.NumProcessed, s.NumAdded, s.NumModified = run.numProcessed, run.numAdded, run.numModified
+			run.mu.Unlock()
+		}
+		s.NumRuns++
+		switch {
+		case err == nil:
+			s.Error = ""
+			s.LastSuccessAt = now
+			s.LastSuccessDuration = now.Sub(start)
+		case interrupted:
+			s.Error = ""
+		default:
+			s.Error = err.Error()
+			s.NumFailures++
+		}
+		serr = st.SetSourceStatus(ctx, s)
+	}
+	if serr != nil {
+		log.Warningf(ctx, "recording end of %s update: %v", source, serr)
+	}
+}
+
+// statusResponse is the response of /status.
+type statusResponse struct {
+	// Healthy is false if the last update of any source failed.
+	Healthy bool
+	Sources []*sourceStatusResponse
+}
+
+// sourceStatusResponse is the status of a source in a statusResponse.
+type sourceStatusResponse struct {
+	*store.SourceStatus
+	// State is "running", "succeeded", "failed" or "interrupted".
+	State string
+	// Duration is how long the last update took, or has taken so far.
+	Duration string
+	// SinceLastSuccess is the time since the last successful update ended,
+	// if there was one.
+	SinceLastSuccess string `json:",omitempty"`
+}
+
+// sourceStatusSummary returns the response of /status for the statuses ss, as
+// of now.
+func sourceStatusSummary(ss []*store.SourceStatus, now time.Time) *statusResponse {
+	resp := &statusResponse{Healthy: true, Sources: []*sourceStatusResponse{}}
+	for _, s := range ss {
+		r := &sourceStatusResponse{SourceStatus: s}
+		end := s.EndedAt
+		switch {
+		case s.EndedAt.IsZero():
+			r.State = "running"
+			end = now
+		case s.Interrupted:
+			r.State = "interrupted"
+		case s.Error != "":
+			r.State = "failed"
+			resp.Healthy = false
+		default:
+			r.State = "succeeded"
+		}
+		r.Duration = end.Sub(s.StartedAt).Round(time.Second).String()
+		if !s.LastSuccessAt.IsZero() {
+			r.SinceLastSuccess = now.Sub(s.LastSuccessAt).Round(time.Second).String()
+		}
+		resp.Sources = append(resp.Sources, r)
+	}
+	return resp
+}
+
+// handleStatus serves the status of each source of updates as JSON.
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodGet {
+		return &serverError{
+			status: http.StatusMethodNotAllowed,
+			err:    fmt.Errorf("%s required", http.MethodGet),
+		}
+	}
+	ss, err := s.cfg.Store.ListSourceStatuses(r.Context())
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(sourceStatusSummary(ss, time.Now()))
+}
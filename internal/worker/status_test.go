@@ -0,0 +1,126 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestSourceStatus(t *testing.T) {
+	mstore := store.NewMemStore()
+	status := func() *store.SourceStatus {
+		t.Helper()
+		ss, err := mstore.ListSourceStatuses(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ss) != 1 {
+			t.Fatalf("got %d statuses, want 1", len(ss))
+		}
+		return ss[0]
+	}
+
+	// A successful update.
+	ctx, end := startUpdate(context.Background(), mstore, "nvd")
+	if s := status(); s.StartedAt.IsZero() || !s.EndedAt.IsZero() {
+		t.Errorf("running: got %+v, want started and not ended", s)
+	}
+	countUpdate(ctx, 10, 2, 3)
+	end(nil)
+	s := status()
+	if s.EndedAt.IsZero() || s.LastSuccessAt.IsZero() || s.NumRuns != 1 || s.NumFailures != 0 ||
+		s.NumProcessed != 10 || s.NumAdded != 2 || s.NumModified != 3 {
+		t.Errorf("succeeded: got %+v", s)
+	}
+	lastSuccess := s.LastSuccessAt
+
+	// A failed update keeps the time of the last success.
+	_, end = startUpdate(context.Background(), mstore, "nvd")
+	end(errors.New("bad"))
+	s = status()
+	if s.Error != "bad" || s.NumRuns != 2 || s.NumFailures != 1 || !s.LastSuccessAt.Equal(lastSuccess) || s.NumProcessed != 0 {
+		t.Errorf("failed: got %+v", s)
+	}
+
+	// An update interrupted by a shutdown is not a failure.
+	var f inFlight
+	cctx, done, err := f.start(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer done()
+	_, end = startUpdate(cctx, mstore, "nvd")
+	f.stop()
+	end(cctx.Err())
+	s = status()
+	if !s.Interrupted || s.Error != "" || s.NumRuns != 3 || s.NumFailures != 1 {
+		t.Errorf("interrupted: got %+v", s)
+	}
+
+	// An update that runs out of time fails.
+	dctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	_, end = startUpdate(dctx, mstore, "nvd")
+	end(dctx.Err())
+	s = status()
+	if s.Interrupted || s.Error == "" || s.NumRuns != 4 || s.NumFailures != 2 {
+		t.Errorf("timed out: got %+v", s)
+	}
+}
+
+func TestHandleStatus(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	now := time.Now()
+	for _, s := range []*store.SourceStatus{
+		{Source: "cvelist", StartedAt: now.Add(-time.Minute)},
+		{Source: "ghsa", StartedAt: now.Add(-time.Hour), EndedAt: now.Add(-time.Hour + time.Minute), LastSuccessAt: now.Add(-time.Hour + time.Minute)},
+		{Source: "nvd", StartedAt: now.Add(-time.Hour), EndedAt: now, Error: "bad"},
+	} {
+		if err := mstore.SetSourceStatus(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv := &Server{cfg: Config{Store: mstore}}
+	w := httptest.NewRecorder()
+	if err := srv.handleStatus(w, httptest.NewRequest(http.MethodGet, "/status", nil)); err != nil {
+		t.Fatal(err)
+	}
+	var got statusResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Healthy {
+		t.Error("got healthy, want unhealthy after a failed update")
+	}
+	var states []string
+	for _, s := range got.Sources {
+		states = append(states, s.Source+":"+s.State)
+	}
+	want := []string{"cvelist:running", "ghsa:succeeded", "nvd:failed"}
+	if len(states) != len(want) {
+		t.Fatalf("got %v, want %v", states, want)
+	}
+	for i := range want {
+		if states[i] != want[i] {
+			t.Errorf("got %v, want %v", states, want)
+			break
+		}
+	}
+	if got.Sources[1].SinceLastSuccess != "59m0s" {
+		t.Errorf("got SinceLastSuccess %q, want 59m0s", got.Sources[1].SinceLastSuccess)
+	}
+}
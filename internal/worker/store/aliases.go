@@ -0,0 +1,60 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import "sort"
+
+// The stores keep a graph of aliases between the IDs of vulnerabilities, such
+// as CVE, GHSA and GO IDs. Each edge says that two IDs identify the same
+// vulnerability, so all the IDs connected to one, directly or through others,
+// are its aliases. Edges are stored in both directions.
+
+// aliasEdges returns the edges between the distinct, non-empty IDs in ids, as
+// a map from each ID to the others.
+func aliasEdges(ids []string) map[string][]string {
+	var us []string
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			us = append(us, id)
+		}
+	}
+	if len(us) < 2 {
+		return nil
+	}
+	edges := map[string][]string{}
+	for _, id := range us {
+		for _, other := range us {
+			if other != id {
+				edges[id] = append(edges[id], other)
+			}
+		}
+	}
+	return edges
+}
+
+// connectedAliases returns the IDs connected to id in the alias graph, not
+// including id, in sorted order. The direct function returns the IDs that
+// share an edge with its argument.
+func connectedAliases(id string, direct func(string) ([]string, error)) ([]string, error) {
+	seen := map[string]bool{id: true}
+	var aliases []string
+	for queue := []string{id}; len(queue) > 0; queue = queue[1:] {
+		ds, err := direct(queue[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range ds {
+			if !seen[d] {
+				seen[d] = true
+				aliases = append(aliases, d)
+				queue = append(queue, d)
+			}
+		}
+	}
+	sort.Strings(aliases)
+	return aliases, nil
+}
@@ -0,0 +1,1006 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/worker/log"
+)
+
+// A DryRunStore is a Store that reads from another Store but does not write
+// to it. It logs each write instead, and keeps it in memory so that later
+// reads see it, as far as they can: ListCVERecords does not return records
+// that were created only in the dry run, and GetAliases, ListTriageHistory,
+// Search and the module scan methods ignore the writes of the dry run.
+//
+// Locks are acquired and released in the other Store, because they
+// coordinate work rather than record it.
+type DryRunStore struct {
+	s Store
+
+	mu sync.Mutex
+	// The records written in the dry run, by ID, and the versions they
+	// replaced, which are nil for new records.
+	cveRecords  map[string]*CVERecord
+	oldCVEs     map[string]*CVERecord
+	ghsaRecords map[string]*GHSARecord
+	oldGHSAs    map[string]*GHSARecord
+
+	updateRecords  map[string]*CommitUpdateRecord
+	dirHashes      map[string]string
+	fetchCursors   map[string]time.Time
+	aliases        [][]string
+	searchTerms    map[string][]string
+	history        []*TriageHistoryEntry
+	modScanRecords []*ModuleScanRecord
+	// discrepancies are the DepsDevDiscrepancies that would replace the
+	// stored ones, if setDiscrepancies is true.
+	discrepancies []*DepsDevDiscrepancy
+	// sourceStatus holds the SourceStatuses that would be set, by source.
+	sourceStatus map[string]*SourceStatus
+	// quarantine holds the QuarantinedFiles that would be set, by path, or
+	// nil for those that would be deleted.
+	quarantine map[string]*QuarantinedFile
+	// releases holds the SecurityReleases that would be set, by release.
+	releases map[string]*SecurityRelease
+	// unfixed holds the UnfixedModules that would be set, or nil for those
+	// that would be deleted.
+	unfixed map[unfixedKey]*UnfixedModule
+	// reviews holds the ReportReviews that would be set, by report ID.
+	reviews map[string]*ReportReview
+	// repoModules holds the RepoModules that would be set, by repo.
+	repoModules      map[string]*RepoModules
+	setDiscrepancies bool
+}
+
+// NewDryRunStore returns a DryRunStore that reads from s.
+func NewDryRunStore(s Store) *DryRunStore {
+	return &DryRunStore{
+		s:             s,
+		cveRecords:    map[string]*CVERecord{},
+		oldCVEs:       map[string]*CVERecord{},
+		ghsaRecords:   map[string]*GHSARecord{},
+		oldGHSAs:      map[string]*GHSARecord{},
+		updateRecords: map[string]*CommitUpdateRecord{},
+		dirHashes:     map[string]string{},
+		fetchCursors:  map[string]time.Time{},
+		searchTerms:   map[string][]string{},
+		sourceStatus:  map[string]*SourceStatus{},
+		quarantine:    map[string]*QuarantinedFile{},
+		releases:      map[string]*SecurityRelease{},
+		unfixed:       map[unfixedKey]*UnfixedModule{},
+		reviews:       map[string]*ReportReview{},
+		repoModules:   map[string]*RepoModules{},
+	}
+}
+
+// CreateCommitUpdateRecord implements Store.CreateCommitUpdateRecord.
+func (d *DryRunStore) CreateCommitUpdateRecord(ctx context.Context, r *CommitUpdateRecord) error {
+	d.mu.Lock()
+	r.ID = fmt.Sprintf("dry-run-%d", len(d.updateRecords)+1)
+	d.mu.Unlock()
+	log.Infof(ctx, "dry run: would create CommitUpdateRecord for commit %s", r.CommitHash)
+	return d.setCommitUpdateRecord(r)
+}
+
+// SetCommitUpdateRecord implements Store.SetCommitUpdateRecord.
+func (d *DryRunStore) SetCommitUpdateRecord(ctx context.Context, r *CommitUpdateRecord) error {
+	log.Debugf(ctx, "dry run: would set CommitUpdateRecord %s", r.ID)
+	return d.setCommitUpdateRecord(r)
+}
+
+func (d *DryRunStore) setCommitUpdateRecord(r *CommitUpdateRecord) error {
+	c := *r
+	c.UpdatedAt = time.Now()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.updateRecords[c.ID] = &c
+	return nil
+}
+
+// ListCommitUpdateRecords implements Store.ListCommitUpdateRecords.
+func (d *DryRunStore) ListCommitUpdateRecords(ctx context.Context, limit int) ([]*CommitUpdateRecord, error) {
+	urs, err := d.s.ListCommitUpdateRecords(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var dry []*CommitUpdateRecord
+	for _, r := range d.updateRecords {
+		dry = append(dry, r)
+	}
+	sort.Slice(dry, func(i, j int) bool { return dry[i].StartedAt.After(dry[j].StartedAt) })
+	urs = append(dry, urs...)
+	if limit > 0 && len(urs) > limit {
+		urs = urs[:limit]
+	}
+	return urs, nil
+}
+
+// GetCVERecord implements Store.GetCVERecord.
+func (d *DryRunStore) GetCVERecord(ctx context.Context, id string) (*CVERecord, error) {
+	if r := d.cveRecord(id); r != nil {
+		return r, nil
+	}
+	return d.s.GetCVERecord(ctx, id)
+}
+
+// cveRecord returns a copy of the CVERecord with id written in the dry run,
+// or nil if there is none.
+func (d *DryRunStore) cveRecord(id string) *CVERecord {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if r, ok := d.cveRecords[id]; ok {
+		c := *r
+		return &c
+	}
+	return nil
+}
+
+// ListCVERecordsWithTriageState implements Store.ListCVERecordsWithTriageState.
+func (d *DryRunStore) ListCVERecordsWithTriageState(ctx context.Context, ts TriageState) ([]*CVERecord, error) {
+	crs, err := d.s.ListCVERecordsWithTriageState(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+	crs = d.overlayCVERecords(crs, func(r *CVERecord) bool { return r.TriageState == ts })
+	listed := map[string]bool{}
+	for _, r := range crs {
+		listed[r.ID] = true
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// Add the records that entered the state in the dry run.
+	for id, r := range d.cveRecords {
+		if !listed[id] && r.TriageState == ts {
+			c := *r
+			crs = append(crs, &c)
+		}
+	}
+	sort.Slice(crs, func(i, j int) bool { return crs[i].ID < crs[j].ID })
+	return crs, nil
+}
+
+// ListCVERecords implements Store.ListCVERecords. Records that the dry run
+// changed are returned as changed, if they still match q.
+func (d *DryRunStore) ListCVERecords(ctx context.Context, q CVERecordQuery) ([]*CVERecord, string, error) {
+	crs, next, err := d.s.ListCVERecords(ctx, q)
+	if err != nil {
+		return nil, "", err
+	}
+	return d.overlayCVERecords(crs, q.matches), next, nil
+}
+
+// overlayCVERecords replaces the records of crs that were written in the dry
+// run with their new versions, and removes those that no longer match.
+func (d *DryRunStore) overlayCVERecords(crs []*CVERecord, match func(*CVERecord) bool) []*CVERecord {
+	var out []*CVERecord
+	for _, r := range crs {
+		if c := d.cveRecord(r.ID); c != nil {
+			if !match(c) {
+				continue
+			}
+			r = c
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// GetDirectoryHash implements Store.GetDirectoryHash.
+func (d *DryRunStore) GetDirectoryHash(ctx context.Context, dir string) (string, error) {
+	d.mu.Lock()
+	h, ok := d.dirHashes[dir]
+	d.mu.Unlock()
+	if ok {
+		return h, nil
+	}
+	return d.s.GetDirectoryHash(ctx, dir)
+}
+
+// SetDirectoryHash implements Store.SetDirectoryHash.
+func (d *DryRunStore) SetDirectoryHash(ctx context.Context, dir, hash string) error {
+	log.Debugf(ctx, "dry run: would set hash of directory %s to %s", dir, hash)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dirHashes[dir] = hash
+	return nil
+}
+
+// GetFetchCursor implements Store.GetFetchCursor.
+func (d *DryRunStore) GetFetchCursor(ctx context.Context, source string) (time.Time, error) {
+	d.mu.Lock()
+	t, ok := d.fetchCursors[source]
+	d.mu.Unlock()
+	if ok {
+		return t, nil
+	}
+	return d.s.GetFetchCursor(ctx, source)
+}
+
+// SetFetchCursor implements Store.SetFetchCursor.
+func (d *DryRunStore) SetFetchCursor(ctx context.Context, source string, t time.Time) error {
+	log.Infof(ctx, "dry run: would set %s fetch cursor to %s", source, t.Format(time.RFC3339))
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.fetchCursors[source] = t
+	return nil
+}
+
+// AcquireLock implements Store.AcquireLock.
+func (d *DryRunStore) AcquireLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	return d.s.AcquireLock(ctx, name, owner, ttl)
+}
+
+// RenewLock implements Store.RenewLock.
+func (d *DryRunStore) RenewLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	return d.s.RenewLock(ctx, name, owner, ttl)
+}
+
+// ReleaseLock implements Store.ReleaseLock.
+func (d *DryRunStore) ReleaseLock(ctx context.Context, name, owner string) error {
+	return d.s.ReleaseLock(ctx, name, owner)
+}
+
+// ListTriageHistory implements Store.ListTriageHistory.
+func (d *DryRunStore) ListTriageHistory(ctx context.Context, id string) ([]*TriageHistoryEntry, error) {
+	return d.s.ListTriageHistory(ctx, id)
+}
+
+// GetAliases implements Store.GetAliases.
+func (d *DryRunStore) GetAliases(ctx context.Context, id string) ([]string, error) {
+	return d.s.GetAliases(ctx, id)
+}
+
+// Search implements Store.Search.
+func (d *DryRunStore) Search(ctx context.Context, terms []string, limit int) ([]string, error) {
+	return d.s.Search(ctx, terms, limit)
+}
+
+// CreateModuleScanRecord implements Store.CreateModuleScanRecord.
+func (d *DryRunStore) CreateModuleScanRecord(ctx context.Context, r *ModuleScanRecord) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	log.Infof(ctx, "dry run: would record scan of %s@%s", r.Path, r.Version)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.modScanRecords = append(d.modScanRecords, r)
+	return nil
+}
+
+// GetModuleScanRecord implements Store.GetModuleScanRecord.
+func (d *DryRunStore) GetModuleScanRecord(ctx context.Context, path, version string, dbTime time.Time) (*ModuleScanRecord, error) {
+	return d.s.GetModuleScanRecord(ctx, path, version, dbTime)
+}
+
+// ListModuleScanRecords implements Store.ListModuleScanRecords.
+func (d *DryRunStore) ListModuleScanRecords(ctx context.Context, limit int) ([]*ModuleScanRecord, error) {
+	return d.s.ListModuleScanRecords(ctx, limit)
+}
+
+// SetDepsDevDiscrepancies implements Store.SetDepsDevDiscrepancies.
+func (d *DryRunStore) SetDepsDevDiscrepancies(ctx context.Context, ds []*DepsDevDiscrepancy) error {
+	for _, dd := range ds {
+		if err := dd.Validate(); err != nil {
+			return err
+		}
+	}
+	log.Infof(ctx, "dry run: would replace deps.dev discrepancies with %d", len(ds))
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.discrepancies = append([]*DepsDevDiscrepancy(nil), ds...)
+	sortDepsDevDiscrepancies(d.discrepancies)
+	d.setDiscrepancies = true
+	return nil
+}
+
+// SetSourceStatus implements Store.SetSourceStatus.
+func (d *DryRunStore) SetSourceStatus(ctx context.Context, s *SourceStatus) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	log.Debugf(ctx, "dry run: would set the status of source %s", s.Source)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c := *s
+	d.sourceStatus[s.Source] = &c
+	return nil
+}
+
+// ListSourceStatuses implements Store.ListSourceStatuses, including the
+// statuses that the dry run set.
+func (d *DryRunStore) ListSourceStatuses(ctx context.Context) ([]*SourceStatus, error) {
+	ss, err := d.s.ListSourceStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	bySource := map[string]*SourceStatus{}
+	for _, s := range ss {
+		bySource[s.Source] = s
+	}
+	for src, s := range d.sourceStatus {
+		c := *s
+		bySource[src] = &c
+	}
+	ss = nil
+	for _, src := range sortedKeys(bySource) {
+		ss = append(ss, bySource[src])
+	}
+	return ss, nil
+}
+
+// SetQuarantinedFile implements Store.SetQuarantinedFile.
+func (d *DryRunStore) SetQuarantinedFile(ctx context.Context, q *QuarantinedFile) error {
+	if err := q.Validate(); err != nil {
+		return err
+	}
+	log.Debugf(ctx, "dry run: would record a parse failure of %s", q.Path)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c := *q
+	d.quarantine[q.Path] = &c
+	return nil
+}
+
+// DeleteQuarantinedFile implements Store.DeleteQuarantinedFile.
+func (d *DryRunStore) DeleteQuarantinedFile(ctx context.Context, path string) error {
+	log.Debugf(ctx, "dry run: would delete the parse failures of %s", path)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.quarantine[path] = nil
+	return nil
+}
+
+// ListQuarantinedFiles implements Store.ListQuarantinedFiles, including the
+// changes that the dry run made.
+func (d *DryRunStore) ListQuarantinedFiles(ctx context.Context) ([]*QuarantinedFile, error) {
+	qs, err := d.s.ListQuarantinedFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	byPath := map[string]*QuarantinedFile{}
+	for _, q := range qs {
+		byPath[q.Path] = q
+	}
+	for p, q := range d.quarantine {
+		if q == nil {
+			delete(byPath, p)
+			continue
+		}
+		c := *q
+		byPath[p] = &c
+	}
+	qs = nil
+	for _, p := range sortedKeys(byPath) {
+		qs = append(qs, byPath[p])
+	}
+	return qs, nil
+}
+
+// SetSecurityRelease implements Store.SetSecurityRelease.
+func (d *DryRunStore) SetSecurityRelease(ctx context.Context, r *SecurityRelease) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	log.Debugf(ctx, "dry run: would set SecurityRelease %s", r.Release)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c := *r
+	d.releases[r.Release] = &c
+	return nil
+}
+
+// ListSecurityReleases implements Store.ListSecurityReleases, including the
+// changes that the dry run made.
+func (d *DryRunStore) ListSecurityReleases(ctx context.Context) ([]*SecurityRelease, error) {
+	rs, err := d.s.ListSecurityReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	byRelease := map[string]*SecurityRelease{}
+	for _, r := range rs {
+		byRelease[r.Release] = r
+	}
+	for rel, r := range d.releases {
+		c := *r
+		byRelease[rel] = &c
+	}
+	rs = nil
+	for _, rel := range sortedKeys(byRelease) {
+		rs = append(rs, byRelease[rel])
+	}
+	return rs, nil
+}
+
+// SetUnfixedModule implements Store.SetUnfixedModule.
+func (d *DryRunStore) SetUnfixedModule(ctx context.Context, u *UnfixedModule) error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+	log.Debugf(ctx, "dry run: would set UnfixedModule %s %s", u.ReportID, u.Module)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c := *u
+	d.unfixed[unfixedKey{u.ReportID, u.Module}] = &c
+	return nil
+}
+
+// DeleteUnfixedModule implements Store.DeleteUnfixedModule.
+func (d *DryRunStore) DeleteUnfixedModule(ctx context.Context, reportID, module string) error {
+	log.Debugf(ctx, "dry run: would delete UnfixedModule %s %s", reportID, module)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.unfixed[unfixedKey{reportID, module}] = nil
+	return nil
+}
+
+// ListUnfixedModules implements Store.ListUnfixedModules, including the
+// changes that the dry run made.
+func (d *DryRunStore) ListUnfixedModules(ctx context.Context) ([]*UnfixedModule, error) {
+	us, err := d.s.ListUnfixedModules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	byKey := map[unfixedKey]*UnfixedModule{}
+	for _, u := range us {
+		byKey[unfixedKey{u.ReportID, u.Module}] = u
+	}
+	for k, u := range d.unfixed {
+		if u == nil {
+			delete(byKey, k)
+			continue
+		}
+		c := *u
+		byKey[k] = &c
+	}
+	us = nil
+	for _, u := range byKey {
+		us = append(us, u)
+	}
+	sortUnfixedModules(us)
+	return us, nil
+}
+
+// SetReportReview implements Store.SetReportReview.
+func (d *DryRunStore) SetReportReview(ctx context.Context, r *ReportReview) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	log.Debugf(ctx, "dry run: would set ReportReview %s", r.ReportID)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c := *r
+	d.reviews[r.ReportID] = &c
+	return nil
+}
+
+// GetReportReview implements Store.GetReportReview, including the changes
+// that the dry run made.
+func (d *DryRunStore) GetReportReview(ctx context.Context, reportID string) (*ReportReview, error) {
+	d.mu.Lock()
+	r, ok := d.reviews[reportID]
+	d.mu.Unlock()
+	if ok {
+		c := *r
+		return &c, nil
+	}
+	return d.s.GetReportReview(ctx, reportID)
+}
+
+// ListReportReviews implements Store.ListReportReviews, including the
+// changes that the dry run made.
+func (d *DryRunStore) ListReportReviews(ctx context.Context) ([]*ReportReview, error) {
+	rs, err := d.s.ListReportReviews(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	byID := map[string]*ReportReview{}
+	for _, r := range rs {
+		byID[r.ReportID] = r
+	}
+	for id, r := range d.reviews {
+		c := *r
+		byID[id] = &c
+	}
+	rs = nil
+	for _, id := range sortedKeys(byID) {
+		rs = append(rs, byID[id])
+	}
+	return rs, nil
+}
+
+// SetRepoModules implements Store.SetRepoModules.
+func (d *DryRunStore) SetRepoModules(ctx context.Context, r *RepoModules) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	log.Debugf(ctx, "dry run: would set RepoModules %s", r.Repo)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.repoModules[r.Repo] = copyRepoModules(r)
+	return nil
+}
+
+// GetRepoModules implements Store.GetRepoModules, including the changes
+// that the dry run made.
+func (d *DryRunStore) GetRepoModules(ctx context.Context, repo string) (*RepoModules, error) {
+	d.mu.Lock()
+	r, ok := d.repoModules[repo]
+	d.mu.Unlock()
+	if ok {
+		return copyRepoModules(r), nil
+	}
+	return d.s.GetRepoModules(ctx, repo)
+}
+
+// ListDepsDevDiscrepancies implements Store.ListDepsDevDiscrepancies.
+// If the dry run set them, it returns those.
+func (d *DryRunStore) ListDepsDevDiscrepancies(ctx context.Context) ([]*DepsDevDiscrepancy, error) {
+	d.mu.Lock()
+	set, ds := d.setDiscrepancies, append([]*DepsDevDiscrepancy(nil), d.discrepancies...)
+	d.mu.Unlock()
+	if set {
+		return ds, nil
+	}
+	return d.s.ListDepsDevDiscrepancies(ctx)
+}
+
+// RunTransaction implements Store.RunTransaction. The transaction reads from
+// a transaction of the other Store, and its writes are kept if it succeeds.
+func (d *DryRunStore) RunTransaction(ctx context.Context, f func(context.Context, Transaction) error) error {
+	var dtx *dryRunTransaction
+	err := d.s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		// The other Store may run f more than once.
+		dtx = &dryRunTransaction{
+			d:           d,
+			tx:          tx,
+			cveRecords:  map[string]*CVERecord{},
+			oldCVEs:     map[string]*CVERecord{},
+			ghsaRecords: map[string]*GHSARecord{},
+			oldGHSAs:    map[string]*GHSARecord{},
+			searchTerms: map[string][]string{},
+			reviews:     map[string]*ReportReview{},
+		}
+		return f(ctx, dtx)
+	})
+	if err != nil {
+		return err
+	}
+	dtx.commit(ctx)
+	return nil
+}
+
+// dryRunTransaction implements Transaction for a DryRunStore.
+type dryRunTransaction struct {
+	d  *DryRunStore
+	tx Transaction
+
+	// The writes of the transaction, like those of a DryRunStore.
+	cveRecords  map[string]*CVERecord
+	oldCVEs     map[string]*CVERecord
+	ghsaRecords map[string]*GHSARecord
+	oldGHSAs    map[string]*GHSARecord
+	aliases     [][]string
+	searchTerms map[string][]string
+	reviews     map[string]*ReportReview
+	history     []*TriageHistoryEntry
+}
+
+// commit logs the writes of the transaction and adds them to its store.
+func (t *dryRunTransaction) commit(ctx context.Context) {
+	d := t.d
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, id := range sortedKeys(t.cveRecords) {
+		r := t.cveRecords[id]
+		if _, ok := d.oldCVEs[id]; !ok {
+			d.oldCVEs[id] = t.oldCVEs[id]
+		}
+		logWrite(ctx, "CVERecord", id, d.oldCVEs[id] == nil, r.TriageState)
+		d.cveRecords[id] = r
+	}
+	for _, id := range sortedKeys(t.ghsaRecords) {
+		r := t.ghsaRecords[id]
+		if _, ok := d.oldGHSAs[id]; !ok {
+			d.oldGHSAs[id] = t.oldGHSAs[id]
+		}
+		logWrite(ctx, "GHSARecord", id, d.oldGHSAs[id] == nil, r.TriageState)
+		d.ghsaRecords[id] = r
+	}
+	for _, ids := range t.aliases {
+		log.Debugf(ctx, "dry run: would add aliases %s", strings.Join(ids, ", "))
+	}
+	d.aliases = append(d.aliases, t.aliases...)
+	if len(t.searchTerms) > 0 {
+		log.Debugf(ctx, "dry run: would set the terms of %d search documents", len(t.searchTerms))
+	}
+	for id, terms := range t.searchTerms {
+		d.searchTerms[id] = terms
+	}
+	for _, id := range sortedKeys(t.reviews) {
+		log.Debugf(ctx, "dry run: would set ReportReview %s", id)
+		d.reviews[id] = t.reviews[id]
+	}
+	if len(t.history) > 0 {
+		log.Infof(ctx, "dry run: would add %d triage history entries", len(t.history))
+	}
+	d.history = append(d.history, t.history...)
+}
+
+func logWrite(ctx context.Context, kind, id string, created bool, ts TriageState) {
+	verb := "set"
+	if created {
+		verb = "create"
+	}
+	log.With("triageState", ts).Infof(ctx, "dry run: would %s %s %s", verb, kind, id)
+}
+
+// CreateCVERecord implements Transaction.CreateCVERecord.
+func (t *dryRunTransaction) CreateCVERecord(r *CVERecord) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	old, err := t.getCVERecord(r.ID)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		return fmt.Errorf("CVERecord %s already exists", r.ID)
+	}
+	if r.Version == 0 {
+		r.Version = 1
+	}
+	return t.setCVERecord(r, nil)
+}
+
+// SetCVERecord implements Transaction.SetCVERecord.
+func (t *dryRunTransaction) SetCVERecord(r *CVERecord) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	old, err := t.getCVERecord(r.ID)
+	if err != nil {
+		return err
+	}
+	var stored int64
+	if old != nil {
+		stored = old.Version
+	}
+	if err := checkVersion(r, stored, old != nil); err != nil {
+		return err
+	}
+	r.Version++
+	return t.setCVERecord(r, old)
+}
+
+func (t *dryRunTransaction) setCVERecord(r, old *CVERecord) error {
+	if _, ok := t.oldCVEs[r.ID]; !ok {
+		t.oldCVEs[r.ID] = old
+	}
+	c := *r
+	t.cveRecords[r.ID] = &c
+	return nil
+}
+
+// getCVERecord returns the current version of the CVERecord with id, or nil
+// if there is none.
+func (t *dryRunTransaction) getCVERecord(id string) (*CVERecord, error) {
+	crs, err := t.GetCVERecords(id, id)
+	if err != nil || len(crs) == 0 {
+		return nil, err
+	}
+	return crs[0], nil
+}
+
+// GetCVERecords implements Transaction.GetCVERecords.
+func (t *dryRunTransaction) GetCVERecords(startID, endID string) ([]*CVERecord, error) {
+	crs, err := t.tx.GetCVERecords(startID, endID)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[string]*CVERecord{}
+	for _, r := range crs {
+		byID[r.ID] = r
+	}
+	t.d.mu.Lock()
+	for id, r := range t.d.cveRecords {
+		if id >= startID && id <= endID {
+			byID[id] = r
+		}
+	}
+	t.d.mu.Unlock()
+	for id, r := range t.cveRecords {
+		if id >= startID && id <= endID {
+			byID[id] = r
+		}
+	}
+	crs = crs[:0]
+	for _, id := range sortedKeys(byID) {
+		c := *byID[id]
+		crs = append(crs, &c)
+	}
+	return crs, nil
+}
+
+// CreateGHSARecord implements Transaction.CreateGHSARecord.
+func (t *dryRunTransaction) CreateGHSARecord(r *GHSARecord) error {
+	if old, _ := t.GetGHSARecord(r.GHSA.ID); old != nil {
+		return fmt.Errorf("GHSARecord %s already exists", r.GHSA.ID)
+	}
+	return t.setGHSARecord(r, nil)
+}
+
+// SetGHSARecord implements Transaction.SetGHSARecord.
+func (t *dryRunTransaction) SetGHSARecord(r *GHSARecord) error {
+	old, _ := t.GetGHSARecord(r.GHSA.ID)
+	if old == nil {
+		return fmt.Errorf("GHSARecord %s does not exist", r.GHSA.ID)
+	}
+	return t.setGHSARecord(r, old)
+}
+
+func (t *dryRunTransaction) setGHSARecord(r, old *GHSARecord) error {
+	if _, ok := t.oldGHSAs[r.GHSA.ID]; !ok {
+		t.oldGHSAs[r.GHSA.ID] = old
+	}
+	c := *r
+	t.ghsaRecords[r.GHSA.ID] = &c
+	return nil
+}
+
+// GetGHSARecord implements Transaction.GetGHSARecord.
+func (t *dryRunTransaction) GetGHSARecord(id string) (*GHSARecord, error) {
+	if r, ok := t.ghsaRecords[id]; ok {
+		c := *r
+		return &c, nil
+	}
+	t.d.mu.Lock()
+	r, ok := t.d.ghsaRecords[id]
+	t.d.mu.Unlock()
+	if ok {
+		c := *r
+		return &c, nil
+	}
+	return t.tx.GetGHSARecord(id)
+}
+
+// GetGHSARecords implements Transaction.GetGHSARecords.
+func (t *dryRunTransaction) GetGHSARecords() ([]*GHSARecord, error) {
+	rs, err := t.tx.GetGHSARecords()
+	if err != nil {
+		return nil, err
+	}
+	byID := map[string]*GHSARecord{}
+	for _, r := range rs {
+		byID[r.GHSA.ID] = r
+	}
+	t.d.mu.Lock()
+	for id, r := range t.d.ghsaRecords {
+		byID[id] = r
+	}
+	t.d.mu.Unlock()
+	for id, r := range t.ghsaRecords {
+		byID[id] = r
+	}
+	rs = rs[:0]
+	for _, id := range sortedKeys(byID) {
+		c := *byID[id]
+		rs = append(rs, &c)
+	}
+	return rs, nil
+}
+
+// AddAliases implements Transaction.AddAliases.
+func (t *dryRunTransaction) AddAliases(ids ...string) error {
+	t.aliases = append(t.aliases, ids)
+	return nil
+}
+
+// GetAliases implements Transaction.GetAliases.
+func (t *dryRunTransaction) GetAliases(id string) ([]string, error) {
+	return t.tx.GetAliases(id)
+}
+
+// SetSearchTerms implements Transaction.SetSearchTerms.
+func (t *dryRunTransaction) SetSearchTerms(id string, terms []string) error {
+	t.searchTerms[id] = uniqueTerms(terms)
+	return nil
+}
+
+// SetReportReview implements Transaction.SetReportReview.
+func (t *dryRunTransaction) SetReportReview(r *ReportReview) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	c := *r
+	t.reviews[r.ReportID] = &c
+	return nil
+}
+
+// GetReportReview implements Transaction.GetReportReview, including the
+// changes that the dry run and the transaction made.
+func (t *dryRunTransaction) GetReportReview(reportID string) (*ReportReview, error) {
+	r, ok := t.reviews[reportID]
+	if !ok {
+		t.d.mu.Lock()
+		r, ok = t.d.reviews[reportID]
+		t.d.mu.Unlock()
+	}
+	if ok {
+		c := *r
+		return &c, nil
+	}
+	return t.tx.GetReportReview(reportID)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// WriteSummary writes a summary of the writes of the dry run to w, in the
+// style of a diff: "+" marks a created record and "~" a changed one, followed
+// by the changes to its fields.
+func (d *DryRunStore) WriteSummary(w io.Writer) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var b strings.Builder
+	// The copies of CVEs and the history are large and mostly unchanged.
+	ignore := cmpopts.IgnoreFields(CVERecord{}, "CVE", "History")
+	for _, id := range sortedKeys(d.cveRecords) {
+		r, old := d.cveRecords[id], d.oldCVEs[id]
+		if old == nil {
+			fmt.Fprintf(&b, "+ CVERecord %s: %s\n", id, stateAndReason(r.TriageState, r.TriageStateReason))
+			continue
+		}
+		if diff := cmp.Diff(old, r, ignore); diff != "" {
+			fmt.Fprintf(&b, "~ CVERecord %s: %s\n", id, stateChange(old.TriageState, r.TriageState, r.TriageStateReason))
+			writeIndented(&b, diff)
+		}
+	}
+	for _, id := range sortedKeys(d.ghsaRecords) {
+		r, old := d.ghsaRecords[id], d.oldGHSAs[id]
+		if old == nil {
+			fmt.Fprintf(&b, "+ GHSARecord %s: %s\n", id, stateAndReason(r.TriageState, r.TriageStateReason))
+			continue
+		}
+		if diff := cmp.Diff(old, r); diff != "" {
+			fmt.Fprintf(&b, "~ GHSARecord %s: %s\n", id, stateChange(old.TriageState, r.TriageState, r.TriageStateReason))
+			writeIndented(&b, diff)
+		}
+	}
+	for _, ids := range d.aliases {
+		fmt.Fprintf(&b, "+ aliases %s\n", strings.Join(ids, ", "))
+	}
+	for _, src := range sortedKeys(d.fetchCursors) {
+		fmt.Fprintf(&b, "~ fetch cursor %s: %s\n", src, d.fetchCursors[src].Format(time.RFC3339))
+	}
+	if n := len(d.history); n > 0 {
+		fmt.Fprintf(&b, "+ %d triage history entries\n", n)
+	}
+	if n := len(d.searchTerms); n > 0 {
+		fmt.Fprintf(&b, "~ %d search documents\n", n)
+	}
+	if n := len(d.dirHashes); n > 0 {
+		fmt.Fprintf(&b, "~ %d directory hashes\n", n)
+	}
+	for _, id := range sortedKeys(d.updateRecords) {
+		r := d.updateRecords[id]
+		fmt.Fprintf(&b, "+ CommitUpdateRecord for commit %s: %d processed, %d added, %d modified\n",
+			r.CommitHash, r.NumProcessed, r.NumAdded, r.NumModified)
+	}
+	for _, r := range d.modScanRecords {
+		fmt.Fprintf(&b, "+ ModuleScanRecord %s@%s\n", r.Path, r.Version)
+	}
+	if d.setDiscrepancies {
+		fmt.Fprintf(&b, "~ %d deps.dev discrepancies\n", len(d.discrepancies))
+	}
+	for _, src := range sortedKeys(d.sourceStatus) {
+		fmt.Fprintf(&b, "~ status of source %s\n", src)
+	}
+	for _, p := range sortedKeys(d.quarantine) {
+		switch q := d.quarantine[p]; {
+		case q == nil:
+			fmt.Fprintf(&b, "- parse failures of %s\n", p)
+		case q.Quarantined:
+			fmt.Fprintf(&b, "~ quarantine %s after %d failures: %s\n", p, q.NumFailures, q.Error)
+		default:
+			fmt.Fprintf(&b, "~ parse failure %d of %s: %s\n", q.NumFailures, p, q.Error)
+		}
+	}
+	for _, rel := range sortedKeys(d.releases) {
+		fmt.Fprintf(&b, "~ security release %s: missing reports for %s\n",
+			rel, strings.Join(d.releases[rel].Missing, ", "))
+	}
+	var keys []unfixedKey
+	for k := range d.unfixed {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].reportID != keys[j].reportID {
+			return keys[i].reportID < keys[j].reportID
+		}
+		return keys[i].module < keys[j].module
+	})
+	numChecked := 0
+	for _, k := range keys {
+		switch u := d.unfixed[k]; {
+		case u == nil:
+			fmt.Fprintf(&b, "- unfixed module %s of %s\n", k.module, k.reportID)
+		case u.FlaggedVersion != "":
+			fmt.Fprintf(&b, "~ unfixed module %s of %s: review %s\n", k.module, k.reportID, u.FlaggedVersion)
+		default:
+			numChecked++
+		}
+	}
+	if numChecked > 0 {
+		fmt.Fprintf(&b, "~ %d unfixed modules\n", numChecked)
+	}
+	for _, id := range sortedKeys(d.reviews) {
+		r := d.reviews[id]
+		fmt.Fprintf(&b, "~ review of %s: %s, assigned to %s\n", id, r.State, r.Assignee)
+	}
+	for _, repo := range sortedKeys(d.repoModules) {
+		fmt.Fprintf(&b, "~ modules of %s: %d\n", repo, len(d.repoModules[repo].Modules))
+	}
+	if b.Len() == 0 {
+		b.WriteString("no writes\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func stateAndReason(ts TriageState, reason string) string {
+	if reason == "" {
+		return string(ts)
+	}
+	return fmt.Sprintf("%s (%s)", ts, reason)
+}
+
+func stateChange(old, new TriageState, reason string) string {
+	if old == new {
+		return string(new)
+	}
+	return fmt.Sprintf("%s -> %s", old, stateAndReason(new, reason))
+}
+
+// writeIndented writes the lines of s to b, indented.
+func writeIndented(b *strings.Builder, s string) {
+	for _, line := range strings.Split(strings.TrimRight(s, "\n"), "\n") {
+		fmt.Fprintf(b, "    %s\n", line)
+	}
+}
+
+// AddTriageHistory implements Transaction.AddTriageHistory.
+func (t *dryRunTransaction) AddTriageHistory(es ...*TriageHistoryEntry) error {
+	t.history = append(t.history, es...)
+	return nil
+}
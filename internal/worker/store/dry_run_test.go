@@ -0,0 +1,87 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package store
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDryRunStore(t *testing.T) {
+	ctx := context.Background()
+	ms := NewMemStore()
+	newRecord := func(id string, ts TriageState) *CVERecord {
+		return &CVERecord{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			TriageState: ts,
+		}
+	}
+	must(ms.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		return tx.CreateCVERecord(newRecord("CVE-2022-0001", TriageStateNoActionNeeded))
+	}))(t)
+
+	d := NewDryRunStore(ms)
+	must(d.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		if err := tx.CreateCVERecord(newRecord("CVE-2022-0002", TriageStateNeedsIssue)); err != nil {
+			return err
+		}
+		if err := tx.CreateCVERecord(newRecord("CVE-2022-0001", TriageStateNeedsIssue)); err == nil {
+			t.Error("created existing record")
+		}
+		r := newRecord("CVE-2022-0001", TriageStateNeedsIssue)
+		r.TriageStateReason = "rule"
+		r.Version = 1
+		return tx.SetCVERecord(r)
+	}))(t)
+	must(d.SetFetchCursor(ctx, "nvd", time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)))(t)
+
+	// The dry run sees its writes.
+	crs := must1(d.ListCVERecordsWithTriageState(ctx, TriageStateNeedsIssue))(t)
+	var ids []string
+	for _, r := range crs {
+		ids = append(ids, r.ID)
+	}
+	if want := []string{"CVE-2022-0001", "CVE-2022-0002"}; !cmp.Equal(ids, want) {
+		t.Errorf("got %v, want %v", ids, want)
+	}
+	if got := must1(d.GetCVERecord(ctx, "CVE-2022-0002"))(t); got == nil {
+		t.Error("created record not found")
+	}
+
+	// The other store doesn't.
+	if got := must1(ms.GetCVERecord(ctx, "CVE-2022-0002"))(t); got != nil {
+		t.Errorf("record created in other store: %+v", got)
+	}
+	if got := must1(ms.GetCVERecord(ctx, "CVE-2022-0001"))(t); got.TriageState != TriageStateNoActionNeeded {
+		t.Errorf("record changed in other store: %+v", got)
+	}
+	if got := must1(ms.GetFetchCursor(ctx, "nvd"))(t); !got.IsZero() {
+		t.Errorf("fetch cursor set in other store: %s", got)
+	}
+
+	var b strings.Builder
+	must(d.WriteSummary(&b))(t)
+	got := b.String()
+	for _, want := range []string{
+		"~ CVERecord CVE-2022-0001: NoActionNeeded -> NeedsIssue (rule)\n",
+		"+ CVERecord CVE-2022-0002: NeedsIssue\n",
+		"~ fetch cursor nvd: 2022-02-01T00:00:00Z\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("summary missing %q:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17 && integration && (linux || darwin)
+// +build go1.17
+// +build integration
+// +build linux darwin
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// emulatorHostEnv is the environment variable that tells the Firestore
+// client to use an emulator at the given host and port instead of Firestore.
+const emulatorHostEnv = "FIRESTORE_EMULATOR_HOST"
+
+// emulatorStartTimeout is how long to wait for a started emulator to be
+// ready. It is a Java program, and slow to start.
+const emulatorStartTimeout = time.Minute
+
+// TestFireStoreEmulator runs the store tests on FireStore, backed by the
+// Firestore emulator. Run it with
+//
+//	go test -tags=integration ./internal/worker/store
+//
+// It uses the emulator at FIRESTORE_EMULATOR_HOST, if that is set, and
+// otherwise starts one with gcloud, which needs the cloud-firestore-emulator
+// component.
+func TestFireStoreEmulator(t *testing.T) {
+	useFirestoreEmulator(t)
+	ctx := context.Background()
+	// A unique namespace, in case the emulator is shared.
+	namespace := fmt.Sprintf("integration-%d", time.Now().UnixNano())
+	fs, err := NewFireStore(ctx, "vulndb-test", namespace, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := fs.Clear(ctx); err != nil {
+			t.Log(err)
+		}
+	}()
+	testStore(t, fs)
+}
+
+// useFirestoreEmulator makes Firestore clients created by t use the emulator,
+// starting one if FIRESTORE_EMULATOR_HOST isn't set. A started emulator is
+// stopped when t finishes.
+func useFirestoreEmulator(t *testing.T) {
+	t.Helper()
+	if os.Getenv(emulatorHostEnv) != "" {
+		return
+	}
+	gcloud, err := exec.LookPath("gcloud")
+	if err != nil {
+		t.Fatalf("%s is not set, and gcloud is not installed to start an emulator", emulatorHostEnv)
+	}
+	host := fmt.Sprintf("localhost:%d", freePort(t))
+	var out bytes.Buffer
+	cmd := exec.Command(gcloud, "emulators", "firestore", "start", "--host-port="+host)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	// gcloud runs the emulator in a child process. Put them in a process
+	// group, to stop them together.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM)
+		cmd.Wait()
+	})
+	if err := waitForEmulator(host, emulatorStartTimeout); err != nil {
+		t.Fatalf("%v; emulator output:\n%s", err, out.String())
+	}
+	t.Setenv(emulatorHostEnv, host)
+}
+
+// freePort returns a TCP port on localhost that is not in use.
+func freePort(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// waitForEmulator waits until the emulator at host answers HTTP requests.
+func waitForEmulator(host string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		resp, err := http.Get("http://" + host)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	return fmt.Errorf("emulator at %s not ready after %s", host, timeout)
+}
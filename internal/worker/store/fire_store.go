@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -34,6 +35,20 @@ import (
 // - DirHashes for directory hashes
 // - GHSAs for GHSARecords.
 // - ModuleScans for ModuleScanRecords.
+// - FetchCursors for fetch cursors.
+// - Locks for locks.
+// - Aliases for the direct aliases of each ID.
+// - SearchTerms for the terms of each search document.
+// - DepsDevDiscrepancies for DepsDevDiscrepancies.
+// - SourceStatus for SourceStatuses, by source.
+// - QuarantinedFiles for QuarantinedFiles, by path.
+// - SecurityReleases for SecurityReleases, by release.
+// - UnfixedModules for UnfixedModules, by report ID and module.
+// - ReportReviews for ReportReviews, by report ID.
+// - RepoModules for RepoModules, by repo.
+//
+// Each CVE and GHSA document has a TriageHistory sub-collection for its
+// TriageHistoryEntries.
 type FireStore struct {
 	namespace string
 	client    *firestore.Client
@@ -41,25 +56,37 @@ type FireStore struct {
 }
 
 const (
-	namespaceCollection = "Namespaces"
-	updateCollection    = "Updates"
-	cveCollection       = "CVEs"
-	dirHashCollection   = "DirHashes"
-	ghsaCollection      = "GHSAs"
-	modScanCollection   = "ModuleScans"
+	namespaceCollection    = "Namespaces"
+	updateCollection       = "Updates"
+	cveCollection          = "CVEs"
+	dirHashCollection      = "DirHashes"
+	ghsaCollection         = "GHSAs"
+	modScanCollection      = "ModuleScans"
+	cursorCollection       = "FetchCursors"
+	lockCollection         = "Locks"
+	historyCollection      = "TriageHistory"
+	aliasCollection        = "Aliases"
+	searchCollection       = "SearchTerms"
+	discrepancyCollection  = "DepsDevDiscrepancies"
+	sourceStatusCollection = "SourceStatus"
+	quarantineCollection   = "QuarantinedFiles"
+	releaseCollection      = "SecurityReleases"
+	unfixedCollection      = "UnfixedModules"
+	reviewCollection       = "ReportReviews"
+	repoModulesCollection  = "RepoModules"
 )
 
 // NewFireStore creates a new FireStore, backed by a client to Firestore. Since
 // each project can have only one Firestore database, callers must provide a
-// non-empty namespace to distinguish different virtual databases (e.g. prod and
-// testing).
+// valid namespace (see ValidateNamespace) to distinguish different virtual
+// databases (e.g. prod and testing).
 // If non-empty, the impersonate argument should be the name of a service
 // account to impersonate.
 func NewFireStore(ctx context.Context, projectID, namespace, impersonate string) (_ *FireStore, err error) {
 	defer derrors.Wrap(&err, "NewFireStore(%q, %q)", projectID, namespace)
 
-	if namespace == "" {
-		return nil, errors.New("empty namespace")
+	if err := ValidateNamespace(namespace); err != nil {
+		return nil, err
 	}
 	var opts []option.ClientOption
 	if impersonate != "" {
@@ -159,6 +186,68 @@ func (fs *FireStore) ListCVERecordsWithTriageState(ctx context.Context, ts Triag
 	return docsnapsToCVERecords(docsnaps)
 }
 
+// ListCVERecords implements Store.ListCVERecords.
+func (fs *FireStore) ListCVERecords(ctx context.Context, q CVERecordQuery) (_ []*CVERecord, _ string, err error) {
+	defer derrors.Wrap(&err, "ListCVERecords(%+v)", q)
+
+	fq := fs.nsDoc.Collection(cveCollection).Query
+	if q.TriageState != "" {
+		fq = fq.Where("TriageState", "==", q.TriageState)
+	}
+	if q.Module != "" {
+		fq = fq.Where("Module", "==", q.Module)
+	}
+	if !q.UpdatedSince.IsZero() {
+		return fs.listCVERecordsUpdatedSince(ctx, fq, q)
+	}
+	fq = fq.OrderBy("ID", firestore.Asc)
+	start, end := q.idRange()
+	if start != "" {
+		fq = fq.StartAfter(start)
+	}
+	if end != "" {
+		fq = fq.EndBefore(end)
+	}
+	if q.Limit > 0 {
+		fq = fq.Limit(q.Limit + 1)
+	}
+	docsnaps, err := fq.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, "", err
+	}
+	crs, err := docsnapsToCVERecords(docsnaps)
+	if err != nil {
+		return nil, "", err
+	}
+	crs, next := pageCVERecords(crs, q.Limit)
+	return crs, next, nil
+}
+
+// listCVERecordsUpdatedSince lists the records of fq that q.UpdatedSince
+// matches. Firestore requires a query with an inequality filter to be ordered
+// first by the filtered field, so the query selects all the records updated
+// since then, which are few, and they are ordered by ID and paged here.
+func (fs *FireStore) listCVERecordsUpdatedSince(ctx context.Context, fq firestore.Query, q CVERecordQuery) ([]*CVERecord, string, error) {
+	docsnaps, err := fq.Where("CommitTime", ">=", q.UpdatedSince).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, "", err
+	}
+	all, err := docsnapsToCVERecords(docsnaps)
+	if err != nil {
+		return nil, "", err
+	}
+	start, end := q.idRange()
+	var crs []*CVERecord
+	for _, cr := range all {
+		if cr.ID > start && (end == "" || cr.ID < end) {
+			crs = append(crs, cr)
+		}
+	}
+	sort.Slice(crs, func(i, j int) bool { return crs[i].ID < crs[j].ID })
+	crs, next := pageCVERecords(crs, q.Limit)
+	return crs, next, nil
+}
+
 // CreateModuleScanRecord implements Store.CreateModuleScanRecord.
 func (fs *FireStore) CreateModuleScanRecord(ctx context.Context, r *ModuleScanRecord) error {
 	if err := r.Validate(); err != nil {
@@ -251,12 +340,529 @@ func (fs *FireStore) SetDirectoryHash(ctx context.Context, dir, hash string) err
 	return err
 }
 
+// A fetchCursor is the document that holds a fetch cursor.
+type fetchCursor struct {
+	Time time.Time
+}
+
+// GetFetchCursor implements Store.GetFetchCursor.
+func (fs *FireStore) GetFetchCursor(ctx context.Context, source string) (_ time.Time, err error) {
+	defer derrors.Wrap(&err, "GetFetchCursor(%s)", source)
+
+	ds, err := fs.nsDoc.Collection(cursorCollection).Doc(source).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	var c fetchCursor
+	if err := ds.DataTo(&c); err != nil {
+		return time.Time{}, err
+	}
+	return c.Time, nil
+}
+
+// SetFetchCursor implements Store.SetFetchCursor.
+func (fs *FireStore) SetFetchCursor(ctx context.Context, source string, t time.Time) (err error) {
+	defer derrors.Wrap(&err, "SetFetchCursor(%s)", source)
+
+	_, err = fs.nsDoc.Collection(cursorCollection).Doc(source).Set(ctx, fetchCursor{Time: t})
+	return err
+}
+
+// A lock is the document that holds a lock.
+type lock struct {
+	Owner   string
+	Expires time.Time
+}
+
+// AcquireLock implements Store.AcquireLock.
+func (fs *FireStore) AcquireLock(ctx context.Context, name, owner string, ttl time.Duration) (acquired bool, err error) {
+	defer derrors.Wrap(&err, "AcquireLock(%s, %s)", name, owner)
+
+	ref := fs.nsDoc.Collection(lockCollection).Doc(name)
+	err = fs.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		acquired = false
+		now := time.Now()
+		ds, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			var l lock
+			if err := ds.DataTo(&l); err != nil {
+				return err
+			}
+			if l.Owner != owner && now.Before(l.Expires) {
+				return nil
+			}
+		}
+		acquired = true
+		return tx.Set(ref, lock{Owner: owner, Expires: now.Add(ttl)})
+	})
+	if err != nil {
+		return false, err
+	}
+	return acquired, nil
+}
+
+// RenewLock implements Store.RenewLock.
+func (fs *FireStore) RenewLock(ctx context.Context, name, owner string, ttl time.Duration) (renewed bool, err error) {
+	defer derrors.Wrap(&err, "RenewLock(%s, %s)", name, owner)
+
+	ref := fs.nsDoc.Collection(lockCollection).Doc(name)
+	err = fs.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		renewed = false
+		now := time.Now()
+		ds, err := tx.Get(ref)
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var l lock
+		if err := ds.DataTo(&l); err != nil {
+			return err
+		}
+		if l.Owner != owner || !now.Before(l.Expires) {
+			return nil
+		}
+		renewed = true
+		return tx.Set(ref, lock{Owner: owner, Expires: now.Add(ttl)})
+	})
+	if err != nil {
+		return false, err
+	}
+	return renewed, nil
+}
+
+// ReleaseLock implements Store.ReleaseLock.
+func (fs *FireStore) ReleaseLock(ctx context.Context, name, owner string) (err error) {
+	defer derrors.Wrap(&err, "ReleaseLock(%s, %s)", name, owner)
+
+	ref := fs.nsDoc.Collection(lockCollection).Doc(name)
+	return fs.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		ds, err := tx.Get(ref)
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var l lock
+		if err := ds.DataTo(&l); err != nil {
+			return err
+		}
+		if l.Owner != owner {
+			return nil
+		}
+		return tx.Delete(ref)
+	})
+}
+
+// ListTriageHistory implements Store.ListTriageHistory.
+func (fs *FireStore) ListTriageHistory(ctx context.Context, id string) (_ []*TriageHistoryEntry, err error) {
+	defer derrors.Wrap(&err, "ListTriageHistory(%s)", id)
+
+	var es []*TriageHistoryEntry
+	iter := fs.historyCollection(id).OrderBy("Time", firestore.Asc).Documents(ctx)
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var e TriageHistoryEntry
+		if err := ds.DataTo(&e); err != nil {
+			return err
+		}
+		es = append(es, &e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+// aliasDoc is the document that holds the direct aliases of an ID.
+type aliasDoc struct {
+	IDs []string
+}
+
+// GetAliases implements Store.GetAliases.
+func (fs *FireStore) GetAliases(ctx context.Context, id string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "GetAliases(%s)", id)
+
+	return connectedAliases(id, func(id string) ([]string, error) {
+		return directAliases(fs.aliasRef(id).Get(ctx))
+	})
+}
+
+// directAliases returns the IDs in the aliasDoc ds, which may not exist.
+func directAliases(ds *firestore.DocumentSnapshot, err error) ([]string, error) {
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var a aliasDoc
+	if err := ds.DataTo(&a); err != nil {
+		return nil, err
+	}
+	return a.IDs, nil
+}
+
+// searchDoc is the document that holds the terms of a search document.
+type searchDoc struct {
+	Terms []string
+}
+
+// Search implements Store.Search.
+// Firestore allows only one array-contains filter in a query, so the terms
+// after the first are matched here rather than in the query.
+func (fs *FireStore) Search(ctx context.Context, terms []string, limit int) (_ []string, err error) {
+	defer derrors.Wrap(&err, "Search(%v)", terms)
+
+	terms = uniqueTerms(terms)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	iter := fs.nsDoc.Collection(searchCollection).Where("Terms", "array-contains", terms[0]).Documents(ctx)
+	defer iter.Stop()
+	var ids []string
+	err = apply(iter, func(ds *firestore.DocumentSnapshot) error {
+		var d searchDoc
+		if err := ds.DataTo(&d); err != nil {
+			return err
+		}
+		if hasAllTerms(d.Terms, terms[1:]) {
+			ids = append(ids, ds.Ref.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return limitIDs(ids, limit), nil
+}
+
+// maxBatchWrites is the most writes that Firestore allows in a batch.
+const maxBatchWrites = 500
+
+// SetDepsDevDiscrepancies implements Store.SetDepsDevDiscrepancies.
+// Unlike the SQL stores, it does not replace the discrepancies atomically:
+// a concurrent read may see some of the old or new ones missing.
+func (fs *FireStore) SetDepsDevDiscrepancies(ctx context.Context, ds []*DepsDevDiscrepancy) (err error) {
+	defer derrors.Wrap(&err, "SetDepsDevDiscrepancies")
+
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+	coll := fs.nsDoc.Collection(discrepancyCollection)
+	if err := deleteCollection(ctx, fs.client, coll, 100); err != nil {
+		return err
+	}
+	for i := 0; i < len(ds); i += maxBatchWrites {
+		j := i + maxBatchWrites
+		if j > len(ds) {
+			j = len(ds)
+		}
+		batch := fs.client.Batch()
+		for _, d := range ds[i:j] {
+			// Firestore IDs cannot contain slashes.
+			id := strings.ReplaceAll(d.Module, "/", "|") + "|" + d.AdvisoryID
+			batch.Set(coll.Doc(id), d)
+		}
+		if _, err := batch.Commit(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListDepsDevDiscrepancies implements Store.ListDepsDevDiscrepancies.
+func (fs *FireStore) ListDepsDevDiscrepancies(ctx context.Context) (_ []*DepsDevDiscrepancy, err error) {
+	defer derrors.Wrap(&err, "ListDepsDevDiscrepancies")
+
+	iter := fs.nsDoc.Collection(discrepancyCollection).Documents(ctx)
+	defer iter.Stop()
+	var ds []*DepsDevDiscrepancy
+	err = apply(iter, func(docsnap *firestore.DocumentSnapshot) error {
+		var d DepsDevDiscrepancy
+		if err := docsnap.DataTo(&d); err != nil {
+			return err
+		}
+		ds = append(ds, &d)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortDepsDevDiscrepancies(ds)
+	return ds, nil
+}
+
+// SetSourceStatus implements Store.SetSourceStatus.
+func (fs *FireStore) SetSourceStatus(ctx context.Context, s *SourceStatus) (err error) {
+	defer derrors.Wrap(&err, "SetSourceStatus(%s)", s.Source)
+
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	_, err = fs.nsDoc.Collection(sourceStatusCollection).Doc(s.Source).Set(ctx, s)
+	return err
+}
+
+// ListSourceStatuses implements Store.ListSourceStatuses.
+func (fs *FireStore) ListSourceStatuses(ctx context.Context) (_ []*SourceStatus, err error) {
+	defer derrors.Wrap(&err, "ListSourceStatuses")
+
+	iter := fs.nsDoc.Collection(sourceStatusCollection).OrderBy(firestore.DocumentID, firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var ss []*SourceStatus
+	err = apply(iter, func(docsnap *firestore.DocumentSnapshot) error {
+		var s SourceStatus
+		if err := docsnap.DataTo(&s); err != nil {
+			return err
+		}
+		ss = append(ss, &s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ss, nil
+}
+
+// quarantineDoc returns the document of the QuarantinedFile for path.
+func (fs *FireStore) quarantineDoc(path string) *firestore.DocumentRef {
+	// Firestore IDs cannot contain slashes.
+	return fs.nsDoc.Collection(quarantineCollection).Doc(strings.ReplaceAll(path, "/", "|"))
+}
+
+// SetQuarantinedFile implements Store.SetQuarantinedFile.
+func (fs *FireStore) SetQuarantinedFile(ctx context.Context, q *QuarantinedFile) (err error) {
+	defer derrors.Wrap(&err, "SetQuarantinedFile(%s)", q.Path)
+
+	if err := q.Validate(); err != nil {
+		return err
+	}
+	_, err = fs.quarantineDoc(q.Path).Set(ctx, q)
+	return err
+}
+
+// DeleteQuarantinedFile implements Store.DeleteQuarantinedFile.
+func (fs *FireStore) DeleteQuarantinedFile(ctx context.Context, path string) (err error) {
+	defer derrors.Wrap(&err, "DeleteQuarantinedFile(%s)", path)
+
+	_, err = fs.quarantineDoc(path).Delete(ctx)
+	return err
+}
+
+// ListQuarantinedFiles implements Store.ListQuarantinedFiles.
+func (fs *FireStore) ListQuarantinedFiles(ctx context.Context) (_ []*QuarantinedFile, err error) {
+	defer derrors.Wrap(&err, "ListQuarantinedFiles")
+
+	iter := fs.nsDoc.Collection(quarantineCollection).OrderBy("Path", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var qs []*QuarantinedFile
+	err = apply(iter, func(docsnap *firestore.DocumentSnapshot) error {
+		var q QuarantinedFile
+		if err := docsnap.DataTo(&q); err != nil {
+			return err
+		}
+		qs = append(qs, &q)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return qs, nil
+}
+
+// SetSecurityRelease implements Store.SetSecurityRelease.
+func (fs *FireStore) SetSecurityRelease(ctx context.Context, r *SecurityRelease) (err error) {
+	defer derrors.Wrap(&err, "SetSecurityRelease(%s)", r.Release)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	_, err = fs.nsDoc.Collection(releaseCollection).Doc(r.Release).Set(ctx, r)
+	return err
+}
+
+// ListSecurityReleases implements Store.ListSecurityReleases.
+func (fs *FireStore) ListSecurityReleases(ctx context.Context) (_ []*SecurityRelease, err error) {
+	defer derrors.Wrap(&err, "ListSecurityReleases")
+
+	iter := fs.nsDoc.Collection(releaseCollection).OrderBy(firestore.DocumentID, firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var rs []*SecurityRelease
+	err = apply(iter, func(docsnap *firestore.DocumentSnapshot) error {
+		var r SecurityRelease
+		if err := docsnap.DataTo(&r); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// unfixedDoc returns the document of the UnfixedModule for the report and
+// module.
+func (fs *FireStore) unfixedDoc(reportID, module string) *firestore.DocumentRef {
+	// Firestore IDs cannot contain slashes.
+	return fs.nsDoc.Collection(unfixedCollection).Doc(reportID + " " + strings.ReplaceAll(module, "/", "|"))
+}
+
+// SetUnfixedModule implements Store.SetUnfixedModule.
+func (fs *FireStore) SetUnfixedModule(ctx context.Context, u *UnfixedModule) (err error) {
+	defer derrors.Wrap(&err, "SetUnfixedModule(%s, %s)", u.ReportID, u.Module)
+
+	if err := u.Validate(); err != nil {
+		return err
+	}
+	_, err = fs.unfixedDoc(u.ReportID, u.Module).Set(ctx, u)
+	return err
+}
+
+// DeleteUnfixedModule implements Store.DeleteUnfixedModule.
+func (fs *FireStore) DeleteUnfixedModule(ctx context.Context, reportID, module string) (err error) {
+	defer derrors.Wrap(&err, "DeleteUnfixedModule(%s, %s)", reportID, module)
+
+	_, err = fs.unfixedDoc(reportID, module).Delete(ctx)
+	return err
+}
+
+// ListUnfixedModules implements Store.ListUnfixedModules.
+func (fs *FireStore) ListUnfixedModules(ctx context.Context) (_ []*UnfixedModule, err error) {
+	defer derrors.Wrap(&err, "ListUnfixedModules")
+
+	// Ordering by two fields would need a composite index; sort here
+	// instead.
+	iter := fs.nsDoc.Collection(unfixedCollection).Documents(ctx)
+	defer iter.Stop()
+	var us []*UnfixedModule
+	err = apply(iter, func(docsnap *firestore.DocumentSnapshot) error {
+		var u UnfixedModule
+		if err := docsnap.DataTo(&u); err != nil {
+			return err
+		}
+		us = append(us, &u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortUnfixedModules(us)
+	return us, nil
+}
+
+// SetReportReview implements Store.SetReportReview.
+func (fs *FireStore) SetReportReview(ctx context.Context, r *ReportReview) (err error) {
+	defer derrors.Wrap(&err, "SetReportReview(%s)", r.ReportID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	_, err = fs.reviewRef(r.ReportID).Set(ctx, r)
+	return err
+}
+
+// GetReportReview implements Store.GetReportReview.
+func (fs *FireStore) GetReportReview(ctx context.Context, reportID string) (_ *ReportReview, err error) {
+	defer derrors.Wrap(&err, "GetReportReview(%s)", reportID)
+
+	docsnap, err := fs.reviewRef(reportID).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var r ReportReview
+	if err := docsnap.DataTo(&r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ListReportReviews implements Store.ListReportReviews.
+func (fs *FireStore) ListReportReviews(ctx context.Context) (_ []*ReportReview, err error) {
+	defer derrors.Wrap(&err, "ListReportReviews")
+
+	iter := fs.nsDoc.Collection(reviewCollection).OrderBy(firestore.DocumentID, firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var rs []*ReportReview
+	err = apply(iter, func(docsnap *firestore.DocumentSnapshot) error {
+		var r ReportReview
+		if err := docsnap.DataTo(&r); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// repoModulesDoc returns the document of the RepoModules for repo.
+func (fs *FireStore) repoModulesDoc(repo string) *firestore.DocumentRef {
+	// Firestore IDs cannot contain slashes.
+	return fs.nsDoc.Collection(repoModulesCollection).Doc(strings.ReplaceAll(repo, "/", "|"))
+}
+
+// SetRepoModules implements Store.SetRepoModules.
+func (fs *FireStore) SetRepoModules(ctx context.Context, r *RepoModules) (err error) {
+	defer derrors.Wrap(&err, "SetRepoModules(%s)", r.Repo)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	_, err = fs.repoModulesDoc(r.Repo).Set(ctx, r)
+	return err
+}
+
+// GetRepoModules implements Store.GetRepoModules.
+func (fs *FireStore) GetRepoModules(ctx context.Context, repo string) (_ *RepoModules, err error) {
+	defer derrors.Wrap(&err, "GetRepoModules(%s)", repo)
+
+	docsnap, err := fs.repoModulesDoc(repo).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var r RepoModules
+	if err := docsnap.DataTo(&r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
 // RunTransaction implements Store.RunTransaction.
 func (fs *FireStore) RunTransaction(ctx context.Context, f func(context.Context, Transaction) error) error {
-	return fs.client.RunTransaction(ctx,
+	// Firestore may call the function more than once; only the tracker of
+	// the last call saw the changes that were committed.
+	var tracker *triageTracker
+	err := fs.client.RunTransaction(ctx,
 		func(ctx context.Context, tx *firestore.Transaction) error {
-			return f(ctx, &fsTransaction{fs, tx})
+			tracker = newTriageTracker(ctx)
+			return f(ctx, &fsTransaction{fs, tx, tracker, map[string]int64{}})
 		})
+	if err != nil {
+		return err
+	}
+	tracker.committed(ctx)
+	return nil
 }
 
 // cveRecordRef returns a DocumentRef to the CVERecord with id.
@@ -269,10 +875,50 @@ func (fs *FireStore) ghsaRecordRef(id string) *firestore.DocumentRef {
 	return fs.nsDoc.Collection(ghsaCollection).Doc(id)
 }
 
+// aliasRef returns a DocumentRef to the aliasDoc of id.
+func (fs *FireStore) aliasRef(id string) *firestore.DocumentRef {
+	return fs.nsDoc.Collection(aliasCollection).Doc(id)
+}
+
+// searchRef returns a DocumentRef to the searchDoc of id.
+func (fs *FireStore) searchRef(id string) *firestore.DocumentRef {
+	return fs.nsDoc.Collection(searchCollection).Doc(id)
+}
+
+// reviewRef returns a DocumentRef to the ReportReview of the report with the
+// given ID.
+func (fs *FireStore) reviewRef(reportID string) *firestore.DocumentRef {
+	return fs.nsDoc.Collection(reviewCollection).Doc(reportID)
+}
+
+// historyCollection returns the TriageHistory sub-collection of the CVE or
+// GHSA with id.
+func (fs *FireStore) historyCollection(id string) *firestore.CollectionRef {
+	if isGHSAID(id) {
+		return fs.ghsaRecordRef(id).Collection(historyCollection)
+	}
+	return fs.cveRecordRef(id).Collection(historyCollection)
+}
+
 // fsTransaction implements Transaction
 type fsTransaction struct {
-	s *FireStore
-	t *firestore.Transaction
+	s       *FireStore
+	t       *firestore.Transaction
+	tracker *triageTracker
+	// versions holds the versions of the CVERecords read in the
+	// transaction. Firestore doesn't allow reads after writes in a
+	// transaction, so SetCVERecord checks versions against these.
+	versions map[string]int64
+}
+
+// addTriageHistory adds a TriageHistoryEntry for the record with the given
+// ID, if its triage state has changed.
+func (tx *fsTransaction) addTriageHistory(id string, ts TriageState, reason string) error {
+	e := tx.tracker.write(id, ts, reason)
+	if e == nil {
+		return nil
+	}
+	return tx.t.Create(tx.s.historyCollection(id).NewDoc(), e)
 }
 
 // CreateCVERecord implements Transaction.CreateCVERecord.
@@ -282,7 +928,14 @@ func (tx *fsTransaction) CreateCVERecord(r *CVERecord) (err error) {
 	if err := r.Validate(); err != nil {
 		return err
 	}
-	return tx.t.Create(tx.s.cveRecordRef(r.ID), r)
+	if r.Version == 0 {
+		r.Version = 1
+	}
+	if err := tx.t.Create(tx.s.cveRecordRef(r.ID), r); err != nil {
+		return err
+	}
+	tx.versions[r.ID] = r.Version
+	return tx.addTriageHistory(r.ID, r.TriageState, r.TriageStateReason)
 }
 
 // SetCVERecord implements Transaction.SetCVERecord.
@@ -292,7 +945,17 @@ func (tx *fsTransaction) SetCVERecord(r *CVERecord) (err error) {
 	if err := r.Validate(); err != nil {
 		return err
 	}
-	return tx.t.Set(tx.s.cveRecordRef(r.ID), r)
+	if err := tx.checkVersion(r); err != nil {
+		return err
+	}
+	c := *r
+	c.Version++
+	if err := tx.t.Set(tx.s.cveRecordRef(r.ID), &c); err != nil {
+		return err
+	}
+	r.Version = c.Version
+	tx.versions[r.ID] = r.Version
+	return tx.addTriageHistory(r.ID, r.TriageState, r.TriageStateReason)
 }
 
 // GetCVERecords implements Transaction.GetCVERecords.
@@ -308,7 +971,36 @@ func (tx *fsTransaction) GetCVERecords(startID, endID string) (_ []*CVERecord, e
 	if err != nil {
 		return nil, err
 	}
-	return docsnapsToCVERecords(docsnaps)
+	crs, err := docsnapsToCVERecords(docsnaps)
+	if err != nil {
+		return nil, err
+	}
+	for _, cr := range crs {
+		tx.tracker.read(cr.ID, cr.TriageState)
+		tx.versions[cr.ID] = cr.Version
+	}
+	return crs, nil
+}
+
+// checkVersion checks the version of r against the stored record, reading
+// the record if it wasn't read in tx.
+func (tx *fsTransaction) checkVersion(r *CVERecord) error {
+	if v, ok := tx.versions[r.ID]; ok {
+		return checkVersion(r, v, true)
+	}
+	docsnap, err := tx.t.Get(tx.s.cveRecordRef(r.ID))
+	if status.Code(err) == codes.NotFound {
+		return checkVersion(r, 0, false)
+	}
+	if err != nil {
+		return err
+	}
+	var cr CVERecord
+	if err := docsnap.DataTo(&cr); err != nil {
+		return err
+	}
+	tx.versions[r.ID] = cr.Version
+	return checkVersion(r, cr.Version, true)
 }
 
 func docsnapsToCVERecords(docsnaps []*firestore.DocumentSnapshot) ([]*CVERecord, error) {
@@ -327,14 +1019,20 @@ func docsnapsToCVERecords(docsnaps []*firestore.DocumentSnapshot) ([]*CVERecord,
 func (tx *fsTransaction) CreateGHSARecord(r *GHSARecord) (err error) {
 	defer derrors.Wrap(&err, "FireStore.CreateGHSARecord(%s)", r.GHSA.ID)
 
-	return tx.t.Create(tx.s.ghsaRecordRef(r.GHSA.ID), r)
+	if err := tx.t.Create(tx.s.ghsaRecordRef(r.GHSA.ID), r); err != nil {
+		return err
+	}
+	return tx.addTriageHistory(r.GHSA.ID, r.TriageState, r.TriageStateReason)
 }
 
 // SetGHSARecord implements Transaction.SetGHSARecord.
 func (tx *fsTransaction) SetGHSARecord(r *GHSARecord) (err error) {
 	defer derrors.Wrap(&err, "SetGHSARecord(%s)", r.GHSA.ID)
 
-	return tx.t.Set(tx.s.ghsaRecordRef(r.GHSA.ID), r)
+	if err := tx.t.Set(tx.s.ghsaRecordRef(r.GHSA.ID), r); err != nil {
+		return err
+	}
+	return tx.addTriageHistory(r.GHSA.ID, r.TriageState, r.TriageStateReason)
 }
 
 // GetGHSARecord implements Transaction.GetGHSARecord.
@@ -349,6 +1047,7 @@ func (tx *fsTransaction) GetGHSARecord(id string) (_ *GHSARecord, err error) {
 	if err := docsnap.DataTo(&gr); err != nil {
 		return nil, err
 	}
+	tx.tracker.read(id, gr.TriageState)
 	return &gr, nil
 }
 
@@ -363,7 +1062,80 @@ func (tx *fsTransaction) GetGHSARecords() (_ []*GHSARecord, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return docsnapsToGHSARecords(docsnaps)
+	grs, err := docsnapsToGHSARecords(docsnaps)
+	if err != nil {
+		return nil, err
+	}
+	for _, gr := range grs {
+		tx.tracker.read(gr.GHSA.ID, gr.TriageState)
+	}
+	return grs, nil
+}
+
+// AddAliases implements Transaction.AddAliases.
+func (tx *fsTransaction) AddAliases(ids ...string) (err error) {
+	defer derrors.Wrap(&err, "AddAliases(%v)", ids)
+
+	for id, others := range aliasEdges(ids) {
+		var vals []interface{}
+		for _, o := range others {
+			vals = append(vals, o)
+		}
+		if err := tx.t.Set(tx.s.aliasRef(id),
+			map[string]interface{}{"IDs": firestore.ArrayUnion(vals...)},
+			firestore.MergeAll); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAliases implements Transaction.GetAliases.
+func (tx *fsTransaction) GetAliases(id string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "GetAliases(%s)", id)
+
+	return connectedAliases(id, func(id string) ([]string, error) {
+		return directAliases(tx.t.Get(tx.s.aliasRef(id)))
+	})
+}
+
+// SetSearchTerms implements Transaction.SetSearchTerms.
+func (tx *fsTransaction) SetSearchTerms(id string, terms []string) (err error) {
+	defer derrors.Wrap(&err, "SetSearchTerms(%s)", id)
+
+	terms = uniqueTerms(terms)
+	if len(terms) == 0 {
+		return tx.t.Delete(tx.s.searchRef(id))
+	}
+	return tx.t.Set(tx.s.searchRef(id), searchDoc{Terms: terms})
+}
+
+// SetReportReview implements Transaction.SetReportReview.
+func (tx *fsTransaction) SetReportReview(r *ReportReview) (err error) {
+	defer derrors.Wrap(&err, "SetReportReview(%s)", r.ReportID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return tx.t.Set(tx.s.reviewRef(r.ReportID), r)
+}
+
+// GetReportReview implements Transaction.GetReportReview.
+func (tx *fsTransaction) GetReportReview(reportID string) (_ *ReportReview, err error) {
+	defer derrors.Wrap(&err, "GetReportReview(%s)", reportID)
+
+	docsnap, err := tx.t.Get(tx.s.reviewRef(reportID))
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var r ReportReview
+	if err := docsnap.DataTo(&r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
 func docsnapsToGHSARecords(docsnaps []*firestore.DocumentSnapshot) ([]*GHSARecord, error) {
@@ -394,7 +1166,8 @@ func (s *FireStore) Clear(ctx context.Context) (err error) {
 	return nil
 }
 
-// Copied from https://cloud.google.com/firestore/docs/samples/firestore-data-delete-collection.
+// Adapted from https://cloud.google.com/firestore/docs/samples/firestore-data-delete-collection
+// to also delete sub-collections.
 func deleteCollection(ctx context.Context, client *firestore.Client, ref *firestore.CollectionRef, batchSize int) error {
 	for {
 		// Get a batch of documents
@@ -412,6 +1185,15 @@ func deleteCollection(ctx context.Context, client *firestore.Client, ref *firest
 			if err != nil {
 				return err
 			}
+			subrefs, err := doc.Ref.Collections(ctx).GetAll()
+			if err != nil {
+				return err
+			}
+			for _, sr := range subrefs {
+				if err := deleteCollection(ctx, client, sr, batchSize); err != nil {
+					return err
+				}
+			}
 			batch.Delete(doc.Ref)
 			numDeleted++
 		}
@@ -443,3 +1225,16 @@ func apply(iter *firestore.DocumentIterator, f func(*firestore.DocumentSnapshot)
 		}
 	}
 }
+
+// AddTriageHistory implements Transaction.AddTriageHistory.
+func (tx *fsTransaction) AddTriageHistory(es ...*TriageHistoryEntry) (err error) {
+	defer derrors.Wrap(&err, "AddTriageHistory")
+
+	for _, e := range es {
+		if err := tx.t.Create(tx.s.historyCollection(e.ID).NewDoc(), e); err != nil {
+			return err
+		}
+		tx.tracker.read(e.ID, e.NewState)
+	}
+	return nil
+}
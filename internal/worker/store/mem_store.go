@@ -20,8 +20,25 @@ type MemStore struct {
 	cveRecords     map[string]*CVERecord
 	updateRecords  map[string]*CommitUpdateRecord
 	dirHashes      map[string]string
+	fetchCursors   map[string]time.Time
+	locks          map[string]memLock
 	ghsaRecords    map[string]*GHSARecord
 	modScanRecords []*ModuleScanRecord
+	triageHistory  map[string][]*TriageHistoryEntry
+	aliases        map[string]map[string]bool
+	searchTerms    map[string][]string
+	discrepancies  []*DepsDevDiscrepancy
+	sourceStatus   map[string]*SourceStatus
+	quarantine     map[string]*QuarantinedFile
+	releases       map[string]*SecurityRelease
+	unfixed        map[unfixedKey]*UnfixedModule
+	reviews        map[string]*ReportReview
+	repoModules    map[string]*RepoModules
+}
+
+// unfixedKey is the key of an UnfixedModule.
+type unfixedKey struct {
+	reportID, module string
 }
 
 // NewMemStore creates a new, empty MemStore.
@@ -36,8 +53,20 @@ func (ms *MemStore) Clear(context.Context) error {
 	ms.cveRecords = map[string]*CVERecord{}
 	ms.updateRecords = map[string]*CommitUpdateRecord{}
 	ms.dirHashes = map[string]string{}
+	ms.fetchCursors = map[string]time.Time{}
+	ms.locks = map[string]memLock{}
 	ms.ghsaRecords = map[string]*GHSARecord{}
 	ms.modScanRecords = nil
+	ms.triageHistory = map[string][]*TriageHistoryEntry{}
+	ms.aliases = map[string]map[string]bool{}
+	ms.searchTerms = map[string][]string{}
+	ms.discrepancies = nil
+	ms.sourceStatus = map[string]*SourceStatus{}
+	ms.quarantine = map[string]*QuarantinedFile{}
+	ms.releases = map[string]*SecurityRelease{}
+	ms.unfixed = map[unfixedKey]*UnfixedModule{}
+	ms.reviews = map[string]*ReportReview{}
+	ms.repoModules = map[string]*RepoModules{}
 	return nil
 }
 
@@ -101,6 +130,22 @@ func (ms *MemStore) ListCVERecordsWithTriageState(_ context.Context, ts TriageSt
 	return crs, nil
 }
 
+// ListCVERecords implements Store.ListCVERecords.
+func (ms *MemStore) ListCVERecords(_ context.Context, q CVERecordQuery) ([]*CVERecord, string, error) {
+	start, end := q.idRange()
+	var crs []*CVERecord
+	for id, r := range ms.cveRecords {
+		if id > start && (end == "" || id < end) && q.matches(r) {
+			crs = append(crs, r)
+		}
+	}
+	sort.Slice(crs, func(i, j int) bool {
+		return crs[i].ID < crs[j].ID
+	})
+	crs, next := pageCVERecords(crs, q.Limit)
+	return crs, next, nil
+}
+
 // CreateModuleScanRecord implements Store.CreateModuleScanRecord.
 func (ms *MemStore) CreateModuleScanRecord(_ context.Context, r *ModuleScanRecord) error {
 	if err := r.Validate(); err != nil {
@@ -134,6 +179,213 @@ func (ms *MemStore) ListModuleScanRecords(ctx context.Context, limit int) ([]*Mo
 	return rs[:limit], nil
 }
 
+// SetDepsDevDiscrepancies implements Store.SetDepsDevDiscrepancies.
+func (ms *MemStore) SetDepsDevDiscrepancies(_ context.Context, ds []*DepsDevDiscrepancy) error {
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+	ms.discrepancies = append([]*DepsDevDiscrepancy(nil), ds...)
+	sortDepsDevDiscrepancies(ms.discrepancies)
+	return nil
+}
+
+// SetSourceStatus implements Store.SetSourceStatus.
+func (ms *MemStore) SetSourceStatus(_ context.Context, s *SourceStatus) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	c := *s
+	ms.sourceStatus[s.Source] = &c
+	return nil
+}
+
+// ListSourceStatuses implements Store.ListSourceStatuses.
+func (ms *MemStore) ListSourceStatuses(context.Context) ([]*SourceStatus, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	var ss []*SourceStatus
+	for _, src := range sortedKeys(ms.sourceStatus) {
+		c := *ms.sourceStatus[src]
+		ss = append(ss, &c)
+	}
+	return ss, nil
+}
+
+// SetQuarantinedFile implements Store.SetQuarantinedFile.
+func (ms *MemStore) SetQuarantinedFile(_ context.Context, q *QuarantinedFile) error {
+	if err := q.Validate(); err != nil {
+		return err
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	c := *q
+	ms.quarantine[q.Path] = &c
+	return nil
+}
+
+// DeleteQuarantinedFile implements Store.DeleteQuarantinedFile.
+func (ms *MemStore) DeleteQuarantinedFile(_ context.Context, path string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	delete(ms.quarantine, path)
+	return nil
+}
+
+// ListQuarantinedFiles implements Store.ListQuarantinedFiles.
+func (ms *MemStore) ListQuarantinedFiles(context.Context) ([]*QuarantinedFile, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	var qs []*QuarantinedFile
+	for _, p := range sortedKeys(ms.quarantine) {
+		c := *ms.quarantine[p]
+		qs = append(qs, &c)
+	}
+	return qs, nil
+}
+
+// SetSecurityRelease implements Store.SetSecurityRelease.
+func (ms *MemStore) SetSecurityRelease(_ context.Context, r *SecurityRelease) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	c := *r
+	ms.releases[r.Release] = &c
+	return nil
+}
+
+// ListSecurityReleases implements Store.ListSecurityReleases.
+func (ms *MemStore) ListSecurityReleases(context.Context) ([]*SecurityRelease, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	var rs []*SecurityRelease
+	for _, rel := range sortedKeys(ms.releases) {
+		c := *ms.releases[rel]
+		rs = append(rs, &c)
+	}
+	return rs, nil
+}
+
+// SetUnfixedModule implements Store.SetUnfixedModule.
+func (ms *MemStore) SetUnfixedModule(_ context.Context, u *UnfixedModule) error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	c := *u
+	ms.unfixed[unfixedKey{u.ReportID, u.Module}] = &c
+	return nil
+}
+
+// DeleteUnfixedModule implements Store.DeleteUnfixedModule.
+func (ms *MemStore) DeleteUnfixedModule(_ context.Context, reportID, module string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	delete(ms.unfixed, unfixedKey{reportID, module})
+	return nil
+}
+
+// ListUnfixedModules implements Store.ListUnfixedModules.
+func (ms *MemStore) ListUnfixedModules(context.Context) ([]*UnfixedModule, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	var us []*UnfixedModule
+	for _, u := range ms.unfixed {
+		c := *u
+		us = append(us, &c)
+	}
+	sortUnfixedModules(us)
+	return us, nil
+}
+
+// SetReportReview implements Store.SetReportReview.
+func (ms *MemStore) SetReportReview(_ context.Context, r *ReportReview) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.setReportReview(r)
+	return nil
+}
+
+func (ms *MemStore) setReportReview(r *ReportReview) {
+	c := *r
+	ms.reviews[r.ReportID] = &c
+}
+
+// GetReportReview implements Store.GetReportReview.
+func (ms *MemStore) GetReportReview(_ context.Context, reportID string) (*ReportReview, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return ms.getReportReview(reportID), nil
+}
+
+func (ms *MemStore) getReportReview(reportID string) *ReportReview {
+	r, ok := ms.reviews[reportID]
+	if !ok {
+		return nil
+	}
+	c := *r
+	return &c
+}
+
+// ListReportReviews implements Store.ListReportReviews.
+func (ms *MemStore) ListReportReviews(context.Context) ([]*ReportReview, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	var rs []*ReportReview
+	for _, id := range sortedKeys(ms.reviews) {
+		c := *ms.reviews[id]
+		rs = append(rs, &c)
+	}
+	return rs, nil
+}
+
+// SetRepoModules implements Store.SetRepoModules.
+func (ms *MemStore) SetRepoModules(_ context.Context, r *RepoModules) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.repoModules[r.Repo] = copyRepoModules(r)
+	return nil
+}
+
+// GetRepoModules implements Store.GetRepoModules.
+func (ms *MemStore) GetRepoModules(_ context.Context, repo string) (*RepoModules, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	r, ok := ms.repoModules[repo]
+	if !ok {
+		return nil, nil
+	}
+	return copyRepoModules(r), nil
+}
+
+// copyRepoModules returns a deep copy of r.
+func copyRepoModules(r *RepoModules) *RepoModules {
+	c := *r
+	c.Modules = nil
+	for _, m := range r.Modules {
+		mc := *m
+		c.Modules = append(c.Modules, &mc)
+	}
+	return &c
+}
+
+// ListDepsDevDiscrepancies implements Store.ListDepsDevDiscrepancies.
+func (ms *MemStore) ListDepsDevDiscrepancies(context.Context) ([]*DepsDevDiscrepancy, error) {
+	return append([]*DepsDevDiscrepancy(nil), ms.discrepancies...), nil
+}
+
 // GetDirectoryHash implements Transaction.GetDirectoryHash.
 func (ms *MemStore) GetDirectoryHash(_ context.Context, dir string) (string, error) {
 	return ms.dirHashes[dir], nil
@@ -145,18 +397,117 @@ func (ms *MemStore) SetDirectoryHash(_ context.Context, dir, hash string) error
 	return nil
 }
 
+// GetFetchCursor implements Store.GetFetchCursor.
+func (ms *MemStore) GetFetchCursor(_ context.Context, source string) (time.Time, error) {
+	return ms.fetchCursors[source], nil
+}
+
+// SetFetchCursor implements Store.SetFetchCursor.
+func (ms *MemStore) SetFetchCursor(_ context.Context, source string, t time.Time) error {
+	ms.fetchCursors[source] = t
+	return nil
+}
+
+type memLock struct {
+	owner   string
+	expires time.Time
+}
+
+// AcquireLock implements Store.AcquireLock.
+func (ms *MemStore) AcquireLock(_ context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	now := time.Now()
+	if l, ok := ms.locks[name]; ok && l.owner != owner && now.Before(l.expires) {
+		return false, nil
+	}
+	ms.locks[name] = memLock{owner: owner, expires: now.Add(ttl)}
+	return true, nil
+}
+
+// RenewLock implements Store.RenewLock.
+func (ms *MemStore) RenewLock(_ context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	now := time.Now()
+	l, ok := ms.locks[name]
+	if !ok || l.owner != owner || !now.Before(l.expires) {
+		return false, nil
+	}
+	ms.locks[name] = memLock{owner: owner, expires: now.Add(ttl)}
+	return true, nil
+}
+
+// ReleaseLock implements Store.ReleaseLock.
+func (ms *MemStore) ReleaseLock(_ context.Context, name, owner string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.locks[name].owner == owner {
+		delete(ms.locks, name)
+	}
+	return nil
+}
+
+// ListTriageHistory implements Store.ListTriageHistory.
+func (ms *MemStore) ListTriageHistory(_ context.Context, id string) ([]*TriageHistoryEntry, error) {
+	es := make([]*TriageHistoryEntry, len(ms.triageHistory[id]))
+	copy(es, ms.triageHistory[id])
+	return es, nil
+}
+
+// GetAliases implements Store.GetAliases.
+func (ms *MemStore) GetAliases(_ context.Context, id string) ([]string, error) {
+	return connectedAliases(id, ms.directAliases)
+}
+
+func (ms *MemStore) directAliases(id string) ([]string, error) {
+	var ds []string
+	for d := range ms.aliases[id] {
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// Search implements Store.Search.
+func (ms *MemStore) Search(_ context.Context, terms []string, limit int) ([]string, error) {
+	terms = uniqueTerms(terms)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	var ids []string
+	for id, ts := range ms.searchTerms {
+		if hasAllTerms(ts, terms) {
+			ids = append(ids, id)
+		}
+	}
+	return limitIDs(ids, limit), nil
+}
+
 // RunTransaction implements Store.RunTransaction.
 // A transaction runs with a single lock on the entire DB.
 func (ms *MemStore) RunTransaction(ctx context.Context, f func(context.Context, Transaction) error) error {
-	tx := &memTransaction{ms}
+	tx := &memTransaction{ms, newTriageTracker(ctx)}
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
-	return f(ctx, tx)
+	if err := f(ctx, tx); err != nil {
+		return err
+	}
+	tx.tracker.committed(ctx)
+	return nil
 }
 
 // memTransaction implements Store.Transaction.
 type memTransaction struct {
-	ms *MemStore
+	ms      *MemStore
+	tracker *triageTracker
+}
+
+// addTriageHistory adds a TriageHistoryEntry for the record with the given
+// ID, if its triage state has changed.
+func (tx *memTransaction) addTriageHistory(id string, ts TriageState, reason string) {
+	if e := tx.tracker.write(id, ts, reason); e != nil {
+		tx.ms.triageHistory[id] = append(tx.ms.triageHistory[id], e)
+	}
 }
 
 // CreateCVERecord implements Transaction.CreateCVERecord.
@@ -164,7 +515,11 @@ func (tx *memTransaction) CreateCVERecord(r *CVERecord) error {
 	if err := r.Validate(); err != nil {
 		return err
 	}
+	if r.Version == 0 {
+		r.Version = 1
+	}
 	tx.ms.cveRecords[r.ID] = r
+	tx.addTriageHistory(r.ID, r.TriageState, r.TriageStateReason)
 	return nil
 }
 
@@ -173,10 +528,17 @@ func (tx *memTransaction) SetCVERecord(r *CVERecord) error {
 	if err := r.Validate(); err != nil {
 		return err
 	}
-	if tx.ms.cveRecords[r.ID] == nil {
-		return fmt.Errorf("CVERecord with ID %q not found", r.ID)
+	old := tx.ms.cveRecords[r.ID]
+	var stored int64
+	if old != nil {
+		stored = old.Version
 	}
+	if err := checkVersion(r, stored, old != nil); err != nil {
+		return err
+	}
+	r.Version++
 	tx.ms.cveRecords[r.ID] = r
+	tx.addTriageHistory(r.ID, r.TriageState, r.TriageStateReason)
 	return nil
 }
 
@@ -187,6 +549,7 @@ func (tx *memTransaction) GetCVERecords(startID, endID string) ([]*CVERecord, er
 		if id >= startID && id <= endID {
 			c := *r
 			crs = append(crs, &c)
+			tx.tracker.read(id, r.TriageState)
 		}
 	}
 	// Sort for testing.
@@ -202,6 +565,7 @@ func (tx *memTransaction) CreateGHSARecord(r *GHSARecord) error {
 		return fmt.Errorf("GHSARecord %s already exists", r.GHSA.ID)
 	}
 	tx.ms.ghsaRecords[r.GHSA.ID] = r
+	tx.addTriageHistory(r.GHSA.ID, r.TriageState, r.TriageStateReason)
 	return nil
 }
 
@@ -211,12 +575,14 @@ func (tx *memTransaction) SetGHSARecord(r *GHSARecord) error {
 		return fmt.Errorf("GHSARecord %s does not exist", r.GHSA.ID)
 	}
 	tx.ms.ghsaRecords[r.GHSA.ID] = r
+	tx.addTriageHistory(r.GHSA.ID, r.TriageState, r.TriageStateReason)
 	return nil
 }
 
 // GetGHSARecord implements Transaction.GetGHSARecord.
 func (tx *memTransaction) GetGHSARecord(id string) (*GHSARecord, error) {
 	if r, ok := tx.ms.ghsaRecords[id]; ok {
+		tx.tracker.read(id, r.TriageState)
 		return r, nil
 	}
 	return nil, fmt.Errorf("GHSARecord %s does not exist", id)
@@ -225,8 +591,62 @@ func (tx *memTransaction) GetGHSARecord(id string) (*GHSARecord, error) {
 // GetGHSARecords implements Transaction.GetGHSARecords.
 func (tx *memTransaction) GetGHSARecords() ([]*GHSARecord, error) {
 	var recs []*GHSARecord
-	for _, r := range tx.ms.ghsaRecords {
+	for id, r := range tx.ms.ghsaRecords {
 		recs = append(recs, r)
+		tx.tracker.read(id, r.TriageState)
 	}
 	return recs, nil
 }
+
+// AddAliases implements Transaction.AddAliases.
+func (tx *memTransaction) AddAliases(ids ...string) error {
+	for id, others := range aliasEdges(ids) {
+		if tx.ms.aliases[id] == nil {
+			tx.ms.aliases[id] = map[string]bool{}
+		}
+		for _, o := range others {
+			tx.ms.aliases[id][o] = true
+		}
+	}
+	return nil
+}
+
+// GetAliases implements Transaction.GetAliases.
+func (tx *memTransaction) GetAliases(id string) ([]string, error) {
+	return connectedAliases(id, tx.ms.directAliases)
+}
+
+// SetSearchTerms implements Transaction.SetSearchTerms.
+func (tx *memTransaction) SetSearchTerms(id string, terms []string) error {
+	terms = uniqueTerms(terms)
+	if len(terms) == 0 {
+		delete(tx.ms.searchTerms, id)
+		return nil
+	}
+	tx.ms.searchTerms[id] = terms
+	return nil
+}
+
+// SetReportReview implements Transaction.SetReportReview.
+func (tx *memTransaction) SetReportReview(r *ReportReview) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	tx.ms.setReportReview(r)
+	return nil
+}
+
+// GetReportReview implements Transaction.GetReportReview.
+func (tx *memTransaction) GetReportReview(reportID string) (*ReportReview, error) {
+	return tx.ms.getReportReview(reportID), nil
+}
+
+// AddTriageHistory implements Transaction.AddTriageHistory.
+func (tx *memTransaction) AddTriageHistory(es ...*TriageHistoryEntry) error {
+	for _, e := range es {
+		c := *e
+		tx.ms.triageHistory[e.ID] = append(tx.ms.triageHistory[e.ID], &c)
+		tx.tracker.read(e.ID, e.NewState)
+	}
+	return nil
+}
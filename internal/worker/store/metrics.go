@@ -0,0 +1,305 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/observe"
+)
+
+var errorCounter = event.NewCounter("store_errors", &event.MetricOptions{
+	Namespace:   "vulndb/worker",
+	Description: "Errors returned by the store, by operation.",
+})
+
+// WithMetrics returns a Store that calls s and counts the errors it returns,
+// labeled by operation. Errors in a transaction are counted once, as errors
+// of RunTransaction.
+//
+// Each operation is also a trace span named "store." followed by the name of
+// the method. The operations of a transaction are not traced individually.
+func WithMetrics(s Store) Store {
+	return &metricStore{s}
+}
+
+type metricStore struct {
+	s Store
+}
+
+// start starts the span of an operation.
+func (m *metricStore) start(ctx context.Context, op string) context.Context {
+	return event.Start(ctx, "store."+op)
+}
+
+// end ends the span of an operation started by start, and counts err.
+func (m *metricStore) end(ctx context.Context, op string, err error) {
+	if err != nil {
+		errorCounter.Record(ctx, 1, event.String("op", op))
+		observe.SetSpanError(ctx, err)
+	}
+	event.End(ctx)
+}
+
+func (m *metricStore) CreateCommitUpdateRecord(ctx context.Context, r *CommitUpdateRecord) error {
+	ctx = m.start(ctx, "CreateCommitUpdateRecord")
+	err := m.s.CreateCommitUpdateRecord(ctx, r)
+	m.end(ctx, "CreateCommitUpdateRecord", err)
+	return err
+}
+
+func (m *metricStore) SetCommitUpdateRecord(ctx context.Context, r *CommitUpdateRecord) error {
+	ctx = m.start(ctx, "SetCommitUpdateRecord")
+	err := m.s.SetCommitUpdateRecord(ctx, r)
+	m.end(ctx, "SetCommitUpdateRecord", err)
+	return err
+}
+
+func (m *metricStore) ListCommitUpdateRecords(ctx context.Context, limit int) ([]*CommitUpdateRecord, error) {
+	ctx = m.start(ctx, "ListCommitUpdateRecords")
+	rs, err := m.s.ListCommitUpdateRecords(ctx, limit)
+	m.end(ctx, "ListCommitUpdateRecords", err)
+	return rs, err
+}
+
+func (m *metricStore) GetCVERecord(ctx context.Context, id string) (*CVERecord, error) {
+	ctx = m.start(ctx, "GetCVERecord")
+	r, err := m.s.GetCVERecord(ctx, id)
+	m.end(ctx, "GetCVERecord", err)
+	return r, err
+}
+
+func (m *metricStore) ListCVERecordsWithTriageState(ctx context.Context, ts TriageState) ([]*CVERecord, error) {
+	ctx = m.start(ctx, "ListCVERecordsWithTriageState")
+	rs, err := m.s.ListCVERecordsWithTriageState(ctx, ts)
+	m.end(ctx, "ListCVERecordsWithTriageState", err)
+	return rs, err
+}
+
+func (m *metricStore) ListCVERecords(ctx context.Context, q CVERecordQuery) ([]*CVERecord, string, error) {
+	ctx = m.start(ctx, "ListCVERecords")
+	rs, next, err := m.s.ListCVERecords(ctx, q)
+	m.end(ctx, "ListCVERecords", err)
+	return rs, next, err
+}
+
+func (m *metricStore) GetDirectoryHash(ctx context.Context, dir string) (string, error) {
+	ctx = m.start(ctx, "GetDirectoryHash")
+	h, err := m.s.GetDirectoryHash(ctx, dir)
+	m.end(ctx, "GetDirectoryHash", err)
+	return h, err
+}
+
+func (m *metricStore) SetDirectoryHash(ctx context.Context, dir, hash string) error {
+	ctx = m.start(ctx, "SetDirectoryHash")
+	err := m.s.SetDirectoryHash(ctx, dir, hash)
+	m.end(ctx, "SetDirectoryHash", err)
+	return err
+}
+
+func (m *metricStore) GetFetchCursor(ctx context.Context, source string) (time.Time, error) {
+	ctx = m.start(ctx, "GetFetchCursor")
+	t, err := m.s.GetFetchCursor(ctx, source)
+	m.end(ctx, "GetFetchCursor", err)
+	return t, err
+}
+
+func (m *metricStore) SetFetchCursor(ctx context.Context, source string, t time.Time) error {
+	ctx = m.start(ctx, "SetFetchCursor")
+	err := m.s.SetFetchCursor(ctx, source, t)
+	m.end(ctx, "SetFetchCursor", err)
+	return err
+}
+
+func (m *metricStore) AcquireLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	ctx = m.start(ctx, "AcquireLock")
+	ok, err := m.s.AcquireLock(ctx, name, owner, ttl)
+	m.end(ctx, "AcquireLock", err)
+	return ok, err
+}
+
+func (m *metricStore) RenewLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	ctx = m.start(ctx, "RenewLock")
+	ok, err := m.s.RenewLock(ctx, name, owner, ttl)
+	m.end(ctx, "RenewLock", err)
+	return ok, err
+}
+
+func (m *metricStore) ReleaseLock(ctx context.Context, name, owner string) error {
+	ctx = m.start(ctx, "ReleaseLock")
+	err := m.s.ReleaseLock(ctx, name, owner)
+	m.end(ctx, "ReleaseLock", err)
+	return err
+}
+
+func (m *metricStore) ListTriageHistory(ctx context.Context, id string) ([]*TriageHistoryEntry, error) {
+	ctx = m.start(ctx, "ListTriageHistory")
+	es, err := m.s.ListTriageHistory(ctx, id)
+	m.end(ctx, "ListTriageHistory", err)
+	return es, err
+}
+
+func (m *metricStore) Search(ctx context.Context, terms []string, limit int) ([]string, error) {
+	ctx = m.start(ctx, "Search")
+	ids, err := m.s.Search(ctx, terms, limit)
+	m.end(ctx, "Search", err)
+	return ids, err
+}
+
+func (m *metricStore) GetAliases(ctx context.Context, id string) ([]string, error) {
+	ctx = m.start(ctx, "GetAliases")
+	as, err := m.s.GetAliases(ctx, id)
+	m.end(ctx, "GetAliases", err)
+	return as, err
+}
+
+func (m *metricStore) CreateModuleScanRecord(ctx context.Context, r *ModuleScanRecord) error {
+	ctx = m.start(ctx, "CreateModuleScanRecord")
+	err := m.s.CreateModuleScanRecord(ctx, r)
+	m.end(ctx, "CreateModuleScanRecord", err)
+	return err
+}
+
+func (m *metricStore) GetModuleScanRecord(ctx context.Context, path, version string, dbTime time.Time) (*ModuleScanRecord, error) {
+	ctx = m.start(ctx, "GetModuleScanRecord")
+	r, err := m.s.GetModuleScanRecord(ctx, path, version, dbTime)
+	m.end(ctx, "GetModuleScanRecord", err)
+	return r, err
+}
+
+func (m *metricStore) ListModuleScanRecords(ctx context.Context, limit int) ([]*ModuleScanRecord, error) {
+	ctx = m.start(ctx, "ListModuleScanRecords")
+	rs, err := m.s.ListModuleScanRecords(ctx, limit)
+	m.end(ctx, "ListModuleScanRecords", err)
+	return rs, err
+}
+
+func (m *metricStore) SetDepsDevDiscrepancies(ctx context.Context, ds []*DepsDevDiscrepancy) error {
+	ctx = m.start(ctx, "SetDepsDevDiscrepancies")
+	err := m.s.SetDepsDevDiscrepancies(ctx, ds)
+	m.end(ctx, "SetDepsDevDiscrepancies", err)
+	return err
+}
+
+func (m *metricStore) SetSourceStatus(ctx context.Context, s *SourceStatus) error {
+	ctx = m.start(ctx, "SetSourceStatus")
+	err := m.s.SetSourceStatus(ctx, s)
+	m.end(ctx, "SetSourceStatus", err)
+	return err
+}
+
+func (m *metricStore) ListSourceStatuses(ctx context.Context) ([]*SourceStatus, error) {
+	ctx = m.start(ctx, "ListSourceStatuses")
+	ss, err := m.s.ListSourceStatuses(ctx)
+	m.end(ctx, "ListSourceStatuses", err)
+	return ss, err
+}
+
+func (m *metricStore) SetQuarantinedFile(ctx context.Context, q *QuarantinedFile) error {
+	ctx = m.start(ctx, "SetQuarantinedFile")
+	err := m.s.SetQuarantinedFile(ctx, q)
+	m.end(ctx, "SetQuarantinedFile", err)
+	return err
+}
+
+func (m *metricStore) DeleteQuarantinedFile(ctx context.Context, path string) error {
+	ctx = m.start(ctx, "DeleteQuarantinedFile")
+	err := m.s.DeleteQuarantinedFile(ctx, path)
+	m.end(ctx, "DeleteQuarantinedFile", err)
+	return err
+}
+
+func (m *metricStore) ListQuarantinedFiles(ctx context.Context) ([]*QuarantinedFile, error) {
+	ctx = m.start(ctx, "ListQuarantinedFiles")
+	qs, err := m.s.ListQuarantinedFiles(ctx)
+	m.end(ctx, "ListQuarantinedFiles", err)
+	return qs, err
+}
+
+func (m *metricStore) SetSecurityRelease(ctx context.Context, r *SecurityRelease) error {
+	ctx = m.start(ctx, "SetSecurityRelease")
+	err := m.s.SetSecurityRelease(ctx, r)
+	m.end(ctx, "SetSecurityRelease", err)
+	return err
+}
+
+func (m *metricStore) ListSecurityReleases(ctx context.Context) ([]*SecurityRelease, error) {
+	ctx = m.start(ctx, "ListSecurityReleases")
+	rs, err := m.s.ListSecurityReleases(ctx)
+	m.end(ctx, "ListSecurityReleases", err)
+	return rs, err
+}
+
+func (m *metricStore) SetUnfixedModule(ctx context.Context, u *UnfixedModule) error {
+	ctx = m.start(ctx, "SetUnfixedModule")
+	err := m.s.SetUnfixedModule(ctx, u)
+	m.end(ctx, "SetUnfixedModule", err)
+	return err
+}
+
+func (m *metricStore) DeleteUnfixedModule(ctx context.Context, reportID, module string) error {
+	ctx = m.start(ctx, "DeleteUnfixedModule")
+	err := m.s.DeleteUnfixedModule(ctx, reportID, module)
+	m.end(ctx, "DeleteUnfixedModule", err)
+	return err
+}
+
+func (m *metricStore) ListUnfixedModules(ctx context.Context) ([]*UnfixedModule, error) {
+	ctx = m.start(ctx, "ListUnfixedModules")
+	us, err := m.s.ListUnfixedModules(ctx)
+	m.end(ctx, "ListUnfixedModules", err)
+	return us, err
+}
+
+func (m *metricStore) SetReportReview(ctx context.Context, r *ReportReview) error {
+	ctx = m.start(ctx, "SetReportReview")
+	err := m.s.SetReportReview(ctx, r)
+	m.end(ctx, "SetReportReview", err)
+	return err
+}
+
+func (m *metricStore) GetReportReview(ctx context.Context, reportID string) (*ReportReview, error) {
+	ctx = m.start(ctx, "GetReportReview")
+	r, err := m.s.GetReportReview(ctx, reportID)
+	m.end(ctx, "GetReportReview", err)
+	return r, err
+}
+
+func (m *metricStore) ListReportReviews(ctx context.Context) ([]*ReportReview, error) {
+	ctx = m.start(ctx, "ListReportReviews")
+	rs, err := m.s.ListReportReviews(ctx)
+	m.end(ctx, "ListReportReviews", err)
+	return rs, err
+}
+
+func (m *metricStore) SetRepoModules(ctx context.Context, r *RepoModules) error {
+	ctx = m.start(ctx, "SetRepoModules")
+	err := m.s.SetRepoModules(ctx, r)
+	m.end(ctx, "SetRepoModules", err)
+	return err
+}
+
+func (m *metricStore) GetRepoModules(ctx context.Context, repo string) (*RepoModules, error) {
+	ctx = m.start(ctx, "GetRepoModules")
+	r, err := m.s.GetRepoModules(ctx, repo)
+	m.end(ctx, "GetRepoModules", err)
+	return r, err
+}
+
+func (m *metricStore) ListDepsDevDiscrepancies(ctx context.Context) ([]*DepsDevDiscrepancy, error) {
+	ctx = m.start(ctx, "ListDepsDevDiscrepancies")
+	ds, err := m.s.ListDepsDevDiscrepancies(ctx)
+	m.end(ctx, "ListDepsDevDiscrepancies", err)
+	return ds, err
+}
+
+func (m *metricStore) RunTransaction(ctx context.Context, f func(context.Context, Transaction) error) error {
+	ctx = m.start(ctx, "RunTransaction")
+	err := m.s.RunTransaction(ctx, f)
+	m.end(ctx, "RunTransaction", err)
+	return err
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package store
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/observe"
+)
+
+func TestMetrics(t *testing.T) {
+	h := observe.NewPrometheusHandler()
+	ctx := event.WithExporter(context.Background(), event.NewExporter(h, nil))
+	st := WithMetrics(NewMemStore())
+
+	cr := &CVERecord{
+		ID:          "CVE-2022-0001",
+		Path:        "2022/0xxx/CVE-2022-0001.json",
+		BlobHash:    "abc",
+		CommitHash:  "def",
+		CommitTime:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		TriageState: TriageStateNeedsIssue,
+	}
+	must(st.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		return tx.CreateCVERecord(cr)
+	}))(t)
+	errFail := errors.New("fail")
+	err := st.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		cr.TriageState = TriageStateIssueCreated
+		if err := tx.SetCVERecord(cr); err != nil {
+			return err
+		}
+		return errFail
+	})
+	if !errors.Is(err, errFail) {
+		t.Fatalf("got %v, want %v", err, errFail)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	got := w.Body.String()
+	for _, want := range []string{
+		`vulndb_worker_store_errors_total{op="RunTransaction"} 1`,
+		`vulndb_worker_triage_transitions_total{from="",to="NeedsIssue"} 1`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in\n%s", want, got)
+		}
+	}
+	// The change in the failed transaction is not counted.
+	if strings.Contains(got, `to="IssueCreated"`) {
+		t.Errorf("failed transaction counted in\n%s", got)
+	}
+}
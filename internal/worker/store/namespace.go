@@ -0,0 +1,34 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// A namespace separates the data of one environment, such as prod, staging
+// or a developer's dev environment, from that of the others that share a
+// Firestore project or Postgres database. Every record, fetch cursor, lock
+// and triage history entry of a FireStore or PGStore is in its namespace, so
+// a worker can't read or change the data of another namespace.
+
+// namespaceRegexp matches valid namespaces. They name Firestore documents
+// and Postgres schemas, whose names can have at most 63 bytes.
+var namespaceRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,62}$`)
+
+// ValidateNamespace returns an error if ns is not a valid namespace: one to
+// 63 letters, digits, dots, hyphens and underscores, starting with a letter
+// or digit.
+func ValidateNamespace(ns string) error {
+	if ns == "" {
+		return errors.New("empty namespace")
+	}
+	if !namespaceRegexp.MatchString(ns) {
+		return fmt.Errorf("bad namespace %q: want 1 to 63 letters, digits, '.', '-' or '_', starting with a letter or digit", ns)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package store
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateNamespace(t *testing.T) {
+	for _, ns := range []string{"prod", "staging", "dev-alice", "testing-j.doe-123", "x"} {
+		if err := ValidateNamespace(ns); err != nil {
+			t.Errorf("%q: %v", ns, err)
+		}
+	}
+	for _, ns := range []string{"", ".", "..", "-prod", "prod/dev", "prod dev", "__prod__", strings.Repeat("a", 64)} {
+		if err := ValidateNamespace(ns); err == nil {
+			t.Errorf("%q: got nil, want error", ns)
+		}
+	}
+}
@@ -0,0 +1,1139 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// PGStore is a Store implemented with PostgreSQL.
+//
+// Each namespace is a separate Postgres schema, so that several virtual
+// databases (e.g. prod and testing) can share one database. Within a
+// namespace, each kind of record has its own table. A record is stored as a
+// JSON document in the data column of its row, along with copies of the
+// fields needed for lookup and ordering:
+// - cve_records for CVERecords
+// - commit_updates for CommitUpdateRecords
+// - dir_hashes for directory hashes
+// - ghsa_records for GHSARecords
+// - module_scans for ModuleScanRecords
+// - fetch_cursors for fetch cursors
+// - triage_history for TriageHistoryEntries
+// - locks for locks
+// - aliases for the edges of the alias graph
+// - search_terms for the terms of search documents
+// - depsdev_discrepancies for DepsDevDiscrepancies
+// - source_status for SourceStatuses
+// - quarantined_files for QuarantinedFiles
+// - security_releases for SecurityReleases
+// - unfixed_modules for UnfixedModules
+// - report_reviews for ReportReviews
+// - repo_modules for RepoModules
+//
+// The tables are created and kept up to date by the migrations in
+// pgMigrations, which NewPGStore applies.
+type PGStore struct {
+	namespace string
+	db        *sql.DB
+}
+
+// NewPGStore creates a new PGStore, connecting to the Postgres database
+// described by dataSourceName. See https://pkg.go.dev/github.com/lib/pq for
+// the format of dataSourceName. The namespace must be valid; see
+// ValidateNamespace. It is used as the name of the Postgres schema that holds
+// the store's tables; the schema is created if it does not exist, and its
+// tables are migrated to the current version.
+func NewPGStore(ctx context.Context, dataSourceName, namespace string) (_ *PGStore, err error) {
+	defer derrors.Wrap(&err, "NewPGStore(%q)", namespace)
+
+	if err := ValidateNamespace(namespace); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("postgres", dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	ps := &PGStore{namespace: namespace, db: db}
+	if err := ps.migrate(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return ps, nil
+}
+
+// Close closes the connection to the database.
+func (ps *PGStore) Close() error {
+	return ps.db.Close()
+}
+
+// pgMigrations are the schema changes for a PGStore namespace, in order.
+// Each is a format string whose only argument, %[1]s, is the quoted schema
+// name. A migration is applied exactly once; to change the schema, append a
+// new migration rather than editing an existing one.
+var pgMigrations = []string{
+	// 1: initial schema.
+	`
+	CREATE TABLE %[1]s.commit_updates (
+		id         BIGSERIAL PRIMARY KEY,
+		started_at TIMESTAMPTZ NOT NULL,
+		data       JSONB NOT NULL
+	);
+	CREATE INDEX ON %[1]s.commit_updates (started_at);
+
+	-- The "C" collation orders IDs bytewise, like Firestore document IDs.
+	CREATE TABLE %[1]s.cve_records (
+		id           TEXT COLLATE "C" PRIMARY KEY,
+		triage_state TEXT NOT NULL,
+		data         JSONB NOT NULL
+	);
+	CREATE INDEX ON %[1]s.cve_records (triage_state, id);
+
+	CREATE TABLE %[1]s.dir_hashes (
+		dir  TEXT PRIMARY KEY,
+		hash TEXT NOT NULL
+	);
+
+	CREATE TABLE %[1]s.ghsa_records (
+		id   TEXT COLLATE "C" PRIMARY KEY,
+		data JSONB NOT NULL
+	);
+
+	-- db_time is in Unix nanoseconds, because it is compared for equality
+	-- and TIMESTAMPTZ only has microsecond precision.
+	CREATE TABLE %[1]s.module_scans (
+		id          BIGSERIAL PRIMARY KEY,
+		path        TEXT NOT NULL,
+		version     TEXT NOT NULL,
+		db_time     BIGINT NOT NULL,
+		finished_at TIMESTAMPTZ NOT NULL,
+		data        JSONB NOT NULL
+	);
+	CREATE INDEX ON %[1]s.module_scans (path, version, db_time);
+	CREATE INDEX ON %[1]s.module_scans (finished_at);
+	`,
+	// 2: fetch cursors.
+	`
+	CREATE TABLE %[1]s.fetch_cursors (
+		source TEXT PRIMARY KEY,
+		time   TIMESTAMPTZ NOT NULL
+	);
+	`,
+	// 3: triage history.
+	`
+	CREATE TABLE %[1]s.triage_history (
+		id        BIGSERIAL PRIMARY KEY,
+		record_id TEXT NOT NULL,
+		data      JSONB NOT NULL
+	);
+	CREATE INDEX ON %[1]s.triage_history (record_id, id);
+	`,
+	// 4: CVE record columns for ListCVERecords. Like module_scans.db_time,
+	// commit_time is in Unix nanoseconds.
+	`
+	ALTER TABLE %[1]s.cve_records
+		ADD COLUMN module      TEXT NOT NULL DEFAULT '',
+		ADD COLUMN commit_time BIGINT NOT NULL DEFAULT 0;
+	UPDATE %[1]s.cve_records SET
+		module = COALESCE(data->>'Module', ''),
+		commit_time = (EXTRACT(EPOCH FROM (data->>'CommitTime')::timestamptz) * 1e9)::bigint;
+	CREATE INDEX ON %[1]s.cve_records (module, id);
+	CREATE INDEX ON %[1]s.cve_records (commit_time);
+	`,
+	// 5: locks.
+	`
+	CREATE TABLE %[1]s.locks (
+		name    TEXT PRIMARY KEY,
+		owner   TEXT NOT NULL,
+		expires TIMESTAMPTZ NOT NULL
+	);
+	`,
+	// 6: aliases.
+	`
+	CREATE TABLE %[1]s.aliases (
+		id    TEXT NOT NULL,
+		alias TEXT NOT NULL,
+		PRIMARY KEY (id, alias)
+	);
+	`,
+	// 7: search terms.
+	`
+	CREATE TABLE %[1]s.search_terms (
+		id   TEXT NOT NULL,
+		term TEXT NOT NULL,
+		PRIMARY KEY (term, id)
+	);
+	CREATE INDEX ON %[1]s.search_terms (id);
+	`,
+	// 8: deps.dev discrepancies.
+	`
+	CREATE TABLE %[1]s.depsdev_discrepancies (
+		module      TEXT COLLATE "C" NOT NULL,
+		advisory_id TEXT COLLATE "C" NOT NULL,
+		data        JSONB NOT NULL,
+		PRIMARY KEY (module, advisory_id)
+	);
+	`,
+	// 9: source status.
+	`
+	CREATE TABLE %[1]s.source_status (
+		source TEXT COLLATE "C" PRIMARY KEY,
+		data   JSONB NOT NULL
+	);
+	`,
+	// 10: quarantined files.
+	`
+	CREATE TABLE %[1]s.quarantined_files (
+		path TEXT COLLATE "C" PRIMARY KEY,
+		data JSONB NOT NULL
+	);
+	`,
+	// 11: security releases.
+	`
+	CREATE TABLE %[1]s.security_releases (
+		release TEXT COLLATE "C" PRIMARY KEY,
+		data    JSONB NOT NULL
+	);
+	`,
+	// 12: unfixed modules.
+	`
+	CREATE TABLE %[1]s.unfixed_modules (
+		report_id TEXT COLLATE "C" NOT NULL,
+		module    TEXT COLLATE "C" NOT NULL,
+		data      JSONB NOT NULL,
+		PRIMARY KEY (report_id, module)
+	);
+	`,
+	// 13: report reviews.
+	`
+	CREATE TABLE %[1]s.report_reviews (
+		report_id TEXT COLLATE "C" PRIMARY KEY,
+		data      JSONB NOT NULL
+	);
+	`,
+	// 14: modules of GitHub repos.
+	`
+	CREATE TABLE %[1]s.repo_modules (
+		repo TEXT COLLATE "C" PRIMARY KEY,
+		data JSONB NOT NULL
+	);
+	`,
+}
+
+// migrate creates the namespace's schema if necessary and applies any
+// migrations that have not yet been applied to it.
+func (ps *PGStore) migrate(ctx context.Context) (err error) {
+	defer derrors.Wrap(&err, "migrate")
+
+	tx, err := ps.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Serialize migrations of the same namespace by different processes.
+	// The lock is released when the transaction ends.
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, ps.namespace); err != nil {
+		return err
+	}
+	schema := pq.QuoteIdentifier(ps.namespace)
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		CREATE SCHEMA IF NOT EXISTS %[1]s;
+		CREATE TABLE IF NOT EXISTS %[1]s.schema_migrations (
+			version    INTEGER PRIMARY KEY,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)`, schema)); err != nil {
+		return err
+	}
+	var version int
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf(
+		`SELECT COALESCE(MAX(version), 0) FROM %s.schema_migrations`, schema)).Scan(&version); err != nil {
+		return err
+	}
+	if version > len(pgMigrations) {
+		return fmt.Errorf("schema version %d is newer than the latest known version %d", version, len(pgMigrations))
+	}
+	for v := version + 1; v <= len(pgMigrations); v++ {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(pgMigrations[v-1], schema)); err != nil {
+			return fmt.Errorf("migration %d: %w", v, err)
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+			`INSERT INTO %s.schema_migrations (version) VALUES ($1)`, schema), v); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// table returns the qualified name of the table in the store's namespace.
+func (ps *PGStore) table(name string) string {
+	return pq.QuoteIdentifier(ps.namespace) + "." + name
+}
+
+// CreateCommitUpdateRecord implements Store.CreateCommitUpdateRecord.
+// On successful return, r.ID is set to the record's ID.
+func (ps *PGStore) CreateCommitUpdateRecord(ctx context.Context, r *CommitUpdateRecord) (err error) {
+	defer derrors.Wrap(&err, "CreateCommitUpdateRecord()")
+
+	r.UpdatedAt = time.Now()
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	var id int64
+	q := fmt.Sprintf(`INSERT INTO %s (started_at, data) VALUES ($1, $2) RETURNING id`, ps.table("commit_updates"))
+	if err := ps.db.QueryRowContext(ctx, q, r.StartedAt, data).Scan(&id); err != nil {
+		return err
+	}
+	r.ID = fmt.Sprint(id)
+	return nil
+}
+
+// SetCommitUpdateRecord implements Store.SetCommitUpdateRecord.
+func (ps *PGStore) SetCommitUpdateRecord(ctx context.Context, r *CommitUpdateRecord) (err error) {
+	defer derrors.Wrap(&err, "SetCommitUpdateRecord(%q)", r.ID)
+
+	if r.ID == "" {
+		return errors.New("missing ID")
+	}
+	r.UpdatedAt = time.Now()
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`UPDATE %s SET started_at = $2, data = $3 WHERE id = $1`, ps.table("commit_updates"))
+	res, err := ps.db.ExecContext(ctx, q, r.ID, r.StartedAt, data)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(res, fmt.Sprintf("CommitUpdateRecord with ID %q not found", r.ID))
+}
+
+// ListCommitUpdateRecords implements Store.ListCommitUpdateRecords.
+func (ps *PGStore) ListCommitUpdateRecords(ctx context.Context, limit int) (_ []*CommitUpdateRecord, err error) {
+	defer derrors.Wrap(&err, "ListCommitUpdateRecords(%d)", limit)
+
+	q := fmt.Sprintf(`SELECT id, data FROM %s ORDER BY started_at DESC`, ps.table("commit_updates"))
+	if limit > 0 {
+		q += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	rows, err := ps.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var urs []*CommitUpdateRecord
+	for rows.Next() {
+		var (
+			id   int64
+			data []byte
+		)
+		if err := rows.Scan(&id, &data); err != nil {
+			return nil, err
+		}
+		var ur CommitUpdateRecord
+		if err := json.Unmarshal(data, &ur); err != nil {
+			return nil, err
+		}
+		ur.ID = fmt.Sprint(id)
+		urs = append(urs, &ur)
+	}
+	return urs, rows.Err()
+}
+
+// GetCVERecord implements Store.GetCVERecord.
+func (ps *PGStore) GetCVERecord(ctx context.Context, id string) (_ *CVERecord, err error) {
+	defer derrors.Wrap(&err, "GetCVERecord(%q)", id)
+
+	q := fmt.Sprintf(`SELECT data FROM %s WHERE id = $1`, ps.table("cve_records"))
+	var data []byte
+	err = ps.db.QueryRowContext(ctx, q, id).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cr CVERecord
+	if err := json.Unmarshal(data, &cr); err != nil {
+		return nil, err
+	}
+	return &cr, nil
+}
+
+// ListCVERecordsWithTriageState implements Store.ListCVERecordsWithTriageState.
+func (ps *PGStore) ListCVERecordsWithTriageState(ctx context.Context, ts TriageState) (_ []*CVERecord, err error) {
+	defer derrors.Wrap(&err, "ListCVERecordsWithTriageState(%s)", ts)
+
+	q := fmt.Sprintf(`SELECT data FROM %s WHERE triage_state = $1 ORDER BY id`, ps.table("cve_records"))
+	return queryCVERecords(ctx, ps.db, q, ts)
+}
+
+// ListCVERecords implements Store.ListCVERecords.
+func (ps *PGStore) ListCVERecords(ctx context.Context, q CVERecordQuery) (_ []*CVERecord, _ string, err error) {
+	defer derrors.Wrap(&err, "ListCVERecords(%+v)", q)
+
+	query, args := cveRecordQuerySQL(ps.table("cve_records"), q, func(n int) string { return fmt.Sprintf("$%d", n) })
+	crs, err := queryCVERecords(ctx, ps.db, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	crs, next := pageCVERecords(crs, q.Limit)
+	return crs, next, nil
+}
+
+// GetDirectoryHash implements Store.GetDirectoryHash.
+func (ps *PGStore) GetDirectoryHash(ctx context.Context, dir string) (_ string, err error) {
+	defer derrors.Wrap(&err, "GetDirectoryHash(%s)", dir)
+
+	q := fmt.Sprintf(`SELECT hash FROM %s WHERE dir = $1`, ps.table("dir_hashes"))
+	var hash string
+	err = ps.db.QueryRowContext(ctx, q, dir).Scan(&hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// SetDirectoryHash implements Store.SetDirectoryHash.
+func (ps *PGStore) SetDirectoryHash(ctx context.Context, dir, hash string) (err error) {
+	defer derrors.Wrap(&err, "SetDirectoryHash(%s)", dir)
+
+	q := fmt.Sprintf(`
+		INSERT INTO %s (dir, hash) VALUES ($1, $2)
+		ON CONFLICT (dir) DO UPDATE SET hash = EXCLUDED.hash`, ps.table("dir_hashes"))
+	_, err = ps.db.ExecContext(ctx, q, dir, hash)
+	return err
+}
+
+// GetFetchCursor implements Store.GetFetchCursor.
+func (ps *PGStore) GetFetchCursor(ctx context.Context, source string) (_ time.Time, err error) {
+	defer derrors.Wrap(&err, "GetFetchCursor(%s)", source)
+
+	q := fmt.Sprintf(`SELECT time FROM %s WHERE source = $1`, ps.table("fetch_cursors"))
+	var t time.Time
+	err = ps.db.QueryRowContext(ctx, q, source).Scan(&t)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+// SetFetchCursor implements Store.SetFetchCursor.
+// Postgres stores the time with microsecond precision.
+func (ps *PGStore) SetFetchCursor(ctx context.Context, source string, t time.Time) (err error) {
+	defer derrors.Wrap(&err, "SetFetchCursor(%s)", source)
+
+	q := fmt.Sprintf(`
+		INSERT INTO %s (source, time) VALUES ($1, $2)
+		ON CONFLICT (source) DO UPDATE SET time = EXCLUDED.time`, ps.table("fetch_cursors"))
+	_, err = ps.db.ExecContext(ctx, q, source, t)
+	return err
+}
+
+// AcquireLock implements Store.AcquireLock.
+func (ps *PGStore) AcquireLock(ctx context.Context, name, owner string, ttl time.Duration) (_ bool, err error) {
+	defer derrors.Wrap(&err, "AcquireLock(%s, %s)", name, owner)
+
+	// The update happens only if the lock is free, so the insert affects a
+	// row exactly when the lock is acquired.
+	now := time.Now()
+	q := fmt.Sprintf(`
+		INSERT INTO %[1]s AS l (name, owner, expires) VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO UPDATE SET owner = EXCLUDED.owner, expires = EXCLUDED.expires
+		WHERE l.owner = EXCLUDED.owner OR l.expires <= $4`, ps.table("locks"))
+	res, err := ps.db.ExecContext(ctx, q, name, owner, now.Add(ttl), now)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// RenewLock implements Store.RenewLock.
+func (ps *PGStore) RenewLock(ctx context.Context, name, owner string, ttl time.Duration) (_ bool, err error) {
+	defer derrors.Wrap(&err, "RenewLock(%s, %s)", name, owner)
+
+	now := time.Now()
+	q := fmt.Sprintf(`UPDATE %s SET expires = $1 WHERE name = $2 AND owner = $3 AND expires > $4`, ps.table("locks"))
+	res, err := ps.db.ExecContext(ctx, q, now.Add(ttl), name, owner, now)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ReleaseLock implements Store.ReleaseLock.
+func (ps *PGStore) ReleaseLock(ctx context.Context, name, owner string) (err error) {
+	defer derrors.Wrap(&err, "ReleaseLock(%s, %s)", name, owner)
+
+	q := fmt.Sprintf(`DELETE FROM %s WHERE name = $1 AND owner = $2`, ps.table("locks"))
+	_, err = ps.db.ExecContext(ctx, q, name, owner)
+	return err
+}
+
+// CreateModuleScanRecord implements Store.CreateModuleScanRecord.
+func (ps *PGStore) CreateModuleScanRecord(ctx context.Context, r *ModuleScanRecord) (err error) {
+	defer derrors.Wrap(&err, "CreateModuleScanRecord(%s@%s)", r.Path, r.Version)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`
+		INSERT INTO %s (path, version, db_time, finished_at, data)
+		VALUES ($1, $2, $3, $4, $5)`, ps.table("module_scans"))
+	_, err = ps.db.ExecContext(ctx, q, r.Path, r.Version, r.DBTime.UnixNano(), r.FinishedAt, data)
+	return err
+}
+
+// GetModuleScanRecord implements Store.GetModuleScanRecord.
+func (ps *PGStore) GetModuleScanRecord(ctx context.Context, path, version string, dbTime time.Time) (_ *ModuleScanRecord, err error) {
+	defer derrors.Wrap(&err, "GetModuleScanRecord(%s@%s)", path, version)
+
+	// There may be several, but we only need one; take the most recent.
+	q := fmt.Sprintf(`
+		SELECT data FROM %s
+		WHERE path = $1 AND version = $2 AND db_time = $3
+		ORDER BY finished_at DESC
+		LIMIT 1`, ps.table("module_scans"))
+	var data []byte
+	err = ps.db.QueryRowContext(ctx, q, path, version, dbTime.UnixNano()).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var r ModuleScanRecord
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ListModuleScanRecords implements Store.ListModuleScanRecords.
+func (ps *PGStore) ListModuleScanRecords(ctx context.Context, limit int) (_ []*ModuleScanRecord, err error) {
+	defer derrors.Wrap(&err, "ListModuleScanRecords(%d)", limit)
+
+	q := fmt.Sprintf(`SELECT data FROM %s ORDER BY finished_at DESC`, ps.table("module_scans"))
+	if limit > 0 {
+		q += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	rows, err := ps.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var rs []*ModuleScanRecord
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var r ModuleScanRecord
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, err
+		}
+		rs = append(rs, &r)
+	}
+	return rs, rows.Err()
+}
+
+// SetDepsDevDiscrepancies implements Store.SetDepsDevDiscrepancies.
+func (ps *PGStore) SetDepsDevDiscrepancies(ctx context.Context, ds []*DepsDevDiscrepancy) (err error) {
+	defer derrors.Wrap(&err, "SetDepsDevDiscrepancies")
+
+	return replaceDepsDevDiscrepancies(ctx, ps.db, ps.table("depsdev_discrepancies"), ds,
+		func(n int) string { return fmt.Sprintf("$%d", n) })
+}
+
+// SetSourceStatus implements Store.SetSourceStatus.
+func (ps *PGStore) SetSourceStatus(ctx context.Context, s *SourceStatus) (err error) {
+	defer derrors.Wrap(&err, "SetSourceStatus(%s)", s.Source)
+
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(s)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`
+		INSERT INTO %s (source, data) VALUES ($1, $2)
+		ON CONFLICT (source) DO UPDATE SET data = EXCLUDED.data`, ps.table("source_status"))
+	_, err = ps.db.ExecContext(ctx, q, s.Source, data)
+	return err
+}
+
+// ListSourceStatuses implements Store.ListSourceStatuses.
+func (ps *PGStore) ListSourceStatuses(ctx context.Context) (_ []*SourceStatus, err error) {
+	defer derrors.Wrap(&err, "ListSourceStatuses")
+
+	return querySourceStatuses(ctx, ps.db,
+		fmt.Sprintf(`SELECT data FROM %s ORDER BY source`, ps.table("source_status")))
+}
+
+// SetQuarantinedFile implements Store.SetQuarantinedFile.
+func (ps *PGStore) SetQuarantinedFile(ctx context.Context, q *QuarantinedFile) (err error) {
+	defer derrors.Wrap(&err, "SetQuarantinedFile(%s)", q.Path)
+
+	if err := q.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(q)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO %s (path, data) VALUES ($1, $2)
+		ON CONFLICT (path) DO UPDATE SET data = EXCLUDED.data`, ps.table("quarantined_files"))
+	_, err = ps.db.ExecContext(ctx, query, q.Path, data)
+	return err
+}
+
+// DeleteQuarantinedFile implements Store.DeleteQuarantinedFile.
+func (ps *PGStore) DeleteQuarantinedFile(ctx context.Context, path string) (err error) {
+	defer derrors.Wrap(&err, "DeleteQuarantinedFile(%s)", path)
+
+	q := fmt.Sprintf(`DELETE FROM %s WHERE path = $1`, ps.table("quarantined_files"))
+	_, err = ps.db.ExecContext(ctx, q, path)
+	return err
+}
+
+// ListQuarantinedFiles implements Store.ListQuarantinedFiles.
+func (ps *PGStore) ListQuarantinedFiles(ctx context.Context) (_ []*QuarantinedFile, err error) {
+	defer derrors.Wrap(&err, "ListQuarantinedFiles")
+
+	return queryQuarantinedFiles(ctx, ps.db,
+		fmt.Sprintf(`SELECT data FROM %s ORDER BY path`, ps.table("quarantined_files")))
+}
+
+// SetSecurityRelease implements Store.SetSecurityRelease.
+func (ps *PGStore) SetSecurityRelease(ctx context.Context, r *SecurityRelease) (err error) {
+	defer derrors.Wrap(&err, "SetSecurityRelease(%s)", r.Release)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO %s (release, data) VALUES ($1, $2)
+		ON CONFLICT (release) DO UPDATE SET data = EXCLUDED.data`, ps.table("security_releases"))
+	_, err = ps.db.ExecContext(ctx, query, r.Release, data)
+	return err
+}
+
+// ListSecurityReleases implements Store.ListSecurityReleases.
+func (ps *PGStore) ListSecurityReleases(ctx context.Context) (_ []*SecurityRelease, err error) {
+	defer derrors.Wrap(&err, "ListSecurityReleases")
+
+	return querySecurityReleases(ctx, ps.db,
+		fmt.Sprintf(`SELECT data FROM %s ORDER BY release`, ps.table("security_releases")))
+}
+
+// SetUnfixedModule implements Store.SetUnfixedModule.
+func (ps *PGStore) SetUnfixedModule(ctx context.Context, u *UnfixedModule) (err error) {
+	defer derrors.Wrap(&err, "SetUnfixedModule(%s, %s)", u.ReportID, u.Module)
+
+	if err := u.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(u)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO %s (report_id, module, data) VALUES ($1, $2, $3)
+		ON CONFLICT (report_id, module) DO UPDATE SET data = EXCLUDED.data`, ps.table("unfixed_modules"))
+	_, err = ps.db.ExecContext(ctx, query, u.ReportID, u.Module, data)
+	return err
+}
+
+// DeleteUnfixedModule implements Store.DeleteUnfixedModule.
+func (ps *PGStore) DeleteUnfixedModule(ctx context.Context, reportID, module string) (err error) {
+	defer derrors.Wrap(&err, "DeleteUnfixedModule(%s, %s)", reportID, module)
+
+	q := fmt.Sprintf(`DELETE FROM %s WHERE report_id = $1 AND module = $2`, ps.table("unfixed_modules"))
+	_, err = ps.db.ExecContext(ctx, q, reportID, module)
+	return err
+}
+
+// ListUnfixedModules implements Store.ListUnfixedModules.
+func (ps *PGStore) ListUnfixedModules(ctx context.Context) (_ []*UnfixedModule, err error) {
+	defer derrors.Wrap(&err, "ListUnfixedModules")
+
+	return queryUnfixedModules(ctx, ps.db,
+		fmt.Sprintf(`SELECT data FROM %s ORDER BY report_id, module`, ps.table("unfixed_modules")))
+}
+
+// SetReportReview implements Store.SetReportReview.
+func (ps *PGStore) SetReportReview(ctx context.Context, r *ReportReview) (err error) {
+	defer derrors.Wrap(&err, "SetReportReview(%s)", r.ReportID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	_, err = ps.db.ExecContext(ctx, ps.setReviewQuery(), r.ReportID, data)
+	return err
+}
+
+// setReviewQuery returns the query that adds or replaces a ReportReview,
+// given its report ID and data.
+func (ps *PGStore) setReviewQuery() string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (report_id, data) VALUES ($1, $2)
+		ON CONFLICT (report_id) DO UPDATE SET data = EXCLUDED.data`, ps.table("report_reviews"))
+}
+
+// GetReportReview implements Store.GetReportReview.
+func (ps *PGStore) GetReportReview(ctx context.Context, reportID string) (_ *ReportReview, err error) {
+	defer derrors.Wrap(&err, "GetReportReview(%s)", reportID)
+
+	rs, err := queryReportReviews(ctx, ps.db, ps.getReviewQuery(), reportID)
+	if err != nil || len(rs) == 0 {
+		return nil, err
+	}
+	return rs[0], nil
+}
+
+// getReviewQuery returns the query for the ReportReview of a report, given
+// its ID.
+func (ps *PGStore) getReviewQuery() string {
+	return fmt.Sprintf(`SELECT data FROM %s WHERE report_id = $1`, ps.table("report_reviews"))
+}
+
+// ListReportReviews implements Store.ListReportReviews.
+func (ps *PGStore) ListReportReviews(ctx context.Context) (_ []*ReportReview, err error) {
+	defer derrors.Wrap(&err, "ListReportReviews")
+
+	return queryReportReviews(ctx, ps.db,
+		fmt.Sprintf(`SELECT data FROM %s ORDER BY report_id`, ps.table("report_reviews")))
+}
+
+// SetRepoModules implements Store.SetRepoModules.
+func (ps *PGStore) SetRepoModules(ctx context.Context, r *RepoModules) (err error) {
+	defer derrors.Wrap(&err, "SetRepoModules(%s)", r.Repo)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO %s (repo, data) VALUES ($1, $2)
+		ON CONFLICT (repo) DO UPDATE SET data = EXCLUDED.data`, ps.table("repo_modules"))
+	_, err = ps.db.ExecContext(ctx, query, r.Repo, data)
+	return err
+}
+
+// GetRepoModules implements Store.GetRepoModules.
+func (ps *PGStore) GetRepoModules(ctx context.Context, repo string) (_ *RepoModules, err error) {
+	defer derrors.Wrap(&err, "GetRepoModules(%s)", repo)
+
+	rs, err := queryRepoModules(ctx, ps.db,
+		fmt.Sprintf(`SELECT data FROM %s WHERE repo = $1`, ps.table("repo_modules")), repo)
+	if err != nil || len(rs) == 0 {
+		return nil, err
+	}
+	return rs[0], nil
+}
+
+// ListDepsDevDiscrepancies implements Store.ListDepsDevDiscrepancies.
+func (ps *PGStore) ListDepsDevDiscrepancies(ctx context.Context) (_ []*DepsDevDiscrepancy, err error) {
+	defer derrors.Wrap(&err, "ListDepsDevDiscrepancies")
+
+	return queryDepsDevDiscrepancies(ctx, ps.db,
+		fmt.Sprintf(`SELECT data FROM %s ORDER BY module, advisory_id`, ps.table("depsdev_discrepancies")))
+}
+
+// ListTriageHistory implements Store.ListTriageHistory.
+func (ps *PGStore) ListTriageHistory(ctx context.Context, id string) (_ []*TriageHistoryEntry, err error) {
+	defer derrors.Wrap(&err, "ListTriageHistory(%s)", id)
+
+	q := fmt.Sprintf(`SELECT data FROM %s WHERE record_id = $1 ORDER BY id`, ps.table("triage_history"))
+	return queryTriageHistory(ctx, ps.db, q, id)
+}
+
+// GetAliases implements Store.GetAliases.
+func (ps *PGStore) GetAliases(ctx context.Context, id string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "GetAliases(%s)", id)
+
+	return connectedAliases(id, sqlAliases(ctx, ps.db, ps.aliasQuery()))
+}
+
+func (ps *PGStore) aliasQuery() string {
+	return fmt.Sprintf(`SELECT alias FROM %s WHERE id = $1`, ps.table("aliases"))
+}
+
+// Search implements Store.Search.
+func (ps *PGStore) Search(ctx context.Context, terms []string, limit int) (_ []string, err error) {
+	defer derrors.Wrap(&err, "Search(%v)", terms)
+
+	terms = uniqueTerms(terms)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	q, args := searchQuerySQL(ps.table("search_terms"), terms, limit, func(n int) string { return fmt.Sprintf("$%d", n) })
+	return queryStrings(ctx, ps.db, q, args...)
+}
+
+// maxPGTransactionAttempts is the number of times RunTransaction will try
+// a transaction that fails because of a conflict with another transaction.
+const maxPGTransactionAttempts = 5
+
+// RunTransaction implements Store.RunTransaction.
+// Transactions run at the serializable isolation level. As with Firestore,
+// a transaction that conflicts with a concurrent one is retried, so f may be
+// called more than once.
+func (ps *PGStore) RunTransaction(ctx context.Context, f func(context.Context, Transaction) error) error {
+	var err error
+	for i := 0; i < maxPGTransactionAttempts; i++ {
+		err = ps.runTransactionOnce(ctx, f)
+		if !isSerializationFailure(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (ps *PGStore) runTransactionOnce(ctx context.Context, f func(context.Context, Transaction) error) error {
+	tx, err := ps.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return err
+	}
+	tracker := newTriageTracker(ctx)
+	if err := f(ctx, &pgTransaction{ps, ctx, tx, tracker}); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	tracker.committed(ctx)
+	return nil
+}
+
+// isSerializationFailure reports whether err is a Postgres error indicating
+// that the transaction could not complete because of a concurrent one, and
+// so can be retried.
+func isSerializationFailure(err error) bool {
+	var perr *pq.Error
+	if !errors.As(err, &perr) {
+		return false
+	}
+	switch perr.Code {
+	case "40001", // serialization_failure
+		"40P01": // deadlock_detected
+		return true
+	default:
+		return false
+	}
+}
+
+// Clear removes all records in the namespace.
+func (ps *PGStore) Clear(ctx context.Context) (err error) {
+	defer derrors.Wrap(&err, "Clear")
+
+	_, err = ps.db.ExecContext(ctx, fmt.Sprintf(`TRUNCATE %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s`,
+		ps.table("commit_updates"),
+		ps.table("cve_records"),
+		ps.table("dir_hashes"),
+		ps.table("ghsa_records"),
+		ps.table("module_scans"),
+		ps.table("fetch_cursors"),
+		ps.table("triage_history"),
+		ps.table("locks"),
+		ps.table("aliases"),
+		ps.table("search_terms"),
+		ps.table("depsdev_discrepancies"),
+		ps.table("source_status"),
+		ps.table("quarantined_files"),
+		ps.table("security_releases"),
+		ps.table("unfixed_modules"),
+		ps.table("report_reviews"),
+		ps.table("repo_modules")))
+	return err
+}
+
+// pgTransaction implements Transaction.
+type pgTransaction struct {
+	s       *PGStore
+	ctx     context.Context
+	tx      *sql.Tx
+	tracker *triageTracker
+}
+
+// addTriageHistory adds a TriageHistoryEntry for the record with the given
+// ID, if its triage state has changed.
+func (tx *pgTransaction) addTriageHistory(id string, ts TriageState, reason string) error {
+	e := tx.tracker.write(id, ts, reason)
+	if e == nil {
+		return nil
+	}
+	return tx.insertTriageHistory(e)
+}
+
+func (tx *pgTransaction) insertTriageHistory(e *TriageHistoryEntry) error {
+	data, err := marshalJSON(e)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`INSERT INTO %s (record_id, data) VALUES ($1, $2)`, tx.s.table("triage_history"))
+	_, err = tx.tx.ExecContext(tx.ctx, q, e.ID, data)
+	return err
+}
+
+// CreateCVERecord implements Transaction.CreateCVERecord.
+func (tx *pgTransaction) CreateCVERecord(r *CVERecord) (err error) {
+	defer derrors.Wrap(&err, "PGStore.CreateCVERecord(%s)", r.ID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if r.Version == 0 {
+		r.Version = 1
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`
+		INSERT INTO %s (id, triage_state, module, commit_time, data)
+		VALUES ($1, $2, $3, $4, $5)`, tx.s.table("cve_records"))
+	if _, err := tx.tx.ExecContext(tx.ctx, q, r.ID, r.TriageState, r.Module, r.CommitTime.UnixNano(), data); err != nil {
+		return err
+	}
+	return tx.addTriageHistory(r.ID, r.TriageState, r.TriageStateReason)
+}
+
+// SetCVERecord implements Transaction.SetCVERecord.
+func (tx *pgTransaction) SetCVERecord(r *CVERecord) (err error) {
+	defer derrors.Wrap(&err, "SetCVERecord(%s)", r.ID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	table := tx.s.table("cve_records")
+	if err := checkStoredVersion(tx.ctx, tx.tx, fmt.Sprintf(`SELECT data FROM %s WHERE id = $1`, table), r); err != nil {
+		return err
+	}
+	c := *r
+	c.Version++
+	data, err := marshalJSON(&c)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`
+		UPDATE %s SET triage_state = $2, module = $3, commit_time = $4, data = $5
+		WHERE id = $1`, table)
+	if _, err := tx.tx.ExecContext(tx.ctx, q, r.ID, r.TriageState, r.Module, r.CommitTime.UnixNano(), data); err != nil {
+		return err
+	}
+	r.Version = c.Version
+	return tx.addTriageHistory(r.ID, r.TriageState, r.TriageStateReason)
+}
+
+// GetCVERecords implements Transaction.GetCVERecords.
+func (tx *pgTransaction) GetCVERecords(startID, endID string) (_ []*CVERecord, err error) {
+	defer derrors.Wrap(&err, "GetCVERecords(%s, %s)", startID, endID)
+
+	q := fmt.Sprintf(`SELECT data FROM %s WHERE id >= $1 AND id <= $2 ORDER BY id`, tx.s.table("cve_records"))
+	crs, err := queryCVERecords(tx.ctx, tx.tx, q, startID, endID)
+	if err != nil {
+		return nil, err
+	}
+	for _, cr := range crs {
+		tx.tracker.read(cr.ID, cr.TriageState)
+	}
+	return crs, nil
+}
+
+// CreateGHSARecord implements Transaction.CreateGHSARecord.
+func (tx *pgTransaction) CreateGHSARecord(r *GHSARecord) (err error) {
+	defer derrors.Wrap(&err, "PGStore.CreateGHSARecord(%s)", r.GHSA.ID)
+
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`INSERT INTO %s (id, data) VALUES ($1, $2)`, tx.s.table("ghsa_records"))
+	if _, err := tx.tx.ExecContext(tx.ctx, q, r.GHSA.ID, data); err != nil {
+		return err
+	}
+	return tx.addTriageHistory(r.GHSA.ID, r.TriageState, r.TriageStateReason)
+}
+
+// SetGHSARecord implements Transaction.SetGHSARecord.
+func (tx *pgTransaction) SetGHSARecord(r *GHSARecord) (err error) {
+	defer derrors.Wrap(&err, "SetGHSARecord(%s)", r.GHSA.ID)
+
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`UPDATE %s SET data = $2 WHERE id = $1`, tx.s.table("ghsa_records"))
+	res, err := tx.tx.ExecContext(tx.ctx, q, r.GHSA.ID, data)
+	if err != nil {
+		return err
+	}
+	if err := checkRowsAffected(res, fmt.Sprintf("GHSARecord %s does not exist", r.GHSA.ID)); err != nil {
+		return err
+	}
+	return tx.addTriageHistory(r.GHSA.ID, r.TriageState, r.TriageStateReason)
+}
+
+// GetGHSARecord implements Transaction.GetGHSARecord.
+func (tx *pgTransaction) GetGHSARecord(id string) (_ *GHSARecord, err error) {
+	defer derrors.Wrap(&err, "GetGHSARecord(%s)", id)
+
+	q := fmt.Sprintf(`SELECT data FROM %s WHERE id = $1`, tx.s.table("ghsa_records"))
+	var data []byte
+	err = tx.tx.QueryRowContext(tx.ctx, q, id).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var gr GHSARecord
+	if err := json.Unmarshal(data, &gr); err != nil {
+		return nil, err
+	}
+	tx.tracker.read(id, gr.TriageState)
+	return &gr, nil
+}
+
+// GetGHSARecords implements Transaction.GetGHSARecords.
+func (tx *pgTransaction) GetGHSARecords() (_ []*GHSARecord, err error) {
+	defer derrors.Wrap(&err, "GetGHSARecords()")
+
+	q := fmt.Sprintf(`SELECT data FROM %s ORDER BY id`, tx.s.table("ghsa_records"))
+	grs, err := queryGHSARecords(tx.ctx, tx.tx, q)
+	if err != nil {
+		return nil, err
+	}
+	for _, gr := range grs {
+		tx.tracker.read(gr.GHSA.ID, gr.TriageState)
+	}
+	return grs, nil
+}
+
+// AddAliases implements Transaction.AddAliases.
+func (tx *pgTransaction) AddAliases(ids ...string) (err error) {
+	defer derrors.Wrap(&err, "AddAliases(%v)", ids)
+
+	q := fmt.Sprintf(`
+		INSERT INTO %s (id, alias) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING`, tx.s.table("aliases"))
+	for id, others := range aliasEdges(ids) {
+		for _, o := range others {
+			if _, err := tx.tx.ExecContext(tx.ctx, q, id, o); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetAliases implements Transaction.GetAliases.
+func (tx *pgTransaction) GetAliases(id string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "GetAliases(%s)", id)
+
+	return connectedAliases(id, sqlAliases(tx.ctx, tx.tx, tx.s.aliasQuery()))
+}
+
+// SetSearchTerms implements Transaction.SetSearchTerms.
+func (tx *pgTransaction) SetSearchTerms(id string, terms []string) (err error) {
+	defer derrors.Wrap(&err, "SetSearchTerms(%s)", id)
+
+	table := tx.s.table("search_terms")
+	if _, err := tx.tx.ExecContext(tx.ctx, fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, table), id); err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`INSERT INTO %s (id, term) VALUES ($1, $2)`, table)
+	for _, t := range uniqueTerms(terms) {
+		if _, err := tx.tx.ExecContext(tx.ctx, q, id, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetReportReview implements Transaction.SetReportReview.
+func (tx *pgTransaction) SetReportReview(r *ReportReview) (err error) {
+	defer derrors.Wrap(&err, "SetReportReview(%s)", r.ReportID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	_, err = tx.tx.ExecContext(tx.ctx, tx.s.setReviewQuery(), r.ReportID, data)
+	return err
+}
+
+// GetReportReview implements Transaction.GetReportReview.
+func (tx *pgTransaction) GetReportReview(reportID string) (_ *ReportReview, err error) {
+	defer derrors.Wrap(&err, "GetReportReview(%s)", reportID)
+
+	rs, err := queryReportReviews(tx.ctx, tx.tx, tx.s.getReviewQuery(), reportID)
+	if err != nil || len(rs) == 0 {
+		return nil, err
+	}
+	return rs[0], nil
+}
+
+// AddTriageHistory implements Transaction.AddTriageHistory.
+func (tx *pgTransaction) AddTriageHistory(es ...*TriageHistoryEntry) (err error) {
+	defer derrors.Wrap(&err, "AddTriageHistory")
+
+	for _, e := range es {
+		if err := tx.insertTriageHistory(e); err != nil {
+			return err
+		}
+		tx.tracker.read(e.ID, e.NewState)
+	}
+	return nil
+}
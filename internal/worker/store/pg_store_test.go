@@ -0,0 +1,59 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package store
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os/user"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var pgDataSource = flag.String("pg", "", "Postgres data source name for PGStore")
+
+func TestPGStore(t *testing.T) {
+	if *pgDataSource == "" {
+		t.Skip("missing -pg")
+	}
+	ctx := context.Background()
+	// Create a store with a unique namespace for this test.
+	username := "unknown"
+	if u, err := user.Current(); err == nil {
+		username = u.Username
+	}
+	rand.Seed(time.Now().UnixNano())
+	r := rand.Intn(1000)
+	namespace := fmt.Sprintf("testing-%s-%d", username, r)
+	t.Logf("testing in namespace %s", namespace)
+
+	ps, err := NewPGStore(ctx, *pgDataSource, namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Drop the namespace when we're done.
+	defer func() {
+		if _, err := ps.db.ExecContext(ctx, "DROP SCHEMA "+pq.QuoteIdentifier(namespace)+" CASCADE"); err != nil {
+			t.Log(err)
+		}
+		ps.Close()
+	}()
+
+	testStore(t, ps)
+
+	// Opening the store again must not re-apply migrations.
+	ps2, err := NewPGStore(ctx, *pgDataSource, namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps2.Close()
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import "sort"
+
+// The stores keep an inverted index for full-text search over the text of
+// vulnerabilities, such as CVE descriptions, GHSA summaries and Go reports.
+// Each search document is identified by the ID of its vulnerability and has
+// a set of terms; a search finds the documents that have all the terms of a
+// query. The worker extracts the terms from the text; the stores only keep
+// them.
+
+// uniqueTerms returns the distinct, non-empty terms, sorted.
+func uniqueTerms(terms []string) []string {
+	seen := map[string]bool{}
+	var us []string
+	for _, t := range terms {
+		if t != "" && !seen[t] {
+			seen[t] = true
+			us = append(us, t)
+		}
+	}
+	sort.Strings(us)
+	return us
+}
+
+// hasAllTerms reports whether terms includes each of query.
+func hasAllTerms(terms, query []string) bool {
+	set := map[string]bool{}
+	for _, t := range terms {
+		set[t] = true
+	}
+	for _, q := range query {
+		if !set[q] {
+			return false
+		}
+	}
+	return true
+}
+
+// limitIDs sorts ids and returns at most limit of them, or all of them if
+// limit is not positive.
+func limitIDs(ids []string, limit int) []string {
+	sort.Strings(ids)
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids
+}
@@ -0,0 +1,239 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/worker/log"
+)
+
+// A snapshot holds the CVERecords, GHSARecords and CommitUpdateRecords of a
+// store, and the triage history of the records, so that the data of one
+// store can be copied to another, such as from production to a local SQLite
+// file.
+//
+// A snapshot is a sequence of JSON objects, one per line, each a
+// snapshotItem. The first item is the header.
+
+// snapshotVersion is the version of the snapshot format.
+const snapshotVersion = 1
+
+// A snapshotItem is one line of a snapshot. Exactly one of its record fields
+// is set.
+type snapshotItem struct {
+	Header             *snapshotHeader     `json:",omitempty"`
+	CVERecord          *CVERecord          `json:",omitempty"`
+	GHSARecord         *GHSARecord         `json:",omitempty"`
+	CommitUpdateRecord *CommitUpdateRecord `json:",omitempty"`
+	// TriageHistory is the history of the CVE or GHSA record, from least to
+	// most recent.
+	TriageHistory []*TriageHistoryEntry `json:",omitempty"`
+}
+
+type snapshotHeader struct {
+	Version int
+	// Time is when the export started.
+	Time time.Time
+}
+
+// SnapshotStats describes the contents of a snapshot.
+type SnapshotStats struct {
+	CVERecords          int
+	GHSARecords         int
+	CommitUpdateRecords int
+	TriageHistory       int
+}
+
+func (s SnapshotStats) String() string {
+	return fmt.Sprintf("%d CVE records, %d GHSA records, %d update records, %d triage history entries",
+		s.CVERecords, s.GHSARecords, s.CommitUpdateRecords, s.TriageHistory)
+}
+
+// exportPageSize is the number of CVERecords that Export reads at once.
+const exportPageSize = 1000
+
+// Export writes a snapshot of s to w.
+//
+// Export reads the history of each record separately, and not in a single
+// transaction, so the snapshot is not of a single point in time if s
+// changes during the export.
+func Export(ctx context.Context, s Store, w io.Writer) (stats SnapshotStats, err error) {
+	defer derrors.Wrap(&err, "Export")
+
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	if err := enc.Encode(snapshotItem{Header: &snapshotHeader{Version: snapshotVersion, Time: time.Now().UTC()}}); err != nil {
+		return stats, err
+	}
+	writeRecord := func(item snapshotItem, id string) error {
+		es, err := s.ListTriageHistory(ctx, id)
+		if err != nil {
+			return err
+		}
+		item.TriageHistory = es
+		stats.TriageHistory += len(es)
+		return enc.Encode(item)
+	}
+
+	q := CVERecordQuery{Limit: exportPageSize}
+	for {
+		crs, next, err := s.ListCVERecords(ctx, q)
+		if err != nil {
+			return stats, err
+		}
+		for _, cr := range crs {
+			if err := writeRecord(snapshotItem{CVERecord: cr}, cr.ID); err != nil {
+				return stats, err
+			}
+			stats.CVERecords++
+		}
+		log.Debugf(ctx, "exported %d CVE records", stats.CVERecords)
+		if next == "" {
+			break
+		}
+		q.Cursor = next
+	}
+
+	var grs []*GHSARecord
+	err = s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		var err error
+		grs, err = tx.GetGHSARecords()
+		return err
+	})
+	if err != nil {
+		return stats, err
+	}
+	sort.Slice(grs, func(i, j int) bool { return grs[i].GHSA.ID < grs[j].GHSA.ID })
+	for _, gr := range grs {
+		if err := writeRecord(snapshotItem{GHSARecord: gr}, gr.GHSA.ID); err != nil {
+			return stats, err
+		}
+		stats.GHSARecords++
+	}
+
+	urs, err := s.ListCommitUpdateRecords(ctx, 0)
+	if err != nil {
+		return stats, err
+	}
+	// Oldest first, the order in which Import creates them.
+	for i := len(urs) - 1; i >= 0; i-- {
+		if err := enc.Encode(snapshotItem{CommitUpdateRecord: urs[i]}); err != nil {
+			return stats, err
+		}
+		stats.CommitUpdateRecords++
+	}
+	return stats, bw.Flush()
+}
+
+// maxImportWrites is the most writes that Import makes in one transaction.
+// Firestore allows 500.
+const maxImportWrites = 400
+
+// Import adds the records and triage history in the snapshot read from r to
+// s, which should not have any of the records already. The triage history
+// of the records is copied as it is, without new entries for the import.
+// CommitUpdateRecords get new IDs.
+func Import(ctx context.Context, s Store, r io.Reader) (stats SnapshotStats, err error) {
+	defer derrors.Wrap(&err, "Import")
+
+	dec := json.NewDecoder(bufio.NewReader(r))
+	var header snapshotItem
+	if err := dec.Decode(&header); err != nil {
+		return stats, fmt.Errorf("reading header: %v", err)
+	}
+	if header.Header == nil {
+		return stats, errors.New("not a snapshot: missing header")
+	}
+	if v := header.Header.Version; v != snapshotVersion {
+		return stats, fmt.Errorf("snapshot has version %d, want %d", v, snapshotVersion)
+	}
+
+	var (
+		batch  []*snapshotItem
+		writes int
+	)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+			for _, item := range batch {
+				if err := importRecord(tx, item); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, item := range batch {
+			if item.CVERecord != nil {
+				stats.CVERecords++
+			} else {
+				stats.GHSARecords++
+			}
+			stats.TriageHistory += len(item.TriageHistory)
+		}
+		log.Debugf(ctx, "imported %d CVE records and %d GHSA records", stats.CVERecords, stats.GHSARecords)
+		batch = batch[:0]
+		writes = 0
+		return nil
+	}
+
+	for {
+		item := &snapshotItem{}
+		err := dec.Decode(item)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stats, err
+		}
+		if ur := item.CommitUpdateRecord; ur != nil {
+			if err := s.CreateCommitUpdateRecord(ctx, ur); err != nil {
+				return stats, err
+			}
+			stats.CommitUpdateRecords++
+			continue
+		}
+		if item.CVERecord == nil && item.GHSARecord == nil {
+			return stats, errors.New("snapshot item without a record")
+		}
+		n := 1 + len(item.TriageHistory)
+		if writes+n > maxImportWrites {
+			if err := flush(); err != nil {
+				return stats, err
+			}
+		}
+		batch = append(batch, item)
+		writes += n
+	}
+	if err := flush(); err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
+
+// importRecord adds the record of item and its history in tx.
+func importRecord(tx Transaction, item *snapshotItem) error {
+	// Add the history first, so that creating the record doesn't add to it.
+	if err := tx.AddTriageHistory(item.TriageHistory...); err != nil {
+		return err
+	}
+	if item.CVERecord != nil {
+		return tx.CreateCVERecord(item.CVERecord)
+	}
+	return tx.CreateGHSARecord(item.GHSARecord)
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/ghsa"
+)
+
+func TestSnapshot(t *testing.T) {
+	ctx := WithActor(context.Background(), "alice")
+	src := NewMemStore()
+	var crs []*CVERecord
+	for _, id := range []string{"CVE-2022-0001", "CVE-2022-0002", "CVE-2021-0001"} {
+		crs = append(crs, &CVERecord{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			TriageState: TriageStateNeedsIssue,
+		})
+	}
+	createCVERecords(t, ctx, src, crs)
+	must(src.RunTransaction(WithActor(ctx, "bob"), func(ctx context.Context, tx Transaction) error {
+		rs, err := tx.GetCVERecords("CVE-2022-0001", "CVE-2022-0001")
+		if err != nil {
+			return err
+		}
+		rs[0].TriageState = TriageStateFalsePositive
+		if err := tx.SetCVERecord(rs[0]); err != nil {
+			return err
+		}
+		return tx.CreateGHSARecord(&GHSARecord{
+			GHSA:        &ghsa.SecurityAdvisory{ID: "GHSA-aaaa-bbbb-cccc", Summary: "sum"},
+			TriageState: TriageStateNoActionNeeded,
+		})
+	}))(t)
+	must(src.CreateCommitUpdateRecord(ctx, &CommitUpdateRecord{
+		StartedAt:  time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+		CommitHash: "123",
+		NumTotal:   3,
+	}))(t)
+
+	var buf bytes.Buffer
+	stats := must1(Export(ctx, src, &buf))(t)
+	want := SnapshotStats{CVERecords: 3, GHSARecords: 1, CommitUpdateRecords: 1, TriageHistory: 5}
+	if stats != want {
+		t.Errorf("Export: got %s, want %s", stats, want)
+	}
+
+	dst := must1(NewSQLiteStore(ctx, filepath.Join(t.TempDir(), "worker.db")))(t)
+	defer dst.Close()
+	stats = must1(Import(WithActor(ctx, "carol"), dst, bytes.NewReader(buf.Bytes())))(t)
+	if stats != want {
+		t.Errorf("Import: got %s, want %s", stats, want)
+	}
+
+	timeOpt := cmpopts.EquateApproxTime(time.Millisecond)
+	for _, cr := range crs {
+		diff(t, must1(src.GetCVERecord(ctx, cr.ID))(t), must1(dst.GetCVERecord(ctx, cr.ID))(t), timeOpt)
+	}
+	for _, id := range []string{"CVE-2022-0001", "GHSA-aaaa-bbbb-cccc"} {
+		diff(t, must1(src.ListTriageHistory(ctx, id))(t), must1(dst.ListTriageHistory(ctx, id))(t), timeOpt)
+	}
+	urs := must1(dst.ListCommitUpdateRecords(ctx, 0))(t)
+	diff(t, must1(src.ListCommitUpdateRecords(ctx, 0))(t), urs, timeOpt,
+		cmpopts.IgnoreFields(CommitUpdateRecord{}, "ID", "UpdatedAt"))
+
+	// Importing the same records again fails.
+	if _, err := Import(ctx, dst, bytes.NewReader(buf.Bytes())); err == nil {
+		t.Error("second import succeeded")
+	}
+	if _, err := Import(ctx, dst, bytes.NewReader([]byte(`{"CVERecord": {}}`))); err == nil {
+		t.Error("import without header succeeded")
+	}
+}
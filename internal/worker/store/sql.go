@@ -0,0 +1,407 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+// This file has helpers shared by the stores implemented with database/sql.
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// queryCVERecords runs a query whose only result column is the data of a
+// CVERecord, and returns the records.
+func queryCVERecords(ctx context.Context, db querier, query string, args ...interface{}) ([]*CVERecord, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var crs []*CVERecord
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var cr CVERecord
+		if err := json.Unmarshal(data, &cr); err != nil {
+			return nil, err
+		}
+		crs = append(crs, &cr)
+	}
+	return crs, rows.Err()
+}
+
+// cveRecordQuerySQL returns a query for the rows of table that match q, and
+// its arguments. The table must have the columns of cve_records. The param
+// function returns the placeholder for the nth argument.
+func cveRecordQuerySQL(table string, q CVERecordQuery, param func(n int) string) (string, []interface{}) {
+	var (
+		conds []string
+		args  []interface{}
+	)
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, param(len(args))))
+	}
+	start, end := q.idRange()
+	if start != "" {
+		add("id > %s", start)
+	}
+	if end != "" {
+		add("id < %s", end)
+	}
+	if q.TriageState != "" {
+		add("triage_state = %s", q.TriageState)
+	}
+	if q.Module != "" {
+		add("module = %s", q.Module)
+	}
+	if !q.UpdatedSince.IsZero() {
+		add("commit_time >= %s", q.UpdatedSince.UnixNano())
+	}
+	query := "SELECT data FROM " + table
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	query += " ORDER BY id"
+	if q.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", q.Limit+1)
+	}
+	return query, args
+}
+
+// queryGHSARecords runs a query whose only result column is the data of a
+// GHSARecord, and returns the records.
+func queryGHSARecords(ctx context.Context, db querier, query string, args ...interface{}) ([]*GHSARecord, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var grs []*GHSARecord
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var gr GHSARecord
+		if err := json.Unmarshal(data, &gr); err != nil {
+			return nil, err
+		}
+		grs = append(grs, &gr)
+	}
+	return grs, rows.Err()
+}
+
+// queryTriageHistory runs a query whose only result column is the data of a
+// TriageHistoryEntry, and returns the entries.
+func queryTriageHistory(ctx context.Context, db querier, query string, args ...interface{}) ([]*TriageHistoryEntry, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var es []*TriageHistoryEntry
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var e TriageHistoryEntry
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		es = append(es, &e)
+	}
+	return es, rows.Err()
+}
+
+// sqlAliases returns a function for connectedAliases that runs query, whose
+// only argument is an ID and whose only result column is an alias of it.
+func sqlAliases(ctx context.Context, db querier, query string) func(string) ([]string, error) {
+	return func(id string) ([]string, error) {
+		return queryStrings(ctx, db, query, id)
+	}
+}
+
+// searchQuerySQL returns a query for the IDs of the search documents in table
+// that have all of terms, which must be distinct, and its arguments. The
+// table must have the columns of search_terms. The param function returns
+// the placeholder for the nth argument.
+func searchQuerySQL(table string, terms []string, limit int, param func(n int) string) (string, []interface{}) {
+	var (
+		ps   []string
+		args []interface{}
+	)
+	for _, t := range terms {
+		args = append(args, t)
+		ps = append(ps, param(len(args)))
+	}
+	query := fmt.Sprintf(`SELECT id FROM %s WHERE term IN (%s) GROUP BY id HAVING COUNT(*) = %d ORDER BY id`,
+		table, strings.Join(ps, ", "), len(terms))
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	return query, args
+}
+
+// replaceDepsDevDiscrepancies replaces the rows of table, which must have the
+// columns of depsdev_discrepancies, with ds, in one transaction of db. The
+// param function returns the placeholder for the nth argument.
+func replaceDepsDevDiscrepancies(ctx context.Context, db *sql.DB, table string, ds []*DepsDevDiscrepancy, param func(n int) string) error {
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s`, table)); err != nil {
+		return err
+	}
+	insert := fmt.Sprintf(`INSERT INTO %s (module, advisory_id, data) VALUES (%s, %s, %s)`,
+		table, param(1), param(2), param(3))
+	for _, d := range ds {
+		data, err := marshalJSON(d)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, insert, d.Module, d.AdvisoryID, data); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// querySourceStatuses runs a query whose only result column is the data of a
+// SourceStatus, and returns the statuses.
+func querySourceStatuses(ctx context.Context, db querier, query string) ([]*SourceStatus, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ss []*SourceStatus
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var s SourceStatus
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, err
+		}
+		ss = append(ss, &s)
+	}
+	return ss, rows.Err()
+}
+
+// queryQuarantinedFiles runs a query whose only result column is the data of
+// a QuarantinedFile, and returns the files.
+func queryQuarantinedFiles(ctx context.Context, db querier, query string) ([]*QuarantinedFile, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var qs []*QuarantinedFile
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var q QuarantinedFile
+		if err := json.Unmarshal(data, &q); err != nil {
+			return nil, err
+		}
+		qs = append(qs, &q)
+	}
+	return qs, rows.Err()
+}
+
+// querySecurityReleases runs a query whose only result column is the data of
+// a SecurityRelease, and returns the releases.
+func querySecurityReleases(ctx context.Context, db querier, query string) ([]*SecurityRelease, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var rs []*SecurityRelease
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var r SecurityRelease
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, err
+		}
+		rs = append(rs, &r)
+	}
+	return rs, rows.Err()
+}
+
+// queryUnfixedModules runs a query whose only result column is the data of
+// an UnfixedModule, and returns the modules.
+func queryUnfixedModules(ctx context.Context, db querier, query string) ([]*UnfixedModule, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var us []*UnfixedModule
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var u UnfixedModule
+		if err := json.Unmarshal(data, &u); err != nil {
+			return nil, err
+		}
+		us = append(us, &u)
+	}
+	return us, rows.Err()
+}
+
+// queryReportReviews runs a query whose only result column is the data of a
+// ReportReview, and returns the reviews.
+func queryReportReviews(ctx context.Context, db querier, query string, args ...interface{}) ([]*ReportReview, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var rs []*ReportReview
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var r ReportReview
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, err
+		}
+		rs = append(rs, &r)
+	}
+	return rs, rows.Err()
+}
+
+// queryRepoModules runs a query whose only result column is the data of a
+// RepoModules, and returns them.
+func queryRepoModules(ctx context.Context, db querier, query string, args ...interface{}) ([]*RepoModules, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var rs []*RepoModules
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var r RepoModules
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, err
+		}
+		rs = append(rs, &r)
+	}
+	return rs, rows.Err()
+}
+
+// queryDepsDevDiscrepancies runs a query whose only result column is the data
+// of a DepsDevDiscrepancy, and returns the discrepancies.
+func queryDepsDevDiscrepancies(ctx context.Context, db querier, query string) ([]*DepsDevDiscrepancy, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ds []*DepsDevDiscrepancy
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var d DepsDevDiscrepancy
+		if err := json.Unmarshal(data, &d); err != nil {
+			return nil, err
+		}
+		ds = append(ds, &d)
+	}
+	return ds, rows.Err()
+}
+
+// queryStrings runs a query whose only result column is a string, and
+// returns the strings.
+func queryStrings(ctx context.Context, db querier, query string, args ...interface{}) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ss []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		ss = append(ss, s)
+	}
+	return ss, rows.Err()
+}
+
+// checkStoredVersion runs query, whose only argument is the ID of r and whose
+// only result column is the data of the stored CVERecord with that ID, and
+// returns the error of checkVersion for r and the stored record.
+func checkStoredVersion(ctx context.Context, db querier, query string, r *CVERecord) error {
+	crs, err := queryCVERecords(ctx, db, query, r.ID)
+	if err != nil {
+		return err
+	}
+	if len(crs) == 0 {
+		return checkVersion(r, 0, false)
+	}
+	return checkVersion(r, crs[0].Version, true)
+}
+
+// checkRowsAffected returns an error with the given message if res
+// reports that no rows were affected.
+func checkRowsAffected(res sql.Result, msg string) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// marshalJSON returns the JSON encoding of v as a string.
+// Query arguments for JSON columns must be strings, because lib/pq sends
+// byte slices as bytea, and SQLite would store them as blobs.
+func marshalJSON(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
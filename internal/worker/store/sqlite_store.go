@@ -0,0 +1,1012 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/vulndb/internal/derrors"
+)
+
+// SQLiteStore is a Store implemented with a SQLite database file.
+// It is intended for running the worker locally: unlike MemStore, its data
+// persists across runs, and unlike FireStore, it needs no cloud project or
+// credentials.
+//
+// The layout is the same as PGStore's, without namespaces: one table per kind
+// of record, with each record stored as JSON in the data column. Times used
+// for ordering or lookup are stored as Unix nanoseconds.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore creates a SQLiteStore backed by the database in filename,
+// creating the file and its tables if they do not exist.
+func NewSQLiteStore(ctx context.Context, filename string) (_ *SQLiteStore, err error) {
+	defer derrors.Wrap(&err, "NewSQLiteStore(%q)", filename)
+
+	// Start transactions with BEGIN IMMEDIATE so that they take the write
+	// lock up front. Otherwise two transactions that read, then write, can
+	// deadlock. Use WAL mode so reads can proceed during a transaction.
+	params := url.Values{
+		"_txlock":       {"immediate"},
+		"_journal_mode": {"WAL"},
+		"_busy_timeout": {"10000"},
+	}
+	db, err := sql.Open("sqlite3", "file:"+filename+"?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.ExecContext(ctx, sqliteSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	ss := &SQLiteStore{db: db}
+	if err := ss.migrate(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return ss, nil
+}
+
+// Close closes the database.
+func (ss *SQLiteStore) Close() error {
+	return ss.db.Close()
+}
+
+const sqliteSchema = `
+	CREATE TABLE IF NOT EXISTS commit_updates (
+		id         INTEGER PRIMARY KEY AUTOINCREMENT,
+		started_at INTEGER NOT NULL,
+		data       TEXT NOT NULL
+	);
+	CREATE INDEX IF NOT EXISTS commit_updates_started_at ON commit_updates (started_at);
+
+	CREATE TABLE IF NOT EXISTS cve_records (
+		id           TEXT PRIMARY KEY,
+		triage_state TEXT NOT NULL,
+		data         TEXT NOT NULL
+	);
+	CREATE INDEX IF NOT EXISTS cve_records_triage_state ON cve_records (triage_state, id);
+
+	CREATE TABLE IF NOT EXISTS dir_hashes (
+		dir  TEXT PRIMARY KEY,
+		hash TEXT NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS ghsa_records (
+		id   TEXT PRIMARY KEY,
+		data TEXT NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS module_scans (
+		id          INTEGER PRIMARY KEY AUTOINCREMENT,
+		path        TEXT NOT NULL,
+		version     TEXT NOT NULL,
+		db_time     INTEGER NOT NULL,
+		finished_at INTEGER NOT NULL,
+		data        TEXT NOT NULL
+	);
+	CREATE INDEX IF NOT EXISTS module_scans_key ON module_scans (path, version, db_time);
+	CREATE INDEX IF NOT EXISTS module_scans_finished_at ON module_scans (finished_at);
+
+	CREATE TABLE IF NOT EXISTS fetch_cursors (
+		source TEXT PRIMARY KEY,
+		time   INTEGER NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS triage_history (
+		id        INTEGER PRIMARY KEY AUTOINCREMENT,
+		record_id TEXT NOT NULL,
+		data      TEXT NOT NULL
+	);
+	CREATE INDEX IF NOT EXISTS triage_history_record_id ON triage_history (record_id, id);
+
+	CREATE TABLE IF NOT EXISTS locks (
+		name    TEXT PRIMARY KEY,
+		owner   TEXT NOT NULL,
+		expires INTEGER NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS aliases (
+		id    TEXT NOT NULL,
+		alias TEXT NOT NULL,
+		PRIMARY KEY (id, alias)
+	);
+
+	CREATE TABLE IF NOT EXISTS search_terms (
+		id   TEXT NOT NULL,
+		term TEXT NOT NULL,
+		PRIMARY KEY (term, id)
+	);
+
+	CREATE TABLE IF NOT EXISTS depsdev_discrepancies (
+		module      TEXT NOT NULL,
+		advisory_id TEXT NOT NULL,
+		data        TEXT NOT NULL,
+		PRIMARY KEY (module, advisory_id)
+	);
+	CREATE INDEX IF NOT EXISTS search_terms_id ON search_terms (id);
+
+	CREATE TABLE IF NOT EXISTS source_status (
+		source TEXT PRIMARY KEY,
+		data   TEXT NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS quarantined_files (
+		path TEXT PRIMARY KEY,
+		data TEXT NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS security_releases (
+		release TEXT PRIMARY KEY,
+		data    TEXT NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS unfixed_modules (
+		report_id TEXT NOT NULL,
+		module    TEXT NOT NULL,
+		data      TEXT NOT NULL,
+		PRIMARY KEY (report_id, module)
+	);
+
+	CREATE TABLE IF NOT EXISTS report_reviews (
+		report_id TEXT PRIMARY KEY,
+		data      TEXT NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS repo_modules (
+		repo TEXT PRIMARY KEY,
+		data TEXT NOT NULL
+	);
+`
+
+// sqliteMigrations are changes to sqliteSchema, in order. A database records
+// how many have been applied to it in its user_version. Databases created
+// before a migration need it to bring their tables up to date; for new
+// databases, it finishes creating them.
+var sqliteMigrations = []func(context.Context, *sql.Tx) error{
+	// 1: CVE record columns for ListCVERecords.
+	func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+			ALTER TABLE cve_records ADD COLUMN module TEXT NOT NULL DEFAULT '';
+			ALTER TABLE cve_records ADD COLUMN commit_time INTEGER NOT NULL DEFAULT 0;
+			CREATE INDEX cve_records_module ON cve_records (module, id);
+			CREATE INDEX cve_records_commit_time ON cve_records (commit_time);`); err != nil {
+			return err
+		}
+		crs, err := queryCVERecords(ctx, tx, `SELECT data FROM cve_records`)
+		if err != nil {
+			return err
+		}
+		for _, cr := range crs {
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE cve_records SET module = ?, commit_time = ? WHERE id = ?`,
+				cr.Module, cr.CommitTime.UnixNano(), cr.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+// migrate applies the migrations that have not been applied to the database.
+func (ss *SQLiteStore) migrate(ctx context.Context) (err error) {
+	defer derrors.Wrap(&err, "migrate")
+
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var version int
+	if err := tx.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version); err != nil {
+		return err
+	}
+	if version > len(sqliteMigrations) {
+		return fmt.Errorf("schema version %d is newer than the latest known version %d", version, len(sqliteMigrations))
+	}
+	for v := version + 1; v <= len(sqliteMigrations); v++ {
+		if err := sqliteMigrations[v-1](ctx, tx); err != nil {
+			return fmt.Errorf("migration %d: %w", v, err)
+		}
+	}
+	// PRAGMA statements can't have parameters.
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`PRAGMA user_version = %d`, len(sqliteMigrations))); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CreateCommitUpdateRecord implements Store.CreateCommitUpdateRecord.
+// On successful return, r.ID is set to the record's ID.
+func (ss *SQLiteStore) CreateCommitUpdateRecord(ctx context.Context, r *CommitUpdateRecord) (err error) {
+	defer derrors.Wrap(&err, "CreateCommitUpdateRecord()")
+
+	r.UpdatedAt = time.Now()
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	res, err := ss.db.ExecContext(ctx,
+		`INSERT INTO commit_updates (started_at, data) VALUES (?, ?)`,
+		r.StartedAt.UnixNano(), data)
+	if err != nil {
+		return err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	r.ID = fmt.Sprint(id)
+	return nil
+}
+
+// SetCommitUpdateRecord implements Store.SetCommitUpdateRecord.
+func (ss *SQLiteStore) SetCommitUpdateRecord(ctx context.Context, r *CommitUpdateRecord) (err error) {
+	defer derrors.Wrap(&err, "SetCommitUpdateRecord(%q)", r.ID)
+
+	if r.ID == "" {
+		return errors.New("missing ID")
+	}
+	r.UpdatedAt = time.Now()
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	res, err := ss.db.ExecContext(ctx,
+		`UPDATE commit_updates SET started_at = ?, data = ? WHERE id = ?`,
+		r.StartedAt.UnixNano(), data, r.ID)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(res, fmt.Sprintf("CommitUpdateRecord with ID %q not found", r.ID))
+}
+
+// ListCommitUpdateRecords implements Store.ListCommitUpdateRecords.
+func (ss *SQLiteStore) ListCommitUpdateRecords(ctx context.Context, limit int) (_ []*CommitUpdateRecord, err error) {
+	defer derrors.Wrap(&err, "ListCommitUpdateRecords(%d)", limit)
+
+	q := `SELECT id, data FROM commit_updates ORDER BY started_at DESC`
+	if limit > 0 {
+		q += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	rows, err := ss.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var urs []*CommitUpdateRecord
+	for rows.Next() {
+		var (
+			id   int64
+			data []byte
+		)
+		if err := rows.Scan(&id, &data); err != nil {
+			return nil, err
+		}
+		var ur CommitUpdateRecord
+		if err := json.Unmarshal(data, &ur); err != nil {
+			return nil, err
+		}
+		ur.ID = fmt.Sprint(id)
+		urs = append(urs, &ur)
+	}
+	return urs, rows.Err()
+}
+
+// GetCVERecord implements Store.GetCVERecord.
+func (ss *SQLiteStore) GetCVERecord(ctx context.Context, id string) (_ *CVERecord, err error) {
+	defer derrors.Wrap(&err, "GetCVERecord(%q)", id)
+
+	var data []byte
+	err = ss.db.QueryRowContext(ctx, `SELECT data FROM cve_records WHERE id = ?`, id).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cr CVERecord
+	if err := json.Unmarshal(data, &cr); err != nil {
+		return nil, err
+	}
+	return &cr, nil
+}
+
+// ListCVERecordsWithTriageState implements Store.ListCVERecordsWithTriageState.
+func (ss *SQLiteStore) ListCVERecordsWithTriageState(ctx context.Context, ts TriageState) (_ []*CVERecord, err error) {
+	defer derrors.Wrap(&err, "ListCVERecordsWithTriageState(%s)", ts)
+
+	return queryCVERecords(ctx, ss.db,
+		`SELECT data FROM cve_records WHERE triage_state = ? ORDER BY id`, ts)
+}
+
+// ListCVERecords implements Store.ListCVERecords.
+func (ss *SQLiteStore) ListCVERecords(ctx context.Context, q CVERecordQuery) (_ []*CVERecord, _ string, err error) {
+	defer derrors.Wrap(&err, "ListCVERecords(%+v)", q)
+
+	query, args := cveRecordQuerySQL("cve_records", q, func(int) string { return "?" })
+	crs, err := queryCVERecords(ctx, ss.db, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	crs, next := pageCVERecords(crs, q.Limit)
+	return crs, next, nil
+}
+
+// GetDirectoryHash implements Store.GetDirectoryHash.
+func (ss *SQLiteStore) GetDirectoryHash(ctx context.Context, dir string) (_ string, err error) {
+	defer derrors.Wrap(&err, "GetDirectoryHash(%s)", dir)
+
+	var hash string
+	err = ss.db.QueryRowContext(ctx, `SELECT hash FROM dir_hashes WHERE dir = ?`, dir).Scan(&hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// SetDirectoryHash implements Store.SetDirectoryHash.
+func (ss *SQLiteStore) SetDirectoryHash(ctx context.Context, dir, hash string) (err error) {
+	defer derrors.Wrap(&err, "SetDirectoryHash(%s)", dir)
+
+	_, err = ss.db.ExecContext(ctx, `
+		INSERT INTO dir_hashes (dir, hash) VALUES (?, ?)
+		ON CONFLICT (dir) DO UPDATE SET hash = excluded.hash`, dir, hash)
+	return err
+}
+
+// GetFetchCursor implements Store.GetFetchCursor.
+func (ss *SQLiteStore) GetFetchCursor(ctx context.Context, source string) (_ time.Time, err error) {
+	defer derrors.Wrap(&err, "GetFetchCursor(%s)", source)
+
+	var nanos int64
+	err = ss.db.QueryRowContext(ctx, `SELECT time FROM fetch_cursors WHERE source = ?`, source).Scan(&nanos)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, nanos).UTC(), nil
+}
+
+// SetFetchCursor implements Store.SetFetchCursor.
+func (ss *SQLiteStore) SetFetchCursor(ctx context.Context, source string, t time.Time) (err error) {
+	defer derrors.Wrap(&err, "SetFetchCursor(%s)", source)
+
+	_, err = ss.db.ExecContext(ctx, `
+		INSERT INTO fetch_cursors (source, time) VALUES (?, ?)
+		ON CONFLICT (source) DO UPDATE SET time = excluded.time`, source, t.UnixNano())
+	return err
+}
+
+// AcquireLock implements Store.AcquireLock.
+func (ss *SQLiteStore) AcquireLock(ctx context.Context, name, owner string, ttl time.Duration) (_ bool, err error) {
+	defer derrors.Wrap(&err, "AcquireLock(%s, %s)", name, owner)
+
+	now := time.Now()
+	res, err := ss.db.ExecContext(ctx, `
+		INSERT INTO locks (name, owner, expires) VALUES (?, ?, ?)
+		ON CONFLICT (name) DO UPDATE SET owner = excluded.owner, expires = excluded.expires
+		WHERE locks.owner = excluded.owner OR locks.expires <= ?`,
+		name, owner, now.Add(ttl).UnixNano(), now.UnixNano())
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// RenewLock implements Store.RenewLock.
+func (ss *SQLiteStore) RenewLock(ctx context.Context, name, owner string, ttl time.Duration) (_ bool, err error) {
+	defer derrors.Wrap(&err, "RenewLock(%s, %s)", name, owner)
+
+	now := time.Now()
+	res, err := ss.db.ExecContext(ctx,
+		`UPDATE locks SET expires = ? WHERE name = ? AND owner = ? AND expires > ?`,
+		now.Add(ttl).UnixNano(), name, owner, now.UnixNano())
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ReleaseLock implements Store.ReleaseLock.
+func (ss *SQLiteStore) ReleaseLock(ctx context.Context, name, owner string) (err error) {
+	defer derrors.Wrap(&err, "ReleaseLock(%s, %s)", name, owner)
+
+	_, err = ss.db.ExecContext(ctx, `DELETE FROM locks WHERE name = ? AND owner = ?`, name, owner)
+	return err
+}
+
+// CreateModuleScanRecord implements Store.CreateModuleScanRecord.
+func (ss *SQLiteStore) CreateModuleScanRecord(ctx context.Context, r *ModuleScanRecord) (err error) {
+	defer derrors.Wrap(&err, "CreateModuleScanRecord(%s@%s)", r.Path, r.Version)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	_, err = ss.db.ExecContext(ctx, `
+		INSERT INTO module_scans (path, version, db_time, finished_at, data)
+		VALUES (?, ?, ?, ?, ?)`,
+		r.Path, r.Version, r.DBTime.UnixNano(), r.FinishedAt.UnixNano(), data)
+	return err
+}
+
+// GetModuleScanRecord implements Store.GetModuleScanRecord.
+func (ss *SQLiteStore) GetModuleScanRecord(ctx context.Context, path, version string, dbTime time.Time) (_ *ModuleScanRecord, err error) {
+	defer derrors.Wrap(&err, "GetModuleScanRecord(%s@%s)", path, version)
+
+	// There may be several, but we only need one; take the most recent.
+	var data []byte
+	err = ss.db.QueryRowContext(ctx, `
+		SELECT data FROM module_scans
+		WHERE path = ? AND version = ? AND db_time = ?
+		ORDER BY finished_at DESC
+		LIMIT 1`, path, version, dbTime.UnixNano()).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var r ModuleScanRecord
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ListModuleScanRecords implements Store.ListModuleScanRecords.
+func (ss *SQLiteStore) ListModuleScanRecords(ctx context.Context, limit int) (_ []*ModuleScanRecord, err error) {
+	defer derrors.Wrap(&err, "ListModuleScanRecords(%d)", limit)
+
+	q := `SELECT data FROM module_scans ORDER BY finished_at DESC`
+	if limit > 0 {
+		q += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	rows, err := ss.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var rs []*ModuleScanRecord
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var r ModuleScanRecord
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, err
+		}
+		rs = append(rs, &r)
+	}
+	return rs, rows.Err()
+}
+
+// SetDepsDevDiscrepancies implements Store.SetDepsDevDiscrepancies.
+func (ss *SQLiteStore) SetDepsDevDiscrepancies(ctx context.Context, ds []*DepsDevDiscrepancy) (err error) {
+	defer derrors.Wrap(&err, "SetDepsDevDiscrepancies")
+
+	return replaceDepsDevDiscrepancies(ctx, ss.db, "depsdev_discrepancies", ds, func(int) string { return "?" })
+}
+
+// SetSourceStatus implements Store.SetSourceStatus.
+func (ss *SQLiteStore) SetSourceStatus(ctx context.Context, s *SourceStatus) (err error) {
+	defer derrors.Wrap(&err, "SetSourceStatus(%s)", s.Source)
+
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(s)
+	if err != nil {
+		return err
+	}
+	_, err = ss.db.ExecContext(ctx, `
+		INSERT INTO source_status (source, data) VALUES (?, ?)
+		ON CONFLICT (source) DO UPDATE SET data = excluded.data`, s.Source, data)
+	return err
+}
+
+// ListSourceStatuses implements Store.ListSourceStatuses.
+func (ss *SQLiteStore) ListSourceStatuses(ctx context.Context) (_ []*SourceStatus, err error) {
+	defer derrors.Wrap(&err, "ListSourceStatuses")
+
+	return querySourceStatuses(ctx, ss.db, `SELECT data FROM source_status ORDER BY source`)
+}
+
+// SetQuarantinedFile implements Store.SetQuarantinedFile.
+func (ss *SQLiteStore) SetQuarantinedFile(ctx context.Context, q *QuarantinedFile) (err error) {
+	defer derrors.Wrap(&err, "SetQuarantinedFile(%s)", q.Path)
+
+	if err := q.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(q)
+	if err != nil {
+		return err
+	}
+	_, err = ss.db.ExecContext(ctx, `
+		INSERT INTO quarantined_files (path, data) VALUES (?, ?)
+		ON CONFLICT (path) DO UPDATE SET data = excluded.data`, q.Path, data)
+	return err
+}
+
+// DeleteQuarantinedFile implements Store.DeleteQuarantinedFile.
+func (ss *SQLiteStore) DeleteQuarantinedFile(ctx context.Context, path string) (err error) {
+	defer derrors.Wrap(&err, "DeleteQuarantinedFile(%s)", path)
+
+	_, err = ss.db.ExecContext(ctx, `DELETE FROM quarantined_files WHERE path = ?`, path)
+	return err
+}
+
+// ListQuarantinedFiles implements Store.ListQuarantinedFiles.
+func (ss *SQLiteStore) ListQuarantinedFiles(ctx context.Context) (_ []*QuarantinedFile, err error) {
+	defer derrors.Wrap(&err, "ListQuarantinedFiles")
+
+	return queryQuarantinedFiles(ctx, ss.db, `SELECT data FROM quarantined_files ORDER BY path`)
+}
+
+// SetSecurityRelease implements Store.SetSecurityRelease.
+func (ss *SQLiteStore) SetSecurityRelease(ctx context.Context, r *SecurityRelease) (err error) {
+	defer derrors.Wrap(&err, "SetSecurityRelease(%s)", r.Release)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	_, err = ss.db.ExecContext(ctx, `
+		INSERT INTO security_releases (release, data) VALUES (?, ?)
+		ON CONFLICT (release) DO UPDATE SET data = excluded.data`, r.Release, data)
+	return err
+}
+
+// ListSecurityReleases implements Store.ListSecurityReleases.
+func (ss *SQLiteStore) ListSecurityReleases(ctx context.Context) (_ []*SecurityRelease, err error) {
+	defer derrors.Wrap(&err, "ListSecurityReleases")
+
+	return querySecurityReleases(ctx, ss.db, `SELECT data FROM security_releases ORDER BY release`)
+}
+
+// SetUnfixedModule implements Store.SetUnfixedModule.
+func (ss *SQLiteStore) SetUnfixedModule(ctx context.Context, u *UnfixedModule) (err error) {
+	defer derrors.Wrap(&err, "SetUnfixedModule(%s, %s)", u.ReportID, u.Module)
+
+	if err := u.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(u)
+	if err != nil {
+		return err
+	}
+	_, err = ss.db.ExecContext(ctx, `
+		INSERT INTO unfixed_modules (report_id, module, data) VALUES (?, ?, ?)
+		ON CONFLICT (report_id, module) DO UPDATE SET data = excluded.data`, u.ReportID, u.Module, data)
+	return err
+}
+
+// DeleteUnfixedModule implements Store.DeleteUnfixedModule.
+func (ss *SQLiteStore) DeleteUnfixedModule(ctx context.Context, reportID, module string) (err error) {
+	defer derrors.Wrap(&err, "DeleteUnfixedModule(%s, %s)", reportID, module)
+
+	_, err = ss.db.ExecContext(ctx, `DELETE FROM unfixed_modules WHERE report_id = ? AND module = ?`, reportID, module)
+	return err
+}
+
+// ListUnfixedModules implements Store.ListUnfixedModules.
+func (ss *SQLiteStore) ListUnfixedModules(ctx context.Context) (_ []*UnfixedModule, err error) {
+	defer derrors.Wrap(&err, "ListUnfixedModules")
+
+	return queryUnfixedModules(ctx, ss.db, `SELECT data FROM unfixed_modules ORDER BY report_id, module`)
+}
+
+// SetReportReview implements Store.SetReportReview.
+func (ss *SQLiteStore) SetReportReview(ctx context.Context, r *ReportReview) (err error) {
+	defer derrors.Wrap(&err, "SetReportReview(%s)", r.ReportID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	_, err = ss.db.ExecContext(ctx, sqliteSetReviewQuery, r.ReportID, data)
+	return err
+}
+
+const sqliteSetReviewQuery = `
+	INSERT INTO report_reviews (report_id, data) VALUES (?, ?)
+	ON CONFLICT (report_id) DO UPDATE SET data = excluded.data`
+
+// GetReportReview implements Store.GetReportReview.
+func (ss *SQLiteStore) GetReportReview(ctx context.Context, reportID string) (_ *ReportReview, err error) {
+	defer derrors.Wrap(&err, "GetReportReview(%s)", reportID)
+
+	rs, err := queryReportReviews(ctx, ss.db, sqliteGetReviewQuery, reportID)
+	if err != nil || len(rs) == 0 {
+		return nil, err
+	}
+	return rs[0], nil
+}
+
+const sqliteGetReviewQuery = `SELECT data FROM report_reviews WHERE report_id = ?`
+
+// ListReportReviews implements Store.ListReportReviews.
+func (ss *SQLiteStore) ListReportReviews(ctx context.Context) (_ []*ReportReview, err error) {
+	defer derrors.Wrap(&err, "ListReportReviews")
+
+	return queryReportReviews(ctx, ss.db, `SELECT data FROM report_reviews ORDER BY report_id`)
+}
+
+// SetRepoModules implements Store.SetRepoModules.
+func (ss *SQLiteStore) SetRepoModules(ctx context.Context, r *RepoModules) (err error) {
+	defer derrors.Wrap(&err, "SetRepoModules(%s)", r.Repo)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	_, err = ss.db.ExecContext(ctx, `
+		INSERT INTO repo_modules (repo, data) VALUES (?, ?)
+		ON CONFLICT (repo) DO UPDATE SET data = excluded.data`, r.Repo, data)
+	return err
+}
+
+// GetRepoModules implements Store.GetRepoModules.
+func (ss *SQLiteStore) GetRepoModules(ctx context.Context, repo string) (_ *RepoModules, err error) {
+	defer derrors.Wrap(&err, "GetRepoModules(%s)", repo)
+
+	rs, err := queryRepoModules(ctx, ss.db, `SELECT data FROM repo_modules WHERE repo = ?`, repo)
+	if err != nil || len(rs) == 0 {
+		return nil, err
+	}
+	return rs[0], nil
+}
+
+// ListDepsDevDiscrepancies implements Store.ListDepsDevDiscrepancies.
+func (ss *SQLiteStore) ListDepsDevDiscrepancies(ctx context.Context) (_ []*DepsDevDiscrepancy, err error) {
+	defer derrors.Wrap(&err, "ListDepsDevDiscrepancies")
+
+	return queryDepsDevDiscrepancies(ctx, ss.db,
+		`SELECT data FROM depsdev_discrepancies ORDER BY module, advisory_id`)
+}
+
+// ListTriageHistory implements Store.ListTriageHistory.
+func (ss *SQLiteStore) ListTriageHistory(ctx context.Context, id string) (_ []*TriageHistoryEntry, err error) {
+	defer derrors.Wrap(&err, "ListTriageHistory(%s)", id)
+
+	return queryTriageHistory(ctx, ss.db,
+		`SELECT data FROM triage_history WHERE record_id = ? ORDER BY id`, id)
+}
+
+// GetAliases implements Store.GetAliases.
+func (ss *SQLiteStore) GetAliases(ctx context.Context, id string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "GetAliases(%s)", id)
+
+	return connectedAliases(id, sqlAliases(ctx, ss.db, sqliteAliasQuery))
+}
+
+const sqliteAliasQuery = `SELECT alias FROM aliases WHERE id = ?`
+
+// Search implements Store.Search.
+func (ss *SQLiteStore) Search(ctx context.Context, terms []string, limit int) (_ []string, err error) {
+	defer derrors.Wrap(&err, "Search(%v)", terms)
+
+	terms = uniqueTerms(terms)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	q, args := searchQuerySQL("search_terms", terms, limit, func(int) string { return "?" })
+	return queryStrings(ctx, ss.db, q, args...)
+}
+
+// RunTransaction implements Store.RunTransaction.
+// SQLite allows only one writer at a time, so transactions never conflict
+// and f is called exactly once.
+func (ss *SQLiteStore) RunTransaction(ctx context.Context, f func(context.Context, Transaction) error) error {
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	tracker := newTriageTracker(ctx)
+	if err := f(ctx, &sqliteTransaction{ctx, tx, tracker}); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	tracker.committed(ctx)
+	return nil
+}
+
+// Clear removes all records from the database.
+func (ss *SQLiteStore) Clear(ctx context.Context) (err error) {
+	defer derrors.Wrap(&err, "Clear")
+
+	_, err = ss.db.ExecContext(ctx, `
+		DELETE FROM commit_updates;
+		DELETE FROM cve_records;
+		DELETE FROM dir_hashes;
+		DELETE FROM ghsa_records;
+		DELETE FROM module_scans;
+		DELETE FROM fetch_cursors;
+		DELETE FROM triage_history;
+		DELETE FROM locks;
+		DELETE FROM aliases;
+		DELETE FROM search_terms;
+		DELETE FROM depsdev_discrepancies;
+		DELETE FROM source_status;
+		DELETE FROM quarantined_files;
+		DELETE FROM security_releases;
+		DELETE FROM unfixed_modules;
+		DELETE FROM report_reviews;
+		DELETE FROM repo_modules;`)
+	return err
+}
+
+// sqliteTransaction implements Transaction.
+type sqliteTransaction struct {
+	ctx     context.Context
+	tx      *sql.Tx
+	tracker *triageTracker
+}
+
+// addTriageHistory adds a TriageHistoryEntry for the record with the given
+// ID, if its triage state has changed.
+func (tx *sqliteTransaction) addTriageHistory(id string, ts TriageState, reason string) error {
+	e := tx.tracker.write(id, ts, reason)
+	if e == nil {
+		return nil
+	}
+	return tx.insertTriageHistory(e)
+}
+
+func (tx *sqliteTransaction) insertTriageHistory(e *TriageHistoryEntry) error {
+	data, err := marshalJSON(e)
+	if err != nil {
+		return err
+	}
+	_, err = tx.tx.ExecContext(tx.ctx,
+		`INSERT INTO triage_history (record_id, data) VALUES (?, ?)`, e.ID, data)
+	return err
+}
+
+// CreateCVERecord implements Transaction.CreateCVERecord.
+func (tx *sqliteTransaction) CreateCVERecord(r *CVERecord) (err error) {
+	defer derrors.Wrap(&err, "SQLiteStore.CreateCVERecord(%s)", r.ID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if r.Version == 0 {
+		r.Version = 1
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.tx.ExecContext(tx.ctx,
+		`INSERT INTO cve_records (id, triage_state, module, commit_time, data) VALUES (?, ?, ?, ?, ?)`,
+		r.ID, r.TriageState, r.Module, r.CommitTime.UnixNano(), data); err != nil {
+		return err
+	}
+	return tx.addTriageHistory(r.ID, r.TriageState, r.TriageStateReason)
+}
+
+// SetCVERecord implements Transaction.SetCVERecord.
+func (tx *sqliteTransaction) SetCVERecord(r *CVERecord) (err error) {
+	defer derrors.Wrap(&err, "SetCVERecord(%s)", r.ID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if err := checkStoredVersion(tx.ctx, tx.tx, `SELECT data FROM cve_records WHERE id = ?`, r); err != nil {
+		return err
+	}
+	c := *r
+	c.Version++
+	data, err := marshalJSON(&c)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.tx.ExecContext(tx.ctx,
+		`UPDATE cve_records SET triage_state = ?, module = ?, commit_time = ?, data = ? WHERE id = ?`,
+		r.TriageState, r.Module, r.CommitTime.UnixNano(), data, r.ID); err != nil {
+		return err
+	}
+	r.Version = c.Version
+	return tx.addTriageHistory(r.ID, r.TriageState, r.TriageStateReason)
+}
+
+// GetCVERecords implements Transaction.GetCVERecords.
+func (tx *sqliteTransaction) GetCVERecords(startID, endID string) (_ []*CVERecord, err error) {
+	defer derrors.Wrap(&err, "GetCVERecords(%s, %s)", startID, endID)
+
+	crs, err := queryCVERecords(tx.ctx, tx.tx,
+		`SELECT data FROM cve_records WHERE id >= ? AND id <= ? ORDER BY id`, startID, endID)
+	if err != nil {
+		return nil, err
+	}
+	for _, cr := range crs {
+		tx.tracker.read(cr.ID, cr.TriageState)
+	}
+	return crs, nil
+}
+
+// CreateGHSARecord implements Transaction.CreateGHSARecord.
+func (tx *sqliteTransaction) CreateGHSARecord(r *GHSARecord) (err error) {
+	defer derrors.Wrap(&err, "SQLiteStore.CreateGHSARecord(%s)", r.GHSA.ID)
+
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.tx.ExecContext(tx.ctx, `INSERT INTO ghsa_records (id, data) VALUES (?, ?)`, r.GHSA.ID, data); err != nil {
+		return err
+	}
+	return tx.addTriageHistory(r.GHSA.ID, r.TriageState, r.TriageStateReason)
+}
+
+// SetGHSARecord implements Transaction.SetGHSARecord.
+func (tx *sqliteTransaction) SetGHSARecord(r *GHSARecord) (err error) {
+	defer derrors.Wrap(&err, "SetGHSARecord(%s)", r.GHSA.ID)
+
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	res, err := tx.tx.ExecContext(tx.ctx, `UPDATE ghsa_records SET data = ? WHERE id = ?`, data, r.GHSA.ID)
+	if err != nil {
+		return err
+	}
+	if err := checkRowsAffected(res, fmt.Sprintf("GHSARecord %s does not exist", r.GHSA.ID)); err != nil {
+		return err
+	}
+	return tx.addTriageHistory(r.GHSA.ID, r.TriageState, r.TriageStateReason)
+}
+
+// GetGHSARecord implements Transaction.GetGHSARecord.
+func (tx *sqliteTransaction) GetGHSARecord(id string) (_ *GHSARecord, err error) {
+	defer derrors.Wrap(&err, "GetGHSARecord(%s)", id)
+
+	var data []byte
+	err = tx.tx.QueryRowContext(tx.ctx, `SELECT data FROM ghsa_records WHERE id = ?`, id).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var gr GHSARecord
+	if err := json.Unmarshal(data, &gr); err != nil {
+		return nil, err
+	}
+	tx.tracker.read(id, gr.TriageState)
+	return &gr, nil
+}
+
+// GetGHSARecords implements Transaction.GetGHSARecords.
+func (tx *sqliteTransaction) GetGHSARecords() (_ []*GHSARecord, err error) {
+	defer derrors.Wrap(&err, "GetGHSARecords()")
+
+	grs, err := queryGHSARecords(tx.ctx, tx.tx, `SELECT data FROM ghsa_records ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	for _, gr := range grs {
+		tx.tracker.read(gr.GHSA.ID, gr.TriageState)
+	}
+	return grs, nil
+}
+
+// AddAliases implements Transaction.AddAliases.
+func (tx *sqliteTransaction) AddAliases(ids ...string) (err error) {
+	defer derrors.Wrap(&err, "AddAliases(%v)", ids)
+
+	for id, others := range aliasEdges(ids) {
+		for _, o := range others {
+			if _, err := tx.tx.ExecContext(tx.ctx,
+				`INSERT OR IGNORE INTO aliases (id, alias) VALUES (?, ?)`, id, o); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetAliases implements Transaction.GetAliases.
+func (tx *sqliteTransaction) GetAliases(id string) (_ []string, err error) {
+	defer derrors.Wrap(&err, "GetAliases(%s)", id)
+
+	return connectedAliases(id, sqlAliases(tx.ctx, tx.tx, sqliteAliasQuery))
+}
+
+// SetSearchTerms implements Transaction.SetSearchTerms.
+func (tx *sqliteTransaction) SetSearchTerms(id string, terms []string) (err error) {
+	defer derrors.Wrap(&err, "SetSearchTerms(%s)", id)
+
+	if _, err := tx.tx.ExecContext(tx.ctx, `DELETE FROM search_terms WHERE id = ?`, id); err != nil {
+		return err
+	}
+	for _, t := range uniqueTerms(terms) {
+		if _, err := tx.tx.ExecContext(tx.ctx,
+			`INSERT INTO search_terms (id, term) VALUES (?, ?)`, id, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetReportReview implements Transaction.SetReportReview.
+func (tx *sqliteTransaction) SetReportReview(r *ReportReview) (err error) {
+	defer derrors.Wrap(&err, "SetReportReview(%s)", r.ReportID)
+
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := marshalJSON(r)
+	if err != nil {
+		return err
+	}
+	_, err = tx.tx.ExecContext(tx.ctx, sqliteSetReviewQuery, r.ReportID, data)
+	return err
+}
+
+// GetReportReview implements Transaction.GetReportReview.
+func (tx *sqliteTransaction) GetReportReview(reportID string) (_ *ReportReview, err error) {
+	defer derrors.Wrap(&err, "GetReportReview(%s)", reportID)
+
+	rs, err := queryReportReviews(tx.ctx, tx.tx, sqliteGetReviewQuery, reportID)
+	if err != nil || len(rs) == 0 {
+		return nil, err
+	}
+	return rs[0], nil
+}
+
+// AddTriageHistory implements Transaction.AddTriageHistory.
+func (tx *sqliteTransaction) AddTriageHistory(es ...*TriageHistoryEntry) (err error) {
+	defer derrors.Wrap(&err, "AddTriageHistory")
+
+	for _, e := range es {
+		if err := tx.insertTriageHistory(e); err != nil {
+			return err
+		}
+		tx.tracker.read(e.ID, e.NewState)
+	}
+	return nil
+}
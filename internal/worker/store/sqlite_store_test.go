@@ -0,0 +1,78 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package store
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteStore(t *testing.T) {
+	ctx := context.Background()
+	filename := filepath.Join(t.TempDir(), "worker.db")
+	ss, err := NewSQLiteStore(ctx, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, ss)
+	want := must1(ss.ListCommitUpdateRecords(ctx, 0))(t)
+	if err := ss.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The data should persist after the store is reopened.
+	ss, err = NewSQLiteStore(ctx, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ss.Close()
+	got := must1(ss.ListCommitUpdateRecords(ctx, 0))(t)
+	diff(t, want, got)
+}
+
+func TestSQLiteMigrations(t *testing.T) {
+	ctx := context.Background()
+	filename := filepath.Join(t.TempDir(), "worker.db")
+	// Create a database with the original schema and a CVE record.
+	db, err := sql.Open("sqlite3", filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := &CVERecord{
+		ID:          "CVE-2022-0001",
+		Path:        "p",
+		BlobHash:    "b",
+		CommitHash:  "c",
+		CommitTime:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		TriageState: TriageStateNeedsIssue,
+		Module:      "example.com/m",
+	}
+	data := must1(marshalJSON(cr))(t)
+	if _, err := db.ExecContext(ctx, sqliteSchema); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecContext(ctx,
+		`INSERT INTO cve_records (id, triage_state, data) VALUES (?, ?, ?)`,
+		cr.ID, cr.TriageState, data); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ss, err := NewSQLiteStore(ctx, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ss.Close()
+	got, _ := must2(ss.ListCVERecords(ctx, CVERecordQuery{Module: cr.Module, UpdatedSince: cr.CommitTime}))(t)
+	diff(t, []*CVERecord{cr}, got)
+}
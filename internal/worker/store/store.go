@@ -9,6 +9,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-git/v5/plumbing/object"
@@ -31,6 +33,9 @@ type CVERecord struct {
 	CommitTime time.Time
 	// CVEState is the value of the metadata.STATE field.
 	CVEState string
+	// Disputed is true if the CVE's description says that its
+	// vulnerability is disputed.
+	Disputed bool
 	// TriageState is the state of our triage processing on the CVE.
 	TriageState TriageState
 	// TriageStateReason is an explanation of TriageState.
@@ -42,6 +47,43 @@ type CVERecord struct {
 	// Package is the Go package path that might be affected.
 	Package string
 
+	// SuggestedExclusion is the reason, like NOT_IMPORTABLE, for which
+	// triage suggests that the report of the CVE be excluded. It is set only
+	// for the NeedsIssue triage state.
+	SuggestedExclusion string
+
+	// CandidateModules are the modules that might be affected, when the
+	// CVE is about a repo with several modules, like a monorepo. They are
+	// chosen by the files that the CVE's fix commits change, most likely
+	// first, and Module is the first of them.
+	CandidateModules []*CandidateModule
+
+	// CVSS is the CVSS v3 vector string of the CVE, if it has one.
+	// It is used to prioritize triage.
+	CVSS string
+
+	// EPSS is the probability, from 0 to 1, that the CVE will be exploited
+	// in the next 30 days, according to the Exploit Prediction Scoring
+	// System. EPSSPercentile is the fraction of CVEs with a score no higher.
+	// They are set only for the NeedsIssue triage state.
+	EPSS           float64
+	EPSSPercentile float64
+
+	// Importers is the number of packages that import Module directly,
+	// according to deps.dev, to prioritize triage by the reach of the
+	// module. It is set only for the NeedsIssue triage state.
+	Importers int
+
+	// GoScore is the probability, from 0 to 1, that the CVE is a Go
+	// vulnerability, according to the triage classifier, to triage the
+	// likeliest first. It is set only when triage decides that the CVE
+	// needs an issue, and only if the worker has a classifier.
+	GoScore float64
+
+	// OSVAdvisories are the IDs of the advisories in OSV.dev, from any
+	// ecosystem, about the same vulnerability as the CVE.
+	OSVAdvisories []string
+
 	// CVE is a copy of the CVE, for the NeedsIssue triage state.
 	CVE *cveschema.CVE
 
@@ -58,9 +100,35 @@ type CVERecord struct {
 	// Set only after a GitHub issue has been successfully created.
 	IssueCreatedAt time.Time
 
+	// TriagedContent is the content of the CVE when it was triaged into its
+	// triage state. It is kept for records whose triage is not decided again
+	// each time the CVE changes, which is all but those in
+	// TriageStateNoActionNeeded and TriageStateNotAModule.
+	TriagedContent *CVEContent
+
+	// Change is how the CVE changed upstream since TriagedContent, if it
+	// did. A record with a change is queued for a person to triage again;
+	// acknowledging the change makes its new content the triaged content.
+	Change *CVEChange
+
+	// WithdrawalFlag is the upstream state of the CVE, REJECT or DISPUTED,
+	// for which its reports, or its issue if no report covers it, were last
+	// flagged for withdrawal review, at WithdrawalFlaggedAt. It is empty if
+	// they haven't been, or if the CVE has since become public and
+	// undisputed.
+	WithdrawalFlag      string
+	WithdrawalFlaggedAt time.Time
+
 	// History holds previous states of a CVERecord,
 	// from most to least recent.
 	History []*CVERecordSnapshot
+
+	// Version is incremented by the store each time the record is written.
+	// SetCVERecord fails with a *ConflictError unless it is the version of
+	// the stored record, so a record read, changed and written back doesn't
+	// overwrite a change made in the meantime. Records written before
+	// versions were added have version 0.
+	Version int64
 }
 
 func (r *CVERecord) GetID() string                { return r.ID }
@@ -88,6 +156,33 @@ func (r *CVERecord) Validate() error {
 	return r.TriageState.Validate()
 }
 
+// A ConflictError is returned by SetCVERecord when the record to be written
+// is not the version of the stored record, because the stored record changed
+// after it was read.
+type ConflictError struct {
+	ID            string
+	Version       int64 // the version of the record to be written
+	StoredVersion int64 // the version of the stored record
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("CVERecord %s changed: writing version %d, but the stored version is %d",
+		e.ID, e.Version, e.StoredVersion)
+}
+
+// checkVersion returns an error if r cannot be written over the stored
+// record with version stored. The exists argument reports whether there is a
+// stored record.
+func checkVersion(r *CVERecord, stored int64, exists bool) error {
+	if !exists {
+		return fmt.Errorf("CVERecord with ID %q not found", r.ID)
+	}
+	if r.Version != stored {
+		return &ConflictError{ID: r.ID, Version: r.Version, StoredVersion: stored}
+	}
+	return nil
+}
+
 // TriageState is the state of our work on the CVE or GHSA.
 // It is implemented as a string rather than an int so that stored values are
 // immune to renumbering.
@@ -110,12 +205,15 @@ const (
 	TriageStateFalsePositive TriageState = "FalsePositive"
 	// There is already an entry in the Go vuln DB that covers this CVE.
 	TriageStateHasVuln TriageState = "HasVuln"
+	// The CVE refers to paths that could be Go modules, but none of them
+	// exists in the module proxy.
+	TriageStateNotAModule TriageState = "NotAModule"
 )
 
 // Validate returns an error if the TriageState is not one of the above values.
 func (s TriageState) Validate() error {
 	switch s {
-	case TriageStateNoActionNeeded, TriageStateNeedsIssue, TriageStateIssueCreated, TriageStateAlias, TriageStateUpdatedSinceIssueCreation, TriageStateFalsePositive, TriageStateHasVuln:
+	case TriageStateNoActionNeeded, TriageStateNeedsIssue, TriageStateIssueCreated, TriageStateAlias, TriageStateUpdatedSinceIssueCreation, TriageStateFalsePositive, TriageStateHasVuln, TriageStateNotAModule:
 		return nil
 	default:
 		return fmt.Errorf("bad TriageState %q", s)
@@ -127,6 +225,8 @@ func NewCVERecord(cve *cveschema.CVE, path, blobHash string, commit *object.Comm
 	return &CVERecord{
 		ID:         cve.ID,
 		CVEState:   cve.State,
+		Disputed:   cve.IsDisputed(),
+		CVSS:       cve.CVSSV3(),
 		Path:       path,
 		BlobHash:   blobHash,
 		CommitHash: commit.Hash.String(),
@@ -152,6 +252,167 @@ func (r *CVERecord) Snapshot() *CVERecordSnapshot {
 	}
 }
 
+// CVEContent is the content of a CVE that triage decisions depend on.
+type CVEContent struct {
+	State       string
+	Description string
+	References  []string
+	CVSS        string
+}
+
+// NewCVEContent returns the content of cve that triage decisions depend on.
+func NewCVEContent(cve *cveschema.CVE) *CVEContent {
+	c := &CVEContent{State: cve.State, CVSS: cve.CVSSV3()}
+	for _, d := range cve.Description.Data {
+		if d.Value != "" {
+			if c.Description != "" {
+				c.Description += "\n"
+			}
+			c.Description += d.Value
+		}
+	}
+	for _, r := range cve.References.Data {
+		if r.URL != "" {
+			c.References = append(c.References, r.URL)
+		}
+	}
+	return c
+}
+
+// Equal reports whether c and d are the same content. A nil CVEContent
+// equals only another.
+func (c *CVEContent) Equal(d *CVEContent) bool {
+	if c == nil || d == nil {
+		return c == d
+	}
+	if c.State != d.State || c.Description != d.Description || c.CVSS != d.CVSS || len(c.References) != len(d.References) {
+		return false
+	}
+	for i, r := range c.References {
+		if d.References[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// A CVEChange is a change to a CVE upstream after it was triaged.
+type CVEChange struct {
+	// CommitHash and CommitTime are those of the latest change: the
+	// commit of the CVE's source that changed it, and when.
+	CommitHash string
+	CommitTime time.Time
+	// Old is the content of the CVE when it was triaged, and New its
+	// content after the change.
+	Old, New *CVEContent
+}
+
+// AddedReferences returns the references that the change added, in order.
+func (c *CVEChange) AddedReferences() []string {
+	return subtractStrings(c.New.References, c.Old.References)
+}
+
+// RemovedReferences returns the references that the change removed, in
+// order.
+func (c *CVEChange) RemovedReferences() []string {
+	return subtractStrings(c.Old.References, c.New.References)
+}
+
+// subtractStrings returns the strings of a that are not in b, in order.
+func subtractStrings(a, b []string) []string {
+	in := map[string]bool{}
+	for _, s := range b {
+		in[s] = true
+	}
+	var d []string
+	for _, s := range a {
+		if !in[s] {
+			d = append(d, s)
+		}
+	}
+	return d
+}
+
+// Summary describes the change briefly, like "2 references added,
+// description changed".
+func (c *CVEChange) Summary() string {
+	var parts []string
+	if n := len(c.AddedReferences()); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d references added", n))
+	}
+	if n := len(c.RemovedReferences()); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d references removed", n))
+	}
+	if c.Old.Description != c.New.Description {
+		parts = append(parts, "description changed")
+	}
+	if c.Old.State != c.New.State {
+		parts = append(parts, fmt.Sprintf("state %s to %s", c.Old.State, c.New.State))
+	}
+	if c.Old.CVSS != c.New.CVSS {
+		parts = append(parts, "CVSS changed")
+	}
+	if len(parts) == 0 {
+		// Only the order of the references changed.
+		return "references reordered"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// A CVERecordQuery selects CVERecords for Store.ListCVERecords. The zero
+// value of each field matches all records.
+type CVERecordQuery struct {
+	// TriageState matches records with that triage state.
+	TriageState TriageState
+	// Year matches records whose CVE ID has that year, e.g. 2022 for
+	// CVE-2022-1234.
+	Year int
+	// Module matches records with that module path.
+	Module string
+	// UpdatedSince matches records whose CommitTime, the time the CVE last
+	// changed in its source, is not before it.
+	UpdatedSince time.Time
+	// Cursor resumes a listing after the page that returned it.
+	Cursor string
+	// Limit is the maximum number of records to return. If zero, all
+	// matching records are returned.
+	Limit int
+}
+
+// idRange returns the bounds of the IDs that q can match: IDs after start
+// (exclusive) and before end (exclusive). An empty end means no bound.
+func (q *CVERecordQuery) idRange() (start, end string) {
+	start = q.Cursor
+	if q.Year != 0 {
+		prefix := fmt.Sprintf("CVE-%04d-", q.Year)
+		if start < prefix {
+			// '-' sorts just before '.', so IDs with the prefix are
+			// between prefix and this.
+			start = prefix
+		}
+		end = fmt.Sprintf("CVE-%04d.", q.Year)
+	}
+	return start, end
+}
+
+// matches reports whether r is selected by the non-ID fields of q.
+func (q *CVERecordQuery) matches(r *CVERecord) bool {
+	return (q.TriageState == "" || r.TriageState == q.TriageState) &&
+		(q.Module == "" || r.Module == q.Module) &&
+		(q.UpdatedSince.IsZero() || !r.CommitTime.Before(q.UpdatedSince))
+}
+
+// pageCVERecords returns the first limit records of crs, and a cursor for the
+// rest if there are more. To tell whether there are more, stores ask for one
+// record past the limit.
+func pageCVERecords(crs []*CVERecord, limit int) ([]*CVERecord, string) {
+	if limit <= 0 || len(crs) <= limit {
+		return crs, ""
+	}
+	crs = crs[:limit]
+	return crs, crs[limit-1].ID
+}
+
 // A CommitUpdateRecord describes a single update operation, which reconciles
 // a commit in the CVE list repo with the DB state.
 type CommitUpdateRecord struct {
@@ -160,6 +421,10 @@ type CommitUpdateRecord struct {
 	// When the update started and completed. If EndedAt is zero,
 	// the update is in progress (or it crashed).
 	StartedAt, EndedAt time.Time
+	// Interrupted reports whether the update was stopped before it was
+	// done, as when the server shut down, rather than by an error. A later
+	// update of the same commit resumes after LastPath.
+	Interrupted bool
 	// The repo commit hash that this update is working on.
 	CommitHash string
 	// The time the commit occurred.
@@ -175,6 +440,10 @@ type CommitUpdateRecord struct {
 	NumModified int
 	// The error that stopped the update.
 	Error string
+	// LastPath is the path of the last CVE file processed, in the order of
+	// the files in the repo. If the update stops before it is done, a later
+	// update of the same commit resumes after it.
+	LastPath string
 	// The last time this record was updated.
 	UpdatedAt time.Time `firestore:",serverTimestamp"`
 }
@@ -228,6 +497,324 @@ func (r *ModuleScanRecord) Validate() error {
 	return nil
 }
 
+// A DepsDevDiscrepancy is a disagreement between deps.dev and the Go reports
+// about a vulnerability of a module.
+type DepsDevDiscrepancy struct {
+	// Module is the path of the module.
+	Module string
+	// AdvisoryID is the ID of the advisory in deps.dev, such as a GHSA ID.
+	AdvisoryID string
+	// ReportID is the ID of the Go report about the same vulnerability, or
+	// empty if there is none.
+	ReportID string
+	// DepsDevOnly are the versions of Module that deps.dev says the
+	// vulnerability affects, but the report doesn't. With no report, they
+	// are all the versions that deps.dev says it affects.
+	DepsDevOnly []string
+	// ReportOnly are the versions of Module that the report says the
+	// vulnerability affects, but deps.dev doesn't.
+	ReportOnly []string
+}
+
+// Validate returns an error if the DepsDevDiscrepancy is not valid.
+func (d *DepsDevDiscrepancy) Validate() error {
+	if d.Module == "" {
+		return errors.New("need Module")
+	}
+	if d.AdvisoryID == "" {
+		return errors.New("need AdvisoryID")
+	}
+	return nil
+}
+
+func (d *DepsDevDiscrepancy) String() string {
+	var parts []string
+	if d.ReportID == "" {
+		parts = append(parts, "no report")
+	} else {
+		parts = append(parts, "report "+d.ReportID)
+	}
+	if len(d.DepsDevOnly) > 0 {
+		parts = append(parts, "only deps.dev affects "+strings.Join(d.DepsDevOnly, ", "))
+	}
+	if len(d.ReportOnly) > 0 {
+		parts = append(parts, "only the report affects "+strings.Join(d.ReportOnly, ", "))
+	}
+	return fmt.Sprintf("%s %s: %s", d.Module, d.AdvisoryID, strings.Join(parts, "; "))
+}
+
+// sortDepsDevDiscrepancies sorts ds by module and advisory ID.
+func sortDepsDevDiscrepancies(ds []*DepsDevDiscrepancy) {
+	sort.Slice(ds, func(i, j int) bool {
+		if ds[i].Module != ds[j].Module {
+			return ds[i].Module < ds[j].Module
+		}
+		return ds[i].AdvisoryID < ds[j].AdvisoryID
+	})
+}
+
+// A SourceStatus describes the updates from one source, like "cvelist" or
+// "nvd": the last update, and the last one that succeeded.
+type SourceStatus struct {
+	// Source is the name of the source.
+	Source string
+	// When the last update started and ended. If EndedAt is zero, the
+	// update is in progress (or it crashed).
+	StartedAt, EndedAt time.Time
+	// The error that stopped the last update, if it failed.
+	Error string
+	// Interrupted reports whether the last update was stopped before it was
+	// done, as when the server shut down, rather than by an error.
+	Interrupted bool
+	// The numbers of records that the last update processed, added and
+	// modified. Not every source counts all three.
+	NumProcessed, NumAdded, NumModified int
+	// When the last successful update ended, and how long it took.
+	LastSuccessAt       time.Time
+	LastSuccessDuration time.Duration
+	// The numbers of updates that ended, and of those that failed.
+	NumRuns, NumFailures int
+}
+
+// Validate returns an error if the SourceStatus is not valid.
+func (s *SourceStatus) Validate() error {
+	if s.Source == "" {
+		return errors.New("need Source")
+	}
+	if s.StartedAt.IsZero() {
+		return errors.New("need StartedAt")
+	}
+	return nil
+}
+
+// A QuarantinedFile is a CVE file of the cvelist repo that updates failed to
+// parse.
+type QuarantinedFile struct {
+	// Path is the path of the file in the repo.
+	Path string
+	// BlobHash is the hash of the file's contents that failed to parse.
+	BlobHash string
+	// Error is the last error from parsing the file.
+	Error string
+	// NumFailures is the number of updates that failed to parse the file
+	// with these contents.
+	NumFailures int
+	// When an update first and last failed to parse the file.
+	FirstFailedAt, LastFailedAt time.Time
+	// Quarantined reports whether updates skip the file, until its contents
+	// change.
+	Quarantined bool
+}
+
+// Validate returns an error if the QuarantinedFile is not valid.
+func (q *QuarantinedFile) Validate() error {
+	if q.Path == "" {
+		return errors.New("need Path")
+	}
+	if q.BlobHash == "" {
+		return errors.New("need BlobHash")
+	}
+	return nil
+}
+
+// A SecurityRelease is a Go release whose release notes list security
+// fixes, as checked against the reports in the vulndb repo.
+type SecurityRelease struct {
+	// Release is the tag of the release, like "go1.19.4".
+	Release string
+	// ReleasedAt is the date of the release.
+	ReleasedAt time.Time
+	// Packages are the packages that the release notes list security fixes
+	// to, like "net/http" or "cmd/go".
+	Packages []string
+	// Missing are the packages of Packages that no report said were fixed
+	// in the release, when it was last checked.
+	Missing []string
+	// CheckedAt is when the release was last checked.
+	CheckedAt time.Time
+	// IssueReference is a reference to the issue created to track the
+	// missing reports, if any.
+	IssueReference string
+	// IssueCreatedAt is when the issue was created.
+	IssueCreatedAt time.Time
+}
+
+// Validate returns an error if the SecurityRelease is not valid.
+func (r *SecurityRelease) Validate() error {
+	if r.Release == "" {
+		return errors.New("need Release")
+	}
+	if r.CheckedAt.IsZero() {
+		return errors.New("need CheckedAt")
+	}
+	return nil
+}
+
+// An UnfixedModule is a module of a report that has no fixed version, whose
+// versions in the module proxy are watched for one that may fix it.
+type UnfixedModule struct {
+	// ReportID is the ID of the report, such as GO-2022-0123.
+	ReportID string
+	// Module is the module path.
+	Module string
+	// LatestVersion is the proxy's latest version of the module when it
+	// was last checked.
+	LatestVersion string
+	// CheckedAt is when the module was last checked.
+	CheckedAt time.Time
+	// FlaggedVersion is the latest tagged version released since the
+	// module was first checked, which may fix the vulnerability, or "" if
+	// there is none. A triager should review the report.
+	FlaggedVersion string
+	// FlaggedAt is when FlaggedVersion was found.
+	FlaggedAt time.Time
+	// IssueReference is a reference to the issue that tracks the review,
+	// if any.
+	IssueReference string
+	// IssueCreatedAt is when the issue was created.
+	IssueCreatedAt time.Time
+}
+
+// Validate returns an error if the UnfixedModule is not valid.
+func (u *UnfixedModule) Validate() error {
+	if u.ReportID == "" {
+		return errors.New("need ReportID")
+	}
+	if u.Module == "" {
+		return errors.New("need Module")
+	}
+	if u.CheckedAt.IsZero() {
+		return errors.New("need CheckedAt")
+	}
+	return nil
+}
+
+// A ReviewState is the state of the review of a pending report.
+type ReviewState string
+
+const (
+	// ReviewStateDraft: the assignee is writing the report.
+	ReviewStateDraft ReviewState = "draft"
+	// ReviewStateInReview: the report is waiting for a reviewer.
+	ReviewStateInReview ReviewState = "in-review"
+	// ReviewStateApproved: a reviewer approved the report, which can be
+	// merged.
+	ReviewStateApproved ReviewState = "approved"
+)
+
+// Validate returns an error if the ReviewState is not one of the above
+// values.
+func (s ReviewState) Validate() error {
+	switch s {
+	case ReviewStateDraft, ReviewStateInReview, ReviewStateApproved:
+		return nil
+	default:
+		return fmt.Errorf("bad ReviewState %q", s)
+	}
+}
+
+// A ReportReview records who owns a pending report in the vulndb repo, and
+// the state of its review.
+type ReportReview struct {
+	// ReportID is the ID of the report, such as GO-2022-0123.
+	ReportID string
+	// State is the state of the review.
+	State ReviewState
+	// Assignee is the user who writes the report.
+	Assignee string
+	// Reviewer is the user who approved the report, or "" if none has.
+	Reviewer string
+	// UpdatedAt is when the review last changed.
+	UpdatedAt time.Time
+}
+
+// Validate returns an error if the ReportReview is not valid.
+func (r *ReportReview) Validate() error {
+	if r.ReportID == "" {
+		return errors.New("need ReportID")
+	}
+	if r.Assignee == "" {
+		return errors.New("need Assignee")
+	}
+	if r.State == ReviewStateApproved && r.Reviewer == "" {
+		return errors.New("approved review needs Reviewer")
+	}
+	return r.State.Validate()
+}
+
+// A CandidateModule is a module that a CVE might affect.
+type CandidateModule struct {
+	// Path is the module path.
+	Path string
+	// Confidence is the fraction, from 0 to 1, of the files changed by the
+	// fix of the CVE that are in the module. Only Go files count, if the
+	// fix changes any.
+	Confidence float64
+}
+
+// RepoModules records the Go modules of a GitHub repo: the modules whose
+// go.mod files are in the tree of its default branch.
+type RepoModules struct {
+	// Repo is the path of the repo, like "github.com/owner/repo".
+	Repo string
+	// Modules are the modules of the repo, ordered by directory. A repo
+	// without go.mod files has none.
+	Modules []*RepoModule
+	// FetchedAt is when the modules were looked up.
+	FetchedAt time.Time
+}
+
+// A RepoModule is a module of a repo.
+type RepoModule struct {
+	// Dir is the directory of the module's go.mod file in the repo, like
+	// "staging/src/k8s.io/api", or "" for the root of the repo.
+	Dir string
+	// Path is the module path that the go.mod file declares.
+	Path string
+}
+
+// Validate returns an error if the RepoModules is not valid.
+func (r *RepoModules) Validate() error {
+	if r.Repo == "" {
+		return errors.New("need Repo")
+	}
+	if r.FetchedAt.IsZero() {
+		return errors.New("need FetchedAt")
+	}
+	for _, m := range r.Modules {
+		if m.Path == "" {
+			return fmt.Errorf("module in %q has no path", m.Dir)
+		}
+	}
+	return nil
+}
+
+// ModuleAt returns the module of r that contains the file or directory at
+// path in the repo: the one whose directory is the longest prefix of path.
+// It returns nil if there is none.
+func (r *RepoModules) ModuleAt(path string) *RepoModule {
+	var best *RepoModule
+	for _, m := range r.Modules {
+		if m.Dir != "" && path != m.Dir && !strings.HasPrefix(path, m.Dir+"/") {
+			continue
+		}
+		if best == nil || len(m.Dir) > len(best.Dir) {
+			best = m
+		}
+	}
+	return best
+}
+
+// sortUnfixedModules sorts us by report ID and module.
+func sortUnfixedModules(us []*UnfixedModule) {
+	sort.Slice(us, func(i, j int) bool {
+		if us[i].ReportID != us[j].ReportID {
+			return us[i].ReportID < us[j].ReportID
+		}
+		return us[i].Module < us[j].Module
+	})
+}
+
 // A Store is a storage system for the CVE database.
 type Store interface {
 	// CreateCommitUpdateRecord creates a new CommitUpdateRecord. It should be called at the start
@@ -250,6 +837,11 @@ type Store interface {
 	// ordered by ID.
 	ListCVERecordsWithTriageState(ctx context.Context, ts TriageState) ([]*CVERecord, error)
 
+	// ListCVERecords returns the CVERecords that match q, ordered by ID. If
+	// there may be more after them, it also returns a non-empty cursor; pass
+	// it as q.Cursor to get the next page.
+	ListCVERecords(ctx context.Context, q CVERecordQuery) (_ []*CVERecord, next string, err error)
+
 	// GetDirectoryHash returns the hash for the tree object corresponding to dir.
 	// If dir isn't found, it succeeds with the empty string.
 	GetDirectoryHash(ctx context.Context, dir string) (string, error)
@@ -257,6 +849,43 @@ type Store interface {
 	// SetDirectoryHash sets the hash for the given directory.
 	SetDirectoryHash(ctx context.Context, dir, hash string) error
 
+	// GetFetchCursor returns the cursor for incremental fetches from the given
+	// source, such as the time of the last modification seen. If there is no
+	// cursor for the source, it succeeds with the zero time.
+	GetFetchCursor(ctx context.Context, source string) (time.Time, error)
+
+	// SetFetchCursor sets the cursor for the given source.
+	SetFetchCursor(ctx context.Context, source string, t time.Time) error
+
+	// AcquireLock acquires the lock with the given name for owner until ttl
+	// from now, and reports whether it did. It does not acquire a lock that
+	// another owner holds and that has not expired. The owner of a lock can
+	// acquire it again to extend it.
+	AcquireLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error)
+
+	// RenewLock extends the lock with the given name until ttl from now, and
+	// reports whether it did. Unlike AcquireLock, it does not take a lock
+	// that owner no longer holds, because it expired, so that the owner
+	// learns that it lost the lock.
+	RenewLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error)
+
+	// ReleaseLock releases the lock with the given name, if owner holds it.
+	ReleaseLock(ctx context.Context, name, owner string) error
+
+	// ListTriageHistory returns the TriageHistoryEntries for the CVE or GHSA
+	// with the given ID, from least to most recent.
+	ListTriageHistory(ctx context.Context, id string) ([]*TriageHistoryEntry, error)
+
+	// GetAliases returns the IDs of vulnerabilities, such as CVE, GHSA and GO
+	// IDs, that are aliases of id, directly or through other aliases. They
+	// are sorted and do not include id.
+	GetAliases(ctx context.Context, id string) ([]string, error)
+
+	// Search returns the IDs of the search documents that have all of the
+	// given terms, in sorted order. If limit is positive, it returns at
+	// most limit IDs. See SetSearchTerms.
+	Search(ctx context.Context, terms []string, limit int) ([]string, error)
+
 	// CreateModuleScanRecord adds a ModuleScanRecord to the DB.
 	CreateModuleScanRecord(context.Context, *ModuleScanRecord) error
 
@@ -269,6 +898,69 @@ type Store interface {
 	// from most to least recent. If limit is zero, all records are returned.
 	ListModuleScanRecords(ctx context.Context, limit int) ([]*ModuleScanRecord, error)
 
+	// SetDepsDevDiscrepancies replaces all the DepsDevDiscrepancies in the
+	// store with ds.
+	SetDepsDevDiscrepancies(ctx context.Context, ds []*DepsDevDiscrepancy) error
+
+	// ListDepsDevDiscrepancies returns the DepsDevDiscrepancies in the
+	// store, ordered by module and advisory ID.
+	ListDepsDevDiscrepancies(ctx context.Context) ([]*DepsDevDiscrepancy, error)
+
+	// SetSourceStatus adds or replaces the SourceStatus for s.Source.
+	SetSourceStatus(ctx context.Context, s *SourceStatus) error
+
+	// ListSourceStatuses returns the SourceStatuses in the store, ordered by
+	// source.
+	ListSourceStatuses(ctx context.Context) ([]*SourceStatus, error)
+
+	// SetQuarantinedFile adds or replaces the QuarantinedFile for q.Path.
+	SetQuarantinedFile(ctx context.Context, q *QuarantinedFile) error
+
+	// DeleteQuarantinedFile deletes the QuarantinedFile for the path, if
+	// there is one.
+	DeleteQuarantinedFile(ctx context.Context, path string) error
+
+	// ListQuarantinedFiles returns the QuarantinedFiles in the store, ordered
+	// by path.
+	ListQuarantinedFiles(ctx context.Context) ([]*QuarantinedFile, error)
+
+	// SetSecurityRelease adds or replaces the SecurityRelease for r.Release.
+	SetSecurityRelease(ctx context.Context, r *SecurityRelease) error
+
+	// ListSecurityReleases returns the SecurityReleases in the store,
+	// ordered by release.
+	ListSecurityReleases(ctx context.Context) ([]*SecurityRelease, error)
+
+	// SetUnfixedModule adds or replaces the UnfixedModule for u.ReportID
+	// and u.Module.
+	SetUnfixedModule(ctx context.Context, u *UnfixedModule) error
+
+	// DeleteUnfixedModule deletes the UnfixedModule for the report and
+	// module, if there is one.
+	DeleteUnfixedModule(ctx context.Context, reportID, module string) error
+
+	// ListUnfixedModules returns the UnfixedModules in the store, ordered
+	// by report ID and module.
+	ListUnfixedModules(ctx context.Context) ([]*UnfixedModule, error)
+
+	// SetReportReview adds or replaces the ReportReview for r.ReportID.
+	SetReportReview(ctx context.Context, r *ReportReview) error
+
+	// GetReportReview returns the ReportReview for the report with the
+	// given ID, or nil if there is none.
+	GetReportReview(ctx context.Context, reportID string) (*ReportReview, error)
+
+	// ListReportReviews returns the ReportReviews in the store, ordered by
+	// report ID.
+	ListReportReviews(ctx context.Context) ([]*ReportReview, error)
+
+	// SetRepoModules adds or replaces the RepoModules for r.Repo.
+	SetRepoModules(ctx context.Context, r *RepoModules) error
+
+	// GetRepoModules returns the RepoModules for the repo, like
+	// "github.com/owner/repo", or nil if there is none.
+	GetRepoModules(ctx context.Context, repo string) (*RepoModules, error)
+
 	// RunTransaction runs the function in a transaction.
 	RunTransaction(context.Context, func(context.Context, Transaction) error) error
 }
@@ -276,11 +968,17 @@ type Store interface {
 // Transaction supports store operations that run inside a transaction.
 type Transaction interface {
 	// CreateCVERecord creates a new CVERecord. It is an error if one with the same ID
-	// already exists.
+	// already exists. If r.Version is zero, it is set to 1.
+	//
+	// This method and the other methods that write records also write a
+	// TriageHistoryEntry for the record, if the record is new or its triage
+	// state differs from the one read in this transaction.
 	CreateCVERecord(*CVERecord) error
 
 	// SetCVERecord sets the CVE record in the database. It is
-	// an error if no such record exists.
+	// an error if no such record exists. If r.Version is not the version
+	// of the stored record, SetCVERecord returns a *ConflictError;
+	// otherwise it increments r.Version.
 	SetCVERecord(r *CVERecord) error
 
 	// GetCVERecords retrieves CVERecords for all CVE IDs between startID and
@@ -301,4 +999,28 @@ type Transaction interface {
 
 	// GetGHSARecords returns all the GHSARecords in the database.
 	GetGHSARecords() ([]*GHSARecord, error)
+
+	// AddAliases records that all the given IDs identify the same
+	// vulnerability. Empty IDs are ignored.
+	AddAliases(ids ...string) error
+
+	// GetAliases is like Store.GetAliases, inside the transaction.
+	GetAliases(id string) ([]string, error)
+
+	// SetSearchTerms replaces the terms of the search document with the
+	// given ID, such as that of a CVE, GHSA or Go report. With no terms, it
+	// removes the document.
+	SetSearchTerms(id string, terms []string) error
+
+	// SetReportReview is like Store.SetReportReview, inside the transaction.
+	SetReportReview(r *ReportReview) error
+
+	// GetReportReview is like Store.GetReportReview, inside the transaction.
+	GetReportReview(reportID string) (*ReportReview, error)
+
+	// AddTriageHistory adds TriageHistoryEntries as they are, such as ones
+	// copied from another store. A record written later in the transaction
+	// gets no entry of its own if its triage state is the NewState of the
+	// last entry added for it.
+	AddTriageHistory(es ...*TriageHistoryEntry) error
 }
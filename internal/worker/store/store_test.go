@@ -6,7 +6,10 @@ package store
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -35,6 +38,16 @@ func must1[T any](x T, err error) func(*testing.T) T {
 	}
 }
 
+func must2[T, U any](x T, y U, err error) func(*testing.T) (T, U) {
+	return func(t *testing.T) (T, U) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return x, y
+	}
+}
+
 func testStore(t *testing.T, s Store) {
 	t.Run("Updates", func(t *testing.T) {
 		testUpdates(t, s)
@@ -42,15 +55,57 @@ func testStore(t *testing.T, s Store) {
 	t.Run("CVEs", func(t *testing.T) {
 		testCVEs(t, s)
 	})
+	t.Run("ListCVERecords", func(t *testing.T) {
+		testListCVERecords(t, s)
+	})
 	t.Run("DirHashes", func(t *testing.T) {
 		testDirHashes(t, s)
 	})
+	t.Run("FetchCursors", func(t *testing.T) {
+		testFetchCursors(t, s)
+	})
+	t.Run("Locks", func(t *testing.T) {
+		testLocks(t, s)
+	})
 	t.Run("GHSAs", func(t *testing.T) {
 		testGHSAs(t, s)
 	})
 	t.Run("ModuleScanRecords", func(t *testing.T) {
 		testModuleScanRecords(t, s)
 	})
+	t.Run("TriageHistory", func(t *testing.T) {
+		testTriageHistory(t, s)
+	})
+	t.Run("Aliases", func(t *testing.T) {
+		testAliases(t, s)
+	})
+	t.Run("Search", func(t *testing.T) {
+		testSearch(t, s)
+	})
+	t.Run("DepsDevDiscrepancies", func(t *testing.T) {
+		testDepsDevDiscrepancies(t, s)
+	})
+	t.Run("SourceStatus", func(t *testing.T) {
+		testSourceStatus(t, s)
+	})
+	t.Run("QuarantinedFiles", func(t *testing.T) {
+		testQuarantinedFiles(t, s)
+	})
+	t.Run("SecurityReleases", func(t *testing.T) {
+		testSecurityReleases(t, s)
+	})
+	t.Run("UnfixedModules", func(t *testing.T) {
+		testUnfixedModules(t, s)
+	})
+	t.Run("ReportReviews", func(t *testing.T) {
+		testReportReviews(t, s)
+	})
+	t.Run("RepoModules", func(t *testing.T) {
+		testRepoModules(t, s)
+	})
+	t.Run("Concurrency", func(t *testing.T) {
+		testConcurrency(t, s)
+	})
 }
 
 func testUpdates(t *testing.T, s Store) {
@@ -166,12 +221,107 @@ func testCVEs(t *testing.T, s Store) {
 	want := *crs[0]
 	want.CVEState = cveschema.StateRejected
 	want.CommitHash = "999"
+	want.Version = 2
 	diff(t, &want, got)
+	diff(t, &want, must1(s.GetCVERecord(ctx, id1))(t))
+
+	// Writing a version that isn't the stored one fails.
+	stale := want
+	stale.Version = 1
+	stale.TriageState = TriageStateNeedsIssue
+	err := s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		return tx.SetCVERecord(&stale)
+	})
+	var cerr *ConflictError
+	if !errors.As(err, &cerr) || cerr.StoredVersion != 2 {
+		t.Errorf("setting stale record: got %v, want ConflictError with stored version 2", err)
+	}
+	diff(t, &want, must1(s.GetCVERecord(ctx, id1))(t))
 
 	gotNoAction := must1(s.ListCVERecordsWithTriageState(ctx, TriageStateNoActionNeeded))(t)
 	diff(t, crs[1:], gotNoAction)
 }
 
+func testListCVERecords(t *testing.T, s Store) {
+	ctx := context.Background()
+	newRecord := func(id string, ts TriageState, module string, year int) *CVERecord {
+		return &CVERecord{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "b",
+			CommitHash:  "c",
+			CommitTime:  time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
+			TriageState: ts,
+			Module:      module,
+		}
+	}
+	crs := []*CVERecord{
+		newRecord("CVE-1906-0001", TriageStateNeedsIssue, "a", 2000),
+		newRecord("CVE-1906-0002", TriageStateNeedsIssue, "b", 2005),
+		newRecord("CVE-1906-0003", TriageStateNoActionNeeded, "", 2010),
+		newRecord("CVE-1906-0004", TriageStateNeedsIssue, "a", 2015),
+		newRecord("CVE-1907-0001", TriageStateNeedsIssue, "a", 2020),
+	}
+	createCVERecords(t, ctx, s, crs)
+
+	for _, test := range []struct {
+		name string
+		q    CVERecordQuery
+		want []*CVERecord
+	}{
+		{"year", CVERecordQuery{Year: 1906}, crs[:4]},
+		{"state", CVERecordQuery{Year: 1906, TriageState: TriageStateNeedsIssue}, []*CVERecord{crs[0], crs[1], crs[3]}},
+		{"module", CVERecordQuery{Module: "a"}, []*CVERecord{crs[0], crs[3], crs[4]}},
+		{"updated", CVERecordQuery{Year: 1906, UpdatedSince: crs[2].CommitTime}, crs[2:4]},
+		{"none", CVERecordQuery{Year: 1908}, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, next, err := s.ListCVERecords(ctx, test.q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			diff(t, test.want, got)
+			if next != "" {
+				t.Errorf("got cursor %q, want none", next)
+			}
+		})
+	}
+
+	// Page through the records, checking that the pages add up to all of them.
+	for _, test := range []struct {
+		name string
+		q    CVERecordQuery
+		want []*CVERecord
+	}{
+		{"pages", CVERecordQuery{Year: 1906, Limit: 3}, crs[:4]},
+		{"exact pages", CVERecordQuery{Year: 1906, Limit: 2}, crs[:4]},
+		{"filtered pages", CVERecordQuery{Module: "a", UpdatedSince: crs[1].CommitTime, Limit: 1}, crs[3:]},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var got []*CVERecord
+			q := test.q
+			for i := 0; ; i++ {
+				if i > len(test.want) {
+					t.Fatal("too many pages")
+				}
+				page, next, err := s.ListCVERecords(ctx, q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(page) > q.Limit {
+					t.Fatalf("got %d records, want at most %d", len(page), q.Limit)
+				}
+				got = append(got, page...)
+				if next == "" {
+					break
+				}
+				q.Cursor = next
+			}
+			diff(t, test.want, got)
+		})
+	}
+}
+
 func testDirHashes(t *testing.T, s Store) {
 	ctx := context.Background()
 	const dir = "a/b/c"
@@ -187,6 +337,73 @@ func testDirHashes(t *testing.T, s Store) {
 	}
 }
 
+func testFetchCursors(t *testing.T, s Store) {
+	ctx := context.Background()
+	const source = "nvd"
+	got := must1(s.GetFetchCursor(ctx, source))(t)
+	if !got.IsZero() {
+		t.Fatalf("got %s, want zero", got)
+	}
+	for _, want := range []time.Time{
+		time.Date(2022, time.June, 1, 12, 15, 9, 647000000, time.UTC),
+		time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		must(s.SetFetchCursor(ctx, source, want))(t)
+		got = must1(s.GetFetchCursor(ctx, source))(t)
+		if !got.Equal(want) {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+	got = must1(s.GetFetchCursor(ctx, "other"))(t)
+	if !got.IsZero() {
+		t.Fatalf("other source: got %s, want zero", got)
+	}
+}
+
+func testLocks(t *testing.T, s Store) {
+	ctx := context.Background()
+	const name = "update"
+	acquire := func(owner string, ttl time.Duration, want bool) {
+		t.Helper()
+		got := must1(s.AcquireLock(ctx, name, owner, ttl))(t)
+		if got != want {
+			t.Fatalf("AcquireLock(%q): got %t, want %t", owner, got, want)
+		}
+	}
+	acquire("a", time.Hour, true)
+	acquire("b", time.Hour, false)
+	// The owner can extend the lock.
+	acquire("a", time.Hour, true)
+	// Only the owner can release it.
+	must(s.ReleaseLock(ctx, name, "b"))(t)
+	acquire("b", time.Hour, false)
+	must(s.ReleaseLock(ctx, name, "a"))(t)
+	acquire("b", time.Hour, true)
+	// Another lock is independent.
+	if !must1(s.AcquireLock(ctx, "other", "a", time.Hour))(t) {
+		t.Fatal("could not acquire other lock")
+	}
+	// An expired lock can be taken.
+	acquire("b", -time.Second, true)
+	acquire("a", time.Hour, true)
+
+	// Only the owner can renew a lock, and only before it expires.
+	renew := func(owner string, ttl time.Duration, want bool) {
+		t.Helper()
+		got := must1(s.RenewLock(ctx, name, owner, ttl))(t)
+		if got != want {
+			t.Fatalf("RenewLock(%q): got %t, want %t", owner, got, want)
+		}
+	}
+	renew("a", time.Hour, true)
+	renew("b", time.Hour, false)
+	renew("a", -time.Second, true)
+	renew("a", time.Hour, false)
+	acquire("b", time.Hour, true)
+	must(s.ReleaseLock(ctx, name, "b"))(t)
+	renew("b", time.Hour, false)
+}
+
 func testGHSAs(t *testing.T, s Store) {
 	ctx := context.Background()
 	// Create two records.
@@ -291,6 +508,533 @@ func testModuleScanRecords(t *testing.T, s Store) {
 	}
 }
 
+func testTriageHistory(t *testing.T, s Store) {
+	ctx := WithActor(context.Background(), "alice")
+	const id = "CVE-2022-9001"
+	cr := &CVERecord{
+		ID:                id,
+		Path:              "p",
+		BlobHash:          "b",
+		CommitHash:        "c",
+		CommitTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		TriageState:       TriageStateNeedsIssue,
+		TriageStateReason: "found module",
+	}
+	createCVERecords(t, ctx, s, []*CVERecord{cr})
+	// Change the state, then write the record again without changing it.
+	var heard []*TriageHistoryEntry
+	lctx := WithTriageListener(WithActor(ctx, "bob"), func(_ context.Context, es []*TriageHistoryEntry) {
+		heard = append(heard, es...)
+	})
+	for _, ts := range []TriageState{TriageStateFalsePositive, TriageStateFalsePositive} {
+		must(s.RunTransaction(lctx, func(ctx context.Context, tx Transaction) error {
+			crs, err := tx.GetCVERecords(id, id)
+			if err != nil {
+				return err
+			}
+			crs[0].TriageState = ts
+			crs[0].TriageStateReason = "not Go"
+			return tx.SetCVERecord(crs[0])
+		}))(t)
+	}
+	const ghsaID = "GHSA-hhhh-hhhh-hhhh"
+	must(s.RunTransaction(context.Background(), func(ctx context.Context, tx Transaction) error {
+		return tx.CreateGHSARecord(&GHSARecord{
+			GHSA:        &ghsa.SecurityAdvisory{ID: ghsaID},
+			TriageState: TriageStateNoActionNeeded,
+		})
+	}))(t)
+
+	opt := cmpopts.IgnoreFields(TriageHistoryEntry{}, "Time")
+	got := must1(s.ListTriageHistory(ctx, id))(t)
+	want := []*TriageHistoryEntry{
+		{ID: id, Actor: "alice", NewState: TriageStateNeedsIssue, Reason: "found module"},
+		{ID: id, Actor: "bob", OldState: TriageStateNeedsIssue, NewState: TriageStateFalsePositive, Reason: "not Go"},
+	}
+	diff(t, want, got, opt)
+	diff(t, want[1:], heard, opt)
+	for _, e := range got {
+		if e.Time.IsZero() {
+			t.Errorf("%+v: zero time", e)
+		}
+	}
+	got = must1(s.ListTriageHistory(ctx, ghsaID))(t)
+	want = []*TriageHistoryEntry{{ID: ghsaID, Actor: DefaultActor, NewState: TriageStateNoActionNeeded}}
+	diff(t, want, got, opt)
+	got = must1(s.ListTriageHistory(ctx, "CVE-2022-9002"))(t)
+	if len(got) != 0 {
+		t.Errorf("unknown ID: got %d entries, want none", len(got))
+	}
+
+	// Added history is kept as it is, and creating a record in its last
+	// state adds nothing.
+	const copiedID = "CVE-2022-9003"
+	copied := []*TriageHistoryEntry{
+		{ID: copiedID, Time: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC), Actor: "carol", NewState: TriageStateNeedsIssue},
+		{ID: copiedID, Time: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), Actor: "dave", OldState: TriageStateNeedsIssue, NewState: TriageStateIssueCreated},
+	}
+	must(s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		if err := tx.AddTriageHistory(copied...); err != nil {
+			return err
+		}
+		c := *cr
+		c.ID = copiedID
+		c.TriageState = TriageStateIssueCreated
+		return tx.CreateCVERecord(&c)
+	}))(t)
+	got = must1(s.ListTriageHistory(ctx, copiedID))(t)
+	diff(t, copied, got, cmpopts.EquateApproxTime(time.Millisecond))
+}
+
+func testAliases(t *testing.T, s Store) {
+	ctx := context.Background()
+	if got := must1(s.GetAliases(ctx, "CVE-2022-0001"))(t); len(got) != 0 {
+		t.Fatalf("before adding: got %v, want none", got)
+	}
+	must(s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		if err := tx.AddAliases("GHSA-aaaa-bbbb-cccc", "CVE-2022-0001", ""); err != nil {
+			return err
+		}
+		// Adding an edge twice is harmless.
+		if err := tx.AddAliases("CVE-2022-0001", "GHSA-aaaa-bbbb-cccc"); err != nil {
+			return err
+		}
+		if err := tx.AddAliases("GO-2022-0001", "GHSA-aaaa-bbbb-cccc", "CVE-2022-0002"); err != nil {
+			return err
+		}
+		// A single ID has no aliases.
+		return tx.AddAliases("CVE-2022-0003")
+	}))(t)
+
+	for _, test := range []struct {
+		id   string
+		want []string
+	}{
+		{"CVE-2022-0001", []string{"CVE-2022-0002", "GHSA-aaaa-bbbb-cccc", "GO-2022-0001"}},
+		{"GO-2022-0001", []string{"CVE-2022-0001", "CVE-2022-0002", "GHSA-aaaa-bbbb-cccc"}},
+		{"CVE-2022-0003", nil},
+	} {
+		diff(t, test.want, must1(s.GetAliases(ctx, test.id))(t), cmpopts.EquateEmpty())
+		must(s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+			got, err := tx.GetAliases(test.id)
+			if err != nil {
+				return err
+			}
+			diff(t, test.want, got, cmpopts.EquateEmpty())
+			return nil
+		}))(t)
+	}
+}
+
+func testSearch(t *testing.T, s Store) {
+	ctx := context.Background()
+	must(s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		for id, terms := range map[string][]string{
+			"CVE-2022-0001":       {"yaml.v2", "deserialization", "panic"},
+			"GHSA-aaaa-bbbb-cccc": {"yaml.v2", "panic", "panic"},
+			"GO-2022-0001":        {"yaml.v3", "deserialization"},
+			"CVE-2022-0002":       {"yaml.v2"},
+		} {
+			if err := tx.SetSearchTerms(id, terms); err != nil {
+				return err
+			}
+		}
+		// Setting the terms again replaces them; no terms remove the
+		// document.
+		if err := tx.SetSearchTerms("GO-2022-0001", []string{"yaml.v2", "deserialization"}); err != nil {
+			return err
+		}
+		return tx.SetSearchTerms("CVE-2022-0002", nil)
+	}))(t)
+
+	for _, test := range []struct {
+		terms []string
+		limit int
+		want  []string
+	}{
+		{[]string{"yaml.v2"}, 0, []string{"CVE-2022-0001", "GHSA-aaaa-bbbb-cccc", "GO-2022-0001"}},
+		{[]string{"yaml.v2", "deserialization"}, 0, []string{"CVE-2022-0001", "GO-2022-0001"}},
+		{[]string{"deserialization", "yaml.v2", "yaml.v2"}, 0, []string{"CVE-2022-0001", "GO-2022-0001"}},
+		{[]string{"yaml.v2"}, 2, []string{"CVE-2022-0001", "GHSA-aaaa-bbbb-cccc"}},
+		{[]string{"yaml.v3"}, 0, nil},
+		{nil, 0, nil},
+	} {
+		got := must1(s.Search(ctx, test.terms, test.limit))(t)
+		diff(t, test.want, got, cmpopts.EquateEmpty())
+	}
+}
+
+func testDepsDevDiscrepancies(t *testing.T, s Store) {
+	ctx := context.Background()
+	d1 := &DepsDevDiscrepancy{
+		Module:      "golang.org/x/text",
+		AdvisoryID:  "GHSA-aaaa-bbbb-cccc",
+		DepsDevOnly: []string{"v0.3.7"},
+	}
+	d2 := &DepsDevDiscrepancy{
+		Module:      "golang.org/x/net",
+		AdvisoryID:  "GHSA-dddd-eeee-ffff",
+		ReportID:    "GO-2022-0001",
+		DepsDevOnly: []string{"v0.1.0"},
+		ReportOnly:  []string{"v0.2.0", "v0.2.1"},
+	}
+	d3 := &DepsDevDiscrepancy{
+		Module:     "golang.org/x/text",
+		AdvisoryID: "GHSA-1111-2222-3333",
+		ReportID:   "GO-2022-0002",
+		ReportOnly: []string{"v0.3.8"},
+	}
+	must(s.SetDepsDevDiscrepancies(ctx, []*DepsDevDiscrepancy{d1, d2, d3}))(t)
+	got := must1(s.ListDepsDevDiscrepancies(ctx))(t)
+	diff(t, []*DepsDevDiscrepancy{d2, d3, d1}, got)
+
+	// Setting them again replaces them all.
+	must(s.SetDepsDevDiscrepancies(ctx, []*DepsDevDiscrepancy{d1}))(t)
+	got = must1(s.ListDepsDevDiscrepancies(ctx))(t)
+	diff(t, []*DepsDevDiscrepancy{d1}, got)
+
+	if err := s.SetDepsDevDiscrepancies(ctx, []*DepsDevDiscrepancy{{Module: "m"}}); err == nil {
+		t.Error("discrepancy without advisory ID: got nil, want error")
+	}
+	must(s.SetDepsDevDiscrepancies(ctx, nil))(t)
+	got = must1(s.ListDepsDevDiscrepancies(ctx))(t)
+	diff(t, []*DepsDevDiscrepancy(nil), got, cmpopts.EquateEmpty())
+}
+
+func testSourceStatus(t *testing.T, s Store) {
+	ctx := context.Background()
+	start := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	nvd := &SourceStatus{
+		Source:              "nvd",
+		StartedAt:           start,
+		EndedAt:             start.Add(time.Minute),
+		NumProcessed:        10,
+		NumAdded:            2,
+		NumModified:         3,
+		LastSuccessAt:       start.Add(time.Minute),
+		LastSuccessDuration: time.Minute,
+		NumRuns:             1,
+	}
+	cvelist := &SourceStatus{
+		Source:    "cvelist",
+		StartedAt: start,
+	}
+	must(s.SetSourceStatus(ctx, nvd))(t)
+	must(s.SetSourceStatus(ctx, cvelist))(t)
+	got := must1(s.ListSourceStatuses(ctx))(t)
+	diff(t, []*SourceStatus{cvelist, nvd}, got)
+
+	// Setting a status replaces the old one.
+	cvelist.EndedAt = start.Add(time.Hour)
+	cvelist.Error = "failed"
+	cvelist.NumRuns = 1
+	cvelist.NumFailures = 1
+	must(s.SetSourceStatus(ctx, cvelist))(t)
+	got = must1(s.ListSourceStatuses(ctx))(t)
+	diff(t, []*SourceStatus{cvelist, nvd}, got)
+
+	if err := s.SetSourceStatus(ctx, &SourceStatus{StartedAt: start}); err == nil {
+		t.Error("status without source: got nil, want error")
+	}
+}
+
+func testQuarantinedFiles(t *testing.T, s Store) {
+	ctx := context.Background()
+	failed := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	q1 := &QuarantinedFile{
+		Path:          "2022/1xxx/CVE-2022-1000.json",
+		BlobHash:      "abc",
+		Error:         "unexpected EOF",
+		NumFailures:   3,
+		FirstFailedAt: failed,
+		LastFailedAt:  failed.Add(2 * time.Hour),
+		Quarantined:   true,
+	}
+	q2 := &QuarantinedFile{
+		Path:          "2021/0xxx/CVE-2021-0001.json",
+		BlobHash:      "def",
+		Error:         "bad",
+		NumFailures:   1,
+		FirstFailedAt: failed,
+		LastFailedAt:  failed,
+	}
+	must(s.SetQuarantinedFile(ctx, q1))(t)
+	must(s.SetQuarantinedFile(ctx, q2))(t)
+	got := must1(s.ListQuarantinedFiles(ctx))(t)
+	diff(t, []*QuarantinedFile{q2, q1}, got)
+
+	// Setting a file replaces it, and deleting a missing file is not an
+	// error.
+	q2.NumFailures = 2
+	must(s.SetQuarantinedFile(ctx, q2))(t)
+	must(s.DeleteQuarantinedFile(ctx, q1.Path))(t)
+	must(s.DeleteQuarantinedFile(ctx, "missing.json"))(t)
+	got = must1(s.ListQuarantinedFiles(ctx))(t)
+	diff(t, []*QuarantinedFile{q2}, got)
+
+	if err := s.SetQuarantinedFile(ctx, &QuarantinedFile{Path: "p"}); err == nil {
+		t.Error("file without blob hash: got nil, want error")
+	}
+}
+
+func testSecurityReleases(t *testing.T, s Store) {
+	ctx := context.Background()
+	checked := time.Date(2022, 12, 7, 0, 0, 0, 0, time.UTC)
+	r1 := &SecurityRelease{
+		Release:    "go1.19.4",
+		ReleasedAt: time.Date(2022, 12, 6, 0, 0, 0, 0, time.UTC),
+		Packages:   []string{"net/http", "os"},
+		Missing:    []string{"os"},
+		CheckedAt:  checked,
+	}
+	r2 := &SecurityRelease{
+		Release:    "go1.19.3",
+		ReleasedAt: time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+		Packages:   []string{"os/exec", "syscall"},
+		CheckedAt:  checked,
+	}
+	must(s.SetSecurityRelease(ctx, r1))(t)
+	must(s.SetSecurityRelease(ctx, r2))(t)
+	got := must1(s.ListSecurityReleases(ctx))(t)
+	diff(t, []*SecurityRelease{r2, r1}, got)
+
+	// Setting a release replaces it.
+	r1.IssueReference = "https://github.com/golang/vulndb/issues/1234"
+	r1.IssueCreatedAt = checked.Add(time.Minute)
+	must(s.SetSecurityRelease(ctx, r1))(t)
+	got = must1(s.ListSecurityReleases(ctx))(t)
+	diff(t, []*SecurityRelease{r2, r1}, got)
+
+	if err := s.SetSecurityRelease(ctx, &SecurityRelease{Release: "go1.19.5"}); err == nil {
+		t.Error("release without check time: got nil, want error")
+	}
+}
+
+func testUnfixedModules(t *testing.T, s Store) {
+	ctx := context.Background()
+	checked := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	u1 := &UnfixedModule{
+		ReportID:      "GO-2022-0002",
+		Module:        "example.com/a",
+		LatestVersion: "v1.0.0",
+		CheckedAt:     checked,
+	}
+	u2 := &UnfixedModule{
+		ReportID:       "GO-2022-0001",
+		Module:         "example.com/b",
+		LatestVersion:  "v2.1.0",
+		CheckedAt:      checked,
+		FlaggedVersion: "v2.1.0",
+		FlaggedAt:      checked,
+	}
+	u3 := &UnfixedModule{
+		ReportID:      "GO-2022-0001",
+		Module:        "example.com/a",
+		LatestVersion: "v0.0.0-20221201000000-abcdefabcdef",
+		CheckedAt:     checked,
+	}
+	for _, u := range []*UnfixedModule{u1, u2, u3} {
+		must(s.SetUnfixedModule(ctx, u))(t)
+	}
+	got := must1(s.ListUnfixedModules(ctx))(t)
+	diff(t, []*UnfixedModule{u3, u2, u1}, got)
+
+	// Setting a module replaces it, and deleting a missing module is not
+	// an error.
+	u2.IssueReference = "https://github.com/golang/vulndb/issues/1"
+	u2.IssueCreatedAt = checked
+	must(s.SetUnfixedModule(ctx, u2))(t)
+	must(s.DeleteUnfixedModule(ctx, u3.ReportID, u3.Module))(t)
+	must(s.DeleteUnfixedModule(ctx, "GO-2022-0009", "example.com/a"))(t)
+	got = must1(s.ListUnfixedModules(ctx))(t)
+	diff(t, []*UnfixedModule{u2, u1}, got)
+
+	if err := s.SetUnfixedModule(ctx, &UnfixedModule{ReportID: "GO-2022-0003", Module: "m"}); err == nil {
+		t.Error("module without check time: got nil, want error")
+	}
+}
+
+func testReportReviews(t *testing.T, s Store) {
+	ctx := context.Background()
+	updated := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	if got := must1(s.GetReportReview(ctx, "GO-2022-0001"))(t); got != nil {
+		t.Fatalf("got %+v, want nil", got)
+	}
+	r1 := &ReportReview{ReportID: "GO-2022-0002", State: ReviewStateDraft, Assignee: "alice", UpdatedAt: updated}
+	r2 := &ReportReview{ReportID: "GO-2022-0001", State: ReviewStateInReview, Assignee: "bob", UpdatedAt: updated}
+	must(s.SetReportReview(ctx, r1))(t)
+	must(s.SetReportReview(ctx, r2))(t)
+	diff(t, []*ReportReview{r2, r1}, must1(s.ListReportReviews(ctx))(t))
+
+	// Setting a review replaces it.
+	r2.State = ReviewStateApproved
+	r2.Reviewer = "carol"
+	must(s.SetReportReview(ctx, r2))(t)
+	diff(t, r2, must1(s.GetReportReview(ctx, r2.ReportID))(t))
+
+	// A transaction reads its own writes, and those of the store.
+	r3 := &ReportReview{ReportID: "GO-2022-0003", State: ReviewStateDraft, Assignee: "dave", UpdatedAt: updated}
+	must(s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+		got, err := tx.GetReportReview(r2.ReportID)
+		if err != nil {
+			return err
+		}
+		diff(t, r2, got)
+		if err := tx.SetReportReview(r3); err != nil {
+			return err
+		}
+		got, err = tx.GetReportReview(r3.ReportID)
+		if err != nil {
+			return err
+		}
+		diff(t, r3, got)
+		if err := tx.SetReportReview(&ReportReview{ReportID: "GO-2022-0004", State: ReviewStateDraft}); err == nil {
+			t.Error("SetReportReview in a transaction: got nil, want error")
+		}
+		return nil
+	}))(t)
+	diff(t, r3, must1(s.GetReportReview(ctx, r3.ReportID))(t))
+
+	for _, bad := range []*ReportReview{
+		{ReportID: "GO-2022-0005", State: ReviewStateDraft},
+		{ReportID: "GO-2022-0005", State: "done", Assignee: "alice"},
+		{ReportID: "GO-2022-0005", State: ReviewStateApproved, Assignee: "alice"},
+	} {
+		if err := s.SetReportReview(ctx, bad); err == nil {
+			t.Errorf("%+v: got nil, want error", bad)
+		}
+	}
+}
+
+func testRepoModules(t *testing.T, s Store) {
+	ctx := context.Background()
+	fetched := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	const repo = "github.com/kubernetes/kubernetes"
+	if got := must1(s.GetRepoModules(ctx, repo))(t); got != nil {
+		t.Fatalf("got %+v, want nil", got)
+	}
+	r := &RepoModules{
+		Repo: repo,
+		Modules: []*RepoModule{
+			{Dir: "", Path: "k8s.io/kubernetes"},
+			{Dir: "staging/src/k8s.io/api", Path: "k8s.io/api"},
+		},
+		FetchedAt: fetched,
+	}
+	must(s.SetRepoModules(ctx, r))(t)
+	diff(t, r, must1(s.GetRepoModules(ctx, repo))(t))
+
+	// Setting the modules of a repo replaces them.
+	r.Modules = r.Modules[:1]
+	r.FetchedAt = fetched.Add(time.Hour)
+	must(s.SetRepoModules(ctx, r))(t)
+	diff(t, r, must1(s.GetRepoModules(ctx, repo))(t))
+
+	if err := s.SetRepoModules(ctx, &RepoModules{Repo: "github.com/a/b"}); err == nil {
+		t.Error("got nil, want error for missing FetchedAt")
+	}
+}
+
+func TestRepoModulesModuleAt(t *testing.T) {
+	r := &RepoModules{Modules: []*RepoModule{
+		{Dir: "", Path: "example.com/root"},
+		{Dir: "sub", Path: "example.com/sub"},
+		{Dir: "sub/deeper", Path: "example.com/deeper"},
+	}}
+	for _, test := range []struct {
+		path, want string
+	}{
+		{"", "example.com/root"},
+		{"main.go", "example.com/root"},
+		{"sub", "example.com/sub"},
+		{"sub/x.go", "example.com/sub"},
+		{"subway/x.go", "example.com/root"},
+		{"sub/deeper/x/y.go", "example.com/deeper"},
+	} {
+		got := r.ModuleAt(test.path)
+		if got == nil || got.Path != test.want {
+			t.Errorf("ModuleAt(%q) = %+v, want %q", test.path, got, test.want)
+		}
+	}
+	if got := (&RepoModules{}).ModuleAt("x.go"); got != nil {
+		t.Errorf("got %+v, want nil", got)
+	}
+}
+
+// testConcurrency checks that concurrent transactions that read and write
+// the same record don't lose each other's writes, and that only one of
+// several owners acquires a lock at once.
+func testConcurrency(t *testing.T, s Store) {
+	ctx := context.Background()
+	const id = "CVE-2022-8001"
+	createCVERecords(t, ctx, s, []*CVERecord{{
+		ID:          id,
+		Path:        "p",
+		BlobHash:    "b",
+		CommitHash:  "c",
+		CommitTime:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		TriageState: TriageStateNoActionNeeded,
+	}})
+
+	// Firestore retries a transaction that conflicts with another only a
+	// few times, so keep the contention modest.
+	const n = 5
+	run := func(f func(i int) error) {
+		t.Helper()
+		var wg sync.WaitGroup
+		errs := make([]error, n)
+		for i := 0; i < n; i++ {
+			i := i
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = f(i)
+			}()
+		}
+		wg.Wait()
+		for _, err := range errs {
+			must(err)(t)
+		}
+	}
+
+	run(func(i int) error {
+		return s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
+			crs, err := tx.GetCVERecords(id, id)
+			if err != nil {
+				return err
+			}
+			cr := crs[0]
+			cr.ReferenceURLs = append(cr.ReferenceURLs, fmt.Sprintf("https://example.com/%d", i))
+			return tx.SetCVERecord(cr)
+		})
+	})
+	got := must1(s.GetCVERecord(ctx, id))(t)
+	if len(got.ReferenceURLs) != n {
+		t.Errorf("got %d reference URLs, want %d: %v", len(got.ReferenceURLs), n, got.ReferenceURLs)
+	}
+
+	var (
+		mu       sync.Mutex
+		acquired []string
+	)
+	run(func(i int) error {
+		owner := fmt.Sprintf("owner%d", i)
+		ok, err := s.AcquireLock(ctx, "concurrency", owner, time.Minute)
+		if ok {
+			mu.Lock()
+			acquired = append(acquired, owner)
+			mu.Unlock()
+		}
+		return err
+	})
+	if len(acquired) != 1 {
+		t.Errorf("lock acquired by %v, want one owner", acquired)
+	}
+	for _, owner := range acquired {
+		must(s.ReleaseLock(ctx, "concurrency", owner))(t)
+	}
+}
+
 func createCVERecords(t *testing.T, ctx context.Context, s Store, crs []*CVERecord) {
 	must(s.RunTransaction(ctx, func(ctx context.Context, tx Transaction) error {
 		for _, cr := range crs {
@@ -308,3 +1052,29 @@ func diff(t *testing.T, want, got interface{}, opts ...cmp.Option) {
 		t.Errorf("mismatch (-want, +got):\n%s", diff)
 	}
 }
+
+func TestCVEChangeSummary(t *testing.T) {
+	old := &CVEContent{State: "PUBLIC", Description: "d", References: []string{"a", "b"}, CVSS: "x"}
+	for _, test := range []struct {
+		new  *CVEContent
+		want string
+	}{
+		{
+			&CVEContent{State: "PUBLIC", Description: "d2", References: []string{"a", "b", "c", "e"}, CVSS: "x"},
+			"2 references added, description changed",
+		},
+		{
+			&CVEContent{State: "REJECT", Description: "d", References: []string{"b"}, CVSS: "y"},
+			"1 references removed, state PUBLIC to REJECT, CVSS changed",
+		},
+		{
+			&CVEContent{State: "PUBLIC", Description: "d", References: []string{"b", "a"}, CVSS: "x"},
+			"references reordered",
+		},
+	} {
+		c := &CVEChange{Old: old, New: test.new}
+		if got := c.Summary(); got != test.want {
+			t.Errorf("%+v: got %q, want %q", test.new, got, test.want)
+		}
+	}
+}
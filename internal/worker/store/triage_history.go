@@ -0,0 +1,125 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/event"
+)
+
+// A TriageHistoryEntry records a change to the triage state of a CVE or GHSA.
+// Entries are written by the store whenever a CVERecord or GHSARecord is
+// created or its TriageState changes, and are never modified.
+type TriageHistoryEntry struct {
+	// ID is the ID of the CVE or GHSA.
+	ID string
+	// Time is when the change was made.
+	Time time.Time
+	// Actor describes who made the change. See WithActor.
+	Actor string
+	// OldState is the triage state before the change. It is empty if the
+	// record was created, or if the record was not read in the transaction
+	// that changed it.
+	OldState TriageState
+	// NewState is the triage state after the change.
+	NewState TriageState
+	// Reason is the TriageStateReason of the record after the change.
+	Reason string
+}
+
+// DefaultActor is the actor of changes made with a context that has none.
+const DefaultActor = "worker"
+
+type actorKey struct{}
+
+// WithActor returns a context whose triage state changes are attributed to
+// actor, such as the name of the person running a command.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor returns the actor set by WithActor, or DefaultActor.
+func Actor(ctx context.Context) string {
+	if a, ok := ctx.Value(actorKey{}).(string); ok && a != "" {
+		return a
+	}
+	return DefaultActor
+}
+
+// isGHSAID reports whether id is the ID of a GHSA rather than a CVE.
+func isGHSAID(id string) bool {
+	return strings.HasPrefix(id, "GHSA-")
+}
+
+// A triageTracker remembers the triage states of the records read in a
+// transaction, so that the transaction can tell when a write changes one
+// without reading the record again. (Firestore requires all reads in a
+// transaction to come before its writes.)
+type triageTracker struct {
+	actor   string
+	states  map[string]TriageState
+	changes []*TriageHistoryEntry
+}
+
+func newTriageTracker(ctx context.Context) *triageTracker {
+	return &triageTracker{actor: Actor(ctx), states: map[string]TriageState{}}
+}
+
+// read notes the triage state of a record read in the transaction.
+func (t *triageTracker) read(id string, ts TriageState) {
+	t.states[id] = ts
+}
+
+// write notes the triage state of a record written in the transaction. It
+// returns the history entry to write, or nil if the state has not changed.
+func (t *triageTracker) write(id string, ts TriageState, reason string) *TriageHistoryEntry {
+	old, ok := t.states[id]
+	if ok && old == ts {
+		return nil
+	}
+	t.states[id] = ts
+	e := &TriageHistoryEntry{
+		ID:       id,
+		Time:     time.Now().UTC(),
+		Actor:    t.actor,
+		OldState: old,
+		NewState: ts,
+		Reason:   reason,
+	}
+	t.changes = append(t.changes, e)
+	return e
+}
+
+var transitionCounter = event.NewCounter("triage_transitions", &event.MetricOptions{
+	Namespace:   "vulndb/worker",
+	Description: "Changes to the triage states of CVEs and GHSAs.",
+})
+
+// committed records metrics for the changes written in the transaction, and
+// passes them to the TriageListener of ctx, if any. It should be called only
+// once the transaction has committed.
+func (t *triageTracker) committed(ctx context.Context) {
+	for _, e := range t.changes {
+		transitionCounter.Record(ctx, 1, event.String("from", string(e.OldState)), event.String("to", string(e.NewState)))
+	}
+	if l, ok := ctx.Value(listenerKey{}).(TriageListener); ok && len(t.changes) > 0 {
+		l(ctx, t.changes)
+	}
+}
+
+// A TriageListener is called with the changes to triage states made by a
+// transaction, after it commits.
+type TriageListener func(ctx context.Context, changes []*TriageHistoryEntry)
+
+type listenerKey struct{}
+
+// WithTriageListener returns a context whose transactions pass their changes
+// to triage states to l. It replaces any listener already in ctx.
+func WithTriageListener(ctx context.Context, l TriageListener) context.Context {
+	return context.WithValue(ctx, listenerKey{}, l)
+}
@@ -0,0 +1,195 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// summaryPeriod is the period that a triage summary covers.
+const summaryPeriod = 7 * 24 * time.Hour
+
+// A TriageSummary summarizes the triage work of a week, replacing
+// hand-written status updates.
+type TriageSummary struct {
+	// Start and End bound the week: it includes Start, but not End.
+	Start, End time.Time
+	// Number of CVEs that updates from the cvelist repo added to the store.
+	NumCVEsAdded int
+	// IDs of the CVEs and GHSAs whose issues were created, in order.
+	IssuesFiled []string
+	// IDs of the reports whose reviews were approved, in order.
+	ReportsApproved []string
+	// IDs of the reports that were published, in order, if the summary
+	// had the vulndb repo. Only reports with published dates count.
+	ReportsPublished []string
+	// Queues are the triage queues at End.
+	Queues AgingStats
+}
+
+// PostTriageSummary summarizes the triage of the week before now from the
+// records in st, and the reports in the vulndb repo at repoPath, if it is
+// not empty, and posts the summary as a comment on the issue in ic with the
+// given number.
+func PostTriageSummary(ctx context.Context, st store.Store, ic issues.Client, issueNumber int, repoPath string) (_ *TriageSummary, err error) {
+	defer derrors.Wrap(&err, "PostTriageSummary(%d, %q)", issueNumber, repoPath)
+
+	var reports []*GoReport
+	if repoPath != "" {
+		reports, err = LoadGoReports(ctx, repoPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return postTriageSummary(ctx, st, ic, issueNumber, reports, time.Now())
+}
+
+func postTriageSummary(ctx context.Context, st store.Store, ic issues.Client, issueNumber int, reports []*GoReport, now time.Time) (_ *TriageSummary, err error) {
+	ctx = event.Start(ctx, "postTriageSummary")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, nil, "triage-summary")
+	defer func() { end(err) }()
+
+	s, err := summarizeTriage(ctx, st, reports, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := ic.CreateComment(ctx, issueNumber, s.Markdown()); err != nil {
+		return nil, err
+	}
+	log.Infof(ctx, "posted triage summary on %s: %d CVEs added, %d issues filed, %d reports approved, %d published",
+		ic.Reference(issueNumber), s.NumCVEsAdded, len(s.IssuesFiled), len(s.ReportsApproved), len(s.ReportsPublished))
+	return s, nil
+}
+
+// summarizeTriage returns the summary of the week before now.
+func summarizeTriage(ctx context.Context, st store.Store, reports []*GoReport, now time.Time) (*TriageSummary, error) {
+	s := &TriageSummary{Start: now.Add(-summaryPeriod), End: now}
+	inWeek := func(t time.Time) bool {
+		return !t.Before(s.Start) && t.Before(s.End)
+	}
+
+	urs, err := updatesSince(ctx, st, s.Start)
+	if err != nil {
+		return nil, err
+	}
+	for _, ur := range urs {
+		if inWeek(ur.StartedAt) {
+			s.NumCVEsAdded += ur.NumAdded
+		}
+	}
+
+	// A record whose issue was created may have moved on to another state
+	// since.
+	for _, ts := range []store.TriageState{
+		store.TriageStateIssueCreated,
+		store.TriageStateUpdatedSinceIssueCreation,
+		store.TriageStateHasVuln,
+	} {
+		crs, _, err := st.ListCVERecords(ctx, store.CVERecordQuery{TriageState: ts})
+		if err != nil {
+			return nil, err
+		}
+		for _, cr := range crs {
+			if inWeek(cr.IssueCreatedAt) {
+				s.IssuesFiled = append(s.IssuesFiled, cr.ID)
+			}
+		}
+	}
+	grs, err := getGHSARecords(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	for _, gr := range grs {
+		if inWeek(gr.IssueCreatedAt) {
+			s.IssuesFiled = append(s.IssuesFiled, gr.GHSA.ID)
+		}
+	}
+	sort.Strings(s.IssuesFiled)
+
+	rvs, err := st.ListReportReviews(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rv := range rvs {
+		if rv.State == store.ReviewStateApproved && inWeek(rv.UpdatedAt) {
+			s.ReportsApproved = append(s.ReportsApproved, rv.ReportID)
+		}
+	}
+	for _, r := range reports {
+		if !r.Excluded && inWeek(r.Published) {
+			s.ReportsPublished = append(s.ReportsPublished, r.ID)
+		}
+	}
+	sort.Strings(s.ReportsPublished)
+
+	s.Queues, err = triageQueues(ctx, st, now)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// updatesSince returns the CommitUpdateRecords in st that started at or
+// after start, and maybe some before.
+func updatesSince(ctx context.Context, st store.Store, start time.Time) ([]*store.CommitUpdateRecord, error) {
+	for limit := 100; ; limit *= 2 {
+		urs, err := st.ListCommitUpdateRecords(ctx, limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(urs) < limit || urs[len(urs)-1].StartedAt.Before(start) {
+			return urs, nil
+		}
+	}
+}
+
+// Markdown returns the summary as Markdown, for an issue comment.
+func (s *TriageSummary) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Triage summary: %s to %s\n\n", s.Start.Format("2006-01-02"), s.End.Format("2006-01-02"))
+	fmt.Fprintf(&b, "- CVEs ingested: %d\n", s.NumCVEsAdded)
+	fmt.Fprintf(&b, "- Issues filed: %d\n", len(s.IssuesFiled))
+	fmt.Fprintf(&b, "- Reports approved: %d\n", len(s.ReportsApproved))
+	fmt.Fprintf(&b, "- Reports published: %d\n", len(s.ReportsPublished))
+	fmt.Fprintf(&b, "- Needing issues: %d, %d for more than %d days\n",
+		s.Queues.NeedsIssue.Size, len(s.Queues.NeedsIssue.Overdue), int(needsIssueSLA.Hours()/24))
+	fmt.Fprintf(&b, "- Issues without reports: %d, %d for more than %d days\n",
+		s.Queues.IssueOpen.Size, len(s.Queues.IssueOpen.Overdue), int(issueOpenSLA.Hours()/24))
+	for _, l := range []struct {
+		title string
+		ids   []string
+	}{
+		{"Reports published", s.ReportsPublished},
+		{"Reports approved", s.ReportsApproved},
+		{"Overdue for an issue, oldest first", s.Queues.NeedsIssue.Overdue},
+		{"Overdue for a report, oldest first", s.Queues.IssueOpen.Overdue},
+	} {
+		if len(l.ids) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n### %s\n\n", l.title)
+		ids := l.ids
+		if len(ids) > maxListedIDs {
+			ids = ids[:maxListedIDs]
+		}
+		b.WriteString(strings.Join(ids, ", "))
+		if len(l.ids) > len(ids) {
+			fmt.Fprintf(&b, " and %d more", len(l.ids)-len(ids))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestPostTriageSummary(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	now := time.Now()
+	day := 24 * time.Hour
+
+	// Only the update in the last week counts.
+	for _, ur := range []*store.CommitUpdateRecord{
+		{StartedAt: now.Add(-8 * day), CommitHash: "abc", NumAdded: 5},
+		{StartedAt: now.Add(-2 * day), CommitHash: "def", NumAdded: 3},
+	} {
+		if err := mstore.CreateCommitUpdateRecord(ctx, ur); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cve := func(id string, ts store.TriageState, issueCreatedAt time.Time) *store.CVERecord {
+		return &store.CVERecord{
+			ID:             id,
+			Path:           id + ".json",
+			BlobHash:       "abc",
+			CommitHash:     "123",
+			CommitTime:     now,
+			TriageState:    ts,
+			IssueCreatedAt: issueCreatedAt,
+		}
+	}
+	err := mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		for _, cr := range []*store.CVERecord{
+			cve("CVE-2022-0001", store.TriageStateIssueCreated, now.Add(-1*day)),
+			cve("CVE-2022-0002", store.TriageStateHasVuln, now.Add(-3*day)),
+			cve("CVE-2022-0003", store.TriageStateIssueCreated, now.Add(-10*day)),
+			cve("CVE-2022-0004", store.TriageStateNeedsIssue, time.Time{}),
+		} {
+			if err := tx.CreateCVERecord(cr); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rv := range []*store.ReportReview{
+		{ReportID: "GO-2022-0001", State: store.ReviewStateApproved, Assignee: "alice", Reviewer: "bob", UpdatedAt: now.Add(-day)},
+		{ReportID: "GO-2022-0002", State: store.ReviewStateInReview, Assignee: "alice", UpdatedAt: now.Add(-day)},
+		{ReportID: "GO-2022-0003", State: store.ReviewStateApproved, Assignee: "alice", Reviewer: "bob", UpdatedAt: now.Add(-9 * day)},
+	} {
+		if err := mstore.SetReportReview(ctx, rv); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reports := []*GoReport{
+		{ID: "GO-2022-0001", Published: now.Add(-day)},
+		{ID: "GO-2022-0003", Published: now.Add(-9 * day)},
+		{ID: "GO-2022-0004"},
+		{ID: "GO-2022-0005", Excluded: true, Published: now.Add(-day)},
+	}
+
+	fc := issues.NewFakeClient()
+	number, err := fc.CreateIssue(ctx, &issues.Issue{Title: "Triage summaries"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ic := issues.NewDryRunClient(fc)
+	got, err := postTriageSummary(ctx, mstore, ic, number, reports, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &TriageSummary{
+		Start:            now.Add(-7 * day),
+		End:              now,
+		NumCVEsAdded:     3,
+		IssuesFiled:      []string{"CVE-2022-0001", "CVE-2022-0002"},
+		ReportsApproved:  []string{"GO-2022-0001"},
+		ReportsPublished: []string{"GO-2022-0001"},
+		Queues: AgingStats{
+			NeedsIssue: QueueAge{Size: 1},
+			IssueOpen:  QueueAge{Size: 2},
+		},
+	}
+	// The age of CVE-2022-0004 depends on when it was created.
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(QueueAge{}, "Oldest")); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+
+	cs := ic.Commented()
+	if len(cs) != 1 || cs[0].Number != number {
+		t.Fatalf("got %d comments, want 1 on issue %d", len(cs), number)
+	}
+	for _, line := range []string{
+		"- CVEs ingested: 3\n",
+		"- Issues filed: 2\n",
+		"- Issues without reports: 2, 0 for more than 30 days\n",
+		"### Reports published\n\nGO-2022-0001\n",
+	} {
+		if !strings.Contains(cs[0].Body, line) {
+			t.Errorf("comment is missing %q:\n%s", line, cs[0].Body)
+		}
+	}
+}
@@ -12,34 +12,43 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"golang.org/x/exp/event"
+	"golang.org/x/exp/slices"
+	"golang.org/x/mod/module"
 	"golang.org/x/time/rate"
+	"golang.org/x/vulndb/internal/cpe"
 	"golang.org/x/vulndb/internal/cveschema"
 	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/report"
 	"golang.org/x/vulndb/internal/stdlib"
 	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
 )
 
 var errCVEVersionUnsupported = errors.New("unsupported CVE version")
 
-// stdlibReferenceDataKeywords are words found in the reference data URL that
-// indicate the CVE is about the standard library or a Go x-repo owned by the
-// Go team.
-var stdlibReferenceDataKeywords = []string{
-	"github.com/golang",
-	"golang.org",
-	// from https://groups.google.com/g/golang-announce.
-	"golang-announce",
-	// from https://groups.google.com/g/golang-nuts.
-	"golang-nuts",
-}
-
 const unknownPath = "Path is unknown"
 
 // TriageCVE reports whether the CVE refers to a Go module.
 func TriageCVE(ctx context.Context, c *cveschema.CVE, pkgsiteURL string) (_ *triageResult, err error) {
 	defer derrors.Wrap(&err, "triageCVE(%q)", c.ID)
+	ctx = event.Start(ctx, "TriageCVE")
+	defer event.End(ctx)
+	observe.SetSpanAttribute(ctx, "cve", c.ID)
+	defer func() { observe.SetSpanError(ctx, err) }()
+
+	r, err := applyTriageRules(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if r != nil {
+		log.Debugf(ctx, "Triage result for %s: %s", c.ID, r.reason)
+		return r, nil
+	}
 	switch c.DataVersion {
 	case "4.0":
 		return triageV4CVE(ctx, c, pkgsiteURL)
@@ -53,31 +62,23 @@ type triageResult struct {
 	modulePath  string
 	packagePath string
 	reason      string
-}
-
-// gopkgHosts are hostnames for popular Go package websites.
-var gopkgHosts = map[string]bool{
-	"godoc.org":  true,
-	"pkg.go.dev": true,
-}
-
-const snykIdentifier = "snyk.io/vuln/SNYK-GOLANG"
-
-// nonGoModules are paths that return a 200 on pkg.go.dev, but do not contain
-// Go code. However, these libraries often have CVEs that are false positive for
-// a Go vuln.
-var notGoModules = map[string]bool{
-	"github.com/channelcat/sanic":            true, // python library
-	"github.com/rapid7/metasploit-framework": true, // ruby library
-	"github.com/tensorflow/tensorflow":       true, // python library
-	"gitweb.gentoo.org/repo/gentoo.git":      true, // ebuild
-	"qpid.apache.org":                        true, // C, python, & Java library
-
-	// vulnerability in tool, not importable package
-	"github.com/grafana/grafana":          true,
-	"github.com/sourcegraph/sourcegraph":  true,
-	"gitlab.com/gitlab-org/gitlab-runner": true,
-	"github.com/gravitational/teleport":   true,
+	// falsePositive is true if a triage rule decided that the CVE looks
+	// relevant to Go but is not. The other fields, except reason, are empty.
+	falsePositive bool
+	// notModule is true if the references of the CVE contain candidate
+	// module paths, but none of them exists in the module proxy. The other
+	// fields, except reason, are empty.
+	notModule bool
+	// goScore is the score of the CVE from the triage classifier, for a
+	// result that needs an issue.
+	goScore float64
+	// candidates are the modules of a repo with several that the CVE's
+	// fixes change, most likely first. If there are any, modulePath is the
+	// first.
+	candidates []*store.CandidateModule
+	// excluded is the reason, if any, for which the report of the CVE
+	// should be excluded, as for a Go program that is not importable.
+	excluded report.ExcludedReason
 }
 
 // triageV4CVE triages a CVE following schema v4.0 and returns the result.
@@ -92,8 +93,18 @@ func triageV4CVE(ctx context.Context, c *cveschema.CVE, pkgsiteURL string) (resu
 			log.Debugf(ctx, "%s: not Go vuln", msg)
 			return
 		}
+		if result.notModule {
+			log.Debugf(ctx, "%s: not Go vuln (%s)", msg, result.reason)
+			return
+		}
 		log.Debugf(ctx, "%s: is Go vuln (%s)", msg, result.reason)
 	}()
+	paths, err := loadTriagePaths()
+	if err != nil {
+		return nil, err
+	}
+	// Candidate module paths that pkgsite doesn't know.
+	var unknown []string
 	for _, r := range c.References.Data {
 		if r.URL == "" {
 			continue
@@ -110,7 +121,7 @@ func triageV4CVE(ctx context.Context, c *cveschema.CVE, pkgsiteURL string) (resu
 				reason:      fmt.Sprintf("Reference data URL %q contains path %q", r.URL, mp),
 			}, nil
 		}
-		if gopkgHosts[refURL.Host] {
+		if paths.isPackageHost(refURL.Host) {
 			mp := strings.TrimPrefix(refURL.Path, "/")
 			if stdlib.Contains(mp) {
 				return &triageResult{
@@ -125,14 +136,60 @@ func triageV4CVE(ctx context.Context, c *cveschema.CVE, pkgsiteURL string) (resu
 			}, nil
 		}
 		modpaths := candidateModulePaths(refURL.Host + refURL.Path)
+		if refURL.Host == "github.com" && len(modpaths) > 0 {
+			// The shortest candidate is the repo. Its go.mod files, if any,
+			// say whether it is a Go module and what the module paths are,
+			// which may not match the repo URL.
+			repoPath := modpaths[len(modpaths)-1]
+			var rm *store.RepoModules
+			if !paths.isNotGoModule(repoPath) {
+				m, err := githubRepoModules(ctx, repoPath)
+				if err != nil {
+					// GitHub may be down, or limiting our requests; pkgsite
+					// may still know the module.
+					log.Warningf(ctx, "%v; looking for the module in pkgsite instead", err)
+				} else {
+					rm = m
+				}
+			}
+			if rm != nil {
+				dir := githubFileDir(refURL.Path)
+				m := rm.ModuleAt(dir)
+				if (m == nil || m.Dir == "") && len(rm.Modules) > 1 {
+					// The URL doesn't pick one of the repo's modules; the
+					// files that the fixes change may.
+					if cs := candidateModules(ctx, rm, c.References.Data); len(cs) > 0 && !paths.isNotGoModule(cs[0].Path) {
+						return &triageResult{
+							modulePath: cs[0].Path,
+							candidates: cs,
+							reason: fmt.Sprintf("Reference data URL %q is in repo %q, whose module %q has %.0f%% of the files changed by the fix",
+								r.URL, repoPath, cs[0].Path, 100*cs[0].Confidence),
+						}, nil
+					}
+				}
+				if m != nil && !paths.isNotGoModule(m.Path) {
+					reason := fmt.Sprintf("Reference data URL %q is in repo %q, whose go.mod declares module %q", r.URL, repoPath, m.Path)
+					if m.Dir != "" {
+						reason = fmt.Sprintf("Reference data URL %q is in directory %q of repo %q, whose go.mod declares module %q", r.URL, m.Dir, repoPath, m.Path)
+					}
+					return &triageResult{
+						modulePath: m.Path,
+						reason:     reason,
+					}, nil
+				}
+			}
+		}
 		for _, mp := range modpaths {
-			if notGoModules[mp] {
+			if paths.isNotGoModule(mp) {
 				continue
 			}
 			known, err := knownToPkgsite(ctx, pkgsiteURL, mp)
 			if err != nil {
 				return nil, err
 			}
+			if !known && !slices.Contains(unknown, mp) {
+				unknown = append(unknown, mp)
+			}
 			if known {
 				u := pkgsiteURL + "/" + mp
 				return &triageResult{
@@ -148,16 +205,18 @@ func triageV4CVE(ctx context.Context, c *cveschema.CVE, pkgsiteURL string) (resu
 	for _, r := range c.References.Data {
 		// Example CVE containing snyk.io URL:
 		// https://github.com/CVEProject/cvelist/blob/899bba20d62eb73e04d1841a5ff04cd6225e1618/2020/7xxx/CVE-2020-7668.json#L52.
-		if strings.Contains(r.URL, snykIdentifier) {
-			return &triageResult{
-				modulePath: unknownPath,
-				reason:     fmt.Sprintf("Reference data URL %q contains %q", r.URL, snykIdentifier),
-			}, nil
+		for _, k := range paths.GoVulnKeywords {
+			if strings.Contains(r.URL, k) {
+				return &triageResult{
+					modulePath: unknownPath,
+					reason:     fmt.Sprintf("Reference data URL %q contains %q", r.URL, k),
+				}, nil
+			}
 		}
 
 		// Check for reference data indicating that this is related to the Go
 		// project.
-		for _, k := range stdlibReferenceDataKeywords {
+		for _, k := range paths.GoProjectKeywords {
 			if strings.Contains(r.URL, k) {
 				return &triageResult{
 					modulePath: stdlib.ModulePath,
@@ -166,9 +225,114 @@ func triageV4CVE(ctx context.Context, c *cveschema.CVE, pkgsiteURL string) (resu
 			}
 		}
 	}
+
+	// Check the CPE names of the products that the NVD's configurations
+	// list as vulnerable.
+	result, err = triageCPEs(ctx, c, paths, pkgsiteURL)
+	if result != nil || err != nil {
+		return result, err
+	}
+	return notModuleResult(ctx, unknown)
+}
+
+// triageCPEs returns a result for c from the CPE names of its vulnerable
+// products, or nil if none of them is a Go product.
+func triageCPEs(ctx context.Context, c *cveschema.CVE, paths *TriagePaths, pkgsiteURL string) (*triageResult, error) {
+	for _, m := range c.VulnerableCPEs() {
+		n, err := cpe.Parse(m.CPE23URI)
+		if err != nil {
+			log.Warningf(ctx, "%s: %v", c.ID, err)
+			continue
+		}
+		if !n.IsApplication() {
+			continue
+		}
+		if paths.isGoProjectCPEVendor(n.Vendor) {
+			// Products of the Go project other than Go itself are
+			// golang.org/x repos, mostly of the same name.
+			if n.Product != "go" {
+				mp := "golang.org/x/" + n.Product
+				known, err := knownToPkgsite(ctx, pkgsiteURL, mp)
+				if err != nil {
+					return nil, err
+				}
+				if known {
+					return &triageResult{
+						modulePath: mp,
+						reason:     fmt.Sprintf("CPE %q names product %q of the Go project", m.CPE23URI, n.Product),
+					}, nil
+				}
+			}
+			return &triageResult{
+				modulePath: stdlib.ModulePath,
+				reason:     fmt.Sprintf("CPE %q has vendor %q", m.CPE23URI, n.Vendor),
+			}, nil
+		}
+		if paths.isGoCPETargetSW(n.TargetSW) {
+			// Go products are often in GitHub repos named for their
+			// vendors and products.
+			mp := "github.com/" + n.Vendor + "/" + n.Product
+			if module.CheckPath(mp) == nil && !paths.isNotGoModule(mp) {
+				known, err := knownToPkgsite(ctx, pkgsiteURL, mp)
+				if err != nil {
+					return nil, err
+				}
+				if known {
+					return &triageResult{
+						modulePath: mp,
+						reason:     fmt.Sprintf("CPE %q has target software %q, and %q is a module", m.CPE23URI, n.TargetSW, mp),
+					}, nil
+				}
+			}
+			return &triageResult{
+				modulePath: unknownPath,
+				reason:     fmt.Sprintf("CPE %q has target software %q", m.CPE23URI, n.TargetSW),
+			}, nil
+		}
+	}
 	return nil, nil
 }
 
+// triageProxy is the module proxy that triage looks for candidate module
+// paths in.
+var triageProxy = newProxyClient(proxyURL)
+
+// maxNotModuleEvidence is the maximum number of module paths whose proxy
+// responses are listed in the reason for a notModule result.
+const maxNotModuleEvidence = 5
+
+// notModuleResult returns a notModule result if none of the candidate module
+// paths exists in the module proxy, and nil otherwise.
+func notModuleResult(ctx context.Context, candidates []string) (*triageResult, error) {
+	seenModulePathMu.Lock()
+	complete := cacheComplete
+	seenModulePathMu.Unlock()
+	// With a complete list of known modules, triage makes no requests.
+	if len(candidates) == 0 || complete {
+		return nil, nil
+	}
+	var evidence []string
+	for _, mp := range candidates {
+		pc, err := triageProxy.check(ctx, mp)
+		if err != nil {
+			return nil, err
+		}
+		if pc.exists {
+			return nil, nil
+		}
+		if len(evidence) < maxNotModuleEvidence {
+			evidence = append(evidence, pc.evidence)
+		}
+	}
+	if n := len(candidates) - len(evidence); n > 0 {
+		evidence = append(evidence, fmt.Sprintf("and %d more", n))
+	}
+	return &triageResult{
+		notModule: true,
+		reason:    fmt.Sprintf("No candidate module path is in the module proxy (%s)", strings.Join(evidence, "; ")),
+	}, nil
+}
+
 // Limit pkgsite requests to this many per second.
 const pkgsiteQPS = 5
 
@@ -178,8 +342,10 @@ var (
 	// basically lets you exceed the rate briefly.
 	pkgsiteRateLimiter = rate.NewLimiter(rate.Every(time.Duration(1000/float64(pkgsiteQPS))*time.Millisecond), 3)
 
-	// Cache of module paths already seen.
-	seenModulePath = map[string]bool{}
+	// Cache of module paths already seen. Updates triage CVEs
+	// concurrently, so it is guarded by seenModulePathMu.
+	seenModulePathMu sync.Mutex
+	seenModulePath   = map[string]bool{}
 	// Does seenModulePath contain all known modules?
 	cacheComplete = false
 )
@@ -187,6 +353,8 @@ var (
 // SetKnownModules provides a list of all known modules,
 // so that no requests need to be made to pkg.go.dev.
 func SetKnownModules(mods []string) {
+	seenModulePathMu.Lock()
+	defer seenModulePathMu.Unlock()
 	for _, m := range mods {
 		seenModulePath[m] = true
 	}
@@ -197,12 +365,19 @@ func SetKnownModules(mods []string) {
 // to a module.
 func knownToPkgsite(ctx context.Context, baseURL, modulePath string) (bool, error) {
 	// If we've seen it before, no need to call.
-	if b, ok := seenModulePath[modulePath]; ok {
+	seenModulePathMu.Lock()
+	b, ok := seenModulePath[modulePath]
+	complete := cacheComplete
+	seenModulePathMu.Unlock()
+	if ok {
 		return b, nil
 	}
-	if cacheComplete {
+	if complete {
 		return false, nil
 	}
+	ctx = event.Start(ctx, "knownToPkgsite")
+	defer event.End(ctx)
+	observe.SetSpanAttribute(ctx, "module", modulePath)
 	// Pause to maintain a max QPS.
 	if err := pkgsiteRateLimiter.Wait(ctx); err != nil {
 		return false, err
@@ -224,6 +399,8 @@ func knownToPkgsite(ctx context.Context, baseURL, modulePath string) (bool, erro
 		return false, err
 	}
 	known := res.StatusCode == http.StatusOK
+	seenModulePathMu.Lock()
 	seenModulePath[modulePath] = known
+	seenModulePathMu.Unlock()
 	return known, nil
 }
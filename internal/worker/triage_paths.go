@@ -0,0 +1,188 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/vulndb/internal/derrors"
+	"gopkg.in/yaml.v3"
+)
+
+// TriagePathsFile is the path of the file of hosts and paths that the
+// built-in triage heuristics use, relative to the root of the vulndb repo.
+const TriagePathsFile = "internal/worker/triage_paths.yaml"
+
+// triagePathsVersion is the version of the triage paths file format.
+// It must be incremented whenever the format changes incompatibly.
+const triagePathsVersion = 1
+
+// The contents of TriagePathsFile, embedded so the worker always triages with
+// the paths that were checked in alongside its code.
+//
+//go:embed triage_paths.yaml
+var triagePathsData []byte
+
+// TriagePaths are the hosts and paths that the built-in triage heuristics
+// treat as signs that a CVE is, or is not, about Go.
+type TriagePaths struct {
+	Version int `yaml:"version"`
+	// PackageHosts are the hosts of Go package documentation sites.
+	PackageHosts []string `yaml:"package_hosts"`
+	// GoProjectKeywords are substrings of reference URLs that mean a CVE is
+	// about the Go project.
+	GoProjectKeywords []string `yaml:"go_project_keywords"`
+	// GoVulnKeywords are substrings of reference URLs that mean a CVE is
+	// about Go, in an unknown module.
+	GoVulnKeywords []string `yaml:"go_vuln_keywords"`
+	// NotGoModules are paths whose CVEs are not about importable Go code.
+	NotGoModules []*NotGoModule `yaml:"not_go_modules"`
+	// GoProjectCPEVendors are the vendors in CPE names of products of the
+	// Go project.
+	GoProjectCPEVendors []string `yaml:"go_project_cpe_vendors"`
+	// GoCPETargetSW are the target software in CPE names of products
+	// written in Go.
+	GoCPETargetSW []string `yaml:"go_cpe_target_software"`
+
+	packageHosts        map[string]bool
+	notGoModules        map[string]bool
+	goProjectCPEVendors map[string]bool
+	goCPETargetSW       map[string]bool
+}
+
+// A NotGoModule is a path whose CVEs are not about importable Go code.
+type NotGoModule struct {
+	Path string `yaml:"path"`
+	// Reason says why the path is not a Go module, for reviewers.
+	Reason string `yaml:"reason"`
+}
+
+// ParseTriagePaths parses the contents of a triage paths file and validates
+// it.
+func ParseTriagePaths(data []byte) (_ *TriagePaths, err error) {
+	defer derrors.Wrap(&err, "ParseTriagePaths")
+
+	d := yaml.NewDecoder(bytes.NewReader(data))
+	d.KnownFields(true)
+	var tp TriagePaths
+	if err := d.Decode(&tp); err != nil {
+		return nil, fmt.Errorf("yaml.Decode: %v", err)
+	}
+	if tp.Version != triagePathsVersion {
+		return nil, fmt.Errorf("version is %d, want %d", tp.Version, triagePathsVersion)
+	}
+	if err := tp.init(); err != nil {
+		return nil, err
+	}
+	return &tp, nil
+}
+
+// init validates tp and builds its lookup tables.
+func (tp *TriagePaths) init() (err error) {
+	if len(tp.PackageHosts) == 0 || len(tp.GoProjectKeywords) == 0 {
+		return errors.New("package_hosts and go_project_keywords must not be empty")
+	}
+	if tp.packageHosts, err = checkTriagePaths("package_hosts", tp.PackageHosts); err != nil {
+		return err
+	}
+	for _, h := range tp.PackageHosts {
+		if strings.Contains(h, "/") {
+			return fmt.Errorf("package_hosts: %q is not a host", h)
+		}
+	}
+	if _, err := checkTriagePaths("go_project_keywords", tp.GoProjectKeywords); err != nil {
+		return err
+	}
+	if _, err := checkTriagePaths("go_vuln_keywords", tp.GoVulnKeywords); err != nil {
+		return err
+	}
+	if tp.goProjectCPEVendors, err = checkTriagePaths("go_project_cpe_vendors", tp.GoProjectCPEVendors); err != nil {
+		return err
+	}
+	if tp.goCPETargetSW, err = checkTriagePaths("go_cpe_target_software", tp.GoCPETargetSW); err != nil {
+		return err
+	}
+	var paths []string
+	for _, m := range tp.NotGoModules {
+		if m.Reason == "" {
+			return fmt.Errorf("not_go_modules: %q has no reason", m.Path)
+		}
+		paths = append(paths, m.Path)
+	}
+	tp.notGoModules, err = checkTriagePaths("not_go_modules", paths)
+	return err
+}
+
+// checkTriagePaths checks the entries of the list with the given name, and
+// returns them as a set.
+func checkTriagePaths(name string, entries []string) (map[string]bool, error) {
+	set := map[string]bool{}
+	for _, e := range entries {
+		switch {
+		case e == "" || strings.TrimSpace(e) != e:
+			return nil, fmt.Errorf("%s: bad entry %q", name, e)
+		case strings.Contains(e, "://"):
+			return nil, fmt.Errorf("%s: %q has a scheme", name, e)
+		case set[e]:
+			return nil, fmt.Errorf("%s: duplicate entry %q", name, e)
+		}
+		set[e] = true
+	}
+	return set, nil
+}
+
+// isPackageHost reports whether host is a Go package documentation site.
+func (tp *TriagePaths) isPackageHost(host string) bool {
+	return tp.packageHosts[host]
+}
+
+// isNotGoModule reports whether CVEs about path are not about Go code.
+func (tp *TriagePaths) isNotGoModule(path string) bool {
+	return tp.notGoModules[path]
+}
+
+// isGoProjectCPEVendor reports whether vendor is the vendor of the Go
+// project's products in CPE names.
+func (tp *TriagePaths) isGoProjectCPEVendor(vendor string) bool {
+	return tp.goProjectCPEVendors[strings.ToLower(vendor)]
+}
+
+// isGoCPETargetSW reports whether a CPE name with target software sw names
+// a product written in Go.
+func (tp *TriagePaths) isGoCPETargetSW(sw string) bool {
+	return tp.goCPETargetSW[strings.ToLower(sw)]
+}
+
+// ReadTriagePaths reads and validates the triage paths file at filename.
+func ReadTriagePaths(filename string) (_ *TriagePaths, err error) {
+	defer derrors.Wrap(&err, "ReadTriagePaths(%q)", filename)
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTriagePaths(data)
+}
+
+var (
+	triagePathsOnce sync.Once
+	triagePaths     *TriagePaths
+	triagePathsErr  error
+)
+
+// loadTriagePaths parses and validates the embedded triage paths file the
+// first time it is called, and returns the result on every call.
+func loadTriagePaths() (*TriagePaths, error) {
+	triagePathsOnce.Do(func() {
+		triagePaths, triagePathsErr = ParseTriagePaths(triagePathsData)
+	})
+	return triagePaths, triagePathsErr
+}
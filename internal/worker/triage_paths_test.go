@@ -0,0 +1,153 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+func TestParseTriagePaths(t *testing.T) {
+	// The embedded file must be valid.
+	if _, err := loadTriagePaths(); err != nil {
+		t.Fatal(err)
+	}
+
+	const valid = `
+version: 1
+package_hosts: [pkg.go.dev]
+go_project_keywords: [golang.org]
+go_project_cpe_vendors: [golang]
+go_cpe_target_software: [go]
+not_go_modules:
+  - path: github.com/example/tool
+    reason: tool
+`
+	tp, err := ParseTriagePaths([]byte(valid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tp.isPackageHost("pkg.go.dev") || !tp.isNotGoModule("github.com/example/tool") || tp.isNotGoModule("github.com/example/lib") {
+		t.Errorf("got %+v, want lookups to match the file", tp)
+	}
+	if !tp.isGoProjectCPEVendor("Golang") || !tp.isGoCPETargetSW("go") || tp.isGoCPETargetSW("python") {
+		t.Errorf("got %+v, want CPE lookups to match the file", tp)
+	}
+
+	for _, test := range []struct {
+		name, data, want string
+	}{
+		{"version", strings.Replace(valid, "version: 1", "version: 2", 1), "version is 2"},
+		{"unknown field", valid + "paths: []\n", "not found"},
+		{"no hosts", strings.Replace(valid, "[pkg.go.dev]", "[]", 1), "must not be empty"},
+		{"host with path", strings.Replace(valid, "[pkg.go.dev]", "[pkg.go.dev/std]", 1), "not a host"},
+		{"scheme", strings.Replace(valid, "[golang.org]", "['https://golang.org']", 1), "has a scheme"},
+		{"duplicate", strings.Replace(valid, "[golang.org]", "[golang.org, golang.org]", 1), "duplicate"},
+		{"duplicate CPE target", strings.Replace(valid, "[go]", "[go, go]", 1), "duplicate"},
+		{"no reason", strings.Replace(valid, "reason: tool", "reason: ''", 1), "no reason"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseTriagePaths([]byte(test.data))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got %v, want error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+// TestTriageKnownCVEs checks that the built-in heuristics, with the paths in
+// the triage paths file, still triage known CVEs as they did when the file
+// was written. If an edit to the file changes the triage of one of these on
+// purpose, update the test.
+func TestTriageKnownCVEs(t *testing.T) {
+	ctx := context.Background()
+	url := getPkgsiteURL(t)
+	useFakeGitHub(t, map[string]string{
+		"prometheus/client_golang": "module github.com/prometheus/client_golang\n\ngo 1.13\n",
+		"grafana/grafana":          "module github.com/grafana/grafana\n\ngo 1.17\n",
+	}, nil)
+	useFakeProxy(t, nil)
+
+	for _, test := range []struct {
+		id   string
+		refs []string
+		want *triageResult
+	}{
+		{
+			// A vulnerability in net/http, announced on golang-announce.
+			"CVE-2022-27664",
+			[]string{"https://groups.google.com/g/golang-announce/c/x49AQzIVX-s"},
+			&triageResult{modulePath: stdlib.ModulePath},
+		},
+		{
+			// A vulnerability in a package documented on pkg.go.dev.
+			"CVE-2022-32148",
+			[]string{"https://pkg.go.dev/net/http/httputil"},
+			&triageResult{modulePath: stdlib.ModulePath, packagePath: "net/http/httputil"},
+		},
+		{
+			// A vulnerability in a module documented on godoc.org.
+			"CVE-2020-29529",
+			[]string{"https://godoc.org/github.com/hashicorp/go-slug"},
+			&triageResult{modulePath: "github.com/hashicorp/go-slug"},
+		},
+		{
+			// A vulnerability in the standard library, with a Go issue.
+			"CVE-2022-41717",
+			[]string{"https://github.com/golang/go/issues/56350"},
+			&triageResult{modulePath: stdlib.ModulePath},
+		},
+		{
+			// A Go vulnerability known only from Snyk.
+			"CVE-2020-7668",
+			[]string{"https://snyk.io/vuln/SNYK-GOLANG-GITHUBCOMUNKNWONCAE-174525"},
+			&triageResult{modulePath: unknownPath},
+		},
+		{
+			// A Go module in a GitHub repo.
+			"CVE-2022-21698",
+			[]string{"https://github.com/prometheus/client_golang/security/advisories/GHSA-cg3q-j54f-5p7p"},
+			&triageResult{modulePath: "github.com/prometheus/client_golang"},
+		},
+		{
+			// A tool written in Go, with a go.mod file, whose CVEs are not
+			// about importable code.
+			"CVE-2021-43798",
+			[]string{"https://github.com/grafana/grafana/security/advisories/GHSA-8pjx-jj86-j47p"},
+			&triageResult{notModule: true},
+		},
+		{
+			// A Python library.
+			"CVE-2021-37635",
+			[]string{"https://github.com/tensorflow/tensorflow/security/advisories/GHSA-9c78-vcq7-7vxq"},
+			&triageResult{notModule: true},
+		},
+	} {
+		t.Run(test.id, func(t *testing.T) {
+			c := &cveschema.CVE{DataVersion: "4.0", Metadata: cveschema.Metadata{ID: test.id}}
+			for _, r := range test.refs {
+				c.References.Data = append(c.References.Data, cveschema.Reference{URL: r})
+			}
+			got, err := TriageCVE(ctx, c, url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got,
+				cmp.AllowUnexported(triageResult{}),
+				cmpopts.IgnoreFields(triageResult{}, "reason")); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/log"
+	"gopkg.in/yaml.v3"
+)
+
+// A TriageRuleAction is what happens to a CVE that matches a triage rule.
+type TriageRuleAction string
+
+const (
+	// The CVE needs an issue. If the rule has a module, it is the affected
+	// module.
+	TriageRuleNeedsIssue TriageRuleAction = "needs_issue"
+	// The CVE is not relevant to Go, even if it looks like it is.
+	TriageRuleFalsePositive TriageRuleAction = "false_positive"
+	// The CVE might be relevant to Go. It needs an issue, so that a person can
+	// decide, but the affected module is unknown.
+	TriageRulePossiblyGo TriageRuleAction = "possibly_go"
+	// The CVE is about a Go program that other code does not import, like a
+	// server. It needs an issue, which suggests an excluded report with
+	// reason NOT_IMPORTABLE. If the rule has a module, it is the program's
+	// module.
+	TriageRuleNotImportable TriageRuleAction = "not_importable"
+)
+
+// A TriageRule decides the triage of the CVEs it matches, overriding the
+// built-in heuristics.
+//
+// A rule matches a CVE if each of its non-empty conditions does. A condition
+// matches if any of its patterns does.
+type TriageRule struct {
+	// Name identifies the rule in triage reasons. It is required.
+	Name string `yaml:"name"`
+	// ReferenceURLs are regular expressions, matched against each reference
+	// URL of the CVE.
+	ReferenceURLs []string `yaml:"reference_urls,omitempty"`
+	// DescriptionKeywords are matched against the descriptions of the CVE,
+	// ignoring case.
+	DescriptionKeywords []string `yaml:"description_keywords,omitempty"`
+	// CPEVendors are matched against the vendor names of the CVE's affected
+	// products, ignoring case. For CVEs from the NVD, those come from the
+	// CPEs of the CVE.
+	CPEVendors []string `yaml:"cpe_vendors,omitempty"`
+	// Action is what to do with a matching CVE.
+	Action TriageRuleAction `yaml:"action"`
+	// Module is the affected module, for the needs_issue and not_importable
+	// actions.
+	Module string `yaml:"module,omitempty"`
+
+	referenceRegexps []*regexp.Regexp
+}
+
+// ParseTriageRules parses a triage rules file and validates the rules in it.
+// The file is YAML, with a list of TriageRules under the key "rules".
+func ParseTriageRules(data []byte) (_ []*TriageRule, err error) {
+	defer derrors.Wrap(&err, "ParseTriageRules")
+
+	d := yaml.NewDecoder(bytes.NewReader(data))
+	d.KnownFields(true)
+	var contents struct {
+		Rules []*TriageRule `yaml:"rules"`
+	}
+	if err := d.Decode(&contents); err != nil {
+		return nil, fmt.Errorf("yaml.Decode: %v", err)
+	}
+	names := map[string]bool{}
+	for i, r := range contents.Rules {
+		if err := r.init(); err != nil {
+			return nil, fmt.Errorf("rule %d (%q): %v", i, r.Name, err)
+		}
+		if names[r.Name] {
+			return nil, fmt.Errorf("rule %d: duplicate name %q", i, r.Name)
+		}
+		names[r.Name] = true
+	}
+	return contents.Rules, nil
+}
+
+// init validates r and compiles its regular expressions.
+func (r *TriageRule) init() error {
+	if r.Name == "" {
+		return errors.New("missing name")
+	}
+	switch r.Action {
+	case TriageRuleNeedsIssue, TriageRuleFalsePositive, TriageRulePossiblyGo, TriageRuleNotImportable:
+	default:
+		return fmt.Errorf("bad action %q", r.Action)
+	}
+	if r.Module != "" && r.Action != TriageRuleNeedsIssue && r.Action != TriageRuleNotImportable {
+		return fmt.Errorf("module is only allowed with actions %s and %s", TriageRuleNeedsIssue, TriageRuleNotImportable)
+	}
+	if len(r.ReferenceURLs) == 0 && len(r.DescriptionKeywords) == 0 && len(r.CPEVendors) == 0 {
+		return errors.New("no conditions")
+	}
+	r.referenceRegexps = nil
+	for _, s := range r.ReferenceURLs {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return err
+		}
+		r.referenceRegexps = append(r.referenceRegexps, re)
+	}
+	return nil
+}
+
+// match reports whether r matches c. If so, it also returns a description of
+// what matched.
+func (r *TriageRule) match(c *cveschema.CVE) (bool, string) {
+	var details []string
+	if len(r.referenceRegexps) > 0 {
+		d := matchReferences(r.referenceRegexps, c)
+		if d == "" {
+			return false, ""
+		}
+		details = append(details, d)
+	}
+	if len(r.DescriptionKeywords) > 0 {
+		d := matchDescription(r.DescriptionKeywords, c)
+		if d == "" {
+			return false, ""
+		}
+		details = append(details, d)
+	}
+	if len(r.CPEVendors) > 0 {
+		d := matchVendors(r.CPEVendors, c)
+		if d == "" {
+			return false, ""
+		}
+		details = append(details, d)
+	}
+	return true, strings.Join(details, "; ")
+}
+
+func matchReferences(res []*regexp.Regexp, c *cveschema.CVE) string {
+	for _, ref := range c.References.Data {
+		for _, re := range res {
+			if re.MatchString(ref.URL) {
+				return fmt.Sprintf("reference URL %q matches %q", ref.URL, re)
+			}
+		}
+	}
+	return ""
+}
+
+func matchDescription(keywords []string, c *cveschema.CVE) string {
+	for _, d := range c.Description.Data {
+		desc := strings.ToLower(d.Value)
+		for _, k := range keywords {
+			if strings.Contains(desc, strings.ToLower(k)) {
+				return fmt.Sprintf("description contains %q", k)
+			}
+		}
+	}
+	return ""
+}
+
+func matchVendors(vendors []string, c *cveschema.CVE) string {
+	for _, v := range c.Affects.Vendor.Data {
+		for _, want := range vendors {
+			if strings.EqualFold(v.VendorName, want) {
+				return fmt.Sprintf("vendor is %q", v.VendorName)
+			}
+		}
+	}
+	return ""
+}
+
+// result returns the triage result for a CVE that r matched.
+func (r *TriageRule) result(detail string) *triageResult {
+	reason := fmt.Sprintf("triage rule %q: %s", r.Name, detail)
+	switch r.Action {
+	case TriageRuleFalsePositive:
+		return &triageResult{falsePositive: true, reason: reason}
+	case TriageRuleNeedsIssue:
+		mp := r.Module
+		if mp == "" {
+			mp = unknownPath
+		}
+		return &triageResult{modulePath: mp, reason: reason}
+	case TriageRuleNotImportable:
+		mp := r.Module
+		if mp == "" {
+			mp = unknownPath
+		}
+		return &triageResult{
+			modulePath: mp,
+			reason:     "Go program, not importable package; " + reason,
+			excluded:   report.ExcludedNotImportable,
+		}
+	default: // TriageRulePossiblyGo
+		return &triageResult{modulePath: unknownPath, reason: "possibly Go; " + reason}
+	}
+}
+
+// A TriageRuleFile is a file of triage rules that is read again when it
+// changes, so that rules can be edited without restarting the worker.
+type TriageRuleFile struct {
+	filename string
+
+	mu      sync.Mutex
+	modTime time.Time // modification time of the file when it was last read
+	checked time.Time // when the modification time was last checked
+	rules   []*TriageRule
+}
+
+// triageRuleCheckInterval is how often a TriageRuleFile checks whether its
+// file has changed.
+const triageRuleCheckInterval = 10 * time.Second
+
+// ReadTriageRuleFile reads and validates the triage rules in filename.
+func ReadTriageRuleFile(filename string) (_ *TriageRuleFile, err error) {
+	defer derrors.Wrap(&err, "ReadTriageRuleFile(%q)", filename)
+
+	f := &TriageRuleFile{filename: filename}
+	if err := f.reload(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Rules returns the current rules of the file. If the file has changed since
+// it was last read, it is read again; if the new contents are invalid, the
+// error is logged and the old rules are kept.
+func (f *TriageRuleFile) Rules(ctx context.Context) []*TriageRule {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if time.Since(f.checked) >= triageRuleCheckInterval {
+		if err := f.reload(); err != nil {
+			log.Errorf(ctx, "keeping old triage rules: %v", err)
+		}
+	}
+	return f.rules
+}
+
+// reload reads the file if it has changed since it was last read.
+// f.mu must be held, except during construction.
+func (f *TriageRuleFile) reload() error {
+	f.checked = time.Now()
+	info, err := os.Stat(f.filename)
+	if err != nil {
+		return err
+	}
+	if info.ModTime().Equal(f.modTime) {
+		return nil
+	}
+	data, err := os.ReadFile(f.filename)
+	if err != nil {
+		return err
+	}
+	rules, err := ParseTriageRules(data)
+	if err != nil {
+		return err
+	}
+	f.rules = rules
+	f.modTime = info.ModTime()
+	return nil
+}
+
+// The file of triage rules used by TriageCVE, if any.
+var triageRuleFile *TriageRuleFile
+
+// SetTriageRules makes TriageCVE apply the rules in f before its built-in
+// heuristics.
+func SetTriageRules(f *TriageRuleFile) {
+	triageRuleFile = f
+}
+
+// The contents of the file of built-in triage rules, embedded so the worker
+// always has them.
+//
+//go:embed triage_rules.yaml
+var builtinTriageRulesData []byte
+
+var (
+	builtinTriageRulesOnce sync.Once
+	builtinTriageRules     []*TriageRule
+	builtinTriageRulesErr  error
+)
+
+// loadBuiltinTriageRules parses and validates the embedded built-in triage
+// rules the first time it is called, and returns the result on every call.
+func loadBuiltinTriageRules() ([]*TriageRule, error) {
+	builtinTriageRulesOnce.Do(func() {
+		builtinTriageRules, builtinTriageRulesErr = ParseTriageRules(builtinTriageRulesData)
+	})
+	return builtinTriageRules, builtinTriageRulesErr
+}
+
+// applyTriageRules returns the result of the first rule that matches c, or
+// nil if none does. The rules of the triage rules file come before the
+// built-in rules.
+func applyTriageRules(ctx context.Context, c *cveschema.CVE) (*triageResult, error) {
+	var fileRules []*TriageRule
+	if triageRuleFile != nil {
+		fileRules = triageRuleFile.Rules(ctx)
+	}
+	builtin, err := loadBuiltinTriageRules()
+	if err != nil {
+		return nil, err
+	}
+	for _, rules := range [][]*TriageRule{fileRules, builtin} {
+		for _, r := range rules {
+			if ok, detail := r.match(c); ok {
+				return r.result(detail), nil
+			}
+		}
+	}
+	return nil, nil
+}
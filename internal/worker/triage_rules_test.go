@@ -0,0 +1,321 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+const testTriageRules = `
+rules:
+  - name: sdk
+    reference_urls: ['^https://github\.com/example/go-sdk/']
+    action: needs_issue
+    module: github.com/example/go-sdk
+  - name: gogs
+    cpe_vendors: [Gogs]
+    description_keywords: [gogs]
+    action: false_positive
+  - name: golang
+    description_keywords: [GOLANG]
+    action: possibly_go
+  - name: minio-sdk
+    reference_urls: ['^https://github\.com/minio/minio/pkg/']
+    action: needs_issue
+    module: github.com/minio/minio
+`
+
+func TestParseTriageRules(t *testing.T) {
+	rules, err := ParseTriageRules([]byte(testTriageRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(rules), 4; got != want {
+		t.Fatalf("got %d rules, want %d", got, want)
+	}
+
+	for _, test := range []struct {
+		name, in, want string
+	}{
+		{"no name", "rules: [{action: needs_issue, description_keywords: [x]}]", "missing name"},
+		{"bad action", "rules: [{name: a, action: ignore, description_keywords: [x]}]", "bad action"},
+		{"no conditions", "rules: [{name: a, action: needs_issue}]", "no conditions"},
+		{"module", "rules: [{name: a, action: possibly_go, module: m, description_keywords: [x]}]", "module is only allowed"},
+		{"bad regexp", "rules: [{name: a, action: needs_issue, reference_urls: ['(']}]", "missing closing )"},
+		{"duplicate", "rules: [{name: a, action: needs_issue, description_keywords: [x]}, {name: a, action: needs_issue, description_keywords: [y]}]", "duplicate name"},
+		{"unknown field", "rules: [{name: a, action: needs_issue, keywords: [x]}]", "not found"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseTriageRules([]byte(test.in))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got %v, want error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestApplyTriageRules(t *testing.T) {
+	ctx := context.Background()
+	filename := filepath.Join(t.TempDir(), "rules.yaml")
+	if err := os.WriteFile(filename, []byte(testTriageRules), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := ReadTriageRuleFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer SetTriageRules(nil)
+	SetTriageRules(f)
+
+	newCVE := func(url, desc, vendor string) *cveschema.CVE {
+		c := &cveschema.CVE{}
+		if url != "" {
+			c.References.Data = []cveschema.Reference{{URL: url}}
+		}
+		if desc != "" {
+			c.Description.Data = []cveschema.LangString{{Lang: "eng", Value: desc}}
+		}
+		if vendor != "" {
+			c.Affects.Vendor.Data = []cveschema.VendorDataItem{{VendorName: vendor}}
+		}
+		return c
+	}
+	apply := func(c *cveschema.CVE) *triageResult {
+		t.Helper()
+		r, err := applyTriageRules(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	for _, test := range []struct {
+		name string
+		in   *cveschema.CVE
+		want *triageResult
+	}{
+		{
+			name: "reference",
+			in:   newCVE("https://github.com/example/go-sdk/issues/1", "", ""),
+			want: &triageResult{
+				modulePath: "github.com/example/go-sdk",
+				reason:     `triage rule "sdk": reference URL "https://github.com/example/go-sdk/issues/1" matches "^https://github\\.com/example/go-sdk/"`,
+			},
+		},
+		{
+			name: "all conditions",
+			in:   newCVE("", "XSS in Gogs", "gogs"),
+			want: &triageResult{
+				falsePositive: true,
+				reason:        `triage rule "gogs": description contains "gogs"; vendor is "gogs"`,
+			},
+		},
+		{
+			name: "some conditions",
+			in:   newCVE("", "XSS in Gogs", "gitea"),
+			want: nil,
+		},
+		{
+			name: "keyword",
+			in:   newCVE("https://example.com", "A golang library", ""),
+			want: &triageResult{
+				modulePath: unknownPath,
+				reason:     `possibly Go; triage rule "golang": description contains "GOLANG"`,
+			},
+		},
+		{
+			name: "first rule wins",
+			in:   newCVE("https://github.com/example/go-sdk/pull/2", "A golang library", ""),
+			want: &triageResult{
+				modulePath: "github.com/example/go-sdk",
+				reason:     `triage rule "sdk": reference URL "https://github.com/example/go-sdk/pull/2" matches "^https://github\\.com/example/go-sdk/"`,
+			},
+		},
+		{
+			name: "built-in not importable",
+			in:   newCVE("https://github.com/goharbor/harbor/security/advisories/GHSA-abcd-1234-wxyz", "", ""),
+			want: &triageResult{
+				modulePath: "github.com/goharbor/harbor/src",
+				reason:     `Go program, not importable package; triage rule "not-importable-harbor": reference URL "https://github.com/goharbor/harbor/security/advisories/GHSA-abcd-1234-wxyz" matches "^https?://github\\.com/goharbor/harbor(/|$)"`,
+				excluded:   report.ExcludedNotImportable,
+			},
+		},
+		{
+			name: "file rule before built-in rule",
+			in:   newCVE("https://github.com/minio/minio/pkg/madmin", "", ""),
+			want: &triageResult{
+				modulePath: "github.com/minio/minio",
+				reason:     `triage rule "minio-sdk": reference URL "https://github.com/minio/minio/pkg/madmin" matches "^https://github\\.com/minio/minio/pkg/"`,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got := apply(test.in)
+			if diff := cmp.Diff(test.want, got, cmp.AllowUnexported(triageResult{})); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+
+	// Changes to the file take effect, but invalid contents are ignored.
+	reload := func(contents string) {
+		t.Helper()
+		if err := os.WriteFile(filename, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+		later := time.Now().Add(time.Hour)
+		if err := os.Chtimes(filename, later, later); err != nil {
+			t.Fatal(err)
+		}
+		f.mu.Lock()
+		f.checked = time.Time{}
+		f.mu.Unlock()
+	}
+	reload("rules: [{name: a, action: needs_issue, description_keywords: [library]}]")
+	if got := apply(newCVE("", "A golang library", "")); got == nil || got.modulePath != unknownPath || !strings.Contains(got.reason, `"a"`) {
+		t.Errorf("after reload: got %+v, want match of rule a", got)
+	}
+	reload("rules: [{name: b}]")
+	if got := apply(newCVE("", "A golang library", "")); got == nil || !strings.Contains(got.reason, `"a"`) {
+		t.Errorf("after bad reload: got %+v, want match of rule a", got)
+	}
+}
+
+func TestBuiltinTriageRules(t *testing.T) {
+	rules, err := loadBuiltinTriageRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rules {
+		if r.Action != TriageRuleNotImportable {
+			t.Errorf("built-in rule %q has action %s, want %s", r.Name, r.Action, TriageRuleNotImportable)
+		}
+	}
+}
+
+// TestBuiltinTriageRulesReports checks that no built-in not_importable rule
+// is for a program that has a report in data/reports that isn't excluded,
+// since something imports the program's packages after all.
+func TestBuiltinTriageRulesReports(t *testing.T) {
+	rules, err := loadBuiltinTriageRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	filenames, err := filepath.Glob(filepath.Join("..", "..", "data", "reports", "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filenames) == 0 {
+		t.Fatal("no reports in data/reports")
+	}
+	for _, filename := range filenames {
+		r, err := report.Read(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Excluded != "" {
+			continue
+		}
+		for _, rule := range rules {
+			if rule.Action != TriageRuleNotImportable {
+				continue
+			}
+			if why := reportMatch(rule, r); why != "" {
+				t.Errorf("built-in rule %q says the program isn't importable, but %s has %s", rule.Name, filepath.Base(filename), why)
+			}
+		}
+	}
+}
+
+// reportMatch describes what about the report r matches the program of the
+// rule, or returns "" if nothing does.
+func reportMatch(rule *TriageRule, r *report.Report) string {
+	for _, m := range r.Modules {
+		if rule.Module != "" && (m.Module == rule.Module || strings.HasPrefix(m.Module, rule.Module+"/")) {
+			return "module " + m.Module
+		}
+	}
+	for _, ref := range r.References {
+		for _, re := range rule.referenceRegexps {
+			if re.MatchString(ref.URL) {
+				return "reference " + ref.URL
+			}
+		}
+	}
+	return ""
+}
+
+func TestTriageAndStoreFalsePositive(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	cve := &cveschema.CVE{
+		Metadata:   cveschema.Metadata{ID: "CVE-2022-0001", State: cveschema.StatePublic},
+		References: cveschema.References{Data: []cveschema.Reference{{URL: "https://example.com/gogs"}}},
+	}
+	src := cveSource{path: "p", blobHash: "b", commitHash: "c", commitTime: time.Now().UTC()}
+	triage := func(context.Context, *cveschema.CVE) (*triageResult, error) {
+		return &triageResult{falsePositive: true, reason: "rule"}, nil
+	}
+	err := mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		_, err := triageAndStoreCVE(ctx, tx, cve, nil, src, nil, triage)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := mstore.CVERecords()[cve.ID]
+	want := &store.CVERecord{
+		ID:                cve.ID,
+		Path:              src.path,
+		BlobHash:          src.blobHash,
+		CommitHash:        src.commitHash,
+		CommitTime:        src.commitTime,
+		CVEState:          cveschema.StatePublic,
+		TriageState:       store.TriageStateFalsePositive,
+		TriageStateReason: "rule",
+		ReferenceURLs:     []string{"https://example.com/gogs"},
+		TriagedContent:    store.NewCVEContent(cve),
+	}
+	if diff := cmp.Diff(want, got, ignoreVersion); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestTriageAndStoreNotImportable(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	cve := &cveschema.CVE{
+		Metadata:   cveschema.Metadata{ID: "CVE-2022-0002", State: cveschema.StatePublic},
+		References: cveschema.References{Data: []cveschema.Reference{{URL: "https://github.com/goharbor/harbor/issues/1"}}},
+	}
+	src := cveSource{path: "p", blobHash: "b", commitHash: "c", commitTime: time.Now().UTC()}
+	triage := func(ctx context.Context, c *cveschema.CVE) (*triageResult, error) {
+		return applyTriageRules(ctx, c)
+	}
+	err := mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		_, err := triageAndStoreCVE(ctx, tx, cve, nil, src, nil, triage)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := mstore.CVERecords()[cve.ID]
+	if got.TriageState != store.TriageStateNeedsIssue || got.Module != "github.com/goharbor/harbor/src" || got.SuggestedExclusion != "NOT_IMPORTABLE" {
+		t.Errorf("got state %s, module %q and suggested exclusion %q; want NeedsIssue, github.com/goharbor/harbor/src and NOT_IMPORTABLE",
+			got.TriageState, got.Module, got.SuggestedExclusion)
+	}
+}
@@ -9,16 +9,19 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"golang.org/x/vulndb/internal/cveschema"
 	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/worker/store"
 )
 
 var usePkgsite = flag.Bool("pkgsite", false, "use pkg.go.dev for tests")
@@ -26,6 +29,24 @@ var usePkgsite = flag.Bool("pkgsite", false, "use pkg.go.dev for tests")
 func TestTriageV4CVE(t *testing.T) {
 	ctx := context.Background()
 	url := getPkgsiteURL(t)
+	useFakeGitHub(t, map[string]string{
+		"example/gomod":     "module example.com/gomod\n\ngo 1.18\n",
+		"example/v2mod":     "// comment\nmodule github.com/example/v2mod/v2\n",
+		"example/nomodule":  "go 1.18\n",
+		"grafana/grafana":   "module github.com/grafana/grafana\n",
+		"example/badmodule": "module \"not a path\"\n",
+		"gin-gonic/gin":     githubUnavailable,
+		"example/multi":     "module example.com/multi\n",
+		"example/multi/api": "module example.com/api\n",
+		// Vendored modules are not modules of the repo.
+		"example/multi/vendor/example.com/v": "module example.com/v\n",
+	}, map[string][]string{
+		"example/multi/commits/abcdef1": {"api/types.go", "api/types_test.go", "CHANGELOG.md"},
+		"example/multi/pulls/8/files":   {"server.go", "cmd/run/main.go", "api/types.go"},
+	})
+	useFakeProxy(t, map[string]string{
+		"github.com/example/inproxy": "",
+	})
 
 	for _, test := range []struct {
 		name string
@@ -109,8 +130,145 @@ func TestTriageV4CVE(t *testing.T) {
 					},
 				},
 			},
+			&triageResult{
+				notModule: true,
+			},
+		},
+		{
+			"contains github.com in proxy but not on pkg.go.dev",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/something/something/404"},
+						{URL: "https://github.com/example/inproxy/pull/1"},
+					},
+				},
+			},
+			nil,
+		},
+		{
+			"no candidate module paths",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/something/something/issues/1"},
+					},
+				},
+			},
 			nil,
 		},
+		{
+			"GitHub repo with go.mod",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/example/gomod/pull/1"},
+					},
+				},
+			},
+			&triageResult{
+				modulePath: "example.com/gomod",
+			},
+		},
+		{
+			"directory in a submodule of a GitHub repo",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/example/multi/tree/v1.2.0/api/server"},
+					},
+				},
+			},
+			&triageResult{
+				modulePath: "example.com/api",
+			},
+		},
+		{
+			"directory in the root module of a GitHub repo with submodules",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/example/multi/tree/main/vendor/example.com/v"},
+					},
+				},
+			},
+			&triageResult{
+				modulePath: "example.com/multi",
+			},
+		},
+		{
+			"fix commit changes a submodule of a GitHub repo",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/example/multi/pull/7"},
+						{URL: "https://github.com/example/multi/commit/abcdef1"},
+					},
+				},
+			},
+			&triageResult{
+				modulePath: "example.com/api",
+				candidates: []*store.CandidateModule{{Path: "example.com/api", Confidence: 1}},
+			},
+		},
+		{
+			"fix pull request changes several modules of a GitHub repo",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/example/multi/pull/8/files"},
+					},
+				},
+			},
+			&triageResult{
+				modulePath: "example.com/multi",
+				candidates: []*store.CandidateModule{
+					{Path: "example.com/multi", Confidence: 2.0 / 3},
+					{Path: "example.com/api", Confidence: 1.0 / 3},
+				},
+			},
+		},
+		{
+			"GitHub repo with go.mod at a major version",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/example/v2mod"},
+					},
+				},
+			},
+			&triageResult{
+				modulePath: "github.com/example/v2mod/v2",
+			},
+		},
+		{
+			"GitHub repo with go.mod without module path",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/example/nomodule/pull/2"},
+						{URL: "https://github.com/example/badmodule"},
+					},
+				},
+			},
+			&triageResult{
+				notModule: true,
+			},
+		},
+		{
+			"GitHub repo with go.mod that is not a Go module",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/grafana/grafana/pull/3"},
+					},
+				},
+			},
+			// No path in the repo is a module in the proxy.
+			&triageResult{
+				notModule: true,
+			},
+		},
 		{
 			"contains longer module path",
 			&cveschema.CVE{
@@ -124,6 +282,19 @@ func TestTriageV4CVE(t *testing.T) {
 				modulePath: "bitbucket.org/foo/bar/baz/v2",
 			},
 		},
+		{
+			"GitHub repo whose go.mod can't be read",
+			&cveschema.CVE{
+				References: cveschema.References{
+					Data: []cveschema.Reference{
+						{URL: "https://github.com/gin-gonic/gin"},
+					},
+				},
+			},
+			&triageResult{
+				modulePath: "github.com/gin-gonic/gin",
+			},
+		},
 		{
 			"repo path is not a module",
 			&cveschema.CVE{
@@ -133,7 +304,9 @@ func TestTriageV4CVE(t *testing.T) {
 					},
 				},
 			},
-			nil,
+			&triageResult{
+				notModule: true,
+			},
 		},
 		{
 			"contains snyk.io URL containing GOLANG",
@@ -148,6 +321,39 @@ func TestTriageV4CVE(t *testing.T) {
 				modulePath: unknownPath,
 			},
 		},
+		{
+			"CPE of the Go project",
+			cpeCVE("cpe:2.3:o:microsoft:windows:-:*:*:*:*:*:*:*", "cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*"),
+			&triageResult{
+				modulePath: stdlib.ModulePath,
+			},
+		},
+		{
+			"CPE of a golang.org/x repo",
+			cpeCVE("cpe:2.3:a:golang:text:*:*:*:*:*:*:*:*"),
+			&triageResult{
+				modulePath: "golang.org/x/text",
+			},
+		},
+		{
+			"CPE of a Go module on GitHub",
+			cpeCVE("cpe:2.3:a:gin-gonic:gin:*:*:*:*:*:go:*:*"),
+			&triageResult{
+				modulePath: "github.com/gin-gonic/gin",
+			},
+		},
+		{
+			"CPE of an unknown Go product",
+			cpeCVE("cpe:2.3:a:example:tool:1.0:*:*:*:*:golang:*:*"),
+			&triageResult{
+				modulePath: unknownPath,
+			},
+		},
+		{
+			"CPE of a product not in Go",
+			cpeCVE("cpe:2.3:a:example:tool:1.0:*:*:*:*:node.js:*:*"),
+			nil,
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			test.in.DataVersion = "4.0"
@@ -164,6 +370,75 @@ func TestTriageV4CVE(t *testing.T) {
 	}
 }
 
+func TestGitHubRepoModulesStore(t *testing.T) {
+	requests := useFakeGitHub(t, map[string]string{
+		"example/multi":     "module example.com/multi\n",
+		"example/multi/api": "module example.com/api\n",
+	}, nil)
+	st := store.NewMemStore()
+	ctx := withRepoModulesStore(context.Background(), st)
+	const repo = "github.com/example/multi"
+	want := []*store.RepoModule{
+		{Dir: "", Path: "example.com/multi"},
+		{Dir: "api", Path: "example.com/api"},
+	}
+	rm, err := githubRepoModules(ctx, repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, rm.Modules); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	// One request for the list of files, and one for each go.mod file.
+	if got := requests(); got != 3 {
+		t.Errorf("got %d requests, want 3", got)
+	}
+
+	// A later run, which has an empty cache in memory, uses the store.
+	githubRepoModulesCacheMu.Lock()
+	githubRepoModulesCache = map[string]*store.RepoModules{}
+	githubRepoModulesCacheMu.Unlock()
+	rm, err = githubRepoModules(ctx, repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, rm.Modules); diff != "" {
+		t.Errorf("from store: mismatch (-want, +got):\n%s", diff)
+	}
+	if got := requests(); got != 3 {
+		t.Errorf("got %d requests, want no more than 3", got)
+	}
+}
+
+func TestGitHubFileDir(t *testing.T) {
+	for _, test := range []struct {
+		in, want string
+	}{
+		{"/owner/repo", ""},
+		{"/owner/repo/pull/1", ""},
+		{"/owner/repo/blob/main/a/b.go", "a/b.go"},
+		{"/owner/repo/tree/v1.0.0/a/", "a"},
+	} {
+		if got := githubFileDir(test.in); got != test.want {
+			t.Errorf("githubFileDir(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+// cpeCVE returns a CVE whose configurations list the products with the
+// given CPE names as vulnerable.
+func cpeCVE(cpes ...string) *cveschema.CVE {
+	var ms []cveschema.CPEMatch
+	for _, c := range cpes {
+		ms = append(ms, cveschema.CPEMatch{Vulnerable: true, CPE23URI: c})
+	}
+	return &cveschema.CVE{
+		Configurations: &cveschema.Configurations{
+			Nodes: []cveschema.ConfigNode{{Operator: "OR", CPEMatch: ms}},
+		},
+	}
+}
+
 func TestKnownToPkgsite(t *testing.T) {
 	ctx := context.Background()
 
@@ -189,17 +464,139 @@ func TestKnownToPkgsite(t *testing.T) {
 	}
 }
 
+// githubUnavailable is the contents of a go.mod file in useFakeGitHub that
+// GitHub fails to serve.
+const githubUnavailable = "<unavailable>"
+
+// useFakeGitHub makes githubRepoModules read go.mod files, and
+// githubChangedFiles read the files changed by fixes, from a fake server for
+// the duration of the test. The keys of gomods are the directories of the
+// files, like "owner/repo" or "owner/repo/sub/dir", and the values are their
+// contents, or githubUnavailable. The keys of fixes are commits and pull requests, like
+// "owner/repo/commits/SHA" or "owner/repo/pulls/N/files", and the values are
+// the files they change. It returns a func that reports the number of
+// requests.
+func useFakeGitHub(t testing.TB, gomods map[string]string, fixes map[string][]string) func() int {
+	var mu sync.Mutex
+	requests := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		if files, ok := fixes[strings.TrimPrefix(r.URL.Path, "/repos/")]; ok {
+			type file struct {
+				Filename string `json:"filename"`
+			}
+			var fs []file
+			for _, f := range files {
+				fs = append(fs, file{f})
+			}
+			if strings.HasSuffix(r.URL.Path, "/files") {
+				json.NewEncoder(w).Encode(fs)
+			} else {
+				json.NewEncoder(w).Encode(map[string]interface{}{"files": fs})
+			}
+			return
+		}
+		if p := strings.TrimPrefix(r.URL.Path, "/repos/"); p != r.URL.Path {
+			// A listing of the files of a repo.
+			repo := strings.TrimSuffix(p, "/git/trees/HEAD")
+			type entry struct {
+				Path string `json:"path"`
+				Type string `json:"type"`
+			}
+			var tree []entry
+			for dir := range gomods {
+				if dir == repo {
+					tree = append(tree, entry{"go.mod", "blob"})
+				} else if strings.HasPrefix(dir, repo+"/") {
+					tree = append(tree, entry{strings.TrimPrefix(dir, repo+"/") + "/go.mod", "blob"})
+				}
+			}
+			if tree == nil {
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"tree": tree})
+			return
+		}
+		parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 4)
+		if len(parts) < 4 || parts[2] != "HEAD" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		dir := strings.TrimSuffix(parts[0]+"/"+parts[1]+"/"+parts[3], "/go.mod")
+		gomod, ok := gomods[dir]
+		if !ok {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		if gomod == githubUnavailable {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(gomod))
+	}))
+	t.Cleanup(s.Close)
+
+	defer func(raw, api string) {
+		t.Cleanup(func() {
+			githubRawURL = raw
+			githubAPIURL = api
+			githubRepoModulesCacheMu.Lock()
+			githubRepoModulesCache = map[string]*store.RepoModules{}
+			githubRepoModulesCacheMu.Unlock()
+		})
+	}(githubRawURL, githubAPIURL)
+	githubRawURL = s.URL
+	githubAPIURL = s.URL
+	return func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+// useFakeProxy makes triage, and the linting of reports, look for modules in
+// a fake module proxy for the duration of the test. The keys of lists are the modules in the proxy, and
+// the values are the responses of their @v/list endpoints. The @latest
+// endpoint of each returns a pseudo-version.
+func useFakeProxy(t testing.TB, lists map[string]string) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mod, suffix, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/@")
+		list, ok := lists[mod]
+		if !ok {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		if suffix == "v/list" {
+			w.Write([]byte(list))
+			return
+		}
+		w.Write([]byte(`{"Version": "v0.0.0-20220101000000-123456789abc"}`))
+	}))
+	t.Cleanup(s.Close)
+
+	defer func(p *proxyClient) {
+		t.Cleanup(func() { triageProxy = p })
+	}(triageProxy)
+	triageProxy = newProxyClient(s.URL)
+	t.Setenv("GOPROXY", s.URL)
+}
+
 // getPkgsiteURL returns a URL to either a fake server or the real pkg.go.dev,
 // depending on the usePkgsite flag.
-func getPkgsiteURL(t *testing.T) string {
+func getPkgsiteURL(t testing.TB) string {
 	if *usePkgsite {
 		return pkgsiteURL
 	}
-	// Start a test server that recognizes anything from golang.org and bitbucket.org/foo/bar/baz.
+	// Start a test server that recognizes anything from golang.org,
+	// bitbucket.org/foo/bar/baz and github.com/gin-gonic/gin.
 	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		modulePath := strings.TrimPrefix(r.URL.Path, "/mod/")
 		if !strings.HasPrefix(modulePath, "golang.org/") &&
-			!strings.HasPrefix(modulePath, "bitbucket.org/foo/bar/baz") {
+			!strings.HasPrefix(modulePath, "bitbucket.org/foo/bar/baz") &&
+			!strings.HasPrefix(modulePath, "github.com/gin-gonic/gin") {
 			http.Error(w, "unknown", http.StatusNotFound)
 		}
 	}))
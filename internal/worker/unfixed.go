@@ -0,0 +1,182 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/stdlib"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// LatestVersionFunc is the type of a function that returns the latest
+// version of a module, as the module proxy's @latest endpoint does.
+type LatestVersionFunc func(ctx context.Context, modulePath string) (string, error)
+
+// ProxyLatestVersion returns the latest version of modulePath in the Go
+// module proxy.
+func ProxyLatestVersion(ctx context.Context, modulePath string) (string, error) {
+	return latestVersion(ctx, proxyURL, modulePath)
+}
+
+// WatchUnfixedStats describes the result of a check of the modules of
+// reports that have no fixed version.
+type WatchUnfixedStats struct {
+	// Number of modules checked.
+	NumChecked int
+	// Modules with a new version that may fix them, in the order of the
+	// reports.
+	Flagged []*store.UnfixedModule
+	// Number of issues created and commented on for them.
+	NumIssues, NumComments int
+}
+
+// WatchUnfixedModules checks the modules of the reports in the vulndb repo at
+// repoPath, which may be a URL to clone or a local directory, that have no
+// fixed version, for new versions that may fix them.
+//
+// The first time it sees a module of a report, it records the module's latest
+// version in st. When a later check finds a newer tagged version, it flags
+// the module for review, and creates an issue in ic for the report, or if
+// there is one already, comments on it. If ic is nil, no issues are created.
+// The record of a module is deleted once the report has a fixed version for
+// it, or is excluded.
+func WatchUnfixedModules(ctx context.Context, latest LatestVersionFunc, st store.Store, ic issues.Client, repoPath string) (_ WatchUnfixedStats, err error) {
+	defer derrors.Wrap(&err, "WatchUnfixedModules(%q)", repoPath)
+
+	reports, err := LoadGoReports(ctx, repoPath)
+	if err != nil {
+		return WatchUnfixedStats{}, err
+	}
+	return watchUnfixedModules(ctx, latest, st, ic, reports, time.Now())
+}
+
+func watchUnfixedModules(ctx context.Context, latest LatestVersionFunc, st store.Store, ic issues.Client, reports []*GoReport, now time.Time) (stats WatchUnfixedStats, err error) {
+	ctx = event.Start(ctx, "watchUnfixedModules")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "unfixed-modules")
+	defer func() { end(err) }()
+
+	stored, err := st.ListUnfixedModules(ctx)
+	if err != nil {
+		return stats, err
+	}
+	type key struct{ reportID, module string }
+	old := map[key]*store.UnfixedModule{}
+	for _, u := range stored {
+		old[key{u.ReportID, u.Module}] = u
+	}
+	// Several reports may be about the same module; look up each module
+	// once.
+	versions := map[string]string{}
+	seen := map[key]bool{}
+	for _, r := range reports {
+		if r.Excluded {
+			continue
+		}
+		for _, m := range r.Modules {
+			k := key{r.ID, m.Path}
+			if !isUnfixed(m) || seen[k] {
+				continue
+			}
+			seen[k] = true
+			v, ok := versions[m.Path]
+			if !ok {
+				v, err = latest(ctx, m.Path)
+				if err != nil {
+					// The module may have left the proxy; keep its record
+					// and try again next time.
+					log.Warningf(ctx, "%s: latest version of %s: %v", r.ID, m.Path, err)
+					continue
+				}
+				versions[m.Path] = v
+			}
+			stats.NumChecked++
+			u := old[k]
+			if u == nil {
+				u = &store.UnfixedModule{ReportID: r.ID, Module: m.Path}
+			} else if semver.Compare(v, u.LatestVersion) > 0 && !module.IsPseudoVersion(v) {
+				u.FlaggedVersion = v
+				u.FlaggedAt = now
+				stats.Flagged = append(stats.Flagged, u)
+				log.Infof(ctx, "%s: %s@%s may fix it", r.ID, m.Path, v)
+				if ic != nil {
+					if err := trackUnfixedModule(ctx, u, ic, now, &stats); err != nil {
+						return stats, err
+					}
+				}
+			}
+			u.LatestVersion = v
+			u.CheckedAt = now
+			if err := st.SetUnfixedModule(ctx, u); err != nil {
+				return stats, err
+			}
+		}
+	}
+	for k := range old {
+		if seen[k] {
+			continue
+		}
+		if err := st.DeleteUnfixedModule(ctx, k.reportID, k.module); err != nil {
+			return stats, err
+		}
+	}
+	log.Infof(ctx, "unfixed module check succeeded: checked %d, flagged %d, created %d issues and %d comments",
+		stats.NumChecked, len(stats.Flagged), stats.NumIssues, stats.NumComments)
+	return stats, nil
+}
+
+// isUnfixed reports whether m, a module of a report, has no fixed version:
+// it has no version ranges, so all its versions are vulnerable, or its last
+// range has no end. The standard library and toolchain are never unfixed
+// for long, and are not in the module proxy.
+func isUnfixed(m *GoReportModule) bool {
+	if m.Path == "" || m.Path == stdlib.ModulePath || m.Path == "cmd" {
+		return false
+	}
+	return len(m.Versions) == 0 || m.Versions[len(m.Versions)-1].Fixed == ""
+}
+
+// trackUnfixedModule comments on the issue of u about its flagged version,
+// or creates one if u has none.
+func trackUnfixedModule(ctx context.Context, u *store.UnfixedModule, ic issues.Client, now time.Time, stats *WatchUnfixedStats) (err error) {
+	defer derrors.Wrap(&err, "trackUnfixedModule(%s, %s)", u.ReportID, u.Module)
+
+	if err := issueRateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	if n := issueNumber(ic, u.IssueReference); n != 0 {
+		body := fmt.Sprintf("%s@%s has been released since, and may fix %s.", u.Module, u.FlaggedVersion, u.ReportID)
+		if err := ic.CreateComment(ctx, n, body); err != nil {
+			return err
+		}
+		stats.NumComments++
+		return nil
+	}
+	body := fmt.Sprintf("Report %s says that no version of %s fixes its vulnerability, "+
+		"but %[2]s@%[3]s was released after %[4]s, the latest version when the report was last checked.\n\n"+
+		"If %[2]s@%[3]s fixes the vulnerability, add it to the report as the fixed version; otherwise, close this issue.\n",
+		u.ReportID, u.Module, u.FlaggedVersion, u.LatestVersion)
+	num, err := ic.CreateIssue(ctx, &issues.Issue{
+		Title: fmt.Sprintf("x/vulndb: possible fix for %s in %s@%s", u.ReportID, u.Module, u.FlaggedVersion),
+		Body:  body,
+	})
+	if err != nil {
+		return err
+	}
+	u.IssueReference = ic.Reference(num)
+	u.IssueCreatedAt = now
+	stats.NumIssues++
+	log.Infof(ctx, "created issue %s for %s", u.IssueReference, u.ReportID)
+	return nil
+}
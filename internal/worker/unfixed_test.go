@@ -0,0 +1,135 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/report"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestWatchUnfixedModules(t *testing.T) {
+	ctx := context.Background()
+	reports := []*GoReport{
+		// Unfixed: no versions.
+		{ID: "GO-2022-0001", Modules: []*GoReportModule{{Path: "example.com/a"}}},
+		// Unfixed: the last range has no end.
+		{
+			ID: "GO-2022-0002",
+			Modules: []*GoReportModule{{
+				Path:     "example.com/b",
+				Versions: []report.VersionRange{{Fixed: "1.0.1"}, {Introduced: "1.1.0"}},
+			}},
+		},
+		// Fixed.
+		{
+			ID: "GO-2022-0003",
+			Modules: []*GoReportModule{{
+				Path:     "example.com/c",
+				Versions: []report.VersionRange{{Fixed: "1.2.0"}},
+			}},
+		},
+		// Excluded, and the standard library, are not checked.
+		{ID: "GO-2022-0004", Excluded: true, Modules: []*GoReportModule{{Path: "example.com/d"}}},
+		{ID: "GO-2022-0005", Modules: []*GoReportModule{{Path: "std"}}},
+		// The module is not in the proxy.
+		{ID: "GO-2022-0006", Modules: []*GoReportModule{{Path: "example.com/gone"}}},
+	}
+	versions := map[string]string{
+		"example.com/a": "v1.0.0",
+		"example.com/b": "v1.1.0",
+	}
+	latest := func(_ context.Context, modulePath string) (string, error) {
+		if v, ok := versions[modulePath]; ok {
+			return v, nil
+		}
+		return "", fmt.Errorf("%s: not found", modulePath)
+	}
+	mstore := store.NewMemStore()
+	ic := issues.NewFakeClient()
+	now := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	watch := func() WatchUnfixedStats {
+		t.Helper()
+		stats, err := watchUnfixedModules(ctx, latest, mstore, ic, reports, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stats
+	}
+	list := func() []*store.UnfixedModule {
+		t.Helper()
+		us, err := mstore.ListUnfixedModules(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return us
+	}
+
+	// The first check records the latest versions, without flagging.
+	stats := watch()
+	if stats.NumChecked != 2 || len(stats.Flagged) != 0 {
+		t.Fatalf("first check: got %d checked, %d flagged; want 2, 0", stats.NumChecked, len(stats.Flagged))
+	}
+	want := []*store.UnfixedModule{
+		{ReportID: "GO-2022-0001", Module: "example.com/a", LatestVersion: "v1.0.0", CheckedAt: now},
+		{ReportID: "GO-2022-0002", Module: "example.com/b", LatestVersion: "v1.1.0", CheckedAt: now},
+	}
+	if diff := cmp.Diff(want, list()); diff != "" {
+		t.Fatalf("first check mismatch (-want, +got):\n%s", diff)
+	}
+
+	// A pseudo-version is recorded, but not flagged; a tagged version is
+	// flagged, and gets an issue.
+	now = now.Add(24 * time.Hour)
+	versions["example.com/a"] = "v1.0.1-0.20221201000000-abcdefabcdef"
+	versions["example.com/b"] = "v1.2.0"
+	stats = watch()
+	if len(stats.Flagged) != 1 || stats.NumIssues != 1 {
+		t.Fatalf("second check: got %d flagged, %d issues; want 1, 1", len(stats.Flagged), stats.NumIssues)
+	}
+	want = []*store.UnfixedModule{
+		{ReportID: "GO-2022-0001", Module: "example.com/a", LatestVersion: "v1.0.1-0.20221201000000-abcdefabcdef", CheckedAt: now},
+		{
+			ReportID:       "GO-2022-0002",
+			Module:         "example.com/b",
+			LatestVersion:  "v1.2.0",
+			CheckedAt:      now,
+			FlaggedVersion: "v1.2.0",
+			FlaggedAt:      now,
+			IssueReference: ic.Reference(1),
+			IssueCreatedAt: now,
+		},
+	}
+	if diff := cmp.Diff(want, list()); diff != "" {
+		t.Fatalf("second check mismatch (-want, +got):\n%s", diff)
+	}
+	if ok, err := ic.IssueExists(ctx, 1); err != nil || !ok {
+		t.Fatalf("IssueExists(1) = %t, %v; want true, nil", ok, err)
+	}
+
+	// Another new version adds a comment to the issue, instead of a new
+	// issue.
+	versions["example.com/b"] = "v1.3.0"
+	stats = watch()
+	if stats.NumIssues != 0 || stats.NumComments != 1 {
+		t.Errorf("third check: got %d issues, %d comments; want 0, 1", stats.NumIssues, stats.NumComments)
+	}
+
+	// Once the report has a fixed version, its record is deleted.
+	reports[1].Modules[0].Versions = []report.VersionRange{{Fixed: "1.0.1"}, {Introduced: "1.1.0", Fixed: "1.3.0"}}
+	watch()
+	if got := list(); len(got) != 1 || got[0].ReportID != "GO-2022-0001" {
+		t.Errorf("after fix: got %d records, want only GO-2022-0001", len(got))
+	}
+}
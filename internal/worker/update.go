@@ -6,19 +6,27 @@ package worker
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"golang.org/x/exp/event"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
 	"golang.org/x/vulndb/internal/cvelistrepo"
 	"golang.org/x/vulndb/internal/cveschema"
 	"golang.org/x/vulndb/internal/derrors"
 	"golang.org/x/vulndb/internal/ghsa"
+	"golang.org/x/vulndb/internal/observe"
+	"golang.org/x/vulndb/internal/osvdev"
+	"golang.org/x/vulndb/internal/refurl"
 	"golang.org/x/vulndb/internal/worker/log"
 	"golang.org/x/vulndb/internal/worker/store"
 )
@@ -26,7 +34,7 @@ import (
 // A triageFunc triages a CVE: it decides whether an issue needs to be filed.
 // If so, it returns a non-empty string indicating the possibly
 // affected module.
-type triageFunc func(*cveschema.CVE) (*triageResult, error)
+type triageFunc func(context.Context, *cveschema.CVE) (*triageResult, error)
 
 // A cveUpdater performs an update operation on the DB.
 type cveUpdater struct {
@@ -35,6 +43,70 @@ type cveUpdater struct {
 	st             store.Store
 	knownIDs       map[string]bool
 	affectedModule triageFunc
+	// quarantine holds the files that earlier updates failed to parse, by
+	// path.
+	quarantine map[string]*store.QuarantinedFile
+}
+
+// startUpdate starts a run of an update from source, the name of what the
+// update reads from, like "cvelist". The log messages of the returned context
+// are labeled with source and a new ID for the run, so that all the messages
+// of a run can be found from any of them. Unless st is nil, the run is
+// recorded in the status of source in st, with the counts that the update
+// gives to countUpdate.
+//
+// Call the returned function with the error of the update when it ends. It
+// records the update's metrics, and notifies triagers of the records that the
+// update moved to TriageStateNeedsIssue, and of its failure. An update that
+// ends with an error after the server's shutdown canceled ctx was
+// interrupted, not failed, and triagers are not notified of it; an update
+// that runs out of time has failed.
+func startUpdate(ctx context.Context, st store.Store, source string) (context.Context, func(error)) {
+	start := time.Now()
+	run := start.UTC().Format("20060102T150405")
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err == nil {
+		run += fmt.Sprintf("-%x", b)
+	}
+	ctx = log.NewContext(ctx, "source", source, "run", run)
+	c := &needsIssueCollector{ids: map[string]bool{}}
+	ctx = store.WithTriageListener(ctx, c.listen)
+	if st != nil {
+		ctx = withRepoModulesStore(ctx, st)
+		ctx = startSourceStatus(ctx, st, source, start)
+	}
+	return ctx, func(err error) {
+		interrupted := err != nil && ctx.Err() != nil && shuttingDown(ctx)
+		recordUpdate(ctx, source, start, err)
+		if st != nil {
+			endSourceStatus(ctx, st, source, start, err, interrupted)
+		}
+		// A failed update may still have committed some changes.
+		if ids := c.sortedIDs(); len(ids) > 0 {
+			notify(ctx, &Notification{
+				Kind:   NotifyNeedsIssue,
+				Text:   fmt.Sprintf("%d CVEs or GHSAs now need issues.", len(ids)),
+				Source: source,
+				Run:    run,
+				IDs:    ids,
+			})
+		}
+		if interrupted {
+			// The update was stopped, as by a shutdown of the server,
+			// rather than failing. The next one picks up where it left off.
+			log.Infof(ctx, "%s update interrupted: %v", source, err)
+			return
+		}
+		if err != nil {
+			notify(ctx, &Notification{
+				Kind:   NotifyUpdateFailed,
+				Text:   fmt.Sprintf("The %s update failed: %v", source, err),
+				Source: source,
+				Run:    run,
+				Error:  err.Error(),
+			})
+		}
+	}
 }
 
 type updateStats struct {
@@ -71,11 +143,22 @@ func (u *cveUpdater) update(ctx context.Context) (ur *store.CommitUpdateRecord,
 	defer derrors.Wrap(&err, "cveUpdater.update(%s)", u.commit.Hash)
 	ctx = event.Start(ctx, "cveUpdater.update")
 	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, u.st, "cvelist")
+	defer func() { end(err) }()
+	defer func() {
+		if ur != nil {
+			countUpdate(ctx, ur.NumProcessed, ur.NumAdded, ur.NumModified)
+		}
+	}()
 
 	defer func() {
-		if err != nil {
+		switch {
+		case ur != nil && ur.Interrupted:
+			log.Infof(ctx, "update interrupted on %s: processed %d of %d",
+				u.commit.Hash, ur.NumProcessed, ur.NumTotal)
+		case err != nil:
 			log.Errorf(ctx, "update failed: %v", err)
-		} else {
+		default:
 			var nAdded, nModified int64
 			if ur != nil {
 				nAdded = int64(ur.NumAdded)
@@ -92,34 +175,59 @@ func (u *cveUpdater) update(ctx context.Context) (ur *store.CommitUpdateRecord,
 	// It is cheaper to read all the files from the repo and compare
 	// them to the DB in bulk, than to walk the repo and process
 	// each file individually.
+	fctx := event.Start(ctx, "cvelistrepo.Files")
 	files, err := cvelistrepo.Files(u.repo, u.commit)
+	event.End(fctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the last update was of this commit and did not finish, pick up
+	// where it left off. Otherwise, create a new CommitUpdateRecord to
+	// describe this run of doUpdate.
+	ur, files, err = u.resume(ctx, files)
 	if err != nil {
 		return nil, err
 	}
+	if ur == nil {
+		ur = &store.CommitUpdateRecord{
+			StartedAt:  time.Now(),
+			CommitHash: u.commit.Hash.String(),
+			CommitTime: u.commit.Committer.When,
+			NumTotal:   len(files),
+		}
+		if err := u.st.CreateCommitUpdateRecord(ctx, ur); err != nil {
+			return ur, err
+		}
+	}
+	// The ID of the record, as shown by list-updates, outlives the run.
+	ctx = log.NewContext(ctx, "updateRecord", ur.ID)
+
 	// Process files in the same directory together, so we can easily skip
 	// the entire directory if it hasn't changed.
 	filesByDir, err := groupFilesByDirectory(files)
 	if err != nil {
 		return nil, err
 	}
-
-	// Create a new CommitUpdateRecord to describe this run of doUpdate.
-	ur = &store.CommitUpdateRecord{
-		StartedAt:  time.Now(),
-		CommitHash: u.commit.Hash.String(),
-		CommitTime: u.commit.Committer.When,
-		NumTotal:   len(files),
-	}
-	if err := u.st.CreateCommitUpdateRecord(ctx, ur); err != nil {
+	if err := u.loadQuarantine(ctx); err != nil {
 		return ur, err
 	}
 
 	var skippedDirs []string
 	const logSkippedEvery = 20 // Log a message every this many skipped directories.
 	for _, dirFiles := range filesByDir {
-		stats, err := u.updateDirectory(ctx, dirFiles)
+		if ctx.Err() != nil {
+			return ur, u.interrupt(ctx, ur)
+		}
+		stats, err := u.updateDirectory(ctx, dirFiles, ur)
 		// Change the CommitUpdateRecord in the Store to reflect the results of the directory update.
 		if err != nil {
+			if ctx.Err() != nil {
+				// The directory's batch in progress was not committed, and
+				// the rest of the directory is processed when the update
+				// resumes.
+				return ur, u.interrupt(ctx, ur)
+			}
 			ur.Error = err.Error()
 			if err2 := u.st.SetCommitUpdateRecord(ctx, ur); err2 != nil {
 				return ur, fmt.Errorf("update failed with %w, could not set update record: %v", err, err2)
@@ -132,29 +240,115 @@ func (u *cveUpdater) update(ctx context.Context) (ur *store.CommitUpdateRecord,
 					skippedDirs[0], len(skippedDirs)-1)
 				skippedDirs = nil
 			}
-		}
-		ur.NumProcessed += stats.numProcessed
-		ur.NumAdded += stats.numAdded
-		ur.NumModified += stats.numModified
-		if err := u.st.SetCommitUpdateRecord(ctx, ur); err != nil {
-			return ur, err
+			// Skipped files count as processed, so the checkpoint moves
+			// past them.
+			if err := u.checkpoint(ctx, ur, dirFiles, 0, 0); err != nil {
+				return ur, err
+			}
 		}
 	}
 	ur.EndedAt = time.Now()
 	return ur, u.st.SetCommitUpdateRecord(ctx, ur)
 }
 
+// resume returns the CommitUpdateRecord of the last update if it was of the
+// same commit and did not finish, along with the files that it did not
+// process. If there is no such update, it returns a nil record and all the
+// files.
+func (u *cveUpdater) resume(ctx context.Context, files []cvelistrepo.File) (*store.CommitUpdateRecord, []cvelistrepo.File, error) {
+	urs, err := u.st.ListCommitUpdateRecords(ctx, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(urs) == 0 {
+		return nil, files, nil
+	}
+	ur := urs[0]
+	ended := !ur.EndedAt.IsZero() && !ur.Interrupted
+	if ur.CommitHash != u.commit.Hash.String() || ended || ur.LastPath == "" {
+		return nil, files, nil
+	}
+	for i, f := range files {
+		if path.Join(f.DirPath, f.Filename) == ur.LastPath {
+			log.Infof(ctx, "resuming update %s of %s after %s: %d of %d files left",
+				ur.ID, ur.CommitHash, ur.LastPath, len(files)-i-1, len(files))
+			ur.Error = ""
+			ur.Interrupted = false
+			ur.EndedAt = time.Time{}
+			return ur, files[i+1:], nil
+		}
+	}
+	// The files are the same for the same commit, so this shouldn't happen.
+	log.Warningf(ctx, "update %s: checkpoint %s not found; starting over", ur.ID, ur.LastPath)
+	return nil, files, nil
+}
+
+// checkpoint records in ur and the store that the given files, the next ones
+// in order, have been processed, adding numAdded and numModified to the
+// totals. A restarted update resumes after the last checkpoint.
+func (u *cveUpdater) checkpoint(ctx context.Context, ur *store.CommitUpdateRecord, files []cvelistrepo.File, numAdded, numModified int) error {
+	last := files[len(files)-1]
+	ur.NumProcessed += len(files)
+	cvesProcessedCounter.Record(ctx, int64(len(files)), event.String("source", "cvelist"))
+	ur.NumAdded += numAdded
+	ur.NumModified += numModified
+	ur.LastPath = path.Join(last.DirPath, last.Filename)
+	return u.st.SetCommitUpdateRecord(ctx, ur)
+}
+
+// interrupt records in ur and the store that the update stopped because ctx
+// was canceled, as when the server shuts down, and returns the context's
+// error. The record keeps the last checkpoint, so the next update of the same
+// commit resumes after it.
+func (u *cveUpdater) interrupt(ctx context.Context, ur *store.CommitUpdateRecord) error {
+	ur.Interrupted = true
+	ur.EndedAt = time.Now()
+	// The record must be written even though ctx is done.
+	if err := u.st.SetCommitUpdateRecord(detach(ctx), ur); err != nil {
+		return fmt.Errorf("update interrupted with %w, could not set update record: %v", ctx.Err(), err)
+	}
+	return ctx.Err()
+}
+
+// updateParallelism is the number of CVEs that an update parses and triages
+// at once.
+var updateParallelism = defaultUpdateParallelism
+
+const defaultUpdateParallelism = 10
+
+// SetUpdateParallelism sets the number of CVEs that an update parses and
+// triages at once. If n is not positive, the default is used.
+func SetUpdateParallelism(n int) {
+	if n <= 0 {
+		n = defaultUpdateParallelism
+	}
+	updateParallelism = n
+}
+
 // Firestore supports a maximum of 500 writes per transaction.
 // See https://cloud.google.com/firestore/quotas.
 const maxTransactionWrites = 500
 
-func (u *cveUpdater) updateDirectory(ctx context.Context, dirFiles []cvelistrepo.File) (_ updateStats, err error) {
+// updateBatchSize is the number of CVEs or GHSAs that an update stores in a
+// transaction. Each writes its record, its search document, and the alias
+// documents of its ID and of its aliases, of which there is usually one, so
+// five writes apiece leaves room for those with more aliases.
+const updateBatchSize = maxTransactionWrites / 5
+
+// updateDirectory updates the store with dirFiles, the files of a directory
+// that have not yet been processed. After each batch of files, it records a
+// checkpoint in ur.
+func (u *cveUpdater) updateDirectory(ctx context.Context, dirFiles []cvelistrepo.File, ur *store.CommitUpdateRecord) (_ updateStats, err error) {
 	dirPath := dirFiles[0].DirPath
 	dirHash := dirFiles[0].TreeHash.String()
+	ctx = event.Start(ctx, "updateDirectory")
+	defer event.End(ctx)
+	observe.SetSpanAttribute(ctx, "dir", dirPath)
 
 	// A non-empty directory hash means that we have fully processed the directory
 	// with that hash. If the stored hash matches the current one, we can skip
-	// this directory.
+	// this directory. When resuming in the middle of a directory, the hash is
+	// "in progress", so the rest of the directory is processed.
 	dbHash, err := u.st.GetDirectoryHash(ctx, dirPath)
 	if err != nil {
 		return updateStats{}, err
@@ -171,23 +365,40 @@ func (u *cveUpdater) updateDirectory(ctx context.Context, dirFiles []cvelistrepo
 
 	// Update files in batches.
 
-	var stats updateStats
-	for i := 0; i < len(dirFiles); i += maxTransactionWrites {
-		j := i + maxTransactionWrites
+	var (
+		stats     updateStats
+		numFailed int
+	)
+	for i := 0; i < len(dirFiles); i += updateBatchSize {
+		// Stop between batches if the update is canceled.
+		if err := ctx.Err(); err != nil {
+			return updateStats{}, err
+		}
+		j := i + updateBatchSize
 		if j > len(dirFiles) {
 			j = len(dirFiles)
 		}
-		numBatchAdds, numBatchMods, err := u.updateBatch(ctx, dirFiles[i:j])
+		numBatchAdds, numBatchMods, numBatchFailed, err := u.updateBatch(ctx, dirFiles[i:j])
 		if err != nil {
 			return updateStats{}, err
 		}
+		numFailed += numBatchFailed
 		stats.numProcessed += j - i
 		// Add in these two numbers here, instead of in the function passed to
 		// RunTransaction, because that function may be executed multiple times.
 		stats.numAdded += numBatchAdds
 		stats.numModified += numBatchMods
+		if err := u.checkpoint(ctx, ur, dirFiles[i:j], numBatchAdds, numBatchMods); err != nil {
+			return updateStats{}, err
+		}
 	} // end batch loop
 
+	if numFailed > 0 {
+		// Leave the hash "in progress", so that the next update tries the
+		// files that failed to parse again.
+		log.Warningf(ctx, "%d files in %s failed to parse", numFailed, dirPath)
+		return stats, nil
+	}
 	// We're done with this directory, so we can remember its hash.
 	if err := u.st.SetDirectoryHash(ctx, dirPath, dirHash); err != nil {
 		return updateStats{}, err
@@ -195,10 +406,23 @@ func (u *cveUpdater) updateDirectory(ctx context.Context, dirFiles []cvelistrepo
 	return stats, nil
 }
 
-func (u *cveUpdater) updateBatch(ctx context.Context, batch []cvelistrepo.File) (numAdds, numMods int, err error) {
+// updateBatch updates the store with the files of batch. It skips files that
+// are quarantined or fail to parse, and returns the number that failed.
+func (u *cveUpdater) updateBatch(ctx context.Context, batch []cvelistrepo.File) (numAdds, numMods, numFailed int, err error) {
 	startID := idFromFilename(batch[0].Filename)
 	endID := idFromFilename(batch[len(batch)-1].Filename)
 	defer derrors.Wrap(&err, "updateBatch(%s-%s)", startID, endID)
+	ctx = event.Start(ctx, "updateBatch")
+	defer event.End(ctx)
+	observe.SetSpanAttribute(ctx, "start", startID)
+	observe.SetSpanAttribute(ctx, "end", endID)
+
+	// Parsing and triaging the CVEs is the slow part of an update, so do it
+	// concurrently, before the transaction.
+	prepared, failures, err := u.prepareBatch(ctx, batch, startID, endID)
+	if err != nil {
+		return 0, 0, 0, err
+	}
 
 	err = u.st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
 		numAdds = 0
@@ -223,7 +447,18 @@ func (u *cveUpdater) updateBatch(ctx context.Context, batch []cvelistrepo.File)
 				// No change; do nothing.
 				continue
 			}
-			added, err := u.handleCVE(f, old, tx)
+			if u.quarantined(f) || failures[id] != nil {
+				continue
+			}
+			p := prepared[id]
+			if p == nil || !slices.Equal(p.ignoredRefs, ignoredRefs(old)) {
+				// The record changed after the CVE was triaged.
+				p, err = u.prepareCVE(ctx, f, old)
+				if err != nil {
+					return err
+				}
+			}
+			added, err := storeCVE(tx, p.cve, old, p.src, p.result, u.knownIDs)
 			if err != nil {
 				return err
 			}
@@ -236,90 +471,335 @@ func (u *cveUpdater) updateBatch(ctx context.Context, batch []cvelistrepo.File)
 		return nil
 	})
 	if err != nil {
-		return 0, 0, err
+		return 0, 0, 0, err
 	}
 	log.Debugf(ctx, "update transaction %s=%s: added %d, modified %d", startID, endID, numAdds, numMods)
-	return numAdds, numMods, nil
+	numFailed, err = u.recordParseResults(ctx, batch, prepared, failures)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return numAdds, numMods, numFailed, nil
 }
 
-// handleCVE determines how to change the store for a single CVE.
-// The CVE will definitely be either added, if it's new, or modified, if it's
-// already in the DB.
-func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVERecord, tx store.Transaction) (added bool, err error) {
-	defer derrors.Wrap(&err, "handleCVE(%s)", f.Filename)
-	cve, err := cvelistrepo.ParseCVE(u.repo, f)
+// A preparedCVE is a CVE from the repo that has been parsed and triaged, but
+// not yet stored.
+type preparedCVE struct {
+	cve    *cveschema.CVE
+	src    cveSource
+	result *triageResult
+	// ignoredRefs are the references that triage ignored, from the
+	// record in the store at the time.
+	ignoredRefs []string
+}
+
+// prepareBatch parses and triages the CVEs in batch that differ from their
+// records in the store and are not quarantined, using up to
+// updateParallelism goroutines. It returns them by ID, along with the errors
+// of those that failed to parse.
+func (u *cveUpdater) prepareBatch(ctx context.Context, batch []cvelistrepo.File, startID, endID string) (map[string]*preparedCVE, map[string]error, error) {
+	ctx = event.Start(ctx, "prepareBatch")
+	defer event.End(ctx)
+
+	var crs []*store.CVERecord
+	err := u.st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		var err error
+		crs, err = tx.GetCVERecords(startID, endID)
+		return err
+	})
 	if err != nil {
-		return false, err
+		return nil, nil, err
+	}
+	idToRecord := map[string]*store.CVERecord{}
+	for _, cr := range crs {
+		idToRecord[cr.ID] = cr
 	}
-	var result *triageResult
-	if cve.State == cveschema.StatePublic && !u.knownIDs[cve.ID] {
-		c := cve
-		// If a false positive has changed, we only care about
-		// whether new reference URLs refer to a Go module.
-		// We know some old ones do. So remove the old ones
-		// before checking.
-		if old != nil && old.TriageState == store.TriageStateFalsePositive {
-			c = copyRemoving(cve, old.ReferenceURLs)
-		}
-		result, err = u.affectedModule(c)
+
+	var (
+		mu       sync.Mutex
+		prepared = map[string]*preparedCVE{}
+		failures = map[string]error{}
+		g        errgroup.Group
+	)
+	g.SetLimit(updateParallelism)
+	for _, f := range batch {
+		f := f
+		id := idFromFilename(f.Filename)
+		old := idToRecord[id]
+		if old != nil && old.BlobHash == f.BlobHash.String() {
+			continue
+		}
+		if u.quarantined(f) {
+			log.Debugf(ctx, "skipping quarantined file %s", path.Join(f.DirPath, f.Filename))
+			continue
+		}
+		g.Go(func() error {
+			p, err := u.prepareCVE(ctx, f, old)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case isParseError(err):
+				failures[id] = err
+			case err != nil:
+				return err
+			default:
+				prepared[id] = p
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
+	}
+	return prepared, failures, nil
+}
+
+// prepareCVE parses and triages the CVE in f, whose record in the store is
+// old.
+func (u *cveUpdater) prepareCVE(ctx context.Context, f cvelistrepo.File, old *store.CVERecord) (_ *preparedCVE, err error) {
+	defer derrors.Wrap(&err, "prepareCVE(%s)", f.Filename)
+	ctx = event.Start(ctx, "prepareCVE")
+	defer event.End(ctx)
+	ctx = log.NewContext(ctx, "cve", idFromFilename(f.Filename))
+	observe.SetSpanAttribute(ctx, "file", f.Filename)
+	defer func() { observe.SetSpanError(ctx, err) }()
+
+	// Most CVEs need nothing but triage, so decode only what it uses.
+	pctx := event.Start(ctx, "cvelistrepo.ParseCVEForTriage")
+	cve, err := cvelistrepo.ParseCVEForTriage(u.repo, f)
+	event.End(pctx)
+	if err != nil {
+		return nil, &parseError{err}
+	}
+	result, err := triageForStore(ctx, cve, old, u.knownIDs, u.affectedModule)
+	if err != nil {
+		return nil, err
+	}
+	if result != nil {
+		// The CVE may need an issue, so its record will hold all of it.
+		pctx := event.Start(ctx, "cvelistrepo.ParseCVE")
+		cve, err = cvelistrepo.ParseCVE(u.repo, f)
+		event.End(pctx)
 		if err != nil {
+			return nil, &parseError{err}
+		}
+	}
+	return &preparedCVE{
+		cve: cve,
+		src: cveSource{
+			path:       path.Join(f.DirPath, f.Filename),
+			blobHash:   f.BlobHash.String(),
+			commitHash: u.commit.Hash.String(),
+			commitTime: u.commit.Committer.When.In(time.UTC),
+		},
+		result:      result,
+		ignoredRefs: ignoredRefs(old),
+	}, nil
+}
+
+// A cveSource describes where a version of a CVE came from.
+type cveSource struct {
+	path       string
+	blobHash   string
+	commitHash string
+	commitTime time.Time
+}
+
+// triageAndStoreCVE triages cve and adds or modifies its record in the
+// store. The record is added if old is nil, and modified otherwise.
+func triageAndStoreCVE(ctx context.Context, tx store.Transaction, cve *cveschema.CVE, old *store.CVERecord, src cveSource, knownIDs map[string]bool, affectedModule triageFunc) (added bool, err error) {
+	ctx = log.NewContext(ctx, "cve", cve.ID)
+	result, err := triageForStore(ctx, cve, old, knownIDs, affectedModule)
+	if err != nil {
+		return false, err
+	}
+	return storeCVE(tx, cve, old, src, result, knownIDs)
+}
+
+// triageForStore triages cve, whose record in the store is old, if it
+// needs triage.
+func triageForStore(ctx context.Context, cve *cveschema.CVE, old *store.CVERecord, knownIDs map[string]bool, affectedModule triageFunc) (*triageResult, error) {
+	if cve.State != cveschema.StatePublic || knownIDs[cve.ID] {
+		return nil, nil
+	}
+	c := cve
+	// If a false positive has changed, we only care about
+	// whether new reference URLs refer to a Go module.
+	// We know some old ones do. So remove the old ones
+	// before checking.
+	if refs := ignoredRefs(old); refs != nil {
+		c = copyRemoving(cve, refs)
+	}
+	result, err := affectedModule(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if result != nil && !result.falsePositive && !result.notModule {
+		result.goScore = scoreCVE(ctx, cve)
+	}
+	return result, nil
+}
+
+// ignoredRefs returns the references of the CVE whose record is old that
+// triage ignores: those of a false positive.
+func ignoredRefs(old *store.CVERecord) []string {
+	if old != nil && old.TriageState == store.TriageStateFalsePositive {
+		return old.ReferenceURLs
+	}
+	return nil
+}
+
+// storeCVE adds or modifies the record of cve in the store, given the
+// result of triaging it, records the aliases of cve, and indexes the text of
+// cve for search. The record is added if old is nil, and modified otherwise.
+func storeCVE(tx store.Transaction, cve *cveschema.CVE, old *store.CVERecord, src cveSource, result *triageResult, knownIDs map[string]bool) (added bool, err error) {
+	added, err = storeCVERecord(tx, cve, old, src, result, knownIDs)
+	if err != nil {
+		return false, err
+	}
+	if ids := cveAliases(cve); len(ids) > 1 {
+		if err := tx.AddAliases(ids...); err != nil {
 			return false, err
 		}
 	}
+	return added, tx.SetSearchTerms(cve.ID, cveSearchTerms(cve))
+}
+
+// storeCVERecord adds or modifies the record of cve in the store, as
+// storeCVE does.
+func storeCVERecord(tx store.Transaction, cve *cveschema.CVE, old *store.CVERecord, src cveSource, result *triageResult, knownIDs map[string]bool) (added bool, err error) {
+	// A triage rule may have decided that the CVE is a false positive, or
+	// triage that it is not about a module.
+	var falsePositive, notModule *triageResult
+	if result != nil && result.falsePositive {
+		falsePositive, result = result, nil
+	}
+	if result != nil && result.notModule {
+		notModule, result = result, nil
+	}
 
-	pathname := path.Join(f.DirPath, f.Filename)
 	// If the CVE is not in the database, add it.
 	if old == nil {
-		// TODO(https://go.dev/issues/54049): Check if there is already
-		// a record in the store for a GHSA that is an alias of this CVE ID,
-		// to avoid creating duplicate issues.
-		cr := store.NewCVERecord(cve, pathname, f.BlobHash.String(), u.commit)
+		cr := &store.CVERecord{
+			ID:         cve.ID,
+			CVEState:   cve.State,
+			Disputed:   cve.IsDisputed(),
+			CVSS:       cve.CVSSV3(),
+			Path:       src.path,
+			BlobHash:   src.blobHash,
+			CommitHash: src.commitHash,
+			CommitTime: src.commitTime,
+		}
+		var (
+			alias  *store.GHSARecord
+			report string
+		)
+		if result != nil {
+			// A Go report or a GHSA for the same vulnerability may have
+			// been added first.
+			report, err = findGoReportAlias(tx, cve.ID)
+			if err != nil {
+				return false, err
+			}
+			alias, err = findGHSAAlias(tx, cve.ID)
+			if err != nil {
+				return false, err
+			}
+		}
 		switch {
+		case report != "":
+			cr.TriageState = store.TriageStateHasVuln
+			cr.TriageStateReason = report
+			cr.Module = result.modulePath
+			cr.CandidateModules = result.candidates
+			cr.Package = result.packagePath
+		case alias != nil:
+			cr.TriageState = store.TriageStateAlias
+			cr.TriageStateReason = aliasReason(alias.GHSA.ID, alias.IssueReference)
+			cr.Module = result.modulePath
+			cr.CandidateModules = result.candidates
+			cr.Package = result.packagePath
 		case result != nil:
 			cr.TriageState = store.TriageStateNeedsIssue
 			cr.Module = result.modulePath
+			cr.CandidateModules = result.candidates
+			cr.SuggestedExclusion = string(result.excluded)
 			cr.Package = result.packagePath
 			cr.TriageStateReason = result.reason
+			cr.GoScore = result.goScore
 			cr.CVE = cve
-		case u.knownIDs[cve.ID]:
+		case knownIDs[cve.ID]:
 			cr.TriageState = store.TriageStateHasVuln
+		case falsePositive != nil:
+			setFalsePositive(cr, cve, falsePositive.reason)
+		case notModule != nil:
+			cr.TriageState = store.TriageStateNotAModule
+			cr.TriageStateReason = notModule.reason
 		default:
 			cr.TriageState = store.TriageStateNoActionNeeded
 		}
+		if keepsTriage(cr.TriageState) {
+			cr.TriagedContent = store.NewCVEContent(cve)
+		}
 		if err := tx.CreateCVERecord(cr); err != nil {
 			return false, err
 		}
 		return true, nil
 	}
 	// Change to an existing record.
+	content := store.NewCVEContent(cve)
+	change := cveChange(old, content, src)
 	mod := *old // copy the old one
-	mod.Path = pathname
-	mod.BlobHash = f.BlobHash.String()
+	mod.Path = src.path
+	mod.BlobHash = src.blobHash
 	mod.CVEState = cve.State
-	mod.CommitHash = u.commit.Hash.String()
-	mod.CommitTime = u.commit.Committer.When.In(time.UTC)
+	mod.Disputed = cve.IsDisputed()
+	mod.CVSS = cve.CVSSV3()
+	mod.CommitHash = src.commitHash
+	mod.CommitTime = src.commitTime
 	switch old.TriageState {
-	case store.TriageStateNoActionNeeded, store.TriageStateFalsePositive:
+	case store.TriageStateNoActionNeeded, store.TriageStateFalsePositive, store.TriageStateNotAModule:
 		if result != nil {
 			// Didn't need an issue before, does now.
 			mod.TriageState = store.TriageStateNeedsIssue
 			mod.Module = result.modulePath
+			mod.CandidateModules = result.candidates
+			mod.SuggestedExclusion = string(result.excluded)
 			mod.Package = result.packagePath
 			mod.TriageStateReason = result.reason
+			mod.GoScore = result.goScore
 			mod.CVE = cve
+		} else if falsePositive != nil {
+			setFalsePositive(&mod, cve, falsePositive.reason)
+		} else if notModule != nil && old.TriageState != store.TriageStateFalsePositive {
+			mod.TriageState = store.TriageStateNotAModule
+			mod.TriageStateReason = notModule.reason
+		} else if old.TriageState == store.TriageStateNotAModule {
+			// A candidate module path has appeared in the proxy.
+			mod.TriageState = store.TriageStateNoActionNeeded
+			mod.TriageStateReason = ""
 		}
 		// Else don't change the triage state, but we still want
 		// to update the other changed fields.
 
 	case store.TriageStateNeedsIssue:
-		if result == nil {
+		if falsePositive != nil {
+			setFalsePositive(&mod, cve, falsePositive.reason)
+		} else if result == nil {
 			// Needed an issue, no longer does.
 			mod.TriageState = store.TriageStateNoActionNeeded
 			mod.Module = ""
+			mod.CandidateModules = nil
+			mod.SuggestedExclusion = ""
 			mod.CVE = nil
+			if notModule != nil {
+				mod.TriageState = store.TriageStateNotAModule
+				mod.TriageStateReason = notModule.reason
+			}
+		} else {
+			// Don't change the triage state, but update the other
+			// changed fields, and the score of the changed CVE.
+			mod.GoScore = result.goScore
 		}
-		// Else don't change the triage state, but we still want
-		// to update the other changed fields.
 
 	case store.TriageStateIssueCreated, store.TriageStateUpdatedSinceIssueCreation:
 		// An issue was filed, so a person should revisit this CVE.
@@ -328,7 +808,11 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVERecord, tx stor
 		if result != nil {
 			mp = result.modulePath
 		}
-		mod.TriageStateReason = fmt.Sprintf("CVE changed; affected module = %q", mp)
+		what := "CVE changed"
+		if change != nil {
+			what = fmt.Sprintf("CVE changed (%s)", change.Summary())
+		}
+		mod.TriageStateReason = fmt.Sprintf("%s; affected module = %q", what, mp)
 	case store.TriageStateAlias:
 		// For now, do nothing.
 	case store.TriageStateHasVuln:
@@ -337,6 +821,17 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVERecord, tx stor
 	default:
 		return false, fmt.Errorf("unknown TriageState: %q", old.TriageState)
 	}
+	switch {
+	case !keepsTriage(mod.TriageState):
+		mod.TriagedContent, mod.Change = nil, nil
+	case mod.TriagedContent == nil ||
+		(mod.TriageState != old.TriageState && mod.TriageState != store.TriageStateUpdatedSinceIssueCreation):
+		// Triage has just decided the record's state, or the record
+		// predates triaged content.
+		mod.TriagedContent, mod.Change = content, nil
+	default:
+		mod.Change = change
+	}
 	// If the triage state changed, add the old state to the history at the beginning.
 	if old.TriageState != mod.TriageState {
 		mod.History = append([]*store.CVERecordSnapshot{old.Snapshot()}, mod.History...)
@@ -351,6 +846,50 @@ func (u *cveUpdater) handleCVE(f cvelistrepo.File, old *store.CVERecord, tx stor
 	return false, nil
 }
 
+// keepsTriage reports whether a record in triage state ts keeps it when
+// its CVE changes, so that a person has to triage the change. Records in
+// other states are triaged again by each update that changes them.
+func keepsTriage(ts store.TriageState) bool {
+	switch ts {
+	case store.TriageStateNoActionNeeded, store.TriageStateNotAModule:
+		return false
+	}
+	return true
+}
+
+// cveChange returns how the CVE of old changed since old was triaged, given
+// its new content from src, or nil if old has no triaged content or the
+// content is the same.
+func cveChange(old *store.CVERecord, content *store.CVEContent, src cveSource) *store.CVEChange {
+	if old.TriagedContent == nil || content.Equal(old.TriagedContent) {
+		return nil
+	}
+	return &store.CVEChange{
+		CommitHash: src.commitHash,
+		CommitTime: src.commitTime,
+		Old:        old.TriagedContent,
+		New:        content,
+	}
+}
+
+// setFalsePositive changes cr to record that cve is a false positive for the
+// given reason.
+func setFalsePositive(cr *store.CVERecord, cve *cveschema.CVE, reason string) {
+	cr.TriageState = store.TriageStateFalsePositive
+	cr.TriageStateReason = reason
+	cr.Module = ""
+	cr.CandidateModules = nil
+	cr.SuggestedExclusion = ""
+	cr.Package = ""
+	cr.CVE = nil
+	cr.ReferenceURLs = nil
+	for _, r := range cve.References.Data {
+		if r.URL != "" {
+			cr.ReferenceURLs = append(cr.ReferenceURLs, r.URL)
+		}
+	}
+}
+
 // copyRemoving returns a copy of cve with any reference that has a given URL removed.
 func copyRemoving(cve *cveschema.CVE, refURLs []string) *cveschema.CVE {
 	remove := map[string]bool{}
@@ -412,15 +951,24 @@ type UpdateGHSAStats struct {
 	NumModified int
 }
 
-// triageNewGHSA determines the initial triage state for the GHSA.
-// It checks if we have already handled a CVE associated with this GHSA.
-func triageNewGHSA(sa *ghsa.SecurityAdvisory, tx store.Transaction) (store.TriageState, error) {
+// triageNewGHSA determines the initial triage state for the GHSA, and the
+// reason for it. It checks if we have already handled a CVE associated with
+// this GHSA.
+func triageNewGHSA(sa *ghsa.SecurityAdvisory, tx store.Transaction) (store.TriageState, string, error) {
+	report, err := findGoReportAlias(tx, ghsaAliases(sa)...)
+	if err != nil {
+		return store.TriageStateNeedsIssue, "", err
+	}
+	if report != "" {
+		// A report in the Go vulnerability database already covers it.
+		return store.TriageStateHasVuln, report, nil
+	}
 	for _, alias := range sa.Identifiers {
 		if alias.Type == "CVE" {
 			cveID := alias.Value
 			cves, err := tx.GetCVERecords(cveID, cveID)
 			if err != nil {
-				return store.TriageStateNeedsIssue, err
+				return store.TriageStateNeedsIssue, "", err
 			}
 			if len(cves) == 0 {
 				continue
@@ -431,23 +979,100 @@ func triageNewGHSA(sa *ghsa.SecurityAdvisory, tx store.Transaction) (store.Triag
 				// The vuln was already covered by the CVE.
 				// TODO(https://go.dev/issues/55303): Add comment to
 				// existing issue with new alias.
-				return store.TriageStateAlias, nil
-			case store.TriageStateFalsePositive, store.TriageStateNoActionNeeded, store.TriageStateAlias:
+				return store.TriageStateAlias, aliasReason(cves[0].ID, cves[0].IssueReference), nil
+			case store.TriageStateFalsePositive, store.TriageStateNoActionNeeded, store.TriageStateAlias, store.TriageStateNotAModule:
 				// Create an issue for the GHSA since no issue
 				// was created for the CVE.
-				return store.TriageStateNeedsIssue, nil
+				return store.TriageStateNeedsIssue, "", nil
 			}
 		}
 	}
 
 	// The GHSA has no associated CVEs.
-	return store.TriageStateNeedsIssue, nil
+	return store.TriageStateNeedsIssue, "", nil
+}
+
+// findGHSAAlias returns a GHSARecord for an advisory that is an alias of the
+// CVE with the given ID and that has been or will be handled, or nil if there
+// is none. It is the counterpart of triageNewGHSA, for CVEs that are added
+// after their GHSA aliases.
+func findGHSAAlias(tx store.Transaction, cveID string) (*store.GHSARecord, error) {
+	grs, err := tx.GetGHSARecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, gr := range grs {
+		switch gr.TriageState {
+		case store.TriageStateIssueCreated, store.TriageStateHasVuln,
+			store.TriageStateNeedsIssue, store.TriageStateUpdatedSinceIssueCreation:
+		default:
+			continue
+		}
+		for _, id := range gr.GHSA.Identifiers {
+			if id.Type == "CVE" && id.Value == cveID {
+				return gr, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// findGoReportAlias returns the ID of a report in the Go vulnerability
+// database that is an alias of one of the given IDs, or "" if there is none.
+func findGoReportAlias(tx store.Transaction, ids ...string) (string, error) {
+	for _, id := range ids {
+		aliases, err := tx.GetAliases(id)
+		if err != nil {
+			return "", err
+		}
+		if reports := osvdev.GoReports(aliases); len(reports) > 0 {
+			return reports[0], nil
+		}
+	}
+	return "", nil
+}
+
+// ghsaAliases returns the ID of the GHSA followed by the CVE IDs it lists.
+func ghsaAliases(sa *ghsa.SecurityAdvisory) []string {
+	ids := []string{sa.ID}
+	for _, id := range sa.Identifiers {
+		if id.Type == "CVE" {
+			ids = append(ids, id.Value)
+		}
+	}
+	return ids
+}
+
+// cveAliases returns the ID of the CVE followed by the IDs of the GitHub
+// security advisories among its references. A CVE that refers to other CVEs
+// is often only related to them, but a GitHub advisory that a CVE refers to
+// is almost always the same vulnerability.
+func cveAliases(cve *cveschema.CVE) []string {
+	ids := []string{cve.ID}
+	for _, r := range cve.References.Data {
+		if id := refurl.AdvisoryID(r.URL); strings.HasPrefix(id, "GHSA-") {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// aliasReason returns the TriageStateReason for a record that is an alias of
+// the vulnerability with the given ID, which may have an issue.
+func aliasReason(id, issueReference string) string {
+	if issueReference == "" {
+		return "alias of " + id
+	}
+	return fmt.Sprintf("alias of %s (%s)", id, issueReference)
 }
 
 func updateGHSAs(ctx context.Context, listSAs GHSAListFunc, since time.Time, st store.Store) (stats UpdateGHSAStats, err error) {
 	defer derrors.Wrap(&err, "updateGHSAs(%s)", since)
 	ctx = event.Start(ctx, "updateGHSAs")
 	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "ghsa")
+	defer func() { end(err) }()
+	defer func() { countUpdate(ctx, stats.NumProcessed, stats.NumAdded, stats.NumModified) }()
 
 	defer func() {
 		if err != nil {
@@ -465,11 +1090,35 @@ func updateGHSAs(ctx context.Context, listSAs GHSAListFunc, since time.Time, st
 		return stats, err
 	}
 	stats.NumProcessed = len(sas)
-	if len(sas) > maxTransactionWrites {
-		return stats, errors.New("number of advisories exceeds maxTransactionWrites")
+	// Process the GHSAs in the order they were updated, so that the cursor
+	// saved after each batch never passes a GHSA that hasn't been stored.
+	sort.SliceStable(sas, func(i, j int) bool { return sas[i].UpdatedAt.Before(sas[j].UpdatedAt) })
+	var latest time.Time
+	for i := 0; i < len(sas); i += updateBatchSize {
+		j := i + updateBatchSize
+		if j > len(sas) {
+			j = len(sas)
+		}
+		numAdded, numModified, err := updateGHSABatch(ctx, sas[i:j], st)
+		if err != nil {
+			return stats, err
+		}
+		stats.NumAdded += numAdded
+		stats.NumModified += numModified
+		for _, sa := range sas[i:j] {
+			if sa.UpdatedAt.After(latest) {
+				latest = sa.UpdatedAt
+			}
+		}
+		// Remember how far we got, so the next update can start there.
+		if err := st.SetFetchCursor(ctx, ghsaCursorSource, latest); err != nil {
+			return stats, err
+		}
 	}
-	numAdded := 0
-	numModified := 0
+	return stats, nil
+}
+
+func updateGHSABatch(ctx context.Context, batch []*ghsa.SecurityAdvisory, st store.Store) (numAdded, numModified int, err error) {
 	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
 		numAdded = 0
 		numModified = 0
@@ -486,18 +1135,19 @@ func updateGHSAs(ctx context.Context, listSAs GHSAListFunc, since time.Time, st
 		// Determine what needs to be added and modified.
 		var toAdd []*store.GHSARecord
 		var toUpdate []*store.GHSARecord
-		for _, sa := range sas {
+		for _, sa := range batch {
 			old := ghsaIDToRecord[sa.ID]
 			if old == nil {
 				// ghsa.List already filters for vulns in the Go ecosystem,
 				// so add a record for all found GHSAs.
-				triageState, err := triageNewGHSA(sa, tx)
+				triageState, reason, err := triageNewGHSA(sa, tx)
 				if err != nil {
 					return err
 				}
 				toAdd = append(toAdd, &store.GHSARecord{
-					GHSA:        sa,
-					TriageState: triageState,
+					GHSA:              sa,
+					TriageState:       triageState,
+					TriageStateReason: reason,
 				})
 			} else if !old.GHSA.UpdatedAt.Equal(sa.UpdatedAt) {
 				// Modify record.
@@ -528,10 +1178,19 @@ func updateGHSAs(ctx context.Context, listSAs GHSAListFunc, since time.Time, st
 			}
 			numModified++
 		}
+		for _, r := range append(toAdd, toUpdate...) {
+			if err := tx.AddAliases(ghsaAliases(r.GHSA)...); err != nil {
+				return err
+			}
+			if err := tx.SetSearchTerms(r.GHSA.ID, ghsaSearchTerms(r.GHSA)); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
-	stats.NumAdded = numAdded
-	stats.NumModified = numModified
-	return stats, nil
+	if err != nil {
+		return 0, 0, err
+	}
+	return numAdded, numModified, nil
 }
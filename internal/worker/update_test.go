@@ -9,13 +9,24 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/time/rate"
 	"golang.org/x/vulndb/internal/cvelistrepo"
 	"golang.org/x/vulndb/internal/cveschema"
 	"golang.org/x/vulndb/internal/gitrepo"
@@ -63,6 +74,12 @@ func modify(r, m *store.CVERecord) *store.CVERecord {
 	if m.History != nil {
 		c.History = m.History
 	}
+	if m.TriagedContent != nil {
+		c.TriagedContent = m.TriagedContent
+	}
+	if m.Change != nil {
+		c.Change = m.Change
+	}
 	return &c
 }
 
@@ -90,7 +107,7 @@ func TestDoUpdate(t *testing.T) {
 	}
 	commit := headCommit(t, repo)
 	purl := getPkgsiteURL(t)
-	needsIssue := func(cve *cveschema.CVE) (*triageResult, error) {
+	needsIssue := func(_ context.Context, cve *cveschema.CVE) (*triageResult, error) {
 		return TriageCVE(ctx, cve, purl)
 	}
 
@@ -122,9 +139,17 @@ func TestDoUpdate(t *testing.T) {
 	rs[0].TriageState = store.TriageStateNeedsIssue // a public CVE, has a golang.org path
 	rs[0].Module = "golang.org/x/mod"
 	rs[0].CVE = cves[0]
+	rs[0].TriagedContent = store.NewCVEContent(cves[0])
 	rs[1].TriageState = store.TriageStateNoActionNeeded // state is reserved
 	rs[2].TriageState = store.TriageStateNoActionNeeded // state is rejected
 	rs[3].TriageState = store.TriageStateHasVuln
+	rs[3].TriagedContent = store.NewCVEContent(cves[3])
+
+	// The content of cves[0] when it was triaged, before a reference was
+	// added and its description changed.
+	oldContent := store.NewCVEContent(cves[0])
+	oldContent.Description = "An earlier description."
+	oldContent.References = oldContent.References[:1]
 
 	for _, test := range []struct {
 		name string
@@ -208,11 +233,41 @@ func TestDoUpdate(t *testing.T) {
 				modify(rs[1], &store.CVERecord{
 					TriageState:       store.TriageStateUpdatedSinceIssueCreation,
 					TriageStateReason: `CVE changed; affected module = ""`,
+					TriagedContent:    store.NewCVEContent(cves[1]),
 				}),
 				rs[2],
 				rs[3],
 			},
 		},
+		{
+			name: "post-issue content changes",
+			cur: []*store.CVERecord{
+				// IssueCreated -> Updated, with the change since triage.
+				modify(rs[0], &store.CVERecord{
+					BlobHash:       "x",
+					TriageState:    store.TriageStateIssueCreated,
+					TriagedContent: oldContent,
+				}),
+			},
+			want: []*store.CVERecord{
+				modify(rs[0], &store.CVERecord{
+					TriageState:    store.TriageStateUpdatedSinceIssueCreation,
+					TriagedContent: oldContent,
+					Change: &store.CVEChange{
+						CommitHash: commitHash,
+						CommitTime: rs[0].CommitTime,
+						Old:        oldContent,
+						New:        store.NewCVEContent(cves[0]),
+					},
+					History: []*store.CVERecordSnapshot{{
+						CommitHash:  commitHash,
+						CVEState:    cveschema.StatePublic,
+						TriageState: store.TriageStateIssueCreated,
+					}},
+				}),
+				rs[1], rs[2], rs[3],
+			},
+		},
 		{
 			name: "false positive no Go URLs",
 			cur: []*store.CVERecord{
@@ -276,7 +331,7 @@ func TestDoUpdate(t *testing.T) {
 				want[cr.ID] = cr
 			}
 			if diff := cmp.Diff(want, got,
-				cmpopts.IgnoreFields(store.CVERecord{}, "TriageStateReason"),
+				cmpopts.IgnoreFields(store.CVERecord{}, "TriageStateReason", "Version"),
 				cmpopts.IgnoreFields(store.CVERecordSnapshot{}, "TriageStateReason")); diff != "" {
 				t.Errorf("mismatch (-want, +got):\n%s", diff)
 			}
@@ -284,6 +339,291 @@ func TestDoUpdate(t *testing.T) {
 	}
 }
 
+func TestStoreCVENotAModule(t *testing.T) {
+	ctx := context.Background()
+	cve := &cveschema.CVE{Metadata: cveschema.Metadata{ID: "CVE-2022-0001", State: cveschema.StatePublic}}
+	src := cveSource{path: "2022/0xxx/CVE-2022-0001.json", blobHash: "b", commitHash: "c", commitTime: time.Now().UTC()}
+	notModule := func(context.Context, *cveschema.CVE) (*triageResult, error) {
+		return &triageResult{notModule: true, reason: "not in proxy"}, nil
+	}
+	module := func(context.Context, *cveschema.CVE) (*triageResult, error) {
+		return &triageResult{modulePath: "example.com/m"}, nil
+	}
+	none := func(context.Context, *cveschema.CVE) (*triageResult, error) { return nil, nil }
+
+	mstore := store.NewMemStore()
+	check := func(triage triageFunc, wantState store.TriageState, wantReason string) {
+		t.Helper()
+		err := mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+			crs, err := tx.GetCVERecords(cve.ID, cve.ID)
+			if err != nil {
+				return err
+			}
+			var old *store.CVERecord
+			if len(crs) > 0 {
+				old = crs[0]
+			}
+			_, err = triageAndStoreCVE(ctx, tx, cve, old, src, nil, triage)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := mstore.CVERecords()[cve.ID]
+		if got.TriageState != wantState || got.TriageStateReason != wantReason {
+			t.Fatalf("got (%s, %q), want (%s, %q)", got.TriageState, got.TriageStateReason, wantState, wantReason)
+		}
+	}
+	check(notModule, store.TriageStateNotAModule, "not in proxy")
+	// A candidate path appears in the proxy.
+	check(none, store.TriageStateNoActionNeeded, "")
+	check(notModule, store.TriageStateNotAModule, "not in proxy")
+	check(module, store.TriageStateNeedsIssue, "")
+	check(notModule, store.TriageStateNotAModule, "not in proxy")
+	if cr := mstore.CVERecords()[cve.ID]; cr.CVE != nil || cr.Module != "" {
+		t.Errorf("NotAModule record has CVE or module %q", cr.Module)
+	}
+}
+
+func TestUpdateResume(t *testing.T) {
+	ctx := context.Background()
+	repo, err := gitrepo.ReadTxtarRepo(testRepoPath, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit := headCommit(t, repo)
+	files, err := cvelistrepo.Files(repo, commit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		mu      sync.Mutex
+		triaged []string
+	)
+	needsIssue := func(_ context.Context, cve *cveschema.CVE) (*triageResult, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		triaged = append(triaged, cve.ID)
+		return nil, nil
+	}
+
+	// Simulate an update of the commit that stopped after the first file.
+	mstore := store.NewMemStore()
+	first := files[0]
+	createCVERecords(t, mstore, []*store.CVERecord{{
+		ID:          idFromFilename(first.Filename),
+		Path:        path.Join(first.DirPath, first.Filename),
+		BlobHash:    first.BlobHash.String(),
+		CommitHash:  commit.Hash.String(),
+		CommitTime:  commit.Committer.When.In(time.UTC),
+		TriageState: store.TriageStateNoActionNeeded,
+	}})
+	old := &store.CommitUpdateRecord{
+		StartedAt:    time.Now(),
+		CommitHash:   commit.Hash.String(),
+		CommitTime:   commit.Committer.When,
+		NumTotal:     len(files),
+		NumProcessed: 1,
+		NumAdded:     1,
+		LastPath:     path.Join(first.DirPath, first.Filename),
+	}
+	if err := mstore.CreateCommitUpdateRecord(ctx, old); err != nil {
+		t.Fatal(err)
+	}
+
+	ur, err := newCVEUpdater(repo, commit, mstore, nil, needsIssue).update(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ur.ID != old.ID {
+		t.Errorf("got update record %s, want resumed record %s", ur.ID, old.ID)
+	}
+	if ur.EndedAt.IsZero() || ur.NumProcessed != len(files) || ur.NumAdded != len(files) {
+		t.Errorf("got %+v, want finished update that processed and added %d files", ur, len(files))
+	}
+	if want := path.Join(files[len(files)-1].DirPath, files[len(files)-1].Filename); ur.LastPath != want {
+		t.Errorf("got LastPath %q, want %q", ur.LastPath, want)
+	}
+	for _, id := range triaged {
+		if id == idFromFilename(first.Filename) {
+			t.Errorf("%s was processed again", id)
+		}
+	}
+	if got, want := len(mstore.CVERecords()), len(files); got != want {
+		t.Errorf("got %d records, want %d", got, want)
+	}
+}
+
+func TestUpdateInterrupted(t *testing.T) {
+	repo, err := gitrepo.ReadTxtarRepo(testRepoPath, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit := headCommit(t, repo)
+	files, err := cvelistrepo.Files(repo, commit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Cancel the update while it triages the first directory, as a shutdown
+	// of the server would.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	needsIssue := func(context.Context, *cveschema.CVE) (*triageResult, error) {
+		cancel()
+		return nil, nil
+	}
+	mstore := store.NewMemStore()
+	ur, err := newCVEUpdater(repo, commit, mstore, nil, needsIssue).update(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+	if !ur.Interrupted || ur.EndedAt.IsZero() || ur.Error != "" {
+		t.Errorf("got %+v, want interrupted update without error", ur)
+	}
+	if ur.NumProcessed == 0 || ur.NumProcessed >= len(files) {
+		t.Errorf("got %d processed, want some of %d", ur.NumProcessed, len(files))
+	}
+	urs, err := mstore.ListCommitUpdateRecords(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(urs) != 1 || !urs[0].Interrupted {
+		t.Fatalf("got %+v, want one interrupted update record", urs)
+	}
+
+	// The next update resumes the interrupted one.
+	needsIssue = func(context.Context, *cveschema.CVE) (*triageResult, error) { return nil, nil }
+	got, err := newCVEUpdater(repo, commit, mstore, nil, needsIssue).update(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != ur.ID || got.Interrupted || got.NumProcessed != len(files) {
+		t.Errorf("got %+v, want resumed record %s that processed %d files", got, ur.ID, len(files))
+	}
+}
+
+func TestUpdateIndexesCVEs(t *testing.T) {
+	ctx := context.Background()
+	repo, err := gitrepo.ReadTxtarRepo(testRepoPath, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	needsIssue := func(context.Context, *cveschema.CVE) (*triageResult, error) { return nil, nil }
+	mstore := store.NewMemStore()
+	if _, err := newCVEUpdater(repo, headCommit(t, repo), mstore, nil, needsIssue).update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Search(ctx, mstore, "ssh signature verification", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"CVE-2020-9283"}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestUpdateAliases(t *testing.T) {
+	ctx := context.Background()
+	repo, err := gitrepo.ReadTxtarRepo("testdata/aliases.txtar", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	needsIssue := func(context.Context, *cveschema.CVE) (*triageResult, error) { return nil, nil }
+	mstore := store.NewMemStore()
+	if _, err := newCVEUpdater(repo, headCommit(t, repo), mstore, nil, needsIssue).update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		cveID, ghsaID string
+	}{
+		{"CVE-2021-0020", "GHSA-aaaa-bbbb-cccc"},
+		// The reference is not in canonical form.
+		{"CVE-2021-0021", "GHSA-dddd-eeee-ffff"},
+	} {
+		got, err := mstore.GetAliases(ctx, test.cveID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{test.ghsaID}; !cmp.Equal(got, want) {
+			t.Errorf("%s: got aliases %v, want %v", test.cveID, got, want)
+		}
+	}
+}
+
+func TestUpdateQuarantine(t *testing.T) {
+	ctx := context.Background()
+	repo, err := gitrepo.ReadTxtarRepo("testdata/badcve.txtar", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit := headCommit(t, repo)
+	needsIssue := func(context.Context, *cveschema.CVE) (*triageResult, error) { return nil, nil }
+	mstore := store.NewMemStore()
+	const badPath = "2021/0xxx/CVE-2021-0011.json"
+
+	quarantined := func() *store.QuarantinedFile {
+		t.Helper()
+		qs, err := mstore.ListQuarantinedFiles(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(qs) != 1 || qs[0].Path != badPath {
+			t.Fatalf("got %+v, want one quarantined file %s", qs, badPath)
+		}
+		return qs[0]
+	}
+
+	// Each update skips the bad file and records the failure, until the file
+	// is quarantined.
+	for i := 1; i <= quarantineAfter+1; i++ {
+		ur, err := newCVEUpdater(repo, commit, mstore, nil, needsIssue).update(ctx)
+		if err != nil {
+			t.Fatalf("update %d: %v", i, err)
+		}
+		if ur.Error != "" || ur.NumProcessed != 2 {
+			t.Errorf("update %d: got %+v, want no error and two processed", i, ur)
+		}
+		q := quarantined()
+		wantFailures := i
+		if wantFailures > quarantineAfter {
+			// A quarantined file is not parsed again.
+			wantFailures = quarantineAfter
+		}
+		if q.NumFailures != wantFailures || q.Quarantined != (i >= quarantineAfter) || q.Error == "" {
+			t.Errorf("update %d: got %+v, want %d failures", i, q, wantFailures)
+		}
+	}
+	if got := len(mstore.CVERecords()); got != 1 {
+		t.Errorf("got %d records, want 1", got)
+	}
+
+	// A file that parses is released from quarantine.
+	repo, err = gitrepo.ReadTxtarRepo(testRepoPath, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mstore = store.NewMemStore()
+	const goodPath = "2021/0xxx/CVE-2021-0001.json"
+	if err := mstore.SetQuarantinedFile(ctx, &store.QuarantinedFile{
+		Path:        goodPath,
+		BlobHash:    "old",
+		NumFailures: quarantineAfter,
+		Quarantined: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newCVEUpdater(repo, headCommit(t, repo), mstore, nil, needsIssue).update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	qs, err := mstore.ListQuarantinedFiles(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qs) != 0 {
+		t.Errorf("got %+v, want no quarantined files", qs)
+	}
+}
+
 func TestGroupFilesByDirectory(t *testing.T) {
 	for _, test := range []struct {
 		in   []cvelistrepo.File
@@ -381,7 +721,7 @@ func createGHSARecords(t *testing.T, s store.Store, grs []*store.GHSARecord) {
 }
 
 // headCommit returns the commit at the repo HEAD.
-func headCommit(t *testing.T, repo *git.Repository) *object.Commit {
+func headCommit(t testing.TB, repo *git.Repository) *object.Commit {
 	h, err := gitrepo.HeadHash(repo)
 	if err != nil {
 		t.Fatal(err)
@@ -392,3 +732,189 @@ func headCommit(t *testing.T, repo *git.Repository) *object.Commit {
 	}
 	return commit
 }
+
+func TestCVEAliases(t *testing.T) {
+	cve := &cveschema.CVE{Metadata: cveschema.Metadata{ID: "CVE-2022-0001"}}
+	for _, u := range []string{
+		"https://github.com/a/b/security/advisories/GHSA-aaaa-bbbb-cccc",
+		"http://github.com/advisories/GHSA-dddd-eeee-ffff/",
+		// Other CVEs are not aliases.
+		"https://nvd.nist.gov/vuln/detail/CVE-2022-0002",
+		"https://github.com/a/b/issues/1",
+	} {
+		cve.References.Data = append(cve.References.Data, cveschema.Reference{URL: u})
+	}
+	want := []string{"CVE-2022-0001", "GHSA-aaaa-bbbb-cccc", "GHSA-dddd-eeee-ffff"}
+	if diff := cmp.Diff(want, cveAliases(cve)); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+// BenchmarkUpdate measures the parts of an update from the cvelist repo,
+// over a generated snapshot of the repo with benchmarkCVEs files: parsing
+// the files, triaging the CVEs, and the whole update into an empty store.
+// Triage looks up modules in fake servers, so network latency is left out.
+// After the first iteration, the caches of triage are warm, as they are in
+// the worker after its first update.
+func BenchmarkUpdate(b *testing.B) {
+	const benchmarkCVEs = 3000
+	ctx := context.Background()
+	repo := benchmarkCVEListRepo(b, benchmarkCVEs)
+	commit := headCommit(b, repo)
+	purl := getPkgsiteURL(b)
+	useFakeGitHub(b, map[string]string{
+		"example/go0": "module github.com/example/go0\n",
+		"example/go1": "module example.com/go1\n",
+	}, nil)
+	useFakeProxy(b, nil)
+	// Don't let the limits on the QPS of the real servers throttle the fakes.
+	defer func(l *rate.Limiter) { pkgsiteRateLimiter = l }(pkgsiteRateLimiter)
+	pkgsiteRateLimiter = rate.NewLimiter(rate.Inf, 0)
+	triageProxy.limiter = rate.NewLimiter(rate.Inf, 0)
+	triage := func(ctx context.Context, cve *cveschema.CVE) (*triageResult, error) {
+		return TriageCVE(ctx, cve, purl)
+	}
+	files, err := cvelistrepo.Files(repo, commit)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if len(files) != benchmarkCVEs {
+		b.Fatalf("got %d files, want %d", len(files), benchmarkCVEs)
+	}
+
+	b.Run("parse", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, f := range files {
+				if _, err := cvelistrepo.ParseCVEForTriage(repo, f); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("triage", func(b *testing.B) {
+		var cves []*cveschema.CVE
+		for _, f := range files {
+			cve, err := cvelistrepo.ParseCVEForTriage(repo, f)
+			if err != nil {
+				b.Fatal(err)
+			}
+			cves = append(cves, cve)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, cve := range cves {
+				if _, err := triage(ctx, cve); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("update", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			st := store.NewMemStore()
+			ur, err := newCVEUpdater(repo, commit, st, nil, triage).update(ctx)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if ur.NumAdded != benchmarkCVEs {
+				b.Fatalf("added %d CVEs, want %d", ur.NumAdded, benchmarkCVEs)
+			}
+		}
+	})
+}
+
+// benchmarkCVEListRepo returns a repo in the shape of the cvelist repo with
+// n generated CVE files. The CVEs are a mix of the kinds that triage
+// handles differently: Go modules documented on pkg.go.dev, the Go project,
+// GitHub repos with and without go.mod files, reserved CVEs, and CVEs that
+// have nothing to do with Go.
+//
+// The repo is written to storage directly, because adding thousands of
+// files to a worktree is slow.
+func benchmarkCVEListRepo(b *testing.B, n int) *git.Repository {
+	b.Helper()
+	st := memory.NewStorage()
+	put := func(t plumbing.ObjectType, encode func(plumbing.EncodedObject) error) plumbing.Hash {
+		obj := st.NewEncodedObject()
+		obj.SetType(t)
+		if err := encode(obj); err != nil {
+			b.Fatal(err)
+		}
+		h, err := st.SetEncodedObject(obj)
+		if err != nil {
+			b.Fatal(err)
+		}
+		return h
+	}
+	putTree := func(entries []object.TreeEntry) plumbing.Hash {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+		return put(plumbing.TreeObject, (&object.Tree{Entries: entries}).Encode)
+	}
+
+	// The files of each directory, like 2022/10xxx.
+	dirs := map[string][]object.TreeEntry{}
+	for i := 0; i < n; i++ {
+		num := 10000 + i
+		id := fmt.Sprintf("CVE-2022-%d", num)
+		cve := &cveschema.CVE{
+			DataType:    "CVE",
+			DataFormat:  "MITRE",
+			DataVersion: "4.0",
+			Metadata:    cveschema.Metadata{ID: id, Assigner: "cve@example.com", State: cveschema.StatePublic},
+		}
+		var refs []string
+		switch i % 5 {
+		case 0:
+			refs = []string{fmt.Sprintf("https://pkg.go.dev/example.com/mod%d", i)}
+		case 1:
+			refs = []string{"https://golang.org/issue/" + strconv.Itoa(i), "https://groups.google.com/g/golang-announce/c/abc"}
+		case 2:
+			refs = []string{fmt.Sprintf("https://github.com/example/go%d/pull/%d", i%4, i)}
+		case 3:
+			cve.Metadata.State = cveschema.StateReserved
+		case 4:
+			refs = []string{fmt.Sprintf("https://www.example.com/security/advisory-%d.html", i), "https://bugzilla.example.com/show_bug.cgi?id=" + strconv.Itoa(i)}
+		}
+		for _, r := range refs {
+			cve.References.Data = append(cve.References.Data, cveschema.Reference{URL: r})
+		}
+		cve.Description.Data = []cveschema.LangString{{
+			Lang:  "eng",
+			Value: fmt.Sprintf("A vulnerability in product %d allows remote attackers to cause a denial of service via crafted input.", i),
+		}}
+		data, err := json.MarshalIndent(cve, "", "    ")
+		if err != nil {
+			b.Fatal(err)
+		}
+		blob := put(plumbing.BlobObject, func(obj plumbing.EncodedObject) error {
+			w, err := obj.Writer()
+			if err != nil {
+				return err
+			}
+			if _, err := w.Write(data); err != nil {
+				return err
+			}
+			return w.Close()
+		})
+		dir := fmt.Sprintf("%dxxx", num/1000)
+		dirs[dir] = append(dirs[dir], object.TreeEntry{Name: id + ".json", Mode: filemode.Regular, Hash: blob})
+	}
+	var year []object.TreeEntry
+	for dir, entries := range dirs {
+		year = append(year, object.TreeEntry{Name: dir, Mode: filemode.Dir, Hash: putTree(entries)})
+	}
+	root := putTree([]object.TreeEntry{{Name: "2022", Mode: filemode.Dir, Hash: putTree(year)}})
+	sig := object.Signature{Name: "Joe Random", Email: "joe@example.com", When: time.Now()}
+	commit := put(plumbing.CommitObject, (&object.Commit{Author: sig, Committer: sig, TreeHash: root}).Encode)
+	if err := st.SetReference(plumbing.NewHashReference("refs/heads/main", commit)); err != nil {
+		b.Fatal(err)
+	}
+	if err := st.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, "refs/heads/main")); err != nil {
+		b.Fatal(err)
+	}
+	repo, err := git.Open(st, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return repo
+}
@@ -0,0 +1,218 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/event"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/derrors"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/worker/log"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+// upstreamDisputed is the WithdrawalFlag of a CVE whose description says
+// that it is disputed. Unlike REJECT, it is not a value of its state.
+const upstreamDisputed = "DISPUTED"
+
+// WithdrawalStats describes the result of a check for CVEs that were
+// rejected or disputed upstream after they were triaged.
+type WithdrawalStats struct {
+	// Number of CVEs checked.
+	NumChecked int
+	// IDs of the reports flagged for withdrawal review, in order.
+	Reports []string
+	// IDs of the CVEs whose issues were flagged because no report covers
+	// them, in order.
+	Issues []string
+	// Number of issues created and commented on for them.
+	NumIssues, NumComments int
+}
+
+// CheckWithdrawals looks for the CVEs of the reports in the vulndb repo at
+// repoPath, which may be a URL to clone or a local directory, and the CVEs
+// in st whose issues are open, that the latest updates found rejected or
+// disputed upstream.
+//
+// It flags the reports of each such CVE for withdrawal review by creating
+// an issue for each report in ic, or if no report covers the CVE, by
+// commenting on its issue, and sends a notification listing them. If ic is
+// nil, only the notification is sent. A CVE is flagged again only if its
+// upstream state changes.
+func CheckWithdrawals(ctx context.Context, st store.Store, ic issues.Client, repoPath string) (_ WithdrawalStats, err error) {
+	defer derrors.Wrap(&err, "CheckWithdrawals(%q)", repoPath)
+
+	reports, err := LoadGoReports(ctx, repoPath)
+	if err != nil {
+		return WithdrawalStats{}, err
+	}
+	return checkWithdrawals(ctx, st, ic, reports, time.Now())
+}
+
+func checkWithdrawals(ctx context.Context, st store.Store, ic issues.Client, reports []*GoReport, now time.Time) (stats WithdrawalStats, err error) {
+	ctx = event.Start(ctx, "checkWithdrawals")
+	defer event.End(ctx)
+	ctx, end := startUpdate(ctx, st, "withdrawals")
+	defer func() { end(err) }()
+
+	// The reports of each CVE. Excluded reports are not published, so they
+	// have nothing to withdraw.
+	byCVE := map[string][]string{}
+	for _, r := range reports {
+		if r.Excluded {
+			continue
+		}
+		for _, a := range r.Aliases {
+			if strings.HasPrefix(a, "CVE-") {
+				byCVE[a] = append(byCVE[a], r.ID)
+			}
+		}
+	}
+	ids := map[string]bool{}
+	for id := range byCVE {
+		ids[id] = true
+	}
+	for _, ts := range []store.TriageState{store.TriageStateIssueCreated, store.TriageStateUpdatedSinceIssueCreation} {
+		crs, _, err := st.ListCVERecords(ctx, store.CVERecordQuery{TriageState: ts})
+		if err != nil {
+			return stats, err
+		}
+		for _, cr := range crs {
+			ids[cr.ID] = true
+		}
+	}
+	var sorted []string
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Strings(sorted)
+
+	for _, id := range sorted {
+		cr, err := st.GetCVERecord(ctx, id)
+		if err != nil {
+			return stats, err
+		}
+		if cr == nil {
+			// The report's CVE may not be in the cvelist repo yet.
+			continue
+		}
+		stats.NumChecked++
+		upstream := upstreamState(cr)
+		if upstream == cr.WithdrawalFlag {
+			continue
+		}
+		if upstream != "" {
+			log.Infof(ctx, "%s is %s upstream", id, upstream)
+			if ic != nil {
+				if err := flagWithdrawal(ctx, cr, upstream, byCVE[id], ic, &stats); err != nil {
+					return stats, err
+				}
+			}
+			if len(byCVE[id]) > 0 {
+				stats.Reports = append(stats.Reports, byCVE[id]...)
+			} else {
+				stats.Issues = append(stats.Issues, id)
+			}
+		}
+		if err := setWithdrawalFlag(ctx, st, id, upstream, now); err != nil {
+			return stats, err
+		}
+	}
+	sort.Strings(stats.Reports)
+	if n := len(stats.Reports) + len(stats.Issues); n > 0 {
+		notify(ctx, &Notification{
+			Kind: NotifyWithdrawalReview,
+			Text: fmt.Sprintf("%d reports and %d issues have CVEs that were rejected or disputed upstream, and need review for withdrawal:", len(stats.Reports), len(stats.Issues)),
+			IDs:  append(append([]string{}, stats.Reports...), stats.Issues...),
+		})
+	}
+	log.Infof(ctx, "withdrawal check succeeded: checked %d CVEs, flagged %d reports and %d issues, created %d issues and %d comments",
+		stats.NumChecked, len(stats.Reports), len(stats.Issues), stats.NumIssues, stats.NumComments)
+	return stats, nil
+}
+
+// upstreamState returns the state of the CVE of cr that calls for the
+// withdrawal of its reports, REJECT or DISPUTED, or "" if there is none.
+func upstreamState(cr *store.CVERecord) string {
+	switch {
+	case cr.CVEState == cveschema.StateRejected:
+		return cveschema.StateRejected
+	case cr.Disputed:
+		return upstreamDisputed
+	}
+	return ""
+}
+
+// flagWithdrawal creates an issue in ic for each of the reports of the CVE
+// of cr, whose upstream state is upstream, or if there are none, comments on
+// the CVE's issue.
+func flagWithdrawal(ctx context.Context, cr *store.CVERecord, upstream string, reportIDs []string, ic issues.Client, stats *WithdrawalStats) (err error) {
+	defer derrors.Wrap(&err, "flagWithdrawal(%s)", cr.ID)
+
+	what := "was rejected"
+	if upstream == upstreamDisputed {
+		what = "is disputed"
+	}
+	if len(reportIDs) == 0 {
+		n := issueNumber(ic, cr.IssueReference)
+		if n == 0 {
+			return nil
+		}
+		if err := issueRateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		body := fmt.Sprintf("%s %s upstream since this issue was created. If it is not a vulnerability, it needs no report.", cr.ID, what)
+		if err := ic.CreateComment(ctx, n, body); err != nil {
+			return err
+		}
+		stats.NumComments++
+		return nil
+	}
+	for _, rid := range reportIDs {
+		if err := issueRateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		body := fmt.Sprintf("%s, an alias of report %s, %s upstream after the report was published.\n\n"+
+			"If the vulnerability is not real, withdraw %[2]s; otherwise, close this issue.\n",
+			cr.ID, rid, what)
+		num, err := ic.CreateIssue(ctx, &issues.Issue{
+			Title: fmt.Sprintf("x/vulndb: review %s for withdrawal: %s %s", rid, cr.ID, what),
+			Body:  body,
+		})
+		if err != nil {
+			return err
+		}
+		stats.NumIssues++
+		log.Infof(ctx, "created issue %s for %s", ic.Reference(num), rid)
+	}
+	return nil
+}
+
+// setWithdrawalFlag records in st that the CVE with the given ID was flagged
+// for its upstream state, or cleared if upstream is empty.
+func setWithdrawalFlag(ctx context.Context, st store.Store, id, upstream string, now time.Time) error {
+	return st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		crs, err := tx.GetCVERecords(id, id)
+		if err != nil {
+			return err
+		}
+		if len(crs) == 0 {
+			return fmt.Errorf("no record for %s", id)
+		}
+		cr := crs[0]
+		cr.WithdrawalFlag = upstream
+		cr.WithdrawalFlaggedAt = time.Time{}
+		if upstream != "" {
+			cr.WithdrawalFlaggedAt = now
+		}
+		return tx.SetCVERecord(cr)
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.17
+// +build go1.17
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/vulndb/internal/cveschema"
+	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/worker/store"
+)
+
+func TestCheckWithdrawals(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	fc := issues.NewFakeClient()
+	issueNum, err := fc.CreateIssue(ctx, &issues.Issue{Title: "CVE-2022-0004"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	cve := func(id, state string, disputed bool, ts store.TriageState) *store.CVERecord {
+		return &store.CVERecord{
+			ID:          id,
+			Path:        id + ".json",
+			BlobHash:    "abc",
+			CommitHash:  "123",
+			CommitTime:  now,
+			CVEState:    state,
+			Disputed:    disputed,
+			TriageState: ts,
+		}
+	}
+	issueOpen := cve("CVE-2022-0004", cveschema.StateRejected, false, store.TriageStateIssueCreated)
+	issueOpen.IssueReference = fc.Reference(issueNum)
+	err = mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		for _, cr := range []*store.CVERecord{
+			// Rejected, with two reports.
+			cve("CVE-2022-0001", cveschema.StateRejected, false, store.TriageStateHasVuln),
+			// Disputed, with a report.
+			cve("CVE-2022-0002", cveschema.StatePublic, true, store.TriageStateHasVuln),
+			// Public, with a report.
+			cve("CVE-2022-0003", cveschema.StatePublic, false, store.TriageStateHasVuln),
+			// Rejected, with an issue, but no report.
+			issueOpen,
+			// Rejected, but never triaged as Go.
+			cve("CVE-2022-0005", cveschema.StateRejected, false, store.TriageStateNoActionNeeded),
+		} {
+			if err := tx.CreateCVERecord(cr); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reports := []*GoReport{
+		{ID: "GO-2022-0001", Aliases: []string{"CVE-2022-0001", "GHSA-aaaa-bbbb-cccc"}},
+		{ID: "GO-2022-0002", Aliases: []string{"CVE-2022-0001"}},
+		{ID: "GO-2022-0003", Aliases: []string{"CVE-2022-0002"}},
+		{ID: "GO-2022-0004", Aliases: []string{"CVE-2022-0003"}},
+		// Excluded reports are not flagged.
+		{ID: "GO-2022-0005", Excluded: true, Aliases: []string{"CVE-2022-0005"}},
+		// The CVE is not in the store.
+		{ID: "GO-2022-0006", Aliases: []string{"CVE-2022-0006"}},
+	}
+
+	ic := issues.NewDryRunClient(fc)
+	rn := &recordingNotifier{}
+	stats, err := checkWithdrawals(WithNotifier(ctx, rn), mstore, ic, reports, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := WithdrawalStats{
+		NumChecked:  4,
+		Reports:     []string{"GO-2022-0001", "GO-2022-0002", "GO-2022-0003"},
+		Issues:      []string{"CVE-2022-0004"},
+		NumIssues:   3,
+		NumComments: 1,
+	}
+	if diff := cmp.Diff(want, stats); diff != "" {
+		t.Errorf("mismatch (-want, +got):\n%s", diff)
+	}
+	if got := ic.Commented(); len(got) != 1 || got[0].Number != issueNum {
+		t.Errorf("got comments %+v, want one on issue %d", got, issueNum)
+	}
+	if len(rn.ns) != 1 || rn.ns[0].Kind != NotifyWithdrawalReview {
+		t.Fatalf("got notifications %+v, want one withdrawal review", rn.ns)
+	}
+	wantIDs := []string{"GO-2022-0001", "GO-2022-0002", "GO-2022-0003", "CVE-2022-0004"}
+	if diff := cmp.Diff(wantIDs, rn.ns[0].IDs); diff != "" {
+		t.Errorf("notification IDs mismatch (-want, +got):\n%s", diff)
+	}
+	cr, err := mstore.GetCVERecord(ctx, "CVE-2022-0002")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cr.WithdrawalFlag != upstreamDisputed || !cr.WithdrawalFlaggedAt.Equal(now) {
+		t.Errorf("CVE-2022-0002 flagged %q at %s, want %q at %s", cr.WithdrawalFlag, cr.WithdrawalFlaggedAt, upstreamDisputed, now)
+	}
+
+	// Nothing is flagged twice, but a disputed CVE that is then rejected is
+	// flagged again.
+	err = mstore.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		cr.CVEState = cveschema.StateRejected
+		return tx.SetCVERecord(cr)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err = checkWithdrawals(ctx, mstore, ic, reports, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"GO-2022-0003"}, stats.Reports); diff != "" || len(stats.Issues) != 0 {
+		t.Errorf("second check: got reports %v and issues %v, want only GO-2022-0003", stats.Reports, stats.Issues)
+	}
+}
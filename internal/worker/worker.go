@@ -9,6 +9,7 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -28,22 +29,40 @@ import (
 	"golang.org/x/vulndb/internal/ghsa"
 	"golang.org/x/vulndb/internal/gitrepo"
 	"golang.org/x/vulndb/internal/issues"
+	"golang.org/x/vulndb/internal/observe"
 	"golang.org/x/vulndb/internal/report"
 	"golang.org/x/vulndb/internal/worker/log"
 	"golang.org/x/vulndb/internal/worker/store"
 )
 
+// cloneOptions describe how UpdateCVEsAtCommit clones the cvelist repo.
+var cloneOptions = gitrepo.CloneOptions{Depth: 1}
+
+// SetCloneOptions sets how the cvelist repo is cloned for updates. By default,
+// only HEAD is cloned, into memory.
+func SetCloneOptions(opts gitrepo.CloneOptions) {
+	cloneOptions = opts
+}
+
 // UpdateCVEsAtCommit performs an update on the store using the given commit.
 // Unless force is true, it checks that the update makes sense before doing it.
+// It holds the update lease while it runs, and returns a *LeaseHeldError if
+// another update is running, even with force.
 func UpdateCVEsAtCommit(ctx context.Context, repoPath, commitHashString string, st store.Store, pkgsiteURL string, force bool) (err error) {
 	defer derrors.Wrap(&err, "RunCommitUpdate(%q, %q, force=%t)", repoPath, commitHashString, force)
 
+	return withLease(ctx, st, updateLease, func(ctx context.Context) error {
+		return updateCVEsAtCommit(ctx, repoPath, commitHashString, st, pkgsiteURL, force)
+	})
+}
+
+func updateCVEsAtCommit(ctx context.Context, repoPath, commitHashString string, st store.Store, pkgsiteURL string, force bool) error {
 	log.Infof(ctx, "updating false positives")
 	if err := updateFalsePositives(ctx, st); err != nil {
 		return err
 	}
 
-	repo, err := gitrepo.CloneOrOpen(ctx, repoPath)
+	repo, err := gitrepo.CloneOrOpenWithOptions(ctx, repoPath, cloneOptions)
 	if err != nil {
 		return err
 	}
@@ -70,7 +89,7 @@ func UpdateCVEsAtCommit(ctx context.Context, repoPath, commitHashString string,
 	if err != nil {
 		return err
 	}
-	u := newCVEUpdater(repo, commit, st, knownVulnIDs, func(cve *cveschema.CVE) (*triageResult, error) {
+	u := newCVEUpdater(repo, commit, st, knownVulnIDs, func(ctx context.Context, cve *cveschema.CVE) (*triageResult, error) {
 		return TriageCVE(ctx, cve, pkgsiteURL)
 	})
 	_, err = u.update(ctx)
@@ -166,6 +185,11 @@ func getAllCVEsAndGHSAsInVulnDB(ctx context.Context) ([]string, error) {
 	return vulnIDs, nil
 }
 
+// ghsaCursorSource is the name of the store's fetch cursor for GitHub security
+// advisories. The cursor is the latest UpdatedAt time of the advisories that
+// have been processed.
+const ghsaCursorSource = "ghsa"
+
 // GHSAListFunc is the type of a function that lists GitHub security advisories.
 type GHSAListFunc func(_ context.Context, since time.Time) ([]*ghsa.SecurityAdvisory, error)
 
@@ -173,12 +197,21 @@ type GHSAListFunc func(_ context.Context, since time.Time) ([]*ghsa.SecurityAdvi
 func UpdateGHSAs(ctx context.Context, list GHSAListFunc, st store.Store) (_ UpdateGHSAStats, err error) {
 	defer derrors.Wrap(&err, "UpdateGHSAs")
 
-	// Find the most recent update time of the records we have in the store.
-	grs, err := getGHSARecords(ctx, st)
-	var since time.Time
-	for _, gr := range grs {
-		if gr.GHSA.UpdatedAt.After(since) {
-			since = gr.GHSA.UpdatedAt
+	// Find the most recent update time of the advisories we have seen.
+	since, err := st.GetFetchCursor(ctx, ghsaCursorSource)
+	if err != nil {
+		return UpdateGHSAStats{}, err
+	}
+	if since.IsZero() {
+		// The store predates the cursor; use the records themselves.
+		grs, err := getGHSARecords(ctx, st)
+		if err != nil {
+			return UpdateGHSAStats{}, err
+		}
+		for _, gr := range grs {
+			if gr.GHSA.UpdatedAt.After(since) {
+				since = gr.GHSA.UpdatedAt
+			}
 		}
 	}
 	// We want to start just after that time.
@@ -209,24 +242,115 @@ const issueQPS = 1
 // basically lets you exceed the rate briefly.
 var issueRateLimiter = rate.NewLimiter(rate.Every(time.Duration(1000/float64(issueQPS))*time.Millisecond), 1)
 
-func CreateIssues(ctx context.Context, st store.Store, ic issues.Client, limit int) (err error) {
+type seenVersionKey struct{}
+
+// withSeenVersion returns a context in which MarkNeedsIssue,
+// MarkFalsePositive and AcknowledgeCVEChange change only version v of the
+// record, the version that the person making the change saw, and fail with a
+// *store.ConflictError if the record has changed since.
+func withSeenVersion(ctx context.Context, v int64) context.Context {
+	return context.WithValue(ctx, seenVersionKey{}, v)
+}
+
+// setSeenVersion sets the version of r to the one set by withSeenVersion, if
+// any.
+func setSeenVersion(ctx context.Context, r *store.CVERecord) {
+	if v, ok := ctx.Value(seenVersionKey{}).(int64); ok {
+		r.Version = v
+	}
+}
+
+// MarkNeedsIssue sets the triage state of the CVE record with the given ID to
+// NeedsIssue, so that CreateIssues will create an issue for it. The module is
+// the affected module; if empty, the record's module is kept.
+//
+// Only records that are still NeedsIssue keep a copy of their CVE, which
+// issue creation uses. For others, MarkNeedsIssue reconstructs what it can:
+// the ID and the reference URLs.
+func MarkNeedsIssue(ctx context.Context, st store.Store, id, module, reason string) (_ *store.CVERecord, err error) {
+	defer derrors.Wrap(&err, "MarkNeedsIssue(%s)", id)
+
+	var mod *store.CVERecord
+	err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		crs, err := tx.GetCVERecords(id, id)
+		if err != nil {
+			return err
+		}
+		if len(crs) == 0 {
+			return fmt.Errorf("no record for %s; it may need an update", id)
+		}
+		old := crs[0]
+		switch old.TriageState {
+		case store.TriageStateIssueCreated, store.TriageStateUpdatedSinceIssueCreation:
+			return fmt.Errorf("%s already has issue %s", id, old.IssueReference)
+		}
+		c := *old // copy the old one
+		mod = &c
+		mod.TriageState = store.TriageStateNeedsIssue
+		mod.TriageStateReason = reason
+		if module != "" {
+			mod.Module = module
+		}
+		if mod.Module == "" {
+			mod.Module = unknownPath
+		}
+		if mod.CVE == nil {
+			cve := &cveschema.CVE{Metadata: cveschema.Metadata{ID: id, State: old.CVEState}}
+			for _, u := range old.ReferenceURLs {
+				cve.References.Data = append(cve.References.Data, cveschema.Reference{URL: u})
+			}
+			mod.CVE = cve
+		}
+		mod.History = append([]*store.CVERecordSnapshot{old.Snapshot()}, old.History...)
+		setSeenVersion(ctx, mod)
+		return tx.SetCVERecord(mod)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mod, nil
+}
+
+// CreateIssues creates issues in ic for up to limit CVEs and up to limit
+// GHSAs that need them, or for all of them if limit is zero. The issue of a
+// CVE lists the reports, of those given, and the open issues that the CVE
+// may duplicate. It holds the issues lease while it runs, and returns a
+// *LeaseHeldError if issues are being created elsewhere.
+func CreateIssues(ctx context.Context, st store.Store, ic issues.Client, reports []*GoReport, limit int) (err error) {
+	defer func() {
+		if lerr := new(LeaseHeldError); err != nil && !errors.As(err, &lerr) {
+			notify(ctx, &Notification{
+				Kind:  NotifyIssuesFailed,
+				Text:  fmt.Sprintf("Creating issues failed: %v", err),
+				Error: err.Error(),
+			})
+		}
+	}()
 	defer derrors.Wrap(&err, "CreateIssues(destination: %s)", ic.Destination())
 	ctx = event.Start(ctx, "CreateIssues")
 	defer event.End(ctx)
 
-	if err := createCVEIssues(ctx, st, ic, limit); err != nil {
-		return err
-	}
-	return createGHSAIssues(ctx, st, ic, limit)
+	return withLease(ctx, st, issuesLease, func(ctx context.Context) error {
+		if err := createCVEIssues(ctx, st, ic, reports, limit); err != nil {
+			return err
+		}
+		return createGHSAIssues(ctx, st, ic, limit)
+	})
 }
 
-func createCVEIssues(ctx context.Context, st store.Store, ic issues.Client, limit int) (err error) {
+func createCVEIssues(ctx context.Context, st store.Store, ic issues.Client, reports []*GoReport, limit int) (err error) {
 	defer derrors.Wrap(&err, "createCVEIssues(destination: %s)", ic.Destination())
 
 	needsIssue, err := st.ListCVERecordsWithTriageState(ctx, store.TriageStateNeedsIssue)
 	if err != nil {
 		return err
 	}
+	dups, err := newDuplicateChecker(ctx, st, reports)
+	if err != nil {
+		return err
+	}
+	// Create issues for the CVEs most likely to be exploited first.
+	sortByEPSS(needsIssue)
 	log.Infof(ctx, "createCVEIssues starting; destination: %s, total needing issue: %d",
 		ic.Destination(), len(needsIssue))
 	numCreated := 0
@@ -234,10 +358,22 @@ func createCVEIssues(ctx context.Context, st store.Store, ic issues.Client, limi
 		if limit > 0 && numCreated >= limit {
 			break
 		}
-		ref, err := createIssue(ctx, cr, ic, newCVEBody)
+		aliases, err := st.GetAliases(ctx, cr.ID)
+		if err != nil {
+			return err
+		}
+		cands := dups.check(cr, aliases)
+		for _, c := range cands {
+			log.With("ID", cr.ID).Infof(ctx, "%s may duplicate %s", cr.ID, c)
+		}
+		ref, err := createIssue(ctx, cr, ic, withDuplicates(newCVEBody, cands))
 		if err != nil {
 			return err
 		}
+		if ref == "" {
+			// createIssue skipped the record and logged why.
+			continue
+		}
 
 		// Update the CVERecord in the DB with issue information.
 		err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
@@ -254,6 +390,9 @@ func createCVEIssues(ctx context.Context, st store.Store, ic issues.Client, limi
 		if err != nil {
 			return err
 		}
+		// Later CVEs may duplicate this one.
+		cr.IssueReference = ref
+		dups.addCVE(cr)
 		numCreated++
 	}
 	log.With("limit", limit).Infof(ctx, "createCVEIssues done: %d created", numCreated)
@@ -270,15 +409,49 @@ func newCVEBody(sr storeRecord) (string, error) {
 		cr.CVE.Metadata.ID = cr.ID
 	}
 	r := report.CVEToReport(cr.CVE, cr.Module)
-	out, err := r.ToString()
+	// The module is unknown if triage only found that the CVE might be
+	// relevant to Go.
+	knownModule := cr.Module != unknownPath
+	suggested := r
+	if cr.SuggestedExclusion != "" {
+		// Excluded reports have only the reason, the CVE and the module.
+		suggested = &report.Report{
+			Excluded: report.ExcludedReason(cr.SuggestedExclusion),
+			CVEs:     []string{cr.ID},
+		}
+		if knownModule {
+			suggested.Modules = []*report.Module{{Module: cr.Module}}
+		}
+	}
+	out, err := suggested.ToString()
 	if err != nil {
 		return "", err
 	}
 
 	var intro strings.Builder
-	fmt.Fprintf(&intro,
-		"%s references [%s](https://%s), which may be a Go module.\n\n",
-		cr.ID, cr.Module, cr.Module)
+	if knownModule {
+		fmt.Fprintf(&intro,
+			"%s references [%s](https://%s), which may be a Go module.\n\n",
+			cr.ID, cr.Module, cr.Module)
+		if len(cr.CandidateModules) > 1 {
+			// The fix changes several modules of a repo.
+			intro.WriteString("Its fix changes files in these modules of the repo:\n")
+			for _, c := range cr.CandidateModules {
+				fmt.Fprintf(&intro, "- %s (%.0f%% of changed files)\n", c.Path, 100*c.Confidence)
+			}
+			intro.WriteString("\n")
+		}
+		if cr.SuggestedExclusion != "" {
+			fmt.Fprintf(&intro, "Triage reason: %s\n\n", cr.TriageStateReason)
+		}
+	} else {
+		fmt.Fprintf(&intro,
+			"%s may affect Go code, but its module is unknown.\n\nTriage reason: %s\n\n",
+			cr.ID, cr.TriageStateReason)
+	}
+	if cr.SuggestedExclusion != "" {
+		fmt.Fprintf(&intro, "Triage suggests that its report be excluded, with reason %s, as below.\n\n", cr.SuggestedExclusion)
+	}
 
 	description := "N/A"
 	if len(cr.CVE.Description.Data) > 0 {
@@ -286,13 +459,17 @@ func newCVEBody(sr storeRecord) (string, error) {
 	}
 	fmt.Fprintf(&intro, "Description:\n%s\n\n", description)
 
-	fmt.Fprintf(&intro, `References:
-- NIST: https://nvd.nist.gov/vuln/detail/%s
-- JSON: %s/tree/%s/%s`, cr.ID, cvelistrepo.URL, cr.CommitHash, cr.Path)
+	fmt.Fprintf(&intro, "References:\n- NIST: https://nvd.nist.gov/vuln/detail/%s", cr.ID)
+	// Records from the NVD have no JSON in the cvelist repo.
+	if cr.CommitHash != nvdCommitHash {
+		fmt.Fprintf(&intro, "\n- JSON: %s/tree/%s/%s", cvelistrepo.URL, cr.CommitHash, cr.Path)
+	}
 	for _, ref := range r.References {
 		fmt.Fprintf(&intro, "\n- %v: %v", strings.ToLower(string(ref.Type)), ref.URL)
 	}
-	fmt.Fprintf(&intro, "\n- Imported by: https://pkg.go.dev/%s?tab=importedby", cr.Module)
+	if knownModule {
+		fmt.Fprintf(&intro, "\n- Imported by: https://pkg.go.dev/%s?tab=importedby", cr.Module)
+	}
 	if err := issueTemplate.Execute(&b, issueTemplateData{
 		Intro:  intro.String(),
 		Report: out,
@@ -328,6 +505,10 @@ func createGHSAIssues(ctx context.Context, st store.Store, ic issues.Client, lim
 		if err != nil {
 			return err
 		}
+		if ref == "" {
+			// createIssue skipped the record and logged why.
+			continue
+		}
 		// Update the GHSARecord in the DB with issue information.
 		err = st.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
 			r, err := tx.GetGHSARecord(gr.GetID())
@@ -394,6 +575,11 @@ type storeRecord interface {
 func createIssue(ctx context.Context, r storeRecord, ic issues.Client, newBody func(storeRecord) (string, error)) (ref string, err error) {
 	id := r.GetID()
 	defer derrors.Wrap(&err, "createIssue(%s)", id)
+	ctx = event.Start(ctx, "createIssue")
+	defer event.End(ctx)
+	ctx = log.NewContext(ctx, "id", id)
+	observe.SetSpanAttribute(ctx, "id", id)
+	defer func() { observe.SetSpanError(ctx, err) }()
 
 	if r.GetIssueReference() != "" || !r.GetIssueCreatedAt().IsZero() {
 		log.With(
@@ -415,7 +601,7 @@ func createIssue(ctx context.Context, r storeRecord, ic issues.Client, newBody f
 	}
 	// Create the issue.
 	iss := &issues.Issue{
-		Title:  fmt.Sprintf("x/vulndb: potential Go vuln in %s: %s", r.GetUnit(), r.GetID()),
+		Title:  issueTitle(r),
 		Body:   body,
 		Labels: labels,
 	}
@@ -435,6 +621,15 @@ func createIssue(ctx context.Context, r storeRecord, ic issues.Client, newBody f
 	return ref, nil
 }
 
+// issueTitle returns the title of the issue for r, which names its unit if
+// it is known.
+func issueTitle(r storeRecord) string {
+	if u := r.GetUnit(); u != "" && u != unknownPath {
+		return fmt.Sprintf("x/vulndb: potential Go vuln in %s: %s", u, r.GetID())
+	}
+	return fmt.Sprintf("x/vulndb: potential Go vuln: %s", r.GetID())
+}
+
 func yearLabel(cve string) string {
 	if !strings.HasPrefix(cve, "CVE-") {
 		return ""
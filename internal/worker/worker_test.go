@@ -9,6 +9,7 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -30,6 +31,10 @@ import (
 
 const testRepoPath = "../cvelistrepo/testdata/basic.txtar"
 
+// ignoreVersion ignores the versions of CVERecords, which the store tests
+// check.
+var ignoreVersion = cmpopts.IgnoreFields(store.CVERecord{}, "Version")
+
 func TestCheckUpdate(t *testing.T) {
 	ctx := context.Background()
 	tm := time.Date(2021, 1, 26, 0, 0, 0, 0, time.Local)
@@ -160,7 +165,7 @@ func TestCreateIssues(t *testing.T) {
 	}
 	createGHSARecords(t, mstore, grs)
 
-	if err := CreateIssues(ctx, mstore, ic, 0); err != nil {
+	if err := CreateIssues(ctx, mstore, ic, nil, 0); err != nil {
 		t.Fatal(err)
 	}
 
@@ -178,7 +183,7 @@ func TestCreateIssues(t *testing.T) {
 	}
 	for _, want := range wantCVERecords {
 		got := gotCVERecs[want.ID]
-		if !cmp.Equal(got, want, cmpopts.IgnoreFields(store.CVERecord{}, "IssueCreatedAt")) {
+		if !cmp.Equal(got, want, cmpopts.IgnoreFields(store.CVERecord{}, "IssueCreatedAt", "Version")) {
 			t.Errorf("\ngot  %+v\nwant %+v", got, want)
 		}
 	}
@@ -229,6 +234,7 @@ References:
 See [doc/triage.md](https://github.com/golang/vulndb/blob/master/doc/triage.md) for instructions on how to triage this report.
 
 ` + "```" + `
+schema_version: 3
 modules:
   - module: a.Module
     packages:
@@ -244,6 +250,76 @@ cves:
 	}
 }
 
+func TestNewCVEBodyUnknownModule(t *testing.T) {
+	r := &store.CVERecord{
+		ID:                "ID1",
+		CommitHash:        nvdCommitHash,
+		Module:            unknownPath,
+		TriageStateReason: "possibly Go",
+		CVE:               &cveschema.CVE{},
+	}
+	got, err := newCVEBody(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"ID1 may affect Go code, but its module is unknown.", "Triage reason: possibly Go"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("body does not contain %q:\n%s", want, got)
+		}
+	}
+	for _, notWant := range []string{"JSON:", "Imported by:"} {
+		if strings.Contains(got, notWant) {
+			t.Errorf("body contains %q:\n%s", notWant, got)
+		}
+	}
+}
+
+func TestNewCVEBodyCandidateModules(t *testing.T) {
+	r := &store.CVERecord{
+		ID:     "ID1",
+		Module: "k8s.io/api",
+		CandidateModules: []*store.CandidateModule{
+			{Path: "k8s.io/api", Confidence: 0.75},
+			{Path: "k8s.io/kubernetes", Confidence: 0.25},
+		},
+		CVE: &cveschema.CVE{},
+	}
+	got, err := newCVEBody(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Its fix changes files in these modules of the repo:\n- k8s.io/api (75% of changed files)\n- k8s.io/kubernetes (25% of changed files)\n"
+	if !strings.Contains(got, want) {
+		t.Errorf("body does not contain %q:\n%s", want, got)
+	}
+}
+
+func TestNewCVEBodySuggestedExclusion(t *testing.T) {
+	r := &store.CVERecord{
+		ID:                 "ID1",
+		Module:             "github.com/goharbor/harbor/src",
+		TriageStateReason:  "Go program, not importable package",
+		SuggestedExclusion: "NOT_IMPORTABLE",
+		CVE:                &cveschema.CVE{},
+	}
+	got, err := newCVEBody(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"Triage reason: Go program, not importable package",
+		"Triage suggests that its report be excluded, with reason NOT_IMPORTABLE, as below.",
+		"excluded: NOT_IMPORTABLE\nmodules:\n  - module: github.com/goharbor/harbor/src\ncves:\n  - ID1\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("body does not contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "description:") {
+		t.Errorf("suggested excluded report has a description:\n%s", got)
+	}
+}
+
 func TestNewGHSABody(t *testing.T) {
 	r := &store.GHSARecord{
 		GHSA: &ghsa.SecurityAdvisory{
@@ -271,6 +347,7 @@ func TestNewGHSABody(t *testing.T) {
 See [doc/triage.md](https://github.com/golang/vulndb/blob/master/doc/triage.md) for instructions on how to triage this report.
 
 ` + "```" + `
+schema_version: 3
 modules:
   - module: TODO
     versions:
@@ -399,10 +476,18 @@ func TestUpdateGHSAs(t *testing.T) {
 	// SA "g5" entered with Alias state because it is an alias of
 	// "CVE-2000-2222" which already has an issue.
 	want = append(want, &store.GHSARecord{
-		GHSA:        sas[4],
-		TriageState: store.TriageStateAlias,
+		GHSA:              sas[4],
+		TriageState:       store.TriageStateAlias,
+		TriageStateReason: "alias of CVE-2000-2222",
 	})
 	updateAndCheck(UpdateGHSAStats{5, 5, 0}, want)
+	cursor, err := mstore.GetFetchCursor(ctx, ghsaCursorSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := day(2021, 12, 1); !cursor.Equal(want) {
+		t.Errorf("cursor: got %s, want %s", cursor, want)
+	}
 
 	// New SA added, old one updated.
 	sas[0] = &ghsa.SecurityAdvisory{
@@ -425,6 +510,208 @@ func TestUpdateGHSAs(t *testing.T) {
 
 }
 
+// failingTxStore is a store whose transactions fail after the first n.
+type failingTxStore struct {
+	store.Store
+	n int
+}
+
+func (s *failingTxStore) RunTransaction(ctx context.Context, f func(context.Context, store.Transaction) error) error {
+	if s.n == 0 {
+		return errors.New("transaction failed")
+	}
+	s.n--
+	return s.Store.RunTransaction(ctx, f)
+}
+
+func TestUpdateGHSAsCursorAfterFailure(t *testing.T) {
+	// More GHSAs than a batch, newest first.
+	var sas []*ghsa.SecurityAdvisory
+	start := day(2021, 1, 1)
+	for i := updateBatchSize + 10; i > 0; i-- {
+		sas = append(sas, &ghsa.SecurityAdvisory{
+			ID:        fmt.Sprintf("g%d", i),
+			UpdatedAt: start.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	mstore := store.NewMemStore()
+	// Reading the existing records and storing the first batch succeed;
+	// storing the second batch fails.
+	if _, err := UpdateGHSAs(context.Background(), fakeListFunc(sas), &failingTxStore{mstore, 2}); err == nil {
+		t.Fatal("got nil, want error")
+	}
+	cursor, err := mstore.GetFetchCursor(context.Background(), ghsaCursorSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := map[string]bool{}
+	for _, r := range getGHSARecordsSorted(t, mstore) {
+		stored[r.GHSA.ID] = true
+	}
+	if len(stored) != updateBatchSize {
+		t.Fatalf("stored %d GHSAs, want %d", len(stored), updateBatchSize)
+	}
+	for _, sa := range sas {
+		if !stored[sa.ID] && !sa.UpdatedAt.After(cursor) {
+			t.Fatalf("cursor %s passes %s, updated at %s, which wasn't stored", cursor, sa.ID, sa.UpdatedAt)
+		}
+	}
+}
+
+// writeCountingStore is a store that records the most documents that one of
+// its transactions wrote.
+type writeCountingStore struct {
+	store.Store
+	maxWrites int
+}
+
+func (s *writeCountingStore) RunTransaction(ctx context.Context, f func(context.Context, store.Transaction) error) error {
+	return s.Store.RunTransaction(ctx, func(ctx context.Context, tx store.Transaction) error {
+		wtx := &writeCountingTx{Transaction: tx}
+		err := f(ctx, wtx)
+		if wtx.writes > s.maxWrites {
+			s.maxWrites = wtx.writes
+		}
+		return err
+	})
+}
+
+type writeCountingTx struct {
+	store.Transaction
+	writes int
+}
+
+func (tx *writeCountingTx) CreateGHSARecord(r *store.GHSARecord) error {
+	tx.writes++
+	return tx.Transaction.CreateGHSARecord(r)
+}
+
+func (tx *writeCountingTx) SetGHSARecord(r *store.GHSARecord) error {
+	tx.writes++
+	return tx.Transaction.SetGHSARecord(r)
+}
+
+func (tx *writeCountingTx) AddAliases(ids ...string) error {
+	tx.writes += len(ids)
+	return tx.Transaction.AddAliases(ids...)
+}
+
+func (tx *writeCountingTx) SetSearchTerms(id string, terms []string) error {
+	tx.writes++
+	return tx.Transaction.SetSearchTerms(id, terms)
+}
+
+func TestUpdateGHSAsTransactionWrites(t *testing.T) {
+	// Enough GHSAs, each with a CVE, to fill batches of records.
+	var sas []*ghsa.SecurityAdvisory
+	for i := 0; i < maxTransactionWrites; i++ {
+		sas = append(sas, &ghsa.SecurityAdvisory{
+			ID:          fmt.Sprintf("GHSA-%04d-xxxx-xxxx", i),
+			Identifiers: []ghsa.Identifier{{Type: "CVE", Value: fmt.Sprintf("CVE-2022-%04d", i)}},
+			UpdatedAt:   day(2021, 1, 1),
+		})
+	}
+	st := &writeCountingStore{Store: store.NewMemStore()}
+	if _, err := UpdateGHSAs(context.Background(), fakeListFunc(sas), st); err != nil {
+		t.Fatal(err)
+	}
+	if st.maxWrites > maxTransactionWrites {
+		t.Errorf("a transaction wrote %d documents, more than Firestore's limit of %d", st.maxWrites, maxTransactionWrites)
+	}
+}
+
+func TestCVEAliasOfGHSA(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	err := mstore.RunTransaction(ctx, func(_ context.Context, tx store.Transaction) error {
+		return tx.CreateGHSARecord(&store.GHSARecord{
+			GHSA: &ghsa.SecurityAdvisory{
+				ID:          "GHSA-xxxx-yyyy-zzzz",
+				Identifiers: []ghsa.Identifier{{Type: "CVE", Value: "CVE-2022-0001"}},
+			},
+			TriageState:    store.TriageStateIssueCreated,
+			IssueReference: "golang/vulndb#1",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	triage := func(context.Context, *cveschema.CVE) (*triageResult, error) {
+		return &triageResult{modulePath: "example.com/m", reason: "reason"}, nil
+	}
+	src := cveSource{path: "p", blobHash: "b", commitHash: "c", commitTime: time.Now()}
+	for _, id := range []string{"CVE-2022-0001", "CVE-2022-0002"} {
+		cve := &cveschema.CVE{Metadata: cveschema.Metadata{ID: id, State: cveschema.StatePublic}}
+		err := mstore.RunTransaction(ctx, func(_ context.Context, tx store.Transaction) error {
+			_, err := triageAndStoreCVE(ctx, tx, cve, nil, src, nil, triage)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := mstore.CVERecords()
+	if got, want := got["CVE-2022-0001"].TriageState, store.TriageStateAlias; got != want {
+		t.Errorf("CVE-2022-0001: got %s, want %s", got, want)
+	}
+	if got, want := got["CVE-2022-0001"].TriageStateReason, "alias of GHSA-xxxx-yyyy-zzzz (golang/vulndb#1)"; got != want {
+		t.Errorf("CVE-2022-0001: got reason %q, want %q", got, want)
+	}
+	if got, want := got["CVE-2022-0002"].TriageState, store.TriageStateNeedsIssue; got != want {
+		t.Errorf("CVE-2022-0002: got %s, want %s", got, want)
+	}
+}
+
+func TestGoReportAlias(t *testing.T) {
+	ctx := context.Background()
+	mstore := store.NewMemStore()
+	err := mstore.RunTransaction(ctx, func(_ context.Context, tx store.Transaction) error {
+		return tx.AddAliases("GO-2022-0100", "CVE-2022-0001")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A new CVE that a Go report covers needs no issue.
+	triage := func(context.Context, *cveschema.CVE) (*triageResult, error) {
+		return &triageResult{modulePath: "example.com/m", reason: "reason"}, nil
+	}
+	src := cveSource{path: "p", blobHash: "b", commitHash: "c", commitTime: time.Now()}
+	cve := &cveschema.CVE{Metadata: cveschema.Metadata{ID: "CVE-2022-0001", State: cveschema.StatePublic}}
+	err = mstore.RunTransaction(ctx, func(_ context.Context, tx store.Transaction) error {
+		_, err := triageAndStoreCVE(ctx, tx, cve, nil, src, nil, triage)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := mstore.CVERecords()["CVE-2022-0001"]
+	if cr.TriageState != store.TriageStateHasVuln || cr.TriageStateReason != "GO-2022-0100" {
+		t.Errorf("CVE: got (%s, %q), want (HasVuln, GO-2022-0100)", cr.TriageState, cr.TriageStateReason)
+	}
+
+	// So does a new GHSA for the same CVE, which becomes an alias too.
+	sa := &ghsa.SecurityAdvisory{
+		ID:          "GHSA-xxxx-yyyy-zzzz",
+		Identifiers: []ghsa.Identifier{{Type: "CVE", Value: "CVE-2022-0001"}},
+		UpdatedAt:   day(2022, 10, 1),
+	}
+	if _, err := UpdateGHSAs(ctx, fakeListFunc([]*ghsa.SecurityAdvisory{sa}), mstore); err != nil {
+		t.Fatal(err)
+	}
+	grs := getGHSARecordsSorted(t, mstore)
+	if len(grs) != 1 || grs[0].TriageState != store.TriageStateHasVuln || grs[0].TriageStateReason != "GO-2022-0100" {
+		t.Fatalf("GHSA: got %+v, want one HasVuln record", grs)
+	}
+	aliases, err := mstore.GetAliases(ctx, "GO-2022-0100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"CVE-2022-0001", "GHSA-xxxx-yyyy-zzzz"}, aliases); diff != "" {
+		t.Errorf("aliases mismatch (-want, +got):\n%s", diff)
+	}
+}
+
 func getGHSARecordsSorted(t *testing.T, st store.Store) []*store.GHSARecord {
 	t.Helper()
 	rs, err := getGHSARecords(context.Background(), st)
@@ -447,6 +734,21 @@ func fakeListFunc(sas []*ghsa.SecurityAdvisory) GHSAListFunc {
 	}
 }
 
+func TestIssueTitle(t *testing.T) {
+	for _, test := range []struct {
+		module, want string
+	}{
+		{"golang.org/x/net", "x/vulndb: potential Go vuln in golang.org/x/net: CVE-2022-0001"},
+		{unknownPath, "x/vulndb: potential Go vuln: CVE-2022-0001"},
+		{"", "x/vulndb: potential Go vuln: CVE-2022-0001"},
+	} {
+		cr := &store.CVERecord{ID: "CVE-2022-0001", Module: test.module}
+		if got := issueTitle(cr); got != test.want {
+			t.Errorf("module %q: got %q, want %q", test.module, got, test.want)
+		}
+	}
+}
+
 func TestYearLabel(t *testing.T) {
 	for _, test := range []struct {
 		input, want string